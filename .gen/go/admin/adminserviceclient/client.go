@@ -53,6 +53,24 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	MigrateTaskListBacklog(
+		ctx context.Context,
+		Request *admin.MigrateTaskListBacklogRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.MigrateTaskListBacklogResponse, error)
+
+	PromoteTaskListBuild(
+		ctx context.Context,
+		Request *admin.PromoteTaskListBuildRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	VerifyWorkflowExecutionHistory(
+		ctx context.Context,
+		Request *admin.VerifyWorkflowExecutionHistoryRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.VerifyWorkflowExecutionHistoryResponse, error)
 }
 
 // New builds a new client for the AdminService service.
@@ -147,3 +165,72 @@ func (c client) GetWorkflowExecutionRawHistory(
 	success, err = admin.AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) MigrateTaskListBacklog(
+	ctx context.Context,
+	_Request *admin.MigrateTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateTaskListBacklogResponse, err error) {
+
+	args := admin.AdminService_MigrateTaskListBacklog_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MigrateTaskListBacklog_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_MigrateTaskListBacklog_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PromoteTaskListBuild(
+	ctx context.Context,
+	_Request *admin.PromoteTaskListBuildRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PromoteTaskListBuild_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PromoteTaskListBuild_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PromoteTaskListBuild_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) VerifyWorkflowExecutionHistory(
+	ctx context.Context,
+	_Request *admin.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.VerifyWorkflowExecutionHistoryResponse, err error) {
+
+	args := admin.AdminService_VerifyWorkflowExecutionHistory_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_VerifyWorkflowExecutionHistory_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_VerifyWorkflowExecutionHistory_Helper.UnwrapResponse(&result)
+	return
+}
@@ -48,6 +48,21 @@ type Interface interface {
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	MigrateTaskListBacklog(
+		ctx context.Context,
+		Request *admin.MigrateTaskListBacklogRequest,
+	) (*admin.MigrateTaskListBacklogResponse, error)
+
+	PromoteTaskListBuild(
+		ctx context.Context,
+		Request *admin.PromoteTaskListBuildRequest,
+	) error
+
+	VerifyWorkflowExecutionHistory(
+		ctx context.Context,
+		Request *admin.VerifyWorkflowExecutionHistoryRequest,
+	) (*admin.VerifyWorkflowExecutionHistoryResponse, error)
 }
 
 // New prepares an implementation of the AdminService service for
@@ -93,10 +108,43 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "GetWorkflowExecutionRawHistory(GetRequest *admin.GetWorkflowExecutionRawHistoryRequest) (*admin.GetWorkflowExecutionRawHistoryResponse)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "MigrateTaskListBacklog",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MigrateTaskListBacklog),
+				},
+				Signature:    "MigrateTaskListBacklog(Request *admin.MigrateTaskListBacklogRequest) (*admin.MigrateTaskListBacklogResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PromoteTaskListBuild",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PromoteTaskListBuild),
+				},
+				Signature:    "PromoteTaskListBuild(Request *admin.PromoteTaskListBuildRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "VerifyWorkflowExecutionHistory",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.VerifyWorkflowExecutionHistory),
+				},
+				Signature:    "VerifyWorkflowExecutionHistory(Request *admin.VerifyWorkflowExecutionHistoryRequest) (*admin.VerifyWorkflowExecutionHistoryResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 3)
+	procedures := make([]transport.Procedure, 0, 6)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -159,3 +207,60 @@ func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.V
 	}
 	return response, err
 }
+
+func (h handler) MigrateTaskListBacklog(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MigrateTaskListBacklog_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.MigrateTaskListBacklog(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_MigrateTaskListBacklog_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PromoteTaskListBuild(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PromoteTaskListBuild_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PromoteTaskListBuild(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PromoteTaskListBuild_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) VerifyWorkflowExecutionHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_VerifyWorkflowExecutionHistory_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.VerifyWorkflowExecutionHistory(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_VerifyWorkflowExecutionHistory_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
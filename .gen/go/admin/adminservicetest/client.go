@@ -161,3 +161,100 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistory(
 	args := append([]interface{}{ctx, _GetRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistory", args...)
 }
+
+// MigrateTaskListBacklog responds to a MigrateTaskListBacklog call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().MigrateTaskListBacklog(gomock.Any(), ...).Return(...)
+// 	... := client.MigrateTaskListBacklog(...)
+func (m *MockClient) MigrateTaskListBacklog(
+	ctx context.Context,
+	_Request *admin.MigrateTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateTaskListBacklogResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MigrateTaskListBacklog", args...)
+	success, _ = ret[i].(*admin.MigrateTaskListBacklogResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MigrateTaskListBacklog(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MigrateTaskListBacklog", args...)
+}
+
+// PromoteTaskListBuild responds to a PromoteTaskListBuild call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PromoteTaskListBuild(gomock.Any(), ...).Return(...)
+// 	... := client.PromoteTaskListBuild(...)
+func (m *MockClient) PromoteTaskListBuild(
+	ctx context.Context,
+	_Request *admin.PromoteTaskListBuildRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PromoteTaskListBuild", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PromoteTaskListBuild(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PromoteTaskListBuild", args...)
+}
+
+// VerifyWorkflowExecutionHistory responds to a VerifyWorkflowExecutionHistory call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().VerifyWorkflowExecutionHistory(gomock.Any(), ...).Return(...)
+// 	... := client.VerifyWorkflowExecutionHistory(...)
+func (m *MockClient) VerifyWorkflowExecutionHistory(
+	ctx context.Context,
+	_Request *admin.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.VerifyWorkflowExecutionHistoryResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "VerifyWorkflowExecutionHistory", args...)
+	success, _ = ret[i].(*admin.VerifyWorkflowExecutionHistoryResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) VerifyWorkflowExecutionHistory(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "VerifyWorkflowExecutionHistory", args...)
+}
@@ -1316,3 +1316,1355 @@ func (v *GetWorkflowExecutionRawHistoryResponse) GetEventStoreVersion() (o int32
 func (v *GetWorkflowExecutionRawHistoryResponse) IsSetEventStoreVersion() bool {
 	return v != nil && v.EventStoreVersion != nil
 }
+
+type MigrateTaskListBacklogRequest struct {
+	Domain         *string              `json:"domain,omitempty"`
+	SourceTaskList *shared.TaskList     `json:"sourceTaskList,omitempty"`
+	TargetTaskList *shared.TaskList     `json:"targetTaskList,omitempty"`
+	TaskListType   *shared.TaskListType `json:"taskListType,omitempty"`
+	ReadLevel      *int64               `json:"readLevel,omitempty"`
+	PageSize       *int32               `json:"pageSize,omitempty"`
+	DryRun         *bool                `json:"dryRun,omitempty"`
+}
+
+// ToWire translates a MigrateTaskListBacklogRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MigrateTaskListBacklogRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SourceTaskList != nil {
+		w, err = v.SourceTaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TargetTaskList != nil {
+		w, err = v.TargetTaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.ReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.PageSize != nil {
+		w, err = wire.NewValueI32(*(v.PageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.DryRun != nil {
+		w, err = wire.NewValueBool(*(v.DryRun)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
+	var v shared.TaskList
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TaskListType_Read(w wire.Value) (shared.TaskListType, error) {
+	var v shared.TaskListType
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a MigrateTaskListBacklogRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MigrateTaskListBacklogRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MigrateTaskListBacklogRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MigrateTaskListBacklogRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.SourceTaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.TargetTaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x shared.TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReadLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.PageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.DryRun = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MigrateTaskListBacklogRequest
+// struct.
+func (v *MigrateTaskListBacklogRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.SourceTaskList != nil {
+		fields[i] = fmt.Sprintf("SourceTaskList: %v", v.SourceTaskList)
+		i++
+	}
+	if v.TargetTaskList != nil {
+		fields[i] = fmt.Sprintf("TargetTaskList: %v", v.TargetTaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+	if v.ReadLevel != nil {
+		fields[i] = fmt.Sprintf("ReadLevel: %v", *(v.ReadLevel))
+		i++
+	}
+	if v.PageSize != nil {
+		fields[i] = fmt.Sprintf("PageSize: %v", *(v.PageSize))
+		i++
+	}
+	if v.DryRun != nil {
+		fields[i] = fmt.Sprintf("DryRun: %v", *(v.DryRun))
+		i++
+	}
+
+	return fmt.Sprintf("MigrateTaskListBacklogRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _TaskListType_EqualsPtr(lhs, rhs *shared.TaskListType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this MigrateTaskListBacklogRequest match the
+// provided MigrateTaskListBacklogRequest.
+//
+// This function performs a deep comparison.
+func (v *MigrateTaskListBacklogRequest) Equals(rhs *MigrateTaskListBacklogRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.SourceTaskList == nil && rhs.SourceTaskList == nil) || (v.SourceTaskList != nil && rhs.SourceTaskList != nil && v.SourceTaskList.Equals(rhs.SourceTaskList))) {
+		return false
+	}
+	if !((v.TargetTaskList == nil && rhs.TargetTaskList == nil) || (v.TargetTaskList != nil && rhs.TargetTaskList != nil && v.TargetTaskList.Equals(rhs.TargetTaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ReadLevel, rhs.ReadLevel) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.PageSize, rhs.PageSize) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.DryRun, rhs.DryRun) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MigrateTaskListBacklogRequest.
+func (v *MigrateTaskListBacklogRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.SourceTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("sourceTaskList", v.SourceTaskList))
+	}
+	if v.TargetTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("targetTaskList", v.TargetTaskList))
+	}
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
+	}
+	if v.ReadLevel != nil {
+		enc.AddInt64("readLevel", *v.ReadLevel)
+	}
+	if v.PageSize != nil {
+		enc.AddInt32("pageSize", *v.PageSize)
+	}
+	if v.DryRun != nil {
+		enc.AddBool("dryRun", *v.DryRun)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetSourceTaskList returns the value of SourceTaskList if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetSourceTaskList() (o *shared.TaskList) {
+	if v != nil && v.SourceTaskList != nil {
+		return v.SourceTaskList
+	}
+
+	return
+}
+
+// IsSetSourceTaskList returns true if SourceTaskList is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetSourceTaskList() bool {
+	return v != nil && v.SourceTaskList != nil
+}
+
+// GetTargetTaskList returns the value of TargetTaskList if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetTargetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TargetTaskList != nil {
+		return v.TargetTaskList
+	}
+
+	return
+}
+
+// IsSetTargetTaskList returns true if TargetTaskList is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetTargetTaskList() bool {
+	return v != nil && v.TargetTaskList != nil
+}
+
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetTaskListType() (o shared.TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
+}
+
+// IsSetTaskListType returns true if TaskListType is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetTaskListType() bool {
+	return v != nil && v.TaskListType != nil
+}
+
+// GetReadLevel returns the value of ReadLevel if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetReadLevel() (o int64) {
+	if v != nil && v.ReadLevel != nil {
+		return *v.ReadLevel
+	}
+
+	return
+}
+
+// IsSetReadLevel returns true if ReadLevel is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetReadLevel() bool {
+	return v != nil && v.ReadLevel != nil
+}
+
+// GetPageSize returns the value of PageSize if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetPageSize() (o int32) {
+	if v != nil && v.PageSize != nil {
+		return *v.PageSize
+	}
+
+	return
+}
+
+// IsSetPageSize returns true if PageSize is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetPageSize() bool {
+	return v != nil && v.PageSize != nil
+}
+
+// GetDryRun returns the value of DryRun if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetDryRun() (o bool) {
+	if v != nil && v.DryRun != nil {
+		return *v.DryRun
+	}
+
+	return
+}
+
+// IsSetDryRun returns true if DryRun is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetDryRun() bool {
+	return v != nil && v.DryRun != nil
+}
+
+type MigrateTaskListBacklogResponse struct {
+	MigratedTasks *int32 `json:"migratedTasks,omitempty"`
+	ExpiredTasks  *int32 `json:"expiredTasks,omitempty"`
+	NextReadLevel *int64 `json:"nextReadLevel,omitempty"`
+	HasMore       *bool  `json:"hasMore,omitempty"`
+}
+
+// ToWire translates a MigrateTaskListBacklogResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MigrateTaskListBacklogResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.MigratedTasks != nil {
+		w, err = wire.NewValueI32(*(v.MigratedTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ExpiredTasks != nil {
+		w, err = wire.NewValueI32(*(v.ExpiredTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.NextReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.NextReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.HasMore != nil {
+		w, err = wire.NewValueBool(*(v.HasMore)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MigrateTaskListBacklogResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MigrateTaskListBacklogResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MigrateTaskListBacklogResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MigrateTaskListBacklogResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MigratedTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExpiredTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextReadLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.HasMore = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MigrateTaskListBacklogResponse
+// struct.
+func (v *MigrateTaskListBacklogResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.MigratedTasks != nil {
+		fields[i] = fmt.Sprintf("MigratedTasks: %v", *(v.MigratedTasks))
+		i++
+	}
+	if v.ExpiredTasks != nil {
+		fields[i] = fmt.Sprintf("ExpiredTasks: %v", *(v.ExpiredTasks))
+		i++
+	}
+	if v.NextReadLevel != nil {
+		fields[i] = fmt.Sprintf("NextReadLevel: %v", *(v.NextReadLevel))
+		i++
+	}
+	if v.HasMore != nil {
+		fields[i] = fmt.Sprintf("HasMore: %v", *(v.HasMore))
+		i++
+	}
+
+	return fmt.Sprintf("MigrateTaskListBacklogResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MigrateTaskListBacklogResponse match the
+// provided MigrateTaskListBacklogResponse.
+//
+// This function performs a deep comparison.
+func (v *MigrateTaskListBacklogResponse) Equals(rhs *MigrateTaskListBacklogResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MigratedTasks, rhs.MigratedTasks) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ExpiredTasks, rhs.ExpiredTasks) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextReadLevel, rhs.NextReadLevel) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.HasMore, rhs.HasMore) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MigrateTaskListBacklogResponse.
+func (v *MigrateTaskListBacklogResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.MigratedTasks != nil {
+		enc.AddInt32("migratedTasks", *v.MigratedTasks)
+	}
+	if v.ExpiredTasks != nil {
+		enc.AddInt32("expiredTasks", *v.ExpiredTasks)
+	}
+	if v.NextReadLevel != nil {
+		enc.AddInt64("nextReadLevel", *v.NextReadLevel)
+	}
+	if v.HasMore != nil {
+		enc.AddBool("hasMore", *v.HasMore)
+	}
+	return err
+}
+
+// GetMigratedTasks returns the value of MigratedTasks if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogResponse) GetMigratedTasks() (o int32) {
+	if v != nil && v.MigratedTasks != nil {
+		return *v.MigratedTasks
+	}
+
+	return
+}
+
+// IsSetMigratedTasks returns true if MigratedTasks is not nil.
+func (v *MigrateTaskListBacklogResponse) IsSetMigratedTasks() bool {
+	return v != nil && v.MigratedTasks != nil
+}
+
+// GetExpiredTasks returns the value of ExpiredTasks if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogResponse) GetExpiredTasks() (o int32) {
+	if v != nil && v.ExpiredTasks != nil {
+		return *v.ExpiredTasks
+	}
+
+	return
+}
+
+// IsSetExpiredTasks returns true if ExpiredTasks is not nil.
+func (v *MigrateTaskListBacklogResponse) IsSetExpiredTasks() bool {
+	return v != nil && v.ExpiredTasks != nil
+}
+
+// GetNextReadLevel returns the value of NextReadLevel if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogResponse) GetNextReadLevel() (o int64) {
+	if v != nil && v.NextReadLevel != nil {
+		return *v.NextReadLevel
+	}
+
+	return
+}
+
+// IsSetNextReadLevel returns true if NextReadLevel is not nil.
+func (v *MigrateTaskListBacklogResponse) IsSetNextReadLevel() bool {
+	return v != nil && v.NextReadLevel != nil
+}
+
+// GetHasMore returns the value of HasMore if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogResponse) GetHasMore() (o bool) {
+	if v != nil && v.HasMore != nil {
+		return *v.HasMore
+	}
+
+	return
+}
+
+// IsSetHasMore returns true if HasMore is not nil.
+func (v *MigrateTaskListBacklogResponse) IsSetHasMore() bool {
+	return v != nil && v.HasMore != nil
+}
+
+type PromoteTaskListBuildRequest struct {
+	Domain                    *string          `json:"domain,omitempty"`
+	TaskList                  *shared.TaskList `json:"taskList,omitempty"`
+	BinaryChecksum            *string          `json:"binaryChecksum,omitempty"`
+	CompatibleBinaryChecksums []string         `json:"compatibleBinaryChecksums,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a PromoteTaskListBuildRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PromoteTaskListBuildRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.CompatibleBinaryChecksums != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.CompatibleBinaryChecksums)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a PromoteTaskListBuildRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PromoteTaskListBuildRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PromoteTaskListBuildRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PromoteTaskListBuildRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.CompatibleBinaryChecksums, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PromoteTaskListBuildRequest
+// struct.
+func (v *PromoteTaskListBuildRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
+		i++
+	}
+	if v.CompatibleBinaryChecksums != nil {
+		fields[i] = fmt.Sprintf("CompatibleBinaryChecksums: %v", v.CompatibleBinaryChecksums)
+		i++
+	}
+
+	return fmt.Sprintf("PromoteTaskListBuildRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this PromoteTaskListBuildRequest match the
+// provided PromoteTaskListBuildRequest.
+//
+// This function performs a deep comparison.
+func (v *PromoteTaskListBuildRequest) Equals(rhs *PromoteTaskListBuildRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
+		return false
+	}
+	if !((v.CompatibleBinaryChecksums == nil && rhs.CompatibleBinaryChecksums == nil) || (v.CompatibleBinaryChecksums != nil && rhs.CompatibleBinaryChecksums != nil && _List_String_Equals(v.CompatibleBinaryChecksums, rhs.CompatibleBinaryChecksums))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PromoteTaskListBuildRequest.
+func (v *PromoteTaskListBuildRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
+	}
+	if v.CompatibleBinaryChecksums != nil {
+		err = multierr.Append(err, enc.AddArray("compatibleBinaryChecksums", (_List_String_Zapper)(v.CompatibleBinaryChecksums)))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *PromoteTaskListBuildRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *PromoteTaskListBuildRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *PromoteTaskListBuildRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *PromoteTaskListBuildRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *PromoteTaskListBuildRequest) GetBinaryChecksum() (o string) {
+	if v != nil && v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
+	}
+
+	return
+}
+
+// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
+func (v *PromoteTaskListBuildRequest) IsSetBinaryChecksum() bool {
+	return v != nil && v.BinaryChecksum != nil
+}
+
+// GetCompatibleBinaryChecksums returns the value of CompatibleBinaryChecksums if it is set or its
+// zero value if it is unset.
+func (v *PromoteTaskListBuildRequest) GetCompatibleBinaryChecksums() (o []string) {
+	if v != nil && v.CompatibleBinaryChecksums != nil {
+		return v.CompatibleBinaryChecksums
+	}
+
+	return
+}
+
+// IsSetCompatibleBinaryChecksums returns true if CompatibleBinaryChecksums is not nil.
+func (v *PromoteTaskListBuildRequest) IsSetCompatibleBinaryChecksums() bool {
+	return v != nil && v.CompatibleBinaryChecksums != nil
+}
+
+type VerifyWorkflowExecutionHistoryRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a VerifyWorkflowExecutionHistoryRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *VerifyWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a VerifyWorkflowExecutionHistoryRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a VerifyWorkflowExecutionHistoryRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v VerifyWorkflowExecutionHistoryRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *VerifyWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a VerifyWorkflowExecutionHistoryRequest
+// struct.
+func (v *VerifyWorkflowExecutionHistoryRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("VerifyWorkflowExecutionHistoryRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this VerifyWorkflowExecutionHistoryRequest match the
+// provided VerifyWorkflowExecutionHistoryRequest.
+//
+// This function performs a deep comparison.
+func (v *VerifyWorkflowExecutionHistoryRequest) Equals(rhs *VerifyWorkflowExecutionHistoryRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of VerifyWorkflowExecutionHistoryRequest.
+func (v *VerifyWorkflowExecutionHistoryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *VerifyWorkflowExecutionHistoryRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *VerifyWorkflowExecutionHistoryRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *VerifyWorkflowExecutionHistoryRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *VerifyWorkflowExecutionHistoryRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type VerifyWorkflowExecutionHistoryResponse struct {
+	Mismatches []string `json:"mismatches,omitempty"`
+}
+
+// ToWire translates a VerifyWorkflowExecutionHistoryResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *VerifyWorkflowExecutionHistoryResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Mismatches != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Mismatches)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a VerifyWorkflowExecutionHistoryResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a VerifyWorkflowExecutionHistoryResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v VerifyWorkflowExecutionHistoryResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *VerifyWorkflowExecutionHistoryResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Mismatches, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a VerifyWorkflowExecutionHistoryResponse
+// struct.
+func (v *VerifyWorkflowExecutionHistoryResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Mismatches != nil {
+		fields[i] = fmt.Sprintf("Mismatches: %v", v.Mismatches)
+		i++
+	}
+
+	return fmt.Sprintf("VerifyWorkflowExecutionHistoryResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this VerifyWorkflowExecutionHistoryResponse match the
+// provided VerifyWorkflowExecutionHistoryResponse.
+//
+// This function performs a deep comparison.
+func (v *VerifyWorkflowExecutionHistoryResponse) Equals(rhs *VerifyWorkflowExecutionHistoryResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Mismatches == nil && rhs.Mismatches == nil) || (v.Mismatches != nil && rhs.Mismatches != nil && _List_String_Equals(v.Mismatches, rhs.Mismatches))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of VerifyWorkflowExecutionHistoryResponse.
+func (v *VerifyWorkflowExecutionHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Mismatches != nil {
+		err = multierr.Append(err, enc.AddArray("mismatches", (_List_String_Zapper)(v.Mismatches)))
+	}
+	return err
+}
+
+// GetMismatches returns the value of Mismatches if it is set or its
+// zero value if it is unset.
+func (v *VerifyWorkflowExecutionHistoryResponse) GetMismatches() (o []string) {
+	if v != nil && v.Mismatches != nil {
+		return v.Mismatches
+	}
+
+	return
+}
+
+// IsSetMismatches returns true if Mismatches is not nil.
+func (v *VerifyWorkflowExecutionHistoryResponse) IsSetMismatches() bool {
+	return v != nil && v.Mismatches != nil
+}
@@ -228,6 +228,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.StartWorkflowExecutionResponse, error)
 
+	StartWorkflowExecutionBatch(
+		ctx context.Context,
+		BatchRequest *shared.StartWorkflowExecutionBatchRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.StartWorkflowExecutionBatchResponse, error)
+
 	TerminateWorkflowExecution(
 		ctx context.Context,
 		TerminateRequest *shared.TerminateWorkflowExecutionRequest,
@@ -239,6 +245,12 @@ type Interface interface {
 		UpdateRequest *shared.UpdateDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the WorkflowService service.
@@ -1001,6 +1013,29 @@ func (c client) StartWorkflowExecution(
 	return
 }
 
+func (c client) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	_BatchRequest *shared.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.StartWorkflowExecutionBatchResponse, err error) {
+
+	args := cadence.WorkflowService_StartWorkflowExecutionBatch_Helper.Args(_BatchRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_StartWorkflowExecutionBatch_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_StartWorkflowExecutionBatch_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) TerminateWorkflowExecution(
 	ctx context.Context,
 	_TerminateRequest *shared.TerminateWorkflowExecutionRequest,
@@ -1046,3 +1081,26 @@ func (c client) UpdateDomain(
 	success, err = cadence.WorkflowService_UpdateDomain_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := cadence.WorkflowService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UpdateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
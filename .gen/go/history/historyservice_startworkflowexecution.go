@@ -75,12 +75,6 @@ func (v *HistoryService_StartWorkflowExecution_Args) ToWire() (wire.Value, error
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StartWorkflowExecutionRequest_1_Read(w wire.Value) (*StartWorkflowExecutionRequest, error) {
-	var v StartWorkflowExecutionRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
 // FromWire deserializes a HistoryService_StartWorkflowExecution_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -105,7 +99,7 @@ func (v *HistoryService_StartWorkflowExecution_Args) FromWire(w wire.Value) erro
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.StartRequest, err = _StartWorkflowExecutionRequest_1_Read(field.Value)
+				v.StartRequest, err = _StartWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
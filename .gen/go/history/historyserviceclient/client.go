@@ -60,6 +60,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.GetMutableStateResponse, error)
 
+	GetRunningWorkflowCounts(
+		ctx context.Context,
+		Request *history.GetRunningWorkflowCountsRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetRunningWorkflowCountsResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -174,6 +180,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.StartWorkflowExecutionResponse, error)
 
+	StartWorkflowExecutionBatch(
+		ctx context.Context,
+		BatchRequest *history.StartWorkflowExecutionBatchRequest,
+		opts ...yarpc.CallOption,
+	) (*history.StartWorkflowExecutionBatchResponse, error)
+
 	SyncActivity(
 		ctx context.Context,
 		SyncActivityRequest *history.SyncActivityRequest,
@@ -191,6 +203,18 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
+
+	VerifyWorkflowExecutionHistory(
+		ctx context.Context,
+		Request *history.VerifyWorkflowExecutionHistoryRequest,
+		opts ...yarpc.CallOption,
+	) (*history.VerifyWorkflowExecutionHistoryResponse, error)
 }
 
 // New builds a new client for the HistoryService service.
@@ -309,6 +333,29 @@ func (c client) GetMutableState(
 	return
 }
 
+func (c client) GetRunningWorkflowCounts(
+	ctx context.Context,
+	_Request *history.GetRunningWorkflowCountsRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetRunningWorkflowCountsResponse, err error) {
+
+	args := history.HistoryService_GetRunningWorkflowCounts_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetRunningWorkflowCounts_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetRunningWorkflowCounts_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -746,6 +793,29 @@ func (c client) StartWorkflowExecution(
 	return
 }
 
+func (c client) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	_BatchRequest *history.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption,
+) (success *history.StartWorkflowExecutionBatchResponse, err error) {
+
+	args := history.HistoryService_StartWorkflowExecutionBatch_Helper.Args(_BatchRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_StartWorkflowExecutionBatch_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_StartWorkflowExecutionBatch_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) SyncActivity(
 	ctx context.Context,
 	_SyncActivityRequest *history.SyncActivityRequest,
@@ -814,3 +884,49 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UpdateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) VerifyWorkflowExecutionHistory(
+	ctx context.Context,
+	_Request *history.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *history.VerifyWorkflowExecutionHistoryResponse, err error) {
+
+	args := history.HistoryService_VerifyWorkflowExecutionHistory_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_VerifyWorkflowExecutionHistory_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_VerifyWorkflowExecutionHistory_Helper.UnwrapResponse(&result)
+	return
+}
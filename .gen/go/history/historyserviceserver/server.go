@@ -54,6 +54,11 @@ type Interface interface {
 		GetRequest *history.GetMutableStateRequest,
 	) (*history.GetMutableStateResponse, error)
 
+	GetRunningWorkflowCounts(
+		ctx context.Context,
+		Request *history.GetRunningWorkflowCountsRequest,
+	) (*history.GetRunningWorkflowCountsResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -149,6 +154,11 @@ type Interface interface {
 		StartRequest *history.StartWorkflowExecutionRequest,
 	) (*shared.StartWorkflowExecutionResponse, error)
 
+	StartWorkflowExecutionBatch(
+		ctx context.Context,
+		BatchRequest *history.StartWorkflowExecutionBatchRequest,
+	) (*history.StartWorkflowExecutionBatchResponse, error)
+
 	SyncActivity(
 		ctx context.Context,
 		SyncActivityRequest *history.SyncActivityRequest,
@@ -163,6 +173,16 @@ type Interface interface {
 		ctx context.Context,
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
+
+	VerifyWorkflowExecutionHistory(
+		ctx context.Context,
+		Request *history.VerifyWorkflowExecutionHistoryRequest,
+	) (*history.VerifyWorkflowExecutionHistoryResponse, error)
 }
 
 // New prepares an implementation of the HistoryService service for
@@ -220,6 +240,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetRunningWorkflowCounts",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetRunningWorkflowCounts),
+				},
+				Signature:    "GetRunningWorkflowCounts(Request *history.GetRunningWorkflowCountsRequest) (*history.GetRunningWorkflowCountsResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RecordActivityTaskHeartbeat",
 				HandlerSpec: thrift.HandlerSpec{
@@ -429,6 +460,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "StartWorkflowExecutionBatch",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.StartWorkflowExecutionBatch),
+				},
+				Signature:    "StartWorkflowExecutionBatch(BatchRequest *history.StartWorkflowExecutionBatchRequest) (*history.StartWorkflowExecutionBatchResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "SyncActivity",
 				HandlerSpec: thrift.HandlerSpec{
@@ -461,10 +503,32 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "TerminateWorkflowExecution(TerminateRequest *history.TerminateWorkflowExecutionRequest)",
 				ThriftModule: history.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateWorkflowExecution),
+				},
+				Signature:    "UpdateWorkflowExecution(UpdateRequest *history.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "VerifyWorkflowExecutionHistory",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.VerifyWorkflowExecutionHistory),
+				},
+				Signature:    "VerifyWorkflowExecutionHistory(Request *history.VerifyWorkflowExecutionHistoryRequest) (*history.VerifyWorkflowExecutionHistoryResponse)",
+				ThriftModule: history.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 26)
+	procedures := make([]transport.Procedure, 0, 30)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -547,6 +611,25 @@ func (h handler) GetMutableState(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) GetRunningWorkflowCounts(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetRunningWorkflowCounts_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GetRunningWorkflowCounts(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_GetRunningWorkflowCounts_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_RecordActivityTaskHeartbeat_Args
 	if err := args.FromWire(body); err != nil {
@@ -908,6 +991,25 @@ func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) StartWorkflowExecutionBatch(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_StartWorkflowExecutionBatch_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.StartWorkflowExecutionBatch(ctx, args.BatchRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_StartWorkflowExecutionBatch_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) SyncActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_SyncActivity_Args
 	if err := args.FromWire(body); err != nil {
@@ -964,3 +1066,41 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	}
 	return response, err
 }
+
+func (h handler) UpdateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UpdateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_UpdateWorkflowExecution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) VerifyWorkflowExecutionHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_VerifyWorkflowExecutionHistory_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.VerifyWorkflowExecutionHistory(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_VerifyWorkflowExecutionHistory_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
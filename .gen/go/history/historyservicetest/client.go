@@ -195,6 +195,39 @@ func (mr *_MockClientRecorder) GetMutableState(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetMutableState", args...)
 }
 
+// GetRunningWorkflowCounts responds to a GetRunningWorkflowCounts call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().GetRunningWorkflowCounts(gomock.Any(), ...).Return(...)
+// 	... := client.GetRunningWorkflowCounts(...)
+func (m *MockClient) GetRunningWorkflowCounts(
+	ctx context.Context,
+	_Request *history.GetRunningWorkflowCountsRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetRunningWorkflowCountsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetRunningWorkflowCounts", args...)
+	success, _ = ret[i].(*history.GetRunningWorkflowCountsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetRunningWorkflowCounts(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetRunningWorkflowCounts", args...)
+}
+
 // RecordActivityTaskHeartbeat responds to a RecordActivityTaskHeartbeat call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -800,6 +833,39 @@ func (mr *_MockClientRecorder) StartWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "StartWorkflowExecution", args...)
 }
 
+// StartWorkflowExecutionBatch responds to a StartWorkflowExecutionBatch call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().StartWorkflowExecutionBatch(gomock.Any(), ...).Return(...)
+// 	... := client.StartWorkflowExecutionBatch(...)
+func (m *MockClient) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	_BatchRequest *history.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption,
+) (success *history.StartWorkflowExecutionBatchResponse, err error) {
+
+	args := []interface{}{ctx, _BatchRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "StartWorkflowExecutionBatch", args...)
+	success, _ = ret[i].(*history.StartWorkflowExecutionBatchResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) StartWorkflowExecutionBatch(
+	ctx interface{},
+	_BatchRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _BatchRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "StartWorkflowExecutionBatch", args...)
+}
+
 // SyncActivity responds to a SyncActivity call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -892,3 +958,69 @@ func (mr *_MockClientRecorder) TerminateWorkflowExecution(
 	args := append([]interface{}{ctx, _TerminateRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "TerminateWorkflowExecution", args...)
 }
+
+// UpdateWorkflowExecution responds to a UpdateWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateWorkflowExecution(...)
+func (m *MockClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", args...)
+	success, _ = ret[i].(*shared.UpdateWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateWorkflowExecution(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateWorkflowExecution", args...)
+}
+
+// VerifyWorkflowExecutionHistory responds to a VerifyWorkflowExecutionHistory call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().VerifyWorkflowExecutionHistory(gomock.Any(), ...).Return(...)
+// 	... := client.VerifyWorkflowExecutionHistory(...)
+func (m *MockClient) VerifyWorkflowExecutionHistory(
+	ctx context.Context,
+	_Request *history.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (success *history.VerifyWorkflowExecutionHistoryResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "VerifyWorkflowExecutionHistory", args...)
+	success, _ = ret[i].(*history.VerifyWorkflowExecutionHistoryResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) VerifyWorkflowExecutionHistory(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "VerifyWorkflowExecutionHistory", args...)
+}
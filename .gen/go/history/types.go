@@ -1841,14 +1841,38 @@ func (v *GetMutableStateResponse) IsSetReplicationInfo() bool {
 	return v != nil && v.ReplicationInfo != nil
 }
 
-type ParentExecutionInfo struct {
-	DomainUUID  *string                   `json:"domainUUID,omitempty"`
-	Domain      *string                   `json:"domain,omitempty"`
-	Execution   *shared.WorkflowExecution `json:"execution,omitempty"`
-	InitiatedId *int64                    `json:"initiatedId,omitempty"`
+type GetRunningWorkflowCountsRequest struct {
+	DomainUUID *string `json:"domainUUID,omitempty"`
+	ShardIDs   []int32 `json:"shardIDs,omitempty"`
 }
 
-// ToWire translates a ParentExecutionInfo struct into a Thrift-level intermediate
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+// ToWire translates a GetRunningWorkflowCountsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1863,9 +1887,9 @@ type ParentExecutionInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ParentExecutionInfo) ToWire() (wire.Value, error) {
+func (v *GetRunningWorkflowCountsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1879,39 +1903,41 @@ func (v *ParentExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 15, Value: w}
-		i++
-	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.ShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.ShardIDs)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.InitiatedId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ParentExecutionInfo struct from its Thrift-level
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetRunningWorkflowCountsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ParentExecutionInfo struct
+// An error is returned if we were unable to build a GetRunningWorkflowCountsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1919,12 +1945,12 @@ func (v *ParentExecutionInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ParentExecutionInfo
+//   var v GetRunningWorkflowCountsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ParentExecutionInfo) FromWire(w wire.Value) error {
+func (v *GetRunningWorkflowCountsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1938,30 +1964,10 @@ func (v *ParentExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 15:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedId = &x
+			if field.Value.Type() == wire.TList {
+				v.ShardIDs, err = _List_I32_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -1973,40 +1979,47 @@ func (v *ParentExecutionInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ParentExecutionInfo
+// String returns a readable string representation of a GetRunningWorkflowCountsRequest
 // struct.
-func (v *ParentExecutionInfo) String() string {
+func (v *GetRunningWorkflowCountsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.ShardIDs != nil {
+		fields[i] = fmt.Sprintf("ShardIDs: %v", v.ShardIDs)
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
+
+	return fmt.Sprintf("GetRunningWorkflowCountsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
-	if v.InitiatedId != nil {
-		fields[i] = fmt.Sprintf("InitiatedId: %v", *(v.InitiatedId))
-		i++
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
 	}
 
-	return fmt.Sprintf("ParentExecutionInfo{%v}", strings.Join(fields[:i], ", "))
+	return true
 }
 
-// Equals returns true if all the fields of this ParentExecutionInfo match the
-// provided ParentExecutionInfo.
+// Equals returns true if all the fields of this GetRunningWorkflowCountsRequest match the
+// provided GetRunningWorkflowCountsRequest.
 //
 // This function performs a deep comparison.
-func (v *ParentExecutionInfo) Equals(rhs *ParentExecutionInfo) bool {
+func (v *GetRunningWorkflowCountsRequest) Equals(rhs *GetRunningWorkflowCountsRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2015,43 +2028,42 @@ func (v *ParentExecutionInfo) Equals(rhs *ParentExecutionInfo) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.InitiatedId, rhs.InitiatedId) {
+	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ParentExecutionInfo.
-func (v *ParentExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of GetRunningWorkflowCountsRequest.
+func (v *GetRunningWorkflowCountsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
-	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
-	}
-	if v.InitiatedId != nil {
-		enc.AddInt64("initiatedId", *v.InitiatedId)
+	if v.ShardIDs != nil {
+		err = multierr.Append(err, enc.AddArray("shardIDs", (_List_I32_Zapper)(v.ShardIDs)))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *ParentExecutionInfo) GetDomainUUID() (o string) {
+func (v *GetRunningWorkflowCountsRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -2060,61 +2072,66 @@ func (v *ParentExecutionInfo) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *ParentExecutionInfo) IsSetDomainUUID() bool {
+func (v *GetRunningWorkflowCountsRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetShardIDs returns the value of ShardIDs if it is set or its
 // zero value if it is unset.
-func (v *ParentExecutionInfo) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *GetRunningWorkflowCountsRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *ParentExecutionInfo) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetShardIDs returns true if ShardIDs is not nil.
+func (v *GetRunningWorkflowCountsRequest) IsSetShardIDs() bool {
+	return v != nil && v.ShardIDs != nil
 }
 
-// GetExecution returns the value of Execution if it is set or its
-// zero value if it is unset.
-func (v *ParentExecutionInfo) GetExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
-	}
-
-	return
+type GetRunningWorkflowCountsResponse struct {
+	Counts   map[string]int64 `json:"counts,omitempty"`
+	ShardIDs []int32          `json:"shardIDs,omitempty"`
 }
 
-// IsSetExecution returns true if Execution is not nil.
-func (v *ParentExecutionInfo) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
-}
+type _Map_String_I64_MapItemList map[string]int64
 
-// GetInitiatedId returns the value of InitiatedId if it is set or its
-// zero value if it is unset.
-func (v *ParentExecutionInfo) GetInitiatedId() (o int64) {
-	if v != nil && v.InitiatedId != nil {
-		return *v.InitiatedId
+func (m _Map_String_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return
+func (m _Map_String_I64_MapItemList) Size() int {
+	return len(m)
 }
 
-// IsSetInitiatedId returns true if InitiatedId is not nil.
-func (v *ParentExecutionInfo) IsSetInitiatedId() bool {
-	return v != nil && v.InitiatedId != nil
+func (_Map_String_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
 }
 
-type RecordActivityTaskHeartbeatRequest struct {
-	DomainUUID       *string                                    `json:"domainUUID,omitempty"`
-	HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `json:"heartbeatRequest,omitempty"`
+func (_Map_String_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
 }
 
-// ToWire translates a RecordActivityTaskHeartbeatRequest struct into a Thrift-level intermediate
+func (_Map_String_I64_MapItemList) Close() {}
+
+// ToWire translates a GetRunningWorkflowCountsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2129,7 +2146,7 @@ type RecordActivityTaskHeartbeatRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
+func (v *GetRunningWorkflowCountsResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -2137,16 +2154,16 @@ func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.Counts != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.Counts)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.HeartbeatRequest != nil {
-		w, err = v.HeartbeatRequest.ToWire()
+	if v.ShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.ShardIDs)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -2157,17 +2174,39 @@ func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RecordActivityTaskHeartbeatRequest_Read(w wire.Value) (*shared.RecordActivityTaskHeartbeatRequest, error) {
-	var v shared.RecordActivityTaskHeartbeatRequest
-	err := v.FromWire(w)
-	return &v, err
+func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[string]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
 }
 
-// FromWire deserializes a RecordActivityTaskHeartbeatRequest struct from its Thrift-level
+// FromWire deserializes a GetRunningWorkflowCountsResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordActivityTaskHeartbeatRequest struct
+// An error is returned if we were unable to build a GetRunningWorkflowCountsResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2175,29 +2214,27 @@ func _RecordActivityTaskHeartbeatRequest_Read(w wire.Value) (*shared.RecordActiv
 //     return nil, err
 //   }
 //
-//   var v RecordActivityTaskHeartbeatRequest
+//   var v GetRunningWorkflowCountsResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
+func (v *GetRunningWorkflowCountsResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+			if field.Value.Type() == wire.TMap {
+				v.Counts, err = _Map_String_I64_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.HeartbeatRequest, err = _RecordActivityTaskHeartbeatRequest_Read(field.Value)
+			if field.Value.Type() == wire.TList {
+				v.ShardIDs, err = _List_I32_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -2209,102 +2246,128 @@ func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordActivityTaskHeartbeatRequest
+// String returns a readable string representation of a GetRunningWorkflowCountsResponse
 // struct.
-func (v *RecordActivityTaskHeartbeatRequest) String() string {
+func (v *GetRunningWorkflowCountsResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.Counts != nil {
+		fields[i] = fmt.Sprintf("Counts: %v", v.Counts)
 		i++
 	}
-	if v.HeartbeatRequest != nil {
-		fields[i] = fmt.Sprintf("HeartbeatRequest: %v", v.HeartbeatRequest)
+	if v.ShardIDs != nil {
+		fields[i] = fmt.Sprintf("ShardIDs: %v", v.ShardIDs)
 		i++
 	}
 
-	return fmt.Sprintf("RecordActivityTaskHeartbeatRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetRunningWorkflowCountsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordActivityTaskHeartbeatRequest match the
-// provided RecordActivityTaskHeartbeatRequest.
+func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this GetRunningWorkflowCountsResponse match the
+// provided GetRunningWorkflowCountsResponse.
 //
 // This function performs a deep comparison.
-func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeartbeatRequest) bool {
+func (v *GetRunningWorkflowCountsResponse) Equals(rhs *GetRunningWorkflowCountsResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+	if !((v.Counts == nil && rhs.Counts == nil) || (v.Counts != nil && rhs.Counts != nil && _Map_String_I64_Equals(v.Counts, rhs.Counts))) {
 		return false
 	}
-	if !((v.HeartbeatRequest == nil && rhs.HeartbeatRequest == nil) || (v.HeartbeatRequest != nil && rhs.HeartbeatRequest != nil && v.HeartbeatRequest.Equals(rhs.HeartbeatRequest))) {
+	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
 		return false
 	}
 
 	return true
 }
 
+type _Map_String_I64_Zapper map[string]int64
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordActivityTaskHeartbeatRequest.
-func (v *RecordActivityTaskHeartbeatRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
+// fast logging of _Map_String_I64_Zapper.
+func (m _Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt64((string)(k), v)
 	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetRunningWorkflowCountsResponse.
+func (v *GetRunningWorkflowCountsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-	if v.HeartbeatRequest != nil {
-		err = multierr.Append(err, enc.AddObject("heartbeatRequest", v.HeartbeatRequest))
+	if v.Counts != nil {
+		err = multierr.Append(err, enc.AddObject("counts", (_Map_String_I64_Zapper)(v.Counts)))
+	}
+	if v.ShardIDs != nil {
+		err = multierr.Append(err, enc.AddArray("shardIDs", (_List_I32_Zapper)(v.ShardIDs)))
 	}
 	return err
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetCounts returns the value of Counts if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *GetRunningWorkflowCountsResponse) GetCounts() (o map[string]int64) {
+	if v != nil && v.Counts != nil {
+		return v.Counts
 	}
 
 	return
 }
 
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RecordActivityTaskHeartbeatRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
+// IsSetCounts returns true if Counts is not nil.
+func (v *GetRunningWorkflowCountsResponse) IsSetCounts() bool {
+	return v != nil && v.Counts != nil
 }
 
-// GetHeartbeatRequest returns the value of HeartbeatRequest if it is set or its
+// GetShardIDs returns the value of ShardIDs if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatRequest) GetHeartbeatRequest() (o *shared.RecordActivityTaskHeartbeatRequest) {
-	if v != nil && v.HeartbeatRequest != nil {
-		return v.HeartbeatRequest
+func (v *GetRunningWorkflowCountsResponse) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
 	}
 
 	return
 }
 
-// IsSetHeartbeatRequest returns true if HeartbeatRequest is not nil.
-func (v *RecordActivityTaskHeartbeatRequest) IsSetHeartbeatRequest() bool {
-	return v != nil && v.HeartbeatRequest != nil
+// IsSetShardIDs returns true if ShardIDs is not nil.
+func (v *GetRunningWorkflowCountsResponse) IsSetShardIDs() bool {
+	return v != nil && v.ShardIDs != nil
 }
 
-type RecordActivityTaskStartedRequest struct {
-	DomainUUID        *string                            `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
-	ScheduleId        *int64                             `json:"scheduleId,omitempty"`
-	TaskId            *int64                             `json:"taskId,omitempty"`
-	RequestId         *string                            `json:"requestId,omitempty"`
-	PollRequest       *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+type ParentExecutionInfo struct {
+	DomainUUID  *string                   `json:"domainUUID,omitempty"`
+	Domain      *string                   `json:"domain,omitempty"`
+	Execution   *shared.WorkflowExecution `json:"execution,omitempty"`
+	InitiatedId *int64                    `json:"initiatedId,omitempty"`
 }
 
-// ToWire translates a RecordActivityTaskStartedRequest struct into a Thrift-level intermediate
+// ToWire translates a ParentExecutionInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2319,9 +2382,9 @@ type RecordActivityTaskStartedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordActivityTaskStartedRequest) ToWire() (wire.Value, error) {
+func (v *ParentExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2335,61 +2398,39 @@ func (v *RecordActivityTaskStartedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.ScheduleId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.TaskId != nil {
-		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 15, Value: w}
 		i++
 	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 45, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.InitiatedId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
-	var v shared.PollForActivityTaskRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RecordActivityTaskStartedRequest struct from its Thrift-level
+// FromWire deserializes a ParentExecutionInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordActivityTaskStartedRequest struct
+// An error is returned if we were unable to build a ParentExecutionInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2397,12 +2438,12 @@ func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTask
 //     return nil, err
 //   }
 //
-//   var v RecordActivityTaskStartedRequest
+//   var v ParentExecutionInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordActivityTaskStartedRequest) FromWire(w wire.Value) error {
+func (v *ParentExecutionInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -2417,47 +2458,29 @@ func (v *RecordActivityTaskStartedRequest) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduleId = &x
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
+		case 30:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.TaskId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 45:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
+				v.InitiatedId = &x
 				if err != nil {
 					return err
 				}
@@ -2469,48 +2492,40 @@ func (v *RecordActivityTaskStartedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordActivityTaskStartedRequest
+// String returns a readable string representation of a ParentExecutionInfo
 // struct.
-func (v *RecordActivityTaskStartedRequest) String() string {
+func (v *ParentExecutionInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [4]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.ScheduleId != nil {
-		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
-		i++
-	}
-	if v.TaskId != nil {
-		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.InitiatedId != nil {
+		fields[i] = fmt.Sprintf("InitiatedId: %v", *(v.InitiatedId))
 		i++
 	}
 
-	return fmt.Sprintf("RecordActivityTaskStartedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ParentExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordActivityTaskStartedRequest match the
-// provided RecordActivityTaskStartedRequest.
+// Equals returns true if all the fields of this ParentExecutionInfo match the
+// provided ParentExecutionInfo.
 //
 // This function performs a deep comparison.
-func (v *RecordActivityTaskStartedRequest) Equals(rhs *RecordActivityTaskStartedRequest) bool {
+func (v *ParentExecutionInfo) Equals(rhs *ParentExecutionInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2519,19 +2534,13 @@ func (v *RecordActivityTaskStartedRequest) Equals(rhs *RecordActivityTaskStarted
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !_I64_EqualsPtr(v.InitiatedId, rhs.InitiatedId) {
 		return false
 	}
 
@@ -2539,35 +2548,29 @@ func (v *RecordActivityTaskStartedRequest) Equals(rhs *RecordActivityTaskStarted
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordActivityTaskStartedRequest.
-func (v *RecordActivityTaskStartedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ParentExecutionInfo.
+func (v *ParentExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
-	}
-	if v.ScheduleId != nil {
-		enc.AddInt64("scheduleId", *v.ScheduleId)
-	}
-	if v.TaskId != nil {
-		enc.AddInt64("taskId", *v.TaskId)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.RequestId != nil {
-		enc.AddString("requestId", *v.RequestId)
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
 	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.InitiatedId != nil {
+		enc.AddInt64("initiatedId", *v.InitiatedId)
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetDomainUUID() (o string) {
+func (v *ParentExecutionInfo) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -2576,96 +2579,61 @@ func (v *RecordActivityTaskStartedRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RecordActivityTaskStartedRequest) IsSetDomainUUID() bool {
+func (v *ParentExecutionInfo) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+func (v *ParentExecutionInfo) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RecordActivityTaskStartedRequest) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *ParentExecutionInfo) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetScheduleId returns the value of ScheduleId if it is set or its
+// GetExecution returns the value of Execution if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetScheduleId() (o int64) {
-	if v != nil && v.ScheduleId != nil {
-		return *v.ScheduleId
+func (v *ParentExecutionInfo) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
 	}
 
 	return
 }
 
-// IsSetScheduleId returns true if ScheduleId is not nil.
-func (v *RecordActivityTaskStartedRequest) IsSetScheduleId() bool {
-	return v != nil && v.ScheduleId != nil
+// IsSetExecution returns true if Execution is not nil.
+func (v *ParentExecutionInfo) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
 }
 
-// GetTaskId returns the value of TaskId if it is set or its
+// GetInitiatedId returns the value of InitiatedId if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetTaskId() (o int64) {
-	if v != nil && v.TaskId != nil {
-		return *v.TaskId
+func (v *ParentExecutionInfo) GetInitiatedId() (o int64) {
+	if v != nil && v.InitiatedId != nil {
+		return *v.InitiatedId
 	}
 
 	return
 }
 
-// IsSetTaskId returns true if TaskId is not nil.
-func (v *RecordActivityTaskStartedRequest) IsSetTaskId() bool {
-	return v != nil && v.TaskId != nil
+// IsSetInitiatedId returns true if InitiatedId is not nil.
+func (v *ParentExecutionInfo) IsSetInitiatedId() bool {
+	return v != nil && v.InitiatedId != nil
 }
 
-// GetRequestId returns the value of RequestId if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetRequestId() (o string) {
-	if v != nil && v.RequestId != nil {
-		return *v.RequestId
-	}
-
-	return
+type RecordActivityTaskHeartbeatRequest struct {
+	DomainUUID       *string                                    `json:"domainUUID,omitempty"`
+	HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `json:"heartbeatRequest,omitempty"`
 }
 
-// IsSetRequestId returns true if RequestId is not nil.
-func (v *RecordActivityTaskStartedRequest) IsSetRequestId() bool {
-	return v != nil && v.RequestId != nil
-}
-
-// GetPollRequest returns the value of PollRequest if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetPollRequest() (o *shared.PollForActivityTaskRequest) {
-	if v != nil && v.PollRequest != nil {
-		return v.PollRequest
-	}
-
-	return
-}
-
-// IsSetPollRequest returns true if PollRequest is not nil.
-func (v *RecordActivityTaskStartedRequest) IsSetPollRequest() bool {
-	return v != nil && v.PollRequest != nil
-}
-
-type RecordActivityTaskStartedResponse struct {
-	ScheduledEvent                  *shared.HistoryEvent `json:"scheduledEvent,omitempty"`
-	StartedTimestamp                *int64               `json:"startedTimestamp,omitempty"`
-	Attempt                         *int64               `json:"attempt,omitempty"`
-	ScheduledTimestampOfThisAttempt *int64               `json:"scheduledTimestampOfThisAttempt,omitempty"`
-	HeartbeatDetails                []byte               `json:"heartbeatDetails,omitempty"`
-	WorkflowType                    *shared.WorkflowType `json:"workflowType,omitempty"`
-	WorkflowDomain                  *string              `json:"workflowDomain,omitempty"`
-}
-
-// ToWire translates a RecordActivityTaskStartedResponse struct into a Thrift-level intermediate
+// ToWire translates a RecordActivityTaskHeartbeatRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2680,85 +2648,45 @@ type RecordActivityTaskStartedResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordActivityTaskStartedResponse) ToWire() (wire.Value, error) {
+func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ScheduledEvent != nil {
-		w, err = v.ScheduledEvent.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.StartedTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ScheduledTimestampOfThisAttempt != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestampOfThisAttempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.HeartbeatDetails != nil {
-		w, err = wire.NewValueBinary(v.HeartbeatDetails), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowDomain != nil {
-		w, err = wire.NewValueString(*(v.WorkflowDomain)), error(nil)
+	if v.HeartbeatRequest != nil {
+		w, err = v.HeartbeatRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _HistoryEvent_Read(w wire.Value) (*shared.HistoryEvent, error) {
-	var v shared.HistoryEvent
+func _RecordActivityTaskHeartbeatRequest_Read(w wire.Value) (*shared.RecordActivityTaskHeartbeatRequest, error) {
+	var v shared.RecordActivityTaskHeartbeatRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RecordActivityTaskStartedResponse struct from its Thrift-level
+// FromWire deserializes a RecordActivityTaskHeartbeatRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordActivityTaskStartedResponse struct
+// An error is returned if we were unable to build a RecordActivityTaskHeartbeatRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2766,75 +2694,29 @@ func _HistoryEvent_Read(w wire.Value) (*shared.HistoryEvent, error) {
 //     return nil, err
 //   }
 //
-//   var v RecordActivityTaskStartedResponse
+//   var v RecordActivityTaskHeartbeatRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordActivityTaskStartedResponse) FromWire(w wire.Value) error {
+func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.ScheduledEvent, err = _HistoryEvent_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestampOfThisAttempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
+		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.HeartbeatDetails, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 70:
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowDomain = &x
+				v.HeartbeatRequest, err = _RecordActivityTaskHeartbeatRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2846,76 +2728,41 @@ func (v *RecordActivityTaskStartedResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordActivityTaskStartedResponse
+// String returns a readable string representation of a RecordActivityTaskHeartbeatRequest
 // struct.
-func (v *RecordActivityTaskStartedResponse) String() string {
+func (v *RecordActivityTaskHeartbeatRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [2]string
 	i := 0
-	if v.ScheduledEvent != nil {
-		fields[i] = fmt.Sprintf("ScheduledEvent: %v", v.ScheduledEvent)
-		i++
-	}
-	if v.StartedTimestamp != nil {
-		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.ScheduledTimestampOfThisAttempt != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestampOfThisAttempt: %v", *(v.ScheduledTimestampOfThisAttempt))
-		i++
-	}
-	if v.HeartbeatDetails != nil {
-		fields[i] = fmt.Sprintf("HeartbeatDetails: %v", v.HeartbeatDetails)
-		i++
-	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.WorkflowDomain != nil {
-		fields[i] = fmt.Sprintf("WorkflowDomain: %v", *(v.WorkflowDomain))
+	if v.HeartbeatRequest != nil {
+		fields[i] = fmt.Sprintf("HeartbeatRequest: %v", v.HeartbeatRequest)
 		i++
 	}
 
-	return fmt.Sprintf("RecordActivityTaskStartedResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordActivityTaskHeartbeatRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordActivityTaskStartedResponse match the
-// provided RecordActivityTaskStartedResponse.
+// Equals returns true if all the fields of this RecordActivityTaskHeartbeatRequest match the
+// provided RecordActivityTaskHeartbeatRequest.
 //
 // This function performs a deep comparison.
-func (v *RecordActivityTaskStartedResponse) Equals(rhs *RecordActivityTaskStartedResponse) bool {
+func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeartbeatRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.ScheduledEvent == nil && rhs.ScheduledEvent == nil) || (v.ScheduledEvent != nil && rhs.ScheduledEvent != nil && v.ScheduledEvent.Equals(rhs.ScheduledEvent))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledTimestampOfThisAttempt, rhs.ScheduledTimestampOfThisAttempt) {
-		return false
-	}
-	if !((v.HeartbeatDetails == nil && rhs.HeartbeatDetails == nil) || (v.HeartbeatDetails != nil && rhs.HeartbeatDetails != nil && bytes.Equal(v.HeartbeatDetails, rhs.HeartbeatDetails))) {
-		return false
-	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.WorkflowDomain, rhs.WorkflowDomain) {
+	if !((v.HeartbeatRequest == nil && rhs.HeartbeatRequest == nil) || (v.HeartbeatRequest != nil && rhs.HeartbeatRequest != nil && v.HeartbeatRequest.Equals(rhs.HeartbeatRequest))) {
 		return false
 	}
 
@@ -2923,154 +2770,60 @@ func (v *RecordActivityTaskStartedResponse) Equals(rhs *RecordActivityTaskStarte
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordActivityTaskStartedResponse.
-func (v *RecordActivityTaskStartedResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RecordActivityTaskHeartbeatRequest.
+func (v *RecordActivityTaskHeartbeatRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ScheduledEvent != nil {
-		err = multierr.Append(err, enc.AddObject("scheduledEvent", v.ScheduledEvent))
-	}
-	if v.StartedTimestamp != nil {
-		enc.AddInt64("startedTimestamp", *v.StartedTimestamp)
-	}
-	if v.Attempt != nil {
-		enc.AddInt64("attempt", *v.Attempt)
-	}
-	if v.ScheduledTimestampOfThisAttempt != nil {
-		enc.AddInt64("scheduledTimestampOfThisAttempt", *v.ScheduledTimestampOfThisAttempt)
-	}
-	if v.HeartbeatDetails != nil {
-		enc.AddString("heartbeatDetails", base64.StdEncoding.EncodeToString(v.HeartbeatDetails))
-	}
-	if v.WorkflowType != nil {
-		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.WorkflowDomain != nil {
-		enc.AddString("workflowDomain", *v.WorkflowDomain)
+	if v.HeartbeatRequest != nil {
+		err = multierr.Append(err, enc.AddObject("heartbeatRequest", v.HeartbeatRequest))
 	}
 	return err
 }
 
-// GetScheduledEvent returns the value of ScheduledEvent if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetScheduledEvent() (o *shared.HistoryEvent) {
-	if v != nil && v.ScheduledEvent != nil {
-		return v.ScheduledEvent
+func (v *RecordActivityTaskHeartbeatRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// IsSetScheduledEvent returns true if ScheduledEvent is not nil.
-func (v *RecordActivityTaskStartedResponse) IsSetScheduledEvent() bool {
-	return v != nil && v.ScheduledEvent != nil
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *RecordActivityTaskHeartbeatRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
 }
 
-// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetStartedTimestamp() (o int64) {
-	if v != nil && v.StartedTimestamp != nil {
-		return *v.StartedTimestamp
-	}
-
-	return
-}
-
-// IsSetStartedTimestamp returns true if StartedTimestamp is not nil.
-func (v *RecordActivityTaskStartedResponse) IsSetStartedTimestamp() bool {
-	return v != nil && v.StartedTimestamp != nil
-}
-
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetAttempt() (o int64) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
-	}
-
-	return
-}
-
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *RecordActivityTaskStartedResponse) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
-}
-
-// GetScheduledTimestampOfThisAttempt returns the value of ScheduledTimestampOfThisAttempt if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetScheduledTimestampOfThisAttempt() (o int64) {
-	if v != nil && v.ScheduledTimestampOfThisAttempt != nil {
-		return *v.ScheduledTimestampOfThisAttempt
-	}
-
-	return
-}
-
-// IsSetScheduledTimestampOfThisAttempt returns true if ScheduledTimestampOfThisAttempt is not nil.
-func (v *RecordActivityTaskStartedResponse) IsSetScheduledTimestampOfThisAttempt() bool {
-	return v != nil && v.ScheduledTimestampOfThisAttempt != nil
-}
-
-// GetHeartbeatDetails returns the value of HeartbeatDetails if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetHeartbeatDetails() (o []byte) {
-	if v != nil && v.HeartbeatDetails != nil {
-		return v.HeartbeatDetails
-	}
-
-	return
-}
-
-// IsSetHeartbeatDetails returns true if HeartbeatDetails is not nil.
-func (v *RecordActivityTaskStartedResponse) IsSetHeartbeatDetails() bool {
-	return v != nil && v.HeartbeatDetails != nil
-}
-
-// GetWorkflowType returns the value of WorkflowType if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetWorkflowType() (o *shared.WorkflowType) {
-	if v != nil && v.WorkflowType != nil {
-		return v.WorkflowType
-	}
-
-	return
-}
-
-// IsSetWorkflowType returns true if WorkflowType is not nil.
-func (v *RecordActivityTaskStartedResponse) IsSetWorkflowType() bool {
-	return v != nil && v.WorkflowType != nil
-}
-
-// GetWorkflowDomain returns the value of WorkflowDomain if it is set or its
+// GetHeartbeatRequest returns the value of HeartbeatRequest if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetWorkflowDomain() (o string) {
-	if v != nil && v.WorkflowDomain != nil {
-		return *v.WorkflowDomain
+func (v *RecordActivityTaskHeartbeatRequest) GetHeartbeatRequest() (o *shared.RecordActivityTaskHeartbeatRequest) {
+	if v != nil && v.HeartbeatRequest != nil {
+		return v.HeartbeatRequest
 	}
 
 	return
 }
 
-// IsSetWorkflowDomain returns true if WorkflowDomain is not nil.
-func (v *RecordActivityTaskStartedResponse) IsSetWorkflowDomain() bool {
-	return v != nil && v.WorkflowDomain != nil
+// IsSetHeartbeatRequest returns true if HeartbeatRequest is not nil.
+func (v *RecordActivityTaskHeartbeatRequest) IsSetHeartbeatRequest() bool {
+	return v != nil && v.HeartbeatRequest != nil
 }
 
-// RecordChildExecutionCompletedRequest is used for reporting the completion of child execution to parent workflow
-// execution which started it.  When a child execution is completed it creates this request and calls the
-// RecordChildExecutionCompleted API with the workflowExecution of parent.  It also sets the completedExecution of the
-// child as it could potentially be different than the ChildExecutionStartedEvent of parent in the situation when
-// child creates multiple runs through ContinueAsNew before finally completing.
-type RecordChildExecutionCompletedRequest struct {
-	DomainUUID         *string                   `json:"domainUUID,omitempty"`
-	WorkflowExecution  *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
-	InitiatedId        *int64                    `json:"initiatedId,omitempty"`
-	CompletedExecution *shared.WorkflowExecution `json:"completedExecution,omitempty"`
-	CompletionEvent    *shared.HistoryEvent      `json:"completionEvent,omitempty"`
+type RecordActivityTaskStartedRequest struct {
+	DomainUUID        *string                            `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
+	ScheduleId        *int64                             `json:"scheduleId,omitempty"`
+	TaskId            *int64                             `json:"taskId,omitempty"`
+	RequestId         *string                            `json:"requestId,omitempty"`
+	PollRequest       *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
 }
 
-// ToWire translates a RecordChildExecutionCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a RecordActivityTaskStartedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3085,9 +2838,9 @@ type RecordChildExecutionCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordChildExecutionCompletedRequest) ToWire() (wire.Value, error) {
+func (v *RecordActivityTaskStartedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3109,24 +2862,32 @@ func (v *RecordChildExecutionCompletedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.InitiatedId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedId)), error(nil)
+	if v.ScheduleId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.CompletedExecution != nil {
-		w, err = v.CompletedExecution.ToWire()
+	if v.TaskId != nil {
+		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.CompletionEvent != nil {
-		w, err = v.CompletionEvent.ToWire()
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 45, Value: w}
+		i++
+	}
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3137,11 +2898,17 @@ func (v *RecordChildExecutionCompletedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RecordChildExecutionCompletedRequest struct from its Thrift-level
+func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
+	var v shared.PollForActivityTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RecordActivityTaskStartedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordChildExecutionCompletedRequest struct
+// An error is returned if we were unable to build a RecordActivityTaskStartedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3149,12 +2916,12 @@ func (v *RecordChildExecutionCompletedRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RecordChildExecutionCompletedRequest
+//   var v RecordActivityTaskStartedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordChildExecutionCompletedRequest) FromWire(w wire.Value) error {
+func (v *RecordActivityTaskStartedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -3181,15 +2948,27 @@ func (v *RecordChildExecutionCompletedRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedId = &x
+				v.ScheduleId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.CompletedExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 45:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
 				if err != nil {
 					return err
 				}
@@ -3197,7 +2976,7 @@ func (v *RecordChildExecutionCompletedRequest) FromWire(w wire.Value) error {
 			}
 		case 50:
 			if field.Value.Type() == wire.TStruct {
-				v.CompletionEvent, err = _HistoryEvent_Read(field.Value)
+				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3209,14 +2988,14 @@ func (v *RecordChildExecutionCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordChildExecutionCompletedRequest
+// String returns a readable string representation of a RecordActivityTaskStartedRequest
 // struct.
-func (v *RecordChildExecutionCompletedRequest) String() string {
+func (v *RecordActivityTaskStartedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -3226,27 +3005,31 @@ func (v *RecordChildExecutionCompletedRequest) String() string {
 		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.InitiatedId != nil {
-		fields[i] = fmt.Sprintf("InitiatedId: %v", *(v.InitiatedId))
+	if v.ScheduleId != nil {
+		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
 		i++
 	}
-	if v.CompletedExecution != nil {
-		fields[i] = fmt.Sprintf("CompletedExecution: %v", v.CompletedExecution)
+	if v.TaskId != nil {
+		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
 		i++
 	}
-	if v.CompletionEvent != nil {
-		fields[i] = fmt.Sprintf("CompletionEvent: %v", v.CompletionEvent)
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
 		i++
 	}
 
-	return fmt.Sprintf("RecordChildExecutionCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordActivityTaskStartedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordChildExecutionCompletedRequest match the
-// provided RecordChildExecutionCompletedRequest.
+// Equals returns true if all the fields of this RecordActivityTaskStartedRequest match the
+// provided RecordActivityTaskStartedRequest.
 //
 // This function performs a deep comparison.
-func (v *RecordChildExecutionCompletedRequest) Equals(rhs *RecordChildExecutionCompletedRequest) bool {
+func (v *RecordActivityTaskStartedRequest) Equals(rhs *RecordActivityTaskStartedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -3258,13 +3041,16 @@ func (v *RecordChildExecutionCompletedRequest) Equals(rhs *RecordChildExecutionC
 	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.InitiatedId, rhs.InitiatedId) {
+	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
 		return false
 	}
-	if !((v.CompletedExecution == nil && rhs.CompletedExecution == nil) || (v.CompletedExecution != nil && rhs.CompletedExecution != nil && v.CompletedExecution.Equals(rhs.CompletedExecution))) {
+	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
 		return false
 	}
-	if !((v.CompletionEvent == nil && rhs.CompletionEvent == nil) || (v.CompletionEvent != nil && rhs.CompletionEvent != nil && v.CompletionEvent.Equals(rhs.CompletionEvent))) {
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
 		return false
 	}
 
@@ -3272,8 +3058,8 @@ func (v *RecordChildExecutionCompletedRequest) Equals(rhs *RecordChildExecutionC
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordChildExecutionCompletedRequest.
-func (v *RecordChildExecutionCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RecordActivityTaskStartedRequest.
+func (v *RecordActivityTaskStartedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -3283,21 +3069,24 @@ func (v *RecordChildExecutionCompletedRequest) MarshalLogObject(enc zapcore.Obje
 	if v.WorkflowExecution != nil {
 		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
-	if v.InitiatedId != nil {
-		enc.AddInt64("initiatedId", *v.InitiatedId)
+	if v.ScheduleId != nil {
+		enc.AddInt64("scheduleId", *v.ScheduleId)
 	}
-	if v.CompletedExecution != nil {
-		err = multierr.Append(err, enc.AddObject("completedExecution", v.CompletedExecution))
+	if v.TaskId != nil {
+		enc.AddInt64("taskId", *v.TaskId)
 	}
-	if v.CompletionEvent != nil {
-		err = multierr.Append(err, enc.AddObject("completionEvent", v.CompletionEvent))
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RecordChildExecutionCompletedRequest) GetDomainUUID() (o string) {
+func (v *RecordActivityTaskStartedRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -3306,13 +3095,13 @@ func (v *RecordChildExecutionCompletedRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RecordChildExecutionCompletedRequest) IsSetDomainUUID() bool {
+func (v *RecordActivityTaskStartedRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
 // GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *RecordChildExecutionCompletedRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+func (v *RecordActivityTaskStartedRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
 	if v != nil && v.WorkflowExecution != nil {
 		return v.WorkflowExecution
 	}
@@ -3321,65 +3110,81 @@ func (v *RecordChildExecutionCompletedRequest) GetWorkflowExecution() (o *shared
 }
 
 // IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RecordChildExecutionCompletedRequest) IsSetWorkflowExecution() bool {
+func (v *RecordActivityTaskStartedRequest) IsSetWorkflowExecution() bool {
 	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetInitiatedId returns the value of InitiatedId if it is set or its
+// GetScheduleId returns the value of ScheduleId if it is set or its
 // zero value if it is unset.
-func (v *RecordChildExecutionCompletedRequest) GetInitiatedId() (o int64) {
-	if v != nil && v.InitiatedId != nil {
-		return *v.InitiatedId
+func (v *RecordActivityTaskStartedRequest) GetScheduleId() (o int64) {
+	if v != nil && v.ScheduleId != nil {
+		return *v.ScheduleId
 	}
 
 	return
 }
 
-// IsSetInitiatedId returns true if InitiatedId is not nil.
-func (v *RecordChildExecutionCompletedRequest) IsSetInitiatedId() bool {
-	return v != nil && v.InitiatedId != nil
+// IsSetScheduleId returns true if ScheduleId is not nil.
+func (v *RecordActivityTaskStartedRequest) IsSetScheduleId() bool {
+	return v != nil && v.ScheduleId != nil
 }
 
-// GetCompletedExecution returns the value of CompletedExecution if it is set or its
+// GetTaskId returns the value of TaskId if it is set or its
 // zero value if it is unset.
-func (v *RecordChildExecutionCompletedRequest) GetCompletedExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.CompletedExecution != nil {
-		return v.CompletedExecution
+func (v *RecordActivityTaskStartedRequest) GetTaskId() (o int64) {
+	if v != nil && v.TaskId != nil {
+		return *v.TaskId
 	}
 
 	return
 }
 
-// IsSetCompletedExecution returns true if CompletedExecution is not nil.
-func (v *RecordChildExecutionCompletedRequest) IsSetCompletedExecution() bool {
-	return v != nil && v.CompletedExecution != nil
+// IsSetTaskId returns true if TaskId is not nil.
+func (v *RecordActivityTaskStartedRequest) IsSetTaskId() bool {
+	return v != nil && v.TaskId != nil
 }
 
-// GetCompletionEvent returns the value of CompletionEvent if it is set or its
+// GetRequestId returns the value of RequestId if it is set or its
 // zero value if it is unset.
-func (v *RecordChildExecutionCompletedRequest) GetCompletionEvent() (o *shared.HistoryEvent) {
-	if v != nil && v.CompletionEvent != nil {
-		return v.CompletionEvent
+func (v *RecordActivityTaskStartedRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
 	}
 
 	return
 }
 
-// IsSetCompletionEvent returns true if CompletionEvent is not nil.
-func (v *RecordChildExecutionCompletedRequest) IsSetCompletionEvent() bool {
-	return v != nil && v.CompletionEvent != nil
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *RecordActivityTaskStartedRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
 }
 
-type RecordDecisionTaskStartedRequest struct {
-	DomainUUID        *string                            `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
-	ScheduleId        *int64                             `json:"scheduleId,omitempty"`
-	TaskId            *int64                             `json:"taskId,omitempty"`
-	RequestId         *string                            `json:"requestId,omitempty"`
-	PollRequest       *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+// GetPollRequest returns the value of PollRequest if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskStartedRequest) GetPollRequest() (o *shared.PollForActivityTaskRequest) {
+	if v != nil && v.PollRequest != nil {
+		return v.PollRequest
+	}
+
+	return
 }
 
-// ToWire translates a RecordDecisionTaskStartedRequest struct into a Thrift-level intermediate
+// IsSetPollRequest returns true if PollRequest is not nil.
+func (v *RecordActivityTaskStartedRequest) IsSetPollRequest() bool {
+	return v != nil && v.PollRequest != nil
+}
+
+type RecordActivityTaskStartedResponse struct {
+	ScheduledEvent                  *shared.HistoryEvent `json:"scheduledEvent,omitempty"`
+	StartedTimestamp                *int64               `json:"startedTimestamp,omitempty"`
+	Attempt                         *int64               `json:"attempt,omitempty"`
+	ScheduledTimestampOfThisAttempt *int64               `json:"scheduledTimestampOfThisAttempt,omitempty"`
+	HeartbeatDetails                []byte               `json:"heartbeatDetails,omitempty"`
+	WorkflowType                    *shared.WorkflowType `json:"workflowType,omitempty"`
+	WorkflowDomain                  *string              `json:"workflowDomain,omitempty"`
+}
+
+// ToWire translates a RecordActivityTaskStartedResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3394,77 +3199,85 @@ type RecordDecisionTaskStartedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordDecisionTaskStartedRequest) ToWire() (wire.Value, error) {
+func (v *RecordActivityTaskStartedResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.ScheduledEvent != nil {
+		w, err = v.ScheduledEvent.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.StartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ScheduleId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.TaskId != nil {
-		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+	if v.ScheduledTimestampOfThisAttempt != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestampOfThisAttempt)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+	if v.HeartbeatDetails != nil {
+		w, err = wire.NewValueBinary(v.HeartbeatDetails), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 45, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.WorkflowDomain != nil {
+		w, err = wire.NewValueString(*(v.WorkflowDomain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
-	var v shared.PollForDecisionTaskRequest
+func _HistoryEvent_Read(w wire.Value) (*shared.HistoryEvent, error) {
+	var v shared.HistoryEvent
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RecordDecisionTaskStartedRequest struct from its Thrift-level
+// FromWire deserializes a RecordActivityTaskStartedResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordDecisionTaskStartedRequest struct
+// An error is returned if we were unable to build a RecordActivityTaskStartedResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3472,67 +3285,75 @@ func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTask
 //     return nil, err
 //   }
 //
-//   var v RecordDecisionTaskStartedRequest
+//   var v RecordActivityTaskStartedResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordDecisionTaskStartedRequest) FromWire(w wire.Value) error {
+func (v *RecordActivityTaskStartedResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.ScheduledEvent, err = _HistoryEvent_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTimestamp = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
+		case 40:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduleId = &x
+				v.Attempt = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
+		case 50:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.TaskId = &x
+				v.ScheduledTimestampOfThisAttempt = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 45:
+		case 60:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
+				v.HeartbeatDetails, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
+		case 70:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowDomain = &x
 				if err != nil {
 					return err
 				}
@@ -3544,69 +3365,76 @@ func (v *RecordDecisionTaskStartedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordDecisionTaskStartedRequest
+// String returns a readable string representation of a RecordActivityTaskStartedResponse
 // struct.
-func (v *RecordDecisionTaskStartedRequest) String() string {
+func (v *RecordActivityTaskStartedResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.ScheduledEvent != nil {
+		fields[i] = fmt.Sprintf("ScheduledEvent: %v", v.ScheduledEvent)
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.StartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
 		i++
 	}
-	if v.ScheduleId != nil {
-		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
 		i++
 	}
-	if v.TaskId != nil {
-		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+	if v.ScheduledTimestampOfThisAttempt != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestampOfThisAttempt: %v", *(v.ScheduledTimestampOfThisAttempt))
 		i++
 	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.HeartbeatDetails != nil {
+		fields[i] = fmt.Sprintf("HeartbeatDetails: %v", v.HeartbeatDetails)
 		i++
 	}
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+		i++
+	}
+	if v.WorkflowDomain != nil {
+		fields[i] = fmt.Sprintf("WorkflowDomain: %v", *(v.WorkflowDomain))
 		i++
 	}
 
-	return fmt.Sprintf("RecordDecisionTaskStartedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordActivityTaskStartedResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordDecisionTaskStartedRequest match the
-// provided RecordDecisionTaskStartedRequest.
+// Equals returns true if all the fields of this RecordActivityTaskStartedResponse match the
+// provided RecordActivityTaskStartedResponse.
 //
 // This function performs a deep comparison.
-func (v *RecordDecisionTaskStartedRequest) Equals(rhs *RecordDecisionTaskStartedRequest) bool {
+func (v *RecordActivityTaskStartedResponse) Equals(rhs *RecordActivityTaskStartedResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+	if !((v.ScheduledEvent == nil && rhs.ScheduledEvent == nil) || (v.ScheduledEvent != nil && rhs.ScheduledEvent != nil && v.ScheduledEvent.Equals(rhs.ScheduledEvent))) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+	if !_I64_EqualsPtr(v.ScheduledTimestampOfThisAttempt, rhs.ScheduledTimestampOfThisAttempt) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+	if !((v.HeartbeatDetails == nil && rhs.HeartbeatDetails == nil) || (v.HeartbeatDetails != nil && rhs.HeartbeatDetails != nil && bytes.Equal(v.HeartbeatDetails, rhs.HeartbeatDetails))) {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowDomain, rhs.WorkflowDomain) {
 		return false
 	}
 
@@ -3614,137 +3442,154 @@ func (v *RecordDecisionTaskStartedRequest) Equals(rhs *RecordDecisionTaskStarted
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordDecisionTaskStartedRequest.
-func (v *RecordDecisionTaskStartedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RecordActivityTaskStartedResponse.
+func (v *RecordActivityTaskStartedResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
+	if v.ScheduledEvent != nil {
+		err = multierr.Append(err, enc.AddObject("scheduledEvent", v.ScheduledEvent))
 	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	if v.StartedTimestamp != nil {
+		enc.AddInt64("startedTimestamp", *v.StartedTimestamp)
 	}
-	if v.ScheduleId != nil {
-		enc.AddInt64("scheduleId", *v.ScheduleId)
+	if v.Attempt != nil {
+		enc.AddInt64("attempt", *v.Attempt)
 	}
-	if v.TaskId != nil {
-		enc.AddInt64("taskId", *v.TaskId)
+	if v.ScheduledTimestampOfThisAttempt != nil {
+		enc.AddInt64("scheduledTimestampOfThisAttempt", *v.ScheduledTimestampOfThisAttempt)
 	}
-	if v.RequestId != nil {
-		enc.AddString("requestId", *v.RequestId)
+	if v.HeartbeatDetails != nil {
+		enc.AddString("heartbeatDetails", base64.StdEncoding.EncodeToString(v.HeartbeatDetails))
 	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.WorkflowType != nil {
+		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	}
+	if v.WorkflowDomain != nil {
+		enc.AddString("workflowDomain", *v.WorkflowDomain)
 	}
 	return err
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetScheduledEvent returns the value of ScheduledEvent if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *RecordActivityTaskStartedResponse) GetScheduledEvent() (o *shared.HistoryEvent) {
+	if v != nil && v.ScheduledEvent != nil {
+		return v.ScheduledEvent
 	}
 
 	return
 }
 
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RecordDecisionTaskStartedRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
-}
-
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// IsSetScheduledEvent returns true if ScheduledEvent is not nil.
+func (v *RecordActivityTaskStartedResponse) IsSetScheduledEvent() bool {
+	return v != nil && v.ScheduledEvent != nil
+}
+
+// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+func (v *RecordActivityTaskStartedResponse) GetStartedTimestamp() (o int64) {
+	if v != nil && v.StartedTimestamp != nil {
+		return *v.StartedTimestamp
 	}
 
 	return
 }
 
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RecordDecisionTaskStartedRequest) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
+// IsSetStartedTimestamp returns true if StartedTimestamp is not nil.
+func (v *RecordActivityTaskStartedResponse) IsSetStartedTimestamp() bool {
+	return v != nil && v.StartedTimestamp != nil
 }
 
-// GetScheduleId returns the value of ScheduleId if it is set or its
+// GetAttempt returns the value of Attempt if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetScheduleId() (o int64) {
-	if v != nil && v.ScheduleId != nil {
-		return *v.ScheduleId
+func (v *RecordActivityTaskStartedResponse) GetAttempt() (o int64) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
 	}
 
 	return
 }
 
-// IsSetScheduleId returns true if ScheduleId is not nil.
-func (v *RecordDecisionTaskStartedRequest) IsSetScheduleId() bool {
-	return v != nil && v.ScheduleId != nil
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *RecordActivityTaskStartedResponse) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
 }
 
-// GetTaskId returns the value of TaskId if it is set or its
+// GetScheduledTimestampOfThisAttempt returns the value of ScheduledTimestampOfThisAttempt if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetTaskId() (o int64) {
-	if v != nil && v.TaskId != nil {
-		return *v.TaskId
+func (v *RecordActivityTaskStartedResponse) GetScheduledTimestampOfThisAttempt() (o int64) {
+	if v != nil && v.ScheduledTimestampOfThisAttempt != nil {
+		return *v.ScheduledTimestampOfThisAttempt
 	}
 
 	return
 }
 
-// IsSetTaskId returns true if TaskId is not nil.
-func (v *RecordDecisionTaskStartedRequest) IsSetTaskId() bool {
-	return v != nil && v.TaskId != nil
+// IsSetScheduledTimestampOfThisAttempt returns true if ScheduledTimestampOfThisAttempt is not nil.
+func (v *RecordActivityTaskStartedResponse) IsSetScheduledTimestampOfThisAttempt() bool {
+	return v != nil && v.ScheduledTimestampOfThisAttempt != nil
 }
 
-// GetRequestId returns the value of RequestId if it is set or its
+// GetHeartbeatDetails returns the value of HeartbeatDetails if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetRequestId() (o string) {
-	if v != nil && v.RequestId != nil {
-		return *v.RequestId
+func (v *RecordActivityTaskStartedResponse) GetHeartbeatDetails() (o []byte) {
+	if v != nil && v.HeartbeatDetails != nil {
+		return v.HeartbeatDetails
 	}
 
 	return
 }
 
-// IsSetRequestId returns true if RequestId is not nil.
-func (v *RecordDecisionTaskStartedRequest) IsSetRequestId() bool {
-	return v != nil && v.RequestId != nil
+// IsSetHeartbeatDetails returns true if HeartbeatDetails is not nil.
+func (v *RecordActivityTaskStartedResponse) IsSetHeartbeatDetails() bool {
+	return v != nil && v.HeartbeatDetails != nil
 }
 
-// GetPollRequest returns the value of PollRequest if it is set or its
+// GetWorkflowType returns the value of WorkflowType if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetPollRequest() (o *shared.PollForDecisionTaskRequest) {
-	if v != nil && v.PollRequest != nil {
-		return v.PollRequest
+func (v *RecordActivityTaskStartedResponse) GetWorkflowType() (o *shared.WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
 	}
 
 	return
 }
 
-// IsSetPollRequest returns true if PollRequest is not nil.
-func (v *RecordDecisionTaskStartedRequest) IsSetPollRequest() bool {
-	return v != nil && v.PollRequest != nil
+// IsSetWorkflowType returns true if WorkflowType is not nil.
+func (v *RecordActivityTaskStartedResponse) IsSetWorkflowType() bool {
+	return v != nil && v.WorkflowType != nil
 }
 
-type RecordDecisionTaskStartedResponse struct {
-	WorkflowType              *shared.WorkflowType          `json:"workflowType,omitempty"`
-	PreviousStartedEventId    *int64                        `json:"previousStartedEventId,omitempty"`
-	ScheduledEventId          *int64                        `json:"scheduledEventId,omitempty"`
-	StartedEventId            *int64                        `json:"startedEventId,omitempty"`
-	NextEventId               *int64                        `json:"nextEventId,omitempty"`
-	Attempt                   *int64                        `json:"attempt,omitempty"`
-	StickyExecutionEnabled    *bool                         `json:"stickyExecutionEnabled,omitempty"`
-	DecisionInfo              *shared.TransientDecisionInfo `json:"decisionInfo,omitempty"`
-	WorkflowExecutionTaskList *shared.TaskList              `json:"WorkflowExecutionTaskList,omitempty"`
-	EventStoreVersion         *int32                        `json:"eventStoreVersion,omitempty"`
-	BranchToken               []byte                        `json:"branchToken,omitempty"`
+// GetWorkflowDomain returns the value of WorkflowDomain if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskStartedResponse) GetWorkflowDomain() (o string) {
+	if v != nil && v.WorkflowDomain != nil {
+		return *v.WorkflowDomain
+	}
+
+	return
 }
 
-// ToWire translates a RecordDecisionTaskStartedResponse struct into a Thrift-level intermediate
+// IsSetWorkflowDomain returns true if WorkflowDomain is not nil.
+func (v *RecordActivityTaskStartedResponse) IsSetWorkflowDomain() bool {
+	return v != nil && v.WorkflowDomain != nil
+}
+
+// RecordChildExecutionCompletedRequest is used for reporting the completion of child execution to parent workflow
+// execution which started it.  When a child execution is completed it creates this request and calls the
+// RecordChildExecutionCompleted API with the workflowExecution of parent.  It also sets the completedExecution of the
+// child as it could potentially be different than the ChildExecutionStartedEvent of parent in the situation when
+// child creates multiple runs through ContinueAsNew before finally completing.
+type RecordChildExecutionCompletedRequest struct {
+	DomainUUID         *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution  *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+	InitiatedId        *int64                    `json:"initiatedId,omitempty"`
+	CompletedExecution *shared.WorkflowExecution `json:"completedExecution,omitempty"`
+	CompletionEvent    *shared.HistoryEvent      `json:"completionEvent,omitempty"`
+}
+
+// ToWire translates a RecordChildExecutionCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3759,117 +3604,63 @@ type RecordDecisionTaskStartedResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordDecisionTaskStartedResponse) ToWire() (wire.Value, error) {
+func (v *RecordChildExecutionCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.PreviousStartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ScheduledEventId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
+	if v.InitiatedId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+	if v.CompletedExecution != nil {
+		w, err = v.CompletedExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+	if v.CompletionEvent != nil {
+		w, err = v.CompletionEvent.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.StickyExecutionEnabled != nil {
-		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.DecisionInfo != nil {
-		w, err = v.DecisionInfo.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.WorkflowExecutionTaskList != nil {
-		w, err = v.WorkflowExecutionTaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.BranchToken != nil {
-		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
-	var v shared.TransientDecisionInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RecordDecisionTaskStartedResponse struct from its Thrift-level
+// FromWire deserializes a RecordChildExecutionCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordDecisionTaskStartedResponse struct
+// An error is returned if we were unable to build a RecordChildExecutionCompletedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3877,29 +3668,29 @@ func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, e
 //     return nil, err
 //   }
 //
-//   var v RecordDecisionTaskStartedResponse
+//   var v RecordChildExecutionCompletedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordDecisionTaskStartedResponse) FromWire(w wire.Value) error {
+func (v *RecordChildExecutionCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.PreviousStartedEventId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3909,190 +3700,90 @@ func (v *RecordDecisionTaskStartedResponse) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledEventId = &x
+				v.InitiatedId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.CompletedExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.CompletionEvent, err = _HistoryEvent_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 70:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.StickyExecutionEnabled = &x
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
-				if err != nil {
-					return err
-				}
+// String returns a readable string representation of a RecordChildExecutionCompletedRequest
+// struct.
+func (v *RecordChildExecutionCompletedRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
 
-			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TBinary {
-				v.BranchToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a RecordDecisionTaskStartedResponse
-// struct.
-func (v *RecordDecisionTaskStartedResponse) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [11]string
+	var fields [5]string
 	i := 0
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
-		i++
-	}
-	if v.PreviousStartedEventId != nil {
-		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
-		i++
-	}
-	if v.ScheduledEventId != nil {
-		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
-		i++
-	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.StickyExecutionEnabled != nil {
-		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.DecisionInfo != nil {
-		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.WorkflowExecutionTaskList != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
+	if v.InitiatedId != nil {
+		fields[i] = fmt.Sprintf("InitiatedId: %v", *(v.InitiatedId))
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+	if v.CompletedExecution != nil {
+		fields[i] = fmt.Sprintf("CompletedExecution: %v", v.CompletedExecution)
 		i++
 	}
-	if v.BranchToken != nil {
-		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
+	if v.CompletionEvent != nil {
+		fields[i] = fmt.Sprintf("CompletionEvent: %v", v.CompletionEvent)
 		i++
 	}
 
-	return fmt.Sprintf("RecordDecisionTaskStartedResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordChildExecutionCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordDecisionTaskStartedResponse match the
-// provided RecordDecisionTaskStartedResponse.
+// Equals returns true if all the fields of this RecordChildExecutionCompletedRequest match the
+// provided RecordChildExecutionCompletedRequest.
 //
 // This function performs a deep comparison.
-func (v *RecordDecisionTaskStartedResponse) Equals(rhs *RecordDecisionTaskStartedResponse) bool {
+func (v *RecordChildExecutionCompletedRequest) Equals(rhs *RecordChildExecutionCompletedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
+	if !_I64_EqualsPtr(v.InitiatedId, rhs.InitiatedId) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+	if !((v.CompletedExecution == nil && rhs.CompletedExecution == nil) || (v.CompletedExecution != nil && rhs.CompletedExecution != nil && v.CompletedExecution.Equals(rhs.CompletedExecution))) {
 		return false
 	}
-	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
+	if !((v.CompletionEvent == nil && rhs.CompletionEvent == nil) || (v.CompletionEvent != nil && rhs.CompletionEvent != nil && v.CompletionEvent.Equals(rhs.CompletionEvent))) {
 		return false
 	}
 
@@ -4100,219 +3791,114 @@ func (v *RecordDecisionTaskStartedResponse) Equals(rhs *RecordDecisionTaskStarte
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordDecisionTaskStartedResponse.
-func (v *RecordDecisionTaskStartedResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RecordChildExecutionCompletedRequest.
+func (v *RecordChildExecutionCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.WorkflowType != nil {
-		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
-	}
-	if v.PreviousStartedEventId != nil {
-		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
-	}
-	if v.ScheduledEventId != nil {
-		enc.AddInt64("scheduledEventId", *v.ScheduledEventId)
-	}
-	if v.StartedEventId != nil {
-		enc.AddInt64("startedEventId", *v.StartedEventId)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
-	}
-	if v.Attempt != nil {
-		enc.AddInt64("attempt", *v.Attempt)
-	}
-	if v.StickyExecutionEnabled != nil {
-		enc.AddBool("stickyExecutionEnabled", *v.StickyExecutionEnabled)
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.DecisionInfo != nil {
-		err = multierr.Append(err, enc.AddObject("decisionInfo", v.DecisionInfo))
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
-	if v.WorkflowExecutionTaskList != nil {
-		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
+	if v.InitiatedId != nil {
+		enc.AddInt64("initiatedId", *v.InitiatedId)
 	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	if v.CompletedExecution != nil {
+		err = multierr.Append(err, enc.AddObject("completedExecution", v.CompletedExecution))
 	}
-	if v.BranchToken != nil {
-		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
+	if v.CompletionEvent != nil {
+		err = multierr.Append(err, enc.AddObject("completionEvent", v.CompletionEvent))
 	}
 	return err
 }
 
-// GetWorkflowType returns the value of WorkflowType if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetWorkflowType() (o *shared.WorkflowType) {
-	if v != nil && v.WorkflowType != nil {
-		return v.WorkflowType
+func (v *RecordChildExecutionCompletedRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// IsSetWorkflowType returns true if WorkflowType is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetWorkflowType() bool {
-	return v != nil && v.WorkflowType != nil
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *RecordChildExecutionCompletedRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
 }
 
-// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetPreviousStartedEventId() (o int64) {
-	if v != nil && v.PreviousStartedEventId != nil {
-		return *v.PreviousStartedEventId
+func (v *RecordChildExecutionCompletedRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetPreviousStartedEventId returns true if PreviousStartedEventId is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetPreviousStartedEventId() bool {
-	return v != nil && v.PreviousStartedEventId != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *RecordChildExecutionCompletedRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
+// GetInitiatedId returns the value of InitiatedId if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetScheduledEventId() (o int64) {
-	if v != nil && v.ScheduledEventId != nil {
-		return *v.ScheduledEventId
+func (v *RecordChildExecutionCompletedRequest) GetInitiatedId() (o int64) {
+	if v != nil && v.InitiatedId != nil {
+		return *v.InitiatedId
 	}
 
 	return
 }
 
-// IsSetScheduledEventId returns true if ScheduledEventId is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetScheduledEventId() bool {
-	return v != nil && v.ScheduledEventId != nil
+// IsSetInitiatedId returns true if InitiatedId is not nil.
+func (v *RecordChildExecutionCompletedRequest) IsSetInitiatedId() bool {
+	return v != nil && v.InitiatedId != nil
 }
 
-// GetStartedEventId returns the value of StartedEventId if it is set or its
+// GetCompletedExecution returns the value of CompletedExecution if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetStartedEventId() (o int64) {
-	if v != nil && v.StartedEventId != nil {
-		return *v.StartedEventId
+func (v *RecordChildExecutionCompletedRequest) GetCompletedExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.CompletedExecution != nil {
+		return v.CompletedExecution
 	}
 
 	return
 }
 
-// IsSetStartedEventId returns true if StartedEventId is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetStartedEventId() bool {
-	return v != nil && v.StartedEventId != nil
+// IsSetCompletedExecution returns true if CompletedExecution is not nil.
+func (v *RecordChildExecutionCompletedRequest) IsSetCompletedExecution() bool {
+	return v != nil && v.CompletedExecution != nil
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
+// GetCompletionEvent returns the value of CompletionEvent if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
+func (v *RecordChildExecutionCompletedRequest) GetCompletionEvent() (o *shared.HistoryEvent) {
+	if v != nil && v.CompletionEvent != nil {
+		return v.CompletionEvent
 	}
 
 	return
 }
 
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
-}
-
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetAttempt() (o int64) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
-	}
-
-	return
+// IsSetCompletionEvent returns true if CompletionEvent is not nil.
+func (v *RecordChildExecutionCompletedRequest) IsSetCompletionEvent() bool {
+	return v != nil && v.CompletionEvent != nil
 }
 
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
+type RecordDecisionTaskStartedRequest struct {
+	DomainUUID        *string                            `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
+	ScheduleId        *int64                             `json:"scheduleId,omitempty"`
+	TaskId            *int64                             `json:"taskId,omitempty"`
+	RequestId         *string                            `json:"requestId,omitempty"`
+	PollRequest       *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
 }
 
-// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetStickyExecutionEnabled() (o bool) {
-	if v != nil && v.StickyExecutionEnabled != nil {
-		return *v.StickyExecutionEnabled
-	}
-
-	return
-}
-
-// IsSetStickyExecutionEnabled returns true if StickyExecutionEnabled is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetStickyExecutionEnabled() bool {
-	return v != nil && v.StickyExecutionEnabled != nil
-}
-
-// GetDecisionInfo returns the value of DecisionInfo if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetDecisionInfo() (o *shared.TransientDecisionInfo) {
-	if v != nil && v.DecisionInfo != nil {
-		return v.DecisionInfo
-	}
-
-	return
-}
-
-// IsSetDecisionInfo returns true if DecisionInfo is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetDecisionInfo() bool {
-	return v != nil && v.DecisionInfo != nil
-}
-
-// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetWorkflowExecutionTaskList() (o *shared.TaskList) {
-	if v != nil && v.WorkflowExecutionTaskList != nil {
-		return v.WorkflowExecutionTaskList
-	}
-
-	return
-}
-
-// IsSetWorkflowExecutionTaskList returns true if WorkflowExecutionTaskList is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetWorkflowExecutionTaskList() bool {
-	return v != nil && v.WorkflowExecutionTaskList != nil
-}
-
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetEventStoreVersion() (o int32) {
-	if v != nil && v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
-	}
-
-	return
-}
-
-// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetEventStoreVersion() bool {
-	return v != nil && v.EventStoreVersion != nil
-}
-
-// GetBranchToken returns the value of BranchToken if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetBranchToken() (o []byte) {
-	if v != nil && v.BranchToken != nil {
-		return v.BranchToken
-	}
-
-	return
-}
-
-// IsSetBranchToken returns true if BranchToken is not nil.
-func (v *RecordDecisionTaskStartedResponse) IsSetBranchToken() bool {
-	return v != nil && v.BranchToken != nil
-}
-
-type RemoveSignalMutableStateRequest struct {
-	DomainUUID        *string                   `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
-	RequestId         *string                   `json:"requestId,omitempty"`
-}
-
-// ToWire translates a RemoveSignalMutableStateRequest struct into a Thrift-level intermediate
+// ToWire translates a RecordDecisionTaskStartedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4327,9 +3913,9 @@ type RemoveSignalMutableStateRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RemoveSignalMutableStateRequest) ToWire() (wire.Value, error) {
+func (v *RecordDecisionTaskStartedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4351,23 +3937,53 @@ func (v *RemoveSignalMutableStateRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ScheduleId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskId != nil {
+		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 	if v.RequestId != nil {
 		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 45, Value: w}
+		i++
+	}
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RemoveSignalMutableStateRequest struct from its Thrift-level
+func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
+	var v shared.PollForDecisionTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RecordDecisionTaskStartedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RemoveSignalMutableStateRequest struct
+// An error is returned if we were unable to build a RecordDecisionTaskStartedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4375,12 +3991,12 @@ func (v *RemoveSignalMutableStateRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RemoveSignalMutableStateRequest
+//   var v RecordDecisionTaskStartedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RemoveSignalMutableStateRequest) FromWire(w wire.Value) error {
+func (v *RecordDecisionTaskStartedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -4404,6 +4020,26 @@ func (v *RemoveSignalMutableStateRequest) FromWire(w wire.Value) error {
 
 			}
 		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduleId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 45:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -4412,6 +4048,14 @@ func (v *RemoveSignalMutableStateRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4419,14 +4063,14 @@ func (v *RemoveSignalMutableStateRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RemoveSignalMutableStateRequest
+// String returns a readable string representation of a RecordDecisionTaskStartedRequest
 // struct.
-func (v *RemoveSignalMutableStateRequest) String() string {
+func (v *RecordDecisionTaskStartedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [6]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -4436,19 +4080,31 @@ func (v *RemoveSignalMutableStateRequest) String() string {
 		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
+	if v.ScheduleId != nil {
+		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+		i++
+	}
+	if v.TaskId != nil {
+		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+		i++
+	}
 	if v.RequestId != nil {
 		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+		i++
+	}
 
-	return fmt.Sprintf("RemoveSignalMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordDecisionTaskStartedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RemoveSignalMutableStateRequest match the
-// provided RemoveSignalMutableStateRequest.
+// Equals returns true if all the fields of this RecordDecisionTaskStartedRequest match the
+// provided RecordDecisionTaskStartedRequest.
 //
 // This function performs a deep comparison.
-func (v *RemoveSignalMutableStateRequest) Equals(rhs *RemoveSignalMutableStateRequest) bool {
+func (v *RecordDecisionTaskStartedRequest) Equals(rhs *RecordDecisionTaskStartedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -4460,16 +4116,25 @@ func (v *RemoveSignalMutableStateRequest) Equals(rhs *RemoveSignalMutableStateRe
 	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+		return false
+	}
 	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RemoveSignalMutableStateRequest.
-func (v *RemoveSignalMutableStateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RecordDecisionTaskStartedRequest.
+func (v *RecordDecisionTaskStartedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -4479,15 +4144,24 @@ func (v *RemoveSignalMutableStateRequest) MarshalLogObject(enc zapcore.ObjectEnc
 	if v.WorkflowExecution != nil {
 		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
+	if v.ScheduleId != nil {
+		enc.AddInt64("scheduleId", *v.ScheduleId)
+	}
+	if v.TaskId != nil {
+		enc.AddInt64("taskId", *v.TaskId)
+	}
 	if v.RequestId != nil {
 		enc.AddString("requestId", *v.RequestId)
 	}
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RemoveSignalMutableStateRequest) GetDomainUUID() (o string) {
+func (v *RecordDecisionTaskStartedRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -4496,13 +4170,13 @@ func (v *RemoveSignalMutableStateRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RemoveSignalMutableStateRequest) IsSetDomainUUID() bool {
+func (v *RecordDecisionTaskStartedRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
 // GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *RemoveSignalMutableStateRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+func (v *RecordDecisionTaskStartedRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
 	if v != nil && v.WorkflowExecution != nil {
 		return v.WorkflowExecution
 	}
@@ -4511,13 +4185,43 @@ func (v *RemoveSignalMutableStateRequest) GetWorkflowExecution() (o *shared.Work
 }
 
 // IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RemoveSignalMutableStateRequest) IsSetWorkflowExecution() bool {
+func (v *RecordDecisionTaskStartedRequest) IsSetWorkflowExecution() bool {
 	return v != nil && v.WorkflowExecution != nil
 }
 
+// GetScheduleId returns the value of ScheduleId if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetScheduleId() (o int64) {
+	if v != nil && v.ScheduleId != nil {
+		return *v.ScheduleId
+	}
+
+	return
+}
+
+// IsSetScheduleId returns true if ScheduleId is not nil.
+func (v *RecordDecisionTaskStartedRequest) IsSetScheduleId() bool {
+	return v != nil && v.ScheduleId != nil
+}
+
+// GetTaskId returns the value of TaskId if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetTaskId() (o int64) {
+	if v != nil && v.TaskId != nil {
+		return *v.TaskId
+	}
+
+	return
+}
+
+// IsSetTaskId returns true if TaskId is not nil.
+func (v *RecordDecisionTaskStartedRequest) IsSetTaskId() bool {
+	return v != nil && v.TaskId != nil
+}
+
 // GetRequestId returns the value of RequestId if it is set or its
 // zero value if it is unset.
-func (v *RemoveSignalMutableStateRequest) GetRequestId() (o string) {
+func (v *RecordDecisionTaskStartedRequest) GetRequestId() (o string) {
 	if v != nil && v.RequestId != nil {
 		return *v.RequestId
 	}
@@ -4526,29 +4230,81 @@ func (v *RemoveSignalMutableStateRequest) GetRequestId() (o string) {
 }
 
 // IsSetRequestId returns true if RequestId is not nil.
-func (v *RemoveSignalMutableStateRequest) IsSetRequestId() bool {
+func (v *RecordDecisionTaskStartedRequest) IsSetRequestId() bool {
 	return v != nil && v.RequestId != nil
 }
 
-type ReplicateEventsRequest struct {
-	SourceCluster           *string                            `json:"sourceCluster,omitempty"`
-	DomainUUID              *string                            `json:"domainUUID,omitempty"`
-	WorkflowExecution       *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
-	FirstEventId            *int64                             `json:"firstEventId,omitempty"`
-	NextEventId             *int64                             `json:"nextEventId,omitempty"`
-	Version                 *int64                             `json:"version,omitempty"`
-	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
-	History                 *shared.History                    `json:"history,omitempty"`
-	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
-	ForceBufferEvents       *bool                              `json:"forceBufferEvents,omitempty"`
-	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
-	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
-	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
-}
-
-// ToWire translates a ReplicateEventsRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+// GetPollRequest returns the value of PollRequest if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetPollRequest() (o *shared.PollForDecisionTaskRequest) {
+	if v != nil && v.PollRequest != nil {
+		return v.PollRequest
+	}
+
+	return
+}
+
+// IsSetPollRequest returns true if PollRequest is not nil.
+func (v *RecordDecisionTaskStartedRequest) IsSetPollRequest() bool {
+	return v != nil && v.PollRequest != nil
+}
+
+type RecordDecisionTaskStartedResponse struct {
+	WorkflowType              *shared.WorkflowType              `json:"workflowType,omitempty"`
+	PreviousStartedEventId    *int64                            `json:"previousStartedEventId,omitempty"`
+	ScheduledEventId          *int64                            `json:"scheduledEventId,omitempty"`
+	StartedEventId            *int64                            `json:"startedEventId,omitempty"`
+	NextEventId               *int64                            `json:"nextEventId,omitempty"`
+	Attempt                   *int64                            `json:"attempt,omitempty"`
+	StickyExecutionEnabled    *bool                             `json:"stickyExecutionEnabled,omitempty"`
+	DecisionInfo              *shared.TransientDecisionInfo     `json:"decisionInfo,omitempty"`
+	WorkflowExecutionTaskList *shared.TaskList                  `json:"WorkflowExecutionTaskList,omitempty"`
+	EventStoreVersion         *int32                            `json:"eventStoreVersion,omitempty"`
+	BranchToken               []byte                            `json:"branchToken,omitempty"`
+	Updates                   map[string]*shared.WorkflowUpdate `json:"updates,omitempty"`
+}
+
+type _Map_String_WorkflowUpdate_MapItemList map[string]*shared.WorkflowUpdate
+
+func (m _Map_String_WorkflowUpdate_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_WorkflowUpdate_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_WorkflowUpdate_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_WorkflowUpdate_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_WorkflowUpdate_MapItemList) Close() {}
+
+// ToWire translates a RecordDecisionTaskStartedResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
@@ -4561,40 +4317,40 @@ type ReplicateEventsRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ReplicateEventsRequest) ToWire() (wire.Value, error) {
+func (v *RecordDecisionTaskStartedResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.SourceCluster != nil {
-		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.PreviousStartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.ScheduledEventId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -4609,85 +4365,111 @@ func (v *ReplicateEventsRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+	if v.StickyExecutionEnabled != nil {
+		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.History != nil {
-		w, err = v.History.ToWire()
+	if v.DecisionInfo != nil {
+		w, err = v.DecisionInfo.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
-	if v.NewRunHistory != nil {
-		w, err = v.NewRunHistory.ToWire()
+	if v.WorkflowExecutionTaskList != nil {
+		w, err = v.WorkflowExecutionTaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
-	if v.ForceBufferEvents != nil {
-		w, err = wire.NewValueBool(*(v.ForceBufferEvents)), error(nil)
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 100, Value: w}
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+	if v.BranchToken != nil {
+		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
-	if v.NewRunEventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
+	if v.Updates != nil {
+		w, err = wire.NewValueMap(_Map_String_WorkflowUpdate_MapItemList(v.Updates)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
-	if v.ResetWorkflow != nil {
-		w, err = wire.NewValueBool(*(v.ResetWorkflow)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 130, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _History_Read(w wire.Value) (*shared.History, error) {
-	var v shared.History
+func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
+	var v shared.TransientDecisionInfo
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a ReplicateEventsRequest struct from its Thrift-level
+func _WorkflowUpdate_Read(w wire.Value) (*shared.WorkflowUpdate, error) {
+	var v shared.WorkflowUpdate
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_WorkflowUpdate_Read(m wire.MapItemList) (map[string]*shared.WorkflowUpdate, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.WorkflowUpdate, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _WorkflowUpdate_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a RecordDecisionTaskStartedResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicateEventsRequest struct
+// An error is returned if we were unable to build a RecordDecisionTaskStartedResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4695,39 +4477,39 @@ func _History_Read(w wire.Value) (*shared.History, error) {
 //     return nil, err
 //   }
 //
-//   var v ReplicateEventsRequest
+//   var v RecordDecisionTaskStartedResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
+func (v *RecordDecisionTaskStartedResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.SourceCluster = &x
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PreviousStartedEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledEventId = &x
 				if err != nil {
 					return err
 				}
@@ -4737,7 +4519,7 @@ func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
+				v.StartedEventId = &x
 				if err != nil {
 					return err
 				}
@@ -4757,15 +4539,17 @@ func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
+				v.Attempt = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 70:
-			if field.Value.Type() == wire.TMap {
-				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.StickyExecutionEnabled = &x
 				if err != nil {
 					return err
 				}
@@ -4773,7 +4557,7 @@ func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 			}
 		case 80:
 			if field.Value.Type() == wire.TStruct {
-				v.History, err = _History_Read(field.Value)
+				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4781,23 +4565,13 @@ func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 			}
 		case 90:
 			if field.Value.Type() == wire.TStruct {
-				v.NewRunHistory, err = _History_Read(field.Value)
+				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 100:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ForceBufferEvents = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
@@ -4807,21 +4581,17 @@ func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 120:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.NewRunEventStoreVersion = &x
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				v.BranchToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 130:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ResetWorkflow = &x
+		case 120:
+			if field.Value.Type() == wire.TMap {
+				v.Updates, err = _Map_String_WorkflowUpdate_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
@@ -4833,235 +4603,253 @@ func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicateEventsRequest
+// String returns a readable string representation of a RecordDecisionTaskStartedResponse
 // struct.
-func (v *ReplicateEventsRequest) String() string {
+func (v *RecordDecisionTaskStartedResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [13]string
+	var fields [12]string
 	i := 0
-	if v.SourceCluster != nil {
-		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
 		i++
 	}
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.PreviousStartedEventId != nil {
+		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.ScheduledEventId != nil {
+		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
 		i++
 	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
 		i++
 	}
 	if v.NextEventId != nil {
 		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
 		i++
 	}
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
-		i++
-	}
-	if v.ReplicationInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
 		i++
 	}
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
+	if v.StickyExecutionEnabled != nil {
+		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
 		i++
 	}
-	if v.NewRunHistory != nil {
-		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
+	if v.DecisionInfo != nil {
+		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
 		i++
 	}
-	if v.ForceBufferEvents != nil {
-		fields[i] = fmt.Sprintf("ForceBufferEvents: %v", *(v.ForceBufferEvents))
+	if v.WorkflowExecutionTaskList != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
 		i++
 	}
 	if v.EventStoreVersion != nil {
 		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
 		i++
 	}
-	if v.NewRunEventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
+	if v.BranchToken != nil {
+		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
 		i++
 	}
-	if v.ResetWorkflow != nil {
-		fields[i] = fmt.Sprintf("ResetWorkflow: %v", *(v.ResetWorkflow))
+	if v.Updates != nil {
+		fields[i] = fmt.Sprintf("Updates: %v", v.Updates)
 		i++
 	}
 
-	return fmt.Sprintf("ReplicateEventsRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordDecisionTaskStartedResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ReplicateEventsRequest match the
-// provided ReplicateEventsRequest.
-//
-// This function performs a deep comparison.
-func (v *ReplicateEventsRequest) Equals(rhs *ReplicateEventsRequest) bool {
+func _Map_String_WorkflowUpdate_Equals(lhs, rhs map[string]*shared.WorkflowUpdate) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this RecordDecisionTaskStartedResponse match the
+// provided RecordDecisionTaskStartedResponse.
+//
+// This function performs a deep comparison.
+func (v *RecordDecisionTaskStartedResponse) Equals(rhs *RecordDecisionTaskStartedResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
 		return false
 	}
 	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
-		return false
-	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
 		return false
 	}
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
 		return false
 	}
-	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
+	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ForceBufferEvents, rhs.ForceBufferEvents) {
+	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
 		return false
 	}
 	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
+	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ResetWorkflow, rhs.ResetWorkflow) {
+	if !((v.Updates == nil && rhs.Updates == nil) || (v.Updates != nil && rhs.Updates != nil && _Map_String_WorkflowUpdate_Equals(v.Updates, rhs.Updates))) {
 		return false
 	}
 
 	return true
 }
 
+type _Map_String_WorkflowUpdate_Zapper map[string]*shared.WorkflowUpdate
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicateEventsRequest.
-func (v *ReplicateEventsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of _Map_String_WorkflowUpdate_Zapper.
+func (m _Map_String_WorkflowUpdate_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RecordDecisionTaskStartedResponse.
+func (v *RecordDecisionTaskStartedResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.SourceCluster != nil {
-		enc.AddString("sourceCluster", *v.SourceCluster)
+	if v.WorkflowType != nil {
+		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
 	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
+	if v.PreviousStartedEventId != nil {
+		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
 	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	if v.ScheduledEventId != nil {
+		enc.AddInt64("scheduledEventId", *v.ScheduledEventId)
 	}
-	if v.FirstEventId != nil {
-		enc.AddInt64("firstEventId", *v.FirstEventId)
+	if v.StartedEventId != nil {
+		enc.AddInt64("startedEventId", *v.StartedEventId)
 	}
 	if v.NextEventId != nil {
 		enc.AddInt64("nextEventId", *v.NextEventId)
 	}
-	if v.Version != nil {
-		enc.AddInt64("version", *v.Version)
-	}
-	if v.ReplicationInfo != nil {
-		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	if v.Attempt != nil {
+		enc.AddInt64("attempt", *v.Attempt)
 	}
-	if v.History != nil {
-		err = multierr.Append(err, enc.AddObject("history", v.History))
+	if v.StickyExecutionEnabled != nil {
+		enc.AddBool("stickyExecutionEnabled", *v.StickyExecutionEnabled)
 	}
-	if v.NewRunHistory != nil {
-		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
+	if v.DecisionInfo != nil {
+		err = multierr.Append(err, enc.AddObject("decisionInfo", v.DecisionInfo))
 	}
-	if v.ForceBufferEvents != nil {
-		enc.AddBool("forceBufferEvents", *v.ForceBufferEvents)
+	if v.WorkflowExecutionTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
 	}
 	if v.EventStoreVersion != nil {
 		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
 	}
-	if v.NewRunEventStoreVersion != nil {
-		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
+	if v.BranchToken != nil {
+		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
 	}
-	if v.ResetWorkflow != nil {
-		enc.AddBool("resetWorkflow", *v.ResetWorkflow)
+	if v.Updates != nil {
+		err = multierr.Append(err, enc.AddObject("updates", (_Map_String_WorkflowUpdate_Zapper)(v.Updates)))
 	}
 	return err
 }
 
-// GetSourceCluster returns the value of SourceCluster if it is set or its
+// GetWorkflowType returns the value of WorkflowType if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetSourceCluster() (o string) {
-	if v != nil && v.SourceCluster != nil {
-		return *v.SourceCluster
+func (v *RecordDecisionTaskStartedResponse) GetWorkflowType() (o *shared.WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
 	}
 
 	return
 }
 
-// IsSetSourceCluster returns true if SourceCluster is not nil.
-func (v *ReplicateEventsRequest) IsSetSourceCluster() bool {
-	return v != nil && v.SourceCluster != nil
+// IsSetWorkflowType returns true if WorkflowType is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetWorkflowType() bool {
+	return v != nil && v.WorkflowType != nil
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *RecordDecisionTaskStartedResponse) GetPreviousStartedEventId() (o int64) {
+	if v != nil && v.PreviousStartedEventId != nil {
+		return *v.PreviousStartedEventId
 	}
 
 	return
 }
 
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *ReplicateEventsRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
+// IsSetPreviousStartedEventId returns true if PreviousStartedEventId is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetPreviousStartedEventId() bool {
+	return v != nil && v.PreviousStartedEventId != nil
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+func (v *RecordDecisionTaskStartedResponse) GetScheduledEventId() (o int64) {
+	if v != nil && v.ScheduledEventId != nil {
+		return *v.ScheduledEventId
 	}
 
 	return
 }
 
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *ReplicateEventsRequest) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
+// IsSetScheduledEventId returns true if ScheduledEventId is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetScheduledEventId() bool {
+	return v != nil && v.ScheduledEventId != nil
 }
 
-// GetFirstEventId returns the value of FirstEventId if it is set or its
+// GetStartedEventId returns the value of StartedEventId if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetFirstEventId() (o int64) {
-	if v != nil && v.FirstEventId != nil {
-		return *v.FirstEventId
+func (v *RecordDecisionTaskStartedResponse) GetStartedEventId() (o int64) {
+	if v != nil && v.StartedEventId != nil {
+		return *v.StartedEventId
 	}
 
 	return
 }
 
-// IsSetFirstEventId returns true if FirstEventId is not nil.
-func (v *ReplicateEventsRequest) IsSetFirstEventId() bool {
-	return v != nil && v.FirstEventId != nil
+// IsSetStartedEventId returns true if StartedEventId is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetStartedEventId() bool {
+	return v != nil && v.StartedEventId != nil
 }
 
 // GetNextEventId returns the value of NextEventId if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetNextEventId() (o int64) {
+func (v *RecordDecisionTaskStartedResponse) GetNextEventId() (o int64) {
 	if v != nil && v.NextEventId != nil {
 		return *v.NextEventId
 	}
@@ -5070,88 +4858,73 @@ func (v *ReplicateEventsRequest) GetNextEventId() (o int64) {
 }
 
 // IsSetNextEventId returns true if NextEventId is not nil.
-func (v *ReplicateEventsRequest) IsSetNextEventId() bool {
+func (v *RecordDecisionTaskStartedResponse) IsSetNextEventId() bool {
 	return v != nil && v.NextEventId != nil
 }
 
-// GetVersion returns the value of Version if it is set or its
-// zero value if it is unset.
-func (v *ReplicateEventsRequest) GetVersion() (o int64) {
-	if v != nil && v.Version != nil {
-		return *v.Version
-	}
-
-	return
-}
-
-// IsSetVersion returns true if Version is not nil.
-func (v *ReplicateEventsRequest) IsSetVersion() bool {
-	return v != nil && v.Version != nil
-}
-
-// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// GetAttempt returns the value of Attempt if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
-	if v != nil && v.ReplicationInfo != nil {
-		return v.ReplicationInfo
+func (v *RecordDecisionTaskStartedResponse) GetAttempt() (o int64) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
 	}
 
 	return
 }
 
-// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
-func (v *ReplicateEventsRequest) IsSetReplicationInfo() bool {
-	return v != nil && v.ReplicationInfo != nil
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
 }
 
-// GetHistory returns the value of History if it is set or its
+// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetHistory() (o *shared.History) {
-	if v != nil && v.History != nil {
-		return v.History
+func (v *RecordDecisionTaskStartedResponse) GetStickyExecutionEnabled() (o bool) {
+	if v != nil && v.StickyExecutionEnabled != nil {
+		return *v.StickyExecutionEnabled
 	}
 
 	return
 }
 
-// IsSetHistory returns true if History is not nil.
-func (v *ReplicateEventsRequest) IsSetHistory() bool {
-	return v != nil && v.History != nil
+// IsSetStickyExecutionEnabled returns true if StickyExecutionEnabled is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetStickyExecutionEnabled() bool {
+	return v != nil && v.StickyExecutionEnabled != nil
 }
 
-// GetNewRunHistory returns the value of NewRunHistory if it is set or its
+// GetDecisionInfo returns the value of DecisionInfo if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetNewRunHistory() (o *shared.History) {
-	if v != nil && v.NewRunHistory != nil {
-		return v.NewRunHistory
+func (v *RecordDecisionTaskStartedResponse) GetDecisionInfo() (o *shared.TransientDecisionInfo) {
+	if v != nil && v.DecisionInfo != nil {
+		return v.DecisionInfo
 	}
 
 	return
 }
 
-// IsSetNewRunHistory returns true if NewRunHistory is not nil.
-func (v *ReplicateEventsRequest) IsSetNewRunHistory() bool {
-	return v != nil && v.NewRunHistory != nil
+// IsSetDecisionInfo returns true if DecisionInfo is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetDecisionInfo() bool {
+	return v != nil && v.DecisionInfo != nil
 }
 
-// GetForceBufferEvents returns the value of ForceBufferEvents if it is set or its
+// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetForceBufferEvents() (o bool) {
-	if v != nil && v.ForceBufferEvents != nil {
-		return *v.ForceBufferEvents
+func (v *RecordDecisionTaskStartedResponse) GetWorkflowExecutionTaskList() (o *shared.TaskList) {
+	if v != nil && v.WorkflowExecutionTaskList != nil {
+		return v.WorkflowExecutionTaskList
 	}
 
 	return
 }
 
-// IsSetForceBufferEvents returns true if ForceBufferEvents is not nil.
-func (v *ReplicateEventsRequest) IsSetForceBufferEvents() bool {
-	return v != nil && v.ForceBufferEvents != nil
+// IsSetWorkflowExecutionTaskList returns true if WorkflowExecutionTaskList is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetWorkflowExecutionTaskList() bool {
+	return v != nil && v.WorkflowExecutionTaskList != nil
 }
 
 // GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetEventStoreVersion() (o int32) {
+func (v *RecordDecisionTaskStartedResponse) GetEventStoreVersion() (o int32) {
 	if v != nil && v.EventStoreVersion != nil {
 		return *v.EventStoreVersion
 	}
@@ -5160,51 +4933,47 @@ func (v *ReplicateEventsRequest) GetEventStoreVersion() (o int32) {
 }
 
 // IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *ReplicateEventsRequest) IsSetEventStoreVersion() bool {
+func (v *RecordDecisionTaskStartedResponse) IsSetEventStoreVersion() bool {
 	return v != nil && v.EventStoreVersion != nil
 }
 
-// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
+// GetBranchToken returns the value of BranchToken if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetNewRunEventStoreVersion() (o int32) {
-	if v != nil && v.NewRunEventStoreVersion != nil {
-		return *v.NewRunEventStoreVersion
+func (v *RecordDecisionTaskStartedResponse) GetBranchToken() (o []byte) {
+	if v != nil && v.BranchToken != nil {
+		return v.BranchToken
 	}
 
 	return
 }
 
-// IsSetNewRunEventStoreVersion returns true if NewRunEventStoreVersion is not nil.
-func (v *ReplicateEventsRequest) IsSetNewRunEventStoreVersion() bool {
-	return v != nil && v.NewRunEventStoreVersion != nil
+// IsSetBranchToken returns true if BranchToken is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetBranchToken() bool {
+	return v != nil && v.BranchToken != nil
 }
 
-// GetResetWorkflow returns the value of ResetWorkflow if it is set or its
+// GetUpdates returns the value of Updates if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetResetWorkflow() (o bool) {
-	if v != nil && v.ResetWorkflow != nil {
-		return *v.ResetWorkflow
+func (v *RecordDecisionTaskStartedResponse) GetUpdates() (o map[string]*shared.WorkflowUpdate) {
+	if v != nil && v.Updates != nil {
+		return v.Updates
 	}
 
 	return
 }
 
-// IsSetResetWorkflow returns true if ResetWorkflow is not nil.
-func (v *ReplicateEventsRequest) IsSetResetWorkflow() bool {
-	return v != nil && v.ResetWorkflow != nil
+// IsSetUpdates returns true if Updates is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetUpdates() bool {
+	return v != nil && v.Updates != nil
 }
 
-type ReplicateRawEventsRequest struct {
-	DomainUUID              *string                            `json:"domainUUID,omitempty"`
-	WorkflowExecution       *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
-	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
-	History                 *shared.DataBlob                   `json:"history,omitempty"`
-	NewRunHistory           *shared.DataBlob                   `json:"newRunHistory,omitempty"`
-	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
-	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
+type RemoveSignalMutableStateRequest struct {
+	DomainUUID        *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+	RequestId         *string                   `json:"requestId,omitempty"`
 }
 
-// ToWire translates a ReplicateRawEventsRequest struct into a Thrift-level intermediate
+// ToWire translates a RemoveSignalMutableStateRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5219,9 +4988,9 @@ type ReplicateRawEventsRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ReplicateRawEventsRequest) ToWire() (wire.Value, error) {
+func (v *RemoveSignalMutableStateRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5243,61 +5012,23 @@ func (v *ReplicateRawEventsRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.History != nil {
-		w, err = v.History.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.NewRunHistory != nil {
-		w, err = v.NewRunHistory.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.NewRunEventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
-	var v shared.DataBlob
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a ReplicateRawEventsRequest struct from its Thrift-level
+// FromWire deserializes a RemoveSignalMutableStateRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicateRawEventsRequest struct
+// An error is returned if we were unable to build a RemoveSignalMutableStateRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5305,12 +5036,12 @@ func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
 //     return nil, err
 //   }
 //
-//   var v ReplicateRawEventsRequest
+//   var v RemoveSignalMutableStateRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ReplicateRawEventsRequest) FromWire(w wire.Value) error {
+func (v *RemoveSignalMutableStateRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -5334,44 +5065,10 @@ func (v *ReplicateRawEventsRequest) FromWire(w wire.Value) error {
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TMap {
-				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.History, err = _DataBlob_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.NewRunHistory, err = _DataBlob_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.NewRunEventStoreVersion = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
 				if err != nil {
 					return err
 				}
@@ -5383,14 +5080,14 @@ func (v *ReplicateRawEventsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicateRawEventsRequest
+// String returns a readable string representation of a RemoveSignalMutableStateRequest
 // struct.
-func (v *ReplicateRawEventsRequest) String() string {
+func (v *RemoveSignalMutableStateRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [3]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -5400,35 +5097,19 @@ func (v *ReplicateRawEventsRequest) String() string {
 		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
-		i++
-	}
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
-		i++
-	}
-	if v.NewRunHistory != nil {
-		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
-		i++
-	}
-	if v.NewRunEventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
 
-	return fmt.Sprintf("ReplicateRawEventsRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RemoveSignalMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ReplicateRawEventsRequest match the
-// provided ReplicateRawEventsRequest.
+// Equals returns true if all the fields of this RemoveSignalMutableStateRequest match the
+// provided RemoveSignalMutableStateRequest.
 //
 // This function performs a deep comparison.
-func (v *ReplicateRawEventsRequest) Equals(rhs *ReplicateRawEventsRequest) bool {
+func (v *RemoveSignalMutableStateRequest) Equals(rhs *RemoveSignalMutableStateRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -5440,19 +5121,7 @@ func (v *ReplicateRawEventsRequest) Equals(rhs *ReplicateRawEventsRequest) bool
 	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
-		return false
-	}
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
-		return false
-	}
-	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
 
@@ -5460,8 +5129,8 @@ func (v *ReplicateRawEventsRequest) Equals(rhs *ReplicateRawEventsRequest) bool
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicateRawEventsRequest.
-func (v *ReplicateRawEventsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RemoveSignalMutableStateRequest.
+func (v *RemoveSignalMutableStateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -5471,27 +5140,15 @@ func (v *ReplicateRawEventsRequest) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.WorkflowExecution != nil {
 		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
-	if v.ReplicationInfo != nil {
-		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
-	}
-	if v.History != nil {
-		err = multierr.Append(err, enc.AddObject("history", v.History))
-	}
-	if v.NewRunHistory != nil {
-		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
-	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
-	}
-	if v.NewRunEventStoreVersion != nil {
-		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *ReplicateRawEventsRequest) GetDomainUUID() (o string) {
+func (v *RemoveSignalMutableStateRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -5500,13 +5157,13 @@ func (v *ReplicateRawEventsRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *ReplicateRawEventsRequest) IsSetDomainUUID() bool {
+func (v *RemoveSignalMutableStateRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
 // GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *ReplicateRawEventsRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+func (v *RemoveSignalMutableStateRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
 	if v != nil && v.WorkflowExecution != nil {
 		return v.WorkflowExecution
 	}
@@ -5515,171 +5172,183 @@ func (v *ReplicateRawEventsRequest) GetWorkflowExecution() (o *shared.WorkflowEx
 }
 
 // IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *ReplicateRawEventsRequest) IsSetWorkflowExecution() bool {
+func (v *RemoveSignalMutableStateRequest) IsSetWorkflowExecution() bool {
 	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// GetRequestId returns the value of RequestId if it is set or its
 // zero value if it is unset.
-func (v *ReplicateRawEventsRequest) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
-	if v != nil && v.ReplicationInfo != nil {
-		return v.ReplicationInfo
+func (v *RemoveSignalMutableStateRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
 	}
 
 	return
 }
 
-// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
-func (v *ReplicateRawEventsRequest) IsSetReplicationInfo() bool {
-	return v != nil && v.ReplicationInfo != nil
-}
-
-// GetHistory returns the value of History if it is set or its
-// zero value if it is unset.
-func (v *ReplicateRawEventsRequest) GetHistory() (o *shared.DataBlob) {
-	if v != nil && v.History != nil {
-		return v.History
-	}
-
-	return
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *RemoveSignalMutableStateRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
 }
 
-// IsSetHistory returns true if History is not nil.
-func (v *ReplicateRawEventsRequest) IsSetHistory() bool {
-	return v != nil && v.History != nil
+type ReplicateEventsRequest struct {
+	SourceCluster           *string                            `json:"sourceCluster,omitempty"`
+	DomainUUID              *string                            `json:"domainUUID,omitempty"`
+	WorkflowExecution       *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
+	FirstEventId            *int64                             `json:"firstEventId,omitempty"`
+	NextEventId             *int64                             `json:"nextEventId,omitempty"`
+	Version                 *int64                             `json:"version,omitempty"`
+	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
+	History                 *shared.History                    `json:"history,omitempty"`
+	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
+	ForceBufferEvents       *bool                              `json:"forceBufferEvents,omitempty"`
+	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
+	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
+	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
 }
 
-// GetNewRunHistory returns the value of NewRunHistory if it is set or its
-// zero value if it is unset.
-func (v *ReplicateRawEventsRequest) GetNewRunHistory() (o *shared.DataBlob) {
-	if v != nil && v.NewRunHistory != nil {
-		return v.NewRunHistory
-	}
-
-	return
-}
+// ToWire translates a ReplicateEventsRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicateEventsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [13]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// IsSetNewRunHistory returns true if NewRunHistory is not nil.
-func (v *ReplicateRawEventsRequest) IsSetNewRunHistory() bool {
-	return v != nil && v.NewRunHistory != nil
-}
-
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
-// zero value if it is unset.
-func (v *ReplicateRawEventsRequest) GetEventStoreVersion() (o int32) {
-	if v != nil && v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
-	}
-
-	return
-}
-
-// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *ReplicateRawEventsRequest) IsSetEventStoreVersion() bool {
-	return v != nil && v.EventStoreVersion != nil
-}
-
-// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
-// zero value if it is unset.
-func (v *ReplicateRawEventsRequest) GetNewRunEventStoreVersion() (o int32) {
-	if v != nil && v.NewRunEventStoreVersion != nil {
-		return *v.NewRunEventStoreVersion
-	}
-
-	return
-}
-
-// IsSetNewRunEventStoreVersion returns true if NewRunEventStoreVersion is not nil.
-func (v *ReplicateRawEventsRequest) IsSetNewRunEventStoreVersion() bool {
-	return v != nil && v.NewRunEventStoreVersion != nil
-}
-
-type RequestCancelWorkflowExecutionRequest struct {
-	DomainUUID                *string                                       `json:"domainUUID,omitempty"`
-	CancelRequest             *shared.RequestCancelWorkflowExecutionRequest `json:"cancelRequest,omitempty"`
-	ExternalInitiatedEventId  *int64                                        `json:"externalInitiatedEventId,omitempty"`
-	ExternalWorkflowExecution *shared.WorkflowExecution                     `json:"externalWorkflowExecution,omitempty"`
-	ChildWorkflowOnly         *bool                                         `json:"childWorkflowOnly,omitempty"`
-}
-
-// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
-	var (
-		fields [5]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.CancelRequest != nil {
-		w, err = v.CancelRequest.ToWire()
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ExternalInitiatedEventId != nil {
-		w, err = wire.NewValueI64(*(v.ExternalInitiatedEventId)), error(nil)
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ExternalWorkflowExecution != nil {
-		w, err = v.ExternalWorkflowExecution.ToWire()
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.ChildWorkflowOnly != nil {
-		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.NewRunHistory != nil {
+		w, err = v.NewRunHistory.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.ForceBufferEvents != nil {
+		w, err = wire.NewValueBool(*(v.ForceBufferEvents)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.NewRunEventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.ResetWorkflow != nil {
+		w, err = wire.NewValueBool(*(v.ResetWorkflow)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RequestCancelWorkflowExecutionRequest_Read(w wire.Value) (*shared.RequestCancelWorkflowExecutionRequest, error) {
-	var v shared.RequestCancelWorkflowExecutionRequest
+func _History_Read(w wire.Value) (*shared.History, error) {
+	var v shared.History
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a ReplicateEventsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a ReplicateEventsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5687,12 +5356,12 @@ func _RequestCancelWorkflowExecutionRequest_Read(w wire.Value) (*shared.RequestC
 //     return nil, err
 //   }
 //
-//   var v RequestCancelWorkflowExecutionRequest
+//   var v ReplicateEventsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -5701,43 +5370,119 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+				v.SourceCluster = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.CancelRequest, err = _RequestCancelWorkflowExecutionRequest_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.ExternalInitiatedEventId = &x
+				v.FirstEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
 			if field.Value.Type() == wire.TStruct {
-				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				v.History, err = _History_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.NewRunHistory, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
 			if field.Value.Type() == wire.TBool {
 				var x bool
 				x, err = field.Value.GetBool(), error(nil)
-				v.ChildWorkflowOnly = &x
+				v.ForceBufferEvents = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NewRunEventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ResetWorkflow = &x
 				if err != nil {
 					return err
 				}
@@ -5749,62 +5494,118 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
+// String returns a readable string representation of a ReplicateEventsRequest
 // struct.
-func (v *RequestCancelWorkflowExecutionRequest) String() string {
+func (v *ReplicateEventsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [13]string
 	i := 0
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.CancelRequest != nil {
-		fields[i] = fmt.Sprintf("CancelRequest: %v", v.CancelRequest)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.ExternalInitiatedEventId != nil {
-		fields[i] = fmt.Sprintf("ExternalInitiatedEventId: %v", *(v.ExternalInitiatedEventId))
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
 		i++
 	}
-	if v.ExternalWorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
 		i++
 	}
-	if v.ChildWorkflowOnly != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NewRunHistory != nil {
+		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
+		i++
+	}
+	if v.ForceBufferEvents != nil {
+		fields[i] = fmt.Sprintf("ForceBufferEvents: %v", *(v.ForceBufferEvents))
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+	if v.NewRunEventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
+		i++
+	}
+	if v.ResetWorkflow != nil {
+		fields[i] = fmt.Sprintf("ResetWorkflow: %v", *(v.ResetWorkflow))
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReplicateEventsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
-// provided RequestCancelWorkflowExecutionRequest.
+// Equals returns true if all the fields of this ReplicateEventsRequest match the
+// provided ReplicateEventsRequest.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
+func (v *ReplicateEventsRequest) Equals(rhs *ReplicateEventsRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.CancelRequest == nil && rhs.CancelRequest == nil) || (v.CancelRequest != nil && rhs.CancelRequest != nil && v.CancelRequest.Equals(rhs.CancelRequest))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ExternalInitiatedEventId, rhs.ExternalInitiatedEventId) {
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
 		return false
 	}
-	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ForceBufferEvents, rhs.ForceBufferEvents) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ResetWorkflow, rhs.ResetWorkflow) {
 		return false
 	}
 
@@ -5812,32 +5613,71 @@ func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelWorkflowExecutionRequest.
-func (v *RequestCancelWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ReplicateEventsRequest.
+func (v *ReplicateEventsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.CancelRequest != nil {
-		err = multierr.Append(err, enc.AddObject("cancelRequest", v.CancelRequest))
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
-	if v.ExternalInitiatedEventId != nil {
-		enc.AddInt64("externalInitiatedEventId", *v.ExternalInitiatedEventId)
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
 	}
-	if v.ExternalWorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("externalWorkflowExecution", v.ExternalWorkflowExecution))
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
 	}
-	if v.ChildWorkflowOnly != nil {
-		enc.AddBool("childWorkflowOnly", *v.ChildWorkflowOnly)
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.ReplicationInfo != nil {
+		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	}
+	if v.History != nil {
+		err = multierr.Append(err, enc.AddObject("history", v.History))
+	}
+	if v.NewRunHistory != nil {
+		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
+	}
+	if v.ForceBufferEvents != nil {
+		enc.AddBool("forceBufferEvents", *v.ForceBufferEvents)
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	if v.NewRunEventStoreVersion != nil {
+		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
+	}
+	if v.ResetWorkflow != nil {
+		enc.AddBool("resetWorkflow", *v.ResetWorkflow)
 	}
 	return err
 }
 
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *ReplicateEventsRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetDomainUUID() (o string) {
+func (v *ReplicateEventsRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -5846,254 +5686,186 @@ func (v *RequestCancelWorkflowExecutionRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetDomainUUID() bool {
+func (v *ReplicateEventsRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetCancelRequest returns the value of CancelRequest if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetCancelRequest() (o *shared.RequestCancelWorkflowExecutionRequest) {
-	if v != nil && v.CancelRequest != nil {
-		return v.CancelRequest
+func (v *ReplicateEventsRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetCancelRequest returns true if CancelRequest is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetCancelRequest() bool {
-	return v != nil && v.CancelRequest != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ReplicateEventsRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetExternalInitiatedEventId returns the value of ExternalInitiatedEventId if it is set or its
+// GetFirstEventId returns the value of FirstEventId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetExternalInitiatedEventId() (o int64) {
-	if v != nil && v.ExternalInitiatedEventId != nil {
-		return *v.ExternalInitiatedEventId
+func (v *ReplicateEventsRequest) GetFirstEventId() (o int64) {
+	if v != nil && v.FirstEventId != nil {
+		return *v.FirstEventId
 	}
 
 	return
 }
 
-// IsSetExternalInitiatedEventId returns true if ExternalInitiatedEventId is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetExternalInitiatedEventId() bool {
-	return v != nil && v.ExternalInitiatedEventId != nil
+// IsSetFirstEventId returns true if FirstEventId is not nil.
+func (v *ReplicateEventsRequest) IsSetFirstEventId() bool {
+	return v != nil && v.FirstEventId != nil
 }
 
-// GetExternalWorkflowExecution returns the value of ExternalWorkflowExecution if it is set or its
+// GetNextEventId returns the value of NextEventId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetExternalWorkflowExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.ExternalWorkflowExecution != nil {
-		return v.ExternalWorkflowExecution
+func (v *ReplicateEventsRequest) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
 	}
 
 	return
 }
 
-// IsSetExternalWorkflowExecution returns true if ExternalWorkflowExecution is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetExternalWorkflowExecution() bool {
-	return v != nil && v.ExternalWorkflowExecution != nil
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *ReplicateEventsRequest) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
 }
 
-// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// GetVersion returns the value of Version if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool) {
-	if v != nil && v.ChildWorkflowOnly != nil {
-		return *v.ChildWorkflowOnly
+func (v *ReplicateEventsRequest) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
 
 	return
 }
 
-// IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetChildWorkflowOnly() bool {
-	return v != nil && v.ChildWorkflowOnly != nil
+// IsSetVersion returns true if Version is not nil.
+func (v *ReplicateEventsRequest) IsSetVersion() bool {
+	return v != nil && v.Version != nil
 }
 
-type ResetStickyTaskListRequest struct {
-	DomainUUID *string                   `json:"domainUUID,omitempty"`
-	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
+	if v != nil && v.ReplicationInfo != nil {
+		return v.ReplicationInfo
+	}
+
+	return
 }
 
-// ToWire translates a ResetStickyTaskListRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
-	var (
-		fields [2]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
+func (v *ReplicateEventsRequest) IsSetReplicationInfo() bool {
+	return v != nil && v.ReplicationInfo != nil
+}
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetHistory() (o *shared.History) {
+	if v != nil && v.History != nil {
+		return v.History
 	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	return
 }
 
-// FromWire deserializes a ResetStickyTaskListRequest struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a ResetStickyTaskListRequest struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v ResetStickyTaskListRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
+// IsSetHistory returns true if History is not nil.
+func (v *ReplicateEventsRequest) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
 
-			}
-		}
+// GetNewRunHistory returns the value of NewRunHistory if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetNewRunHistory() (o *shared.History) {
+	if v != nil && v.NewRunHistory != nil {
+		return v.NewRunHistory
 	}
 
-	return nil
+	return
 }
 
-// String returns a readable string representation of a ResetStickyTaskListRequest
-// struct.
-func (v *ResetStickyTaskListRequest) String() string {
-	if v == nil {
-		return "<nil>"
-	}
+// IsSetNewRunHistory returns true if NewRunHistory is not nil.
+func (v *ReplicateEventsRequest) IsSetNewRunHistory() bool {
+	return v != nil && v.NewRunHistory != nil
+}
 
-	var fields [2]string
-	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
+// GetForceBufferEvents returns the value of ForceBufferEvents if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetForceBufferEvents() (o bool) {
+	if v != nil && v.ForceBufferEvents != nil {
+		return *v.ForceBufferEvents
 	}
 
-	return fmt.Sprintf("ResetStickyTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+	return
 }
 
-// Equals returns true if all the fields of this ResetStickyTaskListRequest match the
-// provided ResetStickyTaskListRequest.
-//
-// This function performs a deep comparison.
-func (v *ResetStickyTaskListRequest) Equals(rhs *ResetStickyTaskListRequest) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
+// IsSetForceBufferEvents returns true if ForceBufferEvents is not nil.
+func (v *ReplicateEventsRequest) IsSetForceBufferEvents() bool {
+	return v != nil && v.ForceBufferEvents != nil
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetEventStoreVersion() (o int32) {
+	if v != nil && v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
 	}
 
-	return true
+	return
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetStickyTaskListRequest.
-func (v *ResetStickyTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
-	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
-	}
-	return err
+// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
+func (v *ReplicateEventsRequest) IsSetEventStoreVersion() bool {
+	return v != nil && v.EventStoreVersion != nil
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
 // zero value if it is unset.
-func (v *ResetStickyTaskListRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *ReplicateEventsRequest) GetNewRunEventStoreVersion() (o int32) {
+	if v != nil && v.NewRunEventStoreVersion != nil {
+		return *v.NewRunEventStoreVersion
 	}
 
 	return
 }
 
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *ResetStickyTaskListRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
+// IsSetNewRunEventStoreVersion returns true if NewRunEventStoreVersion is not nil.
+func (v *ReplicateEventsRequest) IsSetNewRunEventStoreVersion() bool {
+	return v != nil && v.NewRunEventStoreVersion != nil
 }
 
-// GetExecution returns the value of Execution if it is set or its
+// GetResetWorkflow returns the value of ResetWorkflow if it is set or its
 // zero value if it is unset.
-func (v *ResetStickyTaskListRequest) GetExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+func (v *ReplicateEventsRequest) GetResetWorkflow() (o bool) {
+	if v != nil && v.ResetWorkflow != nil {
+		return *v.ResetWorkflow
 	}
 
 	return
 }
 
-// IsSetExecution returns true if Execution is not nil.
-func (v *ResetStickyTaskListRequest) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
+// IsSetResetWorkflow returns true if ResetWorkflow is not nil.
+func (v *ReplicateEventsRequest) IsSetResetWorkflow() bool {
+	return v != nil && v.ResetWorkflow != nil
 }
 
-type ResetStickyTaskListResponse struct {
+type ReplicateRawEventsRequest struct {
+	DomainUUID              *string                            `json:"domainUUID,omitempty"`
+	WorkflowExecution       *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
+	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
+	History                 *shared.DataBlob                   `json:"history,omitempty"`
+	NewRunHistory           *shared.DataBlob                   `json:"newRunHistory,omitempty"`
+	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
+	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
 }
 
-// ToWire translates a ResetStickyTaskListResponse struct into a Thrift-level intermediate
+// ToWire translates a ReplicateRawEventsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6108,20 +5880,85 @@ type ResetStickyTaskListResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
+func (v *ReplicateRawEventsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [0]wire.Field
+		fields [7]wire.Field
 		i      int = 0
+		w      wire.Value
+		err    error
 	)
 
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NewRunHistory != nil {
+		w, err = v.NewRunHistory.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.NewRunEventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
+func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
+	var v shared.DataBlob
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ReplicateRawEventsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
+// An error is returned if we were unable to build a ReplicateRawEventsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6129,130 +5966,13 @@ func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetStickyTaskListResponse
+//   var v ReplicateRawEventsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a ResetStickyTaskListResponse
-// struct.
-func (v *ResetStickyTaskListResponse) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [0]string
-	i := 0
-
-	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
-// provided ResetStickyTaskListResponse.
-//
-// This function performs a deep comparison.
-func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-
-	return true
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetStickyTaskListResponse.
-func (v *ResetStickyTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	return err
-}
-
-type ResetWorkflowExecutionRequest struct {
-	DomainUUID   *string                               `json:"domainUUID,omitempty"`
-	ResetRequest *shared.ResetWorkflowExecutionRequest `json:"resetRequest,omitempty"`
-}
-
-// ToWire translates a ResetWorkflowExecutionRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
-	var (
-		fields [2]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.ResetRequest != nil {
-		w, err = v.ResetRequest.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _ResetWorkflowExecutionRequest_Read(w wire.Value) (*shared.ResetWorkflowExecutionRequest, error) {
-	var v shared.ResetWorkflowExecutionRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a ResetWorkflowExecutionRequest struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a ResetWorkflowExecutionRequest struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v ResetWorkflowExecutionRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
-	var err error
+func (v *ReplicateRawEventsRequest) FromWire(w wire.Value) error {
+	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -6268,7 +5988,51 @@ func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.ResetRequest, err = _ResetWorkflowExecutionRequest_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.History, err = _DataBlob_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.NewRunHistory, err = _DataBlob_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NewRunEventStoreVersion = &x
 				if err != nil {
 					return err
 				}
@@ -6280,32 +6044,52 @@ func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetWorkflowExecutionRequest
+// String returns a readable string representation of a ReplicateRawEventsRequest
 // struct.
-func (v *ResetWorkflowExecutionRequest) String() string {
+func (v *ReplicateRawEventsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [7]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.ResetRequest != nil {
-		fields[i] = fmt.Sprintf("ResetRequest: %v", v.ResetRequest)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NewRunHistory != nil {
+		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+	if v.NewRunEventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
 		i++
 	}
 
-	return fmt.Sprintf("ResetWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReplicateRawEventsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetWorkflowExecutionRequest match the
-// provided ResetWorkflowExecutionRequest.
+// Equals returns true if all the fields of this ReplicateRawEventsRequest match the
+// provided ReplicateRawEventsRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionRequest) bool {
+func (v *ReplicateRawEventsRequest) Equals(rhs *ReplicateRawEventsRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6314,7 +6098,22 @@ func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionReques
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.ResetRequest == nil && rhs.ResetRequest == nil) || (v.ResetRequest != nil && rhs.ResetRequest != nil && v.ResetRequest.Equals(rhs.ResetRequest))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
 		return false
 	}
 
@@ -6322,23 +6121,38 @@ func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionReques
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetWorkflowExecutionRequest.
-func (v *ResetWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ReplicateRawEventsRequest.
+func (v *ReplicateRawEventsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.ResetRequest != nil {
-		err = multierr.Append(err, enc.AddObject("resetRequest", v.ResetRequest))
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.ReplicationInfo != nil {
+		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	}
+	if v.History != nil {
+		err = multierr.Append(err, enc.AddObject("history", v.History))
+	}
+	if v.NewRunHistory != nil {
+		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	if v.NewRunEventStoreVersion != nil {
+		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *ResetWorkflowExecutionRequest) GetDomainUUID() (o string) {
+func (v *ReplicateRawEventsRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -6347,31 +6161,109 @@ func (v *ResetWorkflowExecutionRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *ResetWorkflowExecutionRequest) IsSetDomainUUID() bool {
+func (v *ReplicateRawEventsRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetResetRequest returns the value of ResetRequest if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *ResetWorkflowExecutionRequest) GetResetRequest() (o *shared.ResetWorkflowExecutionRequest) {
-	if v != nil && v.ResetRequest != nil {
-		return v.ResetRequest
+func (v *ReplicateRawEventsRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetResetRequest returns true if ResetRequest is not nil.
-func (v *ResetWorkflowExecutionRequest) IsSetResetRequest() bool {
-	return v != nil && v.ResetRequest != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ReplicateRawEventsRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
-type RespondActivityTaskCanceledRequest struct {
-	DomainUUID    *string                                    `json:"domainUUID,omitempty"`
-	CancelRequest *shared.RespondActivityTaskCanceledRequest `json:"cancelRequest,omitempty"`
+// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// zero value if it is unset.
+func (v *ReplicateRawEventsRequest) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
+	if v != nil && v.ReplicationInfo != nil {
+		return v.ReplicationInfo
+	}
+
+	return
 }
 
-// ToWire translates a RespondActivityTaskCanceledRequest struct into a Thrift-level intermediate
+// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
+func (v *ReplicateRawEventsRequest) IsSetReplicationInfo() bool {
+	return v != nil && v.ReplicationInfo != nil
+}
+
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+func (v *ReplicateRawEventsRequest) GetHistory() (o *shared.DataBlob) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+
+	return
+}
+
+// IsSetHistory returns true if History is not nil.
+func (v *ReplicateRawEventsRequest) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
+
+// GetNewRunHistory returns the value of NewRunHistory if it is set or its
+// zero value if it is unset.
+func (v *ReplicateRawEventsRequest) GetNewRunHistory() (o *shared.DataBlob) {
+	if v != nil && v.NewRunHistory != nil {
+		return v.NewRunHistory
+	}
+
+	return
+}
+
+// IsSetNewRunHistory returns true if NewRunHistory is not nil.
+func (v *ReplicateRawEventsRequest) IsSetNewRunHistory() bool {
+	return v != nil && v.NewRunHistory != nil
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *ReplicateRawEventsRequest) GetEventStoreVersion() (o int32) {
+	if v != nil && v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
+func (v *ReplicateRawEventsRequest) IsSetEventStoreVersion() bool {
+	return v != nil && v.EventStoreVersion != nil
+}
+
+// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *ReplicateRawEventsRequest) GetNewRunEventStoreVersion() (o int32) {
+	if v != nil && v.NewRunEventStoreVersion != nil {
+		return *v.NewRunEventStoreVersion
+	}
+
+	return
+}
+
+// IsSetNewRunEventStoreVersion returns true if NewRunEventStoreVersion is not nil.
+func (v *ReplicateRawEventsRequest) IsSetNewRunEventStoreVersion() bool {
+	return v != nil && v.NewRunEventStoreVersion != nil
+}
+
+type RequestCancelWorkflowExecutionRequest struct {
+	DomainUUID                *string                                       `json:"domainUUID,omitempty"`
+	CancelRequest             *shared.RequestCancelWorkflowExecutionRequest `json:"cancelRequest,omitempty"`
+	ExternalInitiatedEventId  *int64                                        `json:"externalInitiatedEventId,omitempty"`
+	ExternalWorkflowExecution *shared.WorkflowExecution                     `json:"externalWorkflowExecution,omitempty"`
+	ChildWorkflowOnly         *bool                                         `json:"childWorkflowOnly,omitempty"`
+}
+
+// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6386,9 +6278,9 @@ type RespondActivityTaskCanceledRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
+func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6410,21 +6302,45 @@ func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ExternalInitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.ExternalInitiatedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ExternalWorkflowExecution != nil {
+		w, err = v.ExternalWorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondActivityTaskCanceledRequest_Read(w wire.Value) (*shared.RespondActivityTaskCanceledRequest, error) {
-	var v shared.RespondActivityTaskCanceledRequest
+func _RequestCancelWorkflowExecutionRequest_Read(w wire.Value) (*shared.RequestCancelWorkflowExecutionRequest, error) {
+	var v shared.RequestCancelWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RespondActivityTaskCanceledRequest struct from its Thrift-level
+// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskCanceledRequest struct
+// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6432,12 +6348,12 @@ func _RespondActivityTaskCanceledRequest_Read(w wire.Value) (*shared.RespondActi
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskCanceledRequest
+//   var v RequestCancelWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
+func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -6454,7 +6370,35 @@ func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.CancelRequest, err = _RespondActivityTaskCanceledRequest_Read(field.Value)
+				v.CancelRequest, err = _RequestCancelWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExternalInitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ChildWorkflowOnly = &x
 				if err != nil {
 					return err
 				}
@@ -6466,14 +6410,14 @@ func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskCanceledRequest
+// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
 // struct.
-func (v *RespondActivityTaskCanceledRequest) String() string {
+func (v *RequestCancelWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [5]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -6483,15 +6427,27 @@ func (v *RespondActivityTaskCanceledRequest) String() string {
 		fields[i] = fmt.Sprintf("CancelRequest: %v", v.CancelRequest)
 		i++
 	}
+	if v.ExternalInitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("ExternalInitiatedEventId: %v", *(v.ExternalInitiatedEventId))
+		i++
+	}
+	if v.ExternalWorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
+		i++
+	}
 
-	return fmt.Sprintf("RespondActivityTaskCanceledRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskCanceledRequest match the
-// provided RespondActivityTaskCanceledRequest.
+// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
+// provided RequestCancelWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanceledRequest) bool {
+func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6503,13 +6459,22 @@ func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanc
 	if !((v.CancelRequest == nil && rhs.CancelRequest == nil) || (v.CancelRequest != nil && rhs.CancelRequest != nil && v.CancelRequest.Equals(rhs.CancelRequest))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.ExternalInitiatedEventId, rhs.ExternalInitiatedEventId) {
+		return false
+	}
+	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondActivityTaskCanceledRequest.
-func (v *RespondActivityTaskCanceledRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelWorkflowExecutionRequest.
+func (v *RequestCancelWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -6519,12 +6484,21 @@ func (v *RespondActivityTaskCanceledRequest) MarshalLogObject(enc zapcore.Object
 	if v.CancelRequest != nil {
 		err = multierr.Append(err, enc.AddObject("cancelRequest", v.CancelRequest))
 	}
+	if v.ExternalInitiatedEventId != nil {
+		enc.AddInt64("externalInitiatedEventId", *v.ExternalInitiatedEventId)
+	}
+	if v.ExternalWorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("externalWorkflowExecution", v.ExternalWorkflowExecution))
+	}
+	if v.ChildWorkflowOnly != nil {
+		enc.AddBool("childWorkflowOnly", *v.ChildWorkflowOnly)
+	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledRequest) GetDomainUUID() (o string) {
+func (v *RequestCancelWorkflowExecutionRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -6533,13 +6507,13 @@ func (v *RespondActivityTaskCanceledRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RespondActivityTaskCanceledRequest) IsSetDomainUUID() bool {
+func (v *RequestCancelWorkflowExecutionRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
 // GetCancelRequest returns the value of CancelRequest if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledRequest) GetCancelRequest() (o *shared.RespondActivityTaskCanceledRequest) {
+func (v *RequestCancelWorkflowExecutionRequest) GetCancelRequest() (o *shared.RequestCancelWorkflowExecutionRequest) {
 	if v != nil && v.CancelRequest != nil {
 		return v.CancelRequest
 	}
@@ -6548,48 +6522,93 @@ func (v *RespondActivityTaskCanceledRequest) GetCancelRequest() (o *shared.Respo
 }
 
 // IsSetCancelRequest returns true if CancelRequest is not nil.
-func (v *RespondActivityTaskCanceledRequest) IsSetCancelRequest() bool {
+func (v *RequestCancelWorkflowExecutionRequest) IsSetCancelRequest() bool {
 	return v != nil && v.CancelRequest != nil
 }
 
-type RespondActivityTaskCompletedRequest struct {
-	DomainUUID      *string                                     `json:"domainUUID,omitempty"`
-	CompleteRequest *shared.RespondActivityTaskCompletedRequest `json:"completeRequest,omitempty"`
+// GetExternalInitiatedEventId returns the value of ExternalInitiatedEventId if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelWorkflowExecutionRequest) GetExternalInitiatedEventId() (o int64) {
+	if v != nil && v.ExternalInitiatedEventId != nil {
+		return *v.ExternalInitiatedEventId
+	}
+
+	return
 }
 
-// ToWire translates a RespondActivityTaskCompletedRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
-	var (
-		fields [2]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+// IsSetExternalInitiatedEventId returns true if ExternalInitiatedEventId is not nil.
+func (v *RequestCancelWorkflowExecutionRequest) IsSetExternalInitiatedEventId() bool {
+	return v != nil && v.ExternalInitiatedEventId != nil
+}
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.CompleteRequest != nil {
-		w, err = v.CompleteRequest.ToWire()
+// GetExternalWorkflowExecution returns the value of ExternalWorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelWorkflowExecutionRequest) GetExternalWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.ExternalWorkflowExecution != nil {
+		return v.ExternalWorkflowExecution
+	}
+
+	return
+}
+
+// IsSetExternalWorkflowExecution returns true if ExternalWorkflowExecution is not nil.
+func (v *RequestCancelWorkflowExecutionRequest) IsSetExternalWorkflowExecution() bool {
+	return v != nil && v.ExternalWorkflowExecution != nil
+}
+
+// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool) {
+	if v != nil && v.ChildWorkflowOnly != nil {
+		return *v.ChildWorkflowOnly
+	}
+
+	return
+}
+
+// IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
+func (v *RequestCancelWorkflowExecutionRequest) IsSetChildWorkflowOnly() bool {
+	return v != nil && v.ChildWorkflowOnly != nil
+}
+
+type ResetStickyTaskListRequest struct {
+	DomainUUID *string                   `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a ResetStickyTaskListRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -6600,17 +6619,11 @@ func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondActivityTaskCompletedRequest_Read(w wire.Value) (*shared.RespondActivityTaskCompletedRequest, error) {
-	var v shared.RespondActivityTaskCompletedRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RespondActivityTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a ResetStickyTaskListRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskCompletedRequest struct
+// An error is returned if we were unable to build a ResetStickyTaskListRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6618,12 +6631,12 @@ func _RespondActivityTaskCompletedRequest_Read(w wire.Value) (*shared.RespondAct
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskCompletedRequest
+//   var v ResetStickyTaskListRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -6640,7 +6653,7 @@ func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.CompleteRequest, err = _RespondActivityTaskCompletedRequest_Read(field.Value)
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6652,9 +6665,9 @@ func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskCompletedRequest
+// String returns a readable string representation of a ResetStickyTaskListRequest
 // struct.
-func (v *RespondActivityTaskCompletedRequest) String() string {
+func (v *ResetStickyTaskListRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -6665,19 +6678,19 @@ func (v *RespondActivityTaskCompletedRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.CompleteRequest != nil {
-		fields[i] = fmt.Sprintf("CompleteRequest: %v", v.CompleteRequest)
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetStickyTaskListRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskCompletedRequest match the
-// provided RespondActivityTaskCompletedRequest.
+// Equals returns true if all the fields of this ResetStickyTaskListRequest match the
+// provided ResetStickyTaskListRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCompletedRequest) bool {
+func (v *ResetStickyTaskListRequest) Equals(rhs *ResetStickyTaskListRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6686,7 +6699,7 @@ func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCom
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.CompleteRequest == nil && rhs.CompleteRequest == nil) || (v.CompleteRequest != nil && rhs.CompleteRequest != nil && v.CompleteRequest.Equals(rhs.CompleteRequest))) {
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
 
@@ -6694,23 +6707,23 @@ func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCom
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondActivityTaskCompletedRequest.
-func (v *RespondActivityTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetStickyTaskListRequest.
+func (v *ResetStickyTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.CompleteRequest != nil {
-		err = multierr.Append(err, enc.AddObject("completeRequest", v.CompleteRequest))
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedRequest) GetDomainUUID() (o string) {
+func (v *ResetStickyTaskListRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -6719,31 +6732,29 @@ func (v *RespondActivityTaskCompletedRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RespondActivityTaskCompletedRequest) IsSetDomainUUID() bool {
+func (v *ResetStickyTaskListRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetCompleteRequest returns the value of CompleteRequest if it is set or its
+// GetExecution returns the value of Execution if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedRequest) GetCompleteRequest() (o *shared.RespondActivityTaskCompletedRequest) {
-	if v != nil && v.CompleteRequest != nil {
-		return v.CompleteRequest
+func (v *ResetStickyTaskListRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
 	}
 
 	return
 }
 
-// IsSetCompleteRequest returns true if CompleteRequest is not nil.
-func (v *RespondActivityTaskCompletedRequest) IsSetCompleteRequest() bool {
-	return v != nil && v.CompleteRequest != nil
+// IsSetExecution returns true if Execution is not nil.
+func (v *ResetStickyTaskListRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
 }
 
-type RespondActivityTaskFailedRequest struct {
-	DomainUUID    *string                                  `json:"domainUUID,omitempty"`
-	FailedRequest *shared.RespondActivityTaskFailedRequest `json:"failedRequest,omitempty"`
+type ResetStickyTaskListResponse struct {
 }
 
-// ToWire translates a RespondActivityTaskFailedRequest struct into a Thrift-level intermediate
+// ToWire translates a ResetStickyTaskListResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6758,7 +6769,99 @@ type RespondActivityTaskFailedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
+func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetStickyTaskListResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResetStickyTaskListResponse
+// struct.
+func (v *ResetStickyTaskListResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
+// provided ResetStickyTaskListResponse.
+//
+// This function performs a deep comparison.
+func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResetStickyTaskListResponse.
+func (v *ResetStickyTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+type ResetWorkflowExecutionRequest struct {
+	DomainUUID   *string                               `json:"domainUUID,omitempty"`
+	ResetRequest *shared.ResetWorkflowExecutionRequest `json:"resetRequest,omitempty"`
+}
+
+// ToWire translates a ResetWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -6774,8 +6877,8 @@ func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.FailedRequest != nil {
-		w, err = v.FailedRequest.ToWire()
+	if v.ResetRequest != nil {
+		w, err = v.ResetRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -6786,17 +6889,17 @@ func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondActivityTaskFailedRequest_Read(w wire.Value) (*shared.RespondActivityTaskFailedRequest, error) {
-	var v shared.RespondActivityTaskFailedRequest
+func _ResetWorkflowExecutionRequest_Read(w wire.Value) (*shared.ResetWorkflowExecutionRequest, error) {
+	var v shared.ResetWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RespondActivityTaskFailedRequest struct from its Thrift-level
+// FromWire deserializes a ResetWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskFailedRequest struct
+// An error is returned if we were unable to build a ResetWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6804,12 +6907,12 @@ func _RespondActivityTaskFailedRequest_Read(w wire.Value) (*shared.RespondActivi
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskFailedRequest
+//   var v ResetWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
+func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -6826,7 +6929,7 @@ func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.FailedRequest, err = _RespondActivityTaskFailedRequest_Read(field.Value)
+				v.ResetRequest, err = _ResetWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6838,9 +6941,9 @@ func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskFailedRequest
+// String returns a readable string representation of a ResetWorkflowExecutionRequest
 // struct.
-func (v *RespondActivityTaskFailedRequest) String() string {
+func (v *ResetWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -6851,19 +6954,19 @@ func (v *RespondActivityTaskFailedRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.FailedRequest != nil {
-		fields[i] = fmt.Sprintf("FailedRequest: %v", v.FailedRequest)
+	if v.ResetRequest != nil {
+		fields[i] = fmt.Sprintf("ResetRequest: %v", v.ResetRequest)
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskFailedRequest match the
-// provided RespondActivityTaskFailedRequest.
+// Equals returns true if all the fields of this ResetWorkflowExecutionRequest match the
+// provided ResetWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailedRequest) bool {
+func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6872,7 +6975,7 @@ func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailed
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.FailedRequest == nil && rhs.FailedRequest == nil) || (v.FailedRequest != nil && rhs.FailedRequest != nil && v.FailedRequest.Equals(rhs.FailedRequest))) {
+	if !((v.ResetRequest == nil && rhs.ResetRequest == nil) || (v.ResetRequest != nil && rhs.ResetRequest != nil && v.ResetRequest.Equals(rhs.ResetRequest))) {
 		return false
 	}
 
@@ -6880,23 +6983,23 @@ func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailed
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondActivityTaskFailedRequest.
-func (v *RespondActivityTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetWorkflowExecutionRequest.
+func (v *ResetWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.FailedRequest != nil {
-		err = multierr.Append(err, enc.AddObject("failedRequest", v.FailedRequest))
+	if v.ResetRequest != nil {
+		err = multierr.Append(err, enc.AddObject("resetRequest", v.ResetRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedRequest) GetDomainUUID() (o string) {
+func (v *ResetWorkflowExecutionRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -6905,31 +7008,31 @@ func (v *RespondActivityTaskFailedRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RespondActivityTaskFailedRequest) IsSetDomainUUID() bool {
+func (v *ResetWorkflowExecutionRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetFailedRequest returns the value of FailedRequest if it is set or its
+// GetResetRequest returns the value of ResetRequest if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedRequest) GetFailedRequest() (o *shared.RespondActivityTaskFailedRequest) {
-	if v != nil && v.FailedRequest != nil {
-		return v.FailedRequest
+func (v *ResetWorkflowExecutionRequest) GetResetRequest() (o *shared.ResetWorkflowExecutionRequest) {
+	if v != nil && v.ResetRequest != nil {
+		return v.ResetRequest
 	}
 
 	return
 }
 
-// IsSetFailedRequest returns true if FailedRequest is not nil.
-func (v *RespondActivityTaskFailedRequest) IsSetFailedRequest() bool {
-	return v != nil && v.FailedRequest != nil
+// IsSetResetRequest returns true if ResetRequest is not nil.
+func (v *ResetWorkflowExecutionRequest) IsSetResetRequest() bool {
+	return v != nil && v.ResetRequest != nil
 }
 
-type RespondDecisionTaskCompletedRequest struct {
-	DomainUUID      *string                                     `json:"domainUUID,omitempty"`
-	CompleteRequest *shared.RespondDecisionTaskCompletedRequest `json:"completeRequest,omitempty"`
+type RespondActivityTaskCanceledRequest struct {
+	DomainUUID    *string                                    `json:"domainUUID,omitempty"`
+	CancelRequest *shared.RespondActivityTaskCanceledRequest `json:"cancelRequest,omitempty"`
 }
 
-// ToWire translates a RespondDecisionTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskCanceledRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6944,7 +7047,7 @@ type RespondDecisionTaskCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -6960,8 +7063,8 @@ func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.CompleteRequest != nil {
-		w, err = v.CompleteRequest.ToWire()
+	if v.CancelRequest != nil {
+		w, err = v.CancelRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -6972,17 +7075,17 @@ func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondDecisionTaskCompletedRequest_Read(w wire.Value) (*shared.RespondDecisionTaskCompletedRequest, error) {
-	var v shared.RespondDecisionTaskCompletedRequest
+func _RespondActivityTaskCanceledRequest_Read(w wire.Value) (*shared.RespondActivityTaskCanceledRequest, error) {
+	var v shared.RespondActivityTaskCanceledRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RespondDecisionTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskCanceledRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondDecisionTaskCompletedRequest struct
+// An error is returned if we were unable to build a RespondActivityTaskCanceledRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6990,12 +7093,12 @@ func _RespondDecisionTaskCompletedRequest_Read(w wire.Value) (*shared.RespondDec
 //     return nil, err
 //   }
 //
-//   var v RespondDecisionTaskCompletedRequest
+//   var v RespondActivityTaskCanceledRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -7012,7 +7115,7 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.CompleteRequest, err = _RespondDecisionTaskCompletedRequest_Read(field.Value)
+				v.CancelRequest, err = _RespondActivityTaskCanceledRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7024,9 +7127,9 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondDecisionTaskCompletedRequest
+// String returns a readable string representation of a RespondActivityTaskCanceledRequest
 // struct.
-func (v *RespondDecisionTaskCompletedRequest) String() string {
+func (v *RespondActivityTaskCanceledRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -7037,19 +7140,19 @@ func (v *RespondDecisionTaskCompletedRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.CompleteRequest != nil {
-		fields[i] = fmt.Sprintf("CompleteRequest: %v", v.CompleteRequest)
+	if v.CancelRequest != nil {
+		fields[i] = fmt.Sprintf("CancelRequest: %v", v.CancelRequest)
 		i++
 	}
 
-	return fmt.Sprintf("RespondDecisionTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskCanceledRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondDecisionTaskCompletedRequest match the
-// provided RespondDecisionTaskCompletedRequest.
+// Equals returns true if all the fields of this RespondActivityTaskCanceledRequest match the
+// provided RespondActivityTaskCanceledRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCompletedRequest) bool {
+func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanceledRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7058,7 +7161,7 @@ func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCom
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.CompleteRequest == nil && rhs.CompleteRequest == nil) || (v.CompleteRequest != nil && rhs.CompleteRequest != nil && v.CompleteRequest.Equals(rhs.CompleteRequest))) {
+	if !((v.CancelRequest == nil && rhs.CancelRequest == nil) || (v.CancelRequest != nil && rhs.CancelRequest != nil && v.CancelRequest.Equals(rhs.CancelRequest))) {
 		return false
 	}
 
@@ -7066,23 +7169,23 @@ func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCom
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondDecisionTaskCompletedRequest.
-func (v *RespondDecisionTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondActivityTaskCanceledRequest.
+func (v *RespondActivityTaskCanceledRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.CompleteRequest != nil {
-		err = multierr.Append(err, enc.AddObject("completeRequest", v.CompleteRequest))
+	if v.CancelRequest != nil {
+		err = multierr.Append(err, enc.AddObject("cancelRequest", v.CancelRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetDomainUUID() (o string) {
+func (v *RespondActivityTaskCanceledRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -7091,30 +7194,31 @@ func (v *RespondDecisionTaskCompletedRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetDomainUUID() bool {
+func (v *RespondActivityTaskCanceledRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetCompleteRequest returns the value of CompleteRequest if it is set or its
+// GetCancelRequest returns the value of CancelRequest if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetCompleteRequest() (o *shared.RespondDecisionTaskCompletedRequest) {
-	if v != nil && v.CompleteRequest != nil {
-		return v.CompleteRequest
+func (v *RespondActivityTaskCanceledRequest) GetCancelRequest() (o *shared.RespondActivityTaskCanceledRequest) {
+	if v != nil && v.CancelRequest != nil {
+		return v.CancelRequest
 	}
 
 	return
 }
 
-// IsSetCompleteRequest returns true if CompleteRequest is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetCompleteRequest() bool {
-	return v != nil && v.CompleteRequest != nil
+// IsSetCancelRequest returns true if CancelRequest is not nil.
+func (v *RespondActivityTaskCanceledRequest) IsSetCancelRequest() bool {
+	return v != nil && v.CancelRequest != nil
 }
 
-type RespondDecisionTaskCompletedResponse struct {
-	StartedResponse *RecordDecisionTaskStartedResponse `json:"startedResponse,omitempty"`
+type RespondActivityTaskCompletedRequest struct {
+	DomainUUID      *string                                     `json:"domainUUID,omitempty"`
+	CompleteRequest *shared.RespondActivityTaskCompletedRequest `json:"completeRequest,omitempty"`
 }
 
-// ToWire translates a RespondDecisionTaskCompletedResponse struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7129,37 +7233,45 @@ type RespondDecisionTaskCompletedResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondDecisionTaskCompletedResponse) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.StartedResponse != nil {
-		w, err = v.StartedResponse.ToWire()
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.CompleteRequest != nil {
+		w, err = v.CompleteRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RecordDecisionTaskStartedResponse_Read(w wire.Value) (*RecordDecisionTaskStartedResponse, error) {
-	var v RecordDecisionTaskStartedResponse
+func _RespondActivityTaskCompletedRequest_Read(w wire.Value) (*shared.RespondActivityTaskCompletedRequest, error) {
+	var v shared.RespondActivityTaskCompletedRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RespondDecisionTaskCompletedResponse struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondDecisionTaskCompletedResponse struct
+// An error is returned if we were unable to build a RespondActivityTaskCompletedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7167,19 +7279,29 @@ func _RecordDecisionTaskStartedResponse_Read(w wire.Value) (*RecordDecisionTaskS
 //     return nil, err
 //   }
 //
-//   var v RespondDecisionTaskCompletedResponse
+//   var v RespondActivityTaskCompletedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondDecisionTaskCompletedResponse) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.StartedResponse, err = _RecordDecisionTaskStartedResponse_Read(field.Value)
+				v.CompleteRequest, err = _RespondActivityTaskCompletedRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7191,34 +7313,41 @@ func (v *RespondDecisionTaskCompletedResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondDecisionTaskCompletedResponse
+// String returns a readable string representation of a RespondActivityTaskCompletedRequest
 // struct.
-func (v *RespondDecisionTaskCompletedResponse) String() string {
+func (v *RespondActivityTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	if v.StartedResponse != nil {
-		fields[i] = fmt.Sprintf("StartedResponse: %v", v.StartedResponse)
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.CompleteRequest != nil {
+		fields[i] = fmt.Sprintf("CompleteRequest: %v", v.CompleteRequest)
 		i++
 	}
 
-	return fmt.Sprintf("RespondDecisionTaskCompletedResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondDecisionTaskCompletedResponse match the
-// provided RespondDecisionTaskCompletedResponse.
+// Equals returns true if all the fields of this RespondActivityTaskCompletedRequest match the
+// provided RespondActivityTaskCompletedRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondDecisionTaskCompletedResponse) Equals(rhs *RespondDecisionTaskCompletedResponse) bool {
+func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCompletedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.StartedResponse == nil && rhs.StartedResponse == nil) || (v.StartedResponse != nil && rhs.StartedResponse != nil && v.StartedResponse.Equals(rhs.StartedResponse))) {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.CompleteRequest == nil && rhs.CompleteRequest == nil) || (v.CompleteRequest != nil && rhs.CompleteRequest != nil && v.CompleteRequest.Equals(rhs.CompleteRequest))) {
 		return false
 	}
 
@@ -7226,38 +7355,56 @@ func (v *RespondDecisionTaskCompletedResponse) Equals(rhs *RespondDecisionTaskCo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondDecisionTaskCompletedResponse.
-func (v *RespondDecisionTaskCompletedResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondActivityTaskCompletedRequest.
+func (v *RespondActivityTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.StartedResponse != nil {
-		err = multierr.Append(err, enc.AddObject("startedResponse", v.StartedResponse))
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.CompleteRequest != nil {
+		err = multierr.Append(err, enc.AddObject("completeRequest", v.CompleteRequest))
 	}
 	return err
 }
 
-// GetStartedResponse returns the value of StartedResponse if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskCompletedResponse) GetStartedResponse() (o *RecordDecisionTaskStartedResponse) {
-	if v != nil && v.StartedResponse != nil {
-		return v.StartedResponse
+func (v *RespondActivityTaskCompletedRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// IsSetStartedResponse returns true if StartedResponse is not nil.
-func (v *RespondDecisionTaskCompletedResponse) IsSetStartedResponse() bool {
-	return v != nil && v.StartedResponse != nil
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *RespondActivityTaskCompletedRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
 }
 
-type RespondDecisionTaskFailedRequest struct {
+// GetCompleteRequest returns the value of CompleteRequest if it is set or its
+// zero value if it is unset.
+func (v *RespondActivityTaskCompletedRequest) GetCompleteRequest() (o *shared.RespondActivityTaskCompletedRequest) {
+	if v != nil && v.CompleteRequest != nil {
+		return v.CompleteRequest
+	}
+
+	return
+}
+
+// IsSetCompleteRequest returns true if CompleteRequest is not nil.
+func (v *RespondActivityTaskCompletedRequest) IsSetCompleteRequest() bool {
+	return v != nil && v.CompleteRequest != nil
+}
+
+type RespondActivityTaskFailedRequest struct {
 	DomainUUID    *string                                  `json:"domainUUID,omitempty"`
-	FailedRequest *shared.RespondDecisionTaskFailedRequest `json:"failedRequest,omitempty"`
+	FailedRequest *shared.RespondActivityTaskFailedRequest `json:"failedRequest,omitempty"`
 }
 
-// ToWire translates a RespondDecisionTaskFailedRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskFailedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7272,7 +7419,7 @@ type RespondDecisionTaskFailedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -7300,17 +7447,17 @@ func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondDecisionTaskFailedRequest_Read(w wire.Value) (*shared.RespondDecisionTaskFailedRequest, error) {
-	var v shared.RespondDecisionTaskFailedRequest
+func _RespondActivityTaskFailedRequest_Read(w wire.Value) (*shared.RespondActivityTaskFailedRequest, error) {
+	var v shared.RespondActivityTaskFailedRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RespondDecisionTaskFailedRequest struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskFailedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondDecisionTaskFailedRequest struct
+// An error is returned if we were unable to build a RespondActivityTaskFailedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7318,12 +7465,12 @@ func _RespondDecisionTaskFailedRequest_Read(w wire.Value) (*shared.RespondDecisi
 //     return nil, err
 //   }
 //
-//   var v RespondDecisionTaskFailedRequest
+//   var v RespondActivityTaskFailedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -7340,7 +7487,7 @@ func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.FailedRequest, err = _RespondDecisionTaskFailedRequest_Read(field.Value)
+				v.FailedRequest, err = _RespondActivityTaskFailedRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7352,9 +7499,9 @@ func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondDecisionTaskFailedRequest
+// String returns a readable string representation of a RespondActivityTaskFailedRequest
 // struct.
-func (v *RespondDecisionTaskFailedRequest) String() string {
+func (v *RespondActivityTaskFailedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -7370,14 +7517,14 @@ func (v *RespondDecisionTaskFailedRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("RespondDecisionTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondDecisionTaskFailedRequest match the
-// provided RespondDecisionTaskFailedRequest.
+// Equals returns true if all the fields of this RespondActivityTaskFailedRequest match the
+// provided RespondActivityTaskFailedRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondDecisionTaskFailedRequest) Equals(rhs *RespondDecisionTaskFailedRequest) bool {
+func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7394,8 +7541,8 @@ func (v *RespondDecisionTaskFailedRequest) Equals(rhs *RespondDecisionTaskFailed
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondDecisionTaskFailedRequest.
-func (v *RespondDecisionTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondActivityTaskFailedRequest.
+func (v *RespondActivityTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -7410,7 +7557,7 @@ func (v *RespondDecisionTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEn
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskFailedRequest) GetDomainUUID() (o string) {
+func (v *RespondActivityTaskFailedRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -7419,13 +7566,13 @@ func (v *RespondDecisionTaskFailedRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RespondDecisionTaskFailedRequest) IsSetDomainUUID() bool {
+func (v *RespondActivityTaskFailedRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
 // GetFailedRequest returns the value of FailedRequest if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskFailedRequest) GetFailedRequest() (o *shared.RespondDecisionTaskFailedRequest) {
+func (v *RespondActivityTaskFailedRequest) GetFailedRequest() (o *shared.RespondActivityTaskFailedRequest) {
 	if v != nil && v.FailedRequest != nil {
 		return v.FailedRequest
 	}
@@ -7434,17 +7581,16 @@ func (v *RespondDecisionTaskFailedRequest) GetFailedRequest() (o *shared.Respond
 }
 
 // IsSetFailedRequest returns true if FailedRequest is not nil.
-func (v *RespondDecisionTaskFailedRequest) IsSetFailedRequest() bool {
+func (v *RespondActivityTaskFailedRequest) IsSetFailedRequest() bool {
 	return v != nil && v.FailedRequest != nil
 }
 
-type ScheduleDecisionTaskRequest struct {
-	DomainUUID        *string                   `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
-	IsFirstDecision   *bool                     `json:"isFirstDecision,omitempty"`
+type RespondDecisionTaskCompletedRequest struct {
+	DomainUUID      *string                                     `json:"domainUUID,omitempty"`
+	CompleteRequest *shared.RespondDecisionTaskCompletedRequest `json:"completeRequest,omitempty"`
 }
 
-// ToWire translates a ScheduleDecisionTaskRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondDecisionTaskCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7459,9 +7605,9 @@ type ScheduleDecisionTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ScheduleDecisionTaskRequest) ToWire() (wire.Value, error) {
+func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7475,31 +7621,29 @@ func (v *ScheduleDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.CompleteRequest != nil {
+		w, err = v.CompleteRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.IsFirstDecision != nil {
-		w, err = wire.NewValueBool(*(v.IsFirstDecision)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ScheduleDecisionTaskRequest struct from its Thrift-level
+func _RespondDecisionTaskCompletedRequest_Read(w wire.Value) (*shared.RespondDecisionTaskCompletedRequest, error) {
+	var v shared.RespondDecisionTaskCompletedRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondDecisionTaskCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ScheduleDecisionTaskRequest struct
+// An error is returned if we were unable to build a RespondDecisionTaskCompletedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7507,12 +7651,12 @@ func (v *ScheduleDecisionTaskRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ScheduleDecisionTaskRequest
+//   var v RespondDecisionTaskCompletedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ScheduleDecisionTaskRequest) FromWire(w wire.Value) error {
+func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -7529,17 +7673,7 @@ func (v *ScheduleDecisionTaskRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.IsFirstDecision = &x
+				v.CompleteRequest, err = _RespondDecisionTaskCompletedRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7551,36 +7685,32 @@ func (v *ScheduleDecisionTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ScheduleDecisionTaskRequest
+// String returns a readable string representation of a RespondDecisionTaskCompletedRequest
 // struct.
-func (v *ScheduleDecisionTaskRequest) String() string {
+func (v *RespondDecisionTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.IsFirstDecision != nil {
-		fields[i] = fmt.Sprintf("IsFirstDecision: %v", *(v.IsFirstDecision))
+	if v.CompleteRequest != nil {
+		fields[i] = fmt.Sprintf("CompleteRequest: %v", v.CompleteRequest)
 		i++
 	}
 
-	return fmt.Sprintf("ScheduleDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondDecisionTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ScheduleDecisionTaskRequest match the
-// provided ScheduleDecisionTaskRequest.
+// Equals returns true if all the fields of this RespondDecisionTaskCompletedRequest match the
+// provided RespondDecisionTaskCompletedRequest.
 //
 // This function performs a deep comparison.
-func (v *ScheduleDecisionTaskRequest) Equals(rhs *ScheduleDecisionTaskRequest) bool {
+func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCompletedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7589,10 +7719,7 @@ func (v *ScheduleDecisionTaskRequest) Equals(rhs *ScheduleDecisionTaskRequest) b
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.IsFirstDecision, rhs.IsFirstDecision) {
+	if !((v.CompleteRequest == nil && rhs.CompleteRequest == nil) || (v.CompleteRequest != nil && rhs.CompleteRequest != nil && v.CompleteRequest.Equals(rhs.CompleteRequest))) {
 		return false
 	}
 
@@ -7600,26 +7727,23 @@ func (v *ScheduleDecisionTaskRequest) Equals(rhs *ScheduleDecisionTaskRequest) b
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ScheduleDecisionTaskRequest.
-func (v *ScheduleDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondDecisionTaskCompletedRequest.
+func (v *RespondDecisionTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
-	}
-	if v.IsFirstDecision != nil {
-		enc.AddBool("isFirstDecision", *v.IsFirstDecision)
+	if v.CompleteRequest != nil {
+		err = multierr.Append(err, enc.AddObject("completeRequest", v.CompleteRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *ScheduleDecisionTaskRequest) GetDomainUUID() (o string) {
+func (v *RespondDecisionTaskCompletedRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -7628,46 +7752,30 @@ func (v *ScheduleDecisionTaskRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *ScheduleDecisionTaskRequest) IsSetDomainUUID() bool {
+func (v *RespondDecisionTaskCompletedRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
-// zero value if it is unset.
-func (v *ScheduleDecisionTaskRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
-	}
-
-	return
-}
-
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *ScheduleDecisionTaskRequest) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
-}
-
-// GetIsFirstDecision returns the value of IsFirstDecision if it is set or its
+// GetCompleteRequest returns the value of CompleteRequest if it is set or its
 // zero value if it is unset.
-func (v *ScheduleDecisionTaskRequest) GetIsFirstDecision() (o bool) {
-	if v != nil && v.IsFirstDecision != nil {
-		return *v.IsFirstDecision
+func (v *RespondDecisionTaskCompletedRequest) GetCompleteRequest() (o *shared.RespondDecisionTaskCompletedRequest) {
+	if v != nil && v.CompleteRequest != nil {
+		return v.CompleteRequest
 	}
 
 	return
 }
 
-// IsSetIsFirstDecision returns true if IsFirstDecision is not nil.
-func (v *ScheduleDecisionTaskRequest) IsSetIsFirstDecision() bool {
-	return v != nil && v.IsFirstDecision != nil
+// IsSetCompleteRequest returns true if CompleteRequest is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetCompleteRequest() bool {
+	return v != nil && v.CompleteRequest != nil
 }
 
-type ShardOwnershipLostError struct {
-	Message *string `json:"message,omitempty"`
-	Owner   *string `json:"owner,omitempty"`
+type RespondDecisionTaskCompletedResponse struct {
+	StartedResponse *RecordDecisionTaskStartedResponse `json:"startedResponse,omitempty"`
 }
 
-// ToWire translates a ShardOwnershipLostError struct into a Thrift-level intermediate
+// ToWire translates a RespondDecisionTaskCompletedResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7682,39 +7790,37 @@ type ShardOwnershipLostError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ShardOwnershipLostError) ToWire() (wire.Value, error) {
+func (v *RespondDecisionTaskCompletedResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Message != nil {
-		w, err = wire.NewValueString(*(v.Message)), error(nil)
+	if v.StartedResponse != nil {
+		w, err = v.StartedResponse.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Owner != nil {
-		w, err = wire.NewValueString(*(v.Owner)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ShardOwnershipLostError struct from its Thrift-level
+func _RecordDecisionTaskStartedResponse_Read(w wire.Value) (*RecordDecisionTaskStartedResponse, error) {
+	var v RecordDecisionTaskStartedResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondDecisionTaskCompletedResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ShardOwnershipLostError struct
+// An error is returned if we were unable to build a RespondDecisionTaskCompletedResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7722,31 +7828,19 @@ func (v *ShardOwnershipLostError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ShardOwnershipLostError
+//   var v RespondDecisionTaskCompletedResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
+func (v *RespondDecisionTaskCompletedResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Message = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Owner = &x
+			if field.Value.Type() == wire.TStruct {
+				v.StartedResponse, err = _RecordDecisionTaskStartedResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7758,41 +7852,34 @@ func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ShardOwnershipLostError
+// String returns a readable string representation of a RespondDecisionTaskCompletedResponse
 // struct.
-func (v *ShardOwnershipLostError) String() string {
+func (v *RespondDecisionTaskCompletedResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.Message != nil {
-		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
-		i++
-	}
-	if v.Owner != nil {
-		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
+	if v.StartedResponse != nil {
+		fields[i] = fmt.Sprintf("StartedResponse: %v", v.StartedResponse)
 		i++
 	}
 
-	return fmt.Sprintf("ShardOwnershipLostError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondDecisionTaskCompletedResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ShardOwnershipLostError match the
-// provided ShardOwnershipLostError.
+// Equals returns true if all the fields of this RespondDecisionTaskCompletedResponse match the
+// provided RespondDecisionTaskCompletedResponse.
 //
 // This function performs a deep comparison.
-func (v *ShardOwnershipLostError) Equals(rhs *ShardOwnershipLostError) bool {
+func (v *RespondDecisionTaskCompletedResponse) Equals(rhs *RespondDecisionTaskCompletedResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Message, rhs.Message) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
+	if !((v.StartedResponse == nil && rhs.StartedResponse == nil) || (v.StartedResponse != nil && rhs.StartedResponse != nil && v.StartedResponse.Equals(rhs.StartedResponse))) {
 		return false
 	}
 
@@ -7800,60 +7887,38 @@ func (v *ShardOwnershipLostError) Equals(rhs *ShardOwnershipLostError) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ShardOwnershipLostError.
-func (v *ShardOwnershipLostError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondDecisionTaskCompletedResponse.
+func (v *RespondDecisionTaskCompletedResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Message != nil {
-		enc.AddString("message", *v.Message)
-	}
-	if v.Owner != nil {
-		enc.AddString("owner", *v.Owner)
+	if v.StartedResponse != nil {
+		err = multierr.Append(err, enc.AddObject("startedResponse", v.StartedResponse))
 	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetStartedResponse returns the value of StartedResponse if it is set or its
 // zero value if it is unset.
-func (v *ShardOwnershipLostError) GetMessage() (o string) {
-	if v != nil && v.Message != nil {
-		return *v.Message
+func (v *RespondDecisionTaskCompletedResponse) GetStartedResponse() (o *RecordDecisionTaskStartedResponse) {
+	if v != nil && v.StartedResponse != nil {
+		return v.StartedResponse
 	}
 
 	return
 }
 
-// IsSetMessage returns true if Message is not nil.
-func (v *ShardOwnershipLostError) IsSetMessage() bool {
-	return v != nil && v.Message != nil
-}
-
-// GetOwner returns the value of Owner if it is set or its
-// zero value if it is unset.
-func (v *ShardOwnershipLostError) GetOwner() (o string) {
-	if v != nil && v.Owner != nil {
-		return *v.Owner
-	}
-
-	return
+// IsSetStartedResponse returns true if StartedResponse is not nil.
+func (v *RespondDecisionTaskCompletedResponse) IsSetStartedResponse() bool {
+	return v != nil && v.StartedResponse != nil
 }
 
-// IsSetOwner returns true if Owner is not nil.
-func (v *ShardOwnershipLostError) IsSetOwner() bool {
-	return v != nil && v.Owner != nil
+type RespondDecisionTaskFailedRequest struct {
+	DomainUUID    *string                                  `json:"domainUUID,omitempty"`
+	FailedRequest *shared.RespondDecisionTaskFailedRequest `json:"failedRequest,omitempty"`
 }
 
-func (v *ShardOwnershipLostError) Error() string {
-	return v.String()
-}
-
-type SignalWithStartWorkflowExecutionRequest struct {
-	DomainUUID             *string                                         `json:"domainUUID,omitempty"`
-	SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest `json:"signalWithStartRequest,omitempty"`
-}
-
-// ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondDecisionTaskFailedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7868,7 +7933,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -7884,8 +7949,8 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.SignalWithStartRequest != nil {
-		w, err = v.SignalWithStartRequest.ToWire()
+	if v.FailedRequest != nil {
+		w, err = v.FailedRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -7896,17 +7961,17 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _SignalWithStartWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWithStartWorkflowExecutionRequest, error) {
-	var v shared.SignalWithStartWorkflowExecutionRequest
+func _RespondDecisionTaskFailedRequest_Read(w wire.Value) (*shared.RespondDecisionTaskFailedRequest, error) {
+	var v shared.RespondDecisionTaskFailedRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a SignalWithStartWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a RespondDecisionTaskFailedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SignalWithStartWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a RespondDecisionTaskFailedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7914,12 +7979,12 @@ func _SignalWithStartWorkflowExecutionRequest_Read(w wire.Value) (*shared.Signal
 //     return nil, err
 //   }
 //
-//   var v SignalWithStartWorkflowExecutionRequest
+//   var v RespondDecisionTaskFailedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -7936,7 +8001,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.SignalWithStartRequest, err = _SignalWithStartWorkflowExecutionRequest_Read(field.Value)
+				v.FailedRequest, err = _RespondDecisionTaskFailedRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7948,9 +8013,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a SignalWithStartWorkflowExecutionRequest
+// String returns a readable string representation of a RespondDecisionTaskFailedRequest
 // struct.
-func (v *SignalWithStartWorkflowExecutionRequest) String() string {
+func (v *RespondDecisionTaskFailedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -7961,19 +8026,19 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.SignalWithStartRequest != nil {
-		fields[i] = fmt.Sprintf("SignalWithStartRequest: %v", v.SignalWithStartRequest)
+	if v.FailedRequest != nil {
+		fields[i] = fmt.Sprintf("FailedRequest: %v", v.FailedRequest)
 		i++
 	}
 
-	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondDecisionTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SignalWithStartWorkflowExecutionRequest match the
-// provided SignalWithStartWorkflowExecutionRequest.
+// Equals returns true if all the fields of this RespondDecisionTaskFailedRequest match the
+// provided RespondDecisionTaskFailedRequest.
 //
 // This function performs a deep comparison.
-func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWorkflowExecutionRequest) bool {
+func (v *RespondDecisionTaskFailedRequest) Equals(rhs *RespondDecisionTaskFailedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7982,7 +8047,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.SignalWithStartRequest == nil && rhs.SignalWithStartRequest == nil) || (v.SignalWithStartRequest != nil && rhs.SignalWithStartRequest != nil && v.SignalWithStartRequest.Equals(rhs.SignalWithStartRequest))) {
+	if !((v.FailedRequest == nil && rhs.FailedRequest == nil) || (v.FailedRequest != nil && rhs.FailedRequest != nil && v.FailedRequest.Equals(rhs.FailedRequest))) {
 		return false
 	}
 
@@ -7990,23 +8055,23 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SignalWithStartWorkflowExecutionRequest.
-func (v *SignalWithStartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondDecisionTaskFailedRequest.
+func (v *RespondDecisionTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.SignalWithStartRequest != nil {
-		err = multierr.Append(err, enc.AddObject("signalWithStartRequest", v.SignalWithStartRequest))
+	if v.FailedRequest != nil {
+		err = multierr.Append(err, enc.AddObject("failedRequest", v.FailedRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *SignalWithStartWorkflowExecutionRequest) GetDomainUUID() (o string) {
+func (v *RespondDecisionTaskFailedRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -8015,33 +8080,32 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *SignalWithStartWorkflowExecutionRequest) IsSetDomainUUID() bool {
+func (v *RespondDecisionTaskFailedRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetSignalWithStartRequest returns the value of SignalWithStartRequest if it is set or its
+// GetFailedRequest returns the value of FailedRequest if it is set or its
 // zero value if it is unset.
-func (v *SignalWithStartWorkflowExecutionRequest) GetSignalWithStartRequest() (o *shared.SignalWithStartWorkflowExecutionRequest) {
-	if v != nil && v.SignalWithStartRequest != nil {
-		return v.SignalWithStartRequest
+func (v *RespondDecisionTaskFailedRequest) GetFailedRequest() (o *shared.RespondDecisionTaskFailedRequest) {
+	if v != nil && v.FailedRequest != nil {
+		return v.FailedRequest
 	}
 
 	return
 }
 
-// IsSetSignalWithStartRequest returns true if SignalWithStartRequest is not nil.
-func (v *SignalWithStartWorkflowExecutionRequest) IsSetSignalWithStartRequest() bool {
-	return v != nil && v.SignalWithStartRequest != nil
+// IsSetFailedRequest returns true if FailedRequest is not nil.
+func (v *RespondDecisionTaskFailedRequest) IsSetFailedRequest() bool {
+	return v != nil && v.FailedRequest != nil
 }
 
-type SignalWorkflowExecutionRequest struct {
-	DomainUUID                *string                                `json:"domainUUID,omitempty"`
-	SignalRequest             *shared.SignalWorkflowExecutionRequest `json:"signalRequest,omitempty"`
-	ExternalWorkflowExecution *shared.WorkflowExecution              `json:"externalWorkflowExecution,omitempty"`
-	ChildWorkflowOnly         *bool                                  `json:"childWorkflowOnly,omitempty"`
+type ScheduleDecisionTaskRequest struct {
+	DomainUUID        *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+	IsFirstDecision   *bool                     `json:"isFirstDecision,omitempty"`
 }
 
-// ToWire translates a SignalWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a ScheduleDecisionTaskRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8056,9 +8120,9 @@ type SignalWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *SignalWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *ScheduleDecisionTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8072,45 +8136,31 @@ func (v *SignalWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.SignalRequest != nil {
-		w, err = v.SignalRequest.ToWire()
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ExternalWorkflowExecution != nil {
-		w, err = v.ExternalWorkflowExecution.ToWire()
+	if v.IsFirstDecision != nil {
+		w, err = wire.NewValueBool(*(v.IsFirstDecision)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ChildWorkflowOnly != nil {
-		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _SignalWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWorkflowExecutionRequest, error) {
-	var v shared.SignalWorkflowExecutionRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a SignalWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a ScheduleDecisionTaskRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SignalWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a ScheduleDecisionTaskRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8118,12 +8168,12 @@ func _SignalWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWorkflowE
 //     return nil, err
 //   }
 //
-//   var v SignalWorkflowExecutionRequest
+//   var v ScheduleDecisionTaskRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *SignalWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *ScheduleDecisionTaskRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -8140,25 +8190,17 @@ func (v *SignalWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.SignalRequest, err = _SignalWorkflowExecutionRequest_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
 			if field.Value.Type() == wire.TBool {
 				var x bool
 				x, err = field.Value.GetBool(), error(nil)
-				v.ChildWorkflowOnly = &x
+				v.IsFirstDecision = &x
 				if err != nil {
 					return err
 				}
@@ -8170,40 +8212,36 @@ func (v *SignalWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a SignalWorkflowExecutionRequest
+// String returns a readable string representation of a ScheduleDecisionTaskRequest
 // struct.
-func (v *SignalWorkflowExecutionRequest) String() string {
+func (v *ScheduleDecisionTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.SignalRequest != nil {
-		fields[i] = fmt.Sprintf("SignalRequest: %v", v.SignalRequest)
-		i++
-	}
-	if v.ExternalWorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.ChildWorkflowOnly != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
+	if v.IsFirstDecision != nil {
+		fields[i] = fmt.Sprintf("IsFirstDecision: %v", *(v.IsFirstDecision))
 		i++
 	}
 
-	return fmt.Sprintf("SignalWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ScheduleDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SignalWorkflowExecutionRequest match the
-// provided SignalWorkflowExecutionRequest.
+// Equals returns true if all the fields of this ScheduleDecisionTaskRequest match the
+// provided ScheduleDecisionTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *SignalWorkflowExecutionRequest) Equals(rhs *SignalWorkflowExecutionRequest) bool {
+func (v *ScheduleDecisionTaskRequest) Equals(rhs *ScheduleDecisionTaskRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -8212,13 +8250,10 @@ func (v *SignalWorkflowExecutionRequest) Equals(rhs *SignalWorkflowExecutionRequ
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.SignalRequest == nil && rhs.SignalRequest == nil) || (v.SignalRequest != nil && rhs.SignalRequest != nil && v.SignalRequest.Equals(rhs.SignalRequest))) {
-		return false
-	}
-	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+	if !_Bool_EqualsPtr(v.IsFirstDecision, rhs.IsFirstDecision) {
 		return false
 	}
 
@@ -8226,29 +8261,26 @@ func (v *SignalWorkflowExecutionRequest) Equals(rhs *SignalWorkflowExecutionRequ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SignalWorkflowExecutionRequest.
-func (v *SignalWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ScheduleDecisionTaskRequest.
+func (v *ScheduleDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.SignalRequest != nil {
-		err = multierr.Append(err, enc.AddObject("signalRequest", v.SignalRequest))
-	}
-	if v.ExternalWorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("externalWorkflowExecution", v.ExternalWorkflowExecution))
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
-	if v.ChildWorkflowOnly != nil {
-		enc.AddBool("childWorkflowOnly", *v.ChildWorkflowOnly)
+	if v.IsFirstDecision != nil {
+		enc.AddBool("isFirstDecision", *v.IsFirstDecision)
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *SignalWorkflowExecutionRequest) GetDomainUUID() (o string) {
+func (v *ScheduleDecisionTaskRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -8257,69 +8289,46 @@ func (v *SignalWorkflowExecutionRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *SignalWorkflowExecutionRequest) IsSetDomainUUID() bool {
+func (v *ScheduleDecisionTaskRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetSignalRequest returns the value of SignalRequest if it is set or its
-// zero value if it is unset.
-func (v *SignalWorkflowExecutionRequest) GetSignalRequest() (o *shared.SignalWorkflowExecutionRequest) {
-	if v != nil && v.SignalRequest != nil {
-		return v.SignalRequest
-	}
-
-	return
-}
-
-// IsSetSignalRequest returns true if SignalRequest is not nil.
-func (v *SignalWorkflowExecutionRequest) IsSetSignalRequest() bool {
-	return v != nil && v.SignalRequest != nil
-}
-
-// GetExternalWorkflowExecution returns the value of ExternalWorkflowExecution if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *SignalWorkflowExecutionRequest) GetExternalWorkflowExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.ExternalWorkflowExecution != nil {
-		return v.ExternalWorkflowExecution
+func (v *ScheduleDecisionTaskRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetExternalWorkflowExecution returns true if ExternalWorkflowExecution is not nil.
-func (v *SignalWorkflowExecutionRequest) IsSetExternalWorkflowExecution() bool {
-	return v != nil && v.ExternalWorkflowExecution != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ScheduleDecisionTaskRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// GetIsFirstDecision returns the value of IsFirstDecision if it is set or its
 // zero value if it is unset.
-func (v *SignalWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool) {
-	if v != nil && v.ChildWorkflowOnly != nil {
-		return *v.ChildWorkflowOnly
+func (v *ScheduleDecisionTaskRequest) GetIsFirstDecision() (o bool) {
+	if v != nil && v.IsFirstDecision != nil {
+		return *v.IsFirstDecision
 	}
 
 	return
 }
 
-// IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
-func (v *SignalWorkflowExecutionRequest) IsSetChildWorkflowOnly() bool {
-	return v != nil && v.ChildWorkflowOnly != nil
+// IsSetIsFirstDecision returns true if IsFirstDecision is not nil.
+func (v *ScheduleDecisionTaskRequest) IsSetIsFirstDecision() bool {
+	return v != nil && v.IsFirstDecision != nil
 }
 
-type StartWorkflowExecutionRequest struct {
-	DomainUUID                      *string                               `json:"domainUUID,omitempty"`
-	StartRequest                    *shared.StartWorkflowExecutionRequest `json:"startRequest,omitempty"`
-	ParentExecutionInfo             *ParentExecutionInfo                  `json:"parentExecutionInfo,omitempty"`
-	Attempt                         *int32                                `json:"attempt,omitempty"`
-	ExpirationTimestamp             *int64                                `json:"expirationTimestamp,omitempty"`
-	ContinueAsNewInitiator          *shared.ContinueAsNewInitiator        `json:"continueAsNewInitiator,omitempty"`
-	ContinuedFailureReason          *string                               `json:"continuedFailureReason,omitempty"`
-	ContinuedFailureDetails         []byte                                `json:"continuedFailureDetails,omitempty"`
-	LastCompletionResult            []byte                                `json:"lastCompletionResult,omitempty"`
-	FirstDecisionTaskBackoffSeconds *int32                                `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+type ShardOwnershipLostError struct {
+	Message *string `json:"message,omitempty"`
+	Owner   *string `json:"owner,omitempty"`
 }
 
-// ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a ShardOwnershipLostError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8334,121 +8343,39 @@ type StartWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *ShardOwnershipLostError) ToWire() (wire.Value, error) {
 	var (
-		fields [10]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartRequest != nil {
-		w, err = v.StartRequest.ToWire()
+	if v.Owner != nil {
+		w, err = wire.NewValueString(*(v.Owner)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ParentExecutionInfo != nil {
-		w, err = v.ParentExecutionInfo.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ExpirationTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.ContinueAsNewInitiator != nil {
-		w, err = v.ContinueAsNewInitiator.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 55, Value: w}
-		i++
-	}
-	if v.ContinuedFailureReason != nil {
-		w, err = wire.NewValueString(*(v.ContinuedFailureReason)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 56, Value: w}
-		i++
-	}
-	if v.ContinuedFailureDetails != nil {
-		w, err = wire.NewValueBinary(v.ContinuedFailureDetails), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 57, Value: w}
-		i++
-	}
-	if v.LastCompletionResult != nil {
-		w, err = wire.NewValueBinary(v.LastCompletionResult), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 58, Value: w}
-		i++
-	}
-	if v.FirstDecisionTaskBackoffSeconds != nil {
-		w, err = wire.NewValueI32(*(v.FirstDecisionTaskBackoffSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StartWorkflowExecutionRequest_Read(w wire.Value) (*shared.StartWorkflowExecutionRequest, error) {
-	var v shared.StartWorkflowExecutionRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ParentExecutionInfo_Read(w wire.Value) (*ParentExecutionInfo, error) {
-	var v ParentExecutionInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ContinueAsNewInitiator_Read(w wire.Value) (shared.ContinueAsNewInitiator, error) {
-	var v shared.ContinueAsNewInitiator
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a StartWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a ShardOwnershipLostError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a StartWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a ShardOwnershipLostError struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8456,12 +8383,12 @@ func _ContinueAsNewInitiator_Read(w wire.Value) (shared.ContinueAsNewInitiator,
 //     return nil, err
 //   }
 //
-//   var v StartWorkflowExecutionRequest
+//   var v ShardOwnershipLostError
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -8470,89 +8397,17 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+				v.Message = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.StartRequest, err = _StartWorkflowExecutionRequest_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.ParentExecutionInfo, err = _ParentExecutionInfo_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ExpirationTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 55:
-			if field.Value.Type() == wire.TI32 {
-				var x shared.ContinueAsNewInitiator
-				x, err = _ContinueAsNewInitiator_Read(field.Value)
-				v.ContinueAsNewInitiator = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 56:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ContinuedFailureReason = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 57:
-			if field.Value.Type() == wire.TBinary {
-				v.ContinuedFailureDetails, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 58:
-			if field.Value.Type() == wire.TBinary {
-				v.LastCompletionResult, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.FirstDecisionTaskBackoffSeconds = &x
+				v.Owner = &x
 				if err != nil {
 					return err
 				}
@@ -8564,107 +8419,41 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a StartWorkflowExecutionRequest
+// String returns a readable string representation of a ShardOwnershipLostError
 // struct.
-func (v *StartWorkflowExecutionRequest) String() string {
+func (v *ShardOwnershipLostError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [10]string
+	var fields [2]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.StartRequest != nil {
-		fields[i] = fmt.Sprintf("StartRequest: %v", v.StartRequest)
-		i++
-	}
-	if v.ParentExecutionInfo != nil {
-		fields[i] = fmt.Sprintf("ParentExecutionInfo: %v", v.ParentExecutionInfo)
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.ExpirationTimestamp != nil {
-		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
-		i++
-	}
-	if v.ContinueAsNewInitiator != nil {
-		fields[i] = fmt.Sprintf("ContinueAsNewInitiator: %v", *(v.ContinueAsNewInitiator))
-		i++
-	}
-	if v.ContinuedFailureReason != nil {
-		fields[i] = fmt.Sprintf("ContinuedFailureReason: %v", *(v.ContinuedFailureReason))
-		i++
-	}
-	if v.ContinuedFailureDetails != nil {
-		fields[i] = fmt.Sprintf("ContinuedFailureDetails: %v", v.ContinuedFailureDetails)
-		i++
-	}
-	if v.LastCompletionResult != nil {
-		fields[i] = fmt.Sprintf("LastCompletionResult: %v", v.LastCompletionResult)
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
 		i++
 	}
-	if v.FirstDecisionTaskBackoffSeconds != nil {
-		fields[i] = fmt.Sprintf("FirstDecisionTaskBackoffSeconds: %v", *(v.FirstDecisionTaskBackoffSeconds))
+	if v.Owner != nil {
+		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
 		i++
 	}
 
-	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _ContinueAsNewInitiator_EqualsPtr(lhs, rhs *shared.ContinueAsNewInitiator) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("ShardOwnershipLostError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this StartWorkflowExecutionRequest match the
-// provided StartWorkflowExecutionRequest.
+// Equals returns true if all the fields of this ShardOwnershipLostError match the
+// provided ShardOwnershipLostError.
 //
 // This function performs a deep comparison.
-func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionRequest) bool {
+func (v *ShardOwnershipLostError) Equals(rhs *ShardOwnershipLostError) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.StartRequest == nil && rhs.StartRequest == nil) || (v.StartRequest != nil && rhs.StartRequest != nil && v.StartRequest.Equals(rhs.StartRequest))) {
-		return false
-	}
-	if !((v.ParentExecutionInfo == nil && rhs.ParentExecutionInfo == nil) || (v.ParentExecutionInfo != nil && rhs.ParentExecutionInfo != nil && v.ParentExecutionInfo.Equals(rhs.ParentExecutionInfo))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
-		return false
-	}
-	if !_ContinueAsNewInitiator_EqualsPtr(v.ContinueAsNewInitiator, rhs.ContinueAsNewInitiator) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ContinuedFailureReason, rhs.ContinuedFailureReason) {
-		return false
-	}
-	if !((v.ContinuedFailureDetails == nil && rhs.ContinuedFailureDetails == nil) || (v.ContinuedFailureDetails != nil && rhs.ContinuedFailureDetails != nil && bytes.Equal(v.ContinuedFailureDetails, rhs.ContinuedFailureDetails))) {
-		return false
-	}
-	if !((v.LastCompletionResult == nil && rhs.LastCompletionResult == nil) || (v.LastCompletionResult != nil && rhs.LastCompletionResult != nil && bytes.Equal(v.LastCompletionResult, rhs.LastCompletionResult))) {
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.FirstDecisionTaskBackoffSeconds, rhs.FirstDecisionTaskBackoffSeconds) {
+	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
 		return false
 	}
 
@@ -8672,209 +8461,2123 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of StartWorkflowExecutionRequest.
-func (v *StartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ShardOwnershipLostError.
+func (v *ShardOwnershipLostError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
-	}
-	if v.StartRequest != nil {
-		err = multierr.Append(err, enc.AddObject("startRequest", v.StartRequest))
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
 	}
-	if v.ParentExecutionInfo != nil {
-		err = multierr.Append(err, enc.AddObject("parentExecutionInfo", v.ParentExecutionInfo))
+	if v.Owner != nil {
+		enc.AddString("owner", *v.Owner)
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *ShardOwnershipLostError) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+func (v *ShardOwnershipLostError) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// GetOwner returns the value of Owner if it is set or its
+// zero value if it is unset.
+func (v *ShardOwnershipLostError) GetOwner() (o string) {
+	if v != nil && v.Owner != nil {
+		return *v.Owner
+	}
+
+	return
+}
+
+// IsSetOwner returns true if Owner is not nil.
+func (v *ShardOwnershipLostError) IsSetOwner() bool {
+	return v != nil && v.Owner != nil
+}
+
+func (v *ShardOwnershipLostError) Error() string {
+	return v.String()
+}
+
+type SignalWithStartWorkflowExecutionRequest struct {
+	DomainUUID             *string                                         `json:"domainUUID,omitempty"`
+	SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest `json:"signalWithStartRequest,omitempty"`
+}
+
+// ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SignalWithStartRequest != nil {
+		w, err = v.SignalWithStartRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _SignalWithStartWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWithStartWorkflowExecutionRequest, error) {
+	var v shared.SignalWithStartWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a SignalWithStartWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SignalWithStartWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SignalWithStartWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.SignalWithStartRequest, err = _SignalWithStartWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SignalWithStartWorkflowExecutionRequest
+// struct.
+func (v *SignalWithStartWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.SignalWithStartRequest != nil {
+		fields[i] = fmt.Sprintf("SignalWithStartRequest: %v", v.SignalWithStartRequest)
+		i++
+	}
+
+	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SignalWithStartWorkflowExecutionRequest match the
+// provided SignalWithStartWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.SignalWithStartRequest == nil && rhs.SignalWithStartRequest == nil) || (v.SignalWithStartRequest != nil && rhs.SignalWithStartRequest != nil && v.SignalWithStartRequest.Equals(rhs.SignalWithStartRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SignalWithStartWorkflowExecutionRequest.
+func (v *SignalWithStartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.SignalWithStartRequest != nil {
+		err = multierr.Append(err, enc.AddObject("signalWithStartRequest", v.SignalWithStartRequest))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetSignalWithStartRequest returns the value of SignalWithStartRequest if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetSignalWithStartRequest() (o *shared.SignalWithStartWorkflowExecutionRequest) {
+	if v != nil && v.SignalWithStartRequest != nil {
+		return v.SignalWithStartRequest
+	}
+
+	return
+}
+
+// IsSetSignalWithStartRequest returns true if SignalWithStartRequest is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetSignalWithStartRequest() bool {
+	return v != nil && v.SignalWithStartRequest != nil
+}
+
+type SignalWorkflowExecutionRequest struct {
+	DomainUUID                *string                                `json:"domainUUID,omitempty"`
+	SignalRequest             *shared.SignalWorkflowExecutionRequest `json:"signalRequest,omitempty"`
+	ExternalWorkflowExecution *shared.WorkflowExecution              `json:"externalWorkflowExecution,omitempty"`
+	ChildWorkflowOnly         *bool                                  `json:"childWorkflowOnly,omitempty"`
+}
+
+// ToWire translates a SignalWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SignalWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SignalRequest != nil {
+		w, err = v.SignalRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ExternalWorkflowExecution != nil {
+		w, err = v.ExternalWorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _SignalWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWorkflowExecutionRequest, error) {
+	var v shared.SignalWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a SignalWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SignalWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SignalWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SignalWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.SignalRequest, err = _SignalWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ChildWorkflowOnly = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SignalWorkflowExecutionRequest
+// struct.
+func (v *SignalWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.SignalRequest != nil {
+		fields[i] = fmt.Sprintf("SignalRequest: %v", v.SignalRequest)
+		i++
+	}
+	if v.ExternalWorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
+		i++
+	}
+
+	return fmt.Sprintf("SignalWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SignalWorkflowExecutionRequest match the
+// provided SignalWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *SignalWorkflowExecutionRequest) Equals(rhs *SignalWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.SignalRequest == nil && rhs.SignalRequest == nil) || (v.SignalRequest != nil && rhs.SignalRequest != nil && v.SignalRequest.Equals(rhs.SignalRequest))) {
+		return false
+	}
+	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SignalWorkflowExecutionRequest.
+func (v *SignalWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.SignalRequest != nil {
+		err = multierr.Append(err, enc.AddObject("signalRequest", v.SignalRequest))
+	}
+	if v.ExternalWorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("externalWorkflowExecution", v.ExternalWorkflowExecution))
+	}
+	if v.ChildWorkflowOnly != nil {
+		enc.AddBool("childWorkflowOnly", *v.ChildWorkflowOnly)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *SignalWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *SignalWorkflowExecutionRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetSignalRequest returns the value of SignalRequest if it is set or its
+// zero value if it is unset.
+func (v *SignalWorkflowExecutionRequest) GetSignalRequest() (o *shared.SignalWorkflowExecutionRequest) {
+	if v != nil && v.SignalRequest != nil {
+		return v.SignalRequest
+	}
+
+	return
+}
+
+// IsSetSignalRequest returns true if SignalRequest is not nil.
+func (v *SignalWorkflowExecutionRequest) IsSetSignalRequest() bool {
+	return v != nil && v.SignalRequest != nil
+}
+
+// GetExternalWorkflowExecution returns the value of ExternalWorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *SignalWorkflowExecutionRequest) GetExternalWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.ExternalWorkflowExecution != nil {
+		return v.ExternalWorkflowExecution
+	}
+
+	return
+}
+
+// IsSetExternalWorkflowExecution returns true if ExternalWorkflowExecution is not nil.
+func (v *SignalWorkflowExecutionRequest) IsSetExternalWorkflowExecution() bool {
+	return v != nil && v.ExternalWorkflowExecution != nil
+}
+
+// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// zero value if it is unset.
+func (v *SignalWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool) {
+	if v != nil && v.ChildWorkflowOnly != nil {
+		return *v.ChildWorkflowOnly
+	}
+
+	return
+}
+
+// IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
+func (v *SignalWorkflowExecutionRequest) IsSetChildWorkflowOnly() bool {
+	return v != nil && v.ChildWorkflowOnly != nil
+}
+
+type StartWorkflowExecutionBatchRequest struct {
+	StartRequests []*StartWorkflowExecutionRequest `json:"startRequests,omitempty"`
+}
+
+type _List_StartWorkflowExecutionRequest_ValueList []*StartWorkflowExecutionRequest
+
+func (v _List_StartWorkflowExecutionRequest_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_StartWorkflowExecutionRequest_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_StartWorkflowExecutionRequest_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_StartWorkflowExecutionRequest_ValueList) Close() {}
+
+// ToWire translates a StartWorkflowExecutionBatchRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StartWorkflowExecutionBatchRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.StartRequests != nil {
+		w, err = wire.NewValueList(_List_StartWorkflowExecutionRequest_ValueList(v.StartRequests)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _StartWorkflowExecutionRequest_Read(w wire.Value) (*StartWorkflowExecutionRequest, error) {
+	var v StartWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_StartWorkflowExecutionRequest_Read(l wire.ValueList) ([]*StartWorkflowExecutionRequest, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*StartWorkflowExecutionRequest, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _StartWorkflowExecutionRequest_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a StartWorkflowExecutionBatchRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StartWorkflowExecutionBatchRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StartWorkflowExecutionBatchRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StartWorkflowExecutionBatchRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.StartRequests, err = _List_StartWorkflowExecutionRequest_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a StartWorkflowExecutionBatchRequest
+// struct.
+func (v *StartWorkflowExecutionBatchRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.StartRequests != nil {
+		fields[i] = fmt.Sprintf("StartRequests: %v", v.StartRequests)
+		i++
+	}
+
+	return fmt.Sprintf("StartWorkflowExecutionBatchRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_StartWorkflowExecutionRequest_Equals(lhs, rhs []*StartWorkflowExecutionRequest) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this StartWorkflowExecutionBatchRequest match the
+// provided StartWorkflowExecutionBatchRequest.
+//
+// This function performs a deep comparison.
+func (v *StartWorkflowExecutionBatchRequest) Equals(rhs *StartWorkflowExecutionBatchRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.StartRequests == nil && rhs.StartRequests == nil) || (v.StartRequests != nil && rhs.StartRequests != nil && _List_StartWorkflowExecutionRequest_Equals(v.StartRequests, rhs.StartRequests))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_StartWorkflowExecutionRequest_Zapper []*StartWorkflowExecutionRequest
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_StartWorkflowExecutionRequest_Zapper.
+func (l _List_StartWorkflowExecutionRequest_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StartWorkflowExecutionBatchRequest.
+func (v *StartWorkflowExecutionBatchRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.StartRequests != nil {
+		err = multierr.Append(err, enc.AddArray("startRequests", (_List_StartWorkflowExecutionRequest_Zapper)(v.StartRequests)))
+	}
+	return err
+}
+
+// GetStartRequests returns the value of StartRequests if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionBatchRequest) GetStartRequests() (o []*StartWorkflowExecutionRequest) {
+	if v != nil && v.StartRequests != nil {
+		return v.StartRequests
+	}
+
+	return
+}
+
+// IsSetStartRequests returns true if StartRequests is not nil.
+func (v *StartWorkflowExecutionBatchRequest) IsSetStartRequests() bool {
+	return v != nil && v.StartRequests != nil
+}
+
+type StartWorkflowExecutionBatchResponse struct {
+	Results []*shared.StartWorkflowExecutionBatchResult `json:"results,omitempty"`
+}
+
+type _List_StartWorkflowExecutionBatchResult_ValueList []*shared.StartWorkflowExecutionBatchResult
+
+func (v _List_StartWorkflowExecutionBatchResult_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_StartWorkflowExecutionBatchResult_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_StartWorkflowExecutionBatchResult_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_StartWorkflowExecutionBatchResult_ValueList) Close() {}
+
+// ToWire translates a StartWorkflowExecutionBatchResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StartWorkflowExecutionBatchResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Results != nil {
+		w, err = wire.NewValueList(_List_StartWorkflowExecutionBatchResult_ValueList(v.Results)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _StartWorkflowExecutionBatchResult_Read(w wire.Value) (*shared.StartWorkflowExecutionBatchResult, error) {
+	var v shared.StartWorkflowExecutionBatchResult
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_StartWorkflowExecutionBatchResult_Read(l wire.ValueList) ([]*shared.StartWorkflowExecutionBatchResult, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.StartWorkflowExecutionBatchResult, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _StartWorkflowExecutionBatchResult_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a StartWorkflowExecutionBatchResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StartWorkflowExecutionBatchResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StartWorkflowExecutionBatchResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StartWorkflowExecutionBatchResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Results, err = _List_StartWorkflowExecutionBatchResult_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a StartWorkflowExecutionBatchResponse
+// struct.
+func (v *StartWorkflowExecutionBatchResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Results != nil {
+		fields[i] = fmt.Sprintf("Results: %v", v.Results)
+		i++
+	}
+
+	return fmt.Sprintf("StartWorkflowExecutionBatchResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_StartWorkflowExecutionBatchResult_Equals(lhs, rhs []*shared.StartWorkflowExecutionBatchResult) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this StartWorkflowExecutionBatchResponse match the
+// provided StartWorkflowExecutionBatchResponse.
+//
+// This function performs a deep comparison.
+func (v *StartWorkflowExecutionBatchResponse) Equals(rhs *StartWorkflowExecutionBatchResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Results == nil && rhs.Results == nil) || (v.Results != nil && rhs.Results != nil && _List_StartWorkflowExecutionBatchResult_Equals(v.Results, rhs.Results))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_StartWorkflowExecutionBatchResult_Zapper []*shared.StartWorkflowExecutionBatchResult
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_StartWorkflowExecutionBatchResult_Zapper.
+func (l _List_StartWorkflowExecutionBatchResult_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StartWorkflowExecutionBatchResponse.
+func (v *StartWorkflowExecutionBatchResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Results != nil {
+		err = multierr.Append(err, enc.AddArray("results", (_List_StartWorkflowExecutionBatchResult_Zapper)(v.Results)))
+	}
+	return err
+}
+
+// GetResults returns the value of Results if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionBatchResponse) GetResults() (o []*shared.StartWorkflowExecutionBatchResult) {
+	if v != nil && v.Results != nil {
+		return v.Results
+	}
+
+	return
+}
+
+// IsSetResults returns true if Results is not nil.
+func (v *StartWorkflowExecutionBatchResponse) IsSetResults() bool {
+	return v != nil && v.Results != nil
+}
+
+type StartWorkflowExecutionRequest struct {
+	DomainUUID                           *string                               `json:"domainUUID,omitempty"`
+	StartRequest                         *shared.StartWorkflowExecutionRequest `json:"startRequest,omitempty"`
+	ParentExecutionInfo                  *ParentExecutionInfo                  `json:"parentExecutionInfo,omitempty"`
+	Attempt                              *int32                                `json:"attempt,omitempty"`
+	ExpirationTimestamp                  *int64                                `json:"expirationTimestamp,omitempty"`
+	ContinueAsNewInitiator               *shared.ContinueAsNewInitiator        `json:"continueAsNewInitiator,omitempty"`
+	ContinuedFailureReason               *string                               `json:"continuedFailureReason,omitempty"`
+	ContinuedFailureDetails              []byte                                `json:"continuedFailureDetails,omitempty"`
+	LastCompletionResult                 []byte                                `json:"lastCompletionResult,omitempty"`
+	FirstDecisionTaskBackoffSeconds      *int32                                `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	WorkflowExecutionExpirationTimestamp *int64                                `json:"workflowExecutionExpirationTimestamp,omitempty"`
+}
+
+// ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [11]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.StartRequest != nil {
+		w, err = v.StartRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ParentExecutionInfo != nil {
+		w, err = v.ParentExecutionInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ContinueAsNewInitiator != nil {
+		w, err = v.ContinueAsNewInitiator.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 55, Value: w}
+		i++
+	}
+	if v.ContinuedFailureReason != nil {
+		w, err = wire.NewValueString(*(v.ContinuedFailureReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 56, Value: w}
+		i++
+	}
+	if v.ContinuedFailureDetails != nil {
+		w, err = wire.NewValueBinary(v.ContinuedFailureDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 57, Value: w}
+		i++
+	}
+	if v.LastCompletionResult != nil {
+		w, err = wire.NewValueBinary(v.LastCompletionResult), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 58, Value: w}
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FirstDecisionTaskBackoffSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.WorkflowExecutionExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _StartWorkflowExecutionRequest_1_Read(w wire.Value) (*shared.StartWorkflowExecutionRequest, error) {
+	var v shared.StartWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ParentExecutionInfo_Read(w wire.Value) (*ParentExecutionInfo, error) {
+	var v ParentExecutionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ContinueAsNewInitiator_Read(w wire.Value) (shared.ContinueAsNewInitiator, error) {
+	var v shared.ContinueAsNewInitiator
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a StartWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StartWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StartWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.StartRequest, err = _StartWorkflowExecutionRequest_1_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ParentExecutionInfo, err = _ParentExecutionInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 55:
+			if field.Value.Type() == wire.TI32 {
+				var x shared.ContinueAsNewInitiator
+				x, err = _ContinueAsNewInitiator_Read(field.Value)
+				v.ContinueAsNewInitiator = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 56:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ContinuedFailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 57:
+			if field.Value.Type() == wire.TBinary {
+				v.ContinuedFailureDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 58:
+			if field.Value.Type() == wire.TBinary {
+				v.LastCompletionResult, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FirstDecisionTaskBackoffSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.WorkflowExecutionExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a StartWorkflowExecutionRequest
+// struct.
+func (v *StartWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [11]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.StartRequest != nil {
+		fields[i] = fmt.Sprintf("StartRequest: %v", v.StartRequest)
+		i++
+	}
+	if v.ParentExecutionInfo != nil {
+		fields[i] = fmt.Sprintf("ParentExecutionInfo: %v", v.ParentExecutionInfo)
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
+		i++
+	}
+	if v.ContinueAsNewInitiator != nil {
+		fields[i] = fmt.Sprintf("ContinueAsNewInitiator: %v", *(v.ContinueAsNewInitiator))
+		i++
+	}
+	if v.ContinuedFailureReason != nil {
+		fields[i] = fmt.Sprintf("ContinuedFailureReason: %v", *(v.ContinuedFailureReason))
+		i++
+	}
+	if v.ContinuedFailureDetails != nil {
+		fields[i] = fmt.Sprintf("ContinuedFailureDetails: %v", v.ContinuedFailureDetails)
+		i++
+	}
+	if v.LastCompletionResult != nil {
+		fields[i] = fmt.Sprintf("LastCompletionResult: %v", v.LastCompletionResult)
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		fields[i] = fmt.Sprintf("FirstDecisionTaskBackoffSeconds: %v", *(v.FirstDecisionTaskBackoffSeconds))
+		i++
+	}
+	if v.WorkflowExecutionExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionExpirationTimestamp: %v", *(v.WorkflowExecutionExpirationTimestamp))
+		i++
+	}
+
+	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _ContinueAsNewInitiator_EqualsPtr(lhs, rhs *shared.ContinueAsNewInitiator) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this StartWorkflowExecutionRequest match the
+// provided StartWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.StartRequest == nil && rhs.StartRequest == nil) || (v.StartRequest != nil && rhs.StartRequest != nil && v.StartRequest.Equals(rhs.StartRequest))) {
+		return false
+	}
+	if !((v.ParentExecutionInfo == nil && rhs.ParentExecutionInfo == nil) || (v.ParentExecutionInfo != nil && rhs.ParentExecutionInfo != nil && v.ParentExecutionInfo.Equals(rhs.ParentExecutionInfo))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
+		return false
+	}
+	if !_ContinueAsNewInitiator_EqualsPtr(v.ContinueAsNewInitiator, rhs.ContinueAsNewInitiator) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ContinuedFailureReason, rhs.ContinuedFailureReason) {
+		return false
+	}
+	if !((v.ContinuedFailureDetails == nil && rhs.ContinuedFailureDetails == nil) || (v.ContinuedFailureDetails != nil && rhs.ContinuedFailureDetails != nil && bytes.Equal(v.ContinuedFailureDetails, rhs.ContinuedFailureDetails))) {
+		return false
+	}
+	if !((v.LastCompletionResult == nil && rhs.LastCompletionResult == nil) || (v.LastCompletionResult != nil && rhs.LastCompletionResult != nil && bytes.Equal(v.LastCompletionResult, rhs.LastCompletionResult))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.FirstDecisionTaskBackoffSeconds, rhs.FirstDecisionTaskBackoffSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.WorkflowExecutionExpirationTimestamp, rhs.WorkflowExecutionExpirationTimestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StartWorkflowExecutionRequest.
+func (v *StartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.StartRequest != nil {
+		err = multierr.Append(err, enc.AddObject("startRequest", v.StartRequest))
+	}
+	if v.ParentExecutionInfo != nil {
+		err = multierr.Append(err, enc.AddObject("parentExecutionInfo", v.ParentExecutionInfo))
+	}
+	if v.Attempt != nil {
+		enc.AddInt32("attempt", *v.Attempt)
+	}
+	if v.ExpirationTimestamp != nil {
+		enc.AddInt64("expirationTimestamp", *v.ExpirationTimestamp)
+	}
+	if v.ContinueAsNewInitiator != nil {
+		err = multierr.Append(err, enc.AddObject("continueAsNewInitiator", *v.ContinueAsNewInitiator))
+	}
+	if v.ContinuedFailureReason != nil {
+		enc.AddString("continuedFailureReason", *v.ContinuedFailureReason)
+	}
+	if v.ContinuedFailureDetails != nil {
+		enc.AddString("continuedFailureDetails", base64.StdEncoding.EncodeToString(v.ContinuedFailureDetails))
+	}
+	if v.LastCompletionResult != nil {
+		enc.AddString("lastCompletionResult", base64.StdEncoding.EncodeToString(v.LastCompletionResult))
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		enc.AddInt32("firstDecisionTaskBackoffSeconds", *v.FirstDecisionTaskBackoffSeconds)
+	}
+	if v.WorkflowExecutionExpirationTimestamp != nil {
+		enc.AddInt64("workflowExecutionExpirationTimestamp", *v.WorkflowExecutionExpirationTimestamp)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetStartRequest returns the value of StartRequest if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetStartRequest() (o *shared.StartWorkflowExecutionRequest) {
+	if v != nil && v.StartRequest != nil {
+		return v.StartRequest
+	}
+
+	return
+}
+
+// IsSetStartRequest returns true if StartRequest is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetStartRequest() bool {
+	return v != nil && v.StartRequest != nil
+}
+
+// GetParentExecutionInfo returns the value of ParentExecutionInfo if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetParentExecutionInfo() (o *ParentExecutionInfo) {
+	if v != nil && v.ParentExecutionInfo != nil {
+		return v.ParentExecutionInfo
+	}
+
+	return
+}
+
+// IsSetParentExecutionInfo returns true if ParentExecutionInfo is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetParentExecutionInfo() bool {
+	return v != nil && v.ParentExecutionInfo != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetAttempt() (o int32) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetExpirationTimestamp() (o int64) {
+	if v != nil && v.ExpirationTimestamp != nil {
+		return *v.ExpirationTimestamp
+	}
+
+	return
+}
+
+// IsSetExpirationTimestamp returns true if ExpirationTimestamp is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetExpirationTimestamp() bool {
+	return v != nil && v.ExpirationTimestamp != nil
+}
+
+// GetContinueAsNewInitiator returns the value of ContinueAsNewInitiator if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetContinueAsNewInitiator() (o shared.ContinueAsNewInitiator) {
+	if v != nil && v.ContinueAsNewInitiator != nil {
+		return *v.ContinueAsNewInitiator
+	}
+
+	return
+}
+
+// IsSetContinueAsNewInitiator returns true if ContinueAsNewInitiator is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetContinueAsNewInitiator() bool {
+	return v != nil && v.ContinueAsNewInitiator != nil
+}
+
+// GetContinuedFailureReason returns the value of ContinuedFailureReason if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetContinuedFailureReason() (o string) {
+	if v != nil && v.ContinuedFailureReason != nil {
+		return *v.ContinuedFailureReason
+	}
+
+	return
+}
+
+// IsSetContinuedFailureReason returns true if ContinuedFailureReason is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetContinuedFailureReason() bool {
+	return v != nil && v.ContinuedFailureReason != nil
+}
+
+// GetContinuedFailureDetails returns the value of ContinuedFailureDetails if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetContinuedFailureDetails() (o []byte) {
+	if v != nil && v.ContinuedFailureDetails != nil {
+		return v.ContinuedFailureDetails
+	}
+
+	return
+}
+
+// IsSetContinuedFailureDetails returns true if ContinuedFailureDetails is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetContinuedFailureDetails() bool {
+	return v != nil && v.ContinuedFailureDetails != nil
+}
+
+// GetLastCompletionResult returns the value of LastCompletionResult if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetLastCompletionResult() (o []byte) {
+	if v != nil && v.LastCompletionResult != nil {
+		return v.LastCompletionResult
+	}
+
+	return
+}
+
+// IsSetLastCompletionResult returns true if LastCompletionResult is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetLastCompletionResult() bool {
+	return v != nil && v.LastCompletionResult != nil
+}
+
+// GetFirstDecisionTaskBackoffSeconds returns the value of FirstDecisionTaskBackoffSeconds if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetFirstDecisionTaskBackoffSeconds() (o int32) {
+	if v != nil && v.FirstDecisionTaskBackoffSeconds != nil {
+		return *v.FirstDecisionTaskBackoffSeconds
+	}
+
+	return
+}
+
+// IsSetFirstDecisionTaskBackoffSeconds returns true if FirstDecisionTaskBackoffSeconds is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetFirstDecisionTaskBackoffSeconds() bool {
+	return v != nil && v.FirstDecisionTaskBackoffSeconds != nil
+}
+
+// GetWorkflowExecutionExpirationTimestamp returns the value of WorkflowExecutionExpirationTimestamp if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetWorkflowExecutionExpirationTimestamp() (o int64) {
+	if v != nil && v.WorkflowExecutionExpirationTimestamp != nil {
+		return *v.WorkflowExecutionExpirationTimestamp
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionExpirationTimestamp returns true if WorkflowExecutionExpirationTimestamp is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetWorkflowExecutionExpirationTimestamp() bool {
+	return v != nil && v.WorkflowExecutionExpirationTimestamp != nil
+}
+
+type SyncActivityRequest struct {
+	DomainId          *string `json:"domainId,omitempty"`
+	WorkflowId        *string `json:"workflowId,omitempty"`
+	RunId             *string `json:"runId,omitempty"`
+	Version           *int64  `json:"version,omitempty"`
+	ScheduledId       *int64  `json:"scheduledId,omitempty"`
+	ScheduledTime     *int64  `json:"scheduledTime,omitempty"`
+	StartedId         *int64  `json:"startedId,omitempty"`
+	StartedTime       *int64  `json:"startedTime,omitempty"`
+	LastHeartbeatTime *int64  `json:"lastHeartbeatTime,omitempty"`
+	Details           []byte  `json:"details,omitempty"`
+	Attempt           *int32  `json:"attempt,omitempty"`
+}
+
+// ToWire translates a SyncActivityRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SyncActivityRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [11]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ScheduledId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ScheduledTime != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.StartedId != nil {
+		w, err = wire.NewValueI64(*(v.StartedId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.StartedTime != nil {
+		w, err = wire.NewValueI64(*(v.StartedTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.LastHeartbeatTime != nil {
+		w, err = wire.NewValueI64(*(v.LastHeartbeatTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SyncActivityRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SyncActivityRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SyncActivityRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SyncActivityRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastHeartbeatTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SyncActivityRequest
+// struct.
+func (v *SyncActivityRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [11]string
+	i := 0
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+		i++
+	}
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.ScheduledId != nil {
+		fields[i] = fmt.Sprintf("ScheduledId: %v", *(v.ScheduledId))
+		i++
+	}
+	if v.ScheduledTime != nil {
+		fields[i] = fmt.Sprintf("ScheduledTime: %v", *(v.ScheduledTime))
+		i++
+	}
+	if v.StartedId != nil {
+		fields[i] = fmt.Sprintf("StartedId: %v", *(v.StartedId))
+		i++
+	}
+	if v.StartedTime != nil {
+		fields[i] = fmt.Sprintf("StartedTime: %v", *(v.StartedTime))
+		i++
+	}
+	if v.LastHeartbeatTime != nil {
+		fields[i] = fmt.Sprintf("LastHeartbeatTime: %v", *(v.LastHeartbeatTime))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
 	}
 	if v.Attempt != nil {
-		enc.AddInt32("attempt", *v.Attempt)
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
 	}
-	if v.ExpirationTimestamp != nil {
-		enc.AddInt64("expirationTimestamp", *v.ExpirationTimestamp)
+
+	return fmt.Sprintf("SyncActivityRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SyncActivityRequest match the
+// provided SyncActivityRequest.
+//
+// This function performs a deep comparison.
+func (v *SyncActivityRequest) Equals(rhs *SyncActivityRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	if v.ContinueAsNewInitiator != nil {
-		err = multierr.Append(err, enc.AddObject("continueAsNewInitiator", *v.ContinueAsNewInitiator))
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
+		return false
 	}
-	if v.ContinuedFailureReason != nil {
-		enc.AddString("continuedFailureReason", *v.ContinuedFailureReason)
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
 	}
-	if v.ContinuedFailureDetails != nil {
-		enc.AddString("continuedFailureDetails", base64.StdEncoding.EncodeToString(v.ContinuedFailureDetails))
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
 	}
-	if v.LastCompletionResult != nil {
-		enc.AddString("lastCompletionResult", base64.StdEncoding.EncodeToString(v.LastCompletionResult))
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
 	}
-	if v.FirstDecisionTaskBackoffSeconds != nil {
-		enc.AddInt32("firstDecisionTaskBackoffSeconds", *v.FirstDecisionTaskBackoffSeconds)
+	if !_I64_EqualsPtr(v.ScheduledId, rhs.ScheduledId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTime, rhs.ScheduledTime) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedId, rhs.StartedId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedTime, rhs.StartedTime) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastHeartbeatTime, rhs.LastHeartbeatTime) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SyncActivityRequest.
+func (v *SyncActivityRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.ScheduledId != nil {
+		enc.AddInt64("scheduledId", *v.ScheduledId)
+	}
+	if v.ScheduledTime != nil {
+		enc.AddInt64("scheduledTime", *v.ScheduledTime)
+	}
+	if v.StartedId != nil {
+		enc.AddInt64("startedId", *v.StartedId)
+	}
+	if v.StartedTime != nil {
+		enc.AddInt64("startedTime", *v.StartedTime)
+	}
+	if v.LastHeartbeatTime != nil {
+		enc.AddInt64("lastHeartbeatTime", *v.LastHeartbeatTime)
+	}
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	}
+	if v.Attempt != nil {
+		enc.AddInt32("attempt", *v.Attempt)
 	}
 	return err
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetDomainId returns the value of DomainId if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *SyncActivityRequest) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
 	}
 
 	return
 }
 
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *SyncActivityRequest) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
 }
 
-// GetStartRequest returns the value of StartRequest if it is set or its
+// GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetStartRequest() (o *shared.StartWorkflowExecutionRequest) {
-	if v != nil && v.StartRequest != nil {
-		return v.StartRequest
+func (v *SyncActivityRequest) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
 	}
 
 	return
 }
 
-// IsSetStartRequest returns true if StartRequest is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetStartRequest() bool {
-	return v != nil && v.StartRequest != nil
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *SyncActivityRequest) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
 }
 
-// GetParentExecutionInfo returns the value of ParentExecutionInfo if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetParentExecutionInfo() (o *ParentExecutionInfo) {
-	if v != nil && v.ParentExecutionInfo != nil {
-		return v.ParentExecutionInfo
+func (v *SyncActivityRequest) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// IsSetParentExecutionInfo returns true if ParentExecutionInfo is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetParentExecutionInfo() bool {
-	return v != nil && v.ParentExecutionInfo != nil
+// IsSetRunId returns true if RunId is not nil.
+func (v *SyncActivityRequest) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
 }
 
-// GetAttempt returns the value of Attempt if it is set or its
+// GetVersion returns the value of Version if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetAttempt() (o int32) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
+func (v *SyncActivityRequest) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
 
 	return
 }
 
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
+// IsSetVersion returns true if Version is not nil.
+func (v *SyncActivityRequest) IsSetVersion() bool {
+	return v != nil && v.Version != nil
 }
 
-// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
+// GetScheduledId returns the value of ScheduledId if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetExpirationTimestamp() (o int64) {
-	if v != nil && v.ExpirationTimestamp != nil {
-		return *v.ExpirationTimestamp
+func (v *SyncActivityRequest) GetScheduledId() (o int64) {
+	if v != nil && v.ScheduledId != nil {
+		return *v.ScheduledId
 	}
 
 	return
 }
 
-// IsSetExpirationTimestamp returns true if ExpirationTimestamp is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetExpirationTimestamp() bool {
-	return v != nil && v.ExpirationTimestamp != nil
+// IsSetScheduledId returns true if ScheduledId is not nil.
+func (v *SyncActivityRequest) IsSetScheduledId() bool {
+	return v != nil && v.ScheduledId != nil
 }
 
-// GetContinueAsNewInitiator returns the value of ContinueAsNewInitiator if it is set or its
+// GetScheduledTime returns the value of ScheduledTime if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetContinueAsNewInitiator() (o shared.ContinueAsNewInitiator) {
-	if v != nil && v.ContinueAsNewInitiator != nil {
-		return *v.ContinueAsNewInitiator
+func (v *SyncActivityRequest) GetScheduledTime() (o int64) {
+	if v != nil && v.ScheduledTime != nil {
+		return *v.ScheduledTime
 	}
 
 	return
 }
 
-// IsSetContinueAsNewInitiator returns true if ContinueAsNewInitiator is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetContinueAsNewInitiator() bool {
-	return v != nil && v.ContinueAsNewInitiator != nil
+// IsSetScheduledTime returns true if ScheduledTime is not nil.
+func (v *SyncActivityRequest) IsSetScheduledTime() bool {
+	return v != nil && v.ScheduledTime != nil
 }
 
-// GetContinuedFailureReason returns the value of ContinuedFailureReason if it is set or its
+// GetStartedId returns the value of StartedId if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetContinuedFailureReason() (o string) {
-	if v != nil && v.ContinuedFailureReason != nil {
-		return *v.ContinuedFailureReason
+func (v *SyncActivityRequest) GetStartedId() (o int64) {
+	if v != nil && v.StartedId != nil {
+		return *v.StartedId
 	}
 
 	return
 }
 
-// IsSetContinuedFailureReason returns true if ContinuedFailureReason is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetContinuedFailureReason() bool {
-	return v != nil && v.ContinuedFailureReason != nil
+// IsSetStartedId returns true if StartedId is not nil.
+func (v *SyncActivityRequest) IsSetStartedId() bool {
+	return v != nil && v.StartedId != nil
 }
 
-// GetContinuedFailureDetails returns the value of ContinuedFailureDetails if it is set or its
+// GetStartedTime returns the value of StartedTime if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityRequest) GetStartedTime() (o int64) {
+	if v != nil && v.StartedTime != nil {
+		return *v.StartedTime
+	}
+
+	return
+}
+
+// IsSetStartedTime returns true if StartedTime is not nil.
+func (v *SyncActivityRequest) IsSetStartedTime() bool {
+	return v != nil && v.StartedTime != nil
+}
+
+// GetLastHeartbeatTime returns the value of LastHeartbeatTime if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetContinuedFailureDetails() (o []byte) {
-	if v != nil && v.ContinuedFailureDetails != nil {
-		return v.ContinuedFailureDetails
+func (v *SyncActivityRequest) GetLastHeartbeatTime() (o int64) {
+	if v != nil && v.LastHeartbeatTime != nil {
+		return *v.LastHeartbeatTime
 	}
 
 	return
 }
 
-// IsSetContinuedFailureDetails returns true if ContinuedFailureDetails is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetContinuedFailureDetails() bool {
-	return v != nil && v.ContinuedFailureDetails != nil
+// IsSetLastHeartbeatTime returns true if LastHeartbeatTime is not nil.
+func (v *SyncActivityRequest) IsSetLastHeartbeatTime() bool {
+	return v != nil && v.LastHeartbeatTime != nil
 }
 
-// GetLastCompletionResult returns the value of LastCompletionResult if it is set or its
+// GetDetails returns the value of Details if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetLastCompletionResult() (o []byte) {
-	if v != nil && v.LastCompletionResult != nil {
-		return v.LastCompletionResult
+func (v *SyncActivityRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
 
 	return
 }
 
-// IsSetLastCompletionResult returns true if LastCompletionResult is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetLastCompletionResult() bool {
-	return v != nil && v.LastCompletionResult != nil
+// IsSetDetails returns true if Details is not nil.
+func (v *SyncActivityRequest) IsSetDetails() bool {
+	return v != nil && v.Details != nil
 }
 
-// GetFirstDecisionTaskBackoffSeconds returns the value of FirstDecisionTaskBackoffSeconds if it is set or its
+// GetAttempt returns the value of Attempt if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetFirstDecisionTaskBackoffSeconds() (o int32) {
-	if v != nil && v.FirstDecisionTaskBackoffSeconds != nil {
-		return *v.FirstDecisionTaskBackoffSeconds
+func (v *SyncActivityRequest) GetAttempt() (o int32) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
 	}
 
 	return
 }
 
-// IsSetFirstDecisionTaskBackoffSeconds returns true if FirstDecisionTaskBackoffSeconds is not nil.
-func (v *StartWorkflowExecutionRequest) IsSetFirstDecisionTaskBackoffSeconds() bool {
-	return v != nil && v.FirstDecisionTaskBackoffSeconds != nil
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *SyncActivityRequest) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
 }
 
-type SyncActivityRequest struct {
-	DomainId          *string `json:"domainId,omitempty"`
-	WorkflowId        *string `json:"workflowId,omitempty"`
-	RunId             *string `json:"runId,omitempty"`
-	Version           *int64  `json:"version,omitempty"`
-	ScheduledId       *int64  `json:"scheduledId,omitempty"`
-	ScheduledTime     *int64  `json:"scheduledTime,omitempty"`
-	StartedId         *int64  `json:"startedId,omitempty"`
-	StartedTime       *int64  `json:"startedTime,omitempty"`
-	LastHeartbeatTime *int64  `json:"lastHeartbeatTime,omitempty"`
-	Details           []byte  `json:"details,omitempty"`
-	Attempt           *int32  `json:"attempt,omitempty"`
+type SyncShardStatusRequest struct {
+	SourceCluster *string `json:"sourceCluster,omitempty"`
+	ShardId       *int64  `json:"shardId,omitempty"`
+	Timestamp     *int64  `json:"timestamp,omitempty"`
 }
 
-// ToWire translates a SyncActivityRequest struct into a Thrift-level intermediate
+// ToWire translates a SyncShardStatusRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8889,111 +10592,270 @@ type SyncActivityRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *SyncActivityRequest) ToWire() (wire.Value, error) {
+func (v *SyncShardStatusRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainId != nil {
-		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+	if v.ShardId != nil {
+		w, err = wire.NewValueI64(*(v.ShardId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
-		if err != nil {
-			return w, err
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SyncShardStatusRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SyncShardStatusRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SyncShardStatusRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SyncShardStatusRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
 	}
-	if v.ScheduledId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
+
+	return nil
+}
+
+// String returns a readable string representation of a SyncShardStatusRequest
+// struct.
+func (v *SyncShardStatusRequest) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if v.ScheduledTime != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTime)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+
+	var fields [3]string
+	i := 0
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
 		i++
 	}
-	if v.StartedId != nil {
-		w, err = wire.NewValueI64(*(v.StartedId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
 		i++
 	}
-	if v.StartedTime != nil {
-		w, err = wire.NewValueI64(*(v.StartedTime)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
 		i++
 	}
-	if v.LastHeartbeatTime != nil {
-		w, err = wire.NewValueI64(*(v.LastHeartbeatTime)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
+
+	return fmt.Sprintf("SyncShardStatusRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SyncShardStatusRequest match the
+// provided SyncShardStatusRequest.
+//
+// This function performs a deep comparison.
+func (v *SyncShardStatusRequest) Equals(rhs *SyncShardStatusRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SyncShardStatusRequest.
+func (v *SyncShardStatusRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.ShardId != nil {
+		enc.AddInt64("shardId", *v.ShardId)
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	return err
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *SyncShardStatusRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *SyncShardStatusRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *SyncShardStatusRequest) GetShardId() (o int64) {
+	if v != nil && v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+// IsSetShardId returns true if ShardId is not nil.
+func (v *SyncShardStatusRequest) IsSetShardId() bool {
+	return v != nil && v.ShardId != nil
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *SyncShardStatusRequest) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *SyncShardStatusRequest) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
+}
+
+type TerminateWorkflowExecutionRequest struct {
+	DomainUUID       *string                                   `json:"domainUUID,omitempty"`
+	TerminateRequest *shared.TerminateWorkflowExecutionRequest `json:"terminateRequest,omitempty"`
+}
+
+// ToWire translates a TerminateWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 100, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+	if v.TerminateRequest != nil {
+		w, err = v.TerminateRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 110, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a SyncActivityRequest struct from its Thrift-level
+func _TerminateWorkflowExecutionRequest_Read(w wire.Value) (*shared.TerminateWorkflowExecutionRequest, error) {
+	var v shared.TerminateWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a TerminateWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SyncActivityRequest struct
+// An error is returned if we were unable to build a TerminateWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9001,12 +10863,12 @@ func (v *SyncActivityRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v SyncActivityRequest
+//   var v TerminateWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *SyncActivityRequest) FromWire(w wire.Value) error {
+func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -9015,105 +10877,15 @@ func (v *SyncActivityRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainId = &x
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTime = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTime = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastHeartbeatTime = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Attempt = &x
+			if field.Value.Type() == wire.TStruct {
+				v.TerminateRequest, err = _TerminateWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -9125,104 +10897,41 @@ func (v *SyncActivityRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a SyncActivityRequest
+// String returns a readable string representation of a TerminateWorkflowExecutionRequest
 // struct.
-func (v *SyncActivityRequest) String() string {
+func (v *TerminateWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [2]string
 	i := 0
-	if v.DomainId != nil {
-		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
-		i++
-	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
-		i++
-	}
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
-		i++
-	}
-	if v.ScheduledId != nil {
-		fields[i] = fmt.Sprintf("ScheduledId: %v", *(v.ScheduledId))
-		i++
-	}
-	if v.ScheduledTime != nil {
-		fields[i] = fmt.Sprintf("ScheduledTime: %v", *(v.ScheduledTime))
-		i++
-	}
-	if v.StartedId != nil {
-		fields[i] = fmt.Sprintf("StartedId: %v", *(v.StartedId))
-		i++
-	}
-	if v.StartedTime != nil {
-		fields[i] = fmt.Sprintf("StartedTime: %v", *(v.StartedTime))
-		i++
-	}
-	if v.LastHeartbeatTime != nil {
-		fields[i] = fmt.Sprintf("LastHeartbeatTime: %v", *(v.LastHeartbeatTime))
-		i++
-	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+	if v.TerminateRequest != nil {
+		fields[i] = fmt.Sprintf("TerminateRequest: %v", v.TerminateRequest)
 		i++
 	}
 
-	return fmt.Sprintf("SyncActivityRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TerminateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SyncActivityRequest match the
-// provided SyncActivityRequest.
+// Equals returns true if all the fields of this TerminateWorkflowExecutionRequest match the
+// provided TerminateWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *SyncActivityRequest) Equals(rhs *SyncActivityRequest) bool {
+func (v *TerminateWorkflowExecutionRequest) Equals(rhs *TerminateWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledId, rhs.ScheduledId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledTime, rhs.ScheduledTime) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedId, rhs.StartedId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedTime, rhs.StartedTime) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastHeartbeatTime, rhs.LastHeartbeatTime) {
+	} else if rhs == nil {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+	if !((v.TerminateRequest == nil && rhs.TerminateRequest == nil) || (v.TerminateRequest != nil && rhs.TerminateRequest != nil && v.TerminateRequest.Equals(rhs.TerminateRequest))) {
 		return false
 	}
 
@@ -9230,219 +10939,242 @@ func (v *SyncActivityRequest) Equals(rhs *SyncActivityRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SyncActivityRequest.
-func (v *SyncActivityRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of TerminateWorkflowExecutionRequest.
+func (v *TerminateWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainId != nil {
-		enc.AddString("domainId", *v.DomainId)
-	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
-	}
-	if v.Version != nil {
-		enc.AddInt64("version", *v.Version)
-	}
-	if v.ScheduledId != nil {
-		enc.AddInt64("scheduledId", *v.ScheduledId)
-	}
-	if v.ScheduledTime != nil {
-		enc.AddInt64("scheduledTime", *v.ScheduledTime)
-	}
-	if v.StartedId != nil {
-		enc.AddInt64("startedId", *v.StartedId)
-	}
-	if v.StartedTime != nil {
-		enc.AddInt64("startedTime", *v.StartedTime)
-	}
-	if v.LastHeartbeatTime != nil {
-		enc.AddInt64("lastHeartbeatTime", *v.LastHeartbeatTime)
-	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.Attempt != nil {
-		enc.AddInt32("attempt", *v.Attempt)
+	if v.TerminateRequest != nil {
+		err = multierr.Append(err, enc.AddObject("terminateRequest", v.TerminateRequest))
 	}
 	return err
 }
 
-// GetDomainId returns the value of DomainId if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *SyncActivityRequest) GetDomainId() (o string) {
-	if v != nil && v.DomainId != nil {
-		return *v.DomainId
+func (v *TerminateWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// IsSetDomainId returns true if DomainId is not nil.
-func (v *SyncActivityRequest) IsSetDomainId() bool {
-	return v != nil && v.DomainId != nil
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *TerminateWorkflowExecutionRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
 }
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
+// GetTerminateRequest returns the value of TerminateRequest if it is set or its
 // zero value if it is unset.
-func (v *SyncActivityRequest) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
+func (v *TerminateWorkflowExecutionRequest) GetTerminateRequest() (o *shared.TerminateWorkflowExecutionRequest) {
+	if v != nil && v.TerminateRequest != nil {
+		return v.TerminateRequest
 	}
 
 	return
 }
 
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *SyncActivityRequest) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
+// IsSetTerminateRequest returns true if TerminateRequest is not nil.
+func (v *TerminateWorkflowExecutionRequest) IsSetTerminateRequest() bool {
+	return v != nil && v.TerminateRequest != nil
 }
 
-// GetRunId returns the value of RunId if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityRequest) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
-	}
-
-	return
+type UpdateWorkflowExecutionRequest struct {
+	DomainUUID    *string                                `json:"domainUUID,omitempty"`
+	UpdateRequest *shared.UpdateWorkflowExecutionRequest `json:"updateRequest,omitempty"`
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *SyncActivityRequest) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
-}
+// ToWire translates a UpdateWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UpdateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// GetVersion returns the value of Version if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityRequest) GetVersion() (o int64) {
-	if v != nil && v.Version != nil {
-		return *v.Version
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-
-	return
-}
-
-// IsSetVersion returns true if Version is not nil.
-func (v *SyncActivityRequest) IsSetVersion() bool {
-	return v != nil && v.Version != nil
-}
-
-// GetScheduledId returns the value of ScheduledId if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityRequest) GetScheduledId() (o int64) {
-	if v != nil && v.ScheduledId != nil {
-		return *v.ScheduledId
+	if v.UpdateRequest != nil {
+		w, err = v.UpdateRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// IsSetScheduledId returns true if ScheduledId is not nil.
-func (v *SyncActivityRequest) IsSetScheduledId() bool {
-	return v != nil && v.ScheduledId != nil
+func _UpdateWorkflowExecutionRequest_Read(w wire.Value) (*shared.UpdateWorkflowExecutionRequest, error) {
+	var v shared.UpdateWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
 }
 
-// GetScheduledTime returns the value of ScheduledTime if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityRequest) GetScheduledTime() (o int64) {
-	if v != nil && v.ScheduledTime != nil {
-		return *v.ScheduledTime
-	}
+// FromWire deserializes a UpdateWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UpdateWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UpdateWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UpdateWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
 
-	return
-}
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
 
-// IsSetScheduledTime returns true if ScheduledTime is not nil.
-func (v *SyncActivityRequest) IsSetScheduledTime() bool {
-	return v != nil && v.ScheduledTime != nil
-}
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.UpdateRequest, err = _UpdateWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-// GetStartedId returns the value of StartedId if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityRequest) GetStartedId() (o int64) {
-	if v != nil && v.StartedId != nil {
-		return *v.StartedId
+			}
+		}
 	}
 
-	return
-}
-
-// IsSetStartedId returns true if StartedId is not nil.
-func (v *SyncActivityRequest) IsSetStartedId() bool {
-	return v != nil && v.StartedId != nil
+	return nil
 }
 
-// GetStartedTime returns the value of StartedTime if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityRequest) GetStartedTime() (o int64) {
-	if v != nil && v.StartedTime != nil {
-		return *v.StartedTime
+// String returns a readable string representation of a UpdateWorkflowExecutionRequest
+// struct.
+func (v *UpdateWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.UpdateRequest != nil {
+		fields[i] = fmt.Sprintf("UpdateRequest: %v", v.UpdateRequest)
+		i++
+	}
 
-// IsSetStartedTime returns true if StartedTime is not nil.
-func (v *SyncActivityRequest) IsSetStartedTime() bool {
-	return v != nil && v.StartedTime != nil
+	return fmt.Sprintf("UpdateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetLastHeartbeatTime returns the value of LastHeartbeatTime if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityRequest) GetLastHeartbeatTime() (o int64) {
-	if v != nil && v.LastHeartbeatTime != nil {
-		return *v.LastHeartbeatTime
+// Equals returns true if all the fields of this UpdateWorkflowExecutionRequest match the
+// provided UpdateWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *UpdateWorkflowExecutionRequest) Equals(rhs *UpdateWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.UpdateRequest == nil && rhs.UpdateRequest == nil) || (v.UpdateRequest != nil && rhs.UpdateRequest != nil && v.UpdateRequest.Equals(rhs.UpdateRequest))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetLastHeartbeatTime returns true if LastHeartbeatTime is not nil.
-func (v *SyncActivityRequest) IsSetLastHeartbeatTime() bool {
-	return v != nil && v.LastHeartbeatTime != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UpdateWorkflowExecutionRequest.
+func (v *UpdateWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.UpdateRequest != nil {
+		err = multierr.Append(err, enc.AddObject("updateRequest", v.UpdateRequest))
+	}
+	return err
 }
 
-// GetDetails returns the value of Details if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityRequest) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *SyncActivityRequest) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
 }
 
-// GetAttempt returns the value of Attempt if it is set or its
+// GetUpdateRequest returns the value of UpdateRequest if it is set or its
 // zero value if it is unset.
-func (v *SyncActivityRequest) GetAttempt() (o int32) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
+func (v *UpdateWorkflowExecutionRequest) GetUpdateRequest() (o *shared.UpdateWorkflowExecutionRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
 	}
 
 	return
 }
 
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *SyncActivityRequest) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
+// IsSetUpdateRequest returns true if UpdateRequest is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetUpdateRequest() bool {
+	return v != nil && v.UpdateRequest != nil
 }
 
-type SyncShardStatusRequest struct {
-	SourceCluster *string `json:"sourceCluster,omitempty"`
-	ShardId       *int64  `json:"shardId,omitempty"`
-	Timestamp     *int64  `json:"timestamp,omitempty"`
+type VerifyWorkflowExecutionHistoryRequest struct {
+	DomainUUID *string                   `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
 }
 
-// ToWire translates a SyncShardStatusRequest struct into a Thrift-level intermediate
+// ToWire translates a VerifyWorkflowExecutionHistoryRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9457,47 +11189,39 @@ type SyncShardStatusRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *SyncShardStatusRequest) ToWire() (wire.Value, error) {
+func (v *VerifyWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.SourceCluster != nil {
-		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ShardId != nil {
-		w, err = wire.NewValueI64(*(v.ShardId)), error(nil)
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Timestamp != nil {
-		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a SyncShardStatusRequest struct from its Thrift-level
+// FromWire deserializes a VerifyWorkflowExecutionHistoryRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SyncShardStatusRequest struct
+// An error is returned if we were unable to build a VerifyWorkflowExecutionHistoryRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9505,12 +11229,12 @@ func (v *SyncShardStatusRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v SyncShardStatusRequest
+//   var v VerifyWorkflowExecutionHistoryRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *SyncShardStatusRequest) FromWire(w wire.Value) error {
+func (v *VerifyWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -9519,27 +11243,15 @@ func (v *SyncShardStatusRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.SourceCluster = &x
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ShardId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Timestamp = &x
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -9551,48 +11263,41 @@ func (v *SyncShardStatusRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a SyncShardStatusRequest
+// String returns a readable string representation of a VerifyWorkflowExecutionHistoryRequest
 // struct.
-func (v *SyncShardStatusRequest) String() string {
+func (v *VerifyWorkflowExecutionHistoryRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
-	if v.SourceCluster != nil {
-		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
-		i++
-	}
-	if v.ShardId != nil {
-		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.Timestamp != nil {
-		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
 
-	return fmt.Sprintf("SyncShardStatusRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("VerifyWorkflowExecutionHistoryRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SyncShardStatusRequest match the
-// provided SyncShardStatusRequest.
+// Equals returns true if all the fields of this VerifyWorkflowExecutionHistoryRequest match the
+// provided VerifyWorkflowExecutionHistoryRequest.
 //
 // This function performs a deep comparison.
-func (v *SyncShardStatusRequest) Equals(rhs *SyncShardStatusRequest) bool {
+func (v *VerifyWorkflowExecutionHistoryRequest) Equals(rhs *VerifyWorkflowExecutionHistoryRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ShardId, rhs.ShardId) {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
 
@@ -9600,74 +11305,81 @@ func (v *SyncShardStatusRequest) Equals(rhs *SyncShardStatusRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SyncShardStatusRequest.
-func (v *SyncShardStatusRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of VerifyWorkflowExecutionHistoryRequest.
+func (v *VerifyWorkflowExecutionHistoryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.SourceCluster != nil {
-		enc.AddString("sourceCluster", *v.SourceCluster)
-	}
-	if v.ShardId != nil {
-		enc.AddInt64("shardId", *v.ShardId)
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.Timestamp != nil {
-		enc.AddInt64("timestamp", *v.Timestamp)
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
 	}
 	return err
 }
 
-// GetSourceCluster returns the value of SourceCluster if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *SyncShardStatusRequest) GetSourceCluster() (o string) {
-	if v != nil && v.SourceCluster != nil {
-		return *v.SourceCluster
+func (v *VerifyWorkflowExecutionHistoryRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// IsSetSourceCluster returns true if SourceCluster is not nil.
-func (v *SyncShardStatusRequest) IsSetSourceCluster() bool {
-	return v != nil && v.SourceCluster != nil
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *VerifyWorkflowExecutionHistoryRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
 }
 
-// GetShardId returns the value of ShardId if it is set or its
+// GetExecution returns the value of Execution if it is set or its
 // zero value if it is unset.
-func (v *SyncShardStatusRequest) GetShardId() (o int64) {
-	if v != nil && v.ShardId != nil {
-		return *v.ShardId
+func (v *VerifyWorkflowExecutionHistoryRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
 	}
 
 	return
 }
 
-// IsSetShardId returns true if ShardId is not nil.
-func (v *SyncShardStatusRequest) IsSetShardId() bool {
-	return v != nil && v.ShardId != nil
+// IsSetExecution returns true if Execution is not nil.
+func (v *VerifyWorkflowExecutionHistoryRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
 }
 
-// GetTimestamp returns the value of Timestamp if it is set or its
-// zero value if it is unset.
-func (v *SyncShardStatusRequest) GetTimestamp() (o int64) {
-	if v != nil && v.Timestamp != nil {
-		return *v.Timestamp
-	}
+type VerifyWorkflowExecutionHistoryResponse struct {
+	Mismatches []string `json:"mismatches,omitempty"`
+}
 
-	return
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// IsSetTimestamp returns true if Timestamp is not nil.
-func (v *SyncShardStatusRequest) IsSetTimestamp() bool {
-	return v != nil && v.Timestamp != nil
+func (v _List_String_ValueList) Size() int {
+	return len(v)
 }
 
-type TerminateWorkflowExecutionRequest struct {
-	DomainUUID       *string                                   `json:"domainUUID,omitempty"`
-	TerminateRequest *shared.TerminateWorkflowExecutionRequest `json:"terminateRequest,omitempty"`
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
 }
 
-// ToWire translates a TerminateWorkflowExecutionRequest struct into a Thrift-level intermediate
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a VerifyWorkflowExecutionHistoryResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9682,45 +11394,49 @@ type TerminateWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *VerifyWorkflowExecutionHistoryResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.Mismatches != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Mismatches)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TerminateRequest != nil {
-		w, err = v.TerminateRequest.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _TerminateWorkflowExecutionRequest_Read(w wire.Value) (*shared.TerminateWorkflowExecutionRequest, error) {
-	var v shared.TerminateWorkflowExecutionRequest
-	err := v.FromWire(w)
-	return &v, err
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-// FromWire deserializes a TerminateWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a VerifyWorkflowExecutionHistoryResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TerminateWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a VerifyWorkflowExecutionHistoryResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9728,29 +11444,19 @@ func _TerminateWorkflowExecutionRequest_Read(w wire.Value) (*shared.TerminateWor
 //     return nil, err
 //   }
 //
-//   var v TerminateWorkflowExecutionRequest
+//   var v VerifyWorkflowExecutionHistoryResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *VerifyWorkflowExecutionHistoryResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.TerminateRequest, err = _TerminateWorkflowExecutionRequest_Read(field.Value)
+			if field.Value.Type() == wire.TList {
+				v.Mismatches, err = _List_String_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -9762,88 +11468,89 @@ func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TerminateWorkflowExecutionRequest
+// String returns a readable string representation of a VerifyWorkflowExecutionHistoryResponse
 // struct.
-func (v *TerminateWorkflowExecutionRequest) String() string {
+func (v *VerifyWorkflowExecutionHistoryResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.Mismatches != nil {
+		fields[i] = fmt.Sprintf("Mismatches: %v", v.Mismatches)
 		i++
 	}
-	if v.TerminateRequest != nil {
-		fields[i] = fmt.Sprintf("TerminateRequest: %v", v.TerminateRequest)
-		i++
+
+	return fmt.Sprintf("VerifyWorkflowExecutionHistoryResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
 
-	return fmt.Sprintf("TerminateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
 }
 
-// Equals returns true if all the fields of this TerminateWorkflowExecutionRequest match the
-// provided TerminateWorkflowExecutionRequest.
+// Equals returns true if all the fields of this VerifyWorkflowExecutionHistoryResponse match the
+// provided VerifyWorkflowExecutionHistoryResponse.
 //
 // This function performs a deep comparison.
-func (v *TerminateWorkflowExecutionRequest) Equals(rhs *TerminateWorkflowExecutionRequest) bool {
+func (v *VerifyWorkflowExecutionHistoryResponse) Equals(rhs *VerifyWorkflowExecutionHistoryResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.TerminateRequest == nil && rhs.TerminateRequest == nil) || (v.TerminateRequest != nil && rhs.TerminateRequest != nil && v.TerminateRequest.Equals(rhs.TerminateRequest))) {
+	if !((v.Mismatches == nil && rhs.Mismatches == nil) || (v.Mismatches != nil && rhs.Mismatches != nil && _List_String_Equals(v.Mismatches, rhs.Mismatches))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TerminateWorkflowExecutionRequest.
-func (v *TerminateWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of VerifyWorkflowExecutionHistoryResponse.
+func (v *VerifyWorkflowExecutionHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
-	}
-	if v.TerminateRequest != nil {
-		err = multierr.Append(err, enc.AddObject("terminateRequest", v.TerminateRequest))
+	if v.Mismatches != nil {
+		err = multierr.Append(err, enc.AddArray("mismatches", (_List_String_Zapper)(v.Mismatches)))
 	}
 	return err
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
-// zero value if it is unset.
-func (v *TerminateWorkflowExecutionRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
-	}
-
-	return
-}
-
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *TerminateWorkflowExecutionRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
-}
-
-// GetTerminateRequest returns the value of TerminateRequest if it is set or its
+// GetMismatches returns the value of Mismatches if it is set or its
 // zero value if it is unset.
-func (v *TerminateWorkflowExecutionRequest) GetTerminateRequest() (o *shared.TerminateWorkflowExecutionRequest) {
-	if v != nil && v.TerminateRequest != nil {
-		return v.TerminateRequest
+func (v *VerifyWorkflowExecutionHistoryResponse) GetMismatches() (o []string) {
+	if v != nil && v.Mismatches != nil {
+		return v.Mismatches
 	}
 
 	return
 }
 
-// IsSetTerminateRequest returns true if TerminateRequest is not nil.
-func (v *TerminateWorkflowExecutionRequest) IsSetTerminateRequest() bool {
-	return v != nil && v.TerminateRequest != nil
+// IsSetMismatches returns true if Mismatches is not nil.
+func (v *VerifyWorkflowExecutionHistoryResponse) IsSetMismatches() bool {
+	return v != nil && v.Mismatches != nil
 }
@@ -36,6 +36,12 @@ import (
 
 // Interface is a client for the MatchingService service.
 type Interface interface {
+	AcquireDispatchTokens(
+		ctx context.Context,
+		Request *matching.AcquireDispatchTokensRequest,
+		opts ...yarpc.CallOption,
+	) (*matching.AcquireDispatchTokensResponse, error)
+
 	AddActivityTask(
 		ctx context.Context,
 		AddRequest *matching.AddActivityTaskRequest,
@@ -60,6 +66,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	MigrateTaskListBacklog(
+		ctx context.Context,
+		Request *matching.MigrateTaskListBacklogRequest,
+		opts ...yarpc.CallOption,
+	) (*matching.MigrateTaskListBacklogResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -72,6 +84,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*matching.PollForDecisionTaskResponse, error)
 
+	PromoteTaskListBuild(
+		ctx context.Context,
+		Request *matching.PromoteTaskListBuildRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	QueryWorkflow(
 		ctx context.Context,
 		QueryRequest *matching.QueryWorkflowRequest,
@@ -109,6 +127,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) AcquireDispatchTokens(
+	ctx context.Context,
+	_Request *matching.AcquireDispatchTokensRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.AcquireDispatchTokensResponse, err error) {
+
+	args := matching.MatchingService_AcquireDispatchTokens_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_AcquireDispatchTokens_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = matching.MatchingService_AcquireDispatchTokens_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) AddActivityTask(
 	ctx context.Context,
 	_AddRequest *matching.AddActivityTaskRequest,
@@ -201,6 +242,29 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) MigrateTaskListBacklog(
+	ctx context.Context,
+	_Request *matching.MigrateTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.MigrateTaskListBacklogResponse, err error) {
+
+	args := matching.MatchingService_MigrateTaskListBacklog_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_MigrateTaskListBacklog_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = matching.MatchingService_MigrateTaskListBacklog_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *matching.PollForActivityTaskRequest,
@@ -247,6 +311,29 @@ func (c client) PollForDecisionTask(
 	return
 }
 
+func (c client) PromoteTaskListBuild(
+	ctx context.Context,
+	_Request *matching.PromoteTaskListBuildRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_PromoteTaskListBuild_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_PromoteTaskListBuild_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_PromoteTaskListBuild_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) QueryWorkflow(
 	ctx context.Context,
 	_QueryRequest *matching.QueryWorkflowRequest,
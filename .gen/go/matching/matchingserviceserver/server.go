@@ -34,6 +34,11 @@ import (
 
 // Interface is the server-side interface for the MatchingService service.
 type Interface interface {
+	AcquireDispatchTokens(
+		ctx context.Context,
+		Request *matching.AcquireDispatchTokensRequest,
+	) (*matching.AcquireDispatchTokensResponse, error)
+
 	AddActivityTask(
 		ctx context.Context,
 		AddRequest *matching.AddActivityTaskRequest,
@@ -54,6 +59,11 @@ type Interface interface {
 		Request *matching.DescribeTaskListRequest,
 	) (*shared.DescribeTaskListResponse, error)
 
+	MigrateTaskListBacklog(
+		ctx context.Context,
+		Request *matching.MigrateTaskListBacklogRequest,
+	) (*matching.MigrateTaskListBacklogResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -64,6 +74,11 @@ type Interface interface {
 		PollRequest *matching.PollForDecisionTaskRequest,
 	) (*matching.PollForDecisionTaskResponse, error)
 
+	PromoteTaskListBuild(
+		ctx context.Context,
+		Request *matching.PromoteTaskListBuildRequest,
+	) error
+
 	QueryWorkflow(
 		ctx context.Context,
 		QueryRequest *matching.QueryWorkflowRequest,
@@ -86,6 +101,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "MatchingService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "AcquireDispatchTokens",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.AcquireDispatchTokens),
+				},
+				Signature:    "AcquireDispatchTokens(Request *matching.AcquireDispatchTokensRequest) (*matching.AcquireDispatchTokensResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "AddActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -130,6 +156,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MigrateTaskListBacklog",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MigrateTaskListBacklog),
+				},
+				Signature:    "MigrateTaskListBacklog(Request *matching.MigrateTaskListBacklogRequest) (*matching.MigrateTaskListBacklogResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -152,6 +189,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PromoteTaskListBuild",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PromoteTaskListBuild),
+				},
+				Signature:    "PromoteTaskListBuild(Request *matching.PromoteTaskListBuildRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "QueryWorkflow",
 				HandlerSpec: thrift.HandlerSpec{
@@ -176,13 +224,32 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 8)
+	procedures := make([]transport.Procedure, 0, 11)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) AcquireDispatchTokens(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_AcquireDispatchTokens_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.AcquireDispatchTokens(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_AcquireDispatchTokens_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) AddActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_AddActivityTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -259,6 +326,25 @@ func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) MigrateTaskListBacklog(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_MigrateTaskListBacklog_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.MigrateTaskListBacklog(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_MigrateTaskListBacklog_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -297,6 +383,25 @@ func (h handler) PollForDecisionTask(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
+func (h handler) PromoteTaskListBuild(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_PromoteTaskListBuild_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PromoteTaskListBuild(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_PromoteTaskListBuild_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_QueryWorkflow_Args
 	if err := args.FromWire(body); err != nil {
@@ -63,6 +63,39 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// AcquireDispatchTokens responds to a AcquireDispatchTokens call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().AcquireDispatchTokens(gomock.Any(), ...).Return(...)
+// 	... := client.AcquireDispatchTokens(...)
+func (m *MockClient) AcquireDispatchTokens(
+	ctx context.Context,
+	_Request *matching.AcquireDispatchTokensRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.AcquireDispatchTokensResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "AcquireDispatchTokens", args...)
+	success, _ = ret[i].(*matching.AcquireDispatchTokensResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) AcquireDispatchTokens(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "AcquireDispatchTokens", args...)
+}
+
 // AddActivityTask responds to a AddActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -189,6 +222,39 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskList", args...)
 }
 
+// MigrateTaskListBacklog responds to a MigrateTaskListBacklog call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().MigrateTaskListBacklog(gomock.Any(), ...).Return(...)
+// 	... := client.MigrateTaskListBacklog(...)
+func (m *MockClient) MigrateTaskListBacklog(
+	ctx context.Context,
+	_Request *matching.MigrateTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.MigrateTaskListBacklogResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MigrateTaskListBacklog", args...)
+	success, _ = ret[i].(*matching.MigrateTaskListBacklogResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MigrateTaskListBacklog(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MigrateTaskListBacklog", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -255,6 +321,37 @@ func (mr *_MockClientRecorder) PollForDecisionTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "PollForDecisionTask", args...)
 }
 
+// PromoteTaskListBuild responds to a PromoteTaskListBuild call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PromoteTaskListBuild(gomock.Any(), ...).Return(...)
+// 	... := client.PromoteTaskListBuild(...)
+func (m *MockClient) PromoteTaskListBuild(
+	ctx context.Context,
+	_Request *matching.PromoteTaskListBuildRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PromoteTaskListBuild", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PromoteTaskListBuild(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PromoteTaskListBuild", args...)
+}
+
 // QueryWorkflow responds to a QueryWorkflow call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
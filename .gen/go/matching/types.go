@@ -34,16 +34,18 @@ import (
 	strings "strings"
 )
 
-type AddActivityTaskRequest struct {
-	DomainUUID                    *string                   `json:"domainUUID,omitempty"`
-	Execution                     *shared.WorkflowExecution `json:"execution,omitempty"`
-	SourceDomainUUID              *string                   `json:"sourceDomainUUID,omitempty"`
-	TaskList                      *shared.TaskList          `json:"taskList,omitempty"`
-	ScheduleId                    *int64                    `json:"scheduleId,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
-}
-
-// ToWire translates a AddActivityTaskRequest struct into a Thrift-level intermediate
+// MatchingService API is exposed to provide support for polling from long running applications.
+// Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
+// DecisionTask, application is expected to process the history of events for that session and respond back with next
+// decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
+// with completion or failure.
+type AcquireDispatchTokensRequest struct {
+	DomainUUID   *string `json:"domainUUID,omitempty"`
+	ActivityType *string `json:"activityType,omitempty"`
+	Count        *int32  `json:"count,omitempty"`
+}
+
+// ToWire translates a AcquireDispatchTokensRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -58,9 +60,9 @@ type AddActivityTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
+func (v *AcquireDispatchTokensRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -74,67 +76,31 @@ func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.ActivityType != nil {
+		w, err = wire.NewValueString(*(v.ActivityType)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.SourceDomainUUID != nil {
-		w, err = wire.NewValueString(*(v.SourceDomainUUID)), error(nil)
+	if v.Count != nil {
+		w, err = wire.NewValueI32(*(v.Count)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ScheduleId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
-	var v shared.WorkflowExecution
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
-	var v shared.TaskList
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AddActivityTaskRequest struct from its Thrift-level
+// FromWire deserializes a AcquireDispatchTokensRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AddActivityTaskRequest struct
+// An error is returned if we were unable to build a AcquireDispatchTokensRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -142,12 +108,12 @@ func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
 //     return nil, err
 //   }
 //
-//   var v AddActivityTaskRequest
+//   var v AcquireDispatchTokensRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AddActivityTaskRequest) FromWire(w wire.Value) error {
+func (v *AcquireDispatchTokensRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -163,46 +129,20 @@ func (v *AddActivityTaskRequest) FromWire(w wire.Value) error {
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.SourceDomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduleId = &x
+				v.ActivityType = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 60:
+		case 30:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.ScheduleToStartTimeoutSeconds = &x
+				v.Count = &x
 				if err != nil {
 					return err
 				}
@@ -214,41 +154,29 @@ func (v *AddActivityTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AddActivityTaskRequest
+// String returns a readable string representation of a AcquireDispatchTokensRequest
 // struct.
-func (v *AddActivityTaskRequest) String() string {
+func (v *AcquireDispatchTokensRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [3]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
-	}
-	if v.SourceDomainUUID != nil {
-		fields[i] = fmt.Sprintf("SourceDomainUUID: %v", *(v.SourceDomainUUID))
-		i++
-	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
-	}
-	if v.ScheduleId != nil {
-		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", *(v.ActivityType))
 		i++
 	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
 		i++
 	}
 
-	return fmt.Sprintf("AddActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AcquireDispatchTokensRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
 func _String_EqualsPtr(lhs, rhs *string) bool {
@@ -261,16 +189,6 @@ func _String_EqualsPtr(lhs, rhs *string) bool {
 	return lhs == nil && rhs == nil
 }
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 func _I32_EqualsPtr(lhs, rhs *int32) bool {
 	if lhs != nil && rhs != nil {
 
@@ -281,11 +199,11 @@ func _I32_EqualsPtr(lhs, rhs *int32) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this AddActivityTaskRequest match the
-// provided AddActivityTaskRequest.
+// Equals returns true if all the fields of this AcquireDispatchTokensRequest match the
+// provided AcquireDispatchTokensRequest.
 //
 // This function performs a deep comparison.
-func (v *AddActivityTaskRequest) Equals(rhs *AddActivityTaskRequest) bool {
+func (v *AcquireDispatchTokensRequest) Equals(rhs *AcquireDispatchTokensRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -294,19 +212,10 @@ func (v *AddActivityTaskRequest) Equals(rhs *AddActivityTaskRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.SourceDomainUUID, rhs.SourceDomainUUID) {
-		return false
-	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+	if !_String_EqualsPtr(v.ActivityType, rhs.ActivityType) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+	if !_I32_EqualsPtr(v.Count, rhs.Count) {
 		return false
 	}
 
@@ -314,35 +223,26 @@ func (v *AddActivityTaskRequest) Equals(rhs *AddActivityTaskRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AddActivityTaskRequest.
-func (v *AddActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AcquireDispatchTokensRequest.
+func (v *AcquireDispatchTokensRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
-	}
-	if v.SourceDomainUUID != nil {
-		enc.AddString("sourceDomainUUID", *v.SourceDomainUUID)
-	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
-	}
-	if v.ScheduleId != nil {
-		enc.AddInt64("scheduleId", *v.ScheduleId)
+	if v.ActivityType != nil {
+		enc.AddString("activityType", *v.ActivityType)
 	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
+	if v.Count != nil {
+		enc.AddInt32("count", *v.Count)
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *AddActivityTaskRequest) GetDomainUUID() (o string) {
+func (v *AcquireDispatchTokensRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -351,94 +251,45 @@ func (v *AddActivityTaskRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *AddActivityTaskRequest) IsSetDomainUUID() bool {
+func (v *AcquireDispatchTokensRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetExecution returns the value of Execution if it is set or its
-// zero value if it is unset.
-func (v *AddActivityTaskRequest) GetExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
-	}
-
-	return
-}
-
-// IsSetExecution returns true if Execution is not nil.
-func (v *AddActivityTaskRequest) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
-}
-
-// GetSourceDomainUUID returns the value of SourceDomainUUID if it is set or its
-// zero value if it is unset.
-func (v *AddActivityTaskRequest) GetSourceDomainUUID() (o string) {
-	if v != nil && v.SourceDomainUUID != nil {
-		return *v.SourceDomainUUID
-	}
-
-	return
-}
-
-// IsSetSourceDomainUUID returns true if SourceDomainUUID is not nil.
-func (v *AddActivityTaskRequest) IsSetSourceDomainUUID() bool {
-	return v != nil && v.SourceDomainUUID != nil
-}
-
-// GetTaskList returns the value of TaskList if it is set or its
-// zero value if it is unset.
-func (v *AddActivityTaskRequest) GetTaskList() (o *shared.TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
-	}
-
-	return
-}
-
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *AddActivityTaskRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
-}
-
-// GetScheduleId returns the value of ScheduleId if it is set or its
+// GetActivityType returns the value of ActivityType if it is set or its
 // zero value if it is unset.
-func (v *AddActivityTaskRequest) GetScheduleId() (o int64) {
-	if v != nil && v.ScheduleId != nil {
-		return *v.ScheduleId
+func (v *AcquireDispatchTokensRequest) GetActivityType() (o string) {
+	if v != nil && v.ActivityType != nil {
+		return *v.ActivityType
 	}
 
 	return
 }
 
-// IsSetScheduleId returns true if ScheduleId is not nil.
-func (v *AddActivityTaskRequest) IsSetScheduleId() bool {
-	return v != nil && v.ScheduleId != nil
+// IsSetActivityType returns true if ActivityType is not nil.
+func (v *AcquireDispatchTokensRequest) IsSetActivityType() bool {
+	return v != nil && v.ActivityType != nil
 }
 
-// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
+// GetCount returns the value of Count if it is set or its
 // zero value if it is unset.
-func (v *AddActivityTaskRequest) GetScheduleToStartTimeoutSeconds() (o int32) {
-	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
-		return *v.ScheduleToStartTimeoutSeconds
+func (v *AcquireDispatchTokensRequest) GetCount() (o int32) {
+	if v != nil && v.Count != nil {
+		return *v.Count
 	}
 
 	return
 }
 
-// IsSetScheduleToStartTimeoutSeconds returns true if ScheduleToStartTimeoutSeconds is not nil.
-func (v *AddActivityTaskRequest) IsSetScheduleToStartTimeoutSeconds() bool {
-	return v != nil && v.ScheduleToStartTimeoutSeconds != nil
+// IsSetCount returns true if Count is not nil.
+func (v *AcquireDispatchTokensRequest) IsSetCount() bool {
+	return v != nil && v.Count != nil
 }
 
-type AddDecisionTaskRequest struct {
-	DomainUUID                    *string                   `json:"domainUUID,omitempty"`
-	Execution                     *shared.WorkflowExecution `json:"execution,omitempty"`
-	TaskList                      *shared.TaskList          `json:"taskList,omitempty"`
-	ScheduleId                    *int64                    `json:"scheduleId,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
+type AcquireDispatchTokensResponse struct {
+	Granted *int32 `json:"granted,omitempty"`
 }
 
-// ToWire translates a AddDecisionTaskRequest struct into a Thrift-level intermediate
+// ToWire translates a AcquireDispatchTokensResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -453,63 +304,31 @@ type AddDecisionTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AddDecisionTaskRequest) ToWire() (wire.Value, error) {
+func (v *AcquireDispatchTokensResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.Granted != nil {
+		w, err = wire.NewValueI32(*(v.Granted)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.ScheduleId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AddDecisionTaskRequest struct from its Thrift-level
+// FromWire deserializes a AcquireDispatchTokensResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AddDecisionTaskRequest struct
+// An error is returned if we were unable to build a AcquireDispatchTokensResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -517,19 +336,223 @@ func (v *AddDecisionTaskRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v AddDecisionTaskRequest
+//   var v AcquireDispatchTokensResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AddDecisionTaskRequest) FromWire(w wire.Value) error {
+func (v *AcquireDispatchTokensResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Granted = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AcquireDispatchTokensResponse
+// struct.
+func (v *AcquireDispatchTokensResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Granted != nil {
+		fields[i] = fmt.Sprintf("Granted: %v", *(v.Granted))
+		i++
+	}
+
+	return fmt.Sprintf("AcquireDispatchTokensResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AcquireDispatchTokensResponse match the
+// provided AcquireDispatchTokensResponse.
+//
+// This function performs a deep comparison.
+func (v *AcquireDispatchTokensResponse) Equals(rhs *AcquireDispatchTokensResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Granted, rhs.Granted) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AcquireDispatchTokensResponse.
+func (v *AcquireDispatchTokensResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Granted != nil {
+		enc.AddInt32("granted", *v.Granted)
+	}
+	return err
+}
+
+// GetGranted returns the value of Granted if it is set or its
+// zero value if it is unset.
+func (v *AcquireDispatchTokensResponse) GetGranted() (o int32) {
+	if v != nil && v.Granted != nil {
+		return *v.Granted
+	}
+
+	return
+}
+
+// IsSetGranted returns true if Granted is not nil.
+func (v *AcquireDispatchTokensResponse) IsSetGranted() bool {
+	return v != nil && v.Granted != nil
+}
+
+type AddActivityTaskRequest struct {
+	DomainUUID                    *string                   `json:"domainUUID,omitempty"`
+	Execution                     *shared.WorkflowExecution `json:"execution,omitempty"`
+	SourceDomainUUID              *string                   `json:"sourceDomainUUID,omitempty"`
+	TaskList                      *shared.TaskList          `json:"taskList,omitempty"`
+	ScheduleId                    *int64                    `json:"scheduleId,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	ActivityType                  *string                   `json:"activityType,omitempty"`
+}
+
+// ToWire translates a AddActivityTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.SourceDomainUUID != nil {
+		w, err = wire.NewValueString(*(v.SourceDomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ScheduleId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ActivityType != nil {
+		w, err = wire.NewValueString(*(v.ActivityType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
+	var v shared.WorkflowExecution
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
+	var v shared.TaskList
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AddActivityTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AddActivityTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AddActivityTaskRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AddActivityTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
 				x, err = field.Value.GetString(), error(nil)
 				v.DomainUUID = &x
 				if err != nil {
@@ -546,28 +569,991 @@ func (v *AddDecisionTaskRequest) FromWire(w wire.Value) error {
 
 			}
 		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceDomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TStruct {
 				v.TaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduleId = &x
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduleId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToStartTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AddActivityTaskRequest
+// struct.
+func (v *AddActivityTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.SourceDomainUUID != nil {
+		fields[i] = fmt.Sprintf("SourceDomainUUID: %v", *(v.SourceDomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.ScheduleId != nil {
+		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+		i++
+	}
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", *(v.ActivityType))
+		i++
+	}
+
+	return fmt.Sprintf("AddActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this AddActivityTaskRequest match the
+// provided AddActivityTaskRequest.
+//
+// This function performs a deep comparison.
+func (v *AddActivityTaskRequest) Equals(rhs *AddActivityTaskRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceDomainUUID, rhs.SourceDomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActivityType, rhs.ActivityType) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AddActivityTaskRequest.
+func (v *AddActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.SourceDomainUUID != nil {
+		enc.AddString("sourceDomainUUID", *v.SourceDomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.ScheduleId != nil {
+		enc.AddInt64("scheduleId", *v.ScheduleId)
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
+	}
+	if v.ActivityType != nil {
+		enc.AddString("activityType", *v.ActivityType)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *AddActivityTaskRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *AddActivityTaskRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetSourceDomainUUID returns the value of SourceDomainUUID if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetSourceDomainUUID() (o string) {
+	if v != nil && v.SourceDomainUUID != nil {
+		return *v.SourceDomainUUID
+	}
+
+	return
+}
+
+// IsSetSourceDomainUUID returns true if SourceDomainUUID is not nil.
+func (v *AddActivityTaskRequest) IsSetSourceDomainUUID() bool {
+	return v != nil && v.SourceDomainUUID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *AddActivityTaskRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetScheduleId returns the value of ScheduleId if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetScheduleId() (o int64) {
+	if v != nil && v.ScheduleId != nil {
+		return *v.ScheduleId
+	}
+
+	return
+}
+
+// IsSetScheduleId returns true if ScheduleId is not nil.
+func (v *AddActivityTaskRequest) IsSetScheduleId() bool {
+	return v != nil && v.ScheduleId != nil
+}
+
+// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetScheduleToStartTimeoutSeconds returns true if ScheduleToStartTimeoutSeconds is not nil.
+func (v *AddActivityTaskRequest) IsSetScheduleToStartTimeoutSeconds() bool {
+	return v != nil && v.ScheduleToStartTimeoutSeconds != nil
+}
+
+// GetActivityType returns the value of ActivityType if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetActivityType() (o string) {
+	if v != nil && v.ActivityType != nil {
+		return *v.ActivityType
+	}
+
+	return
+}
+
+// IsSetActivityType returns true if ActivityType is not nil.
+func (v *AddActivityTaskRequest) IsSetActivityType() bool {
+	return v != nil && v.ActivityType != nil
+}
+
+type AddDecisionTaskRequest struct {
+	DomainUUID                    *string                   `json:"domainUUID,omitempty"`
+	Execution                     *shared.WorkflowExecution `json:"execution,omitempty"`
+	TaskList                      *shared.TaskList          `json:"taskList,omitempty"`
+	ScheduleId                    *int64                    `json:"scheduleId,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	BinaryChecksum                *string                   `json:"binaryChecksum,omitempty"`
+}
+
+// ToWire translates a AddDecisionTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AddDecisionTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ScheduleId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AddDecisionTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AddDecisionTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AddDecisionTaskRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AddDecisionTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduleId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToStartTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AddDecisionTaskRequest
+// struct.
+func (v *AddDecisionTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.ScheduleId != nil {
+		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
+		i++
+	}
+
+	return fmt.Sprintf("AddDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AddDecisionTaskRequest match the
+// provided AddDecisionTaskRequest.
+//
+// This function performs a deep comparison.
+func (v *AddDecisionTaskRequest) Equals(rhs *AddDecisionTaskRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+		return false
+	}
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AddDecisionTaskRequest.
+func (v *AddDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.ScheduleId != nil {
+		enc.AddInt64("scheduleId", *v.ScheduleId)
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
+	}
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *AddDecisionTaskRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *AddDecisionTaskRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *AddDecisionTaskRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetScheduleId returns the value of ScheduleId if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetScheduleId() (o int64) {
+	if v != nil && v.ScheduleId != nil {
+		return *v.ScheduleId
+	}
+
+	return
+}
+
+// IsSetScheduleId returns true if ScheduleId is not nil.
+func (v *AddDecisionTaskRequest) IsSetScheduleId() bool {
+	return v != nil && v.ScheduleId != nil
+}
+
+// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetScheduleToStartTimeoutSeconds returns true if ScheduleToStartTimeoutSeconds is not nil.
+func (v *AddDecisionTaskRequest) IsSetScheduleToStartTimeoutSeconds() bool {
+	return v != nil && v.ScheduleToStartTimeoutSeconds != nil
+}
+
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetBinaryChecksum() (o string) {
+	if v != nil && v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
+	}
+
+	return
+}
+
+// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
+func (v *AddDecisionTaskRequest) IsSetBinaryChecksum() bool {
+	return v != nil && v.BinaryChecksum != nil
+}
+
+type CancelOutstandingPollRequest struct {
+	DomainUUID   *string          `json:"domainUUID,omitempty"`
+	TaskListType *int32           `json:"taskListType,omitempty"`
+	TaskList     *shared.TaskList `json:"taskList,omitempty"`
+	PollerID     *string          `json:"pollerID,omitempty"`
+}
+
+// ToWire translates a CancelOutstandingPollRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CancelOutstandingPollRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskListType != nil {
+		w, err = wire.NewValueI32(*(v.TaskListType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PollerID != nil {
+		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a CancelOutstandingPollRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CancelOutstandingPollRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CancelOutstandingPollRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CancelOutstandingPollRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PollerID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a CancelOutstandingPollRequest
+// struct.
+func (v *CancelOutstandingPollRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.PollerID != nil {
+		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
+		i++
+	}
+
+	return fmt.Sprintf("CancelOutstandingPollRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this CancelOutstandingPollRequest match the
+// provided CancelOutstandingPollRequest.
+//
+// This function performs a deep comparison.
+func (v *CancelOutstandingPollRequest) Equals(rhs *CancelOutstandingPollRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CancelOutstandingPollRequest.
+func (v *CancelOutstandingPollRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskListType != nil {
+		enc.AddInt32("taskListType", *v.TaskListType)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.PollerID != nil {
+		enc.AddString("pollerID", *v.PollerID)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *CancelOutstandingPollRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *CancelOutstandingPollRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *CancelOutstandingPollRequest) GetTaskListType() (o int32) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
+}
+
+// IsSetTaskListType returns true if TaskListType is not nil.
+func (v *CancelOutstandingPollRequest) IsSetTaskListType() bool {
+	return v != nil && v.TaskListType != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *CancelOutstandingPollRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *CancelOutstandingPollRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetPollerID returns the value of PollerID if it is set or its
+// zero value if it is unset.
+func (v *CancelOutstandingPollRequest) GetPollerID() (o string) {
+	if v != nil && v.PollerID != nil {
+		return *v.PollerID
+	}
+
+	return
+}
+
+// IsSetPollerID returns true if PollerID is not nil.
+func (v *CancelOutstandingPollRequest) IsSetPollerID() bool {
+	return v != nil && v.PollerID != nil
+}
+
+type DescribeTaskListRequest struct {
+	DomainUUID  *string                         `json:"domainUUID,omitempty"`
+	DescRequest *shared.DescribeTaskListRequest `json:"descRequest,omitempty"`
+}
+
+// ToWire translates a DescribeTaskListRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.DescRequest != nil {
+		w, err = v.DescRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeTaskListRequest_Read(w wire.Value) (*shared.DescribeTaskListRequest, error) {
+	var v shared.DescribeTaskListRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DescribeTaskListRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeTaskListRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeTaskListRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ScheduleToStartTimeoutSeconds = &x
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.DescRequest, err = _DescribeTaskListRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -579,44 +1565,32 @@ func (v *AddDecisionTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AddDecisionTaskRequest
+// String returns a readable string representation of a DescribeTaskListRequest
 // struct.
-func (v *AddDecisionTaskRequest) String() string {
+func (v *DescribeTaskListRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
-	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
-	}
-	if v.ScheduleId != nil {
-		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
-		i++
-	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+	if v.DescRequest != nil {
+		fields[i] = fmt.Sprintf("DescRequest: %v", v.DescRequest)
 		i++
 	}
 
-	return fmt.Sprintf("AddDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AddDecisionTaskRequest match the
-// provided AddDecisionTaskRequest.
+// Equals returns true if all the fields of this DescribeTaskListRequest match the
+// provided DescribeTaskListRequest.
 //
 // This function performs a deep comparison.
-func (v *AddDecisionTaskRequest) Equals(rhs *AddDecisionTaskRequest) bool {
+func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -625,16 +1599,7 @@ func (v *AddDecisionTaskRequest) Equals(rhs *AddDecisionTaskRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
-	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+	if !((v.DescRequest == nil && rhs.DescRequest == nil) || (v.DescRequest != nil && rhs.DescRequest != nil && v.DescRequest.Equals(rhs.DescRequest))) {
 		return false
 	}
 
@@ -642,32 +1607,23 @@ func (v *AddDecisionTaskRequest) Equals(rhs *AddDecisionTaskRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AddDecisionTaskRequest.
-func (v *AddDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeTaskListRequest.
+func (v *DescribeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
-	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
-	}
-	if v.ScheduleId != nil {
-		enc.AddInt64("scheduleId", *v.ScheduleId)
-	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
+	if v.DescRequest != nil {
+		err = multierr.Append(err, enc.AddObject("descRequest", v.DescRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *AddDecisionTaskRequest) GetDomainUUID() (o string) {
+func (v *DescribeTaskListRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -676,78 +1632,36 @@ func (v *AddDecisionTaskRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *AddDecisionTaskRequest) IsSetDomainUUID() bool {
+func (v *DescribeTaskListRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetExecution returns the value of Execution if it is set or its
-// zero value if it is unset.
-func (v *AddDecisionTaskRequest) GetExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
-	}
-
-	return
-}
-
-// IsSetExecution returns true if Execution is not nil.
-func (v *AddDecisionTaskRequest) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
-}
-
-// GetTaskList returns the value of TaskList if it is set or its
-// zero value if it is unset.
-func (v *AddDecisionTaskRequest) GetTaskList() (o *shared.TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
-	}
-
-	return
-}
-
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *AddDecisionTaskRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
-}
-
-// GetScheduleId returns the value of ScheduleId if it is set or its
-// zero value if it is unset.
-func (v *AddDecisionTaskRequest) GetScheduleId() (o int64) {
-	if v != nil && v.ScheduleId != nil {
-		return *v.ScheduleId
-	}
-
-	return
-}
-
-// IsSetScheduleId returns true if ScheduleId is not nil.
-func (v *AddDecisionTaskRequest) IsSetScheduleId() bool {
-	return v != nil && v.ScheduleId != nil
-}
-
-// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
+// GetDescRequest returns the value of DescRequest if it is set or its
 // zero value if it is unset.
-func (v *AddDecisionTaskRequest) GetScheduleToStartTimeoutSeconds() (o int32) {
-	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
-		return *v.ScheduleToStartTimeoutSeconds
+func (v *DescribeTaskListRequest) GetDescRequest() (o *shared.DescribeTaskListRequest) {
+	if v != nil && v.DescRequest != nil {
+		return v.DescRequest
 	}
 
 	return
 }
 
-// IsSetScheduleToStartTimeoutSeconds returns true if ScheduleToStartTimeoutSeconds is not nil.
-func (v *AddDecisionTaskRequest) IsSetScheduleToStartTimeoutSeconds() bool {
-	return v != nil && v.ScheduleToStartTimeoutSeconds != nil
+// IsSetDescRequest returns true if DescRequest is not nil.
+func (v *DescribeTaskListRequest) IsSetDescRequest() bool {
+	return v != nil && v.DescRequest != nil
 }
 
-type CancelOutstandingPollRequest struct {
-	DomainUUID   *string          `json:"domainUUID,omitempty"`
-	TaskListType *int32           `json:"taskListType,omitempty"`
-	TaskList     *shared.TaskList `json:"taskList,omitempty"`
-	PollerID     *string          `json:"pollerID,omitempty"`
+type MigrateTaskListBacklogRequest struct {
+	DomainUUID     *string              `json:"domainUUID,omitempty"`
+	SourceTaskList *shared.TaskList     `json:"sourceTaskList,omitempty"`
+	TargetTaskList *shared.TaskList     `json:"targetTaskList,omitempty"`
+	TaskListType   *shared.TaskListType `json:"taskListType,omitempty"`
+	ReadLevel      *int64               `json:"readLevel,omitempty"`
+	PageSize       *int32               `json:"pageSize,omitempty"`
+	DryRun         *bool                `json:"dryRun,omitempty"`
 }
 
-// ToWire translates a CancelOutstandingPollRequest struct into a Thrift-level intermediate
+// ToWire translates a MigrateTaskListBacklogRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -762,9 +1676,9 @@ type CancelOutstandingPollRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *CancelOutstandingPollRequest) ToWire() (wire.Value, error) {
+func (v *MigrateTaskListBacklogRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -778,39 +1692,69 @@ func (v *CancelOutstandingPollRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskListType != nil {
-		w, err = wire.NewValueI32(*(v.TaskListType)), error(nil)
+	if v.SourceTaskList != nil {
+		w, err = v.SourceTaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.TargetTaskList != nil {
+		w, err = v.TargetTaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.PollerID != nil {
-		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.ReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.ReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.PageSize != nil {
+		w, err = wire.NewValueI32(*(v.PageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.DryRun != nil {
+		w, err = wire.NewValueBool(*(v.DryRun)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a CancelOutstandingPollRequest struct from its Thrift-level
+func _TaskListType_Read(w wire.Value) (shared.TaskListType, error) {
+	var v shared.TaskListType
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a MigrateTaskListBacklogRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a CancelOutstandingPollRequest struct
+// An error is returned if we were unable to build a MigrateTaskListBacklogRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -818,12 +1762,12 @@ func (v *CancelOutstandingPollRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v CancelOutstandingPollRequest
+//   var v MigrateTaskListBacklogRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *CancelOutstandingPollRequest) FromWire(w wire.Value) error {
+func (v *MigrateTaskListBacklogRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -839,10 +1783,8 @@ func (v *CancelOutstandingPollRequest) FromWire(w wire.Value) error {
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.TaskListType = &x
+			if field.Value.Type() == wire.TStruct {
+				v.SourceTaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -850,17 +1792,47 @@ func (v *CancelOutstandingPollRequest) FromWire(w wire.Value) error {
 			}
 		case 30:
 			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+				v.TargetTaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.PollerID = &x
+			if field.Value.Type() == wire.TI32 {
+				var x shared.TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReadLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.PageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.DryRun = &x
 				if err != nil {
 					return err
 				}
@@ -872,40 +1844,72 @@ func (v *CancelOutstandingPollRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a CancelOutstandingPollRequest
+// String returns a readable string representation of a MigrateTaskListBacklogRequest
 // struct.
-func (v *CancelOutstandingPollRequest) String() string {
+func (v *MigrateTaskListBacklogRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [7]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
+	if v.SourceTaskList != nil {
+		fields[i] = fmt.Sprintf("SourceTaskList: %v", v.SourceTaskList)
+		i++
+	}
+	if v.TargetTaskList != nil {
+		fields[i] = fmt.Sprintf("TargetTaskList: %v", v.TargetTaskList)
+		i++
+	}
 	if v.TaskListType != nil {
 		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
 		i++
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+	if v.ReadLevel != nil {
+		fields[i] = fmt.Sprintf("ReadLevel: %v", *(v.ReadLevel))
 		i++
 	}
-	if v.PollerID != nil {
-		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
+	if v.PageSize != nil {
+		fields[i] = fmt.Sprintf("PageSize: %v", *(v.PageSize))
+		i++
+	}
+	if v.DryRun != nil {
+		fields[i] = fmt.Sprintf("DryRun: %v", *(v.DryRun))
 		i++
 	}
-
-	return fmt.Sprintf("CancelOutstandingPollRequest{%v}", strings.Join(fields[:i], ", "))
+
+	return fmt.Sprintf("MigrateTaskListBacklogRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _TaskListType_EqualsPtr(lhs, rhs *shared.TaskListType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this CancelOutstandingPollRequest match the
-// provided CancelOutstandingPollRequest.
+// Equals returns true if all the fields of this MigrateTaskListBacklogRequest match the
+// provided MigrateTaskListBacklogRequest.
 //
 // This function performs a deep comparison.
-func (v *CancelOutstandingPollRequest) Equals(rhs *CancelOutstandingPollRequest) bool {
+func (v *MigrateTaskListBacklogRequest) Equals(rhs *MigrateTaskListBacklogRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -914,13 +1918,22 @@ func (v *CancelOutstandingPollRequest) Equals(rhs *CancelOutstandingPollRequest)
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+	if !((v.SourceTaskList == nil && rhs.SourceTaskList == nil) || (v.SourceTaskList != nil && rhs.SourceTaskList != nil && v.SourceTaskList.Equals(rhs.SourceTaskList))) {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+	if !((v.TargetTaskList == nil && rhs.TargetTaskList == nil) || (v.TargetTaskList != nil && rhs.TargetTaskList != nil && v.TargetTaskList.Equals(rhs.TargetTaskList))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ReadLevel, rhs.ReadLevel) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.PageSize, rhs.PageSize) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.DryRun, rhs.DryRun) {
 		return false
 	}
 
@@ -928,29 +1941,38 @@ func (v *CancelOutstandingPollRequest) Equals(rhs *CancelOutstandingPollRequest)
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of CancelOutstandingPollRequest.
-func (v *CancelOutstandingPollRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MigrateTaskListBacklogRequest.
+func (v *MigrateTaskListBacklogRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
+	if v.SourceTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("sourceTaskList", v.SourceTaskList))
+	}
+	if v.TargetTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("targetTaskList", v.TargetTaskList))
+	}
 	if v.TaskListType != nil {
-		enc.AddInt32("taskListType", *v.TaskListType)
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
 	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	if v.ReadLevel != nil {
+		enc.AddInt64("readLevel", *v.ReadLevel)
 	}
-	if v.PollerID != nil {
-		enc.AddString("pollerID", *v.PollerID)
+	if v.PageSize != nil {
+		enc.AddInt32("pageSize", *v.PageSize)
+	}
+	if v.DryRun != nil {
+		enc.AddBool("dryRun", *v.DryRun)
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *CancelOutstandingPollRequest) GetDomainUUID() (o string) {
+func (v *MigrateTaskListBacklogRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -959,13 +1981,43 @@ func (v *CancelOutstandingPollRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *CancelOutstandingPollRequest) IsSetDomainUUID() bool {
+func (v *MigrateTaskListBacklogRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
+// GetSourceTaskList returns the value of SourceTaskList if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetSourceTaskList() (o *shared.TaskList) {
+	if v != nil && v.SourceTaskList != nil {
+		return v.SourceTaskList
+	}
+
+	return
+}
+
+// IsSetSourceTaskList returns true if SourceTaskList is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetSourceTaskList() bool {
+	return v != nil && v.SourceTaskList != nil
+}
+
+// GetTargetTaskList returns the value of TargetTaskList if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetTargetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TargetTaskList != nil {
+		return v.TargetTaskList
+	}
+
+	return
+}
+
+// IsSetTargetTaskList returns true if TargetTaskList is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetTargetTaskList() bool {
+	return v != nil && v.TargetTaskList != nil
+}
+
 // GetTaskListType returns the value of TaskListType if it is set or its
 // zero value if it is unset.
-func (v *CancelOutstandingPollRequest) GetTaskListType() (o int32) {
+func (v *MigrateTaskListBacklogRequest) GetTaskListType() (o shared.TaskListType) {
 	if v != nil && v.TaskListType != nil {
 		return *v.TaskListType
 	}
@@ -974,46 +2026,63 @@ func (v *CancelOutstandingPollRequest) GetTaskListType() (o int32) {
 }
 
 // IsSetTaskListType returns true if TaskListType is not nil.
-func (v *CancelOutstandingPollRequest) IsSetTaskListType() bool {
+func (v *MigrateTaskListBacklogRequest) IsSetTaskListType() bool {
 	return v != nil && v.TaskListType != nil
 }
 
-// GetTaskList returns the value of TaskList if it is set or its
+// GetReadLevel returns the value of ReadLevel if it is set or its
 // zero value if it is unset.
-func (v *CancelOutstandingPollRequest) GetTaskList() (o *shared.TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+func (v *MigrateTaskListBacklogRequest) GetReadLevel() (o int64) {
+	if v != nil && v.ReadLevel != nil {
+		return *v.ReadLevel
 	}
 
 	return
 }
 
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *CancelOutstandingPollRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
+// IsSetReadLevel returns true if ReadLevel is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetReadLevel() bool {
+	return v != nil && v.ReadLevel != nil
 }
 
-// GetPollerID returns the value of PollerID if it is set or its
+// GetPageSize returns the value of PageSize if it is set or its
 // zero value if it is unset.
-func (v *CancelOutstandingPollRequest) GetPollerID() (o string) {
-	if v != nil && v.PollerID != nil {
-		return *v.PollerID
+func (v *MigrateTaskListBacklogRequest) GetPageSize() (o int32) {
+	if v != nil && v.PageSize != nil {
+		return *v.PageSize
 	}
 
 	return
 }
 
-// IsSetPollerID returns true if PollerID is not nil.
-func (v *CancelOutstandingPollRequest) IsSetPollerID() bool {
-	return v != nil && v.PollerID != nil
+// IsSetPageSize returns true if PageSize is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetPageSize() bool {
+	return v != nil && v.PageSize != nil
 }
 
-type DescribeTaskListRequest struct {
-	DomainUUID  *string                         `json:"domainUUID,omitempty"`
-	DescRequest *shared.DescribeTaskListRequest `json:"descRequest,omitempty"`
+// GetDryRun returns the value of DryRun if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogRequest) GetDryRun() (o bool) {
+	if v != nil && v.DryRun != nil {
+		return *v.DryRun
+	}
+
+	return
 }
 
-// ToWire translates a DescribeTaskListRequest struct into a Thrift-level intermediate
+// IsSetDryRun returns true if DryRun is not nil.
+func (v *MigrateTaskListBacklogRequest) IsSetDryRun() bool {
+	return v != nil && v.DryRun != nil
+}
+
+type MigrateTaskListBacklogResponse struct {
+	MigratedTasks *int32 `json:"migratedTasks,omitempty"`
+	ExpiredTasks  *int32 `json:"expiredTasks,omitempty"`
+	NextReadLevel *int64 `json:"nextReadLevel,omitempty"`
+	HasMore       *bool  `json:"hasMore,omitempty"`
+}
+
+// ToWire translates a MigrateTaskListBacklogResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1028,45 +2097,55 @@ type DescribeTaskListRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
+func (v *MigrateTaskListBacklogResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.MigratedTasks != nil {
+		w, err = wire.NewValueI32(*(v.MigratedTasks)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DescRequest != nil {
-		w, err = v.DescRequest.ToWire()
+	if v.ExpiredTasks != nil {
+		w, err = wire.NewValueI32(*(v.ExpiredTasks)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.NextReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.NextReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.HasMore != nil {
+		w, err = wire.NewValueBool(*(v.HasMore)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListRequest_Read(w wire.Value) (*shared.DescribeTaskListRequest, error) {
-	var v shared.DescribeTaskListRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a DescribeTaskListRequest struct from its Thrift-level
+// FromWire deserializes a MigrateTaskListBacklogResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeTaskListRequest struct
+// An error is returned if we were unable to build a MigrateTaskListBacklogResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1074,29 +2153,51 @@ func _DescribeTaskListRequest_Read(w wire.Value) (*shared.DescribeTaskListReques
 //     return nil, err
 //   }
 //
-//   var v DescribeTaskListRequest
+//   var v MigrateTaskListBacklogResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
+func (v *MigrateTaskListBacklogResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MigratedTasks = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.DescRequest, err = _DescribeTaskListRequest_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExpiredTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextReadLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.HasMore = &x
 				if err != nil {
 					return err
 				}
@@ -1108,41 +2209,55 @@ func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeTaskListRequest
+// String returns a readable string representation of a MigrateTaskListBacklogResponse
 // struct.
-func (v *DescribeTaskListRequest) String() string {
+func (v *MigrateTaskListBacklogResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.MigratedTasks != nil {
+		fields[i] = fmt.Sprintf("MigratedTasks: %v", *(v.MigratedTasks))
 		i++
 	}
-	if v.DescRequest != nil {
-		fields[i] = fmt.Sprintf("DescRequest: %v", v.DescRequest)
+	if v.ExpiredTasks != nil {
+		fields[i] = fmt.Sprintf("ExpiredTasks: %v", *(v.ExpiredTasks))
+		i++
+	}
+	if v.NextReadLevel != nil {
+		fields[i] = fmt.Sprintf("NextReadLevel: %v", *(v.NextReadLevel))
+		i++
+	}
+	if v.HasMore != nil {
+		fields[i] = fmt.Sprintf("HasMore: %v", *(v.HasMore))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MigrateTaskListBacklogResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeTaskListRequest match the
-// provided DescribeTaskListRequest.
+// Equals returns true if all the fields of this MigrateTaskListBacklogResponse match the
+// provided MigrateTaskListBacklogResponse.
 //
 // This function performs a deep comparison.
-func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
+func (v *MigrateTaskListBacklogResponse) Equals(rhs *MigrateTaskListBacklogResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+	if !_I32_EqualsPtr(v.MigratedTasks, rhs.MigratedTasks) {
 		return false
 	}
-	if !((v.DescRequest == nil && rhs.DescRequest == nil) || (v.DescRequest != nil && rhs.DescRequest != nil && v.DescRequest.Equals(rhs.DescRequest))) {
+	if !_I32_EqualsPtr(v.ExpiredTasks, rhs.ExpiredTasks) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextReadLevel, rhs.NextReadLevel) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.HasMore, rhs.HasMore) {
 		return false
 	}
 
@@ -1150,48 +2265,84 @@ func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeTaskListRequest.
-func (v *DescribeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MigrateTaskListBacklogResponse.
+func (v *MigrateTaskListBacklogResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
+	if v.MigratedTasks != nil {
+		enc.AddInt32("migratedTasks", *v.MigratedTasks)
 	}
-	if v.DescRequest != nil {
-		err = multierr.Append(err, enc.AddObject("descRequest", v.DescRequest))
+	if v.ExpiredTasks != nil {
+		enc.AddInt32("expiredTasks", *v.ExpiredTasks)
+	}
+	if v.NextReadLevel != nil {
+		enc.AddInt64("nextReadLevel", *v.NextReadLevel)
+	}
+	if v.HasMore != nil {
+		enc.AddBool("hasMore", *v.HasMore)
 	}
 	return err
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetMigratedTasks returns the value of MigratedTasks if it is set or its
 // zero value if it is unset.
-func (v *DescribeTaskListRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *MigrateTaskListBacklogResponse) GetMigratedTasks() (o int32) {
+	if v != nil && v.MigratedTasks != nil {
+		return *v.MigratedTasks
 	}
 
 	return
 }
 
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *DescribeTaskListRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
+// IsSetMigratedTasks returns true if MigratedTasks is not nil.
+func (v *MigrateTaskListBacklogResponse) IsSetMigratedTasks() bool {
+	return v != nil && v.MigratedTasks != nil
 }
 
-// GetDescRequest returns the value of DescRequest if it is set or its
+// GetExpiredTasks returns the value of ExpiredTasks if it is set or its
 // zero value if it is unset.
-func (v *DescribeTaskListRequest) GetDescRequest() (o *shared.DescribeTaskListRequest) {
-	if v != nil && v.DescRequest != nil {
-		return v.DescRequest
+func (v *MigrateTaskListBacklogResponse) GetExpiredTasks() (o int32) {
+	if v != nil && v.ExpiredTasks != nil {
+		return *v.ExpiredTasks
 	}
 
 	return
 }
 
-// IsSetDescRequest returns true if DescRequest is not nil.
-func (v *DescribeTaskListRequest) IsSetDescRequest() bool {
-	return v != nil && v.DescRequest != nil
+// IsSetExpiredTasks returns true if ExpiredTasks is not nil.
+func (v *MigrateTaskListBacklogResponse) IsSetExpiredTasks() bool {
+	return v != nil && v.ExpiredTasks != nil
+}
+
+// GetNextReadLevel returns the value of NextReadLevel if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogResponse) GetNextReadLevel() (o int64) {
+	if v != nil && v.NextReadLevel != nil {
+		return *v.NextReadLevel
+	}
+
+	return
+}
+
+// IsSetNextReadLevel returns true if NextReadLevel is not nil.
+func (v *MigrateTaskListBacklogResponse) IsSetNextReadLevel() bool {
+	return v != nil && v.NextReadLevel != nil
+}
+
+// GetHasMore returns the value of HasMore if it is set or its
+// zero value if it is unset.
+func (v *MigrateTaskListBacklogResponse) GetHasMore() (o bool) {
+	if v != nil && v.HasMore != nil {
+		return *v.HasMore
+	}
+
+	return
+}
+
+// IsSetHasMore returns true if HasMore is not nil.
+func (v *MigrateTaskListBacklogResponse) IsSetHasMore() bool {
+	return v != nil && v.HasMore != nil
 }
 
 type PollForActivityTaskRequest struct {
@@ -1655,22 +2806,61 @@ func (v *PollForDecisionTaskRequest) IsSetPollRequest() bool {
 }
 
 type PollForDecisionTaskResponse struct {
-	TaskToken                 []byte                        `json:"taskToken,omitempty"`
-	WorkflowExecution         *shared.WorkflowExecution     `json:"workflowExecution,omitempty"`
-	WorkflowType              *shared.WorkflowType          `json:"workflowType,omitempty"`
-	PreviousStartedEventId    *int64                        `json:"previousStartedEventId,omitempty"`
-	StartedEventId            *int64                        `json:"startedEventId,omitempty"`
-	Attempt                   *int64                        `json:"attempt,omitempty"`
-	NextEventId               *int64                        `json:"nextEventId,omitempty"`
-	BacklogCountHint          *int64                        `json:"backlogCountHint,omitempty"`
-	StickyExecutionEnabled    *bool                         `json:"stickyExecutionEnabled,omitempty"`
-	Query                     *shared.WorkflowQuery         `json:"query,omitempty"`
-	DecisionInfo              *shared.TransientDecisionInfo `json:"decisionInfo,omitempty"`
-	WorkflowExecutionTaskList *shared.TaskList              `json:"WorkflowExecutionTaskList,omitempty"`
-	EventStoreVersion         *int32                        `json:"eventStoreVersion,omitempty"`
-	BranchToken               []byte                        `json:"branchToken,omitempty"`
+	TaskToken                 []byte                            `json:"taskToken,omitempty"`
+	WorkflowExecution         *shared.WorkflowExecution         `json:"workflowExecution,omitempty"`
+	WorkflowType              *shared.WorkflowType              `json:"workflowType,omitempty"`
+	PreviousStartedEventId    *int64                            `json:"previousStartedEventId,omitempty"`
+	StartedEventId            *int64                            `json:"startedEventId,omitempty"`
+	Attempt                   *int64                            `json:"attempt,omitempty"`
+	NextEventId               *int64                            `json:"nextEventId,omitempty"`
+	BacklogCountHint          *int64                            `json:"backlogCountHint,omitempty"`
+	StickyExecutionEnabled    *bool                             `json:"stickyExecutionEnabled,omitempty"`
+	Query                     *shared.WorkflowQuery             `json:"query,omitempty"`
+	DecisionInfo              *shared.TransientDecisionInfo     `json:"decisionInfo,omitempty"`
+	WorkflowExecutionTaskList *shared.TaskList                  `json:"WorkflowExecutionTaskList,omitempty"`
+	EventStoreVersion         *int32                            `json:"eventStoreVersion,omitempty"`
+	BranchToken               []byte                            `json:"branchToken,omitempty"`
+	Updates                   map[string]*shared.WorkflowUpdate `json:"updates,omitempty"`
+}
+
+type _Map_String_WorkflowUpdate_MapItemList map[string]*shared.WorkflowUpdate
+
+func (m _Map_String_WorkflowUpdate_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_WorkflowUpdate_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_WorkflowUpdate_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_WorkflowUpdate_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
+func (_Map_String_WorkflowUpdate_MapItemList) Close() {}
+
 // ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -1688,7 +2878,7 @@ type PollForDecisionTaskResponse struct {
 //   }
 func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [14]wire.Field
+		fields [15]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1806,6 +2996,14 @@ func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
+	if v.Updates != nil {
+		w, err = wire.NewValueMap(_Map_String_WorkflowUpdate_MapItemList(v.Updates)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1828,6 +3026,40 @@ func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, e
 	return &v, err
 }
 
+func _WorkflowUpdate_Read(w wire.Value) (*shared.WorkflowUpdate, error) {
+	var v shared.WorkflowUpdate
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_WorkflowUpdate_Read(m wire.MapItemList) (map[string]*shared.WorkflowUpdate, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.WorkflowUpdate, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _WorkflowUpdate_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -1975,6 +3207,14 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 130:
+			if field.Value.Type() == wire.TMap {
+				v.Updates, err = _Map_String_WorkflowUpdate_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1989,7 +3229,7 @@ func (v *PollForDecisionTaskResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [14]string
+	var fields [15]string
 	i := 0
 	if v.TaskToken != nil {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
@@ -2047,18 +3287,29 @@ func (v *PollForDecisionTaskResponse) String() string {
 		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
 		i++
 	}
+	if v.Updates != nil {
+		fields[i] = fmt.Sprintf("Updates: %v", v.Updates)
+		i++
+	}
 
 	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
+func _Map_String_WorkflowUpdate_Equals(lhs, rhs map[string]*shared.WorkflowUpdate) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
+	return true
 }
 
 // Equals returns true if all the fields of this PollForDecisionTaskResponse match the
@@ -2113,10 +3364,24 @@ func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) b
 	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
 		return false
 	}
+	if !((v.Updates == nil && rhs.Updates == nil) || (v.Updates != nil && rhs.Updates != nil && _Map_String_WorkflowUpdate_Equals(v.Updates, rhs.Updates))) {
+		return false
+	}
 
 	return true
 }
 
+type _Map_String_WorkflowUpdate_Zapper map[string]*shared.WorkflowUpdate
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_WorkflowUpdate_Zapper.
+func (m _Map_String_WorkflowUpdate_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of PollForDecisionTaskResponse.
 func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2165,6 +3430,9 @@ func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder
 	if v.BranchToken != nil {
 		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
 	}
+	if v.Updates != nil {
+		err = multierr.Append(err, enc.AddObject("updates", (_Map_String_WorkflowUpdate_Zapper)(v.Updates)))
+	}
 	return err
 }
 
@@ -2378,6 +3646,357 @@ func (v *PollForDecisionTaskResponse) IsSetBranchToken() bool {
 	return v != nil && v.BranchToken != nil
 }
 
+// GetUpdates returns the value of Updates if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetUpdates() (o map[string]*shared.WorkflowUpdate) {
+	if v != nil && v.Updates != nil {
+		return v.Updates
+	}
+
+	return
+}
+
+// IsSetUpdates returns true if Updates is not nil.
+func (v *PollForDecisionTaskResponse) IsSetUpdates() bool {
+	return v != nil && v.Updates != nil
+}
+
+type PromoteTaskListBuildRequest struct {
+	DomainUUID                *string          `json:"domainUUID,omitempty"`
+	TaskList                  *shared.TaskList `json:"taskList,omitempty"`
+	BinaryChecksum            *string          `json:"binaryChecksum,omitempty"`
+	CompatibleBinaryChecksums []string         `json:"compatibleBinaryChecksums,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a PromoteTaskListBuildRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PromoteTaskListBuildRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.CompatibleBinaryChecksums != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.CompatibleBinaryChecksums)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a PromoteTaskListBuildRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PromoteTaskListBuildRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PromoteTaskListBuildRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PromoteTaskListBuildRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.CompatibleBinaryChecksums, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PromoteTaskListBuildRequest
+// struct.
+func (v *PromoteTaskListBuildRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
+		i++
+	}
+	if v.CompatibleBinaryChecksums != nil {
+		fields[i] = fmt.Sprintf("CompatibleBinaryChecksums: %v", v.CompatibleBinaryChecksums)
+		i++
+	}
+
+	return fmt.Sprintf("PromoteTaskListBuildRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this PromoteTaskListBuildRequest match the
+// provided PromoteTaskListBuildRequest.
+//
+// This function performs a deep comparison.
+func (v *PromoteTaskListBuildRequest) Equals(rhs *PromoteTaskListBuildRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
+		return false
+	}
+	if !((v.CompatibleBinaryChecksums == nil && rhs.CompatibleBinaryChecksums == nil) || (v.CompatibleBinaryChecksums != nil && rhs.CompatibleBinaryChecksums != nil && _List_String_Equals(v.CompatibleBinaryChecksums, rhs.CompatibleBinaryChecksums))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PromoteTaskListBuildRequest.
+func (v *PromoteTaskListBuildRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
+	}
+	if v.CompatibleBinaryChecksums != nil {
+		err = multierr.Append(err, enc.AddArray("compatibleBinaryChecksums", (_List_String_Zapper)(v.CompatibleBinaryChecksums)))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *PromoteTaskListBuildRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *PromoteTaskListBuildRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *PromoteTaskListBuildRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *PromoteTaskListBuildRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *PromoteTaskListBuildRequest) GetBinaryChecksum() (o string) {
+	if v != nil && v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
+	}
+
+	return
+}
+
+// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
+func (v *PromoteTaskListBuildRequest) IsSetBinaryChecksum() bool {
+	return v != nil && v.BinaryChecksum != nil
+}
+
+// GetCompatibleBinaryChecksums returns the value of CompatibleBinaryChecksums if it is set or its
+// zero value if it is unset.
+func (v *PromoteTaskListBuildRequest) GetCompatibleBinaryChecksums() (o []string) {
+	if v != nil && v.CompatibleBinaryChecksums != nil {
+		return v.CompatibleBinaryChecksums
+	}
+
+	return
+}
+
+// IsSetCompatibleBinaryChecksums returns true if CompatibleBinaryChecksums is not nil.
+func (v *PromoteTaskListBuildRequest) IsSetCompatibleBinaryChecksums() bool {
+	return v != nil && v.CompatibleBinaryChecksums != nil
+}
+
 type QueryWorkflowRequest struct {
 	DomainUUID   *string                      `json:"domainUUID,omitempty"`
 	TaskList     *shared.TaskList             `json:"taskList,omitempty"`
@@ -37,6 +37,467 @@ import (
 	strings "strings"
 )
 
+type AcceptedWorkflowUpdateInfo struct {
+	UpdateId     *string `json:"updateId,omitempty"`
+	EventId      *int64  `json:"eventId,omitempty"`
+	EventBatchId *int64  `json:"eventBatchId,omitempty"`
+}
+
+// ToWire translates a AcceptedWorkflowUpdateInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AcceptedWorkflowUpdateInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.UpdateId != nil {
+		w, err = wire.NewValueString(*(v.UpdateId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.EventId != nil {
+		w, err = wire.NewValueI64(*(v.EventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.EventBatchId != nil {
+		w, err = wire.NewValueI64(*(v.EventBatchId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AcceptedWorkflowUpdateInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AcceptedWorkflowUpdateInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AcceptedWorkflowUpdateInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AcceptedWorkflowUpdateInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EventBatchId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AcceptedWorkflowUpdateInfo
+// struct.
+func (v *AcceptedWorkflowUpdateInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.UpdateId != nil {
+		fields[i] = fmt.Sprintf("UpdateId: %v", *(v.UpdateId))
+		i++
+	}
+	if v.EventId != nil {
+		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
+		i++
+	}
+	if v.EventBatchId != nil {
+		fields[i] = fmt.Sprintf("EventBatchId: %v", *(v.EventBatchId))
+		i++
+	}
+
+	return fmt.Sprintf("AcceptedWorkflowUpdateInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this AcceptedWorkflowUpdateInfo match the
+// provided AcceptedWorkflowUpdateInfo.
+//
+// This function performs a deep comparison.
+func (v *AcceptedWorkflowUpdateInfo) Equals(rhs *AcceptedWorkflowUpdateInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.UpdateId, rhs.UpdateId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EventId, rhs.EventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EventBatchId, rhs.EventBatchId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AcceptedWorkflowUpdateInfo.
+func (v *AcceptedWorkflowUpdateInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.UpdateId != nil {
+		enc.AddString("updateId", *v.UpdateId)
+	}
+	if v.EventId != nil {
+		enc.AddInt64("eventId", *v.EventId)
+	}
+	if v.EventBatchId != nil {
+		enc.AddInt64("eventBatchId", *v.EventBatchId)
+	}
+	return err
+}
+
+// GetUpdateId returns the value of UpdateId if it is set or its
+// zero value if it is unset.
+func (v *AcceptedWorkflowUpdateInfo) GetUpdateId() (o string) {
+	if v != nil && v.UpdateId != nil {
+		return *v.UpdateId
+	}
+
+	return
+}
+
+// IsSetUpdateId returns true if UpdateId is not nil.
+func (v *AcceptedWorkflowUpdateInfo) IsSetUpdateId() bool {
+	return v != nil && v.UpdateId != nil
+}
+
+// GetEventId returns the value of EventId if it is set or its
+// zero value if it is unset.
+func (v *AcceptedWorkflowUpdateInfo) GetEventId() (o int64) {
+	if v != nil && v.EventId != nil {
+		return *v.EventId
+	}
+
+	return
+}
+
+// IsSetEventId returns true if EventId is not nil.
+func (v *AcceptedWorkflowUpdateInfo) IsSetEventId() bool {
+	return v != nil && v.EventId != nil
+}
+
+// GetEventBatchId returns the value of EventBatchId if it is set or its
+// zero value if it is unset.
+func (v *AcceptedWorkflowUpdateInfo) GetEventBatchId() (o int64) {
+	if v != nil && v.EventBatchId != nil {
+		return *v.EventBatchId
+	}
+
+	return
+}
+
+// IsSetEventBatchId returns true if EventBatchId is not nil.
+func (v *AcceptedWorkflowUpdateInfo) IsSetEventBatchId() bool {
+	return v != nil && v.EventBatchId != nil
+}
+
+type AcceptedWorkflowUpdates struct {
+	Updates []*AcceptedWorkflowUpdateInfo `json:"updates,omitempty"`
+}
+
+type _List_AcceptedWorkflowUpdateInfo_ValueList []*AcceptedWorkflowUpdateInfo
+
+func (v _List_AcceptedWorkflowUpdateInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_AcceptedWorkflowUpdateInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_AcceptedWorkflowUpdateInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_AcceptedWorkflowUpdateInfo_ValueList) Close() {}
+
+// ToWire translates a AcceptedWorkflowUpdates struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AcceptedWorkflowUpdates) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Updates != nil {
+		w, err = wire.NewValueList(_List_AcceptedWorkflowUpdateInfo_ValueList(v.Updates)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AcceptedWorkflowUpdateInfo_Read(w wire.Value) (*AcceptedWorkflowUpdateInfo, error) {
+	var v AcceptedWorkflowUpdateInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_AcceptedWorkflowUpdateInfo_Read(l wire.ValueList) ([]*AcceptedWorkflowUpdateInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*AcceptedWorkflowUpdateInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _AcceptedWorkflowUpdateInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a AcceptedWorkflowUpdates struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AcceptedWorkflowUpdates struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AcceptedWorkflowUpdates
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AcceptedWorkflowUpdates) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Updates, err = _List_AcceptedWorkflowUpdateInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AcceptedWorkflowUpdates
+// struct.
+func (v *AcceptedWorkflowUpdates) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Updates != nil {
+		fields[i] = fmt.Sprintf("Updates: %v", v.Updates)
+		i++
+	}
+
+	return fmt.Sprintf("AcceptedWorkflowUpdates{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_AcceptedWorkflowUpdateInfo_Equals(lhs, rhs []*AcceptedWorkflowUpdateInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this AcceptedWorkflowUpdates match the
+// provided AcceptedWorkflowUpdates.
+//
+// This function performs a deep comparison.
+func (v *AcceptedWorkflowUpdates) Equals(rhs *AcceptedWorkflowUpdates) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Updates == nil && rhs.Updates == nil) || (v.Updates != nil && rhs.Updates != nil && _List_AcceptedWorkflowUpdateInfo_Equals(v.Updates, rhs.Updates))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_AcceptedWorkflowUpdateInfo_Zapper []*AcceptedWorkflowUpdateInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_AcceptedWorkflowUpdateInfo_Zapper.
+func (l _List_AcceptedWorkflowUpdateInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AcceptedWorkflowUpdates.
+func (v *AcceptedWorkflowUpdates) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Updates != nil {
+		err = multierr.Append(err, enc.AddArray("updates", (_List_AcceptedWorkflowUpdateInfo_Zapper)(v.Updates)))
+	}
+	return err
+}
+
+// GetUpdates returns the value of Updates if it is set or its
+// zero value if it is unset.
+func (v *AcceptedWorkflowUpdates) GetUpdates() (o []*AcceptedWorkflowUpdateInfo) {
+	if v != nil && v.Updates != nil {
+		return v.Updates
+	}
+
+	return
+}
+
+// IsSetUpdates returns true if Updates is not nil.
+func (v *AcceptedWorkflowUpdates) IsSetUpdates() bool {
+	return v != nil && v.Updates != nil
+}
+
 type AccessDeniedError struct {
 	Message string `json:"message,required"`
 }
@@ -288,26 +749,6 @@ func (v *ActivityTaskCancelRequestedEventAttributes) String() string {
 	return fmt.Sprintf("ActivityTaskCancelRequestedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this ActivityTaskCancelRequestedEventAttributes match the
 // provided ActivityTaskCancelRequestedEventAttributes.
 //
@@ -686,10 +1127,11 @@ func (v *ActivityTaskCanceledEventAttributes) IsSetIdentity() bool {
 }
 
 type ActivityTaskCompletedEventAttributes struct {
-	Result           []byte  `json:"result,omitempty"`
-	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
-	StartedEventId   *int64  `json:"startedEventId,omitempty"`
-	Identity         *string `json:"identity,omitempty"`
+	Result           []byte            `json:"result,omitempty"`
+	ScheduledEventId *int64            `json:"scheduledEventId,omitempty"`
+	StartedEventId   *int64            `json:"startedEventId,omitempty"`
+	Identity         *string           `json:"identity,omitempty"`
+	ResultReference  *PayloadReference `json:"resultReference,omitempty"`
 }
 
 // ToWire translates a ActivityTaskCompletedEventAttributes struct into a Thrift-level intermediate
@@ -709,7 +1151,7 @@ type ActivityTaskCompletedEventAttributes struct {
 //   }
 func (v *ActivityTaskCompletedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -747,10 +1189,24 @@ func (v *ActivityTaskCompletedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.ResultReference != nil {
+		w, err = v.ResultReference.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _PayloadReference_Read(w wire.Value) (*PayloadReference, error) {
+	var v PayloadReference
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a ActivityTaskCompletedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -810,6 +1266,14 @@ func (v *ActivityTaskCompletedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.ResultReference, err = _PayloadReference_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -824,7 +1288,7 @@ func (v *ActivityTaskCompletedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Result != nil {
 		fields[i] = fmt.Sprintf("Result: %v", v.Result)
@@ -842,6 +1306,10 @@ func (v *ActivityTaskCompletedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.ResultReference != nil {
+		fields[i] = fmt.Sprintf("ResultReference: %v", v.ResultReference)
+		i++
+	}
 
 	return fmt.Sprintf("ActivityTaskCompletedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -868,6 +1336,9 @@ func (v *ActivityTaskCompletedEventAttributes) Equals(rhs *ActivityTaskCompleted
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !((v.ResultReference == nil && rhs.ResultReference == nil) || (v.ResultReference != nil && rhs.ResultReference != nil && v.ResultReference.Equals(rhs.ResultReference))) {
+		return false
+	}
 
 	return true
 }
@@ -890,6 +1361,9 @@ func (v *ActivityTaskCompletedEventAttributes) MarshalLogObject(enc zapcore.Obje
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.ResultReference != nil {
+		err = multierr.Append(err, enc.AddObject("resultReference", v.ResultReference))
+	}
 	return err
 }
 
@@ -953,6 +1427,21 @@ func (v *ActivityTaskCompletedEventAttributes) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
+// GetResultReference returns the value of ResultReference if it is set or its
+// zero value if it is unset.
+func (v *ActivityTaskCompletedEventAttributes) GetResultReference() (o *PayloadReference) {
+	if v != nil && v.ResultReference != nil {
+		return v.ResultReference
+	}
+
+	return
+}
+
+// IsSetResultReference returns true if ResultReference is not nil.
+func (v *ActivityTaskCompletedEventAttributes) IsSetResultReference() bool {
+	return v != nil && v.ResultReference != nil
+}
+
 type ActivityTaskFailedEventAttributes struct {
 	Reason           *string `json:"reason,omitempty"`
 	Details          []byte  `json:"details,omitempty"`
@@ -1266,18 +1755,19 @@ func (v *ActivityTaskFailedEventAttributes) IsSetIdentity() bool {
 }
 
 type ActivityTaskScheduledEventAttributes struct {
-	ActivityId                    *string       `json:"activityId,omitempty"`
-	ActivityType                  *ActivityType `json:"activityType,omitempty"`
-	Domain                        *string       `json:"domain,omitempty"`
-	TaskList                      *TaskList     `json:"taskList,omitempty"`
-	Input                         []byte        `json:"input,omitempty"`
-	ScheduleToCloseTimeoutSeconds *int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
-	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
-	DecisionTaskCompletedEventId  *int64        `json:"decisionTaskCompletedEventId,omitempty"`
-	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
-	Header                        *Header       `json:"header,omitempty"`
+	ActivityId                    *string           `json:"activityId,omitempty"`
+	ActivityType                  *ActivityType     `json:"activityType,omitempty"`
+	Domain                        *string           `json:"domain,omitempty"`
+	TaskList                      *TaskList         `json:"taskList,omitempty"`
+	Input                         []byte            `json:"input,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32            `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32            `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32            `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32            `json:"heartbeatTimeoutSeconds,omitempty"`
+	DecisionTaskCompletedEventId  *int64            `json:"decisionTaskCompletedEventId,omitempty"`
+	RetryPolicy                   *RetryPolicy      `json:"retryPolicy,omitempty"`
+	Header                        *Header           `json:"header,omitempty"`
+	InputReference                *PayloadReference `json:"inputReference,omitempty"`
 }
 
 // ToWire translates a ActivityTaskScheduledEventAttributes struct into a Thrift-level intermediate
@@ -1297,7 +1787,7 @@ type ActivityTaskScheduledEventAttributes struct {
 //   }
 func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1399,6 +1889,14 @@ func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
+	if v.InputReference != nil {
+		w, err = v.InputReference.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1558,6 +2056,14 @@ func (v *ActivityTaskScheduledEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 130:
+			if field.Value.Type() == wire.TStruct {
+				v.InputReference, err = _PayloadReference_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1572,7 +2078,7 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [12]string
+	var fields [13]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -1622,6 +2128,10 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.InputReference != nil {
+		fields[i] = fmt.Sprintf("InputReference: %v", v.InputReference)
+		i++
+	}
 
 	return fmt.Sprintf("ActivityTaskScheduledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1682,6 +2192,9 @@ func (v *ActivityTaskScheduledEventAttributes) Equals(rhs *ActivityTaskScheduled
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !((v.InputReference == nil && rhs.InputReference == nil) || (v.InputReference != nil && rhs.InputReference != nil && v.InputReference.Equals(rhs.InputReference))) {
+		return false
+	}
 
 	return true
 }
@@ -1728,6 +2241,9 @@ func (v *ActivityTaskScheduledEventAttributes) MarshalLogObject(enc zapcore.Obje
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.InputReference != nil {
+		err = multierr.Append(err, enc.AddObject("inputReference", v.InputReference))
+	}
 	return err
 }
 
@@ -1911,6 +2427,21 @@ func (v *ActivityTaskScheduledEventAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetInputReference returns the value of InputReference if it is set or its
+// zero value if it is unset.
+func (v *ActivityTaskScheduledEventAttributes) GetInputReference() (o *PayloadReference) {
+	if v != nil && v.InputReference != nil {
+		return v.InputReference
+	}
+
+	return
+}
+
+// IsSetInputReference returns true if InputReference is not nil.
+func (v *ActivityTaskScheduledEventAttributes) IsSetInputReference() bool {
+	return v != nil && v.InputReference != nil
+}
+
 type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
 	Identity         *string `json:"identity,omitempty"`
@@ -7310,6 +7841,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	LastCompletionResult                []byte                  `json:"lastCompletionResult,omitempty"`
 	CronSchedule                        *string                 `json:"cronSchedule,omitempty"`
 	Header                              *Header                 `json:"header,omitempty"`
+	RetentionPeriodInDays               *int32                  `json:"retentionPeriodInDays,omitempty"`
 }
 
 // ToWire translates a ContinueAsNewWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
@@ -7329,7 +7861,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 //   }
 func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7439,6 +7971,14 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 130, Value: w}
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -7586,6 +8126,16 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) FromWire(w wire.Value
 					return err
 				}
 
+			}
+		case 140:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -7600,7 +8150,7 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [13]string
+	var fields [14]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -7654,6 +8204,10 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
 
 	return fmt.Sprintf("ContinueAsNewWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -7717,6 +8271,9 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) Equals(rhs *ContinueA
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
 
 	return true
 }
@@ -7766,6 +8323,9 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) MarshalLogObject(enc
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
 	return err
 }
 
@@ -7964,6 +8524,21 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
+}
+
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
 type CountWorkflowExecutionsRequest struct {
 	Domain *string `json:"domain,omitempty"`
 	Query  *string `json:"query,omitempty"`
@@ -11421,12 +11996,17 @@ func (v *DecisionType) UnmarshalJSON(text []byte) error {
 	}
 }
 
-type DeprecateDomainRequest struct {
-	Name          *string `json:"name,omitempty"`
-	SecurityToken *string `json:"securityToken,omitempty"`
+type DelayedSignalInfo struct {
+	RequestId          *string `json:"requestId,omitempty"`
+	SignalName         *string `json:"signalName,omitempty"`
+	Input              []byte  `json:"input,omitempty"`
+	Identity           *string `json:"identity,omitempty"`
+	RunId              *string `json:"runId,omitempty"`
+	DeliverAtTimestamp *int64  `json:"deliverAtTimestamp,omitempty"`
+	Version            *int64  `json:"version,omitempty"`
 }
 
-// ToWire translates a DeprecateDomainRequest struct into a Thrift-level intermediate
+// ToWire translates a DelayedSignalInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -11441,39 +12021,79 @@ type DeprecateDomainRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
+func (v *DelayedSignalInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.SecurityToken != nil {
-		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
+	if v.SignalName != nil {
+		w, err = wire.NewValueString(*(v.SignalName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.DeliverAtTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.DeliverAtTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DeprecateDomainRequest struct from its Thrift-level
+// FromWire deserializes a DelayedSignalInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DeprecateDomainRequest struct
+// An error is returned if we were unable to build a DelayedSignalInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -11481,12 +12101,12 @@ func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DeprecateDomainRequest
+//   var v DelayedSignalInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
+func (v *DelayedSignalInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -11495,7 +12115,7 @@ func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+				v.RequestId = &x
 				if err != nil {
 					return err
 				}
@@ -11505,7 +12125,55 @@ func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.SecurityToken = &x
+				v.SignalName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DeliverAtTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
 				if err != nil {
 					return err
 				}
@@ -11517,41 +12185,76 @@ func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DeprecateDomainRequest
+// String returns a readable string representation of a DelayedSignalInfo
 // struct.
-func (v *DeprecateDomainRequest) String() string {
+func (v *DelayedSignalInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [7]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
-	if v.SecurityToken != nil {
-		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
+	if v.SignalName != nil {
+		fields[i] = fmt.Sprintf("SignalName: %v", *(v.SignalName))
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.DeliverAtTimestamp != nil {
+		fields[i] = fmt.Sprintf("DeliverAtTimestamp: %v", *(v.DeliverAtTimestamp))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
 		i++
 	}
 
-	return fmt.Sprintf("DeprecateDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DelayedSignalInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DeprecateDomainRequest match the
-// provided DeprecateDomainRequest.
+// Equals returns true if all the fields of this DelayedSignalInfo match the
+// provided DelayedSignalInfo.
 //
 // This function performs a deep comparison.
-func (v *DeprecateDomainRequest) Equals(rhs *DeprecateDomainRequest) bool {
+func (v *DelayedSignalInfo) Equals(rhs *DelayedSignalInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
+	if !_String_EqualsPtr(v.SignalName, rhs.SignalName) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DeliverAtTimestamp, rhs.DeliverAtTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
 		return false
 	}
 
@@ -11559,56 +12262,174 @@ func (v *DeprecateDomainRequest) Equals(rhs *DeprecateDomainRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DeprecateDomainRequest.
-func (v *DeprecateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DelayedSignalInfo.
+func (v *DelayedSignalInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Name != nil {
-		enc.AddString("name", *v.Name)
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
 	}
-	if v.SecurityToken != nil {
-		enc.AddString("securityToken", *v.SecurityToken)
+	if v.SignalName != nil {
+		enc.AddString("signalName", *v.SignalName)
+	}
+	if v.Input != nil {
+		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.DeliverAtTimestamp != nil {
+		enc.AddInt64("deliverAtTimestamp", *v.DeliverAtTimestamp)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
+// GetRequestId returns the value of RequestId if it is set or its
 // zero value if it is unset.
-func (v *DeprecateDomainRequest) GetName() (o string) {
-	if v != nil && v.Name != nil {
-		return *v.Name
+func (v *DelayedSignalInfo) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
 	}
 
 	return
 }
 
-// IsSetName returns true if Name is not nil.
-func (v *DeprecateDomainRequest) IsSetName() bool {
-	return v != nil && v.Name != nil
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *DelayedSignalInfo) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
 }
 
-// GetSecurityToken returns the value of SecurityToken if it is set or its
+// GetSignalName returns the value of SignalName if it is set or its
 // zero value if it is unset.
-func (v *DeprecateDomainRequest) GetSecurityToken() (o string) {
-	if v != nil && v.SecurityToken != nil {
-		return *v.SecurityToken
+func (v *DelayedSignalInfo) GetSignalName() (o string) {
+	if v != nil && v.SignalName != nil {
+		return *v.SignalName
 	}
 
 	return
 }
 
-// IsSetSecurityToken returns true if SecurityToken is not nil.
-func (v *DeprecateDomainRequest) IsSetSecurityToken() bool {
-	return v != nil && v.SecurityToken != nil
+// IsSetSignalName returns true if SignalName is not nil.
+func (v *DelayedSignalInfo) IsSetSignalName() bool {
+	return v != nil && v.SignalName != nil
 }
 
-type DescribeDomainRequest struct {
-	Name *string `json:"name,omitempty"`
-	UUID *string `json:"uuid,omitempty"`
+// GetInput returns the value of Input if it is set or its
+// zero value if it is unset.
+func (v *DelayedSignalInfo) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+
+	return
 }
 
-// ToWire translates a DescribeDomainRequest struct into a Thrift-level intermediate
+// IsSetInput returns true if Input is not nil.
+func (v *DelayedSignalInfo) IsSetInput() bool {
+	return v != nil && v.Input != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *DelayedSignalInfo) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *DelayedSignalInfo) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *DelayedSignalInfo) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *DelayedSignalInfo) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetDeliverAtTimestamp returns the value of DeliverAtTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DelayedSignalInfo) GetDeliverAtTimestamp() (o int64) {
+	if v != nil && v.DeliverAtTimestamp != nil {
+		return *v.DeliverAtTimestamp
+	}
+
+	return
+}
+
+// IsSetDeliverAtTimestamp returns true if DeliverAtTimestamp is not nil.
+func (v *DelayedSignalInfo) IsSetDeliverAtTimestamp() bool {
+	return v != nil && v.DeliverAtTimestamp != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *DelayedSignalInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *DelayedSignalInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+type DelayedSignals struct {
+	Signals []*DelayedSignalInfo `json:"signals,omitempty"`
+}
+
+type _List_DelayedSignalInfo_ValueList []*DelayedSignalInfo
+
+func (v _List_DelayedSignalInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DelayedSignalInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DelayedSignalInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DelayedSignalInfo_ValueList) Close() {}
+
+// ToWire translates a DelayedSignals struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -11623,39 +12444,55 @@ type DescribeDomainRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
+func (v *DelayedSignals) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.Signals != nil {
+		w, err = wire.NewValueList(_List_DelayedSignalInfo_ValueList(v.Signals)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.UUID != nil {
-		w, err = wire.NewValueString(*(v.UUID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DescribeDomainRequest struct from its Thrift-level
+func _DelayedSignalInfo_Read(w wire.Value) (*DelayedSignalInfo, error) {
+	var v DelayedSignalInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DelayedSignalInfo_Read(l wire.ValueList) ([]*DelayedSignalInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*DelayedSignalInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DelayedSignalInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DelayedSignals struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeDomainRequest struct
+// An error is returned if we were unable to build a DelayedSignals struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -11663,31 +12500,19 @@ func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeDomainRequest
+//   var v DelayedSignals
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
+func (v *DelayedSignals) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.UUID = &x
+			if field.Value.Type() == wire.TList {
+				v.Signals, err = _List_DelayedSignalInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -11699,101 +12524,99 @@ func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeDomainRequest
+// String returns a readable string representation of a DelayedSignals
 // struct.
-func (v *DescribeDomainRequest) String() string {
+func (v *DelayedSignals) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.Signals != nil {
+		fields[i] = fmt.Sprintf("Signals: %v", v.Signals)
 		i++
 	}
-	if v.UUID != nil {
-		fields[i] = fmt.Sprintf("UUID: %v", *(v.UUID))
-		i++
+
+	return fmt.Sprintf("DelayedSignals{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_DelayedSignalInfo_Equals(lhs, rhs []*DelayedSignalInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
 
-	return fmt.Sprintf("DescribeDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
 }
 
-// Equals returns true if all the fields of this DescribeDomainRequest match the
-// provided DescribeDomainRequest.
+// Equals returns true if all the fields of this DelayedSignals match the
+// provided DelayedSignals.
 //
 // This function performs a deep comparison.
-func (v *DescribeDomainRequest) Equals(rhs *DescribeDomainRequest) bool {
+func (v *DelayedSignals) Equals(rhs *DelayedSignals) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
-		return false
-	}
-	if !_String_EqualsPtr(v.UUID, rhs.UUID) {
+	if !((v.Signals == nil && rhs.Signals == nil) || (v.Signals != nil && rhs.Signals != nil && _List_DelayedSignalInfo_Equals(v.Signals, rhs.Signals))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_DelayedSignalInfo_Zapper []*DelayedSignalInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DelayedSignalInfo_Zapper.
+func (l _List_DelayedSignalInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeDomainRequest.
-func (v *DescribeDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DelayedSignals.
+func (v *DelayedSignals) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Name != nil {
-		enc.AddString("name", *v.Name)
-	}
-	if v.UUID != nil {
-		enc.AddString("uuid", *v.UUID)
+	if v.Signals != nil {
+		err = multierr.Append(err, enc.AddArray("signals", (_List_DelayedSignalInfo_Zapper)(v.Signals)))
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *DescribeDomainRequest) GetName() (o string) {
-	if v != nil && v.Name != nil {
-		return *v.Name
-	}
-
-	return
-}
-
-// IsSetName returns true if Name is not nil.
-func (v *DescribeDomainRequest) IsSetName() bool {
-	return v != nil && v.Name != nil
-}
-
-// GetUUID returns the value of UUID if it is set or its
+// GetSignals returns the value of Signals if it is set or its
 // zero value if it is unset.
-func (v *DescribeDomainRequest) GetUUID() (o string) {
-	if v != nil && v.UUID != nil {
-		return *v.UUID
+func (v *DelayedSignals) GetSignals() (o []*DelayedSignalInfo) {
+	if v != nil && v.Signals != nil {
+		return v.Signals
 	}
 
 	return
 }
 
-// IsSetUUID returns true if UUID is not nil.
-func (v *DescribeDomainRequest) IsSetUUID() bool {
-	return v != nil && v.UUID != nil
+// IsSetSignals returns true if Signals is not nil.
+func (v *DelayedSignals) IsSetSignals() bool {
+	return v != nil && v.Signals != nil
 }
 
-type DescribeDomainResponse struct {
-	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
-	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
-	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
-	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
-	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+type DeprecateDomainRequest struct {
+	Name          *string `json:"name,omitempty"`
+	SecurityToken *string `json:"securityToken,omitempty"`
 }
 
-// ToWire translates a DescribeDomainResponse struct into a Thrift-level intermediate
+// ToWire translates a DeprecateDomainRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -11808,9 +12631,406 @@ type DescribeDomainResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
+func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SecurityToken != nil {
+		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DeprecateDomainRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DeprecateDomainRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DeprecateDomainRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SecurityToken = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DeprecateDomainRequest
+// struct.
+func (v *DeprecateDomainRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.SecurityToken != nil {
+		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
+		i++
+	}
+
+	return fmt.Sprintf("DeprecateDomainRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DeprecateDomainRequest match the
+// provided DeprecateDomainRequest.
+//
+// This function performs a deep comparison.
+func (v *DeprecateDomainRequest) Equals(rhs *DeprecateDomainRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DeprecateDomainRequest.
+func (v *DeprecateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.SecurityToken != nil {
+		enc.AddString("securityToken", *v.SecurityToken)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *DeprecateDomainRequest) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *DeprecateDomainRequest) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetSecurityToken returns the value of SecurityToken if it is set or its
+// zero value if it is unset.
+func (v *DeprecateDomainRequest) GetSecurityToken() (o string) {
+	if v != nil && v.SecurityToken != nil {
+		return *v.SecurityToken
+	}
+
+	return
+}
+
+// IsSetSecurityToken returns true if SecurityToken is not nil.
+func (v *DeprecateDomainRequest) IsSetSecurityToken() bool {
+	return v != nil && v.SecurityToken != nil
+}
+
+type DescribeDomainRequest struct {
+	Name *string `json:"name,omitempty"`
+	UUID *string `json:"uuid,omitempty"`
+}
+
+// ToWire translates a DescribeDomainRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.UUID != nil {
+		w, err = wire.NewValueString(*(v.UUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeDomainRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeDomainRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeDomainRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeDomainRequest
+// struct.
+func (v *DescribeDomainRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.UUID != nil {
+		fields[i] = fmt.Sprintf("UUID: %v", *(v.UUID))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeDomainRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeDomainRequest match the
+// provided DescribeDomainRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeDomainRequest) Equals(rhs *DescribeDomainRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.UUID, rhs.UUID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeDomainRequest.
+func (v *DescribeDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.UUID != nil {
+		enc.AddString("uuid", *v.UUID)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainRequest) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *DescribeDomainRequest) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetUUID returns the value of UUID if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainRequest) GetUUID() (o string) {
+	if v != nil && v.UUID != nil {
+		return *v.UUID
+	}
+
+	return
+}
+
+// IsSetUUID returns true if UUID is not nil.
+func (v *DescribeDomainRequest) IsSetUUID() bool {
+	return v != nil && v.UUID != nil
+}
+
+type DescribeDomainResponse struct {
+	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
+	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
+	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
+	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+	WorkflowTypeConcurrency  []*WorkflowTypeConcurrency      `json:"workflowTypeConcurrency,omitempty"`
+}
+
+type _List_WorkflowTypeConcurrency_ValueList []*WorkflowTypeConcurrency
+
+func (v _List_WorkflowTypeConcurrency_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_WorkflowTypeConcurrency_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_WorkflowTypeConcurrency_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_WorkflowTypeConcurrency_ValueList) Close() {}
+
+// ToWire translates a DescribeDomainResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -11856,6 +13076,14 @@ func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.WorkflowTypeConcurrency != nil {
+		w, err = wire.NewValueList(_List_WorkflowTypeConcurrency_ValueList(v.WorkflowTypeConcurrency)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -11878,6 +13106,30 @@ func _DomainReplicationConfiguration_Read(w wire.Value) (*DomainReplicationConfi
 	return &v, err
 }
 
+func _WorkflowTypeConcurrency_Read(w wire.Value) (*WorkflowTypeConcurrency, error) {
+	var v WorkflowTypeConcurrency
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_WorkflowTypeConcurrency_Read(l wire.ValueList) ([]*WorkflowTypeConcurrency, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*WorkflowTypeConcurrency, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkflowTypeConcurrency_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeDomainResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -11943,6 +13195,14 @@ func (v *DescribeDomainResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.WorkflowTypeConcurrency, err = _List_WorkflowTypeConcurrency_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -11957,7 +13217,7 @@ func (v *DescribeDomainResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.DomainInfo != nil {
 		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
@@ -11979,6 +13239,10 @@ func (v *DescribeDomainResponse) String() string {
 		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
 		i++
 	}
+	if v.WorkflowTypeConcurrency != nil {
+		fields[i] = fmt.Sprintf("WorkflowTypeConcurrency: %v", v.WorkflowTypeConcurrency)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -11993,6 +13257,21 @@ func _Bool_EqualsPtr(lhs, rhs *bool) bool {
 	return lhs == nil && rhs == nil
 }
 
+func _List_WorkflowTypeConcurrency_Equals(lhs, rhs []*WorkflowTypeConcurrency) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeDomainResponse match the
 // provided DescribeDomainResponse.
 //
@@ -12018,10 +13297,24 @@ func (v *DescribeDomainResponse) Equals(rhs *DescribeDomainResponse) bool {
 	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
 		return false
 	}
+	if !((v.WorkflowTypeConcurrency == nil && rhs.WorkflowTypeConcurrency == nil) || (v.WorkflowTypeConcurrency != nil && rhs.WorkflowTypeConcurrency != nil && _List_WorkflowTypeConcurrency_Equals(v.WorkflowTypeConcurrency, rhs.WorkflowTypeConcurrency))) {
+		return false
+	}
 
 	return true
 }
 
+type _List_WorkflowTypeConcurrency_Zapper []*WorkflowTypeConcurrency
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_WorkflowTypeConcurrency_Zapper.
+func (l _List_WorkflowTypeConcurrency_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeDomainResponse.
 func (v *DescribeDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -12043,6 +13336,9 @@ func (v *DescribeDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.IsGlobalDomain != nil {
 		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
 	}
+	if v.WorkflowTypeConcurrency != nil {
+		err = multierr.Append(err, enc.AddArray("workflowTypeConcurrency", (_List_WorkflowTypeConcurrency_Zapper)(v.WorkflowTypeConcurrency)))
+	}
 	return err
 }
 
@@ -12121,6 +13417,21 @@ func (v *DescribeDomainResponse) IsSetIsGlobalDomain() bool {
 	return v != nil && v.IsGlobalDomain != nil
 }
 
+// GetWorkflowTypeConcurrency returns the value of WorkflowTypeConcurrency if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainResponse) GetWorkflowTypeConcurrency() (o []*WorkflowTypeConcurrency) {
+	if v != nil && v.WorkflowTypeConcurrency != nil {
+		return v.WorkflowTypeConcurrency
+	}
+
+	return
+}
+
+// IsSetWorkflowTypeConcurrency returns true if WorkflowTypeConcurrency is not nil.
+func (v *DescribeDomainResponse) IsSetWorkflowTypeConcurrency() bool {
+	return v != nil && v.WorkflowTypeConcurrency != nil
+}
+
 type DescribeHistoryHostRequest struct {
 	HostAddress      *string            `json:"hostAddress,omitempty"`
 	ShardIdForHost   *int32             `json:"shardIdForHost,omitempty"`
@@ -15991,6 +17302,7 @@ const (
 	EventTypeSignalExternalWorkflowExecutionInitiated        EventType = 38
 	EventTypeSignalExternalWorkflowExecutionFailed           EventType = 39
 	EventTypeExternalWorkflowExecutionSignaled               EventType = 40
+	EventTypeWorkflowExecutionUpdateAccepted                 EventType = 41
 )
 
 // EventType_Values returns all recognized values of EventType.
@@ -16037,6 +17349,7 @@ func EventType_Values() []EventType {
 		EventTypeSignalExternalWorkflowExecutionInitiated,
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
+		EventTypeWorkflowExecutionUpdateAccepted,
 	}
 }
 
@@ -16170,6 +17483,9 @@ func (v *EventType) UnmarshalText(value []byte) error {
 	case "ExternalWorkflowExecutionSignaled":
 		*v = EventTypeExternalWorkflowExecutionSignaled
 		return nil
+	case "WorkflowExecutionUpdateAccepted":
+		*v = EventTypeWorkflowExecutionUpdateAccepted
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -16270,6 +17586,8 @@ func (v EventType) MarshalText() ([]byte, error) {
 		return []byte("SignalExternalWorkflowExecutionFailed"), nil
 	case 40:
 		return []byte("ExternalWorkflowExecutionSignaled"), nil
+	case 41:
+		return []byte("WorkflowExecutionUpdateAccepted"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -16363,6 +17681,8 @@ func (v EventType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "SignalExternalWorkflowExecutionFailed")
 	case 40:
 		enc.AddString("name", "ExternalWorkflowExecutionSignaled")
+	case 41:
+		enc.AddString("name", "WorkflowExecutionUpdateAccepted")
 	}
 	return nil
 }
@@ -16485,6 +17805,8 @@ func (v EventType) String() string {
 		return "SignalExternalWorkflowExecutionFailed"
 	case 40:
 		return "ExternalWorkflowExecutionSignaled"
+	case 41:
+		return "WorkflowExecutionUpdateAccepted"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -16585,6 +17907,8 @@ func (v EventType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"SignalExternalWorkflowExecutionFailed\""), nil
 	case 40:
 		return ([]byte)("\"ExternalWorkflowExecutionSignaled\""), nil
+	case 41:
+		return ([]byte)("\"WorkflowExecutionUpdateAccepted\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -17302,6 +18626,8 @@ type GetWorkflowExecutionHistoryRequest struct {
 	NextPageToken          []byte                  `json:"nextPageToken,omitempty"`
 	WaitForNewEvent        *bool                   `json:"waitForNewEvent,omitempty"`
 	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
+	EventFilter            *HistoryEventFilter     `json:"eventFilter,omitempty"`
+	Reverse                *bool                   `json:"reverse,omitempty"`
 }
 
 // ToWire translates a GetWorkflowExecutionHistoryRequest struct into a Thrift-level intermediate
@@ -17321,7 +18647,7 @@ type GetWorkflowExecutionHistoryRequest struct {
 //   }
 func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -17375,6 +18701,22 @@ func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.EventFilter != nil {
+		w, err = v.EventFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Reverse != nil {
+		w, err = wire.NewValueBool(*(v.Reverse)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -17385,6 +18727,12 @@ func _HistoryEventFilterType_Read(w wire.Value) (HistoryEventFilterType, error)
 	return v, err
 }
 
+func _HistoryEventFilter_Read(w wire.Value) (*HistoryEventFilter, error) {
+	var v HistoryEventFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a GetWorkflowExecutionHistoryRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -17462,6 +18810,24 @@ func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.EventFilter, err = _HistoryEventFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Reverse = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -17476,7 +18842,7 @@ func (v *GetWorkflowExecutionHistoryRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [8]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -17502,6 +18868,14 @@ func (v *GetWorkflowExecutionHistoryRequest) String() string {
 		fields[i] = fmt.Sprintf("HistoryEventFilterType: %v", *(v.HistoryEventFilterType))
 		i++
 	}
+	if v.EventFilter != nil {
+		fields[i] = fmt.Sprintf("EventFilter: %v", v.EventFilter)
+		i++
+	}
+	if v.Reverse != nil {
+		fields[i] = fmt.Sprintf("Reverse: %v", *(v.Reverse))
+		i++
+	}
 
 	return fmt.Sprintf("GetWorkflowExecutionHistoryRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -17544,6 +18918,12 @@ func (v *GetWorkflowExecutionHistoryRequest) Equals(rhs *GetWorkflowExecutionHis
 	if !_HistoryEventFilterType_EqualsPtr(v.HistoryEventFilterType, rhs.HistoryEventFilterType) {
 		return false
 	}
+	if !((v.EventFilter == nil && rhs.EventFilter == nil) || (v.EventFilter != nil && rhs.EventFilter != nil && v.EventFilter.Equals(rhs.EventFilter))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Reverse, rhs.Reverse) {
+		return false
+	}
 
 	return true
 }
@@ -17572,6 +18952,12 @@ func (v *GetWorkflowExecutionHistoryRequest) MarshalLogObject(enc zapcore.Object
 	if v.HistoryEventFilterType != nil {
 		err = multierr.Append(err, enc.AddObject("HistoryEventFilterType", *v.HistoryEventFilterType))
 	}
+	if v.EventFilter != nil {
+		err = multierr.Append(err, enc.AddObject("eventFilter", v.EventFilter))
+	}
+	if v.Reverse != nil {
+		enc.AddBool("reverse", *v.Reverse)
+	}
 	return err
 }
 
@@ -17665,6 +19051,36 @@ func (v *GetWorkflowExecutionHistoryRequest) IsSetHistoryEventFilterType() bool
 	return v != nil && v.HistoryEventFilterType != nil
 }
 
+// GetEventFilter returns the value of EventFilter if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionHistoryRequest) GetEventFilter() (o *HistoryEventFilter) {
+	if v != nil && v.EventFilter != nil {
+		return v.EventFilter
+	}
+
+	return
+}
+
+// IsSetEventFilter returns true if EventFilter is not nil.
+func (v *GetWorkflowExecutionHistoryRequest) IsSetEventFilter() bool {
+	return v != nil && v.EventFilter != nil
+}
+
+// GetReverse returns the value of Reverse if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionHistoryRequest) GetReverse() (o bool) {
+	if v != nil && v.Reverse != nil {
+		return *v.Reverse
+	}
+
+	return
+}
+
+// IsSetReverse returns true if Reverse is not nil.
+func (v *GetWorkflowExecutionHistoryRequest) IsSetReverse() bool {
+	return v != nil && v.Reverse != nil
+}
+
 type GetWorkflowExecutionHistoryResponse struct {
 	History       *History `json:"history,omitempty"`
 	NextPageToken []byte   `json:"nextPageToken,omitempty"`
@@ -18914,6 +20330,7 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionInitiatedEventAttributes        *SignalExternalWorkflowExecutionInitiatedEventAttributes        `json:"signalExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
+	WorkflowExecutionUpdateAcceptedEventAttributes                 *WorkflowExecutionUpdateAcceptedEventAttributes                 `json:"workflowExecutionUpdateAcceptedEventAttributes,omitempty"`
 }
 
 // ToWire translates a HistoryEvent struct into a Thrift-level intermediate
@@ -18933,7 +20350,7 @@ type HistoryEvent struct {
 //   }
 func (v *HistoryEvent) ToWire() (wire.Value, error) {
 	var (
-		fields [46]wire.Field
+		fields [47]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -19307,6 +20724,14 @@ func (v *HistoryEvent) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 440, Value: w}
 		i++
 	}
+	if v.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		w, err = v.WorkflowExecutionUpdateAcceptedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 450, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -19563,6 +20988,12 @@ func _ExternalWorkflowExecutionSignaledEventAttributes_Read(w wire.Value) (*Exte
 	return &v, err
 }
 
+func _WorkflowExecutionUpdateAcceptedEventAttributes_Read(w wire.Value) (*WorkflowExecutionUpdateAcceptedEventAttributes, error) {
+	var v WorkflowExecutionUpdateAcceptedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a HistoryEvent struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -19962,6 +21393,14 @@ func (v *HistoryEvent) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 450:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionUpdateAcceptedEventAttributes, err = _WorkflowExecutionUpdateAcceptedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -19976,7 +21415,7 @@ func (v *HistoryEvent) String() string {
 		return "<nil>"
 	}
 
-	var fields [46]string
+	var fields [47]string
 	i := 0
 	if v.EventId != nil {
 		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
@@ -20162,6 +21601,10 @@ func (v *HistoryEvent) String() string {
 		fields[i] = fmt.Sprintf("ExternalWorkflowExecutionSignaledEventAttributes: %v", v.ExternalWorkflowExecutionSignaledEventAttributes)
 		i++
 	}
+	if v.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionUpdateAcceptedEventAttributes: %v", v.WorkflowExecutionUpdateAcceptedEventAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("HistoryEvent{%v}", strings.Join(fields[:i], ", "))
 }
@@ -20324,6 +21767,9 @@ func (v *HistoryEvent) Equals(rhs *HistoryEvent) bool {
 	if !((v.ExternalWorkflowExecutionSignaledEventAttributes == nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes == nil) || (v.ExternalWorkflowExecutionSignaledEventAttributes != nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes != nil && v.ExternalWorkflowExecutionSignaledEventAttributes.Equals(rhs.ExternalWorkflowExecutionSignaledEventAttributes))) {
 		return false
 	}
+	if !((v.WorkflowExecutionUpdateAcceptedEventAttributes == nil && rhs.WorkflowExecutionUpdateAcceptedEventAttributes == nil) || (v.WorkflowExecutionUpdateAcceptedEventAttributes != nil && rhs.WorkflowExecutionUpdateAcceptedEventAttributes != nil && v.WorkflowExecutionUpdateAcceptedEventAttributes.Equals(rhs.WorkflowExecutionUpdateAcceptedEventAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -20472,6 +21918,9 @@ func (v *HistoryEvent) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("externalWorkflowExecutionSignaledEventAttributes", v.ExternalWorkflowExecutionSignaledEventAttributes))
 	}
+	if v.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionUpdateAcceptedEventAttributes", v.WorkflowExecutionUpdateAcceptedEventAttributes))
+	}
 	return err
 }
 
@@ -21165,6 +22614,491 @@ func (v *HistoryEvent) IsSetExternalWorkflowExecutionSignaledEventAttributes() b
 	return v != nil && v.ExternalWorkflowExecutionSignaledEventAttributes != nil
 }
 
+// GetWorkflowExecutionUpdateAcceptedEventAttributes returns the value of WorkflowExecutionUpdateAcceptedEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetWorkflowExecutionUpdateAcceptedEventAttributes() (o *WorkflowExecutionUpdateAcceptedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUpdateAcceptedEventAttributes != nil {
+		return v.WorkflowExecutionUpdateAcceptedEventAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionUpdateAcceptedEventAttributes returns true if WorkflowExecutionUpdateAcceptedEventAttributes is not nil.
+func (v *HistoryEvent) IsSetWorkflowExecutionUpdateAcceptedEventAttributes() bool {
+	return v != nil && v.WorkflowExecutionUpdateAcceptedEventAttributes != nil
+}
+
+type HistoryEventFilter struct {
+	EventTypes   []EventType `json:"eventTypes,omitempty"`
+	MinEventId   *int64      `json:"minEventId,omitempty"`
+	MaxEventId   *int64      `json:"maxEventId,omitempty"`
+	MinTimestamp *int64      `json:"minTimestamp,omitempty"`
+	MaxTimestamp *int64      `json:"maxTimestamp,omitempty"`
+	ActivityId   *string     `json:"activityId,omitempty"`
+	TimerId      *string     `json:"timerId,omitempty"`
+}
+
+type _List_EventType_ValueList []EventType
+
+func (v _List_EventType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_EventType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_EventType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_EventType_ValueList) Close() {}
+
+// ToWire translates a HistoryEventFilter struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryEventFilter) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.EventTypes != nil {
+		w, err = wire.NewValueList(_List_EventType_ValueList(v.EventTypes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.MinEventId != nil {
+		w, err = wire.NewValueI64(*(v.MinEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MaxEventId != nil {
+		w, err = wire.NewValueI64(*(v.MaxEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.MinTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.MinTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MaxTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.MaxTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.TimerId != nil {
+		w, err = wire.NewValueString(*(v.TimerId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_EventType_Read(l wire.ValueList) ([]EventType, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]EventType, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _EventType_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a HistoryEventFilter struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HistoryEventFilter struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HistoryEventFilter
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HistoryEventFilter) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.EventTypes, err = _List_EventType_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MinEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MinTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TimerId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HistoryEventFilter
+// struct.
+func (v *HistoryEventFilter) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.EventTypes != nil {
+		fields[i] = fmt.Sprintf("EventTypes: %v", v.EventTypes)
+		i++
+	}
+	if v.MinEventId != nil {
+		fields[i] = fmt.Sprintf("MinEventId: %v", *(v.MinEventId))
+		i++
+	}
+	if v.MaxEventId != nil {
+		fields[i] = fmt.Sprintf("MaxEventId: %v", *(v.MaxEventId))
+		i++
+	}
+	if v.MinTimestamp != nil {
+		fields[i] = fmt.Sprintf("MinTimestamp: %v", *(v.MinTimestamp))
+		i++
+	}
+	if v.MaxTimestamp != nil {
+		fields[i] = fmt.Sprintf("MaxTimestamp: %v", *(v.MaxTimestamp))
+		i++
+	}
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+		i++
+	}
+	if v.TimerId != nil {
+		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
+		i++
+	}
+
+	return fmt.Sprintf("HistoryEventFilter{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_EventType_Equals(lhs, rhs []EventType) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this HistoryEventFilter match the
+// provided HistoryEventFilter.
+//
+// This function performs a deep comparison.
+func (v *HistoryEventFilter) Equals(rhs *HistoryEventFilter) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.EventTypes == nil && rhs.EventTypes == nil) || (v.EventTypes != nil && rhs.EventTypes != nil && _List_EventType_Equals(v.EventTypes, rhs.EventTypes))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MinEventId, rhs.MinEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaxEventId, rhs.MaxEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MinTimestamp, rhs.MinTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaxTimestamp, rhs.MaxTimestamp) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
+		return false
+	}
+
+	return true
+}
+
+type _List_EventType_Zapper []EventType
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_EventType_Zapper.
+func (l _List_EventType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryEventFilter.
+func (v *HistoryEventFilter) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.EventTypes != nil {
+		err = multierr.Append(err, enc.AddArray("eventTypes", (_List_EventType_Zapper)(v.EventTypes)))
+	}
+	if v.MinEventId != nil {
+		enc.AddInt64("minEventId", *v.MinEventId)
+	}
+	if v.MaxEventId != nil {
+		enc.AddInt64("maxEventId", *v.MaxEventId)
+	}
+	if v.MinTimestamp != nil {
+		enc.AddInt64("minTimestamp", *v.MinTimestamp)
+	}
+	if v.MaxTimestamp != nil {
+		enc.AddInt64("maxTimestamp", *v.MaxTimestamp)
+	}
+	if v.ActivityId != nil {
+		enc.AddString("activityId", *v.ActivityId)
+	}
+	if v.TimerId != nil {
+		enc.AddString("timerId", *v.TimerId)
+	}
+	return err
+}
+
+// GetEventTypes returns the value of EventTypes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEventFilter) GetEventTypes() (o []EventType) {
+	if v != nil && v.EventTypes != nil {
+		return v.EventTypes
+	}
+
+	return
+}
+
+// IsSetEventTypes returns true if EventTypes is not nil.
+func (v *HistoryEventFilter) IsSetEventTypes() bool {
+	return v != nil && v.EventTypes != nil
+}
+
+// GetMinEventId returns the value of MinEventId if it is set or its
+// zero value if it is unset.
+func (v *HistoryEventFilter) GetMinEventId() (o int64) {
+	if v != nil && v.MinEventId != nil {
+		return *v.MinEventId
+	}
+
+	return
+}
+
+// IsSetMinEventId returns true if MinEventId is not nil.
+func (v *HistoryEventFilter) IsSetMinEventId() bool {
+	return v != nil && v.MinEventId != nil
+}
+
+// GetMaxEventId returns the value of MaxEventId if it is set or its
+// zero value if it is unset.
+func (v *HistoryEventFilter) GetMaxEventId() (o int64) {
+	if v != nil && v.MaxEventId != nil {
+		return *v.MaxEventId
+	}
+
+	return
+}
+
+// IsSetMaxEventId returns true if MaxEventId is not nil.
+func (v *HistoryEventFilter) IsSetMaxEventId() bool {
+	return v != nil && v.MaxEventId != nil
+}
+
+// GetMinTimestamp returns the value of MinTimestamp if it is set or its
+// zero value if it is unset.
+func (v *HistoryEventFilter) GetMinTimestamp() (o int64) {
+	if v != nil && v.MinTimestamp != nil {
+		return *v.MinTimestamp
+	}
+
+	return
+}
+
+// IsSetMinTimestamp returns true if MinTimestamp is not nil.
+func (v *HistoryEventFilter) IsSetMinTimestamp() bool {
+	return v != nil && v.MinTimestamp != nil
+}
+
+// GetMaxTimestamp returns the value of MaxTimestamp if it is set or its
+// zero value if it is unset.
+func (v *HistoryEventFilter) GetMaxTimestamp() (o int64) {
+	if v != nil && v.MaxTimestamp != nil {
+		return *v.MaxTimestamp
+	}
+
+	return
+}
+
+// IsSetMaxTimestamp returns true if MaxTimestamp is not nil.
+func (v *HistoryEventFilter) IsSetMaxTimestamp() bool {
+	return v != nil && v.MaxTimestamp != nil
+}
+
+// GetActivityId returns the value of ActivityId if it is set or its
+// zero value if it is unset.
+func (v *HistoryEventFilter) GetActivityId() (o string) {
+	if v != nil && v.ActivityId != nil {
+		return *v.ActivityId
+	}
+
+	return
+}
+
+// IsSetActivityId returns true if ActivityId is not nil.
+func (v *HistoryEventFilter) IsSetActivityId() bool {
+	return v != nil && v.ActivityId != nil
+}
+
+// GetTimerId returns the value of TimerId if it is set or its
+// zero value if it is unset.
+func (v *HistoryEventFilter) GetTimerId() (o string) {
+	if v != nil && v.TimerId != nil {
+		return *v.TimerId
+	}
+
+	return
+}
+
+// IsSetTimerId returns true if TimerId is not nil.
+func (v *HistoryEventFilter) IsSetTimerId() bool {
+	return v != nil && v.TimerId != nil
+}
+
 type HistoryEventFilterType int32
 
 const (
@@ -24096,6 +26030,232 @@ func (v *Memo) IsSetFields() bool {
 	return v != nil && v.Fields != nil
 }
 
+type PayloadReference struct {
+	Bucket *string `json:"bucket,omitempty"`
+	Key    *string `json:"key,omitempty"`
+	Size   *int64  `json:"size,omitempty"`
+}
+
+// ToWire translates a PayloadReference struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PayloadReference) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Bucket != nil {
+		w, err = wire.NewValueString(*(v.Bucket)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Size != nil {
+		w, err = wire.NewValueI64(*(v.Size)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PayloadReference struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PayloadReference struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PayloadReference
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PayloadReference) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Bucket = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Size = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PayloadReference
+// struct.
+func (v *PayloadReference) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Bucket != nil {
+		fields[i] = fmt.Sprintf("Bucket: %v", *(v.Bucket))
+		i++
+	}
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+	if v.Size != nil {
+		fields[i] = fmt.Sprintf("Size: %v", *(v.Size))
+		i++
+	}
+
+	return fmt.Sprintf("PayloadReference{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PayloadReference match the
+// provided PayloadReference.
+//
+// This function performs a deep comparison.
+func (v *PayloadReference) Equals(rhs *PayloadReference) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Bucket, rhs.Bucket) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Size, rhs.Size) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PayloadReference.
+func (v *PayloadReference) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Bucket != nil {
+		enc.AddString("bucket", *v.Bucket)
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	if v.Size != nil {
+		enc.AddInt64("size", *v.Size)
+	}
+	return err
+}
+
+// GetBucket returns the value of Bucket if it is set or its
+// zero value if it is unset.
+func (v *PayloadReference) GetBucket() (o string) {
+	if v != nil && v.Bucket != nil {
+		return *v.Bucket
+	}
+
+	return
+}
+
+// IsSetBucket returns true if Bucket is not nil.
+func (v *PayloadReference) IsSetBucket() bool {
+	return v != nil && v.Bucket != nil
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *PayloadReference) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *PayloadReference) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// GetSize returns the value of Size if it is set or its
+// zero value if it is unset.
+func (v *PayloadReference) GetSize() (o int64) {
+	if v != nil && v.Size != nil {
+		return *v.Size
+	}
+
+	return
+}
+
+// IsSetSize returns true if Size is not nil.
+func (v *PayloadReference) IsSetSize() bool {
+	return v != nil && v.Size != nil
+}
+
 type PendingActivityInfo struct {
 	ActivityID             *string               `json:"activityID,omitempty"`
 	ActivityType           *ActivityType         `json:"activityType,omitempty"`
@@ -25115,6 +27275,7 @@ type PollForActivityTaskResponse struct {
 	WorkflowType                    *WorkflowType      `json:"workflowType,omitempty"`
 	WorkflowDomain                  *string            `json:"workflowDomain,omitempty"`
 	Header                          *Header            `json:"header,omitempty"`
+	InputReference                  *PayloadReference  `json:"inputReference,omitempty"`
 }
 
 // ToWire translates a PollForActivityTaskResponse struct into a Thrift-level intermediate
@@ -25134,7 +27295,7 @@ type PollForActivityTaskResponse struct {
 //   }
 func (v *PollForActivityTaskResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [16]wire.Field
+		fields [17]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -25268,6 +27429,14 @@ func (v *PollForActivityTaskResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 170, Value: w}
 		i++
 	}
+	if v.InputReference != nil {
+		w, err = v.InputReference.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -25439,6 +27608,14 @@ func (v *PollForActivityTaskResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 180:
+			if field.Value.Type() == wire.TStruct {
+				v.InputReference, err = _PayloadReference_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -25453,7 +27630,7 @@ func (v *PollForActivityTaskResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [16]string
+	var fields [17]string
 	i := 0
 	if v.TaskToken != nil {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
@@ -25519,6 +27696,10 @@ func (v *PollForActivityTaskResponse) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.InputReference != nil {
+		fields[i] = fmt.Sprintf("InputReference: %v", v.InputReference)
+		i++
+	}
 
 	return fmt.Sprintf("PollForActivityTaskResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -25581,6 +27762,9 @@ func (v *PollForActivityTaskResponse) Equals(rhs *PollForActivityTaskResponse) b
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !((v.InputReference == nil && rhs.InputReference == nil) || (v.InputReference != nil && rhs.InputReference != nil && v.InputReference.Equals(rhs.InputReference))) {
+		return false
+	}
 
 	return true
 }
@@ -25639,6 +27823,9 @@ func (v *PollForActivityTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.InputReference != nil {
+		err = multierr.Append(err, enc.AddObject("inputReference", v.InputReference))
+	}
 	return err
 }
 
@@ -25882,6 +28069,21 @@ func (v *PollForActivityTaskResponse) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetInputReference returns the value of InputReference if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetInputReference() (o *PayloadReference) {
+	if v != nil && v.InputReference != nil {
+		return v.InputReference
+	}
+
+	return
+}
+
+// IsSetInputReference returns true if InputReference is not nil.
+func (v *PollForActivityTaskResponse) IsSetInputReference() bool {
+	return v != nil && v.InputReference != nil
+}
+
 type PollForDecisionTaskRequest struct {
 	Domain         *string   `json:"domain,omitempty"`
 	TaskList       *TaskList `json:"taskList,omitempty"`
@@ -26151,19 +28353,58 @@ func (v *PollForDecisionTaskRequest) IsSetBinaryChecksum() bool {
 }
 
 type PollForDecisionTaskResponse struct {
-	TaskToken                 []byte             `json:"taskToken,omitempty"`
-	WorkflowExecution         *WorkflowExecution `json:"workflowExecution,omitempty"`
-	WorkflowType              *WorkflowType      `json:"workflowType,omitempty"`
-	PreviousStartedEventId    *int64             `json:"previousStartedEventId,omitempty"`
-	StartedEventId            *int64             `json:"startedEventId,omitempty"`
-	Attempt                   *int64             `json:"attempt,omitempty"`
-	BacklogCountHint          *int64             `json:"backlogCountHint,omitempty"`
-	History                   *History           `json:"history,omitempty"`
-	NextPageToken             []byte             `json:"nextPageToken,omitempty"`
-	Query                     *WorkflowQuery     `json:"query,omitempty"`
-	WorkflowExecutionTaskList *TaskList          `json:"WorkflowExecutionTaskList,omitempty"`
+	TaskToken                 []byte                     `json:"taskToken,omitempty"`
+	WorkflowExecution         *WorkflowExecution         `json:"workflowExecution,omitempty"`
+	WorkflowType              *WorkflowType              `json:"workflowType,omitempty"`
+	PreviousStartedEventId    *int64                     `json:"previousStartedEventId,omitempty"`
+	StartedEventId            *int64                     `json:"startedEventId,omitempty"`
+	Attempt                   *int64                     `json:"attempt,omitempty"`
+	BacklogCountHint          *int64                     `json:"backlogCountHint,omitempty"`
+	History                   *History                   `json:"history,omitempty"`
+	NextPageToken             []byte                     `json:"nextPageToken,omitempty"`
+	Query                     *WorkflowQuery             `json:"query,omitempty"`
+	WorkflowExecutionTaskList *TaskList                  `json:"WorkflowExecutionTaskList,omitempty"`
+	Updates                   map[string]*WorkflowUpdate `json:"updates,omitempty"`
+}
+
+type _Map_String_WorkflowUpdate_MapItemList map[string]*WorkflowUpdate
+
+func (m _Map_String_WorkflowUpdate_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_WorkflowUpdate_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_WorkflowUpdate_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_WorkflowUpdate_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
+func (_Map_String_WorkflowUpdate_MapItemList) Close() {}
+
 // ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -26181,7 +28422,7 @@ type PollForDecisionTaskResponse struct {
 //   }
 func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -26275,6 +28516,14 @@ func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.Updates != nil {
+		w, err = wire.NewValueMap(_Map_String_WorkflowUpdate_MapItemList(v.Updates)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -26285,6 +28534,40 @@ func _WorkflowQuery_Read(w wire.Value) (*WorkflowQuery, error) {
 	return &v, err
 }
 
+func _WorkflowUpdate_Read(w wire.Value) (*WorkflowUpdate, error) {
+	var v WorkflowUpdate
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_WorkflowUpdate_Read(m wire.MapItemList) (map[string]*WorkflowUpdate, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*WorkflowUpdate, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _WorkflowUpdate_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -26402,6 +28685,14 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 100:
+			if field.Value.Type() == wire.TMap {
+				v.Updates, err = _Map_String_WorkflowUpdate_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -26416,7 +28707,7 @@ func (v *PollForDecisionTaskResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [12]string
 	i := 0
 	if v.TaskToken != nil {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
@@ -26462,10 +28753,31 @@ func (v *PollForDecisionTaskResponse) String() string {
 		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
 		i++
 	}
+	if v.Updates != nil {
+		fields[i] = fmt.Sprintf("Updates: %v", v.Updates)
+		i++
+	}
 
 	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _Map_String_WorkflowUpdate_Equals(lhs, rhs map[string]*WorkflowUpdate) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this PollForDecisionTaskResponse match the
 // provided PollForDecisionTaskResponse.
 //
@@ -26509,10 +28821,24 @@ func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) b
 	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
 		return false
 	}
+	if !((v.Updates == nil && rhs.Updates == nil) || (v.Updates != nil && rhs.Updates != nil && _Map_String_WorkflowUpdate_Equals(v.Updates, rhs.Updates))) {
+		return false
+	}
 
 	return true
 }
 
+type _Map_String_WorkflowUpdate_Zapper map[string]*WorkflowUpdate
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_WorkflowUpdate_Zapper.
+func (m _Map_String_WorkflowUpdate_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of PollForDecisionTaskResponse.
 func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -26552,6 +28878,9 @@ func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder
 	if v.WorkflowExecutionTaskList != nil {
 		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
 	}
+	if v.Updates != nil {
+		err = multierr.Append(err, enc.AddObject("updates", (_Map_String_WorkflowUpdate_Zapper)(v.Updates)))
+	}
 	return err
 }
 
@@ -26720,6 +29049,21 @@ func (v *PollForDecisionTaskResponse) IsSetWorkflowExecutionTaskList() bool {
 	return v != nil && v.WorkflowExecutionTaskList != nil
 }
 
+// GetUpdates returns the value of Updates if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetUpdates() (o map[string]*WorkflowUpdate) {
+	if v != nil && v.Updates != nil {
+		return v.Updates
+	}
+
+	return
+}
+
+// IsSetUpdates returns true if Updates is not nil.
+func (v *PollForDecisionTaskResponse) IsSetUpdates() bool {
+	return v != nil && v.Updates != nil
+}
+
 type PollerInfo struct {
 	LastAccessTime *int64   `json:"lastAccessTime,omitempty"`
 	Identity       *string  `json:"identity,omitempty"`
@@ -34053,14 +36397,15 @@ func (v *RespondActivityTaskFailedRequest) IsSetIdentity() bool {
 }
 
 type RespondDecisionTaskCompletedRequest struct {
-	TaskToken                  []byte                     `json:"taskToken,omitempty"`
-	Decisions                  []*Decision                `json:"decisions,omitempty"`
-	ExecutionContext           []byte                     `json:"executionContext,omitempty"`
-	Identity                   *string                    `json:"identity,omitempty"`
-	StickyAttributes           *StickyExecutionAttributes `json:"stickyAttributes,omitempty"`
-	ReturnNewDecisionTask      *bool                      `json:"returnNewDecisionTask,omitempty"`
-	ForceCreateNewDecisionTask *bool                      `json:"forceCreateNewDecisionTask,omitempty"`
-	BinaryChecksum             *string                    `json:"binaryChecksum,omitempty"`
+	TaskToken                  []byte                           `json:"taskToken,omitempty"`
+	Decisions                  []*Decision                      `json:"decisions,omitempty"`
+	ExecutionContext           []byte                           `json:"executionContext,omitempty"`
+	Identity                   *string                          `json:"identity,omitempty"`
+	StickyAttributes           *StickyExecutionAttributes       `json:"stickyAttributes,omitempty"`
+	ReturnNewDecisionTask      *bool                            `json:"returnNewDecisionTask,omitempty"`
+	ForceCreateNewDecisionTask *bool                            `json:"forceCreateNewDecisionTask,omitempty"`
+	BinaryChecksum             *string                          `json:"binaryChecksum,omitempty"`
+	UpdateResults              map[string]*WorkflowUpdateResult `json:"updateResults,omitempty"`
 }
 
 type _List_Decision_ValueList []*Decision
@@ -34092,6 +36437,44 @@ func (_List_Decision_ValueList) ValueType() wire.Type {
 
 func (_List_Decision_ValueList) Close() {}
 
+type _Map_String_WorkflowUpdateResult_MapItemList map[string]*WorkflowUpdateResult
+
+func (m _Map_String_WorkflowUpdateResult_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_WorkflowUpdateResult_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_WorkflowUpdateResult_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_WorkflowUpdateResult_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_WorkflowUpdateResult_MapItemList) Close() {}
+
 // ToWire translates a RespondDecisionTaskCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -34109,7 +36492,7 @@ func (_List_Decision_ValueList) Close() {}
 //   }
 func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -34179,6 +36562,14 @@ func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
+	if v.UpdateResults != nil {
+		w, err = wire.NewValueMap(_Map_String_WorkflowUpdateResult_MapItemList(v.UpdateResults)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -34213,6 +36604,40 @@ func _StickyExecutionAttributes_Read(w wire.Value) (*StickyExecutionAttributes,
 	return &v, err
 }
 
+func _WorkflowUpdateResult_Read(w wire.Value) (*WorkflowUpdateResult, error) {
+	var v WorkflowUpdateResult
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_WorkflowUpdateResult_Read(m wire.MapItemList) (map[string]*WorkflowUpdateResult, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*WorkflowUpdateResult, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _WorkflowUpdateResult_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a RespondDecisionTaskCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -34306,6 +36731,14 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 90:
+			if field.Value.Type() == wire.TMap {
+				v.UpdateResults, err = _Map_String_WorkflowUpdateResult_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -34320,7 +36753,7 @@ func (v *RespondDecisionTaskCompletedRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [9]string
 	i := 0
 	if v.TaskToken != nil {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
@@ -34354,6 +36787,10 @@ func (v *RespondDecisionTaskCompletedRequest) String() string {
 		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
 		i++
 	}
+	if v.UpdateResults != nil {
+		fields[i] = fmt.Sprintf("UpdateResults: %v", v.UpdateResults)
+		i++
+	}
 
 	return fmt.Sprintf("RespondDecisionTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -34373,6 +36810,23 @@ func _List_Decision_Equals(lhs, rhs []*Decision) bool {
 	return true
 }
 
+func _Map_String_WorkflowUpdateResult_Equals(lhs, rhs map[string]*WorkflowUpdateResult) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this RespondDecisionTaskCompletedRequest match the
 // provided RespondDecisionTaskCompletedRequest.
 //
@@ -34407,6 +36861,9 @@ func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCom
 	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
 		return false
 	}
+	if !((v.UpdateResults == nil && rhs.UpdateResults == nil) || (v.UpdateResults != nil && rhs.UpdateResults != nil && _Map_String_WorkflowUpdateResult_Equals(v.UpdateResults, rhs.UpdateResults))) {
+		return false
+	}
 
 	return true
 }
@@ -34422,6 +36879,17 @@ func (l _List_Decision_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err er
 	return err
 }
 
+type _Map_String_WorkflowUpdateResult_Zapper map[string]*WorkflowUpdateResult
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_WorkflowUpdateResult_Zapper.
+func (m _Map_String_WorkflowUpdateResult_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of RespondDecisionTaskCompletedRequest.
 func (v *RespondDecisionTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -34452,6 +36920,9 @@ func (v *RespondDecisionTaskCompletedRequest) MarshalLogObject(enc zapcore.Objec
 	if v.BinaryChecksum != nil {
 		enc.AddString("binaryChecksum", *v.BinaryChecksum)
 	}
+	if v.UpdateResults != nil {
+		err = multierr.Append(err, enc.AddObject("updateResults", (_Map_String_WorkflowUpdateResult_Zapper)(v.UpdateResults)))
+	}
 	return err
 }
 
@@ -34575,6 +37046,21 @@ func (v *RespondDecisionTaskCompletedRequest) IsSetBinaryChecksum() bool {
 	return v != nil && v.BinaryChecksum != nil
 }
 
+// GetUpdateResults returns the value of UpdateResults if it is set or its
+// zero value if it is unset.
+func (v *RespondDecisionTaskCompletedRequest) GetUpdateResults() (o map[string]*WorkflowUpdateResult) {
+	if v != nil && v.UpdateResults != nil {
+		return v.UpdateResults
+	}
+
+	return
+}
+
+// IsSetUpdateResults returns true if UpdateResults is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetUpdateResults() bool {
+	return v != nil && v.UpdateResults != nil
+}
+
 type RespondDecisionTaskCompletedResponse struct {
 	DecisionTask *PollForDecisionTaskResponse `json:"decisionTask,omitempty"`
 }
@@ -36570,7 +39056,8 @@ func (v *ScheduleActivityTaskDecisionAttributes) IsSetHeader() bool {
 }
 
 type ServiceBusyError struct {
-	Message string `json:"message,required"`
+	Message            string `json:"message,required"`
+	RetryAfterInMillis *int64 `json:"retryAfterInMillis,omitempty"`
 }
 
 // ToWire translates a ServiceBusyError struct into a Thrift-level intermediate
@@ -36590,7 +39077,7 @@ type ServiceBusyError struct {
 //   }
 func (v *ServiceBusyError) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -36602,6 +39089,14 @@ func (v *ServiceBusyError) ToWire() (wire.Value, error) {
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
+	if v.RetryAfterInMillis != nil {
+		w, err = wire.NewValueI64(*(v.RetryAfterInMillis)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -36638,6 +39133,16 @@ func (v *ServiceBusyError) FromWire(w wire.Value) error {
 				}
 				messageIsSet = true
 			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RetryAfterInMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
@@ -36655,10 +39160,14 @@ func (v *ServiceBusyError) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	fields[i] = fmt.Sprintf("Message: %v", v.Message)
 	i++
+	if v.RetryAfterInMillis != nil {
+		fields[i] = fmt.Sprintf("RetryAfterInMillis: %v", *(v.RetryAfterInMillis))
+		i++
+	}
 
 	return fmt.Sprintf("ServiceBusyError{%v}", strings.Join(fields[:i], ", "))
 }
@@ -36676,6 +39185,9 @@ func (v *ServiceBusyError) Equals(rhs *ServiceBusyError) bool {
 	if !(v.Message == rhs.Message) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.RetryAfterInMillis, rhs.RetryAfterInMillis) {
+		return false
+	}
 
 	return true
 }
@@ -36687,6 +39199,9 @@ func (v *ServiceBusyError) MarshalLogObject(enc zapcore.ObjectEncoder) (err erro
 		return nil
 	}
 	enc.AddString("message", v.Message)
+	if v.RetryAfterInMillis != nil {
+		enc.AddInt64("retryAfterInMillis", *v.RetryAfterInMillis)
+	}
 	return err
 }
 
@@ -36699,6 +39214,21 @@ func (v *ServiceBusyError) GetMessage() (o string) {
 	return
 }
 
+// GetRetryAfterInMillis returns the value of RetryAfterInMillis if it is set or its
+// zero value if it is unset.
+func (v *ServiceBusyError) GetRetryAfterInMillis() (o int64) {
+	if v != nil && v.RetryAfterInMillis != nil {
+		return *v.RetryAfterInMillis
+	}
+
+	return
+}
+
+// IsSetRetryAfterInMillis returns true if RetryAfterInMillis is not nil.
+func (v *ServiceBusyError) IsSetRetryAfterInMillis() bool {
+	return v != nil && v.RetryAfterInMillis != nil
+}
+
 func (v *ServiceBusyError) Error() string {
 	return v.String()
 }
@@ -37997,6 +40527,9 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
 	Memo                                *Memo                  `json:"memo,omitempty"`
 	Header                              *Header                `json:"header,omitempty"`
+	RetentionPeriodInDays               *int32                 `json:"retentionPeriodInDays,omitempty"`
+	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	WorkflowExecutionTimeoutSeconds     *int32                 `json:"workflowExecutionTimeoutSeconds,omitempty"`
 }
 
 // ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -38016,7 +40549,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   }
 func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [17]wire.Field
+		fields [20]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -38158,6 +40691,30 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 170, Value: w}
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
+	if v.DelayStartSeconds != nil {
+		w, err = wire.NewValueI32(*(v.DelayStartSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 190, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 200, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -38349,6 +40906,36 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 180:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 190:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.DelayStartSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 200:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -38363,7 +40950,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [17]string
+	var fields [20]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -38433,6 +41020,18 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
+	if v.DelayStartSeconds != nil {
+		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
+		i++
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTimeoutSeconds: %v", *(v.WorkflowExecutionTimeoutSeconds))
+		i++
+	}
 
 	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -38508,6 +41107,15 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.WorkflowExecutionTimeoutSeconds, rhs.WorkflowExecutionTimeoutSeconds) {
+		return false
+	}
 
 	return true
 }
@@ -38569,6 +41177,15 @@ func (v *SignalWithStartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.O
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
+	if v.DelayStartSeconds != nil {
+		enc.AddInt32("delayStartSeconds", *v.DelayStartSeconds)
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		enc.AddInt32("workflowExecutionTimeoutSeconds", *v.WorkflowExecutionTimeoutSeconds)
+	}
 	return err
 }
 
@@ -38827,14 +41444,60 @@ func (v *SignalWithStartWorkflowExecutionRequest) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
+}
+
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
+// GetDelayStartSeconds returns the value of DelayStartSeconds if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetDelayStartSeconds() (o int32) {
+	if v != nil && v.DelayStartSeconds != nil {
+		return *v.DelayStartSeconds
+	}
+
+	return
+}
+
+// IsSetDelayStartSeconds returns true if DelayStartSeconds is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetDelayStartSeconds() bool {
+	return v != nil && v.DelayStartSeconds != nil
+}
+
+// GetWorkflowExecutionTimeoutSeconds returns the value of WorkflowExecutionTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowExecutionTimeoutSeconds() (o int32) {
+	if v != nil && v.WorkflowExecutionTimeoutSeconds != nil {
+		return *v.WorkflowExecutionTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionTimeoutSeconds returns true if WorkflowExecutionTimeoutSeconds is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetWorkflowExecutionTimeoutSeconds() bool {
+	return v != nil && v.WorkflowExecutionTimeoutSeconds != nil
+}
+
 type SignalWorkflowExecutionRequest struct {
-	Domain            *string            `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	SignalName        *string            `json:"signalName,omitempty"`
-	Input             []byte             `json:"input,omitempty"`
-	Identity          *string            `json:"identity,omitempty"`
-	RequestId         *string            `json:"requestId,omitempty"`
-	Control           []byte             `json:"control,omitempty"`
+	Domain             *string            `json:"domain,omitempty"`
+	WorkflowExecution  *WorkflowExecution `json:"workflowExecution,omitempty"`
+	SignalName         *string            `json:"signalName,omitempty"`
+	Input              []byte             `json:"input,omitempty"`
+	Identity           *string            `json:"identity,omitempty"`
+	RequestId          *string            `json:"requestId,omitempty"`
+	Control            []byte             `json:"control,omitempty"`
+	DeliverAtTimestamp *int64             `json:"deliverAtTimestamp,omitempty"`
 }
 
 // ToWire translates a SignalWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -38854,7 +41517,7 @@ type SignalWorkflowExecutionRequest struct {
 //   }
 func (v *SignalWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -38916,6 +41579,14 @@ func (v *SignalWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.DeliverAtTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.DeliverAtTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -39005,6 +41676,16 @@ func (v *SignalWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DeliverAtTimestamp = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -39019,7 +41700,7 @@ func (v *SignalWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -39049,6 +41730,10 @@ func (v *SignalWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Control: %v", v.Control)
 		i++
 	}
+	if v.DeliverAtTimestamp != nil {
+		fields[i] = fmt.Sprintf("DeliverAtTimestamp: %v", *(v.DeliverAtTimestamp))
+		i++
+	}
 
 	return fmt.Sprintf("SignalWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -39084,6 +41769,9 @@ func (v *SignalWorkflowExecutionRequest) Equals(rhs *SignalWorkflowExecutionRequ
 	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.DeliverAtTimestamp, rhs.DeliverAtTimestamp) {
+		return false
+	}
 
 	return true
 }
@@ -39115,6 +41803,9 @@ func (v *SignalWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.Control != nil {
 		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
 	}
+	if v.DeliverAtTimestamp != nil {
+		enc.AddInt64("deliverAtTimestamp", *v.DeliverAtTimestamp)
+	}
 	return err
 }
 
@@ -39223,6 +41914,21 @@ func (v *SignalWorkflowExecutionRequest) IsSetControl() bool {
 	return v != nil && v.Control != nil
 }
 
+// GetDeliverAtTimestamp returns the value of DeliverAtTimestamp if it is set or its
+// zero value if it is unset.
+func (v *SignalWorkflowExecutionRequest) GetDeliverAtTimestamp() (o int64) {
+	if v != nil && v.DeliverAtTimestamp != nil {
+		return *v.DeliverAtTimestamp
+	}
+
+	return
+}
+
+// IsSetDeliverAtTimestamp returns true if DeliverAtTimestamp is not nil.
+func (v *SignalWorkflowExecutionRequest) IsSetDeliverAtTimestamp() bool {
+	return v != nil && v.DeliverAtTimestamp != nil
+}
+
 type StartChildWorkflowExecutionDecisionAttributes struct {
 	Domain                              *string                `json:"domain,omitempty"`
 	WorkflowId                          *string                `json:"workflowId,omitempty"`
@@ -41369,25 +44075,40 @@ func (v *StartTimerDecisionAttributes) IsSetStartToFireTimeoutSeconds() bool {
 	return v != nil && v.StartToFireTimeoutSeconds != nil
 }
 
-type StartWorkflowExecutionRequest struct {
-	Domain                              *string                `json:"domain,omitempty"`
-	WorkflowId                          *string                `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            *string                `json:"identity,omitempty"`
-	RequestId                           *string                `json:"requestId,omitempty"`
-	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	ChildPolicy                         *ChildPolicy           `json:"childPolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
+type StartWorkflowExecutionBatchRequest struct {
+	Requests []*StartWorkflowExecutionRequest `json:"requests,omitempty"`
 }
 
-// ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
+type _List_StartWorkflowExecutionRequest_ValueList []*StartWorkflowExecutionRequest
+
+func (v _List_StartWorkflowExecutionRequest_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_StartWorkflowExecutionRequest_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_StartWorkflowExecutionRequest_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_StartWorkflowExecutionRequest_ValueList) Close() {}
+
+// ToWire translates a StartWorkflowExecutionBatchRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -41402,143 +44123,450 @@ type StartWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *StartWorkflowExecutionBatchRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [15]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.Requests != nil {
+		w, err = wire.NewValueList(_List_StartWorkflowExecutionRequest_ValueList(v.Requests)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _StartWorkflowExecutionRequest_Read(w wire.Value) (*StartWorkflowExecutionRequest, error) {
+	var v StartWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_StartWorkflowExecutionRequest_Read(l wire.ValueList) ([]*StartWorkflowExecutionRequest, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
 	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
+
+	o := make([]*StartWorkflowExecutionRequest, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _StartWorkflowExecutionRequest_Read(x)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
-		if err != nil {
-			return w, err
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a StartWorkflowExecutionBatchRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StartWorkflowExecutionBatchRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StartWorkflowExecutionBatchRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StartWorkflowExecutionBatchRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Requests, err = _List_StartWorkflowExecutionRequest_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
 	}
-	if v.Input != nil {
-		w, err = wire.NewValueBinary(v.Input), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
+
+	return nil
+}
+
+// String returns a readable string representation of a StartWorkflowExecutionBatchRequest
+// struct.
+func (v *StartWorkflowExecutionBatchRequest) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if v.ExecutionStartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ExecutionStartToCloseTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+
+	var fields [1]string
+	i := 0
+	if v.Requests != nil {
+		fields[i] = fmt.Sprintf("Requests: %v", v.Requests)
 		i++
 	}
-	if v.TaskStartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.TaskStartToCloseTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
+
+	return fmt.Sprintf("StartWorkflowExecutionBatchRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_StartWorkflowExecutionRequest_Equals(lhs, rhs []*StartWorkflowExecutionRequest) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+
+	return true
+}
+
+// Equals returns true if all the fields of this StartWorkflowExecutionBatchRequest match the
+// provided StartWorkflowExecutionBatchRequest.
+//
+// This function performs a deep comparison.
+func (v *StartWorkflowExecutionBatchRequest) Equals(rhs *StartWorkflowExecutionBatchRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Requests == nil && rhs.Requests == nil) || (v.Requests != nil && rhs.Requests != nil && _List_StartWorkflowExecutionRequest_Equals(v.Requests, rhs.Requests))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_StartWorkflowExecutionRequest_Zapper []*StartWorkflowExecutionRequest
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_StartWorkflowExecutionRequest_Zapper.
+func (l _List_StartWorkflowExecutionRequest_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StartWorkflowExecutionBatchRequest.
+func (v *StartWorkflowExecutionBatchRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Requests != nil {
+		err = multierr.Append(err, enc.AddArray("requests", (_List_StartWorkflowExecutionRequest_Zapper)(v.Requests)))
+	}
+	return err
+}
+
+// GetRequests returns the value of Requests if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionBatchRequest) GetRequests() (o []*StartWorkflowExecutionRequest) {
+	if v != nil && v.Requests != nil {
+		return v.Requests
+	}
+
+	return
+}
+
+// IsSetRequests returns true if Requests is not nil.
+func (v *StartWorkflowExecutionBatchRequest) IsSetRequests() bool {
+	return v != nil && v.Requests != nil
+}
+
+type StartWorkflowExecutionBatchResponse struct {
+	Results []*StartWorkflowExecutionBatchResult `json:"results,omitempty"`
+}
+
+type _List_StartWorkflowExecutionBatchResult_ValueList []*StartWorkflowExecutionBatchResult
+
+func (v _List_StartWorkflowExecutionBatchResult_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		err = f(w)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
 	}
-	if v.WorkflowIdReusePolicy != nil {
-		w, err = v.WorkflowIdReusePolicy.ToWire()
+	return nil
+}
+
+func (v _List_StartWorkflowExecutionBatchResult_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_StartWorkflowExecutionBatchResult_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_StartWorkflowExecutionBatchResult_ValueList) Close() {}
+
+// ToWire translates a StartWorkflowExecutionBatchResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StartWorkflowExecutionBatchResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Results != nil {
+		w, err = wire.NewValueList(_List_StartWorkflowExecutionBatchResult_ValueList(v.Results)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 100, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ChildPolicy != nil {
-		w, err = v.ChildPolicy.ToWire()
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _StartWorkflowExecutionBatchResult_Read(w wire.Value) (*StartWorkflowExecutionBatchResult, error) {
+	var v StartWorkflowExecutionBatchResult
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_StartWorkflowExecutionBatchResult_Read(l wire.ValueList) ([]*StartWorkflowExecutionBatchResult, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*StartWorkflowExecutionBatchResult, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _StartWorkflowExecutionBatchResult_Read(x)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 110, Value: w}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a StartWorkflowExecutionBatchResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StartWorkflowExecutionBatchResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StartWorkflowExecutionBatchResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StartWorkflowExecutionBatchResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Results, err = _List_StartWorkflowExecutionBatchResult_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a StartWorkflowExecutionBatchResponse
+// struct.
+func (v *StartWorkflowExecutionBatchResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Results != nil {
+		fields[i] = fmt.Sprintf("Results: %v", v.Results)
 		i++
 	}
-	if v.RetryPolicy != nil {
-		w, err = v.RetryPolicy.ToWire()
-		if err != nil {
-			return w, err
+
+	return fmt.Sprintf("StartWorkflowExecutionBatchResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_StartWorkflowExecutionBatchResult_Equals(lhs, rhs []*StartWorkflowExecutionBatchResult) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
 		}
-		fields[i] = wire.Field{ID: 120, Value: w}
-		i++
 	}
-	if v.CronSchedule != nil {
-		w, err = wire.NewValueString(*(v.CronSchedule)), error(nil)
+
+	return true
+}
+
+// Equals returns true if all the fields of this StartWorkflowExecutionBatchResponse match the
+// provided StartWorkflowExecutionBatchResponse.
+//
+// This function performs a deep comparison.
+func (v *StartWorkflowExecutionBatchResponse) Equals(rhs *StartWorkflowExecutionBatchResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Results == nil && rhs.Results == nil) || (v.Results != nil && rhs.Results != nil && _List_StartWorkflowExecutionBatchResult_Equals(v.Results, rhs.Results))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_StartWorkflowExecutionBatchResult_Zapper []*StartWorkflowExecutionBatchResult
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_StartWorkflowExecutionBatchResult_Zapper.
+func (l _List_StartWorkflowExecutionBatchResult_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StartWorkflowExecutionBatchResponse.
+func (v *StartWorkflowExecutionBatchResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Results != nil {
+		err = multierr.Append(err, enc.AddArray("results", (_List_StartWorkflowExecutionBatchResult_Zapper)(v.Results)))
+	}
+	return err
+}
+
+// GetResults returns the value of Results if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionBatchResponse) GetResults() (o []*StartWorkflowExecutionBatchResult) {
+	if v != nil && v.Results != nil {
+		return v.Results
+	}
+
+	return
+}
+
+// IsSetResults returns true if Results is not nil.
+func (v *StartWorkflowExecutionBatchResponse) IsSetResults() bool {
+	return v != nil && v.Results != nil
+}
+
+type StartWorkflowExecutionBatchResult struct {
+	RunId        *string `json:"runId,omitempty"`
+	ErrorType    *string `json:"errorType,omitempty"`
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+}
+
+// ToWire translates a StartWorkflowExecutionBatchResult struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StartWorkflowExecutionBatchResult) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 130, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Memo != nil {
-		w, err = v.Memo.ToWire()
+	if v.ErrorType != nil {
+		w, err = wire.NewValueString(*(v.ErrorType)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 140, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Header != nil {
-		w, err = v.Header.ToWire()
+	if v.ErrorMessage != nil {
+		w, err = wire.NewValueString(*(v.ErrorMessage)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 150, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a StartWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a StartWorkflowExecutionBatchResult struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a StartWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a StartWorkflowExecutionBatchResult struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -41546,12 +44574,12 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v StartWorkflowExecutionRequest
+//   var v StartWorkflowExecutionBatchResult
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *StartWorkflowExecutionBatchResult) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -41560,7 +44588,7 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.RunId = &x
 				if err != nil {
 					return err
 				}
@@ -41570,86 +44598,447 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+				v.ErrorType = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBinary {
-				v.Input, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ExecutionStartToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.TaskStartToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.ErrorMessage = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 90:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x WorkflowIdReusePolicy
-				x, err = _WorkflowIdReusePolicy_Read(field.Value)
-				v.WorkflowIdReusePolicy = &x
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
+// String returns a readable string representation of a StartWorkflowExecutionBatchResult
+// struct.
+func (v *StartWorkflowExecutionBatchResult) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.ErrorType != nil {
+		fields[i] = fmt.Sprintf("ErrorType: %v", *(v.ErrorType))
+		i++
+	}
+	if v.ErrorMessage != nil {
+		fields[i] = fmt.Sprintf("ErrorMessage: %v", *(v.ErrorMessage))
+		i++
+	}
+
+	return fmt.Sprintf("StartWorkflowExecutionBatchResult{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this StartWorkflowExecutionBatchResult match the
+// provided StartWorkflowExecutionBatchResult.
+//
+// This function performs a deep comparison.
+func (v *StartWorkflowExecutionBatchResult) Equals(rhs *StartWorkflowExecutionBatchResult) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ErrorType, rhs.ErrorType) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ErrorMessage, rhs.ErrorMessage) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StartWorkflowExecutionBatchResult.
+func (v *StartWorkflowExecutionBatchResult) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.ErrorType != nil {
+		enc.AddString("errorType", *v.ErrorType)
+	}
+	if v.ErrorMessage != nil {
+		enc.AddString("errorMessage", *v.ErrorMessage)
+	}
+	return err
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionBatchResult) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *StartWorkflowExecutionBatchResult) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetErrorType returns the value of ErrorType if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionBatchResult) GetErrorType() (o string) {
+	if v != nil && v.ErrorType != nil {
+		return *v.ErrorType
+	}
+
+	return
+}
+
+// IsSetErrorType returns true if ErrorType is not nil.
+func (v *StartWorkflowExecutionBatchResult) IsSetErrorType() bool {
+	return v != nil && v.ErrorType != nil
+}
+
+// GetErrorMessage returns the value of ErrorMessage if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionBatchResult) GetErrorMessage() (o string) {
+	if v != nil && v.ErrorMessage != nil {
+		return *v.ErrorMessage
+	}
+
+	return
+}
+
+// IsSetErrorMessage returns true if ErrorMessage is not nil.
+func (v *StartWorkflowExecutionBatchResult) IsSetErrorMessage() bool {
+	return v != nil && v.ErrorMessage != nil
+}
+
+type StartWorkflowExecutionRequest struct {
+	Domain                              *string                `json:"domain,omitempty"`
+	WorkflowId                          *string                `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
+	TaskList                            *TaskList              `json:"taskList,omitempty"`
+	Input                               []byte                 `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            *string                `json:"identity,omitempty"`
+	RequestId                           *string                `json:"requestId,omitempty"`
+	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
+	ChildPolicy                         *ChildPolicy           `json:"childPolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
+	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                  `json:"memo,omitempty"`
+	Header                              *Header                `json:"header,omitempty"`
+	RetentionPeriodInDays               *int32                 `json:"retentionPeriodInDays,omitempty"`
+	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	WorkflowExecutionTimeoutSeconds     *int32                 `json:"workflowExecutionTimeoutSeconds,omitempty"`
+}
+
+// ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [18]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ExecutionStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ExecutionStartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.TaskStartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.WorkflowIdReusePolicy != nil {
+		w, err = v.WorkflowIdReusePolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.ChildPolicy != nil {
+		w, err = v.ChildPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.CronSchedule != nil {
+		w, err = wire.NewValueString(*(v.CronSchedule)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.Memo != nil {
+		w, err = v.Memo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.Header != nil {
+		w, err = v.Header.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
+	if v.DelayStartSeconds != nil {
+		w, err = wire.NewValueI32(*(v.DelayStartSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a StartWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StartWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StartWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExecutionStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowIdReusePolicy
+				x, err = _WorkflowIdReusePolicy_Read(field.Value)
+				v.WorkflowIdReusePolicy = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		case 110:
 			if field.Value.Type() == wire.TI32 {
 				var x ChildPolicy
@@ -41693,6 +45082,36 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 160:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 170:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.DelayStartSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 180:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -41707,7 +45126,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [15]string
+	var fields [18]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -41769,6 +45188,18 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
+	if v.DelayStartSeconds != nil {
+		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
+		i++
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTimeoutSeconds: %v", *(v.WorkflowExecutionTimeoutSeconds))
+		i++
+	}
 
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -41828,6 +45259,15 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.WorkflowExecutionTimeoutSeconds, rhs.WorkflowExecutionTimeoutSeconds) {
+		return false
+	}
 
 	return true
 }
@@ -41883,6 +45323,15 @@ func (v *StartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncod
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
+	if v.DelayStartSeconds != nil {
+		enc.AddInt32("delayStartSeconds", *v.DelayStartSeconds)
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		enc.AddInt32("workflowExecutionTimeoutSeconds", *v.WorkflowExecutionTimeoutSeconds)
+	}
 	return err
 }
 
@@ -42111,6 +45560,51 @@ func (v *StartWorkflowExecutionRequest) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
+}
+
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
+// GetDelayStartSeconds returns the value of DelayStartSeconds if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetDelayStartSeconds() (o int32) {
+	if v != nil && v.DelayStartSeconds != nil {
+		return *v.DelayStartSeconds
+	}
+
+	return
+}
+
+// IsSetDelayStartSeconds returns true if DelayStartSeconds is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetDelayStartSeconds() bool {
+	return v != nil && v.DelayStartSeconds != nil
+}
+
+// GetWorkflowExecutionTimeoutSeconds returns the value of WorkflowExecutionTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetWorkflowExecutionTimeoutSeconds() (o int32) {
+	if v != nil && v.WorkflowExecutionTimeoutSeconds != nil {
+		return *v.WorkflowExecutionTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionTimeoutSeconds returns true if WorkflowExecutionTimeoutSeconds is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetWorkflowExecutionTimeoutSeconds() bool {
+	return v != nil && v.WorkflowExecutionTimeoutSeconds != nil
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId *string `json:"runId,omitempty"`
 }
@@ -42980,6 +46474,320 @@ func (v *TaskListKind) UnmarshalJSON(text []byte) error {
 	}
 }
 
+type TaskListLatencyStats struct {
+	SampleCount *int64 `json:"sampleCount,omitempty"`
+	P50InMillis *int64 `json:"p50InMillis,omitempty"`
+	P95InMillis *int64 `json:"p95InMillis,omitempty"`
+	P99InMillis *int64 `json:"p99InMillis,omitempty"`
+	MaxInMillis *int64 `json:"maxInMillis,omitempty"`
+}
+
+// ToWire translates a TaskListLatencyStats struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TaskListLatencyStats) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SampleCount != nil {
+		w, err = wire.NewValueI64(*(v.SampleCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.P50InMillis != nil {
+		w, err = wire.NewValueI64(*(v.P50InMillis)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.P95InMillis != nil {
+		w, err = wire.NewValueI64(*(v.P95InMillis)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.P99InMillis != nil {
+		w, err = wire.NewValueI64(*(v.P99InMillis)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MaxInMillis != nil {
+		w, err = wire.NewValueI64(*(v.MaxInMillis)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TaskListLatencyStats struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskListLatencyStats struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TaskListLatencyStats
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TaskListLatencyStats) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SampleCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.P50InMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.P95InMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.P99InMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxInMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskListLatencyStats
+// struct.
+func (v *TaskListLatencyStats) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.SampleCount != nil {
+		fields[i] = fmt.Sprintf("SampleCount: %v", *(v.SampleCount))
+		i++
+	}
+	if v.P50InMillis != nil {
+		fields[i] = fmt.Sprintf("P50InMillis: %v", *(v.P50InMillis))
+		i++
+	}
+	if v.P95InMillis != nil {
+		fields[i] = fmt.Sprintf("P95InMillis: %v", *(v.P95InMillis))
+		i++
+	}
+	if v.P99InMillis != nil {
+		fields[i] = fmt.Sprintf("P99InMillis: %v", *(v.P99InMillis))
+		i++
+	}
+	if v.MaxInMillis != nil {
+		fields[i] = fmt.Sprintf("MaxInMillis: %v", *(v.MaxInMillis))
+		i++
+	}
+
+	return fmt.Sprintf("TaskListLatencyStats{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TaskListLatencyStats match the
+// provided TaskListLatencyStats.
+//
+// This function performs a deep comparison.
+func (v *TaskListLatencyStats) Equals(rhs *TaskListLatencyStats) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.SampleCount, rhs.SampleCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.P50InMillis, rhs.P50InMillis) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.P95InMillis, rhs.P95InMillis) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.P99InMillis, rhs.P99InMillis) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaxInMillis, rhs.MaxInMillis) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskListLatencyStats.
+func (v *TaskListLatencyStats) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SampleCount != nil {
+		enc.AddInt64("sampleCount", *v.SampleCount)
+	}
+	if v.P50InMillis != nil {
+		enc.AddInt64("p50InMillis", *v.P50InMillis)
+	}
+	if v.P95InMillis != nil {
+		enc.AddInt64("p95InMillis", *v.P95InMillis)
+	}
+	if v.P99InMillis != nil {
+		enc.AddInt64("p99InMillis", *v.P99InMillis)
+	}
+	if v.MaxInMillis != nil {
+		enc.AddInt64("maxInMillis", *v.MaxInMillis)
+	}
+	return err
+}
+
+// GetSampleCount returns the value of SampleCount if it is set or its
+// zero value if it is unset.
+func (v *TaskListLatencyStats) GetSampleCount() (o int64) {
+	if v != nil && v.SampleCount != nil {
+		return *v.SampleCount
+	}
+
+	return
+}
+
+// IsSetSampleCount returns true if SampleCount is not nil.
+func (v *TaskListLatencyStats) IsSetSampleCount() bool {
+	return v != nil && v.SampleCount != nil
+}
+
+// GetP50InMillis returns the value of P50InMillis if it is set or its
+// zero value if it is unset.
+func (v *TaskListLatencyStats) GetP50InMillis() (o int64) {
+	if v != nil && v.P50InMillis != nil {
+		return *v.P50InMillis
+	}
+
+	return
+}
+
+// IsSetP50InMillis returns true if P50InMillis is not nil.
+func (v *TaskListLatencyStats) IsSetP50InMillis() bool {
+	return v != nil && v.P50InMillis != nil
+}
+
+// GetP95InMillis returns the value of P95InMillis if it is set or its
+// zero value if it is unset.
+func (v *TaskListLatencyStats) GetP95InMillis() (o int64) {
+	if v != nil && v.P95InMillis != nil {
+		return *v.P95InMillis
+	}
+
+	return
+}
+
+// IsSetP95InMillis returns true if P95InMillis is not nil.
+func (v *TaskListLatencyStats) IsSetP95InMillis() bool {
+	return v != nil && v.P95InMillis != nil
+}
+
+// GetP99InMillis returns the value of P99InMillis if it is set or its
+// zero value if it is unset.
+func (v *TaskListLatencyStats) GetP99InMillis() (o int64) {
+	if v != nil && v.P99InMillis != nil {
+		return *v.P99InMillis
+	}
+
+	return
+}
+
+// IsSetP99InMillis returns true if P99InMillis is not nil.
+func (v *TaskListLatencyStats) IsSetP99InMillis() bool {
+	return v != nil && v.P99InMillis != nil
+}
+
+// GetMaxInMillis returns the value of MaxInMillis if it is set or its
+// zero value if it is unset.
+func (v *TaskListLatencyStats) GetMaxInMillis() (o int64) {
+	if v != nil && v.MaxInMillis != nil {
+		return *v.MaxInMillis
+	}
+
+	return
+}
+
+// IsSetMaxInMillis returns true if MaxInMillis is not nil.
+func (v *TaskListLatencyStats) IsSetMaxInMillis() bool {
+	return v != nil && v.MaxInMillis != nil
+}
+
 type TaskListMetadata struct {
 	MaxTasksPerSecond *float64 `json:"maxTasksPerSecond,omitempty"`
 }
@@ -43119,11 +46927,15 @@ func (v *TaskListMetadata) IsSetMaxTasksPerSecond() bool {
 }
 
 type TaskListStatus struct {
-	BacklogCountHint *int64       `json:"backlogCountHint,omitempty"`
-	ReadLevel        *int64       `json:"readLevel,omitempty"`
-	AckLevel         *int64       `json:"ackLevel,omitempty"`
-	RatePerSecond    *float64     `json:"ratePerSecond,omitempty"`
-	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogCountHint             *int64                `json:"backlogCountHint,omitempty"`
+	ReadLevel                    *int64                `json:"readLevel,omitempty"`
+	AckLevel                     *int64                `json:"ackLevel,omitempty"`
+	RatePerSecond                *float64              `json:"ratePerSecond,omitempty"`
+	TaskIDBlock                  *TaskIDBlock          `json:"taskIDBlock,omitempty"`
+	OldestUnackedTaskAgeInMillis *int64                `json:"oldestUnackedTaskAgeInMillis,omitempty"`
+	ScheduleToStartLatency       *TaskListLatencyStats `json:"scheduleToStartLatency,omitempty"`
+	SyncMatchCount               *int64                `json:"syncMatchCount,omitempty"`
+	BacklogMatchCount            *int64                `json:"backlogMatchCount,omitempty"`
 }
 
 // ToWire translates a TaskListStatus struct into a Thrift-level intermediate
@@ -43143,7 +46955,7 @@ type TaskListStatus struct {
 //   }
 func (v *TaskListStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -43189,6 +47001,38 @@ func (v *TaskListStatus) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.OldestUnackedTaskAgeInMillis != nil {
+		w, err = wire.NewValueI64(*(v.OldestUnackedTaskAgeInMillis)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ScheduleToStartLatency != nil {
+		w, err = v.ScheduleToStartLatency.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.SyncMatchCount != nil {
+		w, err = wire.NewValueI64(*(v.SyncMatchCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.BacklogMatchCount != nil {
+		w, err = wire.NewValueI64(*(v.BacklogMatchCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -43199,6 +47043,12 @@ func _TaskIDBlock_Read(w wire.Value) (*TaskIDBlock, error) {
 	return &v, err
 }
 
+func _TaskListLatencyStats_Read(w wire.Value) (*TaskListLatencyStats, error) {
+	var v TaskListLatencyStats
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a TaskListStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -43268,6 +47118,44 @@ func (v *TaskListStatus) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.OldestUnackedTaskAgeInMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.ScheduleToStartLatency, err = _TaskListLatencyStats_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SyncMatchCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.BacklogMatchCount = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -43282,7 +47170,7 @@ func (v *TaskListStatus) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [9]string
 	i := 0
 	if v.BacklogCountHint != nil {
 		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
@@ -43304,6 +47192,22 @@ func (v *TaskListStatus) String() string {
 		fields[i] = fmt.Sprintf("TaskIDBlock: %v", v.TaskIDBlock)
 		i++
 	}
+	if v.OldestUnackedTaskAgeInMillis != nil {
+		fields[i] = fmt.Sprintf("OldestUnackedTaskAgeInMillis: %v", *(v.OldestUnackedTaskAgeInMillis))
+		i++
+	}
+	if v.ScheduleToStartLatency != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartLatency: %v", v.ScheduleToStartLatency)
+		i++
+	}
+	if v.SyncMatchCount != nil {
+		fields[i] = fmt.Sprintf("SyncMatchCount: %v", *(v.SyncMatchCount))
+		i++
+	}
+	if v.BacklogMatchCount != nil {
+		fields[i] = fmt.Sprintf("BacklogMatchCount: %v", *(v.BacklogMatchCount))
+		i++
+	}
 
 	return fmt.Sprintf("TaskListStatus{%v}", strings.Join(fields[:i], ", "))
 }
@@ -43333,6 +47237,18 @@ func (v *TaskListStatus) Equals(rhs *TaskListStatus) bool {
 	if !((v.TaskIDBlock == nil && rhs.TaskIDBlock == nil) || (v.TaskIDBlock != nil && rhs.TaskIDBlock != nil && v.TaskIDBlock.Equals(rhs.TaskIDBlock))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.OldestUnackedTaskAgeInMillis, rhs.OldestUnackedTaskAgeInMillis) {
+		return false
+	}
+	if !((v.ScheduleToStartLatency == nil && rhs.ScheduleToStartLatency == nil) || (v.ScheduleToStartLatency != nil && rhs.ScheduleToStartLatency != nil && v.ScheduleToStartLatency.Equals(rhs.ScheduleToStartLatency))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.SyncMatchCount, rhs.SyncMatchCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.BacklogMatchCount, rhs.BacklogMatchCount) {
+		return false
+	}
 
 	return true
 }
@@ -43358,6 +47274,18 @@ func (v *TaskListStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error)
 	if v.TaskIDBlock != nil {
 		err = multierr.Append(err, enc.AddObject("taskIDBlock", v.TaskIDBlock))
 	}
+	if v.OldestUnackedTaskAgeInMillis != nil {
+		enc.AddInt64("oldestUnackedTaskAgeInMillis", *v.OldestUnackedTaskAgeInMillis)
+	}
+	if v.ScheduleToStartLatency != nil {
+		err = multierr.Append(err, enc.AddObject("scheduleToStartLatency", v.ScheduleToStartLatency))
+	}
+	if v.SyncMatchCount != nil {
+		enc.AddInt64("syncMatchCount", *v.SyncMatchCount)
+	}
+	if v.BacklogMatchCount != nil {
+		enc.AddInt64("backlogMatchCount", *v.BacklogMatchCount)
+	}
 	return err
 }
 
@@ -43436,6 +47364,66 @@ func (v *TaskListStatus) IsSetTaskIDBlock() bool {
 	return v != nil && v.TaskIDBlock != nil
 }
 
+// GetOldestUnackedTaskAgeInMillis returns the value of OldestUnackedTaskAgeInMillis if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetOldestUnackedTaskAgeInMillis() (o int64) {
+	if v != nil && v.OldestUnackedTaskAgeInMillis != nil {
+		return *v.OldestUnackedTaskAgeInMillis
+	}
+
+	return
+}
+
+// IsSetOldestUnackedTaskAgeInMillis returns true if OldestUnackedTaskAgeInMillis is not nil.
+func (v *TaskListStatus) IsSetOldestUnackedTaskAgeInMillis() bool {
+	return v != nil && v.OldestUnackedTaskAgeInMillis != nil
+}
+
+// GetScheduleToStartLatency returns the value of ScheduleToStartLatency if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetScheduleToStartLatency() (o *TaskListLatencyStats) {
+	if v != nil && v.ScheduleToStartLatency != nil {
+		return v.ScheduleToStartLatency
+	}
+
+	return
+}
+
+// IsSetScheduleToStartLatency returns true if ScheduleToStartLatency is not nil.
+func (v *TaskListStatus) IsSetScheduleToStartLatency() bool {
+	return v != nil && v.ScheduleToStartLatency != nil
+}
+
+// GetSyncMatchCount returns the value of SyncMatchCount if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetSyncMatchCount() (o int64) {
+	if v != nil && v.SyncMatchCount != nil {
+		return *v.SyncMatchCount
+	}
+
+	return
+}
+
+// IsSetSyncMatchCount returns true if SyncMatchCount is not nil.
+func (v *TaskListStatus) IsSetSyncMatchCount() bool {
+	return v != nil && v.SyncMatchCount != nil
+}
+
+// GetBacklogMatchCount returns the value of BacklogMatchCount if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetBacklogMatchCount() (o int64) {
+	if v != nil && v.BacklogMatchCount != nil {
+		return *v.BacklogMatchCount
+	}
+
+	return
+}
+
+// IsSetBacklogMatchCount returns true if BacklogMatchCount is not nil.
+func (v *TaskListStatus) IsSetBacklogMatchCount() bool {
+	return v != nil && v.BacklogMatchCount != nil
+}
+
 type TaskListType int32
 
 const (
@@ -45860,6 +49848,584 @@ func (v *UpdateDomainResponse) IsSetIsGlobalDomain() bool {
 	return v != nil && v.IsGlobalDomain != nil
 }
 
+type UpdateWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	UpdateName        *string            `json:"updateName,omitempty"`
+	Input             []byte             `json:"input,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+	RequestId         *string            `json:"requestId,omitempty"`
+	TimeoutSeconds    *int32             `json:"timeoutSeconds,omitempty"`
+}
+
+// ToWire translates a UpdateWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UpdateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.UpdateName != nil {
+		w, err = wire.NewValueString(*(v.UpdateName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.TimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.TimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UpdateWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UpdateWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UpdateWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UpdateWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UpdateWorkflowExecutionRequest
+// struct.
+func (v *UpdateWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.UpdateName != nil {
+		fields[i] = fmt.Sprintf("UpdateName: %v", *(v.UpdateName))
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+	if v.TimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("TimeoutSeconds: %v", *(v.TimeoutSeconds))
+		i++
+	}
+
+	return fmt.Sprintf("UpdateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UpdateWorkflowExecutionRequest match the
+// provided UpdateWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *UpdateWorkflowExecutionRequest) Equals(rhs *UpdateWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.UpdateName, rhs.UpdateName) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.TimeoutSeconds, rhs.TimeoutSeconds) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UpdateWorkflowExecutionRequest.
+func (v *UpdateWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.UpdateName != nil {
+		enc.AddString("updateName", *v.UpdateName)
+	}
+	if v.Input != nil {
+		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
+	if v.TimeoutSeconds != nil {
+		enc.AddInt32("timeoutSeconds", *v.TimeoutSeconds)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetUpdateName returns the value of UpdateName if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetUpdateName() (o string) {
+	if v != nil && v.UpdateName != nil {
+		return *v.UpdateName
+	}
+
+	return
+}
+
+// IsSetUpdateName returns true if UpdateName is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetUpdateName() bool {
+	return v != nil && v.UpdateName != nil
+}
+
+// GetInput returns the value of Input if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+
+	return
+}
+
+// IsSetInput returns true if Input is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetInput() bool {
+	return v != nil && v.Input != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
+// GetTimeoutSeconds returns the value of TimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionRequest) GetTimeoutSeconds() (o int32) {
+	if v != nil && v.TimeoutSeconds != nil {
+		return *v.TimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetTimeoutSeconds returns true if TimeoutSeconds is not nil.
+func (v *UpdateWorkflowExecutionRequest) IsSetTimeoutSeconds() bool {
+	return v != nil && v.TimeoutSeconds != nil
+}
+
+type UpdateWorkflowExecutionResponse struct {
+	RunId        *string               `json:"runId,omitempty"`
+	UpdateResult *WorkflowUpdateResult `json:"updateResult,omitempty"`
+}
+
+// ToWire translates a UpdateWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UpdateWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.UpdateResult != nil {
+		w, err = v.UpdateResult.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UpdateWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UpdateWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UpdateWorkflowExecutionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UpdateWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.UpdateResult, err = _WorkflowUpdateResult_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UpdateWorkflowExecutionResponse
+// struct.
+func (v *UpdateWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.UpdateResult != nil {
+		fields[i] = fmt.Sprintf("UpdateResult: %v", v.UpdateResult)
+		i++
+	}
+
+	return fmt.Sprintf("UpdateWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UpdateWorkflowExecutionResponse match the
+// provided UpdateWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *UpdateWorkflowExecutionResponse) Equals(rhs *UpdateWorkflowExecutionResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !((v.UpdateResult == nil && rhs.UpdateResult == nil) || (v.UpdateResult != nil && rhs.UpdateResult != nil && v.UpdateResult.Equals(rhs.UpdateResult))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UpdateWorkflowExecutionResponse.
+func (v *UpdateWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.UpdateResult != nil {
+		err = multierr.Append(err, enc.AddObject("updateResult", v.UpdateResult))
+	}
+	return err
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionResponse) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *UpdateWorkflowExecutionResponse) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetUpdateResult returns the value of UpdateResult if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkflowExecutionResponse) GetUpdateResult() (o *WorkflowUpdateResult) {
+	if v != nil && v.UpdateResult != nil {
+		return v.UpdateResult
+	}
+
+	return
+}
+
+// IsSetUpdateResult returns true if UpdateResult is not nil.
+func (v *UpdateWorkflowExecutionResponse) IsSetUpdateResult() bool {
+	return v != nil && v.UpdateResult != nil
+}
+
 type WorkflowExecution struct {
 	WorkflowId *string `json:"workflowId,omitempty"`
 	RunId      *string `json:"runId,omitempty"`
@@ -46944,8 +51510,9 @@ func (v *WorkflowExecutionCloseStatus) UnmarshalJSON(text []byte) error {
 }
 
 type WorkflowExecutionCompletedEventAttributes struct {
-	Result                       []byte `json:"result,omitempty"`
-	DecisionTaskCompletedEventId *int64 `json:"decisionTaskCompletedEventId,omitempty"`
+	Result                       []byte            `json:"result,omitempty"`
+	DecisionTaskCompletedEventId *int64            `json:"decisionTaskCompletedEventId,omitempty"`
+	ResultReference              *PayloadReference `json:"resultReference,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionCompletedEventAttributes struct into a Thrift-level intermediate
@@ -46965,7 +51532,7 @@ type WorkflowExecutionCompletedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionCompletedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -46987,6 +51554,14 @@ func (v *WorkflowExecutionCompletedEventAttributes) ToWire() (wire.Value, error)
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ResultReference != nil {
+		w, err = v.ResultReference.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -47030,6 +51605,14 @@ func (v *WorkflowExecutionCompletedEventAttributes) FromWire(w wire.Value) error
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ResultReference, err = _PayloadReference_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -47044,7 +51627,7 @@ func (v *WorkflowExecutionCompletedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Result != nil {
 		fields[i] = fmt.Sprintf("Result: %v", v.Result)
@@ -47054,6 +51637,10 @@ func (v *WorkflowExecutionCompletedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
+	if v.ResultReference != nil {
+		fields[i] = fmt.Sprintf("ResultReference: %v", v.ResultReference)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionCompletedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -47074,6 +51661,9 @@ func (v *WorkflowExecutionCompletedEventAttributes) Equals(rhs *WorkflowExecutio
 	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
+	if !((v.ResultReference == nil && rhs.ResultReference == nil) || (v.ResultReference != nil && rhs.ResultReference != nil && v.ResultReference.Equals(rhs.ResultReference))) {
+		return false
+	}
 
 	return true
 }
@@ -47090,6 +51680,9 @@ func (v *WorkflowExecutionCompletedEventAttributes) MarshalLogObject(enc zapcore
 	if v.DecisionTaskCompletedEventId != nil {
 		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
+	if v.ResultReference != nil {
+		err = multierr.Append(err, enc.AddObject("resultReference", v.ResultReference))
+	}
 	return err
 }
 
@@ -47123,11 +51716,28 @@ func (v *WorkflowExecutionCompletedEventAttributes) IsSetDecisionTaskCompletedEv
 	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
+// GetResultReference returns the value of ResultReference if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionCompletedEventAttributes) GetResultReference() (o *PayloadReference) {
+	if v != nil && v.ResultReference != nil {
+		return v.ResultReference
+	}
+
+	return
+}
+
+// IsSetResultReference returns true if ResultReference is not nil.
+func (v *WorkflowExecutionCompletedEventAttributes) IsSetResultReference() bool {
+	return v != nil && v.ResultReference != nil
+}
+
 type WorkflowExecutionConfiguration struct {
 	TaskList                            *TaskList    `json:"taskList,omitempty"`
 	ExecutionStartToCloseTimeoutSeconds *int32       `json:"executionStartToCloseTimeoutSeconds,omitempty"`
 	TaskStartToCloseTimeoutSeconds      *int32       `json:"taskStartToCloseTimeoutSeconds,omitempty"`
 	ChildPolicy                         *ChildPolicy `json:"childPolicy,omitempty"`
+	RetentionPeriodInDays               *int32       `json:"retentionPeriodInDays,omitempty"`
+	WorkflowExecutionTimeoutSeconds     *int32       `json:"workflowExecutionTimeoutSeconds,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionConfiguration struct into a Thrift-level intermediate
@@ -47147,7 +51757,7 @@ type WorkflowExecutionConfiguration struct {
 //   }
 func (v *WorkflowExecutionConfiguration) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -47185,6 +51795,22 @@ func (v *WorkflowExecutionConfiguration) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -47248,6 +51874,26 @@ func (v *WorkflowExecutionConfiguration) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -47262,7 +51908,7 @@ func (v *WorkflowExecutionConfiguration) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
 	if v.TaskList != nil {
 		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
@@ -47280,6 +51926,14 @@ func (v *WorkflowExecutionConfiguration) String() string {
 		fields[i] = fmt.Sprintf("ChildPolicy: %v", *(v.ChildPolicy))
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTimeoutSeconds: %v", *(v.WorkflowExecutionTimeoutSeconds))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
@@ -47306,6 +51960,12 @@ func (v *WorkflowExecutionConfiguration) Equals(rhs *WorkflowExecutionConfigurat
 	if !_ChildPolicy_EqualsPtr(v.ChildPolicy, rhs.ChildPolicy) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.WorkflowExecutionTimeoutSeconds, rhs.WorkflowExecutionTimeoutSeconds) {
+		return false
+	}
 
 	return true
 }
@@ -47328,6 +51988,12 @@ func (v *WorkflowExecutionConfiguration) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.ChildPolicy != nil {
 		err = multierr.Append(err, enc.AddObject("childPolicy", *v.ChildPolicy))
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		enc.AddInt32("workflowExecutionTimeoutSeconds", *v.WorkflowExecutionTimeoutSeconds)
+	}
 	return err
 }
 
@@ -47391,6 +52057,36 @@ func (v *WorkflowExecutionConfiguration) IsSetChildPolicy() bool {
 	return v != nil && v.ChildPolicy != nil
 }
 
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionConfiguration) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
+}
+
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *WorkflowExecutionConfiguration) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
+// GetWorkflowExecutionTimeoutSeconds returns the value of WorkflowExecutionTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionConfiguration) GetWorkflowExecutionTimeoutSeconds() (o int32) {
+	if v != nil && v.WorkflowExecutionTimeoutSeconds != nil {
+		return *v.WorkflowExecutionTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionTimeoutSeconds returns true if WorkflowExecutionTimeoutSeconds is not nil.
+func (v *WorkflowExecutionConfiguration) IsSetWorkflowExecutionTimeoutSeconds() bool {
+	return v != nil && v.WorkflowExecutionTimeoutSeconds != nil
+}
+
 type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	NewExecutionRunId                   *string                 `json:"newExecutionRunId,omitempty"`
 	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
@@ -48408,17 +53104,18 @@ func (v *WorkflowExecutionFilter) IsSetWorkflowId() bool {
 }
 
 type WorkflowExecutionInfo struct {
-	Execution       *WorkflowExecution            `json:"execution,omitempty"`
-	Type            *WorkflowType                 `json:"type,omitempty"`
-	StartTime       *int64                        `json:"startTime,omitempty"`
-	CloseTime       *int64                        `json:"closeTime,omitempty"`
-	CloseStatus     *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
-	HistoryLength   *int64                        `json:"historyLength,omitempty"`
-	ParentDomainId  *string                       `json:"parentDomainId,omitempty"`
-	ParentExecution *WorkflowExecution            `json:"parentExecution,omitempty"`
-	ExecutionTime   *int64                        `json:"executionTime,omitempty"`
-	Memo            *Memo                         `json:"memo,omitempty"`
-	AutoResetPoints *ResetPoints                  `json:"autoResetPoints,omitempty"`
+	Execution                       *WorkflowExecution            `json:"execution,omitempty"`
+	Type                            *WorkflowType                 `json:"type,omitempty"`
+	StartTime                       *int64                        `json:"startTime,omitempty"`
+	CloseTime                       *int64                        `json:"closeTime,omitempty"`
+	CloseStatus                     *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	HistoryLength                   *int64                        `json:"historyLength,omitempty"`
+	ParentDomainId                  *string                       `json:"parentDomainId,omitempty"`
+	ParentExecution                 *WorkflowExecution            `json:"parentExecution,omitempty"`
+	ExecutionTime                   *int64                        `json:"executionTime,omitempty"`
+	Memo                            *Memo                         `json:"memo,omitempty"`
+	AutoResetPoints                 *ResetPoints                  `json:"autoResetPoints,omitempty"`
+	WorkflowExecutionExpirationTime *int64                        `json:"workflowExecutionExpirationTime,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
@@ -48438,7 +53135,7 @@ type WorkflowExecutionInfo struct {
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -48532,6 +53229,14 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
+	if v.WorkflowExecutionExpirationTime != nil {
+		w, err = wire.NewValueI64(*(v.WorkflowExecutionExpirationTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -48663,6 +53368,16 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.WorkflowExecutionExpirationTime = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -48677,7 +53392,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [12]string
 	i := 0
 	if v.Execution != nil {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
@@ -48723,6 +53438,10 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("AutoResetPoints: %v", v.AutoResetPoints)
 		i++
 	}
+	if v.WorkflowExecutionExpirationTime != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionExpirationTime: %v", *(v.WorkflowExecutionExpirationTime))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -48770,6 +53489,9 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !((v.AutoResetPoints == nil && rhs.AutoResetPoints == nil) || (v.AutoResetPoints != nil && rhs.AutoResetPoints != nil && v.AutoResetPoints.Equals(rhs.AutoResetPoints))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.WorkflowExecutionExpirationTime, rhs.WorkflowExecutionExpirationTime) {
+		return false
+	}
 
 	return true
 }
@@ -48813,6 +53535,9 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.AutoResetPoints != nil {
 		err = multierr.Append(err, enc.AddObject("autoResetPoints", v.AutoResetPoints))
 	}
+	if v.WorkflowExecutionExpirationTime != nil {
+		enc.AddInt64("workflowExecutionExpirationTime", *v.WorkflowExecutionExpirationTime)
+	}
 	return err
 }
 
@@ -48981,10 +53706,27 @@ func (v *WorkflowExecutionInfo) IsSetAutoResetPoints() bool {
 	return v != nil && v.AutoResetPoints != nil
 }
 
+// GetWorkflowExecutionExpirationTime returns the value of WorkflowExecutionExpirationTime if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetWorkflowExecutionExpirationTime() (o int64) {
+	if v != nil && v.WorkflowExecutionExpirationTime != nil {
+		return *v.WorkflowExecutionExpirationTime
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionExpirationTime returns true if WorkflowExecutionExpirationTime is not nil.
+func (v *WorkflowExecutionInfo) IsSetWorkflowExecutionExpirationTime() bool {
+	return v != nil && v.WorkflowExecutionExpirationTime != nil
+}
+
 type WorkflowExecutionSignaledEventAttributes struct {
-	SignalName *string `json:"signalName,omitempty"`
-	Input      []byte  `json:"input,omitempty"`
-	Identity   *string `json:"identity,omitempty"`
+	SignalName     *string           `json:"signalName,omitempty"`
+	Input          []byte            `json:"input,omitempty"`
+	Identity       *string           `json:"identity,omitempty"`
+	InputReference *PayloadReference `json:"inputReference,omitempty"`
+	RequestId      *string           `json:"requestId,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionSignaledEventAttributes struct into a Thrift-level intermediate
@@ -49004,7 +53746,7 @@ type WorkflowExecutionSignaledEventAttributes struct {
 //   }
 func (v *WorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -49034,6 +53776,22 @@ func (v *WorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value, error)
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.InputReference != nil {
+		w, err = v.InputReference.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -49087,6 +53845,24 @@ func (v *WorkflowExecutionSignaledEventAttributes) FromWire(w wire.Value) error
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.InputReference, err = _PayloadReference_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -49101,7 +53877,7 @@ func (v *WorkflowExecutionSignaledEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
 	if v.SignalName != nil {
 		fields[i] = fmt.Sprintf("SignalName: %v", *(v.SignalName))
@@ -49115,6 +53891,14 @@ func (v *WorkflowExecutionSignaledEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.InputReference != nil {
+		fields[i] = fmt.Sprintf("InputReference: %v", v.InputReference)
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionSignaledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -49138,6 +53922,12 @@ func (v *WorkflowExecutionSignaledEventAttributes) Equals(rhs *WorkflowExecution
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !((v.InputReference == nil && rhs.InputReference == nil) || (v.InputReference != nil && rhs.InputReference != nil && v.InputReference.Equals(rhs.InputReference))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
 
 	return true
 }
@@ -49157,6 +53947,12 @@ func (v *WorkflowExecutionSignaledEventAttributes) MarshalLogObject(enc zapcore.
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.InputReference != nil {
+		err = multierr.Append(err, enc.AddObject("inputReference", v.InputReference))
+	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
 	return err
 }
 
@@ -49205,30 +54001,64 @@ func (v *WorkflowExecutionSignaledEventAttributes) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
+// GetInputReference returns the value of InputReference if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionSignaledEventAttributes) GetInputReference() (o *PayloadReference) {
+	if v != nil && v.InputReference != nil {
+		return v.InputReference
+	}
+
+	return
+}
+
+// IsSetInputReference returns true if InputReference is not nil.
+func (v *WorkflowExecutionSignaledEventAttributes) IsSetInputReference() bool {
+	return v != nil && v.InputReference != nil
+}
+
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionSignaledEventAttributes) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *WorkflowExecutionSignaledEventAttributes) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
 type WorkflowExecutionStartedEventAttributes struct {
-	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
-	ParentWorkflowDomain                *string                 `json:"parentWorkflowDomain,omitempty"`
-	ParentWorkflowExecution             *WorkflowExecution      `json:"parentWorkflowExecution,omitempty"`
-	ParentInitiatedEventId              *int64                  `json:"parentInitiatedEventId,omitempty"`
-	TaskList                            *TaskList               `json:"taskList,omitempty"`
-	Input                               []byte                  `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	ChildPolicy                         *ChildPolicy            `json:"childPolicy,omitempty"`
-	ContinuedExecutionRunId             *string                 `json:"continuedExecutionRunId,omitempty"`
-	Initiator                           *ContinueAsNewInitiator `json:"initiator,omitempty"`
-	ContinuedFailureReason              *string                 `json:"continuedFailureReason,omitempty"`
-	ContinuedFailureDetails             []byte                  `json:"continuedFailureDetails,omitempty"`
-	LastCompletionResult                []byte                  `json:"lastCompletionResult,omitempty"`
-	Identity                            *string                 `json:"identity,omitempty"`
-	RetryPolicy                         *RetryPolicy            `json:"retryPolicy,omitempty"`
-	Attempt                             *int32                  `json:"attempt,omitempty"`
-	ExpirationTimestamp                 *int64                  `json:"expirationTimestamp,omitempty"`
-	CronSchedule                        *string                 `json:"cronSchedule,omitempty"`
-	FirstDecisionTaskBackoffSeconds     *int32                  `json:"firstDecisionTaskBackoffSeconds,omitempty"`
-	Memo                                *Memo                   `json:"memo,omitempty"`
-	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
-	Header                              *Header                 `json:"header,omitempty"`
+	WorkflowType                         *WorkflowType           `json:"workflowType,omitempty"`
+	ParentWorkflowDomain                 *string                 `json:"parentWorkflowDomain,omitempty"`
+	ParentWorkflowExecution              *WorkflowExecution      `json:"parentWorkflowExecution,omitempty"`
+	ParentInitiatedEventId               *int64                  `json:"parentInitiatedEventId,omitempty"`
+	TaskList                             *TaskList               `json:"taskList,omitempty"`
+	Input                                []byte                  `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds  *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds       *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	ChildPolicy                          *ChildPolicy            `json:"childPolicy,omitempty"`
+	ContinuedExecutionRunId              *string                 `json:"continuedExecutionRunId,omitempty"`
+	Initiator                            *ContinueAsNewInitiator `json:"initiator,omitempty"`
+	ContinuedFailureReason               *string                 `json:"continuedFailureReason,omitempty"`
+	ContinuedFailureDetails              []byte                  `json:"continuedFailureDetails,omitempty"`
+	LastCompletionResult                 []byte                  `json:"lastCompletionResult,omitempty"`
+	Identity                             *string                 `json:"identity,omitempty"`
+	RetryPolicy                          *RetryPolicy            `json:"retryPolicy,omitempty"`
+	Attempt                              *int32                  `json:"attempt,omitempty"`
+	ExpirationTimestamp                  *int64                  `json:"expirationTimestamp,omitempty"`
+	CronSchedule                         *string                 `json:"cronSchedule,omitempty"`
+	FirstDecisionTaskBackoffSeconds      *int32                  `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	Memo                                 *Memo                   `json:"memo,omitempty"`
+	PrevAutoResetPoints                  *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
+	Header                               *Header                 `json:"header,omitempty"`
+	RetentionPeriodInDays                *int32                  `json:"retentionPeriodInDays,omitempty"`
+	InputReference                       *PayloadReference       `json:"inputReference,omitempty"`
+	WorkflowExecutionTimeoutSeconds      *int32                  `json:"workflowExecutionTimeoutSeconds,omitempty"`
+	WorkflowExecutionExpirationTimestamp *int64                  `json:"workflowExecutionExpirationTimestamp,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionStartedEventAttributes struct into a Thrift-level intermediate
@@ -49248,7 +54078,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [23]wire.Field
+		fields [27]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -49438,6 +54268,38 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 140, Value: w}
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.InputReference != nil {
+		w, err = v.InputReference.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.WorkflowExecutionExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -49673,6 +54535,44 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 150:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 160:
+			if field.Value.Type() == wire.TStruct {
+				v.InputReference, err = _PayloadReference_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 170:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 180:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.WorkflowExecutionExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -49687,7 +54587,7 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [23]string
+	var fields [27]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -49781,6 +54681,22 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.RetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("RetentionPeriodInDays: %v", *(v.RetentionPeriodInDays))
+		i++
+	}
+	if v.InputReference != nil {
+		fields[i] = fmt.Sprintf("InputReference: %v", v.InputReference)
+		i++
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTimeoutSeconds: %v", *(v.WorkflowExecutionTimeoutSeconds))
+		i++
+	}
+	if v.WorkflowExecutionExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionExpirationTimestamp: %v", *(v.WorkflowExecutionExpirationTimestamp))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -49864,6 +54780,18 @@ func (v *WorkflowExecutionStartedEventAttributes) Equals(rhs *WorkflowExecutionS
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionPeriodInDays, rhs.RetentionPeriodInDays) {
+		return false
+	}
+	if !((v.InputReference == nil && rhs.InputReference == nil) || (v.InputReference != nil && rhs.InputReference != nil && v.InputReference.Equals(rhs.InputReference))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.WorkflowExecutionTimeoutSeconds, rhs.WorkflowExecutionTimeoutSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.WorkflowExecutionExpirationTimestamp, rhs.WorkflowExecutionExpirationTimestamp) {
+		return false
+	}
 
 	return true
 }
@@ -49943,6 +54871,18 @@ func (v *WorkflowExecutionStartedEventAttributes) MarshalLogObject(enc zapcore.O
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.RetentionPeriodInDays != nil {
+		enc.AddInt32("retentionPeriodInDays", *v.RetentionPeriodInDays)
+	}
+	if v.InputReference != nil {
+		err = multierr.Append(err, enc.AddObject("inputReference", v.InputReference))
+	}
+	if v.WorkflowExecutionTimeoutSeconds != nil {
+		enc.AddInt32("workflowExecutionTimeoutSeconds", *v.WorkflowExecutionTimeoutSeconds)
+	}
+	if v.WorkflowExecutionExpirationTimestamp != nil {
+		enc.AddInt64("workflowExecutionExpirationTimestamp", *v.WorkflowExecutionExpirationTimestamp)
+	}
 	return err
 }
 
@@ -50291,6 +55231,66 @@ func (v *WorkflowExecutionStartedEventAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetRetentionPeriodInDays returns the value of RetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetRetentionPeriodInDays() (o int32) {
+	if v != nil && v.RetentionPeriodInDays != nil {
+		return *v.RetentionPeriodInDays
+	}
+
+	return
+}
+
+// IsSetRetentionPeriodInDays returns true if RetentionPeriodInDays is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetRetentionPeriodInDays() bool {
+	return v != nil && v.RetentionPeriodInDays != nil
+}
+
+// GetInputReference returns the value of InputReference if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetInputReference() (o *PayloadReference) {
+	if v != nil && v.InputReference != nil {
+		return v.InputReference
+	}
+
+	return
+}
+
+// IsSetInputReference returns true if InputReference is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetInputReference() bool {
+	return v != nil && v.InputReference != nil
+}
+
+// GetWorkflowExecutionTimeoutSeconds returns the value of WorkflowExecutionTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetWorkflowExecutionTimeoutSeconds() (o int32) {
+	if v != nil && v.WorkflowExecutionTimeoutSeconds != nil {
+		return *v.WorkflowExecutionTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionTimeoutSeconds returns true if WorkflowExecutionTimeoutSeconds is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetWorkflowExecutionTimeoutSeconds() bool {
+	return v != nil && v.WorkflowExecutionTimeoutSeconds != nil
+}
+
+// GetWorkflowExecutionExpirationTimestamp returns the value of WorkflowExecutionExpirationTimestamp if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetWorkflowExecutionExpirationTimestamp() (o int64) {
+	if v != nil && v.WorkflowExecutionExpirationTimestamp != nil {
+		return *v.WorkflowExecutionExpirationTimestamp
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionExpirationTimestamp returns true if WorkflowExecutionExpirationTimestamp is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetWorkflowExecutionExpirationTimestamp() bool {
+	return v != nil && v.WorkflowExecutionExpirationTimestamp != nil
+}
+
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   *string `json:"reason,omitempty"`
 	Details  []byte  `json:"details,omitempty"`
@@ -50653,12 +55653,367 @@ func (v *WorkflowExecutionTimedOutEventAttributes) IsSetTimeoutType() bool {
 	return v != nil && v.TimeoutType != nil
 }
 
+type WorkflowExecutionUpdateAcceptedEventAttributes struct {
+	UpdateId                     *string `json:"updateId,omitempty"`
+	UpdateName                   *string `json:"updateName,omitempty"`
+	Input                        []byte  `json:"input,omitempty"`
+	Result                       []byte  `json:"result,omitempty"`
+	Identity                     *string `json:"identity,omitempty"`
+	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionUpdateAcceptedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.UpdateId != nil {
+		w, err = wire.NewValueString(*(v.UpdateId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.UpdateName != nil {
+		w, err = wire.NewValueString(*(v.UpdateName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Result != nil {
+		w, err = wire.NewValueBinary(v.Result), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionUpdateAcceptedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionUpdateAcceptedEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionUpdateAcceptedEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Result, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionUpdateAcceptedEventAttributes
+// struct.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.UpdateId != nil {
+		fields[i] = fmt.Sprintf("UpdateId: %v", *(v.UpdateId))
+		i++
+	}
+	if v.UpdateName != nil {
+		fields[i] = fmt.Sprintf("UpdateName: %v", *(v.UpdateName))
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", v.Result)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionUpdateAcceptedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionUpdateAcceptedEventAttributes match the
+// provided WorkflowExecutionUpdateAcceptedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) Equals(rhs *WorkflowExecutionUpdateAcceptedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.UpdateId, rhs.UpdateId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.UpdateName, rhs.UpdateName) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionUpdateAcceptedEventAttributes.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.UpdateId != nil {
+		enc.AddString("updateId", *v.UpdateId)
+	}
+	if v.UpdateName != nil {
+		enc.AddString("updateName", *v.UpdateName)
+	}
+	if v.Input != nil {
+		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
+	}
+	if v.Result != nil {
+		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	}
+	return err
+}
+
+// GetUpdateId returns the value of UpdateId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdateId() (o string) {
+	if v != nil && v.UpdateId != nil {
+		return *v.UpdateId
+	}
+
+	return
+}
+
+// IsSetUpdateId returns true if UpdateId is not nil.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) IsSetUpdateId() bool {
+	return v != nil && v.UpdateId != nil
+}
+
+// GetUpdateName returns the value of UpdateName if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetUpdateName() (o string) {
+	if v != nil && v.UpdateName != nil {
+		return *v.UpdateName
+	}
+
+	return
+}
+
+// IsSetUpdateName returns true if UpdateName is not nil.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) IsSetUpdateName() bool {
+	return v != nil && v.UpdateName != nil
+}
+
+// GetInput returns the value of Input if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+
+	return
+}
+
+// IsSetInput returns true if Input is not nil.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) IsSetInput() bool {
+	return v != nil && v.Input != nil
+}
+
+// GetResult returns the value of Result if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+
+	return
+}
+
+// IsSetResult returns true if Result is not nil.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) IsSetResult() bool {
+	return v != nil && v.Result != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
+	}
+
+	return
+}
+
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *WorkflowExecutionUpdateAcceptedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
+}
+
 type WorkflowIdReusePolicy int32
 
 const (
 	WorkflowIdReusePolicyAllowDuplicateFailedOnly WorkflowIdReusePolicy = 0
 	WorkflowIdReusePolicyAllowDuplicate           WorkflowIdReusePolicy = 1
 	WorkflowIdReusePolicyRejectDuplicate          WorkflowIdReusePolicy = 2
+	WorkflowIdReusePolicyTerminateIfRunning       WorkflowIdReusePolicy = 3
 )
 
 // WorkflowIdReusePolicy_Values returns all recognized values of WorkflowIdReusePolicy.
@@ -50667,6 +56022,7 @@ func WorkflowIdReusePolicy_Values() []WorkflowIdReusePolicy {
 		WorkflowIdReusePolicyAllowDuplicateFailedOnly,
 		WorkflowIdReusePolicyAllowDuplicate,
 		WorkflowIdReusePolicyRejectDuplicate,
+		WorkflowIdReusePolicyTerminateIfRunning,
 	}
 }
 
@@ -50686,6 +56042,9 @@ func (v *WorkflowIdReusePolicy) UnmarshalText(value []byte) error {
 	case "RejectDuplicate":
 		*v = WorkflowIdReusePolicyRejectDuplicate
 		return nil
+	case "TerminateIfRunning":
+		*v = WorkflowIdReusePolicyTerminateIfRunning
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -50710,6 +56069,8 @@ func (v WorkflowIdReusePolicy) MarshalText() ([]byte, error) {
 		return []byte("AllowDuplicate"), nil
 	case 2:
 		return []byte("RejectDuplicate"), nil
+	case 3:
+		return []byte("TerminateIfRunning"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -50727,6 +56088,8 @@ func (v WorkflowIdReusePolicy) MarshalLogObject(enc zapcore.ObjectEncoder) error
 		enc.AddString("name", "AllowDuplicate")
 	case 2:
 		enc.AddString("name", "RejectDuplicate")
+	case 3:
+		enc.AddString("name", "TerminateIfRunning")
 	}
 	return nil
 }
@@ -50773,6 +56136,8 @@ func (v WorkflowIdReusePolicy) String() string {
 		return "AllowDuplicate"
 	case 2:
 		return "RejectDuplicate"
+	case 3:
+		return "TerminateIfRunning"
 	}
 	return fmt.Sprintf("WorkflowIdReusePolicy(%d)", w)
 }
@@ -50797,6 +56162,8 @@ func (v WorkflowIdReusePolicy) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"AllowDuplicate\""), nil
 	case 2:
 		return ([]byte)("\"RejectDuplicate\""), nil
+	case 3:
+		return ([]byte)("\"TerminateIfRunning\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -51155,6 +56522,232 @@ func (v *WorkflowType) IsSetName() bool {
 	return v != nil && v.Name != nil
 }
 
+type WorkflowTypeConcurrency struct {
+	WorkflowType      *string `json:"workflowType,omitempty"`
+	RunningExecutions *int64  `json:"runningExecutions,omitempty"`
+	Limit             *int32  `json:"limit,omitempty"`
+}
+
+// ToWire translates a WorkflowTypeConcurrency struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowTypeConcurrency) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.WorkflowType != nil {
+		w, err = wire.NewValueString(*(v.WorkflowType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RunningExecutions != nil {
+		w, err = wire.NewValueI64(*(v.RunningExecutions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Limit != nil {
+		w, err = wire.NewValueI32(*(v.Limit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowTypeConcurrency struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowTypeConcurrency struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowTypeConcurrency
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowTypeConcurrency) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RunningExecutions = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Limit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowTypeConcurrency
+// struct.
+func (v *WorkflowTypeConcurrency) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", *(v.WorkflowType))
+		i++
+	}
+	if v.RunningExecutions != nil {
+		fields[i] = fmt.Sprintf("RunningExecutions: %v", *(v.RunningExecutions))
+		i++
+	}
+	if v.Limit != nil {
+		fields[i] = fmt.Sprintf("Limit: %v", *(v.Limit))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowTypeConcurrency{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowTypeConcurrency match the
+// provided WorkflowTypeConcurrency.
+//
+// This function performs a deep comparison.
+func (v *WorkflowTypeConcurrency) Equals(rhs *WorkflowTypeConcurrency) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowType, rhs.WorkflowType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.RunningExecutions, rhs.RunningExecutions) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Limit, rhs.Limit) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowTypeConcurrency.
+func (v *WorkflowTypeConcurrency) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.WorkflowType != nil {
+		enc.AddString("workflowType", *v.WorkflowType)
+	}
+	if v.RunningExecutions != nil {
+		enc.AddInt64("runningExecutions", *v.RunningExecutions)
+	}
+	if v.Limit != nil {
+		enc.AddInt32("limit", *v.Limit)
+	}
+	return err
+}
+
+// GetWorkflowType returns the value of WorkflowType if it is set or its
+// zero value if it is unset.
+func (v *WorkflowTypeConcurrency) GetWorkflowType() (o string) {
+	if v != nil && v.WorkflowType != nil {
+		return *v.WorkflowType
+	}
+
+	return
+}
+
+// IsSetWorkflowType returns true if WorkflowType is not nil.
+func (v *WorkflowTypeConcurrency) IsSetWorkflowType() bool {
+	return v != nil && v.WorkflowType != nil
+}
+
+// GetRunningExecutions returns the value of RunningExecutions if it is set or its
+// zero value if it is unset.
+func (v *WorkflowTypeConcurrency) GetRunningExecutions() (o int64) {
+	if v != nil && v.RunningExecutions != nil {
+		return *v.RunningExecutions
+	}
+
+	return
+}
+
+// IsSetRunningExecutions returns true if RunningExecutions is not nil.
+func (v *WorkflowTypeConcurrency) IsSetRunningExecutions() bool {
+	return v != nil && v.RunningExecutions != nil
+}
+
+// GetLimit returns the value of Limit if it is set or its
+// zero value if it is unset.
+func (v *WorkflowTypeConcurrency) GetLimit() (o int32) {
+	if v != nil && v.Limit != nil {
+		return *v.Limit
+	}
+
+	return
+}
+
+// IsSetLimit returns true if Limit is not nil.
+func (v *WorkflowTypeConcurrency) IsSetLimit() bool {
+	return v != nil && v.Limit != nil
+}
+
 type WorkflowTypeFilter struct {
 	Name *string `json:"name,omitempty"`
 }
@@ -51292,3 +56885,638 @@ func (v *WorkflowTypeFilter) GetName() (o string) {
 func (v *WorkflowTypeFilter) IsSetName() bool {
 	return v != nil && v.Name != nil
 }
+
+type WorkflowUpdate struct {
+	UpdateName *string `json:"updateName,omitempty"`
+	Input      []byte  `json:"input,omitempty"`
+	Identity   *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a WorkflowUpdate struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowUpdate) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.UpdateName != nil {
+		w, err = wire.NewValueString(*(v.UpdateName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowUpdate struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowUpdate struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowUpdate
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowUpdate) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowUpdate
+// struct.
+func (v *WorkflowUpdate) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.UpdateName != nil {
+		fields[i] = fmt.Sprintf("UpdateName: %v", *(v.UpdateName))
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowUpdate{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowUpdate match the
+// provided WorkflowUpdate.
+//
+// This function performs a deep comparison.
+func (v *WorkflowUpdate) Equals(rhs *WorkflowUpdate) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.UpdateName, rhs.UpdateName) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowUpdate.
+func (v *WorkflowUpdate) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.UpdateName != nil {
+		enc.AddString("updateName", *v.UpdateName)
+	}
+	if v.Input != nil {
+		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetUpdateName returns the value of UpdateName if it is set or its
+// zero value if it is unset.
+func (v *WorkflowUpdate) GetUpdateName() (o string) {
+	if v != nil && v.UpdateName != nil {
+		return *v.UpdateName
+	}
+
+	return
+}
+
+// IsSetUpdateName returns true if UpdateName is not nil.
+func (v *WorkflowUpdate) IsSetUpdateName() bool {
+	return v != nil && v.UpdateName != nil
+}
+
+// GetInput returns the value of Input if it is set or its
+// zero value if it is unset.
+func (v *WorkflowUpdate) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+
+	return
+}
+
+// IsSetInput returns true if Input is not nil.
+func (v *WorkflowUpdate) IsSetInput() bool {
+	return v != nil && v.Input != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowUpdate) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowUpdate) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type WorkflowUpdateResult struct {
+	ResultType   *WorkflowUpdateResultType `json:"resultType,omitempty"`
+	Result       []byte                    `json:"result,omitempty"`
+	RejectReason *string                   `json:"rejectReason,omitempty"`
+}
+
+// ToWire translates a WorkflowUpdateResult struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowUpdateResult) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ResultType != nil {
+		w, err = v.ResultType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Result != nil {
+		w, err = wire.NewValueBinary(v.Result), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RejectReason != nil {
+		w, err = wire.NewValueString(*(v.RejectReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowUpdateResultType_Read(w wire.Value) (WorkflowUpdateResultType, error) {
+	var v WorkflowUpdateResultType
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a WorkflowUpdateResult struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowUpdateResult struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowUpdateResult
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowUpdateResult) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowUpdateResultType
+				x, err = _WorkflowUpdateResultType_Read(field.Value)
+				v.ResultType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Result, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RejectReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowUpdateResult
+// struct.
+func (v *WorkflowUpdateResult) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.ResultType != nil {
+		fields[i] = fmt.Sprintf("ResultType: %v", *(v.ResultType))
+		i++
+	}
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", v.Result)
+		i++
+	}
+	if v.RejectReason != nil {
+		fields[i] = fmt.Sprintf("RejectReason: %v", *(v.RejectReason))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowUpdateResult{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _WorkflowUpdateResultType_EqualsPtr(lhs, rhs *WorkflowUpdateResultType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this WorkflowUpdateResult match the
+// provided WorkflowUpdateResult.
+//
+// This function performs a deep comparison.
+func (v *WorkflowUpdateResult) Equals(rhs *WorkflowUpdateResult) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_WorkflowUpdateResultType_EqualsPtr(v.ResultType, rhs.ResultType) {
+		return false
+	}
+	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RejectReason, rhs.RejectReason) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowUpdateResult.
+func (v *WorkflowUpdateResult) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ResultType != nil {
+		err = multierr.Append(err, enc.AddObject("resultType", *v.ResultType))
+	}
+	if v.Result != nil {
+		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
+	}
+	if v.RejectReason != nil {
+		enc.AddString("rejectReason", *v.RejectReason)
+	}
+	return err
+}
+
+// GetResultType returns the value of ResultType if it is set or its
+// zero value if it is unset.
+func (v *WorkflowUpdateResult) GetResultType() (o WorkflowUpdateResultType) {
+	if v != nil && v.ResultType != nil {
+		return *v.ResultType
+	}
+
+	return
+}
+
+// IsSetResultType returns true if ResultType is not nil.
+func (v *WorkflowUpdateResult) IsSetResultType() bool {
+	return v != nil && v.ResultType != nil
+}
+
+// GetResult returns the value of Result if it is set or its
+// zero value if it is unset.
+func (v *WorkflowUpdateResult) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+
+	return
+}
+
+// IsSetResult returns true if Result is not nil.
+func (v *WorkflowUpdateResult) IsSetResult() bool {
+	return v != nil && v.Result != nil
+}
+
+// GetRejectReason returns the value of RejectReason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowUpdateResult) GetRejectReason() (o string) {
+	if v != nil && v.RejectReason != nil {
+		return *v.RejectReason
+	}
+
+	return
+}
+
+// IsSetRejectReason returns true if RejectReason is not nil.
+func (v *WorkflowUpdateResult) IsSetRejectReason() bool {
+	return v != nil && v.RejectReason != nil
+}
+
+type WorkflowUpdateResultType int32
+
+const (
+	WorkflowUpdateResultTypeAccepted WorkflowUpdateResultType = 0
+	WorkflowUpdateResultTypeRejected WorkflowUpdateResultType = 1
+)
+
+// WorkflowUpdateResultType_Values returns all recognized values of WorkflowUpdateResultType.
+func WorkflowUpdateResultType_Values() []WorkflowUpdateResultType {
+	return []WorkflowUpdateResultType{
+		WorkflowUpdateResultTypeAccepted,
+		WorkflowUpdateResultTypeRejected,
+	}
+}
+
+// UnmarshalText tries to decode WorkflowUpdateResultType from a byte slice
+// containing its name.
+//
+//   var v WorkflowUpdateResultType
+//   err := v.UnmarshalText([]byte("ACCEPTED"))
+func (v *WorkflowUpdateResultType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "ACCEPTED":
+		*v = WorkflowUpdateResultTypeAccepted
+		return nil
+	case "REJECTED":
+		*v = WorkflowUpdateResultTypeRejected
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowUpdateResultType", err)
+		}
+		*v = WorkflowUpdateResultType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes WorkflowUpdateResultType to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v WorkflowUpdateResultType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("ACCEPTED"), nil
+	case 1:
+		return []byte("REJECTED"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowUpdateResultType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v WorkflowUpdateResultType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "ACCEPTED")
+	case 1:
+		enc.AddString("name", "REJECTED")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v WorkflowUpdateResultType) Ptr() *WorkflowUpdateResultType {
+	return &v
+}
+
+// ToWire translates WorkflowUpdateResultType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v WorkflowUpdateResultType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes WorkflowUpdateResultType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return WorkflowUpdateResultType(0), err
+//   }
+//
+//   var v WorkflowUpdateResultType
+//   if err := v.FromWire(x); err != nil {
+//     return WorkflowUpdateResultType(0), err
+//   }
+//   return v, nil
+func (v *WorkflowUpdateResultType) FromWire(w wire.Value) error {
+	*v = (WorkflowUpdateResultType)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of WorkflowUpdateResultType.
+func (v WorkflowUpdateResultType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ACCEPTED"
+	case 1:
+		return "REJECTED"
+	}
+	return fmt.Sprintf("WorkflowUpdateResultType(%d)", w)
+}
+
+// Equals returns true if this WorkflowUpdateResultType value matches the provided
+// value.
+func (v WorkflowUpdateResultType) Equals(rhs WorkflowUpdateResultType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes WorkflowUpdateResultType into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v WorkflowUpdateResultType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ACCEPTED\""), nil
+	case 1:
+		return ([]byte)("\"REJECTED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode WorkflowUpdateResultType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *WorkflowUpdateResultType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "WorkflowUpdateResultType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "WorkflowUpdateResultType")
+		}
+		*v = (WorkflowUpdateResultType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "WorkflowUpdateResultType")
+	}
+}
@@ -4495,15 +4495,16 @@ func (v *RequestCancelInfo) IsSetCancelRequestID() bool {
 }
 
 type ShardInfo struct {
-	StolenSinceRenew          *int32           `json:"stolenSinceRenew,omitempty"`
-	UpdatedAtNanos            *int64           `json:"updatedAtNanos,omitempty"`
-	ReplicationAckLevel       *int64           `json:"replicationAckLevel,omitempty"`
-	TransferAckLevel          *int64           `json:"transferAckLevel,omitempty"`
-	TimerAckLevelNanos        *int64           `json:"timerAckLevelNanos,omitempty"`
-	DomainNotificationVersion *int64           `json:"domainNotificationVersion,omitempty"`
-	ClusterTransferAckLevel   map[string]int64 `json:"clusterTransferAckLevel,omitempty"`
-	ClusterTimerAckLevel      map[string]int64 `json:"clusterTimerAckLevel,omitempty"`
-	Owner                     *string          `json:"owner,omitempty"`
+	StolenSinceRenew          *int32                      `json:"stolenSinceRenew,omitempty"`
+	UpdatedAtNanos            *int64                      `json:"updatedAtNanos,omitempty"`
+	ReplicationAckLevel       *int64                      `json:"replicationAckLevel,omitempty"`
+	TransferAckLevel          *int64                      `json:"transferAckLevel,omitempty"`
+	TimerAckLevelNanos        *int64                      `json:"timerAckLevelNanos,omitempty"`
+	DomainNotificationVersion *int64                      `json:"domainNotificationVersion,omitempty"`
+	ClusterTransferAckLevel   map[string]int64            `json:"clusterTransferAckLevel,omitempty"`
+	ClusterTimerAckLevel      map[string]int64            `json:"clusterTimerAckLevel,omitempty"`
+	Owner                     *string                     `json:"owner,omitempty"`
+	RunningWorkflowCounts     map[string]map[string]int64 `json:"runningWorkflowCounts,omitempty"`
 }
 
 type _Map_String_I64_MapItemList map[string]int64
@@ -4541,6 +4542,44 @@ func (_Map_String_I64_MapItemList) ValueType() wire.Type {
 
 func (_Map_String_I64_MapItemList) Close() {}
 
+type _Map_String_Map_String_I64_MapItemList map[string]map[string]int64
+
+func (m _Map_String_Map_String_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueMap(_Map_String_I64_MapItemList(v)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Map_String_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Map_String_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Map_String_I64_MapItemList) ValueType() wire.Type {
+	return wire.TMap
+}
+
+func (_Map_String_Map_String_I64_MapItemList) Close() {}
+
 // ToWire translates a ShardInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -4558,7 +4597,7 @@ func (_Map_String_I64_MapItemList) Close() {}
 //   }
 func (v *ShardInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4636,6 +4675,14 @@ func (v *ShardInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 38, Value: w}
 		i++
 	}
+	if v.RunningWorkflowCounts != nil {
+		w, err = wire.NewValueMap(_Map_String_Map_String_I64_MapItemList(v.RunningWorkflowCounts)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -4668,6 +4715,34 @@ func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
 	return o, err
 }
 
+func _Map_String_Map_String_I64_Read(m wire.MapItemList) (map[string]map[string]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TMap {
+		return nil, nil
+	}
+
+	o := make(map[string]map[string]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Map_String_I64_Read(x.Value.GetMap())
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a ShardInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -4775,6 +4850,14 @@ func (v *ShardInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TMap {
+				v.RunningWorkflowCounts, err = _Map_String_Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4789,7 +4872,7 @@ func (v *ShardInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [9]string
+	var fields [10]string
 	i := 0
 	if v.StolenSinceRenew != nil {
 		fields[i] = fmt.Sprintf("StolenSinceRenew: %v", *(v.StolenSinceRenew))
@@ -4827,6 +4910,10 @@ func (v *ShardInfo) String() string {
 		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
 		i++
 	}
+	if v.RunningWorkflowCounts != nil {
+		fields[i] = fmt.Sprintf("RunningWorkflowCounts: %v", v.RunningWorkflowCounts)
+		i++
+	}
 
 	return fmt.Sprintf("ShardInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -4848,6 +4935,23 @@ func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
 	return true
 }
 
+func _Map_String_Map_String_I64_Equals(lhs, rhs map[string]map[string]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_Map_String_I64_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this ShardInfo match the
 // provided ShardInfo.
 //
@@ -4885,6 +4989,9 @@ func (v *ShardInfo) Equals(rhs *ShardInfo) bool {
 	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
 		return false
 	}
+	if !((v.RunningWorkflowCounts == nil && rhs.RunningWorkflowCounts == nil) || (v.RunningWorkflowCounts != nil && rhs.RunningWorkflowCounts != nil && _Map_String_Map_String_I64_Equals(v.RunningWorkflowCounts, rhs.RunningWorkflowCounts))) {
+		return false
+	}
 
 	return true
 }
@@ -4900,6 +5007,17 @@ func (m _Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	return err
 }
 
+type _Map_String_Map_String_I64_Zapper map[string]map[string]int64
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Map_String_I64_Zapper.
+func (m _Map_String_Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), (_Map_String_I64_Zapper)(v)))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ShardInfo.
 func (v *ShardInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -4933,6 +5051,9 @@ func (v *ShardInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.Owner != nil {
 		enc.AddString("owner", *v.Owner)
 	}
+	if v.RunningWorkflowCounts != nil {
+		err = multierr.Append(err, enc.AddObject("runningWorkflowCounts", (_Map_String_Map_String_I64_Zapper)(v.RunningWorkflowCounts)))
+	}
 	return err
 }
 
@@ -5071,6 +5192,21 @@ func (v *ShardInfo) IsSetOwner() bool {
 	return v != nil && v.Owner != nil
 }
 
+// GetRunningWorkflowCounts returns the value of RunningWorkflowCounts if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetRunningWorkflowCounts() (o map[string]map[string]int64) {
+	if v != nil && v.RunningWorkflowCounts != nil {
+		return v.RunningWorkflowCounts
+	}
+
+	return
+}
+
+// IsSetRunningWorkflowCounts returns true if RunningWorkflowCounts is not nil.
+func (v *ShardInfo) IsSetRunningWorkflowCounts() bool {
+	return v != nil && v.RunningWorkflowCounts != nil
+}
+
 type SignalInfo struct {
 	Version   *int64  `json:"version,omitempty"`
 	RequestID *string `json:"requestID,omitempty"`
@@ -5382,10 +5518,13 @@ func (v *SignalInfo) IsSetControl() bool {
 }
 
 type TaskInfo struct {
-	WorkflowID      *string `json:"workflowID,omitempty"`
-	RunID           []byte  `json:"runID,omitempty"`
-	ScheduleID      *int64  `json:"scheduleID,omitempty"`
-	ExpiryTimeNanos *int64  `json:"expiryTimeNanos,omitempty"`
+	WorkflowID       *string `json:"workflowID,omitempty"`
+	RunID            []byte  `json:"runID,omitempty"`
+	ScheduleID       *int64  `json:"scheduleID,omitempty"`
+	ExpiryTimeNanos  *int64  `json:"expiryTimeNanos,omitempty"`
+	ActivityType     *string `json:"activityType,omitempty"`
+	BinaryChecksum   *string `json:"binaryChecksum,omitempty"`
+	CreatedTimeNanos *int64  `json:"createdTimeNanos,omitempty"`
 }
 
 // ToWire translates a TaskInfo struct into a Thrift-level intermediate
@@ -5405,7 +5544,7 @@ type TaskInfo struct {
 //   }
 func (v *TaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5443,6 +5582,30 @@ func (v *TaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 14, Value: w}
 		i++
 	}
+	if v.ActivityType != nil {
+		w, err = wire.NewValueString(*(v.ActivityType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
+	if v.CreatedTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.CreatedTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -5506,6 +5669,36 @@ func (v *TaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 16:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 18:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CreatedTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -5520,7 +5713,7 @@ func (v *TaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [7]string
 	i := 0
 	if v.WorkflowID != nil {
 		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
@@ -5538,6 +5731,18 @@ func (v *TaskInfo) String() string {
 		fields[i] = fmt.Sprintf("ExpiryTimeNanos: %v", *(v.ExpiryTimeNanos))
 		i++
 	}
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", *(v.ActivityType))
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
+		i++
+	}
+	if v.CreatedTimeNanos != nil {
+		fields[i] = fmt.Sprintf("CreatedTimeNanos: %v", *(v.CreatedTimeNanos))
+		i++
+	}
 
 	return fmt.Sprintf("TaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -5564,6 +5769,15 @@ func (v *TaskInfo) Equals(rhs *TaskInfo) bool {
 	if !_I64_EqualsPtr(v.ExpiryTimeNanos, rhs.ExpiryTimeNanos) {
 		return false
 	}
+	if !_String_EqualsPtr(v.ActivityType, rhs.ActivityType) {
+		return false
+	}
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CreatedTimeNanos, rhs.CreatedTimeNanos) {
+		return false
+	}
 
 	return true
 }
@@ -5586,6 +5800,15 @@ func (v *TaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.ExpiryTimeNanos != nil {
 		enc.AddInt64("expiryTimeNanos", *v.ExpiryTimeNanos)
 	}
+	if v.ActivityType != nil {
+		enc.AddString("activityType", *v.ActivityType)
+	}
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
+	}
+	if v.CreatedTimeNanos != nil {
+		enc.AddInt64("createdTimeNanos", *v.CreatedTimeNanos)
+	}
 	return err
 }
 
@@ -5649,11 +5872,58 @@ func (v *TaskInfo) IsSetExpiryTimeNanos() bool {
 	return v != nil && v.ExpiryTimeNanos != nil
 }
 
+// GetActivityType returns the value of ActivityType if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetActivityType() (o string) {
+	if v != nil && v.ActivityType != nil {
+		return *v.ActivityType
+	}
+
+	return
+}
+
+// IsSetActivityType returns true if ActivityType is not nil.
+func (v *TaskInfo) IsSetActivityType() bool {
+	return v != nil && v.ActivityType != nil
+}
+
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetBinaryChecksum() (o string) {
+	if v != nil && v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
+	}
+
+	return
+}
+
+// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
+func (v *TaskInfo) IsSetBinaryChecksum() bool {
+	return v != nil && v.BinaryChecksum != nil
+}
+
+// GetCreatedTimeNanos returns the value of CreatedTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetCreatedTimeNanos() (o int64) {
+	if v != nil && v.CreatedTimeNanos != nil {
+		return *v.CreatedTimeNanos
+	}
+
+	return
+}
+
+// IsSetCreatedTimeNanos returns true if CreatedTimeNanos is not nil.
+func (v *TaskInfo) IsSetCreatedTimeNanos() bool {
+	return v != nil && v.CreatedTimeNanos != nil
+}
+
 type TaskListInfo struct {
-	Kind             *int16 `json:"kind,omitempty"`
-	AckLevel         *int64 `json:"ackLevel,omitempty"`
-	ExpiryTimeNanos  *int64 `json:"expiryTimeNanos,omitempty"`
-	LastUpdatedNanos *int64 `json:"lastUpdatedNanos,omitempty"`
+	Kind                      *int16   `json:"kind,omitempty"`
+	AckLevel                  *int64   `json:"ackLevel,omitempty"`
+	ExpiryTimeNanos           *int64   `json:"expiryTimeNanos,omitempty"`
+	LastUpdatedNanos          *int64   `json:"lastUpdatedNanos,omitempty"`
+	DefaultBinaryChecksum     *string  `json:"defaultBinaryChecksum,omitempty"`
+	CompatibleBinaryChecksums []string `json:"compatibleBinaryChecksums,omitempty"`
 }
 
 // ToWire translates a TaskListInfo struct into a Thrift-level intermediate
@@ -5673,7 +5943,7 @@ type TaskListInfo struct {
 //   }
 func (v *TaskListInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5711,6 +5981,22 @@ func (v *TaskListInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 16, Value: w}
 		i++
 	}
+	if v.DefaultBinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.DefaultBinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
+	if v.CompatibleBinaryChecksums != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.CompatibleBinaryChecksums)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -5776,6 +6062,24 @@ func (v *TaskListInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 18:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DefaultBinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.CompatibleBinaryChecksums, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -5790,7 +6094,7 @@ func (v *TaskListInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
 	if v.Kind != nil {
 		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
@@ -5808,6 +6112,14 @@ func (v *TaskListInfo) String() string {
 		fields[i] = fmt.Sprintf("LastUpdatedNanos: %v", *(v.LastUpdatedNanos))
 		i++
 	}
+	if v.DefaultBinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("DefaultBinaryChecksum: %v", *(v.DefaultBinaryChecksum))
+		i++
+	}
+	if v.CompatibleBinaryChecksums != nil {
+		fields[i] = fmt.Sprintf("CompatibleBinaryChecksums: %v", v.CompatibleBinaryChecksums)
+		i++
+	}
 
 	return fmt.Sprintf("TaskListInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -5834,6 +6146,12 @@ func (v *TaskListInfo) Equals(rhs *TaskListInfo) bool {
 	if !_I64_EqualsPtr(v.LastUpdatedNanos, rhs.LastUpdatedNanos) {
 		return false
 	}
+	if !_String_EqualsPtr(v.DefaultBinaryChecksum, rhs.DefaultBinaryChecksum) {
+		return false
+	}
+	if !((v.CompatibleBinaryChecksums == nil && rhs.CompatibleBinaryChecksums == nil) || (v.CompatibleBinaryChecksums != nil && rhs.CompatibleBinaryChecksums != nil && _List_String_Equals(v.CompatibleBinaryChecksums, rhs.CompatibleBinaryChecksums))) {
+		return false
+	}
 
 	return true
 }
@@ -5856,6 +6174,12 @@ func (v *TaskListInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.LastUpdatedNanos != nil {
 		enc.AddInt64("lastUpdatedNanos", *v.LastUpdatedNanos)
 	}
+	if v.DefaultBinaryChecksum != nil {
+		enc.AddString("defaultBinaryChecksum", *v.DefaultBinaryChecksum)
+	}
+	if v.CompatibleBinaryChecksums != nil {
+		err = multierr.Append(err, enc.AddArray("compatibleBinaryChecksums", (_List_String_Zapper)(v.CompatibleBinaryChecksums)))
+	}
 	return err
 }
 
@@ -5919,6 +6243,36 @@ func (v *TaskListInfo) IsSetLastUpdatedNanos() bool {
 	return v != nil && v.LastUpdatedNanos != nil
 }
 
+// GetDefaultBinaryChecksum returns the value of DefaultBinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetDefaultBinaryChecksum() (o string) {
+	if v != nil && v.DefaultBinaryChecksum != nil {
+		return *v.DefaultBinaryChecksum
+	}
+
+	return
+}
+
+// IsSetDefaultBinaryChecksum returns true if DefaultBinaryChecksum is not nil.
+func (v *TaskListInfo) IsSetDefaultBinaryChecksum() bool {
+	return v != nil && v.DefaultBinaryChecksum != nil
+}
+
+// GetCompatibleBinaryChecksums returns the value of CompatibleBinaryChecksums if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetCompatibleBinaryChecksums() (o []string) {
+	if v != nil && v.CompatibleBinaryChecksums != nil {
+		return v.CompatibleBinaryChecksums
+	}
+
+	return
+}
+
+// IsSetCompatibleBinaryChecksums returns true if CompatibleBinaryChecksums is not nil.
+func (v *TaskListInfo) IsSetCompatibleBinaryChecksums() bool {
+	return v != nil && v.CompatibleBinaryChecksums != nil
+}
+
 type TimerInfo struct {
 	Version         *int64 `json:"version,omitempty"`
 	StartedID       *int64 `json:"startedID,omitempty"`
@@ -6198,6 +6552,7 @@ type TimerTaskInfo struct {
 	Version         *int64  `json:"version,omitempty"`
 	ScheduleAttempt *int64  `json:"scheduleAttempt,omitempty"`
 	EventID         *int64  `json:"eventID,omitempty"`
+	SignalRequestID *string `json:"signalRequestID,omitempty"`
 }
 
 // ToWire translates a TimerTaskInfo struct into a Thrift-level intermediate
@@ -6217,7 +6572,7 @@ type TimerTaskInfo struct {
 //   }
 func (v *TimerTaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6287,6 +6642,14 @@ func (v *TimerTaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 24, Value: w}
 		i++
 	}
+	if v.SignalRequestID != nil {
+		w, err = wire.NewValueString(*(v.SignalRequestID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 26, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -6388,6 +6751,16 @@ func (v *TimerTaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 26:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SignalRequestID = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6402,7 +6775,7 @@ func (v *TimerTaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [9]string
 	i := 0
 	if v.DomainID != nil {
 		fields[i] = fmt.Sprintf("DomainID: %v", v.DomainID)
@@ -6436,6 +6809,10 @@ func (v *TimerTaskInfo) String() string {
 		fields[i] = fmt.Sprintf("EventID: %v", *(v.EventID))
 		i++
 	}
+	if v.SignalRequestID != nil {
+		fields[i] = fmt.Sprintf("SignalRequestID: %v", *(v.SignalRequestID))
+		i++
+	}
 
 	return fmt.Sprintf("TimerTaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -6474,6 +6851,9 @@ func (v *TimerTaskInfo) Equals(rhs *TimerTaskInfo) bool {
 	if !_I64_EqualsPtr(v.EventID, rhs.EventID) {
 		return false
 	}
+	if !_String_EqualsPtr(v.SignalRequestID, rhs.SignalRequestID) {
+		return false
+	}
 
 	return true
 }
@@ -6508,6 +6888,9 @@ func (v *TimerTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error)
 	if v.EventID != nil {
 		enc.AddInt64("eventID", *v.EventID)
 	}
+	if v.SignalRequestID != nil {
+		enc.AddString("signalRequestID", *v.SignalRequestID)
+	}
 	return err
 }
 
@@ -6631,6 +7014,21 @@ func (v *TimerTaskInfo) IsSetEventID() bool {
 	return v != nil && v.EventID != nil
 }
 
+// GetSignalRequestID returns the value of SignalRequestID if it is set or its
+// zero value if it is unset.
+func (v *TimerTaskInfo) GetSignalRequestID() (o string) {
+	if v != nil && v.SignalRequestID != nil {
+		return *v.SignalRequestID
+	}
+
+	return
+}
+
+// IsSetSignalRequestID returns true if SignalRequestID is not nil.
+func (v *TimerTaskInfo) IsSetSignalRequestID() bool {
+	return v != nil && v.SignalRequestID != nil
+}
+
 type TransferTaskInfo struct {
 	DomainID                 []byte  `json:"domainID,omitempty"`
 	WorkflowID               *string `json:"workflowID,omitempty"`
@@ -6644,6 +7042,7 @@ type TransferTaskInfo struct {
 	ScheduleID               *int64  `json:"scheduleID,omitempty"`
 	Version                  *int64  `json:"version,omitempty"`
 	VisibilityTimestampNanos *int64  `json:"visibilityTimestampNanos,omitempty"`
+	TraceContext             []byte  `json:"traceContext,omitempty"`
 }
 
 // ToWire translates a TransferTaskInfo struct into a Thrift-level intermediate
@@ -6663,7 +7062,7 @@ type TransferTaskInfo struct {
 //   }
 func (v *TransferTaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6765,6 +7164,14 @@ func (v *TransferTaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 32, Value: w}
 		i++
 	}
+	if v.TraceContext != nil {
+		w, err = wire.NewValueBinary(v.TraceContext), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 34, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -6902,6 +7309,14 @@ func (v *TransferTaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 34:
+			if field.Value.Type() == wire.TBinary {
+				v.TraceContext, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6916,7 +7331,7 @@ func (v *TransferTaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [12]string
+	var fields [13]string
 	i := 0
 	if v.DomainID != nil {
 		fields[i] = fmt.Sprintf("DomainID: %v", v.DomainID)
@@ -6966,6 +7381,10 @@ func (v *TransferTaskInfo) String() string {
 		fields[i] = fmt.Sprintf("VisibilityTimestampNanos: %v", *(v.VisibilityTimestampNanos))
 		i++
 	}
+	if v.TraceContext != nil {
+		fields[i] = fmt.Sprintf("TraceContext: %v", v.TraceContext)
+		i++
+	}
 
 	return fmt.Sprintf("TransferTaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -7016,6 +7435,9 @@ func (v *TransferTaskInfo) Equals(rhs *TransferTaskInfo) bool {
 	if !_I64_EqualsPtr(v.VisibilityTimestampNanos, rhs.VisibilityTimestampNanos) {
 		return false
 	}
+	if !((v.TraceContext == nil && rhs.TraceContext == nil) || (v.TraceContext != nil && rhs.TraceContext != nil && bytes.Equal(v.TraceContext, rhs.TraceContext))) {
+		return false
+	}
 
 	return true
 }
@@ -7062,6 +7484,9 @@ func (v *TransferTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err erro
 	if v.VisibilityTimestampNanos != nil {
 		enc.AddInt64("visibilityTimestampNanos", *v.VisibilityTimestampNanos)
 	}
+	if v.TraceContext != nil {
+		enc.AddString("traceContext", base64.StdEncoding.EncodeToString(v.TraceContext))
+	}
 	return err
 }
 
@@ -7245,6 +7670,21 @@ func (v *TransferTaskInfo) IsSetVisibilityTimestampNanos() bool {
 	return v != nil && v.VisibilityTimestampNanos != nil
 }
 
+// GetTraceContext returns the value of TraceContext if it is set or its
+// zero value if it is unset.
+func (v *TransferTaskInfo) GetTraceContext() (o []byte) {
+	if v != nil && v.TraceContext != nil {
+		return v.TraceContext
+	}
+
+	return
+}
+
+// IsSetTraceContext returns true if TraceContext is not nil.
+func (v *TransferTaskInfo) IsSetTraceContext() bool {
+	return v != nil && v.TraceContext != nil
+}
+
 type WorkflowExecutionInfo struct {
 	ParentDomainID               []byte                      `json:"parentDomainID,omitempty"`
 	ParentWorkflowID             *string                     `json:"parentWorkflowID,omitempty"`
@@ -7300,6 +7740,17 @@ type WorkflowExecutionInfo struct {
 	ClientImpl                   *string                     `json:"clientImpl,omitempty"`
 	AutoResetPoints              []byte                      `json:"autoResetPoints,omitempty"`
 	AutoResetPointsEncoding      *string                     `json:"autoResetPointsEncoding,omitempty"`
+	RetentionDays                *int32                      `json:"retentionDays,omitempty"`
+	WorkflowExecutionTimeout     *int32                      `json:"workflowExecutionTimeout,omitempty"`
+	WorkflowExpirationTimeNanos  *int64                      `json:"workflowExpirationTimeNanos,omitempty"`
+	RecentSignalRequestIDs       map[string]int64            `json:"recentSignalRequestIDs,omitempty"`
+	DecisionHeartbeatCount       *int32                      `json:"decisionHeartbeatCount,omitempty"`
+	DecisionHeartbeatTimeNanos   *int64                      `json:"decisionHeartbeatTimeNanos,omitempty"`
+	LastBinaryChecksum           *string                     `json:"lastBinaryChecksum,omitempty"`
+	DelayedSignals               []byte                      `json:"delayedSignals,omitempty"`
+	DelayedSignalsEncoding       *string                     `json:"delayedSignalsEncoding,omitempty"`
+	AcceptedUpdates              []byte                      `json:"acceptedUpdates,omitempty"`
+	AcceptedUpdatesEncoding      *string                     `json:"acceptedUpdatesEncoding,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
@@ -7319,7 +7770,7 @@ type WorkflowExecutionInfo struct {
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [54]wire.Field
+		fields [65]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7698,63 +8149,151 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 104, Value: w}
+		fields[i] = wire.Field{ID: 104, Value: w}
+		i++
+	}
+	if v.SignalCount != nil {
+		w, err = wire.NewValueI64(*(v.SignalCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 106, Value: w}
+		i++
+	}
+	if v.HistorySize != nil {
+		w, err = wire.NewValueI64(*(v.HistorySize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 108, Value: w}
+		i++
+	}
+	if v.ClientLibraryVersion != nil {
+		w, err = wire.NewValueString(*(v.ClientLibraryVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.ClientFeatureVersion != nil {
+		w, err = wire.NewValueString(*(v.ClientFeatureVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 112, Value: w}
+		i++
+	}
+	if v.ClientImpl != nil {
+		w, err = wire.NewValueString(*(v.ClientImpl)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 114, Value: w}
+		i++
+	}
+	if v.AutoResetPoints != nil {
+		w, err = wire.NewValueBinary(v.AutoResetPoints), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 115, Value: w}
+		i++
+	}
+	if v.AutoResetPointsEncoding != nil {
+		w, err = wire.NewValueString(*(v.AutoResetPointsEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 116, Value: w}
+		i++
+	}
+	if v.RetentionDays != nil {
+		w, err = wire.NewValueI32(*(v.RetentionDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 118, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTimeout != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionTimeout)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.WorkflowExpirationTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.WorkflowExpirationTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 122, Value: w}
+		i++
+	}
+	if v.RecentSignalRequestIDs != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.RecentSignalRequestIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 124, Value: w}
 		i++
 	}
-	if v.SignalCount != nil {
-		w, err = wire.NewValueI64(*(v.SignalCount)), error(nil)
+	if v.DecisionHeartbeatCount != nil {
+		w, err = wire.NewValueI32(*(v.DecisionHeartbeatCount)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 106, Value: w}
+		fields[i] = wire.Field{ID: 126, Value: w}
 		i++
 	}
-	if v.HistorySize != nil {
-		w, err = wire.NewValueI64(*(v.HistorySize)), error(nil)
+	if v.DecisionHeartbeatTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.DecisionHeartbeatTimeNanos)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 108, Value: w}
+		fields[i] = wire.Field{ID: 128, Value: w}
 		i++
 	}
-	if v.ClientLibraryVersion != nil {
-		w, err = wire.NewValueString(*(v.ClientLibraryVersion)), error(nil)
+	if v.LastBinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.LastBinaryChecksum)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 110, Value: w}
+		fields[i] = wire.Field{ID: 130, Value: w}
 		i++
 	}
-	if v.ClientFeatureVersion != nil {
-		w, err = wire.NewValueString(*(v.ClientFeatureVersion)), error(nil)
+	if v.DelayedSignals != nil {
+		w, err = wire.NewValueBinary(v.DelayedSignals), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 112, Value: w}
+		fields[i] = wire.Field{ID: 132, Value: w}
 		i++
 	}
-	if v.ClientImpl != nil {
-		w, err = wire.NewValueString(*(v.ClientImpl)), error(nil)
+	if v.DelayedSignalsEncoding != nil {
+		w, err = wire.NewValueString(*(v.DelayedSignalsEncoding)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 114, Value: w}
+		fields[i] = wire.Field{ID: 134, Value: w}
 		i++
 	}
-	if v.AutoResetPoints != nil {
-		w, err = wire.NewValueBinary(v.AutoResetPoints), error(nil)
+	if v.AcceptedUpdates != nil {
+		w, err = wire.NewValueBinary(v.AcceptedUpdates), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 115, Value: w}
+		fields[i] = wire.Field{ID: 136, Value: w}
 		i++
 	}
-	if v.AutoResetPointsEncoding != nil {
-		w, err = wire.NewValueString(*(v.AutoResetPointsEncoding)), error(nil)
+	if v.AcceptedUpdatesEncoding != nil {
+		w, err = wire.NewValueString(*(v.AcceptedUpdatesEncoding)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 116, Value: w}
+		fields[i] = wire.Field{ID: 138, Value: w}
 		i++
 	}
 
@@ -8306,6 +8845,110 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 118:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetentionDays = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionTimeout = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 122:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.WorkflowExpirationTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 124:
+			if field.Value.Type() == wire.TMap {
+				v.RecentSignalRequestIDs, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 126:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.DecisionHeartbeatCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 128:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionHeartbeatTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LastBinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 132:
+			if field.Value.Type() == wire.TBinary {
+				v.DelayedSignals, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 134:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DelayedSignalsEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 136:
+			if field.Value.Type() == wire.TBinary {
+				v.AcceptedUpdates, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 138:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AcceptedUpdatesEncoding = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -8320,7 +8963,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [54]string
+	var fields [65]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -8538,6 +9181,50 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("AutoResetPointsEncoding: %v", *(v.AutoResetPointsEncoding))
 		i++
 	}
+	if v.RetentionDays != nil {
+		fields[i] = fmt.Sprintf("RetentionDays: %v", *(v.RetentionDays))
+		i++
+	}
+	if v.WorkflowExecutionTimeout != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTimeout: %v", *(v.WorkflowExecutionTimeout))
+		i++
+	}
+	if v.WorkflowExpirationTimeNanos != nil {
+		fields[i] = fmt.Sprintf("WorkflowExpirationTimeNanos: %v", *(v.WorkflowExpirationTimeNanos))
+		i++
+	}
+	if v.RecentSignalRequestIDs != nil {
+		fields[i] = fmt.Sprintf("RecentSignalRequestIDs: %v", v.RecentSignalRequestIDs)
+		i++
+	}
+	if v.DecisionHeartbeatCount != nil {
+		fields[i] = fmt.Sprintf("DecisionHeartbeatCount: %v", *(v.DecisionHeartbeatCount))
+		i++
+	}
+	if v.DecisionHeartbeatTimeNanos != nil {
+		fields[i] = fmt.Sprintf("DecisionHeartbeatTimeNanos: %v", *(v.DecisionHeartbeatTimeNanos))
+		i++
+	}
+	if v.LastBinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("LastBinaryChecksum: %v", *(v.LastBinaryChecksum))
+		i++
+	}
+	if v.DelayedSignals != nil {
+		fields[i] = fmt.Sprintf("DelayedSignals: %v", v.DelayedSignals)
+		i++
+	}
+	if v.DelayedSignalsEncoding != nil {
+		fields[i] = fmt.Sprintf("DelayedSignalsEncoding: %v", *(v.DelayedSignalsEncoding))
+		i++
+	}
+	if v.AcceptedUpdates != nil {
+		fields[i] = fmt.Sprintf("AcceptedUpdates: %v", v.AcceptedUpdates)
+		i++
+	}
+	if v.AcceptedUpdatesEncoding != nil {
+		fields[i] = fmt.Sprintf("AcceptedUpdatesEncoding: %v", *(v.AcceptedUpdatesEncoding))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -8714,6 +9401,39 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.AutoResetPointsEncoding, rhs.AutoResetPointsEncoding) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.RetentionDays, rhs.RetentionDays) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.WorkflowExecutionTimeout, rhs.WorkflowExecutionTimeout) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.WorkflowExpirationTimeNanos, rhs.WorkflowExpirationTimeNanos) {
+		return false
+	}
+	if !((v.RecentSignalRequestIDs == nil && rhs.RecentSignalRequestIDs == nil) || (v.RecentSignalRequestIDs != nil && rhs.RecentSignalRequestIDs != nil && _Map_String_I64_Equals(v.RecentSignalRequestIDs, rhs.RecentSignalRequestIDs))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.DecisionHeartbeatCount, rhs.DecisionHeartbeatCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionHeartbeatTimeNanos, rhs.DecisionHeartbeatTimeNanos) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LastBinaryChecksum, rhs.LastBinaryChecksum) {
+		return false
+	}
+	if !((v.DelayedSignals == nil && rhs.DelayedSignals == nil) || (v.DelayedSignals != nil && rhs.DelayedSignals != nil && bytes.Equal(v.DelayedSignals, rhs.DelayedSignals))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DelayedSignalsEncoding, rhs.DelayedSignalsEncoding) {
+		return false
+	}
+	if !((v.AcceptedUpdates == nil && rhs.AcceptedUpdates == nil) || (v.AcceptedUpdates != nil && rhs.AcceptedUpdates != nil && bytes.Equal(v.AcceptedUpdates, rhs.AcceptedUpdates))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.AcceptedUpdatesEncoding, rhs.AcceptedUpdatesEncoding) {
+		return false
+	}
 
 	return true
 }
@@ -8886,6 +9606,39 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.AutoResetPointsEncoding != nil {
 		enc.AddString("autoResetPointsEncoding", *v.AutoResetPointsEncoding)
 	}
+	if v.RetentionDays != nil {
+		enc.AddInt32("retentionDays", *v.RetentionDays)
+	}
+	if v.WorkflowExecutionTimeout != nil {
+		enc.AddInt32("workflowExecutionTimeout", *v.WorkflowExecutionTimeout)
+	}
+	if v.WorkflowExpirationTimeNanos != nil {
+		enc.AddInt64("workflowExpirationTimeNanos", *v.WorkflowExpirationTimeNanos)
+	}
+	if v.RecentSignalRequestIDs != nil {
+		err = multierr.Append(err, enc.AddObject("recentSignalRequestIDs", (_Map_String_I64_Zapper)(v.RecentSignalRequestIDs)))
+	}
+	if v.DecisionHeartbeatCount != nil {
+		enc.AddInt32("decisionHeartbeatCount", *v.DecisionHeartbeatCount)
+	}
+	if v.DecisionHeartbeatTimeNanos != nil {
+		enc.AddInt64("decisionHeartbeatTimeNanos", *v.DecisionHeartbeatTimeNanos)
+	}
+	if v.LastBinaryChecksum != nil {
+		enc.AddString("lastBinaryChecksum", *v.LastBinaryChecksum)
+	}
+	if v.DelayedSignals != nil {
+		enc.AddString("delayedSignals", base64.StdEncoding.EncodeToString(v.DelayedSignals))
+	}
+	if v.DelayedSignalsEncoding != nil {
+		enc.AddString("delayedSignalsEncoding", *v.DelayedSignalsEncoding)
+	}
+	if v.AcceptedUpdates != nil {
+		enc.AddString("acceptedUpdates", base64.StdEncoding.EncodeToString(v.AcceptedUpdates))
+	}
+	if v.AcceptedUpdatesEncoding != nil {
+		enc.AddString("acceptedUpdatesEncoding", *v.AcceptedUpdatesEncoding)
+	}
 	return err
 }
 
@@ -9698,3 +10451,168 @@ func (v *WorkflowExecutionInfo) GetAutoResetPointsEncoding() (o string) {
 func (v *WorkflowExecutionInfo) IsSetAutoResetPointsEncoding() bool {
 	return v != nil && v.AutoResetPointsEncoding != nil
 }
+
+// GetRetentionDays returns the value of RetentionDays if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRetentionDays() (o int32) {
+	if v != nil && v.RetentionDays != nil {
+		return *v.RetentionDays
+	}
+
+	return
+}
+
+// IsSetRetentionDays returns true if RetentionDays is not nil.
+func (v *WorkflowExecutionInfo) IsSetRetentionDays() bool {
+	return v != nil && v.RetentionDays != nil
+}
+
+// GetWorkflowExecutionTimeout returns the value of WorkflowExecutionTimeout if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetWorkflowExecutionTimeout() (o int32) {
+	if v != nil && v.WorkflowExecutionTimeout != nil {
+		return *v.WorkflowExecutionTimeout
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionTimeout returns true if WorkflowExecutionTimeout is not nil.
+func (v *WorkflowExecutionInfo) IsSetWorkflowExecutionTimeout() bool {
+	return v != nil && v.WorkflowExecutionTimeout != nil
+}
+
+// GetWorkflowExpirationTimeNanos returns the value of WorkflowExpirationTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetWorkflowExpirationTimeNanos() (o int64) {
+	if v != nil && v.WorkflowExpirationTimeNanos != nil {
+		return *v.WorkflowExpirationTimeNanos
+	}
+
+	return
+}
+
+// IsSetWorkflowExpirationTimeNanos returns true if WorkflowExpirationTimeNanos is not nil.
+func (v *WorkflowExecutionInfo) IsSetWorkflowExpirationTimeNanos() bool {
+	return v != nil && v.WorkflowExpirationTimeNanos != nil
+}
+
+// GetRecentSignalRequestIDs returns the value of RecentSignalRequestIDs if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRecentSignalRequestIDs() (o map[string]int64) {
+	if v != nil && v.RecentSignalRequestIDs != nil {
+		return v.RecentSignalRequestIDs
+	}
+
+	return
+}
+
+// IsSetRecentSignalRequestIDs returns true if RecentSignalRequestIDs is not nil.
+func (v *WorkflowExecutionInfo) IsSetRecentSignalRequestIDs() bool {
+	return v != nil && v.RecentSignalRequestIDs != nil
+}
+
+// GetDecisionHeartbeatCount returns the value of DecisionHeartbeatCount if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetDecisionHeartbeatCount() (o int32) {
+	if v != nil && v.DecisionHeartbeatCount != nil {
+		return *v.DecisionHeartbeatCount
+	}
+
+	return
+}
+
+// IsSetDecisionHeartbeatCount returns true if DecisionHeartbeatCount is not nil.
+func (v *WorkflowExecutionInfo) IsSetDecisionHeartbeatCount() bool {
+	return v != nil && v.DecisionHeartbeatCount != nil
+}
+
+// GetDecisionHeartbeatTimeNanos returns the value of DecisionHeartbeatTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetDecisionHeartbeatTimeNanos() (o int64) {
+	if v != nil && v.DecisionHeartbeatTimeNanos != nil {
+		return *v.DecisionHeartbeatTimeNanos
+	}
+
+	return
+}
+
+// IsSetDecisionHeartbeatTimeNanos returns true if DecisionHeartbeatTimeNanos is not nil.
+func (v *WorkflowExecutionInfo) IsSetDecisionHeartbeatTimeNanos() bool {
+	return v != nil && v.DecisionHeartbeatTimeNanos != nil
+}
+
+// GetLastBinaryChecksum returns the value of LastBinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetLastBinaryChecksum() (o string) {
+	if v != nil && v.LastBinaryChecksum != nil {
+		return *v.LastBinaryChecksum
+	}
+
+	return
+}
+
+// IsSetLastBinaryChecksum returns true if LastBinaryChecksum is not nil.
+func (v *WorkflowExecutionInfo) IsSetLastBinaryChecksum() bool {
+	return v != nil && v.LastBinaryChecksum != nil
+}
+
+// GetDelayedSignals returns the value of DelayedSignals if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetDelayedSignals() (o []byte) {
+	if v != nil && v.DelayedSignals != nil {
+		return v.DelayedSignals
+	}
+
+	return
+}
+
+// IsSetDelayedSignals returns true if DelayedSignals is not nil.
+func (v *WorkflowExecutionInfo) IsSetDelayedSignals() bool {
+	return v != nil && v.DelayedSignals != nil
+}
+
+// GetDelayedSignalsEncoding returns the value of DelayedSignalsEncoding if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetDelayedSignalsEncoding() (o string) {
+	if v != nil && v.DelayedSignalsEncoding != nil {
+		return *v.DelayedSignalsEncoding
+	}
+
+	return
+}
+
+// IsSetDelayedSignalsEncoding returns true if DelayedSignalsEncoding is not nil.
+func (v *WorkflowExecutionInfo) IsSetDelayedSignalsEncoding() bool {
+	return v != nil && v.DelayedSignalsEncoding != nil
+}
+
+// GetAcceptedUpdates returns the value of AcceptedUpdates if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetAcceptedUpdates() (o []byte) {
+	if v != nil && v.AcceptedUpdates != nil {
+		return v.AcceptedUpdates
+	}
+
+	return
+}
+
+// IsSetAcceptedUpdates returns true if AcceptedUpdates is not nil.
+func (v *WorkflowExecutionInfo) IsSetAcceptedUpdates() bool {
+	return v != nil && v.AcceptedUpdates != nil
+}
+
+// GetAcceptedUpdatesEncoding returns the value of AcceptedUpdatesEncoding if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetAcceptedUpdatesEncoding() (o string) {
+	if v != nil && v.AcceptedUpdatesEncoding != nil {
+		return *v.AcceptedUpdatesEncoding
+	}
+
+	return
+}
+
+// IsSetAcceptedUpdatesEncoding returns true if AcceptedUpdatesEncoding is not nil.
+func (v *WorkflowExecutionInfo) IsSetAcceptedUpdatesEncoding() bool {
+	return v != nil && v.AcceptedUpdatesEncoding != nil
+}
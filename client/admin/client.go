@@ -103,6 +103,54 @@ func (c *clientImpl) GetWorkflowExecutionRawHistory(
 	return client.GetWorkflowExecutionRawHistory(ctx, request, opts...)
 }
 
+func (c *clientImpl) VerifyWorkflowExecutionHistory(
+	ctx context.Context,
+	request *admin.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*admin.VerifyWorkflowExecutionHistoryResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.VerifyWorkflowExecutionHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) PromoteTaskListBuild(
+	ctx context.Context,
+	request *admin.PromoteTaskListBuildRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PromoteTaskListBuild(ctx, request, opts...)
+}
+
+func (c *clientImpl) MigrateTaskListBacklog(
+	ctx context.Context,
+	request *admin.MigrateTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*admin.MigrateTaskListBacklogResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MigrateTaskListBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -26,6 +26,7 @@ import (
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/tracing"
 	"go.uber.org/yarpc"
 )
 
@@ -51,10 +52,12 @@ func (c *metricClient) DescribeHistoryHost(
 ) (*shared.DescribeHistoryHostResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.AdminClientDescribeHistoryHostScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.AdminClientDescribeHistoryHostScope)
 
 	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeHistoryHostScope, metrics.CadenceClientLatency)
 	resp, err := c.client.DescribeHistoryHost(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.AdminClientDescribeHistoryHostScope, metrics.CadenceClientFailures)
@@ -69,10 +72,12 @@ func (c *metricClient) DescribeWorkflowExecution(
 ) (*admin.DescribeWorkflowExecutionResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.AdminClientDescribeWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.AdminClientDescribeWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeWorkflowExecutionScope, metrics.CadenceClientLatency)
 	resp, err := c.client.DescribeWorkflowExecution(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.AdminClientDescribeWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -87,13 +92,75 @@ func (c *metricClient) GetWorkflowExecutionRawHistory(
 ) (*admin.GetWorkflowExecutionRawHistoryResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.AdminClientGetWorkflowExecutionRawHistoryScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.AdminClientGetWorkflowExecutionRawHistoryScope)
 
 	sw := c.metricsClient.StartTimer(metrics.AdminClientGetWorkflowExecutionRawHistoryScope, metrics.CadenceClientLatency)
 	resp, err := c.client.GetWorkflowExecutionRawHistory(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.AdminClientGetWorkflowExecutionRawHistoryScope, metrics.CadenceClientFailures)
 	}
 	return resp, err
 }
+
+func (c *metricClient) VerifyWorkflowExecutionHistory(
+	ctx context.Context,
+	request *admin.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*admin.VerifyWorkflowExecutionHistoryResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientVerifyWorkflowExecutionHistoryScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.AdminClientVerifyWorkflowExecutionHistoryScope)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientVerifyWorkflowExecutionHistoryScope, metrics.CadenceClientLatency)
+	resp, err := c.client.VerifyWorkflowExecutionHistory(ctx, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientVerifyWorkflowExecutionHistoryScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PromoteTaskListBuild(
+	ctx context.Context,
+	request *admin.PromoteTaskListBuildRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPromoteTaskListBuildScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.AdminClientPromoteTaskListBuildScope)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPromoteTaskListBuildScope, metrics.CadenceClientLatency)
+	err := c.client.PromoteTaskListBuild(ctx, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPromoteTaskListBuildScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) MigrateTaskListBacklog(
+	ctx context.Context,
+	request *admin.MigrateTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*admin.MigrateTaskListBacklogResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientMigrateTaskListBacklogScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.AdminClientMigrateTaskListBacklogScope)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMigrateTaskListBacklogScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MigrateTaskListBacklog(ctx, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMigrateTaskListBacklogScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
@@ -93,3 +93,47 @@ func (c *retryableClient) GetWorkflowExecutionRawHistory(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) VerifyWorkflowExecutionHistory(
+	ctx context.Context,
+	request *admin.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*admin.VerifyWorkflowExecutionHistoryResponse, error) {
+
+	var resp *admin.VerifyWorkflowExecutionHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.VerifyWorkflowExecutionHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PromoteTaskListBuild(
+	ctx context.Context,
+	request *admin.PromoteTaskListBuildRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PromoteTaskListBuild(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) MigrateTaskListBacklog(
+	ctx context.Context,
+	request *admin.MigrateTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*admin.MigrateTaskListBacklogResponse, error) {
+
+	var resp *admin.MigrateTaskListBacklogResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MigrateTaskListBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
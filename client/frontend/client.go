@@ -572,6 +572,22 @@ func (c *clientImpl) StartWorkflowExecution(
 	return client.StartWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionBatchResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StartWorkflowExecutionBatch(ctx, request, opts...)
+}
+
 func (c *clientImpl) TerminateWorkflowExecution(
 	ctx context.Context,
 	request *shared.TerminateWorkflowExecutionRequest,
@@ -604,6 +620,22 @@ func (c *clientImpl) UpdateDomain(
 	return client.UpdateDomain(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createLongPollContext(ctx)
+	defer cancel()
+	return client.UpdateWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
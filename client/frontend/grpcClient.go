@@ -0,0 +1,475 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package frontend
+
+import (
+	"context"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common/proto"
+)
+
+var _ Client = (*grpcClient)(nil)
+
+type grpcClient struct {
+	client apiv1.WorkflowServiceYARPCClient
+}
+
+// NewGRPCClient creates a frontend client which talks to the frontend over gRPC,
+// translating the thrift requests and responses into their protobuf equivalents
+func NewGRPCClient(
+	client apiv1.WorkflowServiceYARPCClient,
+) Client {
+	return &grpcClient{
+		client: client,
+	}
+}
+
+func (c *grpcClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.CountWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.CountWorkflowExecutions(ctx, proto.FromCountWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToCountWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) DeprecateDomain(
+	ctx context.Context,
+	request *shared.DeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.DeprecateDomain(ctx, proto.FromDeprecateDomainRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeDomainResponse, error) {
+
+	response, err := c.client.DescribeDomain(ctx, proto.FromDescribeDomainRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToDescribeDomainResponse(response), nil
+}
+
+func (c *grpcClient) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeTaskListResponse, error) {
+
+	response, err := c.client.DescribeTaskList(ctx, proto.FromDescribeTaskListRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToDescribeTaskListResponse(response), nil
+}
+
+func (c *grpcClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	response, err := c.client.DescribeWorkflowExecution(ctx, proto.FromDescribeWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToDescribeWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	response, err := c.client.GetWorkflowExecutionHistory(ctx, proto.FromGetWorkflowExecutionHistoryRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToGetWorkflowExecutionHistoryResponse(response), nil
+}
+
+func (c *grpcClient) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListClosedWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.ListClosedWorkflowExecutions(ctx, proto.FromListClosedWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToListClosedWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDomainsResponse, error) {
+
+	response, err := c.client.ListDomains(ctx, proto.FromListDomainsRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToListDomainsResponse(response), nil
+}
+
+func (c *grpcClient) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.ListOpenWorkflowExecutions(ctx, proto.FromListOpenWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToListOpenWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) ListWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.ListWorkflowExecutions(ctx, proto.FromListWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToListWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) PollForActivityTask(
+	ctx context.Context,
+	request *shared.PollForActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.PollForActivityTaskResponse, error) {
+
+	response, err := c.client.PollForActivityTask(ctx, proto.FromPollForActivityTaskRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToPollForActivityTaskResponse(response), nil
+}
+
+func (c *grpcClient) PollForDecisionTask(
+	ctx context.Context,
+	request *shared.PollForDecisionTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.PollForDecisionTaskResponse, error) {
+
+	response, err := c.client.PollForDecisionTask(ctx, proto.FromPollForDecisionTaskRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToPollForDecisionTaskResponse(response), nil
+}
+
+func (c *grpcClient) QueryWorkflow(
+	ctx context.Context,
+	request *shared.QueryWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*shared.QueryWorkflowResponse, error) {
+
+	response, err := c.client.QueryWorkflow(ctx, proto.FromQueryWorkflowRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToQueryWorkflowResponse(response), nil
+}
+
+func (c *grpcClient) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := c.client.RecordActivityTaskHeartbeat(ctx, proto.FromRecordActivityTaskHeartbeatRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+func (c *grpcClient) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatByIDRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := c.client.RecordActivityTaskHeartbeatByID(ctx, proto.FromRecordActivityTaskHeartbeatByIDRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+func (c *grpcClient) RegisterDomain(
+	ctx context.Context,
+	request *shared.RegisterDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RegisterDomain(ctx, proto.FromRegisterDomainRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *shared.RequestCancelWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RequestCancelWorkflowExecution(ctx, proto.FromRequestCancelWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) ResetStickyTaskList(
+	ctx context.Context,
+	request *shared.ResetStickyTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetStickyTaskListResponse, error) {
+
+	response, err := c.client.ResetStickyTaskList(ctx, proto.FromResetStickyTaskListRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToResetStickyTaskListResponse(response), nil
+}
+
+func (c *grpcClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	response, err := c.client.ResetWorkflowExecution(ctx, proto.FromResetWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToResetWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskCanceled(ctx, proto.FromRespondActivityTaskCanceledRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskCanceledByID(ctx, proto.FromRespondActivityTaskCanceledByIDRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskCompleted(ctx, proto.FromRespondActivityTaskCompletedRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskCompletedByID(ctx, proto.FromRespondActivityTaskCompletedByIDRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskFailed(ctx, proto.FromRespondActivityTaskFailedRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondActivityTaskFailedByID(ctx, proto.FromRespondActivityTaskFailedByIDRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RespondDecisionTaskCompletedResponse, error) {
+
+	response, err := c.client.RespondDecisionTaskCompleted(ctx, proto.FromRespondDecisionTaskCompletedRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToRespondDecisionTaskCompletedResponse(response), nil
+}
+
+func (c *grpcClient) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondDecisionTaskFailed(ctx, proto.FromRespondDecisionTaskFailedRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.RespondQueryTaskCompleted(ctx, proto.FromRespondQueryTaskCompletedRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	response, err := c.client.ScanWorkflowExecutions(ctx, proto.FromListWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToListWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	response, err := c.client.SignalWithStartWorkflowExecution(ctx, proto.FromSignalWithStartWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToStartWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.SignalWorkflowExecution(ctx, proto.FromSignalWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	response, err := c.client.StartWorkflowExecution(ctx, proto.FromStartWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToStartWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionBatchResponse, error) {
+
+	response, err := c.client.StartWorkflowExecutionBatch(ctx, proto.FromStartWorkflowExecutionBatchRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToStartWorkflowExecutionBatchResponse(response), nil
+}
+
+func (c *grpcClient) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *shared.TerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	_, err := c.client.TerminateWorkflowExecution(ctx, proto.FromTerminateWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (c *grpcClient) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateDomainResponse, error) {
+
+	response, err := c.client.UpdateDomain(ctx, proto.FromUpdateDomainRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToUpdateDomainResponse(response), nil
+}
+
+func (c *grpcClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	response, err := c.client.UpdateWorkflowExecution(ctx, proto.FromUpdateWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return proto.ToUpdateWorkflowExecutionResponse(response), nil
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/tracing"
 	"go.uber.org/yarpc"
 )
 
@@ -49,10 +50,12 @@ func (c *metricClient) DeprecateDomain(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientDeprecateDomainScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientDeprecateDomainScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientDeprecateDomainScope, metrics.CadenceClientLatency)
 	err := c.client.DeprecateDomain(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientDeprecateDomainScope, metrics.CadenceClientFailures)
@@ -67,10 +70,12 @@ func (c *metricClient) DescribeDomain(
 ) (*shared.DescribeDomainResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientDescribeDomainScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientDescribeDomainScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientDescribeDomainScope, metrics.CadenceClientLatency)
 	resp, err := c.client.DescribeDomain(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientDescribeDomainScope, metrics.CadenceClientFailures)
@@ -85,10 +90,12 @@ func (c *metricClient) DescribeTaskList(
 ) (*shared.DescribeTaskListResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientDescribeTaskListScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientDescribeTaskListScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientDescribeTaskListScope, metrics.CadenceClientLatency)
 	resp, err := c.client.DescribeTaskList(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientDescribeTaskListScope, metrics.CadenceClientFailures)
@@ -103,10 +110,12 @@ func (c *metricClient) DescribeWorkflowExecution(
 ) (*shared.DescribeWorkflowExecutionResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientDescribeWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientDescribeWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientDescribeWorkflowExecutionScope, metrics.CadenceClientLatency)
 	resp, err := c.client.DescribeWorkflowExecution(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientDescribeWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -121,10 +130,12 @@ func (c *metricClient) GetWorkflowExecutionHistory(
 ) (*shared.GetWorkflowExecutionHistoryResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientGetWorkflowExecutionHistoryScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientGetWorkflowExecutionHistoryScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientGetWorkflowExecutionHistoryScope, metrics.CadenceClientLatency)
 	resp, err := c.client.GetWorkflowExecutionHistory(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientGetWorkflowExecutionHistoryScope, metrics.CadenceClientFailures)
@@ -139,10 +150,12 @@ func (c *metricClient) ListClosedWorkflowExecutions(
 ) (*shared.ListClosedWorkflowExecutionsResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientListClosedWorkflowExecutionsScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientListClosedWorkflowExecutionsScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientListClosedWorkflowExecutionsScope, metrics.CadenceClientLatency)
 	resp, err := c.client.ListClosedWorkflowExecutions(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientListClosedWorkflowExecutionsScope, metrics.CadenceClientFailures)
@@ -157,10 +170,12 @@ func (c *metricClient) ListDomains(
 ) (*shared.ListDomainsResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientListDomainsScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientListDomainsScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientListDomainsScope, metrics.CadenceClientLatency)
 	resp, err := c.client.ListDomains(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientListDomainsScope, metrics.CadenceClientFailures)
@@ -175,10 +190,12 @@ func (c *metricClient) ListOpenWorkflowExecutions(
 ) (*shared.ListOpenWorkflowExecutionsResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendListOpenWorkflowExecutionsScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendListOpenWorkflowExecutionsScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientListOpenWorkflowExecutionsScope, metrics.CadenceClientLatency)
 	resp, err := c.client.ListOpenWorkflowExecutions(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientListOpenWorkflowExecutionsScope, metrics.CadenceClientFailures)
@@ -193,10 +210,12 @@ func (c *metricClient) ListWorkflowExecutions(
 ) (*shared.ListWorkflowExecutionsResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendListWorkflowExecutionsScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendListWorkflowExecutionsScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendListWorkflowExecutionsScope, metrics.CadenceClientLatency)
 	resp, err := c.client.ListWorkflowExecutions(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendListWorkflowExecutionsScope, metrics.CadenceClientFailures)
@@ -211,10 +230,12 @@ func (c *metricClient) ScanWorkflowExecutions(
 ) (*shared.ListWorkflowExecutionsResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendScanWorkflowExecutionsScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendScanWorkflowExecutionsScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendScanWorkflowExecutionsScope, metrics.CadenceClientLatency)
 	resp, err := c.client.ListWorkflowExecutions(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendScanWorkflowExecutionsScope, metrics.CadenceClientFailures)
@@ -229,10 +250,12 @@ func (c *metricClient) CountWorkflowExecutions(
 ) (*shared.CountWorkflowExecutionsResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendCountWorkflowExecutionsScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendCountWorkflowExecutionsScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendCountWorkflowExecutionsScope, metrics.CadenceClientLatency)
 	resp, err := c.client.CountWorkflowExecutions(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendCountWorkflowExecutionsScope, metrics.CadenceClientFailures)
@@ -247,10 +270,12 @@ func (c *metricClient) PollForActivityTask(
 ) (*shared.PollForActivityTaskResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientPollForActivityTaskScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientPollForActivityTaskScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientPollForActivityTaskScope, metrics.CadenceClientLatency)
 	resp, err := c.client.PollForActivityTask(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientPollForActivityTaskScope, metrics.CadenceClientFailures)
@@ -265,10 +290,12 @@ func (c *metricClient) PollForDecisionTask(
 ) (*shared.PollForDecisionTaskResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientPollForDecisionTaskScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientPollForDecisionTaskScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientPollForDecisionTaskScope, metrics.CadenceClientLatency)
 	resp, err := c.client.PollForDecisionTask(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientPollForDecisionTaskScope, metrics.CadenceClientFailures)
@@ -283,10 +310,12 @@ func (c *metricClient) QueryWorkflow(
 ) (*shared.QueryWorkflowResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientQueryWorkflowScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientQueryWorkflowScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientQueryWorkflowScope, metrics.CadenceClientLatency)
 	resp, err := c.client.QueryWorkflow(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientQueryWorkflowScope, metrics.CadenceClientFailures)
@@ -301,10 +330,12 @@ func (c *metricClient) RecordActivityTaskHeartbeat(
 ) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRecordActivityTaskHeartbeatScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRecordActivityTaskHeartbeatScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRecordActivityTaskHeartbeatScope, metrics.CadenceClientLatency)
 	resp, err := c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRecordActivityTaskHeartbeatScope, metrics.CadenceClientFailures)
@@ -319,10 +350,12 @@ func (c *metricClient) RecordActivityTaskHeartbeatByID(
 ) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRecordActivityTaskHeartbeatByIDScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRecordActivityTaskHeartbeatByIDScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRecordActivityTaskHeartbeatByIDScope, metrics.CadenceClientLatency)
 	resp, err := c.client.RecordActivityTaskHeartbeatByID(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRecordActivityTaskHeartbeatByIDScope, metrics.CadenceClientFailures)
@@ -337,10 +370,12 @@ func (c *metricClient) RegisterDomain(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRegisterDomainScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRegisterDomainScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRegisterDomainScope, metrics.CadenceClientLatency)
 	err := c.client.RegisterDomain(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRegisterDomainScope, metrics.CadenceClientFailures)
@@ -355,10 +390,12 @@ func (c *metricClient) RequestCancelWorkflowExecution(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRequestCancelWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRequestCancelWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRequestCancelWorkflowExecutionScope, metrics.CadenceClientLatency)
 	err := c.client.RequestCancelWorkflowExecution(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRequestCancelWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -373,10 +410,12 @@ func (c *metricClient) ResetStickyTaskList(
 ) (*shared.ResetStickyTaskListResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientResetStickyTaskListScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientResetStickyTaskListScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientResetStickyTaskListScope, metrics.CadenceClientLatency)
 	resp, err := c.client.ResetStickyTaskList(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientResetStickyTaskListScope, metrics.CadenceClientFailures)
@@ -391,10 +430,12 @@ func (c *metricClient) ResetWorkflowExecution(
 ) (*shared.ResetWorkflowExecutionResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientResetWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientResetWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientResetWorkflowExecutionScope, metrics.CadenceClientLatency)
 	resp, err := c.client.ResetWorkflowExecution(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientResetWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -409,10 +450,12 @@ func (c *metricClient) RespondActivityTaskCanceled(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskCanceledScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRespondActivityTaskCanceledScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRespondActivityTaskCanceledScope, metrics.CadenceClientLatency)
 	err := c.client.RespondActivityTaskCanceled(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskCanceledScope, metrics.CadenceClientFailures)
@@ -427,10 +470,12 @@ func (c *metricClient) RespondActivityTaskCanceledByID(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskCanceledByIDScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRespondActivityTaskCanceledByIDScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRespondActivityTaskCanceledByIDScope, metrics.CadenceClientLatency)
 	err := c.client.RespondActivityTaskCanceledByID(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskCanceledByIDScope, metrics.CadenceClientFailures)
@@ -445,10 +490,12 @@ func (c *metricClient) RespondActivityTaskCompleted(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskCompletedScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRespondActivityTaskCompletedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRespondActivityTaskCompletedScope, metrics.CadenceClientLatency)
 	err := c.client.RespondActivityTaskCompleted(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskCompletedScope, metrics.CadenceClientFailures)
@@ -463,10 +510,12 @@ func (c *metricClient) RespondActivityTaskCompletedByID(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskCompletedByIDScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRespondActivityTaskCompletedByIDScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRespondActivityTaskCompletedByIDScope, metrics.CadenceClientLatency)
 	err := c.client.RespondActivityTaskCompletedByID(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskCompletedByIDScope, metrics.CadenceClientFailures)
@@ -481,10 +530,12 @@ func (c *metricClient) RespondActivityTaskFailed(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskFailedScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRespondActivityTaskFailedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRespondActivityTaskFailedScope, metrics.CadenceClientLatency)
 	err := c.client.RespondActivityTaskFailed(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskFailedScope, metrics.CadenceClientFailures)
@@ -499,10 +550,12 @@ func (c *metricClient) RespondActivityTaskFailedByID(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskFailedByIDScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRespondActivityTaskFailedByIDScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRespondActivityTaskFailedByIDScope, metrics.CadenceClientLatency)
 	err := c.client.RespondActivityTaskFailedByID(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRespondActivityTaskFailedByIDScope, metrics.CadenceClientFailures)
@@ -517,10 +570,12 @@ func (c *metricClient) RespondDecisionTaskCompleted(
 ) (*shared.RespondDecisionTaskCompletedResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRespondDecisionTaskCompletedScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRespondDecisionTaskCompletedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRespondDecisionTaskCompletedScope, metrics.CadenceClientLatency)
 	resp, err := c.client.RespondDecisionTaskCompleted(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRespondDecisionTaskCompletedScope, metrics.CadenceClientFailures)
@@ -535,10 +590,12 @@ func (c *metricClient) RespondDecisionTaskFailed(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRespondDecisionTaskFailedScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRespondDecisionTaskFailedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRespondDecisionTaskFailedScope, metrics.CadenceClientLatency)
 	err := c.client.RespondDecisionTaskFailed(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRespondDecisionTaskFailedScope, metrics.CadenceClientFailures)
@@ -553,10 +610,12 @@ func (c *metricClient) RespondQueryTaskCompleted(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientRespondQueryTaskCompletedScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientRespondQueryTaskCompletedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientRespondQueryTaskCompletedScope, metrics.CadenceClientLatency)
 	err := c.client.RespondQueryTaskCompleted(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientRespondQueryTaskCompletedScope, metrics.CadenceClientFailures)
@@ -571,10 +630,12 @@ func (c *metricClient) SignalWithStartWorkflowExecution(
 ) (*shared.StartWorkflowExecutionResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientSignalWithStartWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientSignalWithStartWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientSignalWithStartWorkflowExecutionScope, metrics.CadenceClientLatency)
 	resp, err := c.client.SignalWithStartWorkflowExecution(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientSignalWithStartWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -589,10 +650,12 @@ func (c *metricClient) SignalWorkflowExecution(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientSignalWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientSignalWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientSignalWorkflowExecutionScope, metrics.CadenceClientLatency)
 	err := c.client.SignalWorkflowExecution(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientSignalWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -607,10 +670,12 @@ func (c *metricClient) StartWorkflowExecution(
 ) (*shared.StartWorkflowExecutionResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientStartWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientStartWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientStartWorkflowExecutionScope, metrics.CadenceClientLatency)
 	resp, err := c.client.StartWorkflowExecution(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientStartWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -618,6 +683,26 @@ func (c *metricClient) StartWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionBatchResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientStartWorkflowExecutionBatchScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientStartWorkflowExecutionBatchScope)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientStartWorkflowExecutionBatchScope, metrics.CadenceClientLatency)
+	resp, err := c.client.StartWorkflowExecutionBatch(ctx, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientStartWorkflowExecutionBatchScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) TerminateWorkflowExecution(
 	ctx context.Context,
 	request *shared.TerminateWorkflowExecutionRequest,
@@ -625,10 +710,12 @@ func (c *metricClient) TerminateWorkflowExecution(
 ) error {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientTerminateWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientTerminateWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientTerminateWorkflowExecutionScope, metrics.CadenceClientLatency)
 	err := c.client.TerminateWorkflowExecution(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientTerminateWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -643,13 +730,35 @@ func (c *metricClient) UpdateDomain(
 ) (*shared.UpdateDomainResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientUpdateDomainScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientUpdateDomainScope)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateDomainScope, metrics.CadenceClientLatency)
 	resp, err := c.client.UpdateDomain(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.FrontendClientUpdateDomainScope, metrics.CadenceClientFailures)
 	}
 	return resp, err
 }
+
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.FrontendClientUpdateWorkflowExecutionScope)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
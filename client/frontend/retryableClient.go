@@ -510,6 +510,22 @@ func (c *retryableClient) StartWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionBatchResponse, error) {
+
+	var resp *shared.StartWorkflowExecutionBatchResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartWorkflowExecutionBatch(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) TerminateWorkflowExecution(
 	ctx context.Context,
 	request *shared.TerminateWorkflowExecutionRequest,
@@ -537,3 +553,19 @@ func (c *retryableClient) UpdateDomain(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	var resp *shared.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -22,6 +22,7 @@ package history
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
@@ -83,6 +84,34 @@ func (c *clientImpl) StartWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	request *h.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption) (*h.StartWorkflowExecutionBatchResponse, error) {
+	if len(request.StartRequests) == 0 {
+		return &h.StartWorkflowExecutionBatchResponse{}, nil
+	}
+	// all the workflowIDs of a batch belong to the same shard
+	client, err := c.getClientForWorkflowID(request.StartRequests[0].StartRequest.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.StartWorkflowExecutionBatchResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.StartWorkflowExecutionBatch(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) GetMutableState(
 	ctx context.Context,
 	request *h.GetMutableStateRequest,
@@ -170,6 +199,30 @@ func (c *clientImpl) DescribeMutableState(
 	return response, nil
 }
 
+func (c *clientImpl) VerifyWorkflowExecutionHistory(
+	ctx context.Context,
+	request *h.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption) (*h.VerifyWorkflowExecutionHistoryResponse, error) {
+	client, err := c.getClientForWorkflowID(*request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.VerifyWorkflowExecutionHistoryResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.VerifyWorkflowExecutionHistory(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ResetStickyTaskList(
 	ctx context.Context,
 	request *h.ResetStickyTaskListRequest,
@@ -442,6 +495,30 @@ func (c *clientImpl) SignalWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*workflow.UpdateWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(*request.UpdateRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.UpdateWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		// update blocks until the worker responds, so it is bounded by the
+		// caller's deadline rather than the default client timeout
+		response, err = client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *h.SignalWithStartWorkflowExecutionRequest,
@@ -638,6 +715,110 @@ func (c *clientImpl) SyncActivity(
 	return err
 }
 
+// GetRunningWorkflowCounts returns the number of running executions per workflow type of a domain.
+// When no shard IDs are given, the counts of all shards are aggregated by fanning out to every history host.
+// Shards which could not be counted are retried once and are otherwise missing from the response shard IDs.
+func (c *clientImpl) GetRunningWorkflowCounts(
+	ctx context.Context,
+	request *h.GetRunningWorkflowCountsRequest,
+	opts ...yarpc.CallOption) (*h.GetRunningWorkflowCountsResponse, error) {
+
+	shardIDs := request.ShardIDs
+	if len(shardIDs) == 0 {
+		for shardID := 0; shardID < c.numberOfShards; shardID++ {
+			shardIDs = append(shardIDs, int32(shardID))
+		}
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	result := &h.GetRunningWorkflowCountsResponse{
+		Counts: make(map[string]int64),
+	}
+	remaining := shardIDs
+	var lastErr error
+	for attempt := 0; attempt < 2 && len(remaining) > 0; attempt++ {
+		counted, err := c.getRunningWorkflowCounts(ctx, request.GetDomainUUID(), remaining, result.Counts, opts...)
+		if err != nil {
+			lastErr = err
+		}
+		result.ShardIDs = append(result.ShardIDs, counted...)
+		remaining = subtractShardIDs(remaining, counted)
+	}
+	if len(result.ShardIDs) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return result, nil
+}
+
+// getRunningWorkflowCounts groups the shards by their owning history host, queries each host concurrently
+// and merges the counts into the given map, returning the shards which were counted
+func (c *clientImpl) getRunningWorkflowCounts(
+	ctx context.Context,
+	domainID string,
+	shardIDs []int32,
+	counts map[string]int64,
+	opts ...yarpc.CallOption) ([]int32, error) {
+
+	shardIDsByHost := make(map[string][]int32)
+	for _, shardID := range shardIDs {
+		host, err := c.clients.GetClientKeyForKey(string(int(shardID)))
+		if err != nil {
+			return nil, err
+		}
+		shardIDsByHost[host] = append(shardIDsByHost[host], shardID)
+	}
+
+	var lock sync.Mutex
+	var lastErr error
+	var counted []int32
+	var wg sync.WaitGroup
+	for host, hostShardIDs := range shardIDsByHost {
+		wg.Add(1)
+		go func(host string, hostShardIDs []int32) {
+			defer wg.Done()
+
+			var response *h.GetRunningWorkflowCountsResponse
+			client, err := c.clients.GetClientForClientKey(host)
+			if err == nil {
+				ctx, cancel := c.createContext(ctx)
+				defer cancel()
+				response, err = client.(historyserviceclient.Interface).GetRunningWorkflowCounts(ctx, &h.GetRunningWorkflowCountsRequest{
+					DomainUUID: common.StringPtr(domainID),
+					ShardIDs:   hostShardIDs,
+				}, opts...)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				lastErr = err
+				return
+			}
+			for workflowType, count := range response.Counts {
+				counts[workflowType] += count
+			}
+			counted = append(counted, response.ShardIDs...)
+		}(host, hostShardIDs)
+	}
+	wg.Wait()
+
+	return counted, lastErr
+}
+
+func subtractShardIDs(shardIDs []int32, toRemove []int32) []int32 {
+	removed := make(map[int32]struct{}, len(toRemove))
+	for _, shardID := range toRemove {
+		removed[shardID] = struct{}{}
+	}
+	var result []int32
+	for _, shardID := range shardIDs {
+		if _, ok := removed[shardID]; !ok {
+			result = append(result, shardID)
+		}
+	}
+	return result
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
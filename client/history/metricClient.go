@@ -26,6 +26,7 @@ import (
 	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/tracing"
 	"go.uber.org/yarpc"
 )
 
@@ -49,10 +50,12 @@ func (c *metricClient) StartWorkflowExecution(
 	request *h.StartWorkflowExecutionRequest,
 	opts ...yarpc.CallOption) (*shared.StartWorkflowExecutionResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientStartWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientStartWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientStartWorkflowExecutionScope, metrics.CadenceClientLatency)
 	resp, err := c.client.StartWorkflowExecution(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientStartWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -61,6 +64,25 @@ func (c *metricClient) StartWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) StartWorkflowExecutionBatch(
+	context context.Context,
+	request *h.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption) (*h.StartWorkflowExecutionBatchResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientStartWorkflowExecutionBatchScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientStartWorkflowExecutionBatchScope)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientStartWorkflowExecutionBatchScope, metrics.CadenceClientLatency)
+	resp, err := c.client.StartWorkflowExecutionBatch(context, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientStartWorkflowExecutionBatchScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) DescribeHistoryHost(
 	context context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -79,15 +101,36 @@ func (c *metricClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *metricClient) VerifyWorkflowExecutionHistory(
+	context context.Context,
+	request *h.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption) (*h.VerifyWorkflowExecutionHistoryResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientVerifyWorkflowExecutionHistoryScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientVerifyWorkflowExecutionHistoryScope)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientVerifyWorkflowExecutionHistoryScope, metrics.CadenceClientLatency)
+	resp, err := c.client.VerifyWorkflowExecutionHistory(context, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientVerifyWorkflowExecutionHistoryScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) GetMutableState(
 	context context.Context,
 	request *h.GetMutableStateRequest,
 	opts ...yarpc.CallOption) (*h.GetMutableStateResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientGetMutableStateScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientGetMutableStateScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetMutableStateScope, metrics.CadenceClientLatency)
 	resp, err := c.client.GetMutableState(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientGetMutableStateScope, metrics.CadenceClientFailures)
@@ -101,10 +144,12 @@ func (c *metricClient) ResetStickyTaskList(
 	request *h.ResetStickyTaskListRequest,
 	opts ...yarpc.CallOption) (*h.ResetStickyTaskListResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientResetStickyTaskListScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientResetStickyTaskListScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetStickyTaskListScope, metrics.CadenceClientLatency)
 	resp, err := c.client.ResetStickyTaskList(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientResetStickyTaskListScope, metrics.CadenceClientFailures)
@@ -118,10 +163,12 @@ func (c *metricClient) DescribeWorkflowExecution(
 	request *h.DescribeWorkflowExecutionRequest,
 	opts ...yarpc.CallOption) (*shared.DescribeWorkflowExecutionResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientDescribeWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientDescribeWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeWorkflowExecutionScope, metrics.CadenceClientLatency)
 	resp, err := c.client.DescribeWorkflowExecution(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientDescribeWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -135,10 +182,12 @@ func (c *metricClient) RecordDecisionTaskStarted(
 	request *h.RecordDecisionTaskStartedRequest,
 	opts ...yarpc.CallOption) (*h.RecordDecisionTaskStartedResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientRecordDecisionTaskStartedScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRecordDecisionTaskStartedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRecordDecisionTaskStartedScope, metrics.CadenceClientLatency)
 	resp, err := c.client.RecordDecisionTaskStarted(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRecordDecisionTaskStartedScope, metrics.CadenceClientFailures)
@@ -152,10 +201,12 @@ func (c *metricClient) RecordActivityTaskStarted(
 	request *h.RecordActivityTaskStartedRequest,
 	opts ...yarpc.CallOption) (*h.RecordActivityTaskStartedResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientRecordActivityTaskStartedScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRecordActivityTaskStartedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRecordActivityTaskStartedScope, metrics.CadenceClientLatency)
 	resp, err := c.client.RecordActivityTaskStarted(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRecordActivityTaskStartedScope, metrics.CadenceClientFailures)
@@ -169,10 +220,12 @@ func (c *metricClient) RespondDecisionTaskCompleted(
 	request *h.RespondDecisionTaskCompletedRequest,
 	opts ...yarpc.CallOption) (*h.RespondDecisionTaskCompletedResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientRespondDecisionTaskCompletedScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRespondDecisionTaskCompletedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRespondDecisionTaskCompletedScope, metrics.CadenceClientLatency)
 	response, err := c.client.RespondDecisionTaskCompleted(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRespondDecisionTaskCompletedScope, metrics.CadenceClientFailures)
@@ -186,10 +239,12 @@ func (c *metricClient) RespondDecisionTaskFailed(
 	request *h.RespondDecisionTaskFailedRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientRespondDecisionTaskFailedScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRespondDecisionTaskFailedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRespondDecisionTaskFailedScope, metrics.CadenceClientLatency)
 	err := c.client.RespondDecisionTaskFailed(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRespondDecisionTaskFailedScope, metrics.CadenceClientFailures)
@@ -203,10 +258,12 @@ func (c *metricClient) RespondActivityTaskCompleted(
 	request *h.RespondActivityTaskCompletedRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientRespondActivityTaskCompletedScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRespondActivityTaskCompletedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRespondActivityTaskCompletedScope, metrics.CadenceClientLatency)
 	err := c.client.RespondActivityTaskCompleted(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRespondActivityTaskCompletedScope, metrics.CadenceClientFailures)
@@ -220,10 +277,12 @@ func (c *metricClient) RespondActivityTaskFailed(
 	request *h.RespondActivityTaskFailedRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientRespondActivityTaskFailedScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRespondActivityTaskFailedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRespondActivityTaskFailedScope, metrics.CadenceClientLatency)
 	err := c.client.RespondActivityTaskFailed(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRespondActivityTaskFailedScope, metrics.CadenceClientFailures)
@@ -237,10 +296,12 @@ func (c *metricClient) RespondActivityTaskCanceled(
 	request *h.RespondActivityTaskCanceledRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientRespondActivityTaskCanceledScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRespondActivityTaskCanceledScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRespondActivityTaskCanceledScope, metrics.CadenceClientLatency)
 	err := c.client.RespondActivityTaskCanceled(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRespondActivityTaskCanceledScope, metrics.CadenceClientFailures)
@@ -254,10 +315,12 @@ func (c *metricClient) RecordActivityTaskHeartbeat(
 	request *h.RecordActivityTaskHeartbeatRequest,
 	opts ...yarpc.CallOption) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientRecordActivityTaskHeartbeatScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRecordActivityTaskHeartbeatScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRecordActivityTaskHeartbeatScope, metrics.CadenceClientLatency)
 	resp, err := c.client.RecordActivityTaskHeartbeat(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRecordActivityTaskHeartbeatScope, metrics.CadenceClientFailures)
@@ -271,10 +334,12 @@ func (c *metricClient) RequestCancelWorkflowExecution(
 	request *h.RequestCancelWorkflowExecutionRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientRequestCancelWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRequestCancelWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRequestCancelWorkflowExecutionScope, metrics.CadenceClientLatency)
 	err := c.client.RequestCancelWorkflowExecution(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRequestCancelWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -288,10 +353,12 @@ func (c *metricClient) SignalWorkflowExecution(
 	request *h.SignalWorkflowExecutionRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientSignalWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientSignalWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientSignalWorkflowExecutionScope, metrics.CadenceClientLatency)
 	err := c.client.SignalWorkflowExecution(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientSignalWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -300,15 +367,36 @@ func (c *metricClient) SignalWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) UpdateWorkflowExecution(
+	context context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.UpdateWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientUpdateWorkflowExecutionScope)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(context, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) SignalWithStartWorkflowExecution(
 	context context.Context,
 	request *h.SignalWithStartWorkflowExecutionRequest,
 	opts ...yarpc.CallOption) (*shared.StartWorkflowExecutionResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientSignalWithStartWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceClientLatency)
 	resp, err := c.client.SignalWithStartWorkflowExecution(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -322,10 +410,12 @@ func (c *metricClient) RemoveSignalMutableState(
 	request *h.RemoveSignalMutableStateRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientRemoveSignalMutableStateScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRemoveSignalMutableStateScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRemoveSignalMutableStateScope, metrics.CadenceClientLatency)
 	err := c.client.RemoveSignalMutableState(context, request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRemoveSignalMutableStateScope, metrics.CadenceClientFailures)
@@ -339,10 +429,12 @@ func (c *metricClient) TerminateWorkflowExecution(
 	request *h.TerminateWorkflowExecutionRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientTerminateWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientTerminateWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientTerminateWorkflowExecutionScope, metrics.CadenceClientLatency)
 	err := c.client.TerminateWorkflowExecution(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientTerminateWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -356,10 +448,12 @@ func (c *metricClient) ResetWorkflowExecution(
 	request *h.ResetWorkflowExecutionRequest,
 	opts ...yarpc.CallOption) (*shared.ResetWorkflowExecutionResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientResetWorkflowExecutionScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceClientLatency)
 	resp, err := c.client.ResetWorkflowExecution(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceClientFailures)
@@ -373,10 +467,12 @@ func (c *metricClient) ScheduleDecisionTask(
 	request *h.ScheduleDecisionTaskRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientScheduleDecisionTaskScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientScheduleDecisionTaskScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientScheduleDecisionTaskScope, metrics.CadenceClientLatency)
 	err := c.client.ScheduleDecisionTask(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientScheduleDecisionTaskScope, metrics.CadenceClientFailures)
@@ -390,10 +486,12 @@ func (c *metricClient) RecordChildExecutionCompleted(
 	request *h.RecordChildExecutionCompletedRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientRecordChildExecutionCompletedScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientRecordChildExecutionCompletedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRecordChildExecutionCompletedScope, metrics.CadenceClientLatency)
 	err := c.client.RecordChildExecutionCompleted(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRecordChildExecutionCompletedScope, metrics.CadenceClientFailures)
@@ -407,10 +505,12 @@ func (c *metricClient) ReplicateEvents(
 	request *h.ReplicateEventsRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientReplicateEventsScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientReplicateEventsScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientReplicateEventsScope, metrics.CadenceClientLatency)
 	err := c.client.ReplicateEvents(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientReplicateEventsScope, metrics.CadenceClientFailures)
@@ -424,10 +524,12 @@ func (c *metricClient) ReplicateRawEvents(
 	request *h.ReplicateRawEventsRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientReplicateRawEventsScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientReplicateRawEventsScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientReplicateRawEventsScope, metrics.CadenceClientLatency)
 	err := c.client.ReplicateRawEvents(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientReplicateRawEventsScope, metrics.CadenceClientFailures)
@@ -441,10 +543,12 @@ func (c *metricClient) SyncShardStatus(
 	request *h.SyncShardStatusRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientSyncShardStatusScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientSyncShardStatusScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientSyncShardStatusScope, metrics.CadenceClientLatency)
 	err := c.client.SyncShardStatus(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientSyncShardStatusScope, metrics.CadenceClientFailures)
@@ -458,10 +562,12 @@ func (c *metricClient) SyncActivity(
 	request *h.SyncActivityRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientSyncActivityScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientSyncActivityScope)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientSyncActivityScope, metrics.CadenceClientLatency)
 	err := c.client.SyncActivity(context, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientSyncActivityScope, metrics.CadenceClientFailures)
@@ -469,3 +575,22 @@ func (c *metricClient) SyncActivity(
 
 	return err
 }
+
+func (c *metricClient) GetRunningWorkflowCounts(
+	context context.Context,
+	request *h.GetRunningWorkflowCountsRequest,
+	opts ...yarpc.CallOption) (*h.GetRunningWorkflowCountsResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetRunningWorkflowCountsScope, metrics.CadenceClientRequests)
+	span, context := tracing.StartSpan(context, metrics.HistoryClientGetRunningWorkflowCountsScope)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetRunningWorkflowCountsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetRunningWorkflowCounts(context, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetRunningWorkflowCountsScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
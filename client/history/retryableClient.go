@@ -62,6 +62,22 @@ func (c *retryableClient) StartWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	request *h.StartWorkflowExecutionBatchRequest,
+	opts ...yarpc.CallOption) (*h.StartWorkflowExecutionBatchResponse, error) {
+
+	var resp *h.StartWorkflowExecutionBatchResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartWorkflowExecutionBatch(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -94,6 +110,22 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) VerifyWorkflowExecutionHistory(
+	ctx context.Context,
+	request *h.VerifyWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption) (*h.VerifyWorkflowExecutionHistoryResponse, error) {
+
+	var resp *h.VerifyWorkflowExecutionHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.VerifyWorkflowExecutionHistory(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetMutableState(
 	ctx context.Context,
 	request *h.GetMutableStateRequest,
@@ -278,6 +310,22 @@ func (c *retryableClient) SignalWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	var resp *shared.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *h.SignalWithStartWorkflowExecutionRequest,
@@ -405,3 +453,19 @@ func (c *retryableClient) SyncActivity(
 
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
+
+func (c *retryableClient) GetRunningWorkflowCounts(
+	ctx context.Context,
+	request *h.GetRunningWorkflowCountsRequest,
+	opts ...yarpc.CallOption) (*h.GetRunningWorkflowCountsResponse, error) {
+
+	var resp *h.GetRunningWorkflowCountsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetRunningWorkflowCounts(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -159,6 +159,40 @@ func (c *clientImpl) DescribeTaskList(ctx context.Context, request *m.DescribeTa
 	return client.DescribeTaskList(ctx, request, opts...)
 }
 
+func (c *clientImpl) AcquireDispatchTokens(ctx context.Context, request *m.AcquireDispatchTokensRequest, opts ...yarpc.CallOption) (*m.AcquireDispatchTokensResponse, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	// the dispatch budget of an activity type is owned by the matching host the domain and activity type hash to
+	client, err := c.getClientForTasklist(request.GetDomainUUID() + "/" + request.GetActivityType())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.AcquireDispatchTokens(ctx, request, opts...)
+}
+
+func (c *clientImpl) PromoteTaskListBuild(ctx context.Context, request *m.PromoteTaskListBuildRequest, opts ...yarpc.CallOption) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTasklist(request.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PromoteTaskListBuild(ctx, request, opts...)
+}
+
+func (c *clientImpl) MigrateTaskListBacklog(ctx context.Context, request *m.MigrateTaskListBacklogRequest, opts ...yarpc.CallOption) (*m.MigrateTaskListBacklogResponse, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTasklist(request.SourceTaskList.GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MigrateTaskListBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -26,6 +26,7 @@ import (
 	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/tracing"
 	"go.uber.org/yarpc"
 )
 
@@ -49,10 +50,12 @@ func (c *metricClient) AddActivityTask(
 	addRequest *m.AddActivityTaskRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.MatchingClientAddActivityTaskScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientAddActivityTaskScope)
 
 	sw := c.metricsClient.StartTimer(metrics.MatchingClientAddActivityTaskScope, metrics.CadenceClientLatency)
 	err := c.client.AddActivityTask(ctx, addRequest, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.MatchingClientAddActivityTaskScope, metrics.CadenceClientFailures)
@@ -66,10 +69,12 @@ func (c *metricClient) AddDecisionTask(
 	addRequest *m.AddDecisionTaskRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.MatchingClientAddDecisionTaskScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientAddDecisionTaskScope)
 
 	sw := c.metricsClient.StartTimer(metrics.MatchingClientAddDecisionTaskScope, metrics.CadenceClientLatency)
 	err := c.client.AddDecisionTask(ctx, addRequest, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.MatchingClientAddDecisionTaskScope, metrics.CadenceClientFailures)
@@ -83,10 +88,12 @@ func (c *metricClient) PollForActivityTask(
 	pollRequest *m.PollForActivityTaskRequest,
 	opts ...yarpc.CallOption) (*workflow.PollForActivityTaskResponse, error) {
 	c.metricsClient.IncCounter(metrics.MatchingClientPollForActivityTaskScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientPollForActivityTaskScope)
 
 	sw := c.metricsClient.StartTimer(metrics.MatchingClientPollForActivityTaskScope, metrics.CadenceClientLatency)
 	resp, err := c.client.PollForActivityTask(ctx, pollRequest, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.MatchingClientPollForActivityTaskScope, metrics.CadenceClientFailures)
@@ -100,10 +107,12 @@ func (c *metricClient) PollForDecisionTask(
 	pollRequest *m.PollForDecisionTaskRequest,
 	opts ...yarpc.CallOption) (*m.PollForDecisionTaskResponse, error) {
 	c.metricsClient.IncCounter(metrics.MatchingClientPollForDecisionTaskScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientPollForDecisionTaskScope)
 
 	sw := c.metricsClient.StartTimer(metrics.MatchingClientPollForDecisionTaskScope, metrics.CadenceClientLatency)
 	resp, err := c.client.PollForDecisionTask(ctx, pollRequest, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.MatchingClientPollForDecisionTaskScope, metrics.CadenceClientFailures)
@@ -117,10 +126,12 @@ func (c *metricClient) QueryWorkflow(
 	queryRequest *m.QueryWorkflowRequest,
 	opts ...yarpc.CallOption) (*workflow.QueryWorkflowResponse, error) {
 	c.metricsClient.IncCounter(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientQueryWorkflowScope)
 
 	sw := c.metricsClient.StartTimer(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceClientLatency)
 	resp, err := c.client.QueryWorkflow(ctx, queryRequest, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceClientFailures)
@@ -134,10 +145,12 @@ func (c *metricClient) RespondQueryTaskCompleted(
 	request *m.RespondQueryTaskCompletedRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientRespondQueryTaskCompletedScope)
 
 	sw := c.metricsClient.StartTimer(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceClientLatency)
 	err := c.client.RespondQueryTaskCompleted(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceClientFailures)
@@ -151,10 +164,12 @@ func (c *metricClient) CancelOutstandingPoll(
 	request *m.CancelOutstandingPollRequest,
 	opts ...yarpc.CallOption) error {
 	c.metricsClient.IncCounter(metrics.MatchingClientCancelOutstandingPollScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientCancelOutstandingPollScope)
 
 	sw := c.metricsClient.StartTimer(metrics.MatchingClientCancelOutstandingPollScope, metrics.CadenceClientLatency)
 	err := c.client.CancelOutstandingPoll(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.MatchingClientCancelOutstandingPollScope, metrics.CadenceClientFailures)
@@ -163,15 +178,74 @@ func (c *metricClient) CancelOutstandingPoll(
 	return err
 }
 
+func (c *metricClient) PromoteTaskListBuild(
+	ctx context.Context,
+	request *m.PromoteTaskListBuildRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientPromoteTaskListBuildScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientPromoteTaskListBuildScope)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientPromoteTaskListBuildScope, metrics.CadenceClientLatency)
+	err := c.client.PromoteTaskListBuild(ctx, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientPromoteTaskListBuildScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) MigrateTaskListBacklog(
+	ctx context.Context,
+	request *m.MigrateTaskListBacklogRequest,
+	opts ...yarpc.CallOption) (*m.MigrateTaskListBacklogResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientMigrateTaskListBacklogScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientMigrateTaskListBacklogScope)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientMigrateTaskListBacklogScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MigrateTaskListBacklog(ctx, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientMigrateTaskListBacklogScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) AcquireDispatchTokens(
+	ctx context.Context,
+	request *m.AcquireDispatchTokensRequest,
+	opts ...yarpc.CallOption) (*m.AcquireDispatchTokensResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientAcquireDispatchTokensScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientAcquireDispatchTokensScope)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientAcquireDispatchTokensScope, metrics.CadenceClientLatency)
+	resp, err := c.client.AcquireDispatchTokens(ctx, request, opts...)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientAcquireDispatchTokensScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) DescribeTaskList(
 	ctx context.Context,
 	request *m.DescribeTaskListRequest,
 	opts ...yarpc.CallOption) (*workflow.DescribeTaskListResponse, error) {
 	c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListScope, metrics.CadenceClientRequests)
+	span, ctx := tracing.StartSpan(ctx, metrics.MatchingClientDescribeTaskListScope)
 
 	sw := c.metricsClient.StartTimer(metrics.MatchingClientDescribeTaskListScope, metrics.CadenceClientLatency)
 	resp, err := c.client.DescribeTaskList(ctx, request, opts...)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListScope, metrics.CadenceClientFailures)
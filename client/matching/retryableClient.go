@@ -141,6 +141,50 @@ func (c *retryableClient) CancelOutstandingPoll(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) PromoteTaskListBuild(
+	ctx context.Context,
+	request *m.PromoteTaskListBuildRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.PromoteTaskListBuild(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) MigrateTaskListBacklog(
+	ctx context.Context,
+	request *m.MigrateTaskListBacklogRequest,
+	opts ...yarpc.CallOption) (*m.MigrateTaskListBacklogResponse, error) {
+
+	var resp *m.MigrateTaskListBacklogResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MigrateTaskListBacklog(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) AcquireDispatchTokens(
+	ctx context.Context,
+	request *m.AcquireDispatchTokensRequest,
+	opts ...yarpc.CallOption) (*m.AcquireDispatchTokensResponse, error) {
+
+	var resp *m.AcquireDispatchTokensResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.AcquireDispatchTokens(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskList(
 	ctx context.Context,
 	request *m.DescribeTaskListRequest,
@@ -23,8 +23,12 @@ package main
 import (
 	"log"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/tools/cassandra"
 
@@ -59,6 +63,12 @@ func startHandler(c *cli.Context) {
 		log.Fatalf("config validation failed: %v", err)
 	}
 
+	tracer, tracerCloser, err := cfg.Tracing.NewTracer()
+	if err != nil {
+		log.Fatalf("error creating tracer: %v", err)
+	}
+	opentracing.SetGlobalTracer(tracer)
+
 	dir, err := os.Getwd()
 	if err != nil {
 		log.Fatal("Unable to get current directory")
@@ -68,15 +78,26 @@ func startHandler(c *cli.Context) {
 	}
 
 	services := getServices(c)
+	var daemons []common.Daemon
 	for _, svc := range services {
 		if _, ok := cfg.Services[svc]; !ok {
 			log.Fatalf("`%v` service missing config", svc)
 		}
 		server := newServer(svc, &cfg)
 		server.Start()
+		daemons = append(daemons, server)
 	}
 
-	select {}
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-sigc
+	log.Printf("Received signal %v, stopping services\n", sig)
+	for _, daemon := range daemons {
+		daemon.Stop()
+	}
+	if err := tracerCloser.Close(); err != nil {
+		log.Printf("error closing tracer: %v", err)
+	}
 }
 
 func getEnvironment(c *cli.Context) string {
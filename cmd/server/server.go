@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -108,11 +109,6 @@ func (s *server) startService() common.Daemon {
 	params.Logger = loggerimpl.NewLogger(s.cfg.Log.NewZapLogger())
 	params.PersistenceConfig = s.cfg.Persistence
 
-	params.MembershipFactory, err = s.cfg.Ringpop.NewFactory(params.Logger, params.Name)
-	if err != nil {
-		log.Fatalf("error creating ringpop factory: %v", err)
-	}
-
 	params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger.WithTags(tag.Service(params.Name)), s.doneC)
 	if err != nil {
 		log.Printf("error creating file based dynamic config client, use no-op config client instead. error: %v", err)
@@ -152,6 +148,12 @@ func (s *server) startService() common.Daemon {
 		s.cfg.Archival.DefaultBucket,
 		enableReadFromArchival,
 	)
+
+	params.MembershipFactory, err = s.newMembershipFactory(&params)
+	if err != nil {
+		log.Fatalf("error creating membership factory: %v", err)
+	}
+
 	params.DispatcherProvider = client.NewIPYarpcDispatcherProvider()
 	params.ESConfig = &s.cfg.ElasticSearch
 	params.ESConfig.Enable = dc.GetBoolProperty(dynamicconfig.EnableVisibilityToKafka, params.ESConfig.Enable)() // force override with dynamic config
@@ -210,6 +212,34 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// newMembershipFactory returns the membership monitor factory of the configured provider
+func (s *server) newMembershipFactory(params *service.BootstrapParams) (service.MembershipMonitorFactory, error) {
+	provider, err := s.cfg.Membership.GetProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	if provider == config.MembershipProviderPersistence {
+		pFactory := persistencefactory.New(
+			&params.PersistenceConfig,
+			params.ClusterMetadata.GetCurrentClusterName(),
+			params.MetricsClient,
+			params.Logger,
+		)
+		clusterMembershipManager, err := pFactory.NewClusterMembershipManager()
+		if err != nil {
+			return nil, err
+		}
+		return s.cfg.Membership.Heartbeat.NewFactory(
+			service.NewPersistenceHeartbeatStore(clusterMembershipManager),
+			params.Logger,
+			params.Name,
+		)
+	}
+
+	return s.cfg.Ringpop.NewFactory(params.Logger, params.Name)
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
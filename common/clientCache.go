@@ -29,6 +29,7 @@ type (
 	ClientCache interface {
 		GetClientForKey(key string) (interface{}, error)
 		GetClientForClientKey(clientKey string) (interface{}, error)
+		GetClientKeyForKey(key string) (string, error)
 	}
 
 	keyResolver    func(string) (string, error)
@@ -66,6 +67,10 @@ func (c *clientCacheImpl) GetClientForKey(key string) (interface{}, error) {
 	return c.GetClientForClientKey(clientKey)
 }
 
+func (c *clientCacheImpl) GetClientKeyForKey(key string) (string, error) {
+	return c.keyResolver(key)
+}
+
 func (c *clientCacheImpl) GetClientForClientKey(clientKey string) (interface{}, error) {
 	c.cacheLock.RLock()
 	client, ok := c.clients[clientKey]
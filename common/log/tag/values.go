@@ -35,6 +35,7 @@ var (
 	WorkflowActionWorkflowCancelRequested = workflowAction("add-workflow-cancel-requested-event")
 	WorkflowActionWorkflowSignaled        = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker    = workflowAction("add-workflow-marker-record-event")
+	WorkflowActionWorkflowUpdateAccepted  = workflowAction("add-workflow-update-accepted-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -42,6 +43,7 @@ var (
 	WorkflowActionDecisionTaskCompleted = workflowAction("add-decisiontask-completed-event")
 	WorkflowActionDecisionTaskTimedOut  = workflowAction("add-decisiontask-timedout-event")
 	WorkflowActionDecisionTaskFailed    = workflowAction("add-decisiontask-failed-event")
+	WorkflowActionDecisionTaskHeartbeat = workflowAction("heartbeat-decisiontask")
 
 	// activity
 	WorkflowActionActivityTaskScheduled       = workflowAction("add-activitytask-scheduled-event")
@@ -115,6 +117,8 @@ var (
 	ComponentArchiver                 = component("archiver")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
+	ComponentParentClosePolicy        = component("parent-close-policy")
+	ComponentHistoryVerifier          = component("history-verifier")
 )
 
 // Pre-defined values for TagSysLifecycle
@@ -0,0 +1,195 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	// HeartbeatOptions contains the timing of the heartbeat based membership provider
+	HeartbeatOptions struct {
+		// HeartbeatInterval is how often a host records its heartbeat
+		HeartbeatInterval time.Duration
+		// HeartbeatTTL is how long a host is considered alive after its last heartbeat
+		HeartbeatTTL time.Duration
+		// RefreshInterval is how often the hosts of every service are reloaded
+		RefreshInterval time.Duration
+		// PruneInterval is how often the records of dead hosts are removed from the store
+		PruneInterval time.Duration
+	}
+
+	heartbeatMonitor struct {
+		status       int32
+		services     []string
+		serviceName  string
+		hostInfo     *HostInfo
+		sessionStart time.Time
+		store        HeartbeatStore
+		options      *HeartbeatOptions
+		rings        map[string]*heartbeatServiceResolver
+		logger       log.Logger
+		shutdownCh   chan struct{}
+		shutdownWG   sync.WaitGroup
+	}
+)
+
+var _ Monitor = (*heartbeatMonitor)(nil)
+
+// NewHeartbeatMonitor returns a membership monitor which discovers the hosts through
+// the heartbeats recorded in the given store and hashes keys to hosts with a consistent
+// hash ring, the same way the ringpop based monitor does
+func NewHeartbeatMonitor(
+	services []string,
+	serviceName string,
+	address string,
+	store HeartbeatStore,
+	options *HeartbeatOptions,
+	logger log.Logger,
+) Monitor {
+	labels := map[string]string{RoleKey: serviceName}
+	hbm := &heartbeatMonitor{
+		services:     services,
+		serviceName:  serviceName,
+		hostInfo:     NewHostInfo(address, labels),
+		sessionStart: time.Now(),
+		store:        store,
+		options:      options,
+		rings:        make(map[string]*heartbeatServiceResolver),
+		logger:       logger,
+		shutdownCh:   make(chan struct{}),
+	}
+	for _, service := range services {
+		hbm.rings[service] = newHeartbeatServiceResolver(service, store, options, logger)
+	}
+	return hbm
+}
+
+func (hbm *heartbeatMonitor) Start() error {
+	if !atomic.CompareAndSwapInt32(&hbm.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+
+	// the first heartbeat is synchronous so that this host is visible to
+	// the others, and to itself, by the time the monitor is started
+	if err := hbm.heartbeat(); err != nil {
+		hbm.logger.Error("Failed to record membership heartbeat.", tag.Error(err))
+		return err
+	}
+
+	for service, ring := range hbm.rings {
+		if err := ring.Start(); err != nil {
+			hbm.logger.Error("Failed to initialize ring.", tag.Service(service))
+			return err
+		}
+	}
+
+	hbm.shutdownWG.Add(1)
+	go hbm.heartbeatWorker()
+	return nil
+}
+
+func (hbm *heartbeatMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(&hbm.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(hbm.shutdownCh)
+	if success := common.AwaitWaitGroup(&hbm.shutdownWG, time.Minute); !success {
+		hbm.logger.Warn("heartbeat monitor timed out on shutdown.")
+	}
+
+	// the record of this host is left to expire, the other hosts
+	// will drop it from their rings once the heartbeat ttl passes
+	for _, ring := range hbm.rings {
+		ring.Stop()
+	}
+}
+
+func (hbm *heartbeatMonitor) WhoAmI() (*HostInfo, error) {
+	return hbm.hostInfo, nil
+}
+
+func (hbm *heartbeatMonitor) GetResolver(service string) (ServiceResolver, error) {
+	ring, found := hbm.rings[service]
+	if !found {
+		return nil, ErrUnknownService
+	}
+	return ring, nil
+}
+
+func (hbm *heartbeatMonitor) Lookup(service string, key string) (*HostInfo, error) {
+	ring, err := hbm.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return ring.Lookup(key)
+}
+
+func (hbm *heartbeatMonitor) AddListener(service string, name string, notifyChannel chan<- *ChangedEvent) error {
+	ring, err := hbm.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.AddListener(name, notifyChannel)
+}
+
+func (hbm *heartbeatMonitor) RemoveListener(service string, name string) error {
+	ring, err := hbm.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.RemoveListener(name)
+}
+
+func (hbm *heartbeatMonitor) heartbeat() error {
+	return hbm.store.Heartbeat(hbm.serviceName, hbm.hostInfo.GetAddress(), hbm.sessionStart, hbm.options.HeartbeatTTL)
+}
+
+func (hbm *heartbeatMonitor) heartbeatWorker() {
+	defer hbm.shutdownWG.Done()
+
+	heartbeatTicker := time.NewTicker(hbm.options.HeartbeatInterval)
+	defer heartbeatTicker.Stop()
+	pruneTicker := time.NewTicker(hbm.options.PruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-hbm.shutdownCh:
+			return
+		case <-heartbeatTicker.C:
+			if err := hbm.heartbeat(); err != nil {
+				hbm.logger.Warn("Failed to record membership heartbeat.", tag.Error(err))
+			}
+		case <-pruneTicker.C:
+			if err := hbm.store.PruneExpiredHosts(); err != nil {
+				hbm.logger.Warn("Failed to prune expired membership records.", tag.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	HeartbeatMonitorSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+
+	inMemoryHeartbeatStore struct {
+		sync.Mutex
+		lastHeartbeat map[string]map[string]time.Time
+	}
+)
+
+func TestHeartbeatMonitorSuite(t *testing.T) {
+	suite.Run(t, new(HeartbeatMonitorSuite))
+}
+
+func (s *HeartbeatMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *HeartbeatMonitorSuite) TestHeartbeatMonitor() {
+	store := newInMemoryHeartbeatStore()
+	services := []string{"hbm-test", "hbm-other"}
+	options := &HeartbeatOptions{
+		HeartbeatInterval: 20 * time.Millisecond,
+		HeartbeatTTL:      200 * time.Millisecond,
+		RefreshInterval:   20 * time.Millisecond,
+		PruneInterval:     50 * time.Millisecond,
+	}
+	logger := loggerimpl.NewNopLogger()
+
+	addrs := []string{"127.0.0.1:7001", "127.0.0.1:7002", "127.0.0.1:7003"}
+	var monitors []Monitor
+	for _, addr := range addrs {
+		monitor := NewHeartbeatMonitor(services, "hbm-test", addr, store, options, logger)
+		s.NoError(monitor.Start())
+		monitors = append(monitors, monitor)
+	}
+
+	// give the first monitor time to pick up the hosts started after it
+	time.Sleep(100 * time.Millisecond)
+
+	self, err := monitors[0].WhoAmI()
+	s.NoError(err)
+	s.Equal(addrs[0], self.GetAddress())
+	role, ok := self.Label(RoleKey)
+	s.True(ok)
+	s.Equal("hbm-test", role)
+
+	// every host hashes a key to the same owner
+	for _, key := range []string{"key1", "key2", "key3", "key4"} {
+		owner, err := monitors[0].Lookup("hbm-test", key)
+		s.NoError(err)
+		for _, monitor := range monitors[1:] {
+			host, err := monitor.Lookup("hbm-test", key)
+			s.NoError(err)
+			s.Equal(owner.GetAddress(), host.GetAddress())
+		}
+	}
+
+	_, err = monitors[0].Lookup("hbm-other", "key")
+	s.Equal(ErrInsufficientHosts, err)
+	_, err = monitors[0].Lookup("hbm-unknown", "key")
+	s.Equal(ErrUnknownService, err)
+
+	listenCh := make(chan *ChangedEvent, 5)
+	s.NoError(monitors[0].AddListener("hbm-test", "test-listener", listenCh))
+	s.Equal(ErrListenerAlreadyExist, monitors[0].AddListener("hbm-test", "test-listener", listenCh))
+
+	monitors[1].Stop()
+	select {
+	case e := <-listenCh:
+		s.Equal(1, len(e.HostsRemoved))
+		s.Equal(addrs[1], e.HostsRemoved[0].GetAddress())
+		s.Nil(e.HostsAdded)
+		s.Nil(e.HostsUpdated)
+	case <-time.After(10 * time.Second):
+		s.Fail("Timed out waiting for the stopped host to expire")
+	}
+
+	for _, key := range []string{"key1", "key2", "key3", "key4"} {
+		host, err := monitors[0].Lookup("hbm-test", key)
+		s.NoError(err)
+		s.NotEqual(addrs[1], host.GetAddress())
+	}
+
+	s.NoError(monitors[0].RemoveListener("hbm-test", "test-listener"))
+	monitors[0].Stop()
+	monitors[2].Stop()
+}
+
+func newInMemoryHeartbeatStore() *inMemoryHeartbeatStore {
+	return &inMemoryHeartbeatStore{lastHeartbeat: make(map[string]map[string]time.Time)}
+}
+
+func (m *inMemoryHeartbeatStore) Heartbeat(service string, address string, sessionStart time.Time, ttl time.Duration) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.lastHeartbeat[service]; !ok {
+		m.lastHeartbeat[service] = make(map[string]time.Time)
+	}
+	m.lastHeartbeat[service][address] = time.Now()
+	return nil
+}
+
+func (m *inMemoryHeartbeatStore) GetLiveHosts(service string, ttl time.Duration) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	var addrs []string
+	for addr, lastHeartbeat := range m.lastHeartbeat[service] {
+		if time.Since(lastHeartbeat) < ttl {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs, nil
+}
+
+func (m *inMemoryHeartbeatStore) PruneExpiredHosts() error {
+	return nil
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/ringpop-go/hashring"
+)
+
+type heartbeatServiceResolver struct {
+	service    string
+	status     int32
+	store      HeartbeatStore
+	options    *HeartbeatOptions
+	shutdownCh chan struct{}
+	shutdownWG sync.WaitGroup
+	logger     log.Logger
+
+	ringLock sync.RWMutex
+	ring     *hashring.HashRing
+	members  map[string]struct{}
+
+	listenerLock sync.RWMutex
+	listeners    map[string]chan<- *ChangedEvent
+}
+
+var _ ServiceResolver = (*heartbeatServiceResolver)(nil)
+
+func newHeartbeatServiceResolver(
+	service string,
+	store HeartbeatStore,
+	options *HeartbeatOptions,
+	logger log.Logger,
+) *heartbeatServiceResolver {
+	return &heartbeatServiceResolver{
+		service:    service,
+		store:      store,
+		options:    options,
+		logger:     logger.WithTags(tag.ComponentServiceResolver, tag.Service(service)),
+		ring:       hashring.New(farm.Fingerprint32, replicaPoints),
+		members:    make(map[string]struct{}),
+		listeners:  make(map[string]chan<- *ChangedEvent),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+// Start loads the hosts of the service and starts refreshing them periodically
+func (r *heartbeatServiceResolver) Start() error {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+
+	if err := r.refresh(); err != nil {
+		return err
+	}
+
+	r.shutdownWG.Add(1)
+	go r.refreshRingWorker()
+	return nil
+}
+
+// Stop stops the resolver
+func (r *heartbeatServiceResolver) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(r.shutdownCh)
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("service resolver timed out on shutdown.")
+	}
+
+	r.ringLock.Lock()
+	r.ring = hashring.New(farm.Fingerprint32, replicaPoints)
+	r.members = make(map[string]struct{})
+	r.ringLock.Unlock()
+
+	r.listenerLock.Lock()
+	r.listeners = make(map[string]chan<- *ChangedEvent)
+	r.listenerLock.Unlock()
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *heartbeatServiceResolver) Lookup(key string) (*HostInfo, error) {
+	r.ringLock.RLock()
+	defer r.ringLock.RUnlock()
+	addr, found := r.ring.Lookup(key)
+	if !found {
+		return nil, ErrInsufficientHosts
+	}
+	return NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+func (r *heartbeatServiceResolver) AddListener(name string, notifyChannel chan<- *ChangedEvent) error {
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *heartbeatServiceResolver) RemoveListener(name string) error {
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if !ok {
+		return nil
+	}
+	delete(r.listeners, name)
+	return nil
+}
+
+// refresh reloads the live hosts from the store, rebuilds the ring when
+// they changed and notifies the listeners about the difference
+func (r *heartbeatServiceResolver) refresh() error {
+	addrs, err := r.store.GetLiveHosts(r.service, r.options.HeartbeatTTL)
+	if err != nil {
+		r.logger.Warn("Error during membership refresh.", tag.Error(err))
+		return err
+	}
+
+	members := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		members[addr] = struct{}{}
+	}
+
+	event := &ChangedEvent{}
+	r.ringLock.Lock()
+	for addr := range members {
+		if _, ok := r.members[addr]; !ok {
+			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	for addr := range r.members {
+		if _, ok := members[addr]; !ok {
+			event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	if len(event.HostsAdded) == 0 && len(event.HostsRemoved) == 0 {
+		r.ringLock.Unlock()
+		return nil
+	}
+
+	// hosts are added in a stable order so that every host builds the same ring
+	sort.Strings(addrs)
+	ring := hashring.New(farm.Fingerprint32, replicaPoints)
+	for _, addr := range addrs {
+		ring.AddMembers(NewHostInfo(addr, r.getLabelsMap()))
+	}
+	r.ring = ring
+	r.members = members
+	r.ringLock.Unlock()
+
+	r.logger.Info("Membership of the service changed", tag.Addresses(addrs))
+	r.emitEvent(event)
+	return nil
+}
+
+func (r *heartbeatServiceResolver) emitEvent(event *ChangedEvent) {
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+func (r *heartbeatServiceResolver) refreshRingWorker() {
+	defer r.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(r.options.RefreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-refreshTicker.C:
+			r.refresh()
+		}
+	}
+}
+
+func (r *heartbeatServiceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[RoleKey] = r.service
+	return labels
+}
@@ -22,6 +22,7 @@ package membership
 
 import (
 	"errors"
+	"time"
 
 	"github.com/uber/cadence/.gen/go/shared"
 )
@@ -75,4 +76,16 @@ type (
 		// RemoveListener removes a listener for this service.
 		RemoveListener(name string) error
 	}
+
+	// HeartbeatStore is the storage behind the heartbeat based membership provider.
+	// Every host periodically records a heartbeat for itself, and hosts discover
+	// each other by listing the hosts of a service which heartbeated recently.
+	HeartbeatStore interface {
+		// Heartbeat records that the host with the given address is alive for the next ttl
+		Heartbeat(service string, address string, sessionStart time.Time, ttl time.Duration) error
+		// GetLiveHosts returns the addresses of the hosts of a service which heartbeated within the ttl
+		GetLiveHosts(service string, ttl time.Duration) ([]string, error)
+		// PruneExpiredHosts removes the records of the hosts which stopped heartbeating
+		PruneExpiredHosts() error
+	}
 )
@@ -254,10 +254,18 @@ const (
 	HistoryClientRequestCancelWorkflowExecutionScope
 	// HistoryClientSignalWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWorkflowExecutionScope
+	// HistoryClientStartWorkflowExecutionBatchScope tracks RPC calls to history service
+	HistoryClientStartWorkflowExecutionBatchScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
 	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWithStartWorkflowExecutionScope
 	// HistoryClientRemoveSignalMutableStateScope tracks RPC calls to history service
 	HistoryClientRemoveSignalMutableStateScope
+	// HistoryClientGetRunningWorkflowCountsScope tracks RPC calls to history service
+	HistoryClientGetRunningWorkflowCountsScope
+	// HistoryClientVerifyWorkflowExecutionHistoryScope tracks RPC calls to history service
+	HistoryClientVerifyWorkflowExecutionHistoryScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
@@ -290,6 +298,12 @@ const (
 	MatchingClientCancelOutstandingPollScope
 	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskListScope
+	// MatchingClientAcquireDispatchTokensScope tracks RPC calls to matching service
+	MatchingClientAcquireDispatchTokensScope
+	// MatchingClientPromoteTaskListBuildScope tracks RPC calls to matching service
+	MatchingClientPromoteTaskListBuildScope
+	// MatchingClientMigrateTaskListBacklogScope tracks RPC calls to matching service
+	MatchingClientMigrateTaskListBacklogScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -346,8 +360,12 @@ const (
 	FrontendClientSignalWithStartWorkflowExecutionScope
 	// FrontendClientSignalWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientSignalWorkflowExecutionScope
+	// FrontendClientUpdateWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkflowExecutionScope
 	// FrontendClientStartWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientStartWorkflowExecutionScope
+	// FrontendClientStartWorkflowExecutionBatchScope tracks RPC calls to frontend service
+	FrontendClientStartWorkflowExecutionBatchScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
@@ -358,6 +376,12 @@ const (
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientVerifyWorkflowExecutionHistoryScope tracks RPC calls to admin service
+	AdminClientVerifyWorkflowExecutionHistoryScope
+	// AdminClientPromoteTaskListBuildScope tracks RPC calls to admin service
+	AdminClientPromoteTaskListBuildScope
+	// AdminClientMigrateTaskListBacklogScope tracks RPC calls to admin service
+	AdminClientMigrateTaskListBacklogScope
 
 	// MessagingPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -389,6 +413,12 @@ const (
 	PersistenceCompleteForkBranchScope
 	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
 	PersistenceGetHistoryTreeScope
+	// PersistenceUpsertClusterMembershipScope tracks UpsertClusterMembership calls made by service to persistence layer
+	PersistenceUpsertClusterMembershipScope
+	// PersistenceGetClusterMembersScope tracks GetClusterMembers calls made by service to persistence layer
+	PersistenceGetClusterMembersScope
+	// PersistencePruneClusterMembershipScope tracks PruneClusterMembership calls made by service to persistence layer
+	PersistencePruneClusterMembershipScope
 
 	// BlobstoreClientUploadScope tracks Upload calls to blobstore
 	BlobstoreClientUploadScope
@@ -451,6 +481,12 @@ const (
 	AdminDescribeWorkflowExecutionScope
 	// AdminGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryScope
+	// AdminVerifyWorkflowExecutionHistoryScope is the metric scope for admin.VerifyWorkflowExecutionHistory
+	AdminVerifyWorkflowExecutionHistoryScope
+	// AdminPromoteTaskListBuildScope is the metric scope for admin.PromoteTaskListBuild
+	AdminPromoteTaskListBuildScope
+	// AdminMigrateTaskListBacklogScope is the metric scope for admin.MigrateTaskListBacklog
+	AdminMigrateTaskListBacklogScope
 
 	NumAdminScopes
 )
@@ -489,6 +525,10 @@ const (
 	FrontendGetWorkflowExecutionHistoryScope
 	// FrontendSignalWorkflowExecutionScope is the metric scope for frontend.SignalWorkflowExecution
 	FrontendSignalWorkflowExecutionScope
+	// FrontendStartWorkflowExecutionBatchScope is the metric scope for frontend.StartWorkflowExecutionBatch
+	FrontendStartWorkflowExecutionBatchScope
+	// FrontendUpdateWorkflowExecutionScope is the metric scope for frontend.UpdateWorkflowExecution
+	FrontendUpdateWorkflowExecutionScope
 	// FrontendSignalWithStartWorkflowExecutionScope is the metric scope for frontend.SignalWithStartWorkflowExecution
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
@@ -557,10 +597,18 @@ const (
 	HistoryRecordActivityTaskStartedScope
 	// HistorySignalWorkflowExecutionScope tracks SignalWorkflowExecution API calls received by service
 	HistorySignalWorkflowExecutionScope
+	// HistoryStartWorkflowExecutionBatchScope tracks StartWorkflowExecutionBatch API calls received by service
+	HistoryStartWorkflowExecutionBatchScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
 	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
 	HistorySignalWithStartWorkflowExecutionScope
 	// HistoryRemoveSignalMutableStateScope tracks RemoveSignalMutableState API calls received by service
 	HistoryRemoveSignalMutableStateScope
+	// HistoryGetRunningWorkflowCountsScope tracks GetRunningWorkflowCounts API calls received by service
+	HistoryGetRunningWorkflowCountsScope
+	// HistoryVerifyWorkflowExecutionHistoryScope tracks VerifyWorkflowExecutionHistory API calls received by service
+	HistoryVerifyWorkflowExecutionHistoryScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
@@ -637,6 +685,8 @@ const (
 	TimerActiveTaskActivityRetryTimerScope
 	// TimerActiveTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerActiveTaskWorkflowBackoffTimerScope
+	// TimerActiveTaskDelayedSignalScope is the scope used by metric emitted by timer queue processor for processing delayed signals
+	TimerActiveTaskDelayedSignalScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
@@ -653,6 +703,8 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskDelayedSignalScope is the scope used by metric emitted by timer queue processor for processing delayed signals
+	TimerStandbyTaskDelayedSignalScope
 	// HistoryEventNotificationScope is the scope used by shard history event nitification
 	HistoryEventNotificationScope
 	// ReplicatorQueueProcessorScope is the scope used by all metric emitted by replicator queue processor
@@ -697,6 +749,8 @@ const (
 	WorkflowCompletionStatsScope
 	// ArchiverClientScope is scope used by all metrics emitted by archiver.Client
 	ArchiverClientScope
+	// ParentClosePolicyClientScope is scope used by all metrics emitted by parentclosepolicy.Client
+	ParentClosePolicyClientScope
 
 	NumHistoryScopes
 )
@@ -721,6 +775,12 @@ const (
 	MatchingCancelOutstandingPollScope
 	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
 	MatchingDescribeTaskListScope
+	// MatchingAcquireDispatchTokensScope tracks AcquireDispatchTokens API calls received by service
+	MatchingAcquireDispatchTokensScope
+	// MatchingPromoteTaskListBuildScope tracks PromoteTaskListBuild API calls received by service
+	MatchingPromoteTaskListBuildScope
+	// MatchingMigrateTaskListBacklogScope tracks MigrateTaskListBacklog API calls received by service
+	MatchingMigrateTaskListBacklogScope
 
 	NumMatchingScopes
 )
@@ -755,6 +815,10 @@ const (
 	ArchiverArchivalWorkflowScope
 	// TaskListScavengerScope is scope used by all metrics emitted by worker.tasklist.Scavenger module
 	TaskListScavengerScope
+	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
+	ParentClosePolicyProcessorScope
+	// HistoryVerifierScope is scope used by all metrics emitted by worker.scanner.history verifier module
+	HistoryVerifierScope
 
 	NumWorkerScopes
 )
@@ -840,6 +904,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteHistoryBranchScope:                      {operation: "DeleteHistoryBranch"},
 		PersistenceCompleteForkBranchScope:                       {operation: "CompleteForkBranch"},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree"},
+		PersistenceUpsertClusterMembershipScope:                  {operation: "UpsertClusterMembership"},
+		PersistenceGetClusterMembersScope:                        {operation: "GetClusterMembers"},
+		PersistencePruneClusterMembershipScope:                   {operation: "PruneClusterMembership"},
 
 		BlobstoreClientUploadScope:         {operation: "BlobstoreClientUpload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientDownloadScope:       {operation: "BlobstoreClientDownload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
@@ -866,8 +933,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientRecordActivityTaskStartedScope:         {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRequestCancelWorkflowExecutionScope:    {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSignalWorkflowExecutionScope:           {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientStartWorkflowExecutionBatchScope:       {operation: "HistoryClientStartWorkflowExecutionBatch", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:           {operation: "HistoryClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetRunningWorkflowCountsScope:          {operation: "HistoryClientGetRunningWorkflowCounts", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientVerifyWorkflowExecutionHistoryScope:    {operation: "HistoryClientVerifyWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
@@ -884,6 +955,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientRespondQueryTaskCompletedScope:        {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientCancelOutstandingPollScope:            {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientDescribeTaskListScope:                 {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientAcquireDispatchTokensScope:            {operation: "MatchingClientAcquireDispatchTokens", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientPromoteTaskListBuildScope:             {operation: "MatchingClientPromoteTaskListBuild", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientMigrateTaskListBacklogScope:           {operation: "MatchingClientMigrateTaskListBacklog", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                  {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeDomainScope:                   {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                 {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
@@ -912,12 +986,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientRespondQueryTaskCompletedScope:        {operation: "FrontendClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientSignalWithStartWorkflowExecutionScope: {operation: "FrontendClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientSignalWorkflowExecutionScope:          {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientUpdateWorkflowExecutionScope:          {operation: "FrontendClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:           {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientStartWorkflowExecutionBatchScope:      {operation: "FrontendClientStartWorkflowExecutionBatch", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:       {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientUpdateDomainScope:                     {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientVerifyWorkflowExecutionHistoryScope:      {operation: "AdminClientVerifyWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientPromoteTaskListBuildScope:                {operation: "AdminClientPromoteTaskListBuild", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientMigrateTaskListBacklogScope:              {operation: "AdminClientMigrateTaskListBacklog", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -950,6 +1029,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
 		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminVerifyWorkflowExecutionHistoryScope: {operation: "VerifyWorkflowExecutionHistory"},
+		AdminPromoteTaskListBuildScope:           {operation: "PromoteTaskListBuild"},
+		AdminMigrateTaskListBacklogScope:         {operation: "MigrateTaskListBacklog"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -967,6 +1049,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendRespondActivityTaskCanceledByIDScope:  {operation: "RespondActivityTaskCanceledByID"},
 		FrontendGetWorkflowExecutionHistoryScope:      {operation: "GetWorkflowExecutionHistory"},
 		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
+		FrontendStartWorkflowExecutionBatchScope:      {operation: "StartWorkflowExecutionBatch"},
+		FrontendUpdateWorkflowExecutionScope:          {operation: "UpdateWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
 		FrontendResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
@@ -1001,8 +1085,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRecordDecisionTaskStartedScope:         {operation: "RecordDecisionTaskStarted"},
 		HistoryRecordActivityTaskStartedScope:         {operation: "RecordActivityTaskStarted"},
 		HistorySignalWorkflowExecutionScope:           {operation: "SignalWorkflowExecution"},
+		HistoryStartWorkflowExecutionBatchScope:       {operation: "StartWorkflowExecutionBatch"},
+		HistoryUpdateWorkflowExecutionScope:           {operation: "UpdateWorkflowExecution"},
 		HistorySignalWithStartWorkflowExecutionScope:  {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:          {operation: "RemoveSignalMutableState"},
+		HistoryGetRunningWorkflowCountsScope:          {operation: "GetRunningWorkflowCounts"},
+		HistoryVerifyWorkflowExecutionHistoryScope:    {operation: "VerifyWorkflowExecutionHistory"},
 		HistoryTerminateWorkflowExecutionScope:        {operation: "TerminateWorkflowExecution"},
 		HistoryResetWorkflowExecutionScope:            {operation: "ResetWorkflowExecution"},
 		HistoryProcessDeleteHistoryEventScope:         {operation: "ProcessDeleteHistoryEvent"},
@@ -1043,6 +1131,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerActiveTaskWorkflowTimeoutScope:           {operation: "TimerActiveTaskWorkflowTimeout"},
 		TimerActiveTaskActivityRetryTimerScope:        {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:      {operation: "TimerActiveTaskWorkflowBackoffTimer"},
+		TimerActiveTaskDelayedSignalScope:             {operation: "TimerActiveTaskDelayedSignal"},
 		TimerActiveTaskDeleteHistoryEventScope:        {operation: "TimerActiveTaskDeleteHistoryEvent"},
 		TimerStandbyTaskActivityTimeoutScope:          {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskDecisionTimeoutScope:          {operation: "TimerStandbyTaskDecisionTimeout"},
@@ -1050,6 +1139,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerStandbyTaskWorkflowTimeoutScope:          {operation: "TimerStandbyTaskWorkflowTimeout"},
 		TimerStandbyTaskActivityRetryTimerScope:       {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope:     {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
+		TimerStandbyTaskDelayedSignalScope:            {operation: "TimerStandbyTaskDelayedSignal"},
 		TimerStandbyTaskDeleteHistoryEventScope:       {operation: "TimerStandbyTaskDeleteHistoryEvent"},
 		HistoryEventNotificationScope:                 {operation: "HistoryEventNotification"},
 		ReplicatorQueueProcessorScope:                 {operation: "ReplicatorQueueProcessor"},
@@ -1071,6 +1161,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		SessionCountStatsScope:                        {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
 		WorkflowCompletionStatsScope:                  {operation: "CompletionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
 		ArchiverClientScope:                           {operation: "ArchiverClient"},
+		ParentClosePolicyClientScope:                  {operation: "ParentClosePolicyClient"},
 	},
 	// Matching Scope Names
 	Matching: {
@@ -1083,6 +1174,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
 		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
+		MatchingAcquireDispatchTokensScope:     {operation: "AcquireDispatchTokens"},
+		MatchingPromoteTaskListBuildScope:      {operation: "PromoteTaskListBuild"},
+		MatchingMigrateTaskListBacklogScope:    {operation: "MigrateTaskListBacklog"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1100,6 +1194,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ArchiverPumpScope:                   {operation: "ArchiverPump"},
 		ArchiverArchivalWorkflowScope:       {operation: "ArchiverArchivalWorkflow"},
 		TaskListScavengerScope:              {operation: "tasklistscavenger"},
+		ParentClosePolicyProcessorScope:     {operation: "ParentClosePolicyProcessor"},
+		HistoryVerifierScope:                {operation: "historyverifier"},
 	},
 	// Blobstore Scope Names
 	Blobstore: {
@@ -1244,6 +1340,8 @@ const (
 	RemoveEngineForShardLatency
 	CompleteDecisionWithStickyEnabledCounter
 	CompleteDecisionWithStickyDisabledCounter
+	DecisionHeartbeatCounter
+	DecisionHeartbeatLimitExceededCounter
 	HistoryEventNotificationQueueingLatency
 	HistoryEventNotificationFanoutLatency
 	HistoryEventNotificationInFlightMessageGauge
@@ -1284,6 +1382,9 @@ const (
 	DeleteRequestCancelInfoCount
 	WorkflowRetryBackoffTimerCount
 	WorkflowCronBackoffTimerCount
+	WorkflowDelayStartBackoffTimerCount
+	WorkflowConcurrencyLimitBackoffTimerCount
+	WorkflowConcurrencyLimitExceededCount
 	WorkflowCleanupDeleteCount
 	WorkflowCleanupArchiveCount
 	WorkflowCleanupNopCount
@@ -1292,6 +1393,10 @@ const (
 	WorkflowFailedCount
 	WorkflowTimeoutCount
 	WorkflowTerminateCount
+	ParentClosePolicyTerminateCount
+	ParentClosePolicyRequestCancelCount
+	ParentClosePolicyDelegatedCount
+	ParentClosePolicySkippedCount
 
 	NumHistoryMetrics
 )
@@ -1309,6 +1414,20 @@ const (
 	BufferThrottleCounter
 	SyncMatchLatency
 	ExpiredTasksCounter
+	ActivityTypeSyncThrottleCounter
+	ActivityTypeBufferThrottleCounter
+	DispatchTokenAcquireFailureCounter
+	BuildRoutedTaskCounter
+	BuildRoutingFallbackCounter
+	ScheduleToStartLatency
+	OldestUnackedTaskAgeGauge
+	BacklogCountGauge
+	PollAdmittedCounter
+	PollAdmissionQueuedCounter
+	PollAdmissionTimeoutCounter
+	PollDomainLimitExceededCounter
+	PollAdmissionLatency
+	MigratedTasksCounter
 
 	NumMatchingMetrics
 )
@@ -1363,6 +1482,12 @@ const (
 	StoppedCount
 	ExecutorTasksDeferredCount
 	ExecutorTasksDroppedCount
+	ParentClosePolicyProcessorSuccess
+	ParentClosePolicyProcessorFailures
+	ParentClosePolicyProcessorSkipped
+	HistoryVerifierVerifiedCount
+	HistoryVerifierMismatchCount
+	HistoryVerifierFailures
 	NumWorkerMetrics
 )
 
@@ -1488,6 +1613,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		RemoveEngineForShardLatency:                  {metricName: "remove_engine_for_shard_latency", metricType: Timer},
 		CompleteDecisionWithStickyEnabledCounter:     {metricName: "complete_decision_sticky_enabled_count", metricType: Counter},
 		CompleteDecisionWithStickyDisabledCounter:    {metricName: "complete_decision_sticky_disabled_count", metricType: Counter},
+		DecisionHeartbeatCounter:                     {metricName: "decision_heartbeat_count", metricType: Counter},
+		DecisionHeartbeatLimitExceededCounter:        {metricName: "decision_heartbeat_limit_exceeded_count", metricType: Counter},
 		HistoryEventNotificationQueueingLatency:      {metricName: "history_event_notification_queueing_latency", metricType: Timer},
 		HistoryEventNotificationFanoutLatency:        {metricName: "history_event_notification_fanout_latency", metricType: Timer},
 		HistoryEventNotificationInFlightMessageGauge: {metricName: "history_event_notification_inflight_message_gauge", metricType: Gauge},
@@ -1528,6 +1655,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DeleteRequestCancelInfoCount:                 {metricName: "delete_request_cancel_info", metricType: Timer},
 		WorkflowRetryBackoffTimerCount:               {metricName: "workflow_retry_backoff_timer", metricType: Counter},
 		WorkflowCronBackoffTimerCount:                {metricName: "workflow_cron_backoff_timer", metricType: Counter},
+		WorkflowDelayStartBackoffTimerCount:          {metricName: "workflow_delay_start_backoff_timer", metricType: Counter},
+		WorkflowConcurrencyLimitBackoffTimerCount:    {metricName: "workflow_concurrency_limit_backoff_timer", metricType: Counter},
+		WorkflowConcurrencyLimitExceededCount:        {metricName: "workflow_concurrency_limit_exceeded", metricType: Counter},
 		WorkflowCleanupDeleteCount:                   {metricName: "workflow_cleanup_delete", metricType: Counter},
 		WorkflowCleanupArchiveCount:                  {metricName: "workflow_cleanup_archive", metricType: Counter},
 		WorkflowCleanupNopCount:                      {metricName: "workflow_cleanup_nop", metricType: Counter},
@@ -1536,19 +1666,37 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WorkflowFailedCount:                          {metricName: "workflow_failed", metricType: Counter},
 		WorkflowTimeoutCount:                         {metricName: "workflow_timeout", metricType: Counter},
 		WorkflowTerminateCount:                       {metricName: "workflow_terminate", metricType: Counter},
+		ParentClosePolicyTerminateCount:              {metricName: "parent_close_policy_terminate", metricType: Counter},
+		ParentClosePolicyRequestCancelCount:          {metricName: "parent_close_policy_request_cancel", metricType: Counter},
+		ParentClosePolicyDelegatedCount:              {metricName: "parent_close_policy_delegated", metricType: Counter},
+		ParentClosePolicySkippedCount:                {metricName: "parent_close_policy_skipped", metricType: Counter},
 	},
 	Matching: {
-		PollSuccessCounter:            {metricName: "poll_success"},
-		PollTimeoutCounter:            {metricName: "poll_timeouts"},
-		PollSuccessWithSyncCounter:    {metricName: "poll_success_sync"},
-		LeaseRequestCounter:           {metricName: "lease_requests"},
-		LeaseFailureCounter:           {metricName: "lease_failures"},
-		ConditionFailedErrorCounter:   {metricName: "condition_failed_errors"},
-		RespondQueryTaskFailedCounter: {metricName: "respond_query_failed"},
-		SyncThrottleCounter:           {metricName: "sync_throttle_count"},
-		BufferThrottleCounter:         {metricName: "buffer_throttle_count"},
-		ExpiredTasksCounter:           {metricName: "tasks_expired"},
-		SyncMatchLatency:              {metricName: "syncmatch_latency", metricType: Timer},
+		PollSuccessCounter:                 {metricName: "poll_success"},
+		PollTimeoutCounter:                 {metricName: "poll_timeouts"},
+		PollSuccessWithSyncCounter:         {metricName: "poll_success_sync"},
+		LeaseRequestCounter:                {metricName: "lease_requests"},
+		LeaseFailureCounter:                {metricName: "lease_failures"},
+		ConditionFailedErrorCounter:        {metricName: "condition_failed_errors"},
+		RespondQueryTaskFailedCounter:      {metricName: "respond_query_failed"},
+		SyncThrottleCounter:                {metricName: "sync_throttle_count"},
+		BufferThrottleCounter:              {metricName: "buffer_throttle_count"},
+		ExpiredTasksCounter:                {metricName: "tasks_expired"},
+		SyncMatchLatency:                   {metricName: "syncmatch_latency", metricType: Timer},
+		ActivityTypeSyncThrottleCounter:    {metricName: "activity_type_sync_throttle_count"},
+		ActivityTypeBufferThrottleCounter:  {metricName: "activity_type_buffer_throttle_count"},
+		DispatchTokenAcquireFailureCounter: {metricName: "dispatch_token_acquire_failures"},
+		BuildRoutedTaskCounter:             {metricName: "build_routed_tasks"},
+		BuildRoutingFallbackCounter:        {metricName: "build_routing_fallbacks"},
+		ScheduleToStartLatency:             {metricName: "schedule_to_start_latency", metricType: Timer},
+		OldestUnackedTaskAgeGauge:          {metricName: "oldest_unacked_task_age_seconds", metricType: Gauge},
+		BacklogCountGauge:                  {metricName: "backlog_count", metricType: Gauge},
+		PollAdmittedCounter:                {metricName: "poll_admitted"},
+		PollAdmissionQueuedCounter:         {metricName: "poll_admission_queued"},
+		PollAdmissionTimeoutCounter:        {metricName: "poll_admission_timeouts"},
+		PollDomainLimitExceededCounter:     {metricName: "poll_domain_limit_exceeded"},
+		PollAdmissionLatency:               {metricName: "poll_admission_latency", metricType: Timer},
+		MigratedTasksCounter:               {metricName: "migrated_tasks"},
 	},
 	Worker: {
 		ReplicatorMessages:                                     {metricName: "replicator_messages"},
@@ -1599,9 +1747,20 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		StoppedCount:                                           {metricName: "stopped", metricType: Counter},
 		ExecutorTasksDeferredCount:                             {metricName: "executor_deferred", metricType: Counter},
 		ExecutorTasksDroppedCount:                              {metricName: "executor_dropped", metricType: Counter},
+		ParentClosePolicyProcessorSuccess:                      {metricName: "parent_close_policy_processor_requests", metricType: Counter},
+		ParentClosePolicyProcessorFailures:                     {metricName: "parent_close_policy_processor_errors", metricType: Counter},
+		ParentClosePolicyProcessorSkipped:                      {metricName: "parent_close_policy_processor_skipped", metricType: Counter},
+		HistoryVerifierVerifiedCount:                           {metricName: "history_verifier_verified", metricType: Counter},
+		HistoryVerifierMismatchCount:                           {metricName: "history_verifier_mismatch", metricType: Counter},
+		HistoryVerifierFailures:                                {metricName: "history_verifier_errors", metricType: Counter},
 	},
 }
 
+// GetCommonScopeOperation returns the 'operation' tag value of a scope defined for all services
+func GetCommonScopeOperation(scopeIdx int) string {
+	return ScopeDefs[Common][scopeIdx].operation
+}
+
 // ErrorClass is an enum to help with classifying SLA vs. non-SLA errors (SLA = "service level agreement")
 type ErrorClass uint8
 
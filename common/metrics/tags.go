@@ -23,6 +23,8 @@ package metrics
 const (
 	instance       = "instance"
 	domain         = "domain"
+	taskList       = "tasklist"
+	taskListType   = "tasklisttype"
 	domainAllValue = "all"
 )
 
@@ -86,3 +88,41 @@ func (i instanceTag) Key() string {
 func (i instanceTag) Value() string {
 	return i.value
 }
+
+type taskListTag struct {
+	value string
+}
+
+// TaskListTag returns a new task list tag
+func TaskListTag(value string) Tag {
+	return taskListTag{value}
+}
+
+// Key returns the key of the task list tag
+func (t taskListTag) Key() string {
+	return taskList
+}
+
+// Value returns the value of a task list tag
+func (t taskListTag) Value() string {
+	return t.value
+}
+
+type taskListTypeTag struct {
+	value string
+}
+
+// TaskListTypeTag returns a new task list type tag
+func TaskListTypeTag(value string) Tag {
+	return taskListTypeTag{value}
+}
+
+// Key returns the key of the task list type tag
+func (t taskListTypeTag) Key() string {
+	return taskListType
+}
+
+// Value returns the value of a task list type tag
+func (t taskListTypeTag) Value() string {
+	return t.value
+}
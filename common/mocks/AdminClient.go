@@ -105,3 +105,63 @@ func (_m *AdminClient) GetWorkflowExecutionRawHistory(ctx context.Context, reque
 
 	return r0, r1
 }
+
+// VerifyWorkflowExecutionHistory provides a mock function with given fields: ctx, request
+func (_m *AdminClient) VerifyWorkflowExecutionHistory(ctx context.Context, request *admin.VerifyWorkflowExecutionHistoryRequest, opts ...yarpc.CallOption) (*admin.VerifyWorkflowExecutionHistoryResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.VerifyWorkflowExecutionHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.VerifyWorkflowExecutionHistoryRequest) *admin.VerifyWorkflowExecutionHistoryResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.VerifyWorkflowExecutionHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.VerifyWorkflowExecutionHistoryRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoteTaskListBuild provides a mock function with given fields: ctx, request
+func (_m *AdminClient) PromoteTaskListBuild(ctx context.Context, request *admin.PromoteTaskListBuildRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.PromoteTaskListBuildRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MigrateTaskListBacklog provides a mock function with given fields: ctx, request
+func (_m *AdminClient) MigrateTaskListBacklog(ctx context.Context, request *admin.MigrateTaskListBacklogRequest, opts ...yarpc.CallOption) (*admin.MigrateTaskListBacklogResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.MigrateTaskListBacklogResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.MigrateTaskListBacklogRequest) *admin.MigrateTaskListBacklogResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.MigrateTaskListBacklogResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.MigrateTaskListBacklogRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
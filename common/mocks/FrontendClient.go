@@ -890,6 +890,36 @@ func (_m *FrontendClient) StartWorkflowExecution(ctx context.Context, StartReque
 	return r0, r1
 }
 
+// StartWorkflowExecutionBatch provides a mock function with given fields: ctx, BatchRequest, opts
+func (_m *FrontendClient) StartWorkflowExecutionBatch(ctx context.Context, BatchRequest *shared.StartWorkflowExecutionBatchRequest, opts ...yarpc.CallOption) (*shared.StartWorkflowExecutionBatchResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, BatchRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *shared.StartWorkflowExecutionBatchResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.StartWorkflowExecutionBatchRequest, ...yarpc.CallOption) *shared.StartWorkflowExecutionBatchResponse); ok {
+		r0 = rf(ctx, BatchRequest, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.StartWorkflowExecutionBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.StartWorkflowExecutionBatchRequest, ...yarpc.CallOption) error); ok {
+		r1 = rf(ctx, BatchRequest, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TerminateWorkflowExecution provides a mock function with given fields: ctx, TerminateRequest, opts
 func (_m *FrontendClient) TerminateWorkflowExecution(ctx context.Context, TerminateRequest *shared.TerminateWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
 	_va := make([]interface{}, len(opts))
@@ -940,3 +970,33 @@ func (_m *FrontendClient) UpdateDomain(ctx context.Context, UpdateRequest *share
 
 	return r0, r1
 }
+
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, UpdateRequest, opts
+func (_m *FrontendClient) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.UpdateWorkflowExecutionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, UpdateRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, UpdateRequest, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) error); ok {
+		r1 = rf(ctx, UpdateRequest, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
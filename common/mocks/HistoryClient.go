@@ -84,6 +84,29 @@ func (_m *HistoryClient) DescribeMutableState(ctx context.Context, request *hist
 	return r0, r1
 }
 
+// VerifyWorkflowExecutionHistory provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) VerifyWorkflowExecutionHistory(ctx context.Context, request *history.VerifyWorkflowExecutionHistoryRequest, opts ...yarpc.CallOption) (*history.VerifyWorkflowExecutionHistoryResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.VerifyWorkflowExecutionHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.VerifyWorkflowExecutionHistoryRequest) *history.VerifyWorkflowExecutionHistoryResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.VerifyWorkflowExecutionHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.VerifyWorkflowExecutionHistoryRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMutableState provides a mock function with given fields: ctx, getRequest
 func (_m *HistoryClient) GetMutableState(ctx context.Context, getRequest *history.GetMutableStateRequest, opts ...yarpc.CallOption) (*history.GetMutableStateResponse, error) {
 	ret := _m.Called(ctx, getRequest)
@@ -315,6 +338,52 @@ func (_m *HistoryClient) SignalWorkflowExecution(ctx context.Context, signalRequ
 	return r0
 }
 
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, updateRequest
+func (_m *HistoryClient) UpdateWorkflowExecution(ctx context.Context, updateRequest *history.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, updateRequest)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, updateRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, updateRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRunningWorkflowCounts provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetRunningWorkflowCounts(ctx context.Context, request *history.GetRunningWorkflowCountsRequest, opts ...yarpc.CallOption) (*history.GetRunningWorkflowCountsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.GetRunningWorkflowCountsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.GetRunningWorkflowCountsRequest) *history.GetRunningWorkflowCountsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.GetRunningWorkflowCountsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.GetRunningWorkflowCountsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignalWithStartWorkflowExecution provides a mock function with given fields: ctx, signalWithStartRequest
 func (_m *HistoryClient) SignalWithStartWorkflowExecution(ctx context.Context,
 	signalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
@@ -377,6 +446,29 @@ func (_m *HistoryClient) StartWorkflowExecution(ctx context.Context, startReques
 	return r0, r1
 }
 
+// StartWorkflowExecutionBatch is mock implementation for StartWorkflowExecutionBatch of HistoryEngine
+func (_m *HistoryClient) StartWorkflowExecutionBatch(ctx context.Context, batchRequest *history.StartWorkflowExecutionBatchRequest, opts ...yarpc.CallOption) (*history.StartWorkflowExecutionBatchResponse, error) {
+	ret := _m.Called(ctx, batchRequest)
+
+	var r0 *history.StartWorkflowExecutionBatchResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.StartWorkflowExecutionBatchRequest) *history.StartWorkflowExecutionBatchResponse); ok {
+		r0 = rf(ctx, batchRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.StartWorkflowExecutionBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.StartWorkflowExecutionBatchRequest) error); ok {
+		r1 = rf(ctx, batchRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RequestCancelWorkflowExecution is mock implementation for RequestCancelWorkflowExecution of HistoryEngine
 func (_m *HistoryClient) RequestCancelWorkflowExecution(ctx context.Context, request *history.RequestCancelWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
 	ret := _m.Called(ctx, request)
@@ -165,6 +165,69 @@ func (_m *MatchingClient) CancelOutstandingPoll(ctx context.Context,
 	return r0
 }
 
+// AcquireDispatchTokens provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) AcquireDispatchTokens(ctx context.Context,
+	request *matching.AcquireDispatchTokensRequest, opts ...yarpc.CallOption) (*matching.AcquireDispatchTokensResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *matching.AcquireDispatchTokensResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.AcquireDispatchTokensRequest) *matching.AcquireDispatchTokensResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*matching.AcquireDispatchTokensResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *matching.AcquireDispatchTokensRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoteTaskListBuild provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) PromoteTaskListBuild(ctx context.Context,
+	request *matching.PromoteTaskListBuildRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.PromoteTaskListBuildRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MigrateTaskListBacklog provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) MigrateTaskListBacklog(ctx context.Context,
+	request *matching.MigrateTaskListBacklogRequest, opts ...yarpc.CallOption) (*matching.MigrateTaskListBacklogResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *matching.MigrateTaskListBacklogResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.MigrateTaskListBacklogRequest) *matching.MigrateTaskListBacklogResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*matching.MigrateTaskListBacklogResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *matching.MigrateTaskListBacklogRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeTaskList provides a mock function with given fields: ctx, request
 func (_m *MatchingClient) DescribeTaskList(ctx context.Context,
 	request *matching.DescribeTaskListRequest, opts ...yarpc.CallOption) (*shared.DescribeTaskListResponse, error) {
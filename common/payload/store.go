@@ -0,0 +1,335 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dgryski/go-farm"
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+)
+
+const (
+	keyExtension      = "payload"
+	keyPrefix         = "payload"
+	bufferedKeyPrefix = "buffered"
+
+	fieldInput  = "input"
+	fieldResult = "result"
+)
+
+var (
+	// ErrStoreNotConfigured indicates that a payload reference was found but no blobstore is configured
+	ErrStoreNotConfigured = &shared.InternalServiceError{Message: "payload reference found but blobstore is not configured"}
+
+	errInvalidKeyInput = errors.New("invalid input to construct payload key")
+)
+
+type (
+	// Store offloads large payloads of history events to the blobstore and resolves
+	// the references which are left in the events in their place
+	Store interface {
+		// OffloadEvents returns the events with every payload larger than threshold replaced by a reference to a
+		// blob in bucket, the events with offloaded payloads are copies and the given events are left unchanged.
+		// Buffered events can be offloaded too so that large payloads are not persisted in the mutable state.
+		OffloadEvents(ctx context.Context, bucket string, threshold int, domainID, workflowID, runID string,
+			events []*shared.HistoryEvent) ([]*shared.HistoryEvent, error)
+		// ResolveEvents replaces every payload reference in events with the referenced payload
+		ResolveEvents(ctx context.Context, events []*shared.HistoryEvent) error
+		// Resolve downloads the payload the reference points to
+		Resolve(ctx context.Context, ref *shared.PayloadReference) ([]byte, error)
+		// DeleteExecution deletes all payloads offloaded for a workflow execution from bucket
+		DeleteExecution(ctx context.Context, bucket string, domainID, workflowID, runID string) error
+		// Enabled returns false if no blobstore is configured, nothing is offloaded then
+		Enabled() bool
+	}
+
+	storeImpl struct {
+		client blobstore.Client
+	}
+
+	payloadField struct {
+		name string
+		data *[]byte
+		ref  **shared.PayloadReference
+	}
+)
+
+var _ Store = (*storeImpl)(nil)
+
+// NewStore creates a new payload store backed by the given blobstore client,
+// client can be nil in which case offloading is disabled
+func NewStore(client blobstore.Client) Store {
+	return &storeImpl{
+		client: client,
+	}
+}
+
+func (s *storeImpl) OffloadEvents(
+	ctx context.Context,
+	bucket string,
+	threshold int,
+	domainID string,
+	workflowID string,
+	runID string,
+	events []*shared.HistoryEvent,
+) ([]*shared.HistoryEvent, error) {
+
+	if s.client == nil || len(bucket) == 0 || threshold <= 0 {
+		return events, nil
+	}
+	var offloaded []*shared.HistoryEvent
+	for i, event := range events {
+		if !hasLargePayload(event, threshold) {
+			continue
+		}
+		// the events may be held by the mutable state and the events cache, which keep the payloads
+		event = copyPayloadEvent(event)
+		for _, field := range payloadFields(event) {
+			if *field.ref != nil || len(*field.data) <= threshold {
+				continue
+			}
+			key, err := newEventKey(domainID, workflowID, runID, event.GetEventId(), field.name)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Upload(ctx, bucket, key, blob.NewBlob(*field.data, map[string]string{})); err != nil {
+				return nil, err
+			}
+			*field.ref = &shared.PayloadReference{
+				Bucket: common.StringPtr(bucket),
+				Key:    common.StringPtr(key.String()),
+				Size:   common.Int64Ptr(int64(len(*field.data))),
+			}
+			*field.data = nil
+		}
+		if offloaded == nil {
+			offloaded = make([]*shared.HistoryEvent, len(events))
+			copy(offloaded, events)
+		}
+		offloaded[i] = event
+	}
+	if offloaded == nil {
+		return events, nil
+	}
+	return offloaded, nil
+}
+
+func (s *storeImpl) ResolveEvents(
+	ctx context.Context,
+	events []*shared.HistoryEvent,
+) error {
+
+	for _, event := range events {
+		for _, field := range payloadFields(event) {
+			if *field.ref == nil {
+				continue
+			}
+			data, err := s.Resolve(ctx, *field.ref)
+			if err != nil {
+				return err
+			}
+			*field.data = data
+			*field.ref = nil
+		}
+	}
+	return nil
+}
+
+func (s *storeImpl) Resolve(
+	ctx context.Context,
+	ref *shared.PayloadReference,
+) ([]byte, error) {
+
+	if ref == nil {
+		return nil, nil
+	}
+	if s.client == nil {
+		return nil, ErrStoreNotConfigured
+	}
+	key, err := blob.NewKeyFromString(ref.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.client.Download(ctx, ref.GetBucket(), key)
+	if err != nil {
+		return nil, err
+	}
+	return b.Body, nil
+}
+
+func (s *storeImpl) Enabled() bool {
+	return s.client != nil
+}
+
+func (s *storeImpl) DeleteExecution(
+	ctx context.Context,
+	bucket string,
+	domainID string,
+	workflowID string,
+	runID string,
+) error {
+
+	if s.client == nil || len(bucket) == 0 {
+		return nil
+	}
+	hash, err := executionHash(domainID, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	prefix := strings.Join([]string{keyPrefix, hash, ""}, "_")
+	keys, err := s.client.ListByPrefix(ctx, bucket, prefix)
+	if err != nil {
+		if err == blobstore.ErrBucketNotExists {
+			return nil
+		}
+		return err
+	}
+	for _, key := range keys {
+		if _, err := s.client.Delete(ctx, bucket, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewKey constructs the blob key of an offloaded payload, all payloads of an execution share a common prefix
+func NewKey(domainID, workflowID, runID string, eventID int64, field string) (blob.Key, error) {
+	hash, err := executionHash(domainID, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return blob.NewKey(keyExtension, keyPrefix, hash, strconv.FormatInt(eventID, 10), field)
+}
+
+// newEventKey constructs the blob key of a payload of the event, buffered events do not have an event ID
+// yet so their payloads get a unique key instead
+func newEventKey(domainID, workflowID, runID string, eventID int64, field string) (blob.Key, error) {
+	if eventID != common.BufferedEventID {
+		return NewKey(domainID, workflowID, runID, eventID, field)
+	}
+	hash, err := executionHash(domainID, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	id := bufferedKeyPrefix + strings.Replace(uuid.New(), "-", "", -1)
+	return blob.NewKey(keyExtension, keyPrefix, hash, id, field)
+}
+
+// HasReferences returns true if any of the events carries a payload reference
+func HasReferences(events []*shared.HistoryEvent) bool {
+	for _, event := range events {
+		for _, field := range payloadFields(event) {
+			if *field.ref != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AddReferencedBuckets adds the buckets the payload references of the events point to
+func AddReferencedBuckets(buckets map[string]struct{}, events []*shared.HistoryEvent) {
+	for _, event := range events {
+		for _, field := range payloadFields(event) {
+			if *field.ref != nil {
+				buckets[(*field.ref).GetBucket()] = struct{}{}
+			}
+		}
+	}
+}
+
+func hasLargePayload(event *shared.HistoryEvent, threshold int) bool {
+	for _, field := range payloadFields(event) {
+		if *field.ref == nil && len(*field.data) > threshold {
+			return true
+		}
+	}
+	return false
+}
+
+// copyPayloadEvent copies the event along with its payload carrying attributes, so that payloads can be
+// replaced by references on the copy only
+func copyPayloadEvent(event *shared.HistoryEvent) *shared.HistoryEvent {
+	copied := *event
+	switch event.GetEventType() {
+	case shared.EventTypeWorkflowExecutionStarted:
+		attr := *event.WorkflowExecutionStartedEventAttributes
+		copied.WorkflowExecutionStartedEventAttributes = &attr
+	case shared.EventTypeWorkflowExecutionCompleted:
+		attr := *event.WorkflowExecutionCompletedEventAttributes
+		copied.WorkflowExecutionCompletedEventAttributes = &attr
+	case shared.EventTypeActivityTaskScheduled:
+		attr := *event.ActivityTaskScheduledEventAttributes
+		copied.ActivityTaskScheduledEventAttributes = &attr
+	case shared.EventTypeActivityTaskCompleted:
+		attr := *event.ActivityTaskCompletedEventAttributes
+		copied.ActivityTaskCompletedEventAttributes = &attr
+	case shared.EventTypeWorkflowExecutionSignaled:
+		attr := *event.WorkflowExecutionSignaledEventAttributes
+		copied.WorkflowExecutionSignaledEventAttributes = &attr
+	}
+	return &copied
+}
+
+func executionHash(domainID, workflowID, runID string) (string, error) {
+	if len(domainID) == 0 || len(workflowID) == 0 || len(runID) == 0 {
+		return "", errInvalidKeyInput
+	}
+	domainIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(domainID)))
+	workflowIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(workflowID)))
+	runIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(runID)))
+	return strings.Join([]string{domainIDHash, workflowIDHash, runIDHash}, ""), nil
+}
+
+func payloadFields(event *shared.HistoryEvent) []payloadField {
+	switch event.GetEventType() {
+	case shared.EventTypeWorkflowExecutionStarted:
+		if attr := event.WorkflowExecutionStartedEventAttributes; attr != nil {
+			return []payloadField{{name: fieldInput, data: &attr.Input, ref: &attr.InputReference}}
+		}
+	case shared.EventTypeWorkflowExecutionCompleted:
+		if attr := event.WorkflowExecutionCompletedEventAttributes; attr != nil {
+			return []payloadField{{name: fieldResult, data: &attr.Result, ref: &attr.ResultReference}}
+		}
+	case shared.EventTypeActivityTaskScheduled:
+		if attr := event.ActivityTaskScheduledEventAttributes; attr != nil {
+			return []payloadField{{name: fieldInput, data: &attr.Input, ref: &attr.InputReference}}
+		}
+	case shared.EventTypeActivityTaskCompleted:
+		if attr := event.ActivityTaskCompletedEventAttributes; attr != nil {
+			return []payloadField{{name: fieldResult, data: &attr.Result, ref: &attr.ResultReference}}
+		}
+	case shared.EventTypeWorkflowExecutionSignaled:
+		if attr := event.WorkflowExecutionSignaledEventAttributes; attr != nil {
+			return []payloadField{{name: fieldInput, data: &attr.Input, ref: &attr.InputReference}}
+		}
+	}
+	return nil
+}
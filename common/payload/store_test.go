@@ -0,0 +1,200 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/blob"
+	"github.com/uber/cadence/common/mocks"
+)
+
+const (
+	testBucket     = "test-bucket"
+	testDomainID   = "test-domain-id"
+	testWorkflowID = "test-workflow-id"
+	testRunID      = "test-run-id"
+)
+
+type storeSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	mockClient *mocks.BlobstoreClient
+	store      Store
+}
+
+func TestStoreSuite(t *testing.T) {
+	suite.Run(t, new(storeSuite))
+}
+
+func (s *storeSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockClient = &mocks.BlobstoreClient{}
+	s.store = NewStore(s.mockClient)
+}
+
+func (s *storeSuite) TearDownTest() {
+	s.mockClient.AssertExpectations(s.T())
+}
+
+func (s *storeSuite) TestOffloadEvents_AboveThreshold() {
+	large := []byte("large activity input")
+	small := []byte("small")
+	events := []*shared.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(5),
+			EventType: shared.EventTypeActivityTaskScheduled.Ptr(),
+			ActivityTaskScheduledEventAttributes: &shared.ActivityTaskScheduledEventAttributes{
+				Input: large,
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(6),
+			EventType: shared.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &shared.WorkflowExecutionSignaledEventAttributes{
+				Input: small,
+			},
+		},
+	}
+	key, err := NewKey(testDomainID, testWorkflowID, testRunID, 5, fieldInput)
+	s.NoError(err)
+	s.mockClient.On("Upload", mock.Anything, testBucket, key, mock.MatchedBy(func(b *blob.Blob) bool {
+		return string(b.Body) == string(large)
+	})).Return(nil).Once()
+
+	offloaded, err := s.store.OffloadEvents(context.Background(), testBucket, 10, testDomainID, testWorkflowID, testRunID, events)
+	s.NoError(err)
+
+	attr := offloaded[0].ActivityTaskScheduledEventAttributes
+	s.Nil(attr.Input)
+	s.Equal(testBucket, attr.InputReference.GetBucket())
+	s.Equal(key.String(), attr.InputReference.GetKey())
+	s.Equal(int64(len(large)), attr.InputReference.GetSize())
+	s.Equal(events[1], offloaded[1])
+	s.True(HasReferences(offloaded))
+
+	// the given events keep their payloads
+	s.Equal(large, events[0].ActivityTaskScheduledEventAttributes.Input)
+	s.False(HasReferences(events))
+
+	buckets := make(map[string]struct{})
+	AddReferencedBuckets(buckets, offloaded)
+	s.Equal(map[string]struct{}{testBucket: {}}, buckets)
+}
+
+func (s *storeSuite) TestOffloadEvents_Buffered() {
+	var events []*shared.HistoryEvent
+	for i := 0; i < 2; i++ {
+		events = append(events, &shared.HistoryEvent{
+			EventId:   common.Int64Ptr(common.BufferedEventID),
+			EventType: shared.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &shared.WorkflowExecutionSignaledEventAttributes{
+				Input: []byte("large signal input"),
+			},
+		})
+	}
+	s.mockClient.On("Upload", mock.Anything, testBucket, mock.Anything, mock.Anything).Return(nil).Twice()
+
+	offloaded, err := s.store.OffloadEvents(context.Background(), testBucket, 10, testDomainID, testWorkflowID, testRunID, events)
+	s.NoError(err)
+
+	// buffered events share the event ID, their payloads must not overwrite each other
+	ref1 := offloaded[0].WorkflowExecutionSignaledEventAttributes.InputReference
+	ref2 := offloaded[1].WorkflowExecutionSignaledEventAttributes.InputReference
+	s.NotNil(ref1)
+	s.NotNil(ref2)
+	s.NotEqual(ref1.GetKey(), ref2.GetKey())
+	hash, err := executionHash(testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	for _, ref := range []*shared.PayloadReference{ref1, ref2} {
+		s.True(strings.HasPrefix(ref.GetKey(), keyPrefix+"_"+hash+"_"))
+	}
+}
+
+func (s *storeSuite) TestOffloadEvents_Disabled() {
+	events := []*shared.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+				Input: []byte("workflow input"),
+			},
+		},
+	}
+	offloaded, err := s.store.OffloadEvents(context.Background(), "", 1, testDomainID, testWorkflowID, testRunID, events)
+	s.NoError(err)
+	s.Equal(events, offloaded)
+	offloaded, err = s.store.OffloadEvents(context.Background(), testBucket, 0, testDomainID, testWorkflowID, testRunID, events)
+	s.NoError(err)
+	s.Equal(events, offloaded)
+	s.Equal([]byte("workflow input"), events[0].WorkflowExecutionStartedEventAttributes.Input)
+	s.False(HasReferences(events))
+}
+
+func (s *storeSuite) TestResolveEvents() {
+	key, err := NewKey(testDomainID, testWorkflowID, testRunID, 7, fieldResult)
+	s.NoError(err)
+	events := []*shared.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(7),
+			EventType: shared.EventTypeActivityTaskCompleted.Ptr(),
+			ActivityTaskCompletedEventAttributes: &shared.ActivityTaskCompletedEventAttributes{
+				ResultReference: &shared.PayloadReference{
+					Bucket: common.StringPtr(testBucket),
+					Key:    common.StringPtr(key.String()),
+				},
+			},
+		},
+	}
+	s.mockClient.On("Download", mock.Anything, testBucket, key).Return(blob.NewBlob([]byte("activity result"), nil), nil).Once()
+
+	s.NoError(s.store.ResolveEvents(context.Background(), events))
+	s.Equal([]byte("activity result"), events[0].ActivityTaskCompletedEventAttributes.Result)
+	s.Nil(events[0].ActivityTaskCompletedEventAttributes.ResultReference)
+}
+
+func (s *storeSuite) TestResolve_NotConfigured() {
+	store := NewStore(nil)
+	_, err := store.Resolve(context.Background(), &shared.PayloadReference{Bucket: common.StringPtr(testBucket)})
+	s.Equal(ErrStoreNotConfigured, err)
+}
+
+func (s *storeSuite) TestDeleteExecution() {
+	key1, err := NewKey(testDomainID, testWorkflowID, testRunID, 1, fieldInput)
+	s.NoError(err)
+	key2, err := NewKey(testDomainID, testWorkflowID, testRunID, 9, fieldResult)
+	s.NoError(err)
+	s.mockClient.On("ListByPrefix", mock.Anything, testBucket, mock.MatchedBy(func(prefix string) bool {
+		return len(prefix) > 0 && key1.String()[:len(prefix)] == prefix && key2.String()[:len(prefix)] == prefix
+	})).Return([]blob.Key{key1, key2}, nil).Once()
+	s.mockClient.On("Delete", mock.Anything, testBucket, key1).Return(true, nil).Once()
+	s.mockClient.On("Delete", mock.Anything, testBucket, key2).Return(true, nil).Once()
+
+	s.NoError(s.store.DeleteExecution(context.Background(), testBucket, testDomainID, testWorkflowID, testRunID))
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	templateUpsertClusterMembership = `INSERT INTO cluster_membership (` +
+		`role, rpc_address, session_start, last_heartbeat) ` +
+		`VALUES (?, ?, ?, ?) USING TTL ?`
+
+	templateGetClusterMembers = `SELECT rpc_address, session_start, last_heartbeat FROM cluster_membership ` +
+		`WHERE role = ?`
+)
+
+type (
+	cassandraClusterMembershipPersistence struct {
+		cassandraStore
+	}
+)
+
+// newClusterMembershipPersistence is used to create an instance of ClusterMembershipStore implementation
+func newClusterMembershipPersistence(cfg config.Cassandra, logger log.Logger) (p.ClusterMembershipStore, error) {
+	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
+	cluster.Keyspace = cfg.Keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassandraClusterMembershipPersistence{cassandraStore: cassandraStore{session: session, logger: logger}}, nil
+}
+
+// UpsertClusterMembership records the heartbeat of a host, the record is expired by cassandra TTL
+func (m *cassandraClusterMembershipPersistence) UpsertClusterMembership(request *p.UpsertClusterMembershipRequest) error {
+	ttl := int64(request.RecordExpiry.Seconds())
+	if ttl <= 0 {
+		return &p.InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("UpsertClusterMembership requires a positive record expiry, got %v", request.RecordExpiry),
+		}
+	}
+
+	query := m.session.Query(templateUpsertClusterMembership,
+		request.Role,
+		request.RPCAddress,
+		request.SessionStart,
+		time.Now(),
+		ttl)
+	if err := query.Exec(); err != nil {
+		return convertCommonErrors("UpsertClusterMembership", err)
+	}
+	return nil
+}
+
+// GetClusterMembers returns the hosts of a role which heartbeated recently
+func (m *cassandraClusterMembershipPersistence) GetClusterMembers(request *p.GetClusterMembersRequest) (*p.GetClusterMembersResponse, error) {
+	query := m.session.Query(templateGetClusterMembers, request.Role)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetClusterMembers operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	var cutoff time.Time
+	if request.LastHeartbeatWithin > 0 {
+		cutoff = time.Now().Add(-request.LastHeartbeatWithin)
+	}
+
+	var members []*p.ClusterMember
+	var rpcAddress string
+	var sessionStart, lastHeartbeat time.Time
+	for iter.Scan(&rpcAddress, &sessionStart, &lastHeartbeat) {
+		if lastHeartbeat.After(cutoff) {
+			members = append(members, &p.ClusterMember{
+				Role:          request.Role,
+				RPCAddress:    rpcAddress,
+				SessionStart:  sessionStart,
+				LastHeartbeat: lastHeartbeat,
+			})
+		}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("GetClusterMembers", err)
+	}
+
+	return &p.GetClusterMembersResponse{ActiveMembers: members}, nil
+}
+
+// PruneClusterMembership is a no-op, cassandra expires the records by TTL
+func (m *cassandraClusterMembershipPersistence) PruneClusterMembership(request *p.PruneClusterMembershipRequest) error {
+	return nil
+}
@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"encoding/binary"
 	"fmt"
 	"sort"
 	"time"
@@ -42,6 +43,9 @@ const (
 	v2templateReadData = `SELECT node_id, txn_id, data, data_encoding FROM history_node ` +
 		`WHERE tree_id = ? AND branch_id = ? AND node_id >= ? AND node_id < ? `
 
+	v2templateReadDataReverse = `SELECT node_id, txn_id, data, data_encoding FROM history_node ` +
+		`WHERE tree_id = ? AND branch_id = ? AND node_id >= ? AND node_id < ? ORDER BY branch_id DESC, node_id DESC LIMIT ? `
+
 	v2templateRangeDeleteData = `DELETE FROM history_node WHERE tree_id = ? AND branch_id = ? AND node_id >= ? `
 
 	// below are templates for history_tree table
@@ -148,6 +152,10 @@ func (h *cassandraHistoryV2Persistence) AppendHistoryNodes(request *p.InternalAp
 // ReadHistoryBranch returns history node data for a branch
 // NOTE: For branch that has ancestors, we need to query Cassandra multiple times, because it doesn't support OR/UNION operator
 func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+	if request.Reverse {
+		return h.readHistoryBranchReverse(request)
+	}
+
 	treeID := request.TreeID
 	branchID := request.BranchID
 
@@ -200,6 +208,97 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(request *p.InternalRea
 	return response, nil
 }
 
+// readHistoryBranchReverse returns history node data for a branch in descending order of node ID
+// NOTE: reading in reverse order, Cassandra returns the rows of the same node in ascending order of txn_id,
+// so instead of the page state, the paging token is the smallest node ID that is fully read. This makes sure
+// the rows of a node are never split into two pages and the one with the largest txn_id wins. A page holding
+// the rows of a single node reads that node again in ascending order of node ID, which returns the row with the
+// largest txn_id first, because the rows beyond the page size could not be dropped to the next page.
+func (h *cassandraHistoryV2Persistence) readHistoryBranchReverse(request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+	maxNodeID := request.MaxNodeID
+	if len(request.NextPageToken) > 0 {
+		if len(request.NextPageToken) != 8 {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken),
+			}
+		}
+		maxNodeID = int64(binary.LittleEndian.Uint64(request.NextPageToken))
+	}
+
+	query := h.session.Query(v2templateReadDataReverse,
+		request.TreeID, request.BranchID, request.MinNodeID, maxNodeID, request.PageSize)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ReadHistoryBranch operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	history := make([]*p.DataBlob, 0, int(request.PageSize))
+	nodeIDs := make([]int64, 0, int(request.PageSize))
+	rowCount := 0
+	lastTxnID := int64(-1)
+	eventBlob := &p.DataBlob{}
+	nodeID := int64(0)
+	txnID := int64(0)
+
+	for iter.Scan(&nodeID, &txnID, &eventBlob.Data, &eventBlob.Encoding) {
+		rowCount++
+		if len(nodeIDs) > 0 && nodeID == nodeIDs[len(nodeIDs)-1] {
+			if txnID <= lastTxnID {
+				return nil, &workflow.InternalServiceError{
+					Message: fmt.Sprintf("corrupted data, same nodeID must have larger txnID in reverse order"),
+				}
+			}
+			// the node with larger txn_id wins
+			lastTxnID = txnID
+			history[len(history)-1] = eventBlob
+			eventBlob = &p.DataBlob{}
+			continue
+		}
+		lastTxnID = txnID
+		nodeIDs = append(nodeIDs, nodeID)
+		history = append(history, eventBlob)
+		eventBlob = &p.DataBlob{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ReadHistoryBranch. Close operation failed. Error: %v", err),
+		}
+	}
+
+	var pagingToken []byte
+	if rowCount >= request.PageSize {
+		// rows of the last node may continue beyond this page, so it is read again with the next page,
+		// unless it is the only node of this page
+		if len(nodeIDs) > 1 {
+			history = history[:len(history)-1]
+			nodeIDs = nodeIDs[:len(nodeIDs)-1]
+		} else {
+			eventBlob = &p.DataBlob{}
+			query := h.session.Query(v2templateReadData,
+				request.TreeID, request.BranchID, nodeIDs[0], nodeIDs[0]+1)
+			if err := query.Scan(&nodeID, &txnID, &eventBlob.Data, &eventBlob.Encoding); err != nil {
+				return nil, &workflow.InternalServiceError{
+					Message: fmt.Sprintf("ReadHistoryBranch operation failed. Error: %v", err),
+				}
+			}
+			history[0] = eventBlob
+		}
+		pagingToken = make([]byte, 8)
+		binary.LittleEndian.PutUint64(pagingToken, uint64(nodeIDs[len(nodeIDs)-1]))
+	}
+
+	response := &p.InternalReadHistoryBranchResponse{
+		History:       history,
+		NextPageToken: pagingToken,
+	}
+
+	return response, nil
+}
+
 // ForkHistoryBranch forks a new branch from an existing branch
 // Note that application must provide a void forking nodeID, it must be a valid nodeID in that branch.
 // A valid forking nodeID can be an ancestor from the existing branch.
@@ -107,7 +107,8 @@ const (
 		`timer_ack_level: ?, ` +
 		`cluster_transfer_ack_level: ?, ` +
 		`cluster_timer_ack_level: ?, ` +
-		`domain_notification_version: ? ` +
+		`domain_notification_version: ?, ` +
+		`running_workflow_counts: ? ` +
 		`}`
 
 	templateWorkflowExecutionType = `{` +
@@ -164,7 +165,18 @@ const (
 		`event_store_version: ?, ` +
 		`branch_token: ?, ` +
 		`cron_schedule: ?, ` +
-		`expiration_seconds: ? ` +
+		`expiration_seconds: ?, ` +
+		`retention_days: ?, ` +
+		`workflow_execution_timeout: ?, ` +
+		`workflow_expiration_time: ?, ` +
+		`recent_signal_request_ids: ?, ` +
+		`decision_heartbeat_count: ?, ` +
+		`decision_heartbeat_timestamp: ?, ` +
+		`last_binary_checksum: ?, ` +
+		`delayed_signals: ?, ` +
+		`delayed_signals_encoding: ?, ` +
+		`accepted_updates: ?, ` +
+		`accepted_updates_encoding: ? ` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -189,6 +201,7 @@ const (
 		`type: ?, ` +
 		`schedule_id: ?, ` +
 		`record_visibility: ?, ` +
+		`trace_context: ?, ` +
 		`version: ?` +
 		`}`
 
@@ -220,7 +233,8 @@ const (
 		`timeout_type: ?, ` +
 		`event_id: ?, ` +
 		`schedule_attempt: ?, ` +
-		`version: ?` +
+		`version: ?, ` +
+		`signal_request_id: ?` +
 		`}`
 
 	templateActivityInfoType = `{` +
@@ -324,14 +338,19 @@ const (
 		`type: ?, ` +
 		`ack_level: ?, ` +
 		`kind: ?, ` +
-		`last_updated: ? ` +
+		`last_updated: ?, ` +
+		`default_binary_checksum: ?, ` +
+		`compatible_binary_checksums: ? ` +
 		`}`
 
 	templateTaskType = `{` +
 		`domain_id: ?, ` +
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
-		`schedule_id: ?` +
+		`schedule_id: ?, ` +
+		`activity_type: ?, ` +
+		`binary_checksum: ?, ` +
+		`created_time: ?` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -989,6 +1008,7 @@ func (d *cassandraPersistence) CreateShard(request *p.CreateShardRequest) error
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
 		shardInfo.DomainNotificationVersion,
+		shardInfo.RunningWorkflowCounts,
 		shardInfo.RangeID)
 
 	previous := make(map[string]interface{})
@@ -1064,6 +1084,7 @@ func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
 		shardInfo.DomainNotificationVersion,
+		shardInfo.RunningWorkflowCounts,
 		shardInfo.RangeID,
 		shardInfo.ShardID,
 		rowTypeShard,
@@ -1405,6 +1426,17 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Int
 			request.BranchToken,
 			request.CronSchedule,
 			request.ExpirationSeconds,
+			request.RetentionDays,
+			request.WorkflowExecutionTimeout,
+			request.WorkflowExpirationTime,
+			request.RecentSignalRequestIDs,
+			0,   // decision_heartbeat_count
+			0,   // decision_heartbeat_timestamp
+			"",  // last_binary_checksum
+			nil, // delayed_signals
+			"",  // delayed_signals_encoding
+			nil, // accepted_updates
+			"",  // accepted_updates_encoding
 			request.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
@@ -1474,6 +1506,17 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Int
 			request.BranchToken,
 			request.CronSchedule,
 			request.ExpirationSeconds,
+			request.RetentionDays,
+			request.WorkflowExecutionTimeout,
+			request.WorkflowExpirationTime,
+			request.RecentSignalRequestIDs,
+			0,   // decision_heartbeat_count
+			0,   // decision_heartbeat_timestamp
+			"",  // last_binary_checksum
+			nil, // delayed_signals
+			"",  // delayed_signals_encoding
+			nil, // accepted_updates
+			"",  // accepted_updates_encoding
 			request.ReplicationState.CurrentVersion,
 			request.ReplicationState.StartVersion,
 			request.ReplicationState.LastWriteVersion,
@@ -1665,6 +1708,17 @@ func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionI
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.RetentionDays,
+			executionInfo.WorkflowExecutionTimeout,
+			executionInfo.WorkflowExpirationTime,
+			executionInfo.RecentSignalRequestIDs,
+			executionInfo.DecisionHeartbeatCount,
+			executionInfo.DecisionHeartbeatTimestamp,
+			executionInfo.LastBinaryChecksum,
+			executionInfo.DelayedSignals.Data,
+			executionInfo.DelayedSignals.GetEncoding(),
+			executionInfo.AcceptedUpdates.Data,
+			executionInfo.AcceptedUpdates.GetEncoding(),
 			executionInfo.NextEventID,
 			d.shardID,
 			rowTypeExecution,
@@ -1734,6 +1788,17 @@ func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionI
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.RetentionDays,
+			executionInfo.WorkflowExecutionTimeout,
+			executionInfo.WorkflowExpirationTime,
+			executionInfo.RecentSignalRequestIDs,
+			executionInfo.DecisionHeartbeatCount,
+			executionInfo.DecisionHeartbeatTimestamp,
+			executionInfo.LastBinaryChecksum,
+			executionInfo.DelayedSignals.Data,
+			executionInfo.DelayedSignals.GetEncoding(),
+			executionInfo.AcceptedUpdates.Data,
+			executionInfo.AcceptedUpdates.GetEncoding(),
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -2574,6 +2639,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		taskListTaskID,
 	)
 	var rangeID, ackLevel int64
+	var defaultBinaryChecksum string
+	var compatibleBinaryChecksums []string
 	var tlDB map[string]interface{}
 	err := query.Scan(&rangeID, &tlDB)
 	if err != nil {
@@ -2591,6 +2658,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 				0,
 				request.TaskListKind,
 				now,
+				"",
+				compatibleBinaryChecksums,
 			)
 		} else if isThrottlingError(err) {
 			return nil, &workflow.ServiceBusyError{
@@ -2615,6 +2684,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		}
 		ackLevel = tlDB["ack_level"].(int64)
 		taskListKind := tlDB["kind"].(int)
+		defaultBinaryChecksum = tlDB["default_binary_checksum"].(string)
+		compatibleBinaryChecksums = tlDB["compatible_binary_checksums"].([]string)
 		query = d.session.Query(templateUpdateTaskListQuery,
 			rangeID+1,
 			request.DomainID,
@@ -2623,6 +2694,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 			ackLevel,
 			taskListKind,
 			now,
+			defaultBinaryChecksum,
+			compatibleBinaryChecksums,
 			request.DomainID,
 			&request.TaskList,
 			request.TaskType,
@@ -2651,13 +2724,15 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		}
 	}
 	tli := &p.TaskListInfo{
-		DomainID:    request.DomainID,
-		Name:        request.TaskList,
-		TaskType:    request.TaskType,
-		RangeID:     rangeID + 1,
-		AckLevel:    ackLevel,
-		Kind:        request.TaskListKind,
-		LastUpdated: now,
+		DomainID:                  request.DomainID,
+		Name:                      request.TaskList,
+		TaskType:                  request.TaskType,
+		RangeID:                   rangeID + 1,
+		AckLevel:                  ackLevel,
+		Kind:                      request.TaskListKind,
+		LastUpdated:               now,
+		DefaultBinaryChecksum:     defaultBinaryChecksum,
+		CompatibleBinaryChecksums: compatibleBinaryChecksums,
 	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -2680,6 +2755,8 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 			tli.AckLevel,
 			tli.Kind,
 			time.Now(),
+			tli.DefaultBinaryChecksum,
+			tli.CompatibleBinaryChecksums,
 			stickyTaskListTTL,
 		)
 		err := query.Exec()
@@ -2704,6 +2781,8 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 		tli.AckLevel,
 		tli.Kind,
 		time.Now(),
+		tli.DefaultBinaryChecksum,
+		tli.CompatibleBinaryChecksums,
 		tli.DomainID,
 		&tli.Name,
 		tli.TaskType,
@@ -2790,7 +2869,10 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				domainID,
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
-				scheduleID)
+				scheduleID,
+				task.Data.ActivityType,
+				task.Data.BinaryChecksum,
+				task.Data.CreatedTime)
 		} else {
 			batch.Query(templateCreateTaskWithTTLQuery,
 				domainID,
@@ -2802,6 +2884,9 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
 				scheduleID,
+				task.Data.ActivityType,
+				task.Data.BinaryChecksum,
+				task.Data.CreatedTime,
 				task.Data.ScheduleToStartTimeout)
 		}
 	}
@@ -2815,6 +2900,8 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 		ackLevel,
 		taskListKind,
 		time.Now(),
+		request.TaskListInfo.DefaultBinaryChecksum,
+		request.TaskListInfo.CompatibleBinaryChecksums,
 		domainID,
 		taskList,
 		taskListType,
@@ -3004,18 +3091,21 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 		targetRunID := p.TransferTaskTransferTargetRunID
 		targetChildWorkflowOnly := false
 		recordVisibility := false
+		var traceContext []byte
 
 		switch task.GetType() {
 		case p.TransferTaskTypeActivityTask:
 			targetDomainID = task.(*p.ActivityTask).DomainID
 			taskList = task.(*p.ActivityTask).TaskList
 			scheduleID = task.(*p.ActivityTask).ScheduleID
+			traceContext = task.(*p.ActivityTask).TraceContext
 
 		case p.TransferTaskTypeDecisionTask:
 			targetDomainID = task.(*p.DecisionTask).DomainID
 			taskList = task.(*p.DecisionTask).TaskList
 			scheduleID = task.(*p.DecisionTask).ScheduleID
 			recordVisibility = task.(*p.DecisionTask).RecordVisibility
+			traceContext = task.(*p.DecisionTask).TraceContext
 
 		case p.TransferTaskTypeCancelExecution:
 			targetDomainID = task.(*p.CancelExecutionTask).TargetDomainID
@@ -3070,6 +3160,7 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 			task.GetType(),
 			scheduleID,
 			recordVisibility,
+			traceContext,
 			task.GetVersion(),
 			defaultVisibilityTimestamp,
 			task.GetTaskID())
@@ -3146,6 +3237,7 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 	for _, task := range timerTasks {
 		var eventID int64
 		var attempt int64
+		var signalRequestID string
 
 		timeoutType := 0
 
@@ -3166,6 +3258,8 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 		case *p.WorkflowBackoffTimerTask:
 			eventID = t.EventID
 			timeoutType = t.TimeoutType
+		case *p.DelayedSignalTask:
+			signalRequestID = t.RequestID
 		}
 
 		// Ignoring possible type cast errors.
@@ -3187,6 +3281,7 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 			eventID,
 			attempt,
 			task.GetVersion(),
+			signalRequestID,
 			ts,
 			task.GetTaskID())
 	}
@@ -3690,6 +3785,8 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			info.ClusterTimerAckLevel = v.(map[string]time.Time)
 		case "domain_notification_version":
 			info.DomainNotificationVersion = v.(int64)
+		case "running_workflow_counts":
+			info.RunningWorkflowCounts = v.(map[string]map[string]int64)
 		}
 	}
 
@@ -3713,6 +3810,10 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *p.InternalWorkf
 	var completionEventEncoding common.EncodingType
 	var autoResetPoints []byte
 	var autoResetPointsEncoding common.EncodingType
+	var delayedSignals []byte
+	var delayedSignalsEncoding common.EncodingType
+	var acceptedUpdates []byte
+	var acceptedUpdatesEncoding common.EncodingType
 
 	for k, v := range result {
 		switch k {
@@ -3830,10 +3931,34 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *p.InternalWorkf
 			info.CronSchedule = v.(string)
 		case "expiration_seconds":
 			info.ExpirationSeconds = int32(v.(int))
+		case "retention_days":
+			info.RetentionDays = int32(v.(int))
+		case "workflow_execution_timeout":
+			info.WorkflowExecutionTimeout = int32(v.(int))
+		case "workflow_expiration_time":
+			info.WorkflowExpirationTime = v.(time.Time)
+		case "recent_signal_request_ids":
+			info.RecentSignalRequestIDs = v.(map[string]time.Time)
+		case "decision_heartbeat_count":
+			info.DecisionHeartbeatCount = int32(v.(int))
+		case "decision_heartbeat_timestamp":
+			info.DecisionHeartbeatTimestamp = v.(int64)
+		case "last_binary_checksum":
+			info.LastBinaryChecksum = v.(string)
+		case "delayed_signals":
+			delayedSignals = v.([]byte)
+		case "delayed_signals_encoding":
+			delayedSignalsEncoding = common.EncodingType(v.(string))
+		case "accepted_updates":
+			acceptedUpdates = v.([]byte)
+		case "accepted_updates_encoding":
+			acceptedUpdatesEncoding = common.EncodingType(v.(string))
 		}
 	}
 	info.CompletionEvent = p.NewDataBlob(completionEventData, completionEventEncoding)
 	info.AutoResetPoints = p.NewDataBlob(autoResetPoints, autoResetPointsEncoding)
+	info.DelayedSignals = p.NewDataBlob(delayedSignals, delayedSignalsEncoding)
+	info.AcceptedUpdates = p.NewDataBlob(acceptedUpdates, acceptedUpdatesEncoding)
 	return info
 }
 
@@ -3898,6 +4023,8 @@ func createTransferTaskInfo(result map[string]interface{}) *p.TransferTaskInfo {
 			info.ScheduleID = v.(int64)
 		case "record_visibility":
 			info.RecordVisibility = v.(bool)
+		case "trace_context":
+			info.TraceContext = v.([]byte)
 		case "version":
 			info.Version = v.(int64)
 		}
@@ -4304,6 +4431,12 @@ func createTaskInfo(result map[string]interface{}) *p.TaskInfo {
 			info.RunID = v.(gocql.UUID).String()
 		case "schedule_id":
 			info.ScheduleID = v.(int64)
+		case "activity_type":
+			info.ActivityType = v.(string)
+		case "binary_checksum":
+			info.BinaryChecksum = v.(string)
+		case "created_time":
+			info.CreatedTime = v.(time.Time)
 		}
 	}
 
@@ -4334,6 +4467,8 @@ func createTimerTaskInfo(result map[string]interface{}) *p.TimerTaskInfo {
 			info.ScheduleAttempt = v.(int64)
 		case "version":
 			info.Version = v.(int64)
+		case "signal_request_id":
+			info.SignalRequestID = v.(string)
 		}
 	}
 
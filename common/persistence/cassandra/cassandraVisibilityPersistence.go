@@ -27,6 +27,7 @@ import (
 	"github.com/gocql/gocql"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
@@ -655,15 +656,16 @@ func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(request *p.Visi
 }
 
 func (v *cassandraVisibilityPersistence) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return listWorkflowExecutionsByQuery(v, request, es.StartTime)
 }
 
+// ScanWorkflowExecutions is the same as ListWorkflowExecutions since cassandra pagination does not rely on ordering
 func (v *cassandraVisibilityPersistence) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return listWorkflowExecutionsByQuery(v, request, es.StartTime)
 }
 
 func (v *cassandraVisibilityPersistence) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return nil, newVisibilityQueryError("CountWorkflowExecutions is not supported by cassandra visibility store")
 }
 
 func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*p.VisibilityWorkflowExecutionInfo, bool) {
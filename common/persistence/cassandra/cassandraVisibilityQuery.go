@@ -0,0 +1,246 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+	"math"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	es "github.com/uber/cadence/common/elasticsearch"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/visibilityquery"
+)
+
+type (
+	// visibilityQueryConditions is the subset of a visibility query that can be
+	// served by the list APIs of the cassandra visibility tables
+	visibilityQueryConditions struct {
+		open        *bool
+		workflowID  *string
+		typeName    *string
+		closeStatus *int64
+		timeRanges  map[string]*visibilityQueryTimeRange
+	}
+
+	visibilityQueryTimeRange struct {
+		earliest int64
+		latest   int64
+	}
+)
+
+// listWorkflowExecutionsByQuery serves a visibility query by translating it into one of the list APIs of store.
+// Only conjunctions of the following predicates are supported:
+//   - CloseTime = missing (open workflows) or CloseTime != missing (closed workflows)
+//   - one of WorkflowID = x, WorkflowType = x or CloseStatus = x (closed workflows only)
+//   - a range on StartTime for open workflows, or on closedTimeField for closed workflows
+func listWorkflowExecutionsByQuery(
+	store p.VisibilityStore,
+	request *p.ListWorkflowExecutionsRequestV2,
+	closedTimeField string,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := visibilityquery.Parse(request.Query)
+	if err != nil {
+		return nil, newVisibilityQueryError("Error when parse query: %v", err)
+	}
+	if len(query.OrderBy) > 0 {
+		return nil, newVisibilityQueryError("ORDER BY is not supported by cassandra visibility store")
+	}
+
+	conditions := &visibilityQueryConditions{timeRanges: make(map[string]*visibilityQueryTimeRange)}
+	if query.Filter != nil {
+		if err := conditions.add(query.Filter); err != nil {
+			return nil, err
+		}
+	}
+	if conditions.closeStatus != nil {
+		if conditions.open != nil && *conditions.open {
+			return nil, newVisibilityQueryError("%v cannot be used to query open workflows", es.CloseStatus)
+		}
+		conditions.open = common.BoolPtr(false)
+	}
+	if conditions.open == nil {
+		if _, ok := conditions.timeRanges[es.CloseTime]; !ok {
+			return nil, newVisibilityQueryError(
+				"query must specify either '%v = missing' for open workflows or '%v != missing' for closed workflows",
+				es.CloseTime, es.CloseTime)
+		}
+		conditions.open = common.BoolPtr(false)
+	}
+
+	timeField := es.StartTime
+	if !*conditions.open {
+		timeField = closedTimeField
+	}
+	timeRange := &visibilityQueryTimeRange{earliest: 0, latest: math.MaxInt64}
+	for field, r := range conditions.timeRanges {
+		if field != timeField {
+			return nil, newVisibilityQueryError("range on %v is not supported for this query, use %v instead", field, timeField)
+		}
+		timeRange = r
+	}
+
+	listRequest := p.ListWorkflowExecutionsRequest{
+		DomainUUID:        request.DomainUUID,
+		Domain:            request.Domain,
+		EarliestStartTime: timeRange.earliest,
+		LatestStartTime:   timeRange.latest,
+		PageSize:          request.PageSize,
+		NextPageToken:     request.NextPageToken,
+	}
+	switch {
+	case conditions.workflowID != nil && *conditions.open:
+		return store.ListOpenWorkflowExecutionsByWorkflowID(&p.ListWorkflowExecutionsByWorkflowIDRequest{
+			ListWorkflowExecutionsRequest: listRequest,
+			WorkflowID:                    *conditions.workflowID,
+		})
+	case conditions.workflowID != nil:
+		return store.ListClosedWorkflowExecutionsByWorkflowID(&p.ListWorkflowExecutionsByWorkflowIDRequest{
+			ListWorkflowExecutionsRequest: listRequest,
+			WorkflowID:                    *conditions.workflowID,
+		})
+	case conditions.typeName != nil && *conditions.open:
+		return store.ListOpenWorkflowExecutionsByType(&p.ListWorkflowExecutionsByTypeRequest{
+			ListWorkflowExecutionsRequest: listRequest,
+			WorkflowTypeName:              *conditions.typeName,
+		})
+	case conditions.typeName != nil:
+		return store.ListClosedWorkflowExecutionsByType(&p.ListWorkflowExecutionsByTypeRequest{
+			ListWorkflowExecutionsRequest: listRequest,
+			WorkflowTypeName:              *conditions.typeName,
+		})
+	case conditions.closeStatus != nil:
+		return store.ListClosedWorkflowExecutionsByStatus(&p.ListClosedWorkflowExecutionsByStatusRequest{
+			ListWorkflowExecutionsRequest: listRequest,
+			Status:                        workflow.WorkflowExecutionCloseStatus(*conditions.closeStatus),
+		})
+	case *conditions.open:
+		return store.ListOpenWorkflowExecutions(&listRequest)
+	default:
+		return store.ListClosedWorkflowExecutions(&listRequest)
+	}
+}
+
+func (c *visibilityQueryConditions) add(expr visibilityquery.Expression) error {
+	switch expr := expr.(type) {
+	case *visibilityquery.AndExpression:
+		if err := c.add(expr.Left); err != nil {
+			return err
+		}
+		return c.add(expr.Right)
+	case *visibilityquery.MissingExpression:
+		if expr.Field != es.CloseTime {
+			return newVisibilityQueryError("missing is only supported on %v", es.CloseTime)
+		}
+		open := !expr.Not
+		if c.open != nil && *c.open != open {
+			return newVisibilityQueryError("conflicting conditions on %v", es.CloseTime)
+		}
+		c.open = &open
+		return nil
+	case *visibilityquery.ComparisonExpression:
+		return c.addComparison(expr)
+	case *visibilityquery.RangeExpression:
+		if expr.Not {
+			return newVisibilityQueryError("NOT BETWEEN is not supported by cassandra visibility store")
+		}
+		if err := c.addTimeBound(expr.Field, visibilityquery.OperatorGreaterThanOrEqual, expr.From); err != nil {
+			return err
+		}
+		return c.addTimeBound(expr.Field, visibilityquery.OperatorLessThanOrEqual, expr.To)
+	case *visibilityquery.OrExpression:
+		return newVisibilityQueryError("OR is not supported by cassandra visibility store")
+	case *visibilityquery.NotExpression:
+		return newVisibilityQueryError("NOT is not supported by cassandra visibility store")
+	case *visibilityquery.InExpression:
+		return newVisibilityQueryError("IN is not supported by cassandra visibility store")
+	default:
+		return newVisibilityQueryError("unsupported expression: %T", expr)
+	}
+}
+
+func (c *visibilityQueryConditions) addComparison(expr *visibilityquery.ComparisonExpression) error {
+	switch expr.Field {
+	case es.StartTime, es.CloseTime:
+		return c.addTimeBound(expr.Field, expr.Operator, expr.Value)
+	}
+
+	if expr.Operator != visibilityquery.OperatorEqual {
+		return newVisibilityQueryError("operator %v on %v is not supported by cassandra visibility store", expr.Operator, expr.Field)
+	}
+	if c.workflowID != nil || c.typeName != nil || c.closeStatus != nil {
+		return newVisibilityQueryError("only one of %v, %v or %v can be specified", es.WorkflowID, es.WorkflowType, es.CloseStatus)
+	}
+	switch expr.Field {
+	case es.WorkflowID:
+		value := expr.Value.(string)
+		c.workflowID = &value
+	case es.WorkflowType:
+		value := expr.Value.(string)
+		c.typeName = &value
+	case es.CloseStatus:
+		value := expr.Value.(int64)
+		c.closeStatus = &value
+	default:
+		return newVisibilityQueryError("%v is not supported by cassandra visibility store", expr.Field)
+	}
+	return nil
+}
+
+func (c *visibilityQueryConditions) addTimeBound(field string, operator visibilityquery.Operator, value interface{}) error {
+	if field != es.StartTime && field != es.CloseTime {
+		return newVisibilityQueryError("range on %v is not supported by cassandra visibility store", field)
+	}
+	nanos := value.(int64)
+	r, ok := c.timeRanges[field]
+	if !ok {
+		r = &visibilityQueryTimeRange{earliest: 0, latest: math.MaxInt64}
+		c.timeRanges[field] = r
+	}
+	switch operator {
+	case visibilityquery.OperatorEqual:
+		r.earliest = common.MaxInt64(r.earliest, nanos)
+		r.latest = common.MinInt64(r.latest, nanos)
+	case visibilityquery.OperatorGreaterThan:
+		r.earliest = common.MaxInt64(r.earliest, nanos+1)
+	case visibilityquery.OperatorGreaterThanOrEqual:
+		r.earliest = common.MaxInt64(r.earliest, nanos)
+	case visibilityquery.OperatorLessThan:
+		r.latest = common.MinInt64(r.latest, nanos-1)
+	case visibilityquery.OperatorLessThanOrEqual:
+		r.latest = common.MinInt64(r.latest, nanos)
+	default:
+		return newVisibilityQueryError("operator %v on %v is not supported by cassandra visibility store", operator, field)
+	}
+	if field == es.CloseTime {
+		// a bound on close time implies closed workflows
+		if c.open != nil && *c.open {
+			return newVisibilityQueryError("conflicting conditions on %v", es.CloseTime)
+		}
+		c.open = common.BoolPtr(false)
+	}
+	return nil
+}
+
+func newVisibilityQueryError(format string, args ...interface{}) error {
+	return &workflow.BadRequestError{Message: fmt.Sprintf(format, args...)}
+}
@@ -118,6 +118,7 @@ const (
 	TaskTypeDeleteHistoryEvent
 	TaskTypeActivityRetryTimer
 	TaskTypeWorkflowBackoffTimer
+	TaskTypeDelayedSignal
 )
 
 // UnknownNumRowsAffected is returned when the number of rows that an API affected cannot be determined
@@ -127,6 +128,8 @@ const UnknownNumRowsAffected = -1
 const (
 	WorkflowBackoffTimeoutTypeRetry = iota
 	WorkflowBackoffTimeoutTypeCron
+	WorkflowBackoffTimeoutTypeDelayStart
+	WorkflowBackoffTimeoutTypeConcurrencyLimit
 )
 
 const (
@@ -198,6 +201,7 @@ type (
 		TransferFailoverLevels    map[string]TransferFailoverLevel // uuid -> TransferFailoverLevel
 		TimerFailoverLevels       map[string]TimerFailoverLevel    // uuid -> TimerFailoverLevel
 		DomainNotificationVersion int64
+		RunningWorkflowCounts     map[string]map[string]int64 // domainID -> workflow type -> running executions
 	}
 
 	// TransferFailoverLevel contains corresponding start / end level
@@ -275,6 +279,22 @@ type (
 		// Cron
 		CronSchedule      string
 		ExpirationSeconds int32
+		// per workflow retention, 0 means using domain retention
+		RetentionDays int32
+		// whole chain timeout across retries and continue-as-new, 0 means no limit
+		WorkflowExecutionTimeout int32
+		WorkflowExpirationTime   time.Time
+		// request IDs of recently applied signals and when they were applied, for deduplicating signals
+		RecentSignalRequestIDs map[string]time.Time
+		// heartbeats of the in flight decision, which extend it without writing history events
+		DecisionHeartbeatCount     int32
+		DecisionHeartbeatTimestamp int64
+		// binary checksum of the worker which last completed a decision, decision tasks are routed to it
+		LastBinaryChecksum string
+		// signals accepted by this run which are not delivered yet
+		DelayedSignals *workflow.DelayedSignals
+		// updates recently accepted by this run, for deduplication
+		AcceptedUpdates *workflow.AcceptedWorkflowUpdates
 	}
 
 	// ReplicationState represents mutable state information for global domains.
@@ -303,6 +323,7 @@ type (
 		ScheduleID              int64
 		Version                 int64
 		RecordVisibility        bool
+		TraceContext            []byte
 	}
 
 	// ReplicationTaskInfo describes the replication task created for replication of history events
@@ -336,6 +357,7 @@ type (
 		EventID             int64
 		ScheduleAttempt     int64
 		Version             int64
+		SignalRequestID     string
 	}
 
 	// TaskListInfo describes a state of a task list implementation.
@@ -348,6 +370,10 @@ type (
 		Kind        int
 		Expiry      time.Time
 		LastUpdated time.Time
+		// binary checksum of the worker build promoted as default for new workflows on the task list
+		DefaultBinaryChecksum string
+		// binary checksums of the worker builds compatible with the default build
+		CompatibleBinaryChecksums []string
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -359,6 +385,9 @@ type (
 		ScheduleID             int64
 		ScheduleToStartTimeout int32
 		Expiry                 time.Time
+		ActivityType           string
+		BinaryChecksum         string
+		CreatedTime            time.Time
 	}
 
 	// Task is the generic interface for workflow tasks
@@ -380,6 +409,7 @@ type (
 		TaskList            string
 		ScheduleID          int64
 		Version             int64
+		TraceContext        []byte
 	}
 
 	// DecisionTask identifies a transfer task for decision
@@ -391,6 +421,7 @@ type (
 		ScheduleID          int64
 		Version             int64
 		RecordVisibility    bool
+		TraceContext        []byte
 	}
 
 	// RecordWorkflowStartedTask identifites a transfer task for writing visibility open execution record
@@ -505,7 +536,16 @@ type (
 		TaskID              int64
 		EventID             int64
 		Version             int64
-		TimeoutType         int // 0 for retry, 1 for cron.
+		TimeoutType         int // 0 for retry, 1 for cron, 2 for delayed start.
+	}
+
+	// DelayedSignalTask to deliver a signal to the workflow at a future time,
+	// the signal itself is kept in the delayed signals of the mutable state
+	DelayedSignalTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+		RequestID           string
 	}
 
 	// HistoryReplicationTask is the replication task created for shipping history replication events to other clusters
@@ -708,6 +748,13 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		// per workflow retention, 0 means using domain retention
+		RetentionDays int32
+		// whole chain timeout across retries and continue-as-new, 0 means no limit
+		WorkflowExecutionTimeout int32
+		WorkflowExpirationTime   time.Time
+		// request IDs of recently applied signals and when they were applied, for deduplicating signals
+		RecentSignalRequestIDs map[string]time.Time
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -1264,11 +1311,13 @@ type (
 		NextPageToken []byte
 		// The shard to get history branch data
 		ShardID *int
+		// Read the history nodes from MaxEventID down to MinEventID, the latest events are returned first
+		Reverse bool
 	}
 
 	// ReadHistoryBranchResponse is the response to ReadHistoryBranchRequest
 	ReadHistoryBranchResponse struct {
-		// History events
+		// History events, in descending order of event ID if the request is reverse
 		HistoryEvents []*workflow.HistoryEvent
 		// Token to read next page if there are more events beyond page size.
 		// Use this to set NextPageToken on ReadHistoryBranchRequest to read the next page.
@@ -1282,7 +1331,7 @@ type (
 
 	// ReadHistoryBranchByBatchResponse is the response to ReadHistoryBranchRequest
 	ReadHistoryBranchByBatchResponse struct {
-		// History events by batch
+		// History events by batch, the batches are in descending order of event ID if the request is reverse
 		History []*workflow.History
 		// Token to read next page if there are more events beyond page size.
 		// Use this to set NextPageToken on ReadHistoryBranchRequest to read the next page.
@@ -1362,6 +1411,40 @@ type (
 		Size int
 	}
 
+	// ClusterMember is a host of a cadence service recorded in the cluster membership table
+	ClusterMember struct {
+		Role          string
+		RPCAddress    string
+		SessionStart  time.Time
+		LastHeartbeat time.Time
+	}
+
+	// UpsertClusterMembershipRequest is used to record the heartbeat of a host
+	UpsertClusterMembershipRequest struct {
+		Role         string
+		RPCAddress   string
+		SessionStart time.Time
+		// RecordExpiry is how long the record stays in the table without another heartbeat
+		RecordExpiry time.Duration
+	}
+
+	// GetClusterMembersRequest is used to list the live hosts of a role
+	GetClusterMembersRequest struct {
+		Role string
+		// LastHeartbeatWithin filters out the hosts which have not heartbeated within the duration
+		LastHeartbeatWithin time.Duration
+	}
+
+	// GetClusterMembersResponse is the response to GetClusterMembersRequest
+	GetClusterMembersResponse struct {
+		ActiveMembers []*ClusterMember
+	}
+
+	// PruneClusterMembershipRequest is used to remove expired host records
+	PruneClusterMembershipRequest struct {
+		MaxRecordsPruned int
+	}
+
 	// Closeable is an interface for any entity that supports a close operation to release resources
 	Closeable interface {
 		Close()
@@ -1490,6 +1573,17 @@ type (
 		ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error)
 		GetMetadata() (*GetMetadataResponse, error)
 	}
+
+	// ClusterMembershipManager is used to manage the host heartbeats of the cluster
+	ClusterMembershipManager interface {
+		Closeable
+		GetName() string
+		UpsertClusterMembership(request *UpsertClusterMembershipRequest) error
+		GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error)
+		// PruneClusterMembership removes the records whose expiry has passed, stores
+		// which expire records by themselves may implement it as a no-op
+		PruneClusterMembership(request *PruneClusterMembershipRequest) error
+	}
 )
 
 func (e *InvalidPersistenceRequestError) Error() string {
@@ -1911,6 +2005,41 @@ func (r *WorkflowBackoffTimerTask) SetVisibilityTimestamp(t time.Time) {
 	r.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the delayed signal task
+func (d *DelayedSignalTask) GetType() int {
+	return TaskTypeDelayedSignal
+}
+
+// GetVersion returns the version of the delayed signal task
+func (d *DelayedSignalTask) GetVersion() int64 {
+	return d.Version
+}
+
+// SetVersion returns the version of the delayed signal task
+func (d *DelayedSignalTask) SetVersion(version int64) {
+	d.Version = version
+}
+
+// GetTaskID returns the sequence ID.
+func (d *DelayedSignalTask) GetTaskID() int64 {
+	return d.TaskID
+}
+
+// SetTaskID sets the sequence ID.
+func (d *DelayedSignalTask) SetTaskID(id int64) {
+	d.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (d *DelayedSignalTask) GetVisibilityTimestamp() time.Time {
+	return d.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (d *DelayedSignalTask) SetVisibilityTimestamp(t time.Time) {
+	d.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetType() int {
 	return TaskTypeWorkflowTimeout
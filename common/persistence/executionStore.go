@@ -108,6 +108,16 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(info *InternalWorkflowEx
 		return nil, err
 	}
 
+	delayedSignals, err := m.serializer.DeserializeDelayedSignals(info.DelayedSignals)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedUpdates, err := m.serializer.DeserializeAcceptedUpdates(info.AcceptedUpdates)
+	if err != nil {
+		return nil, err
+	}
+
 	newInfo := &WorkflowExecutionInfo{
 		CompletionEvent: completionEvent,
 
@@ -161,7 +171,16 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(info *InternalWorkflowEx
 		BranchToken:                  info.BranchToken,
 		CronSchedule:                 info.CronSchedule,
 		ExpirationSeconds:            info.ExpirationSeconds,
+		RetentionDays:                info.RetentionDays,
+		WorkflowExecutionTimeout:     info.WorkflowExecutionTimeout,
+		WorkflowExpirationTime:       info.WorkflowExpirationTime,
+		RecentSignalRequestIDs:       info.RecentSignalRequestIDs,
+		DecisionHeartbeatCount:       info.DecisionHeartbeatCount,
+		DecisionHeartbeatTimestamp:   info.DecisionHeartbeatTimestamp,
+		LastBinaryChecksum:           info.LastBinaryChecksum,
 		AutoResetPoints:              autoResetPoints,
+		DelayedSignals:               delayedSignals,
+		AcceptedUpdates:              acceptedUpdates,
 	}
 	return newInfo, nil
 }
@@ -483,6 +502,16 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 		return nil, err
 	}
 
+	delayedSignals, err := m.serializer.SerializeDelayedSignals(info.DelayedSignals, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedUpdates, err := m.serializer.SerializeAcceptedUpdates(info.AcceptedUpdates, encoding)
+	if err != nil {
+		return nil, err
+	}
+
 	return &InternalWorkflowExecutionInfo{
 		DomainID:                     info.DomainID,
 		WorkflowID:                   info.WorkflowID,
@@ -536,6 +565,15 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 		BranchToken:                  info.BranchToken,
 		CronSchedule:                 info.CronSchedule,
 		ExpirationSeconds:            info.ExpirationSeconds,
+		RetentionDays:                info.RetentionDays,
+		WorkflowExecutionTimeout:     info.WorkflowExecutionTimeout,
+		WorkflowExpirationTime:       info.WorkflowExpirationTime,
+		RecentSignalRequestIDs:       info.RecentSignalRequestIDs,
+		DecisionHeartbeatCount:       info.DecisionHeartbeatCount,
+		DecisionHeartbeatTimestamp:   info.DecisionHeartbeatTimestamp,
+		LastBinaryChecksum:           info.LastBinaryChecksum,
+		DelayedSignals:               delayedSignals,
+		AcceptedUpdates:              acceptedUpdates,
 	}, nil
 }
 
@@ -682,6 +720,10 @@ func (m *executionManagerImpl) SerializeCreateWorkflowExecutionRequest(request *
 		BranchToken:                 request.BranchToken,
 		CronSchedule:                request.CronSchedule,
 		ExpirationSeconds:           request.ExpirationSeconds,
+		RetentionDays:               request.RetentionDays,
+		WorkflowExecutionTimeout:    request.WorkflowExecutionTimeout,
+		WorkflowExpirationTime:      request.WorkflowExpirationTime,
+		RecentSignalRequestIDs:      request.RecentSignalRequestIDs,
 	}, nil
 }
 
@@ -22,6 +22,7 @@ package persistence
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -266,7 +267,14 @@ func (m *historyV2ManagerImpl) readHistoryBranch(byBatch bool, request *ReadHist
 	}
 
 	defaultLastEventID := request.MinEventID - 1
-	token, err := m.pagingTokenSerializer.Deserialize(request.NextPageToken, defaultLastEventID, common.EmptyVersion)
+	defaultLastEventVersion := common.EmptyVersion
+	if request.Reverse {
+		// when reading in reverse, the token records the first event ID of the last batch read,
+		// and the version of batches can only decrease
+		defaultLastEventID = request.MaxEventID
+		defaultLastEventVersion = math.MaxInt64
+	}
+	token, err := m.pagingTokenSerializer.Deserialize(request.NextPageToken, defaultLastEventID, defaultLastEventVersion)
 	if err != nil {
 		return nil, nil, nil, 0, 0, err
 	}
@@ -305,6 +313,9 @@ func (m *historyV2ManagerImpl) readHistoryBranch(byBatch bool, request *ReadHist
 				Message: fmt.Sprintf("branchRange is corrupted"),
 			}
 		}
+		if request.Reverse {
+			token.SetRangeIndexes(token.FinalRangeIndex, token.CurrentRangeIndex)
+		}
 	}
 
 	maxNodeID := *allBRs[token.CurrentRangeIndex].EndNodeID
@@ -327,6 +338,7 @@ func (m *historyV2ManagerImpl) readHistoryBranch(byBatch bool, request *ReadHist
 		PageSize:      request.PageSize,
 		NextPageToken: token.StoreToken,
 		ShardID:       shardID,
+		Reverse:       request.Reverse,
 	}
 
 	resp, err := m.persistence.ReadHistoryBranch(req)
@@ -346,69 +358,76 @@ func (m *historyV2ManagerImpl) readHistoryBranch(byBatch bool, request *ReadHist
 	//NOTE: in this method, we need to make sure eventVersion is NOT decreasing(otherwise we skip the events), eventID should be continuous(otherwise return error)
 	logger := m.logger.WithTags(tag.WorkflowBranchID(*branch.BranchID), tag.WorkflowTreeID(*branch.TreeID))
 
-	for _, b := range resp.History {
-		es, err := m.historySerializer.DeserializeBatchEvents(b)
+	if request.Reverse {
+		events, historyBatches, dataSize, lastFirstEventID, err = m.deserializeReverseBatches(byBatch, resp.History, token, defaultLastEventID, logger)
 		if err != nil {
 			return nil, nil, nil, 0, 0, err
 		}
-		if len(es) == 0 {
-			logger.Error("Empty events in a batch")
-			return nil, nil, nil, 0, 0, &workflow.InternalServiceError{
-				Message: fmt.Sprintf("corrupted history event batch, empty events"),
+	} else {
+		for _, b := range resp.History {
+			es, err := m.historySerializer.DeserializeBatchEvents(b)
+			if err != nil {
+				return nil, nil, nil, 0, 0, err
 			}
-		}
-
-		firstEvent := es[0]           // first
-		eventCount := len(es)         // length
-		lastEvent := es[eventCount-1] // last
-
-		if firstEvent.GetVersion() != lastEvent.GetVersion() || firstEvent.GetEventId()+int64(eventCount-1) != lastEvent.GetEventId() {
-			// in a single batch, version should be the same, and ID should be continous
-			logger.Error("Corrupted event batch",
-				tag.FirstEventVersion(firstEvent.GetVersion()), tag.WorkflowFirstEventID(firstEvent.GetEventId()),
-				tag.LastEventVersion(lastEvent.GetVersion()), tag.WorkflowNextEventID(lastEvent.GetEventId()),
-				tag.Counter(eventCount))
-			return nil, nil, nil, 0, 0, &workflow.InternalServiceError{
-				Message: fmt.Sprintf("corrupted history event batch, wrong version and IDs"),
+			if len(es) == 0 {
+				logger.Error("Empty events in a batch")
+				return nil, nil, nil, 0, 0, &workflow.InternalServiceError{
+					Message: fmt.Sprintf("corrupted history event batch, empty events"),
+				}
 			}
-		}
 
-		if firstEvent.GetVersion() < token.LastEventVersion {
-			// version decrease means the this batch are all stale events, we should skip
-			logger.Info("Stale event batch with smaller version", tag.FirstEventVersion(firstEvent.GetVersion()), tag.TokenLastEventVersion(token.LastEventVersion))
-			continue
-		}
-		if firstEvent.GetEventId() <= token.LastEventID {
-			// we could see it because first batch of next page has a smaller txn_id
-			logger.Info("Stale event batch with eventID", tag.WorkflowFirstEventID(firstEvent.GetEventId()), tag.TokenLastEventID(token.LastEventID))
-			continue
-		}
-		if firstEvent.GetEventId() != token.LastEventID+1 {
-			// We assume application layer want to read from MinEventID(inclusive)
-			// However, for getting history from remote cluster, there is scenario that we have to read from middle without knowing the firstEventID.
-			// In that case we don't validate history continuousness for the first page
-			// TODO: in this case, some events returned can be invalid(stale). application layer need to make sure it won't make any problems to XDC
-			if defaultLastEventID == 0 || token.LastEventID != defaultLastEventID {
-				logger.Error("Corrupted incontinouous event batch",
+			firstEvent := es[0]           // first
+			eventCount := len(es)         // length
+			lastEvent := es[eventCount-1] // last
+
+			if firstEvent.GetVersion() != lastEvent.GetVersion() || firstEvent.GetEventId()+int64(eventCount-1) != lastEvent.GetEventId() {
+				// in a single batch, version should be the same, and ID should be continous
+				logger.Error("Corrupted event batch",
 					tag.FirstEventVersion(firstEvent.GetVersion()), tag.WorkflowFirstEventID(firstEvent.GetEventId()),
 					tag.LastEventVersion(lastEvent.GetVersion()), tag.WorkflowNextEventID(lastEvent.GetEventId()),
-					tag.TokenLastEventVersion(token.LastEventVersion), tag.TokenLastEventID(token.LastEventID),
 					tag.Counter(eventCount))
 				return nil, nil, nil, 0, 0, &workflow.InternalServiceError{
-					Message: fmt.Sprintf("corrupted history event batch, eventID is not continouous"),
+					Message: fmt.Sprintf("corrupted history event batch, wrong version and IDs"),
 				}
 			}
-		}
 
-		token.LastEventVersion = firstEvent.GetVersion()
-		token.LastEventID = lastEvent.GetEventId()
-		if byBatch {
-			historyBatches = append(historyBatches, &workflow.History{Events: es})
-		} else {
-			events = append(events, es...)
+			if firstEvent.GetVersion() < token.LastEventVersion {
+				// version decrease means the this batch are all stale events, we should skip
+				logger.Info("Stale event batch with smaller version", tag.FirstEventVersion(firstEvent.GetVersion()), tag.TokenLastEventVersion(token.LastEventVersion))
+				continue
+			}
+			if firstEvent.GetEventId() <= token.LastEventID {
+				// we could see it because first batch of next page has a smaller txn_id
+				logger.Info("Stale event batch with eventID", tag.WorkflowFirstEventID(firstEvent.GetEventId()), tag.TokenLastEventID(token.LastEventID))
+				continue
+			}
+			if firstEvent.GetEventId() != token.LastEventID+1 {
+				// We assume application layer want to read from MinEventID(inclusive)
+				// However, for getting history from remote cluster, there is scenario that we have to read from middle without knowing the firstEventID.
+				// In that case we don't validate history continuousness for the first page
+				// TODO: in this case, some events returned can be invalid(stale). application layer need to make sure it won't make any problems to XDC
+				if defaultLastEventID == 0 || token.LastEventID != defaultLastEventID {
+					logger.Error("Corrupted incontinouous event batch",
+						tag.FirstEventVersion(firstEvent.GetVersion()), tag.WorkflowFirstEventID(firstEvent.GetEventId()),
+						tag.LastEventVersion(lastEvent.GetVersion()), tag.WorkflowNextEventID(lastEvent.GetEventId()),
+						tag.TokenLastEventVersion(token.LastEventVersion), tag.TokenLastEventID(token.LastEventID),
+						tag.Counter(eventCount))
+					return nil, nil, nil, 0, 0, &workflow.InternalServiceError{
+						Message: fmt.Sprintf("corrupted history event batch, eventID is not continouous"),
+					}
+				}
+			}
+
+			token.LastEventVersion = firstEvent.GetVersion()
+			token.LastEventID = lastEvent.GetEventId()
+			if byBatch {
+				historyBatches = append(historyBatches, &workflow.History{Events: es})
+			} else {
+				events = append(events, es...)
+			}
+			dataSize += len(b.Data)
+			lastFirstEventID = firstEvent.GetEventId()
 		}
-		dataSize += len(b.Data)
-		lastFirstEventID = firstEvent.GetEventId()
 	}
 
 	var nextToken []byte
@@ -417,7 +436,11 @@ func (m *historyV2ManagerImpl) readHistoryBranch(byBatch bool, request *ReadHist
 			// this means that we have reached the final page of final branchRange
 			nextToken = nil
 		} else {
-			token.CurrentRangeIndex++
+			if request.Reverse {
+				token.CurrentRangeIndex--
+			} else {
+				token.CurrentRangeIndex++
+			}
 			token.StoreToken = nil
 			nextToken, err = m.pagingTokenSerializer.Serialize(token)
 			if err != nil {
@@ -435,6 +458,107 @@ func (m *historyV2ManagerImpl) readHistoryBranch(byBatch bool, request *ReadHist
 	return events, historyBatches, nextToken, dataSize, lastFirstEventID, nil
 }
 
+// deserializeReverseBatches deserializes the history batches read in descending order of node ID and updates the token.
+// A batch overlapping with the batches following it makes them stale, as a node is only rewritten after the nodes
+// following it failed to commit. This is the same as reading forward, where the batch with the smaller node ID wins.
+func (m *historyV2ManagerImpl) deserializeReverseBatches(
+	byBatch bool,
+	blobs []*DataBlob,
+	token *historyV2PagingToken,
+	defaultLastEventID int64,
+	logger log.Logger,
+) ([]*workflow.HistoryEvent, []*workflow.History, int, int64, error) {
+
+	type batch struct {
+		events []*workflow.HistoryEvent
+		size   int
+	}
+	batches := make([]batch, 0, len(blobs))
+
+	for _, b := range blobs {
+		es, err := m.historySerializer.DeserializeBatchEvents(b)
+		if err != nil {
+			return nil, nil, 0, 0, err
+		}
+		if len(es) == 0 {
+			logger.Error("Empty events in a batch")
+			return nil, nil, 0, 0, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("corrupted history event batch, empty events"),
+			}
+		}
+
+		firstEvent := es[0]           // first
+		eventCount := len(es)         // length
+		lastEvent := es[eventCount-1] // last
+
+		if firstEvent.GetVersion() != lastEvent.GetVersion() || firstEvent.GetEventId()+int64(eventCount-1) != lastEvent.GetEventId() {
+			// in a single batch, version should be the same, and ID should be continous
+			logger.Error("Corrupted event batch",
+				tag.FirstEventVersion(firstEvent.GetVersion()), tag.WorkflowFirstEventID(firstEvent.GetEventId()),
+				tag.LastEventVersion(lastEvent.GetVersion()), tag.WorkflowNextEventID(lastEvent.GetEventId()),
+				tag.Counter(eventCount))
+			return nil, nil, 0, 0, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("corrupted history event batch, wrong version and IDs"),
+			}
+		}
+
+		// the batches of this page overlapping with this one are stale
+		validCount := len(batches)
+		for validCount > 0 && batches[validCount-1].events[0].GetEventId() <= lastEvent.GetEventId() {
+			validCount--
+		}
+		lastEventID := token.LastEventID
+		lastEventVersion := token.LastEventVersion
+		if validCount > 0 {
+			lastEventID = batches[validCount-1].events[0].GetEventId()
+			lastEventVersion = batches[validCount-1].events[0].GetVersion()
+		}
+
+		if firstEvent.GetVersion() > lastEventVersion {
+			// version increase means this batch are all stale events as the batches are read backwards, we should skip
+			logger.Info("Stale event batch with larger version", tag.FirstEventVersion(firstEvent.GetVersion()), tag.TokenLastEventVersion(lastEventVersion))
+			continue
+		}
+		if firstEvent.GetEventId() >= lastEventID {
+			logger.Info("Stale event batch with eventID", tag.WorkflowFirstEventID(firstEvent.GetEventId()), tag.TokenLastEventID(lastEventID))
+			continue
+		}
+		// the first batch is not validated, as MaxEventID can be in the middle of a batch
+		if lastEvent.GetEventId()+1 != lastEventID && lastEventID != defaultLastEventID {
+			logger.Error("Corrupted incontinouous event batch",
+				tag.FirstEventVersion(firstEvent.GetVersion()), tag.WorkflowFirstEventID(firstEvent.GetEventId()),
+				tag.LastEventVersion(lastEvent.GetVersion()), tag.WorkflowNextEventID(lastEvent.GetEventId()),
+				tag.TokenLastEventVersion(lastEventVersion), tag.TokenLastEventID(lastEventID),
+				tag.Counter(eventCount))
+			return nil, nil, 0, 0, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("corrupted history event batch, eventID is not continouous"),
+			}
+		}
+
+		batches = append(batches[:validCount], batch{events: es, size: len(b.Data)})
+	}
+
+	events := make([]*workflow.HistoryEvent, 0, len(batches))
+	historyBatches := make([]*workflow.History, 0, len(batches))
+	dataSize := 0
+	// first_event_id of the last batch
+	lastFirstEventID := common.EmptyEventID
+	for _, b := range batches {
+		if byBatch {
+			historyBatches = append(historyBatches, &workflow.History{Events: b.events})
+		} else {
+			for i := len(b.events) - 1; i >= 0; i-- {
+				events = append(events, b.events[i])
+			}
+		}
+		dataSize += b.size
+		lastFirstEventID = b.events[0].GetEventId()
+		token.LastEventID = lastFirstEventID
+		token.LastEventVersion = b.events[0].GetVersion()
+	}
+	return events, historyBatches, dataSize, lastFirstEventID, nil
+}
+
 func (m *historyV2ManagerImpl) Close() {
 	m.persistence.Close()
 }
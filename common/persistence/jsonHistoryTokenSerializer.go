@@ -27,6 +27,7 @@ type (
 
 	// historyV2PagingToken is used to serialize/deserialize pagination token for ReadHistoryBranchRequest
 	historyV2PagingToken struct {
+		// version and last event ID of the last batch read, or the first event ID of the batch when reading in reverse
 		LastEventVersion int64
 		LastEventID      int64
 		// the pagination token passing to persistence
@@ -55,6 +55,8 @@ type (
 		NewExecutionManager(shardID int) (p.ExecutionManager, error)
 		// NewVisibilityManager returns a new visibility manager
 		NewVisibilityManager() (p.VisibilityManager, error)
+		// NewClusterMembershipManager returns a new cluster membership manager
+		NewClusterMembershipManager() (p.ClusterMembershipManager, error)
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -79,6 +81,8 @@ type (
 		NewExecutionStore(shardID int) (p.ExecutionStore, error)
 		// NewVisibilityStore returns a new visibility store
 		NewVisibilityStore() (p.VisibilityStore, error)
+		// NewClusterMembershipStore returns a new cluster membership store
+		NewClusterMembershipStore() (p.ClusterMembershipStore, error)
 	}
 	// Datastore represents a datastore
 	Datastore struct {
@@ -106,6 +110,7 @@ const (
 	storeTypeMetadata
 	storeTypeExecution
 	storeTypeVisibility
+	storeTypeClusterMembership
 )
 
 const (
@@ -118,7 +123,7 @@ const (
 )
 
 var storeTypes = []storeType{
-	storeTypeHistory, storeTypeTask, storeTypeShard, storeTypeMetadata, storeTypeExecution, storeTypeVisibility}
+	storeTypeHistory, storeTypeTask, storeTypeShard, storeTypeMetadata, storeTypeExecution, storeTypeVisibility, storeTypeClusterMembership}
 
 // New returns an implementation of factory that vends persistence objects based on
 // specified configuration. This factory takes as input a config.Persistence object
@@ -280,6 +285,22 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 	return result, nil
 }
 
+// NewClusterMembershipManager returns a new cluster membership manager
+func (f *factoryImpl) NewClusterMembershipManager() (p.ClusterMembershipManager, error) {
+	ds := f.datastores[storeTypeClusterMembership]
+	result, err := ds.factory.NewClusterMembershipStore()
+	if err != nil {
+		return nil, err
+	}
+	if ds.ratelimit != nil {
+		result = p.NewClusterMembershipPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewClusterMembershipPersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+	return result, nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
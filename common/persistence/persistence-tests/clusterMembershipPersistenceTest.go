@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// ClusterMembershipPersistenceSuite contains cluster membership persistence tests
+	ClusterMembershipPersistenceSuite struct {
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+// SetupSuite implementation
+func (s *ClusterMembershipPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+// SetupTest implementation
+func (s *ClusterMembershipPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+// TearDownSuite implementation
+func (s *ClusterMembershipPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+// TestUpsertAndGetClusterMembers test
+func (s *ClusterMembershipPersistenceSuite) TestUpsertAndGetClusterMembers() {
+	role := "test-upsert-role"
+	sessionStart := time.Now().Truncate(time.Millisecond)
+
+	for _, address := range []string{"127.0.0.1:7933", "127.0.0.2:7933"} {
+		err := s.ClusterMembershipMgr.UpsertClusterMembership(&p.UpsertClusterMembershipRequest{
+			Role:         role,
+			RPCAddress:   address,
+			SessionStart: sessionStart,
+			RecordExpiry: time.Minute,
+		})
+		s.NoError(err)
+	}
+	err := s.ClusterMembershipMgr.UpsertClusterMembership(&p.UpsertClusterMembershipRequest{
+		Role:         "test-upsert-other-role",
+		RPCAddress:   "127.0.0.3:7933",
+		SessionStart: sessionStart,
+		RecordExpiry: time.Minute,
+	})
+	s.NoError(err)
+
+	resp, err := s.ClusterMembershipMgr.GetClusterMembers(&p.GetClusterMembersRequest{
+		Role:                role,
+		LastHeartbeatWithin: time.Minute,
+	})
+	s.NoError(err)
+	s.Equal(2, len(resp.ActiveMembers))
+	addresses := make(map[string]bool)
+	for _, member := range resp.ActiveMembers {
+		s.Equal(role, member.Role)
+		s.True(member.SessionStart.Equal(sessionStart))
+		s.False(member.LastHeartbeat.Before(sessionStart))
+		addresses[member.RPCAddress] = true
+	}
+	s.True(addresses["127.0.0.1:7933"])
+	s.True(addresses["127.0.0.2:7933"])
+
+	// heartbeat again from the same host does not add a member
+	err = s.ClusterMembershipMgr.UpsertClusterMembership(&p.UpsertClusterMembershipRequest{
+		Role:         role,
+		RPCAddress:   "127.0.0.1:7933",
+		SessionStart: sessionStart,
+		RecordExpiry: time.Minute,
+	})
+	s.NoError(err)
+	resp, err = s.ClusterMembershipMgr.GetClusterMembers(&p.GetClusterMembersRequest{
+		Role:                role,
+		LastHeartbeatWithin: time.Minute,
+	})
+	s.NoError(err)
+	s.Equal(2, len(resp.ActiveMembers))
+}
+
+// TestClusterMembershipExpiry test
+func (s *ClusterMembershipPersistenceSuite) TestClusterMembershipExpiry() {
+	role := "test-expiry-role"
+	err := s.ClusterMembershipMgr.UpsertClusterMembership(&p.UpsertClusterMembershipRequest{
+		Role:         role,
+		RPCAddress:   "127.0.0.1:7933",
+		SessionStart: time.Now(),
+		RecordExpiry: time.Second,
+	})
+	s.NoError(err)
+
+	resp, err := s.ClusterMembershipMgr.GetClusterMembers(&p.GetClusterMembersRequest{Role: role})
+	s.NoError(err)
+	s.Equal(1, len(resp.ActiveMembers))
+
+	time.Sleep(2 * time.Second)
+	resp, err = s.ClusterMembershipMgr.GetClusterMembers(&p.GetClusterMembersRequest{Role: role})
+	s.NoError(err)
+	s.Equal(0, len(resp.ActiveMembers))
+
+	err = s.ClusterMembershipMgr.PruneClusterMembership(&p.PruneClusterMembershipRequest{MaxRecordsPruned: 100})
+	s.NoError(err)
+}
+
+// TestUpsertClusterMembershipInvalidExpiry test
+func (s *ClusterMembershipPersistenceSuite) TestUpsertClusterMembershipInvalidExpiry() {
+	err := s.ClusterMembershipMgr.UpsertClusterMembership(&p.UpsertClusterMembershipRequest{
+		Role:         "test-invalid-role",
+		RPCAddress:   "127.0.0.1:7933",
+		SessionStart: time.Now(),
+	})
+	s.IsType(&p.InvalidPersistenceRequestError{}, err)
+}
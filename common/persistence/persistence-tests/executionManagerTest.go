@@ -762,8 +762,12 @@ func (s *ExecutionManagerSuite) TestGetWorkflow() {
 		NonRetriableErrors:      []string{"badRequestError", "accessDeniedError"},
 		CronSchedule:            "* * * * *",
 		ExpirationSeconds:       rand.Int31(),
+		RetentionDays:           rand.Int31(),
 		PreviousAutoResetPoints: &testResetPoints,
 	}
+	createReq.WorkflowExecutionTimeout = rand.Int31()
+	createReq.WorkflowExpirationTime = time.Now().Add(time.Hour)
+	createReq.RecentSignalRequestIDs = map[string]time.Time{uuid.New(): time.Now()}
 
 	createResp, err := s.ExecutionManager.CreateWorkflowExecution(createReq)
 	s.NoError(err)
@@ -804,6 +808,13 @@ func (s *ExecutionManagerSuite) TestGetWorkflow() {
 	s.Equal(createReq.MaximumAttempts, info.MaximumAttempts)
 	s.Equal(createReq.MaximumInterval, info.MaximumInterval)
 	s.Equal(createReq.ExpirationSeconds, info.ExpirationSeconds)
+	s.Equal(createReq.RetentionDays, info.RetentionDays)
+	s.Equal(createReq.WorkflowExecutionTimeout, info.WorkflowExecutionTimeout)
+	s.EqualTimes(createReq.WorkflowExpirationTime, info.WorkflowExpirationTime)
+	s.Equal(len(createReq.RecentSignalRequestIDs), len(info.RecentSignalRequestIDs))
+	for requestID, appliedTime := range createReq.RecentSignalRequestIDs {
+		s.EqualTimes(appliedTime, info.RecentSignalRequestIDs[requestID])
+	}
 	s.EqualTimes(createReq.ExpirationTime, info.ExpirationTime)
 	s.Equal(createReq.CronSchedule, info.CronSchedule)
 	s.Equal(createReq.NonRetriableErrors, info.NonRetriableErrors)
@@ -886,6 +897,13 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	updatedInfo.MaximumInterval = math.MaxInt32
 	updatedInfo.MaximumAttempts = math.MaxInt32
 	updatedInfo.ExpirationSeconds = math.MaxInt32
+	updatedInfo.RetentionDays = math.MaxInt32
+	updatedInfo.WorkflowExecutionTimeout = math.MaxInt32
+	updatedInfo.WorkflowExpirationTime = time.Now()
+	updatedInfo.RecentSignalRequestIDs = map[string]time.Time{uuid.New(): time.Now(), uuid.New(): time.Now().Add(-time.Minute)}
+	updatedInfo.DecisionHeartbeatCount = 3
+	updatedInfo.DecisionHeartbeatTimestamp = time.Now().UnixNano()
+	updatedInfo.LastBinaryChecksum = "test-binary-checksum"
 	updatedInfo.ExpirationTime = time.Now()
 	updatedInfo.NonRetriableErrors = []string{"accessDenied", "badRequest"}
 
@@ -928,6 +946,16 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(updatedInfo.MaximumInterval, info1.MaximumInterval)
 	s.Equal(updatedInfo.MaximumAttempts, info1.MaximumAttempts)
 	s.Equal(updatedInfo.ExpirationSeconds, info1.ExpirationSeconds)
+	s.Equal(updatedInfo.RetentionDays, info1.RetentionDays)
+	s.Equal(updatedInfo.WorkflowExecutionTimeout, info1.WorkflowExecutionTimeout)
+	s.EqualTimes(updatedInfo.WorkflowExpirationTime, info1.WorkflowExpirationTime)
+	s.Equal(updatedInfo.DecisionHeartbeatCount, info1.DecisionHeartbeatCount)
+	s.Equal(updatedInfo.DecisionHeartbeatTimestamp, info1.DecisionHeartbeatTimestamp)
+	s.Equal(updatedInfo.LastBinaryChecksum, info1.LastBinaryChecksum)
+	s.Equal(len(updatedInfo.RecentSignalRequestIDs), len(info1.RecentSignalRequestIDs))
+	for requestID, appliedTime := range updatedInfo.RecentSignalRequestIDs {
+		s.EqualTimes(appliedTime, info1.RecentSignalRequestIDs[requestID])
+	}
 	s.EqualTimes(updatedInfo.ExpirationTime, info1.ExpirationTime)
 	s.Equal(updatedInfo.NonRetriableErrors, info1.NonRetriableErrors)
 
@@ -969,6 +997,16 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(updatedInfo.MaximumInterval, info2.MaximumInterval)
 	s.Equal(updatedInfo.MaximumAttempts, info2.MaximumAttempts)
 	s.Equal(updatedInfo.ExpirationSeconds, info2.ExpirationSeconds)
+	s.Equal(updatedInfo.RetentionDays, info2.RetentionDays)
+	s.Equal(updatedInfo.WorkflowExecutionTimeout, info2.WorkflowExecutionTimeout)
+	s.EqualTimes(updatedInfo.WorkflowExpirationTime, info2.WorkflowExpirationTime)
+	s.Equal(updatedInfo.DecisionHeartbeatCount, info2.DecisionHeartbeatCount)
+	s.Equal(updatedInfo.DecisionHeartbeatTimestamp, info2.DecisionHeartbeatTimestamp)
+	s.Equal(updatedInfo.LastBinaryChecksum, info2.LastBinaryChecksum)
+	s.Equal(len(updatedInfo.RecentSignalRequestIDs), len(info2.RecentSignalRequestIDs))
+	for requestID, appliedTime := range updatedInfo.RecentSignalRequestIDs {
+		s.EqualTimes(appliedTime, info2.RecentSignalRequestIDs[requestID])
+	}
 	s.EqualTimes(updatedInfo.ExpirationTime, info2.ExpirationTime)
 	s.Equal(updatedInfo.NonRetriableErrors, info2.NonRetriableErrors)
 
@@ -1009,6 +1047,16 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(updatedInfo.MaximumInterval, info2.MaximumInterval)
 	s.Equal(updatedInfo.MaximumAttempts, info2.MaximumAttempts)
 	s.Equal(updatedInfo.ExpirationSeconds, info2.ExpirationSeconds)
+	s.Equal(updatedInfo.RetentionDays, info2.RetentionDays)
+	s.Equal(updatedInfo.WorkflowExecutionTimeout, info2.WorkflowExecutionTimeout)
+	s.EqualTimes(updatedInfo.WorkflowExpirationTime, info2.WorkflowExpirationTime)
+	s.Equal(updatedInfo.DecisionHeartbeatCount, info2.DecisionHeartbeatCount)
+	s.Equal(updatedInfo.DecisionHeartbeatTimestamp, info2.DecisionHeartbeatTimestamp)
+	s.Equal(updatedInfo.LastBinaryChecksum, info2.LastBinaryChecksum)
+	s.Equal(len(updatedInfo.RecentSignalRequestIDs), len(info2.RecentSignalRequestIDs))
+	for requestID, appliedTime := range updatedInfo.RecentSignalRequestIDs {
+		s.EqualTimes(appliedTime, info2.RecentSignalRequestIDs[requestID])
+	}
 	s.EqualTimes(updatedInfo.ExpirationTime, info2.ExpirationTime)
 	s.Equal(updatedInfo.NonRetriableErrors, info2.NonRetriableErrors)
 
@@ -1050,6 +1098,16 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(updatedInfo.MaximumInterval, info2.MaximumInterval)
 	s.Equal(updatedInfo.MaximumAttempts, info2.MaximumAttempts)
 	s.Equal(updatedInfo.ExpirationSeconds, info2.ExpirationSeconds)
+	s.Equal(updatedInfo.RetentionDays, info2.RetentionDays)
+	s.Equal(updatedInfo.WorkflowExecutionTimeout, info2.WorkflowExecutionTimeout)
+	s.EqualTimes(updatedInfo.WorkflowExpirationTime, info2.WorkflowExpirationTime)
+	s.Equal(updatedInfo.DecisionHeartbeatCount, info2.DecisionHeartbeatCount)
+	s.Equal(updatedInfo.DecisionHeartbeatTimestamp, info2.DecisionHeartbeatTimestamp)
+	s.Equal(updatedInfo.LastBinaryChecksum, info2.LastBinaryChecksum)
+	s.Equal(len(updatedInfo.RecentSignalRequestIDs), len(info2.RecentSignalRequestIDs))
+	for requestID, appliedTime := range updatedInfo.RecentSignalRequestIDs {
+		s.EqualTimes(appliedTime, info2.RecentSignalRequestIDs[requestID])
+	}
 	s.EqualTimes(updatedInfo.ExpirationTime, info2.ExpirationTime)
 	s.Equal(updatedInfo.NonRetriableErrors, info2.NonRetriableErrors)
 
@@ -1667,8 +1725,8 @@ func (s *ExecutionManagerSuite) TestTransferTasksComplete() {
 	currentTransferID := s.GetTransferReadLevel()
 	now := time.Now()
 	tasks := []p.Task{
-		&p.ActivityTask{now, currentTransferID + 10001, domainID, tasklist, scheduleID, 111},
-		&p.DecisionTask{now, currentTransferID + 10002, domainID, tasklist, scheduleID, 222, false},
+		&p.ActivityTask{now, currentTransferID + 10001, domainID, tasklist, scheduleID, 111, []byte("activity trace context")},
+		&p.DecisionTask{now, currentTransferID + 10002, domainID, tasklist, scheduleID, 222, false, []byte("decision trace context")},
 		&p.CloseExecutionTask{now, currentTransferID + 10003, 333},
 		&p.CancelExecutionTask{now, currentTransferID + 10004, targetDomainID, targetWorkflowID, targetRunID, true, scheduleID, 444},
 		&p.SignalExecutionTask{now, currentTransferID + 10005, targetDomainID, targetWorkflowID, targetRunID, true, scheduleID, 555},
@@ -1696,6 +1754,9 @@ func (s *ExecutionManagerSuite) TestTransferTasksComplete() {
 	s.Equal(int64(444), txTasks[3].Version)
 	s.Equal(int64(555), txTasks[4].Version)
 	s.Equal(int64(666), txTasks[5].Version)
+	s.Equal([]byte("activity trace context"), txTasks[0].TraceContext)
+	s.Equal([]byte("decision trace context"), txTasks[1].TraceContext)
+	s.Empty(txTasks[2].TraceContext)
 
 	err2 = s.CompleteTransferTask(txTasks[0].TaskID)
 	s.NoError(err2)
@@ -1764,8 +1825,8 @@ func (s *ExecutionManagerSuite) TestTransferTasksRangeComplete() {
 	currentTransferID := s.GetTransferReadLevel()
 	now := time.Now()
 	tasks := []p.Task{
-		&p.ActivityTask{now, currentTransferID + 10001, domainID, tasklist, scheduleID, 111},
-		&p.DecisionTask{now, currentTransferID + 10002, domainID, tasklist, scheduleID, 222, false},
+		&p.ActivityTask{now, currentTransferID + 10001, domainID, tasklist, scheduleID, 111, nil},
+		&p.DecisionTask{now, currentTransferID + 10002, domainID, tasklist, scheduleID, 222, false, nil},
 		&p.CloseExecutionTask{now, currentTransferID + 10003, 333},
 		&p.CancelExecutionTask{now, currentTransferID + 10004, targetDomainID, targetWorkflowID, targetRunID, true, scheduleID, 444},
 		&p.SignalExecutionTask{now, currentTransferID + 10005, targetDomainID, targetWorkflowID, targetRunID, true, scheduleID, 555},
@@ -1857,6 +1918,62 @@ func (s *ExecutionManagerSuite) TestTimerTasksComplete() {
 	s.Empty(timerTasks2, "expected empty task list.")
 }
 
+// TestDelayedSignalTimerTask test
+func (s *ExecutionManagerSuite) TestDelayedSignalTimerTask() {
+	domainID := "0e2e2a5c-a3d6-4a8e-9e4c-7c6d6b0e1d52"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("delayed-signal-timer-task-test"),
+		RunId:      common.StringPtr("ca1f5d9c-0b1e-4b8e-8b2f-5b1d0e4c2a71"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "taskList", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.NoError(err0)
+	s.NotNil(task0, "Expected non empty task identifier.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err1)
+	info0 := state0.ExecutionInfo
+	s.NotNil(info0, "Valid Workflow info expected.")
+
+	deliverAt := time.Now().Add(time.Minute)
+	delayedSignals := &gen.DelayedSignals{
+		Signals: []*gen.DelayedSignalInfo{{
+			RequestId:          common.StringPtr("signal-request-id"),
+			SignalName:         common.StringPtr("signal-name"),
+			Input:              []byte("signal-input"),
+			Identity:           common.StringPtr("signal-identity"),
+			DeliverAtTimestamp: common.Int64Ptr(deliverAt.UnixNano()),
+			Version:            common.Int64Ptr(11),
+		}},
+	}
+	updatedInfo := copyWorkflowExecutionInfo(info0)
+	updatedInfo.DelayedSignals = delayedSignals
+	tasks := []p.Task{
+		&p.DelayedSignalTask{
+			VisibilityTimestamp: deliverAt,
+			TaskID:              1,
+			Version:             11,
+			RequestID:           "signal-request-id",
+		},
+	}
+	err2 := s.UpdateWorkflowExecution(updatedInfo, nil, nil, int64(3), tasks, nil, nil, nil, nil)
+	s.NoError(err2)
+
+	state1, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err1)
+	s.Equal(delayedSignals.String(), state1.ExecutionInfo.DelayedSignals.String())
+
+	timerTasks, err1 := s.GetTimerIndexTasks(100, true)
+	s.NoError(err1)
+	s.Equal(1, len(timerTasks))
+	s.Equal(p.TaskTypeDelayedSignal, timerTasks[0].TaskType)
+	s.Equal(int64(11), timerTasks[0].Version)
+	s.Equal("signal-request-id", timerTasks[0].SignalRequestID)
+
+	err2 = s.CompleteTimerTask(timerTasks[0].VisibilityTimestamp, timerTasks[0].TaskID)
+	s.NoError(err2)
+}
+
 // TestTimerTasksRangeComplete test
 func (s *ExecutionManagerSuite) TestTimerTasksRangeComplete() {
 	domainID := "8bfb47be-5b57-4d66-9109-5fb35e20b1d7"
@@ -3678,6 +3795,9 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 			cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 		},
 		DomainNotificationVersion: domainNotificationVersion,
+		RunningWorkflowCounts: map[string]map[string]int64{
+			"some random domain": {"some random workflow type": 12},
+		},
 	}
 	updateRequest := &p.UpdateShardRequest{
 		ShardInfo:       shardInfo,
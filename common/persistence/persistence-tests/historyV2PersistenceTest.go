@@ -286,6 +286,113 @@ func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 	s.IsType(&gen.EntityNotExistsError{}, err)
 }
 
+// TestReadBranchReverse test
+func (s *HistoryV2PersistenceSuite) TestReadBranchReverse() {
+	treeID := uuid.New()
+	bi, err := s.newHistoryBranch(treeID)
+	s.Nil(err)
+
+	historyW := &workflow.History{}
+	events := s.genRandomEvents([]int64{1, 2, 3}, 1)
+	err = s.appendNewBranchAndFirstNode(bi, events, 1, "branchInfo")
+	s.Nil(err)
+	historyW.Events = events
+
+	events = s.genRandomEvents([]int64{4}, 1)
+	err = s.appendNewNode(bi, events, 1)
+	s.Nil(err)
+	historyW.Events = append(historyW.Events, events...)
+
+	// stale event batch, overwritten by the batch of smaller node ID appended after it
+	events = s.genRandomEvents([]int64{6, 7, 8}, 1)
+	err = s.appendNewNode(bi, events, 1)
+	s.Nil(err)
+
+	events = s.genRandomEvents([]int64{5, 6, 7, 8}, 1)
+	err = s.appendNewNode(bi, events, 2)
+	s.Nil(err)
+	historyW.Events = append(historyW.Events, events...)
+
+	// stale event batch, overwritten by the batch of the same node ID and larger txn_id appended after it
+	events = s.genRandomEvents([]int64{9, 10}, 1)
+	err = s.appendNewNode(bi, events, 1)
+	s.Nil(err)
+
+	events = s.genRandomEvents([]int64{9, 10, 11}, 1)
+	err = s.appendNewNode(bi, events, 2)
+	s.Nil(err)
+	historyW.Events = append(historyW.Events, events...)
+
+	events = s.genRandomEvents([]int64{12, 13}, 1)
+	err = s.appendNewNode(bi, events, 1)
+	s.Nil(err)
+	// we don't append this batch because we will fork from 12
+	//historyW.Events = append(historyW.Events, events...)
+
+	// fork from here
+	bi2, err := s.fork(bi, 12)
+	s.Nil(err)
+
+	events = s.genRandomEvents([]int64{12}, 2)
+	err = s.appendNewNode(bi2, events, 1)
+	s.Nil(err)
+	historyW.Events = append(historyW.Events, events...)
+
+	events = s.genRandomEvents([]int64{13, 14}, 2)
+	err = s.appendNewNode(bi2, events, 1)
+	s.Nil(err)
+	historyW.Events = append(historyW.Events, events...)
+
+	events = s.genRandomEvents([]int64{15}, 2)
+	err = s.appendNewNode(bi2, events, 1)
+	s.Nil(err)
+	historyW.Events = append(historyW.Events, events...)
+
+	req := &p.ReadHistoryBranchRequest{
+		BranchToken:   bi2,
+		MinEventID:    1,
+		MaxEventID:    16,
+		PageSize:      10,
+		NextPageToken: nil,
+		ShardID:       common.IntPtr(s.ShardInfo.ShardID),
+		Reverse:       true,
+	}
+	// first page, the nodes of the forked branch
+	resp, err := s.HistoryV2Mgr.ReadHistoryBranch(req)
+	s.Nil(err)
+	s.Equal(s.descendingEventIDs(15, 12), s.getEventIDs(resp.HistoryEvents))
+	historyR := &workflow.History{Events: resp.HistoryEvents}
+	req.NextPageToken = resp.NextPageToken
+
+	// second page, the nodes of the ancestor branch
+	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+	s.Nil(err)
+	s.Equal(s.descendingEventIDs(11, 1), s.getEventIDs(resp.HistoryEvents))
+	historyR.Events = append(historyR.Events, resp.HistoryEvents...)
+	s.Equal(0, len(resp.NextPageToken))
+
+	for i, j := 0, len(historyR.Events)-1; i < j; i, j = i+1, j-1 {
+		historyR.Events[i], historyR.Events[j] = historyR.Events[j], historyR.Events[i]
+	}
+	s.True(historyW.Equals(historyR))
+
+	// read the latest events one node per page, the page of node 9 holds only the stale batch of it
+	req.MinEventID = 9
+	req.PageSize = 1
+	req.NextPageToken = nil
+	var ids []int64
+	for {
+		resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+		s.Nil(err)
+		ids = append(ids, s.getEventIDs(resp.HistoryEvents)...)
+		req.NextPageToken = resp.NextPageToken
+		if len(req.NextPageToken) == 0 {
+			break
+		}
+	}
+	s.Equal(s.descendingEventIDs(15, 9), ids)
+}
+
 //TestConcurrentlyCreateAndAppendBranches test
 func (s *HistoryV2PersistenceSuite) TestConcurrentlyCreateAndAppendBranches() {
 	treeID := uuid.New()
@@ -615,6 +722,22 @@ func (s *HistoryV2PersistenceSuite) genRandomEvents(eventIDs []int64, version in
 	return events
 }
 
+func (s *HistoryV2PersistenceSuite) descendingEventIDs(first, last int64) []int64 {
+	var ids []int64
+	for id := first; id >= last; id-- {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (s *HistoryV2PersistenceSuite) getEventIDs(events []*workflow.HistoryEvent) []int64 {
+	var ids []int64
+	for _, e := range events {
+		ids = append(ids, e.GetEventId())
+	}
+	return ids
+}
+
 // persistence helper
 func (s *HistoryV2PersistenceSuite) newHistoryBranch(treeID string) ([]byte, error) {
 	return p.NewHistoryBranchToken(treeID)
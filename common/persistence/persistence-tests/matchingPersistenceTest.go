@@ -103,6 +103,7 @@ func (s *MatchingPersistenceSuite) TestCreateTask() {
 		s.Equal(*workflowExecution.WorkflowId, resp.Tasks[0].WorkflowID)
 		s.Equal(*workflowExecution.RunId, resp.Tasks[0].RunID)
 		s.Equal(sid, resp.Tasks[0].ScheduleID)
+		s.Equal(defaultActivityType, resp.Tasks[0].ActivityType)
 		if s.TaskMgr.GetName() != "cassandra" {
 			// cassandra uses TTL and expiry isn't stored as part of task state
 			s.True(time.Now().Before(resp.Tasks[0].Expiry))
@@ -126,6 +127,8 @@ func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	s.NotNil(tasks1Response.Tasks, "expected valid list of tasks.")
 	s.Equal(1, len(tasks1Response.Tasks), "Expected 1 decision task.")
 	s.Equal(int64(5), tasks1Response.Tasks[0].ScheduleID)
+	s.Equal(defaultBinaryChecksum, tasks1Response.Tasks[0].BinaryChecksum)
+	s.WithinDuration(time.Now(), tasks1Response.Tasks[0].CreatedTime, time.Minute)
 }
 
 // TestGetTasksWithNoMaxReadLevel test
@@ -324,12 +327,14 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.True(ok)
 
 	taskListInfo := &p.TaskListInfo{
-		DomainID: domainID,
-		Name:     taskList,
-		TaskType: p.TaskListTypeActivity,
-		RangeID:  2,
-		AckLevel: 0,
-		Kind:     p.TaskListKindNormal,
+		DomainID:                  domainID,
+		Name:                      taskList,
+		TaskType:                  p.TaskListTypeActivity,
+		RangeID:                   2,
+		AckLevel:                  0,
+		Kind:                      p.TaskListKindNormal,
+		DefaultBinaryChecksum:     defaultBinaryChecksum,
+		CompatibleBinaryChecksums: []string{"compatible-checksum-1", "compatible-checksum-2"},
 	}
 	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
 		TaskListInfo: taskListInfo,
@@ -341,6 +346,16 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 		TaskListInfo: taskListInfo,
 	})
 	s.Error(err)
+
+	response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.EqualValues(3, response.TaskListInfo.RangeID)
+	s.Equal(defaultBinaryChecksum, response.TaskListInfo.DefaultBinaryChecksum)
+	s.Equal([]string{"compatible-checksum-1", "compatible-checksum-2"}, response.TaskListInfo.CompatibleBinaryChecksums)
 }
 
 // TestLeaseAndUpdateTaskListSticky test
@@ -69,6 +69,7 @@ type (
 		MetadataManagerV2     p.MetadataManager
 		MetadataProxy         p.MetadataManager
 		VisibilityMgr         p.VisibilityManager
+		ClusterMembershipMgr  p.ClusterMembershipManager
 		ShardInfo             *p.ShardInfo
 		TaskIDGenerator       TransferTaskIDGenerator
 		ClusterMetadata       cluster.Metadata
@@ -99,6 +100,8 @@ type (
 
 const (
 	defaultScheduleToStartTimeout = 111
+	defaultActivityType           = "test-activity-type"
+	defaultBinaryChecksum         = "test-binary-checksum"
 )
 
 // NewTestBaseWithCassandra returns a persistence test base backed by cassandra datastore
@@ -197,6 +200,9 @@ func (s *TestBase) Setup() {
 	s.ShardMgr, err = factory.NewShardManager()
 	s.fatalOnError("NewShardManager", err)
 
+	s.ClusterMembershipMgr, err = factory.NewClusterMembershipManager()
+	s.fatalOnError("NewClusterMembershipManager", err)
+
 	s.ExecutionMgrFactory = factory
 	s.ExecutionManager, err = factory.NewExecutionManager(shardID)
 	s.fatalOnError("NewExecutionManager", err)
@@ -1066,11 +1072,13 @@ func (s *TestBase) CreateDecisionTask(domainID string, workflowExecution workflo
 			TaskID:    taskID,
 			Execution: workflowExecution,
 			Data: &p.TaskInfo{
-				DomainID:   domainID,
-				WorkflowID: *workflowExecution.WorkflowId,
-				RunID:      *workflowExecution.RunId,
-				TaskID:     taskID,
-				ScheduleID: decisionScheduleID,
+				DomainID:       domainID,
+				WorkflowID:     *workflowExecution.WorkflowId,
+				RunID:          *workflowExecution.RunId,
+				TaskID:         taskID,
+				ScheduleID:     decisionScheduleID,
+				BinaryChecksum: defaultBinaryChecksum,
+				CreatedTime:    time.Now(),
 			},
 		},
 	}
@@ -1118,6 +1126,7 @@ func (s *TestBase) CreateActivityTasks(domainID string, workflowExecution workfl
 					TaskID:                 taskID,
 					ScheduleID:             activityScheduleID,
 					ScheduleToStartTimeout: defaultScheduleToStartTimeout,
+					ActivityType:           defaultActivityType,
 				},
 			},
 		}
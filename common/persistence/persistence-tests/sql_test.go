@@ -81,3 +81,10 @@ func TestSQLVisibilityPersistenceSuite(t *testing.T) {
 	s.TestBase.Setup()
 	suite.Run(t, s)
 }
+
+func TestSQLClusterMembershipPersistenceSuite(t *testing.T) {
+	s := new(ClusterMembershipPersistenceSuite)
+	s.TestBase = NewTestBaseWithSQL(&TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
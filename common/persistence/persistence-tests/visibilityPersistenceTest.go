@@ -482,6 +482,108 @@ func (s *VisibilityPersistenceSuite) TestFilteringByCloseStatus() {
 }
 
 // TestGetClosedExecution test
+func (s *VisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	testDomainUUID := uuid.New()
+
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	openExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-query-test-open"),
+		RunId:      common.StringPtr("d3c7bfbd-b2bc-4b8a-a2b4-a7fe3e0ff3c5"),
+	}
+	openReq := &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        openExecution,
+		WorkflowTypeName: "visibility-query-workflow",
+		StartTimestamp:   startTime,
+	}
+	err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(openReq)
+	s.Nil(err0)
+
+	closedExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-query-test-closed"),
+		RunId:      common.StringPtr("27ed4ce8-3b1a-4c62-89e7-bb1f19d9c0b4"),
+	}
+	closeReq := &p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        closedExecution,
+		WorkflowTypeName: "visibility-query-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           gen.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:    3,
+	}
+	err1 := s.VisibilityMgr.RecordWorkflowExecutionClosed(closeReq)
+	s.Nil(err1)
+
+	resp, err2 := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      `CloseTime = missing and WorkflowType = 'visibility-query-workflow'`,
+	})
+	s.Nil(err2)
+	s.Equal(1, len(resp.Executions))
+	s.assertOpenExecutionEquals(openReq, resp.Executions[0])
+
+	resp, err3 := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      `CloseStatus = 'failed'`,
+	})
+	s.Nil(err3)
+	s.Equal(1, len(resp.Executions))
+	s.assertClosedExecutionEquals(closeReq, resp.Executions[0])
+
+	resp, err4 := s.VisibilityMgr.ScanWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      `CloseTime != missing and WorkflowID = 'visibility-query-test-closed'`,
+	})
+	s.Nil(err4)
+	s.Equal(1, len(resp.Executions))
+	s.assertClosedExecutionEquals(closeReq, resp.Executions[0])
+
+	_, err5 := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      `WorkflowID like 'visibility%'`,
+	})
+	s.IsType(&gen.BadRequestError{}, err5)
+
+	// executions with the same start time must be paginated without duplicates
+	openReq2 := &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID: testDomainUUID,
+		Execution: gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("visibility-query-test-open2"),
+			RunId:      common.StringPtr("0c5d3a0a-8d4a-4c4b-9b4e-4f7b1a2d6e11"),
+		},
+		WorkflowTypeName: "visibility-query-workflow",
+		StartTimestamp:   startTime,
+	}
+	err6 := s.VisibilityMgr.RecordWorkflowExecutionStarted(openReq2)
+	s.Nil(err6)
+
+	runIDs := make(map[string]struct{})
+	var token []byte
+	for {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+			DomainUUID:    testDomainUUID,
+			PageSize:      1,
+			NextPageToken: token,
+			Query:         `CloseTime = missing and WorkflowType = 'visibility-query-workflow'`,
+		})
+		s.Nil(err)
+		for _, execution := range resp.Executions {
+			s.NotContains(runIDs, execution.GetExecution().GetRunId())
+			runIDs[execution.GetExecution().GetRunId()] = struct{}{}
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Len(runIDs, 2)
+}
+
 func (s *VisibilityPersistenceSuite) TestGetClosedExecution() {
 	testDomainUUID := uuid.New()
 
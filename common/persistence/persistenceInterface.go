@@ -40,6 +40,8 @@ type (
 	ShardStore = ShardManager
 	// TaskStore is a lower level of TaskManager
 	TaskStore = TaskManager
+	// ClusterMembershipStore is a lower level of ClusterMembershipManager
+	ClusterMembershipStore = ClusterMembershipManager
 	// MetadataStore is a lower level of MetadataManager
 	MetadataStore interface {
 		Closeable
@@ -196,6 +198,13 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		// per workflow retention, 0 means using domain retention
+		RetentionDays int32
+		// whole chain timeout across retries and continue-as-new, 0 means no limit
+		WorkflowExecutionTimeout int32
+		WorkflowExpirationTime   time.Time
+		// request IDs of recently applied signals and when they were applied, for deduplicating signals
+		RecentSignalRequestIDs map[string]time.Time
 	}
 
 	// InternalWorkflowExecutionInfo describes a workflow execution for Persistence Interface
@@ -254,6 +263,22 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		// per workflow retention, 0 means using domain retention
+		RetentionDays int32
+		// whole chain timeout across retries and continue-as-new, 0 means no limit
+		WorkflowExecutionTimeout int32
+		WorkflowExpirationTime   time.Time
+		// request IDs of recently applied signals and when they were applied, for deduplicating signals
+		RecentSignalRequestIDs map[string]time.Time
+		// heartbeats of the in flight decision, which extend it without writing history events
+		DecisionHeartbeatCount     int32
+		DecisionHeartbeatTimestamp int64
+		// binary checksum of the worker which last completed a decision, decision tasks are routed to it
+		LastBinaryChecksum string
+		// signals accepted by this run which are not delivered yet
+		DelayedSignals *DataBlob
+		// updates recently accepted by this run, for deduplication
+		AcceptedUpdates *DataBlob
 	}
 
 	// InternalWorkflowMutableState indicates workflow related state for Persistence Interface
@@ -520,6 +545,8 @@ type (
 		NextPageToken []byte
 		// Used in sharded data stores to identify which shard to use
 		ShardID int
+		// Read the history nodes in descending order of node ID
+		Reverse bool
 	}
 
 	// InternalCompleteForkBranchRequest is used to update some tree/branch meta data for forking
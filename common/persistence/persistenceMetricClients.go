@@ -69,6 +69,12 @@ type (
 		persistence  VisibilityManager
 		logger       log.Logger
 	}
+
+	clusterMembershipPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  ClusterMembershipManager
+		logger       log.Logger
+	}
 )
 
 var _ ShardManager = (*shardPersistenceClient)(nil)
@@ -78,6 +84,7 @@ var _ HistoryManager = (*historyPersistenceClient)(nil)
 var _ HistoryV2Manager = (*historyV2PersistenceClient)(nil)
 var _ MetadataManager = (*metadataPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityPersistenceClient)(nil)
+var _ ClusterMembershipManager = (*clusterMembershipPersistenceClient)(nil)
 
 // NewShardPersistenceMetricsClient creates a client to manage shards
 func NewShardPersistenceMetricsClient(persistence ShardManager, metricClient metrics.Client, logger log.Logger) ShardManager {
@@ -142,6 +149,15 @@ func NewVisibilityPersistenceMetricsClient(persistence VisibilityManager, metric
 	}
 }
 
+// NewClusterMembershipPersistenceMetricsClient creates a client to manage the cluster membership
+func NewClusterMembershipPersistenceMetricsClient(persistence ClusterMembershipManager, metricClient metrics.Client, logger log.Logger) ClusterMembershipManager {
+	return &clusterMembershipPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+		logger:       logger,
+	}
+}
+
 func (p *shardPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
@@ -1142,3 +1158,67 @@ func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	}
 }
+
+func (p *clusterMembershipPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *clusterMembershipPersistenceClient) UpsertClusterMembership(request *UpsertClusterMembershipRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpsertClusterMembershipScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpsertClusterMembershipScope, metrics.PersistenceLatency)
+	err := p.persistence.UpsertClusterMembership(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpsertClusterMembershipScope, err)
+	}
+
+	return err
+}
+
+func (p *clusterMembershipPersistenceClient) GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetClusterMembersScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetClusterMembersScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetClusterMembers(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetClusterMembersScope, err)
+	}
+
+	return response, err
+}
+
+func (p *clusterMembershipPersistenceClient) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePruneClusterMembershipScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistencePruneClusterMembershipScope, metrics.PersistenceLatency)
+	err := p.persistence.PruneClusterMembership(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePruneClusterMembershipScope, err)
+	}
+
+	return err
+}
+
+func (p *clusterMembershipPersistenceClient) updateErrorMetric(scope int, err error) {
+	switch err.(type) {
+	case *TimeoutError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *workflow.ServiceBusyError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		p.logger.Error("Operation failed with internal error.", tag.Error(err), tag.MetricScope(scope))
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
+
+func (p *clusterMembershipPersistenceClient) Close() {
+	p.persistence.Close()
+}
@@ -75,6 +75,12 @@ type (
 		persistence VisibilityManager
 		logger      log.Logger
 	}
+
+	clusterMembershipRateLimitedPersistenceClient struct {
+		rateLimiter tokenbucket.TokenBucket
+		persistence ClusterMembershipManager
+		logger      log.Logger
+	}
 )
 
 var _ ShardManager = (*shardRateLimitedPersistenceClient)(nil)
@@ -84,6 +90,7 @@ var _ HistoryManager = (*historyRateLimitedPersistenceClient)(nil)
 var _ HistoryV2Manager = (*historyV2RateLimitedPersistenceClient)(nil)
 var _ MetadataManager = (*metadataRateLimitedPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityRateLimitedPersistenceClient)(nil)
+var _ ClusterMembershipManager = (*clusterMembershipRateLimitedPersistenceClient)(nil)
 
 // NewShardPersistenceRateLimitedClient creates a client to manage shards
 func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter tokenbucket.TokenBucket, logger log.Logger) ShardManager {
@@ -148,6 +155,15 @@ func NewVisibilityPersistenceRateLimitedClient(persistence VisibilityManager, ra
 	}
 }
 
+// NewClusterMembershipPersistenceRateLimitedClient creates a client to manage the cluster membership
+func NewClusterMembershipPersistenceRateLimitedClient(persistence ClusterMembershipManager, rateLimiter tokenbucket.TokenBucket, logger log.Logger) ClusterMembershipManager {
+	return &clusterMembershipRateLimitedPersistenceClient{
+		persistence: persistence,
+		rateLimiter: rateLimiter,
+		logger:      logger,
+	}
+}
+
 func (p *shardRateLimitedPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
@@ -723,3 +739,38 @@ func (p *historyV2RateLimitedPersistenceClient) GetHistoryTree(request *GetHisto
 	response, err := p.persistence.GetHistoryTree(request)
 	return response, err
 }
+
+func (p *clusterMembershipRateLimitedPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *clusterMembershipRateLimitedPersistenceClient) UpsertClusterMembership(request *UpsertClusterMembershipRequest) error {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.UpsertClusterMembership(request)
+	return err
+}
+
+func (p *clusterMembershipRateLimitedPersistenceClient) GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetClusterMembers(request)
+	return response, err
+}
+
+func (p *clusterMembershipRateLimitedPersistenceClient) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.PruneClusterMembership(request)
+	return err
+}
+
+func (p *clusterMembershipRateLimitedPersistenceClient) Close() {
+	p.persistence.Close()
+}
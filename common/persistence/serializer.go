@@ -52,6 +52,14 @@ type (
 		// serialize/deserialize bad binaries
 		SerializeBadBinaries(event *workflow.BadBinaries, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeBadBinaries(data *DataBlob) (*workflow.BadBinaries, error)
+
+		// serialize/deserialize delayed signals
+		SerializeDelayedSignals(signals *workflow.DelayedSignals, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeDelayedSignals(data *DataBlob) (*workflow.DelayedSignals, error)
+
+		// serialize/deserialize accepted workflow updates
+		SerializeAcceptedUpdates(updates *workflow.AcceptedWorkflowUpdates, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeAcceptedUpdates(data *DataBlob) (*workflow.AcceptedWorkflowUpdates, error)
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -139,6 +147,32 @@ func (t *serializerImpl) DeserializeBadBinaries(data *DataBlob) (*workflow.BadBi
 	return &bb, err
 }
 
+func (t *serializerImpl) SerializeDelayedSignals(signals *workflow.DelayedSignals, encodingType common.EncodingType) (*DataBlob, error) {
+	if signals == nil {
+		signals = &workflow.DelayedSignals{}
+	}
+	return t.serialize(signals, encodingType)
+}
+
+func (t *serializerImpl) DeserializeDelayedSignals(data *DataBlob) (*workflow.DelayedSignals, error) {
+	var signals workflow.DelayedSignals
+	err := t.deserialize(data, &signals)
+	return &signals, err
+}
+
+func (t *serializerImpl) SerializeAcceptedUpdates(updates *workflow.AcceptedWorkflowUpdates, encodingType common.EncodingType) (*DataBlob, error) {
+	if updates == nil {
+		updates = &workflow.AcceptedWorkflowUpdates{}
+	}
+	return t.serialize(updates, encodingType)
+}
+
+func (t *serializerImpl) DeserializeAcceptedUpdates(data *DataBlob) (*workflow.AcceptedWorkflowUpdates, error) {
+	var updates workflow.AcceptedWorkflowUpdates
+	err := t.deserialize(data, &updates)
+	return &updates, err
+}
+
 func (t *serializerImpl) SerializeVisibilityMemo(memo *workflow.Memo, encodingType common.EncodingType) (*DataBlob, error) {
 	if memo == nil {
 		// Return nil here to be consistent with Event
@@ -190,6 +224,10 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(input.(*workflow.ResetPoints))
 	case *workflow.BadBinaries:
 		return t.thriftrwEncoder.Encode(input.(*workflow.BadBinaries))
+	case *workflow.DelayedSignals:
+		return t.thriftrwEncoder.Encode(input.(*workflow.DelayedSignals))
+	case *workflow.AcceptedWorkflowUpdates:
+		return t.thriftrwEncoder.Encode(input.(*workflow.AcceptedWorkflowUpdates))
 	default:
 		return nil, nil
 	}
@@ -243,6 +281,12 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		rp := target.(*workflow.BadBinaries)
 		t.thriftrwEncoder.Decode(data, rp)
 		return nil
+	case *workflow.DelayedSignals:
+		signals := target.(*workflow.DelayedSignals)
+		return t.thriftrwEncoder.Decode(data, signals)
+	case *workflow.AcceptedWorkflowUpdates:
+		updates := target.(*workflow.AcceptedWorkflowUpdates)
+		return t.thriftrwEncoder.Decode(data, updates)
 	default:
 		return nil
 	}
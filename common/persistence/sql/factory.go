@@ -130,6 +130,15 @@ func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 	return NewSQLVisibilityStore(f.cfg, f.logger)
 }
 
+// NewClusterMembershipStore returns a new cluster membership store
+func (f *Factory) NewClusterMembershipStore() (p.ClusterMembershipStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+	return newClusterMembershipPersistence(conn, f.logger)
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.dbConn.forceClose()
@@ -0,0 +1,109 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+type sqlClusterMembershipManager struct {
+	sqlStore
+}
+
+// newClusterMembershipPersistence creates an instance of ClusterMembershipManager
+func newClusterMembershipPersistence(db sqldb.Interface, log log.Logger) (persistence.ClusterMembershipManager, error) {
+	return &sqlClusterMembershipManager{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: log,
+		},
+	}, nil
+}
+
+func (m *sqlClusterMembershipManager) UpsertClusterMembership(request *persistence.UpsertClusterMembershipRequest) error {
+	if request.RecordExpiry <= 0 {
+		return &persistence.InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("UpsertClusterMembership requires a positive record expiry, got %v", request.RecordExpiry),
+		}
+	}
+
+	now := time.Now()
+	if _, err := m.db.UpsertClusterMembership(&sqldb.ClusterMembershipRow{
+		Role:          request.Role,
+		RPCAddress:    request.RPCAddress,
+		SessionStart:  request.SessionStart,
+		LastHeartbeat: now,
+		RecordExpiry:  now.Add(request.RecordExpiry),
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpsertClusterMembership operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlClusterMembershipManager) GetClusterMembers(request *persistence.GetClusterMembersRequest) (*persistence.GetClusterMembersResponse, error) {
+	filter := &sqldb.ClusterMembershipFilter{Role: request.Role}
+	if request.LastHeartbeatWithin > 0 {
+		filter.LastHeartbeatAfter = time.Now().Add(-request.LastHeartbeatWithin)
+	}
+
+	rows, err := m.db.SelectFromClusterMembership(filter)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetClusterMembers operation failed. Error: %v", err),
+		}
+	}
+
+	now := time.Now()
+	members := make([]*persistence.ClusterMember, 0, len(rows))
+	for _, row := range rows {
+		if row.RecordExpiry.Before(now) {
+			continue
+		}
+		members = append(members, &persistence.ClusterMember{
+			Role:          row.Role,
+			RPCAddress:    row.RPCAddress,
+			SessionStart:  row.SessionStart,
+			LastHeartbeat: row.LastHeartbeat,
+		})
+	}
+	return &persistence.GetClusterMembersResponse{ActiveMembers: members}, nil
+}
+
+func (m *sqlClusterMembershipManager) PruneClusterMembership(request *persistence.PruneClusterMembershipRequest) error {
+	if _, err := m.db.DeleteFromClusterMembership(&sqldb.ClusterMembershipFilter{
+		RecordExpiryBefore: time.Now(),
+		PageSize:           common.IntPtr(request.MaxRecordsPruned),
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PruneClusterMembership operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
@@ -239,6 +239,13 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 		MaximumInterval:              info.GetRetryMaximumIntervalSeconds(),
 		MaximumAttempts:              info.GetRetryMaximumAttempts(),
 		ExpirationSeconds:            info.GetRetryExpirationSeconds(),
+		RetentionDays:                info.GetRetentionDays(),
+		WorkflowExecutionTimeout:     info.GetWorkflowExecutionTimeout(),
+		WorkflowExpirationTime:       time.Unix(0, info.GetWorkflowExpirationTimeNanos()),
+		RecentSignalRequestIDs:       signalRequestIDsFromNanos(info.GetRecentSignalRequestIDs()),
+		DecisionHeartbeatCount:       info.GetDecisionHeartbeatCount(),
+		DecisionHeartbeatTimestamp:   info.GetDecisionHeartbeatTimeNanos(),
+		LastBinaryChecksum:           info.GetLastBinaryChecksum(),
 		ExpirationTime:               time.Unix(0, info.GetRetryExpirationTimeNanos()),
 		EventStoreVersion:            info.GetEventStoreVersion(),
 		BranchToken:                  info.GetEventBranchToken(),
@@ -287,6 +294,16 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 			common.EncodingType(info.GetAutoResetPointsEncoding()))
 	}
 
+	if info.DelayedSignals != nil {
+		state.ExecutionInfo.DelayedSignals = p.NewDataBlob(info.DelayedSignals,
+			common.EncodingType(info.GetDelayedSignalsEncoding()))
+	}
+
+	if info.AcceptedUpdates != nil {
+		state.ExecutionInfo.AcceptedUpdates = p.NewDataBlob(info.AcceptedUpdates,
+			common.EncodingType(info.GetAcceptedUpdatesEncoding()))
+	}
+
 	{
 		var err error
 		state.ActivitInfos, err = getActivityInfoMap(m.db,
@@ -1139,6 +1156,7 @@ func (m *sqlExecutionManager) GetTransferTasks(request *p.GetTransferTasksReques
 			TaskType:                int(info.GetTaskType()),
 			ScheduleID:              info.GetScheduleID(),
 			Version:                 info.GetVersion(),
+			TraceContext:            info.GetTraceContext(),
 		}
 	}
 	return resp, nil
@@ -1310,6 +1328,7 @@ func (m *sqlExecutionManager) GetTimerIndexTasks(request *p.GetTimerIndexTasksRe
 			EventID:             info.GetEventID(),
 			ScheduleAttempt:     info.GetScheduleAttempt(),
 			Version:             info.GetVersion(),
+			SignalRequestID:     info.GetSignalRequestID(),
 		}
 	}
 
@@ -1435,6 +1454,10 @@ func createExecutionFromRequest(
 		RetryMaximumIntervalSeconds:  &request.MaximumInterval,
 		RetryMaximumAttempts:         &request.MaximumAttempts,
 		RetryExpirationSeconds:       &request.ExpirationSeconds,
+		RetentionDays:                &request.RetentionDays,
+		WorkflowExecutionTimeout:     &request.WorkflowExecutionTimeout,
+		WorkflowExpirationTimeNanos:  common.Int64Ptr(request.WorkflowExpirationTime.UnixNano()),
+		RecentSignalRequestIDs:       signalRequestIDsToNanos(request.RecentSignalRequestIDs),
 		RetryExpirationTimeNanos:     common.Int64Ptr(request.ExpirationTime.UnixNano()),
 		RetryNonRetryableErrors:      request.NonRetriableErrors,
 		ExecutionContext:             request.ExecutionContext,
@@ -1602,11 +1625,13 @@ func createTransferTasks(tx sqldb.Tx, transferTasks []p.Task, shardID int, domai
 			info.TargetDomainID = sqldb.MustParseUUID(task.(*p.ActivityTask).DomainID)
 			info.TaskList = &task.(*p.ActivityTask).TaskList
 			info.ScheduleID = &task.(*p.ActivityTask).ScheduleID
+			info.TraceContext = task.(*p.ActivityTask).TraceContext
 
 		case p.TransferTaskTypeDecisionTask:
 			info.TargetDomainID = sqldb.MustParseUUID(task.(*p.DecisionTask).DomainID)
 			info.TaskList = &task.(*p.DecisionTask).TaskList
 			info.ScheduleID = &task.(*p.DecisionTask).ScheduleID
+			info.TraceContext = task.(*p.DecisionTask).TraceContext
 
 		case p.TransferTaskTypeCancelExecution:
 			info.TargetDomainID = sqldb.MustParseUUID(task.(*p.CancelExecutionTask).TargetDomainID)
@@ -1798,6 +1823,8 @@ func createTimerTasks(
 			case *p.WorkflowBackoffTimerTask:
 				info.EventID = &t.EventID
 				info.TimeoutType = common.Int16Ptr(int16(t.TimeoutType))
+			case *p.DelayedSignalTask:
+				info.SignalRequestID = common.StringPtr(t.RequestID)
 			}
 
 			info.DomainID = domainID
@@ -1931,12 +1958,23 @@ func buildExecutionRow(executionInfo *p.InternalWorkflowExecutionInfo,
 		RetryMaximumIntervalSeconds:  &executionInfo.MaximumInterval,
 		RetryMaximumAttempts:         &executionInfo.MaximumAttempts,
 		RetryExpirationSeconds:       &executionInfo.ExpirationSeconds,
+		RetentionDays:                &executionInfo.RetentionDays,
+		WorkflowExecutionTimeout:     &executionInfo.WorkflowExecutionTimeout,
+		WorkflowExpirationTimeNanos:  common.Int64Ptr(executionInfo.WorkflowExpirationTime.UnixNano()),
+		RecentSignalRequestIDs:       signalRequestIDsToNanos(executionInfo.RecentSignalRequestIDs),
+		DecisionHeartbeatCount:       &executionInfo.DecisionHeartbeatCount,
+		DecisionHeartbeatTimeNanos:   &executionInfo.DecisionHeartbeatTimestamp,
+		LastBinaryChecksum:           &executionInfo.LastBinaryChecksum,
 		RetryExpirationTimeNanos:     common.Int64Ptr(executionInfo.ExpirationTime.UnixNano()),
 		RetryNonRetryableErrors:      executionInfo.NonRetriableErrors,
 		EventStoreVersion:            &executionInfo.EventStoreVersion,
 		EventBranchToken:             executionInfo.BranchToken,
 		AutoResetPoints:              executionInfo.AutoResetPoints.Data,
 		AutoResetPointsEncoding:      common.StringPtr(string(executionInfo.AutoResetPoints.GetEncoding())),
+		DelayedSignals:               executionInfo.DelayedSignals.Data,
+		DelayedSignalsEncoding:       common.StringPtr(string(executionInfo.DelayedSignals.GetEncoding())),
+		AcceptedUpdates:              executionInfo.AcceptedUpdates.Data,
+		AcceptedUpdatesEncoding:      common.StringPtr(string(executionInfo.AcceptedUpdates.GetEncoding())),
 	}
 
 	completionEvent := executionInfo.CompletionEvent
@@ -2042,3 +2080,25 @@ func createExecution(tx sqldb.Tx,
 
 	return nil
 }
+
+func signalRequestIDsToNanos(requestIDs map[string]time.Time) map[string]int64 {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	result := make(map[string]int64, len(requestIDs))
+	for requestID, appliedTime := range requestIDs {
+		result[requestID] = appliedTime.UnixNano()
+	}
+	return result
+}
+
+func signalRequestIDsFromNanos(requestIDs map[string]int64) map[string]time.Time {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	result := make(map[string]time.Time, len(requestIDs))
+	for requestID, appliedTimeNanos := range requestIDs {
+		result[requestID] = time.Unix(0, appliedTimeNanos)
+	}
+	return result
+}
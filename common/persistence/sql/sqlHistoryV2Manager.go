@@ -154,6 +154,7 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(request *p.InternalAppendHistor
 // ReadHistoryBranch returns history node data for a branch
 func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
 	minNodeID := request.MinNodeID
+	maxNodeID := request.MaxNodeID
 
 	if request.NextPageToken != nil && len(request.NextPageToken) > 0 {
 		var lastNodeID int64
@@ -162,16 +163,21 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 			return nil, &shared.InternalServiceError{
 				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken)}
 		}
-		minNodeID = lastNodeID + 1
+		if request.Reverse {
+			maxNodeID = lastNodeID
+		} else {
+			minNodeID = lastNodeID + 1
+		}
 	}
 
 	filter := &sqldb.HistoryNodeFilter{
 		TreeID:    sqldb.MustParseUUID(request.TreeID),
 		BranchID:  sqldb.MustParseUUID(request.BranchID),
 		MinNodeID: &minNodeID,
-		MaxNodeID: &request.MaxNodeID,
+		MaxNodeID: &maxNodeID,
 		PageSize:  &request.PageSize,
 		ShardID:   request.ShardID,
+		Reverse:   request.Reverse,
 	}
 
 	rows, err := m.db.SelectFromHistoryNode(filter)
@@ -180,6 +186,7 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 	}
 
 	history := make([]*p.DataBlob, 0, int(request.PageSize))
+	nodeIDs := make([]int64, 0, int(request.PageSize))
 	lastNodeID := int64(-1)
 	lastTxnID := int64(-1)
 	eventBlob := &p.DataBlob{}
@@ -188,10 +195,25 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 		eventBlob.Data = row.Data
 		eventBlob.Encoding = common.EncodingType(row.DataEncoding)
 		switch {
-		case row.NodeID < lastNodeID:
+		case !request.Reverse && row.NodeID < lastNodeID:
 			return nil, &shared.InternalServiceError{
 				Message: fmt.Sprintf("corrupted data, nodeID cannot decrease"),
 			}
+		case request.Reverse && lastNodeID != -1 && row.NodeID > lastNodeID:
+			return nil, &shared.InternalServiceError{
+				Message: fmt.Sprintf("corrupted data, nodeID cannot increase in reverse order"),
+			}
+		case request.Reverse && row.NodeID == lastNodeID:
+			// NOTE: in reverse order, the rows of the same node come in ascending order of txnID,
+			// so the one with largest txnID comes last and replaces the ones before it
+			if *row.TxnID <= lastTxnID {
+				return nil, &shared.InternalServiceError{
+					Message: fmt.Sprintf("corrupted data, same nodeID must have larger txnID in reverse order"),
+				}
+			}
+			lastTxnID = *row.TxnID
+			history[len(history)-1] = eventBlob
+			eventBlob = &p.DataBlob{}
 		case row.NodeID == lastNodeID:
 			if *row.TxnID < lastTxnID {
 				// skip the nodes with smaller txn_id
@@ -201,10 +223,11 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 					Message: fmt.Sprintf("corrupted data, same nodeID must have smaller txnID"),
 				}
 			}
-		default: // row.NodeID > lastNodeID:
+		default: // row.NodeID > lastNodeID, or row.NodeID < lastNodeID in reverse order:
 			// NOTE: when row.nodeID > lastNodeID, we expect the one with largest txnID comes first
 			lastTxnID = *row.TxnID
 			lastNodeID = row.NodeID
+			nodeIDs = append(nodeIDs, row.NodeID)
 			history = append(history, eventBlob)
 			eventBlob = &p.DataBlob{}
 		}
@@ -212,6 +235,35 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 
 	var pagingToken []byte
 	if len(rows) >= request.PageSize {
+		if request.Reverse && len(nodeIDs) > 1 {
+			// rows of the last node may continue beyond this page, so it is read again with the next page
+			history = history[:len(history)-1]
+			nodeIDs = nodeIDs[:len(nodeIDs)-1]
+			lastNodeID = nodeIDs[len(nodeIDs)-1]
+		} else if request.Reverse {
+			// rows of the only node of this page may continue beyond it, so the node is read again in
+			// ascending order of node ID, which returns the row with largest txnID first
+			nodeMinID := lastNodeID
+			nodeMaxID := lastNodeID + 1
+			nodePageSize := 1
+			nodeRows, err := m.db.SelectFromHistoryNode(&sqldb.HistoryNodeFilter{
+				TreeID:    filter.TreeID,
+				BranchID:  filter.BranchID,
+				MinNodeID: &nodeMinID,
+				MaxNodeID: &nodeMaxID,
+				PageSize:  &nodePageSize,
+				ShardID:   request.ShardID,
+			})
+			if err != nil || len(nodeRows) == 0 {
+				return nil, &shared.InternalServiceError{
+					Message: fmt.Sprintf("ReadHistoryBranch operation failed. Error: %v", err),
+				}
+			}
+			history[0] = &p.DataBlob{
+				Data:     nodeRows[0].Data,
+				Encoding: common.EncodingType(nodeRows[0].DataEncoding),
+			}
+		}
 		pagingToken = serializePageToken(lastNodeID)
 	}
 	response := &p.InternalReadHistoryBranchResponse{
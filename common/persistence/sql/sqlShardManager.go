@@ -121,6 +121,7 @@ func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persi
 		ClusterTransferAckLevel:   shardInfo.ClusterTransferAckLevel,
 		ClusterTimerAckLevel:      timerAckLevel,
 		DomainNotificationVersion: shardInfo.GetDomainNotificationVersion(),
+		RunningWorkflowCounts:     shardInfo.RunningWorkflowCounts,
 	}}
 
 	return resp, nil
@@ -215,6 +216,7 @@ func shardInfoToShardsRow(s persistence.ShardInfo) (*sqldb.ShardsRow, error) {
 		ClusterTimerAckLevel:      timerAckLevels,
 		DomainNotificationVersion: common.Int64Ptr(s.DomainNotificationVersion),
 		Owner:                     &s.Owner,
+		RunningWorkflowCounts:     s.RunningWorkflowCounts,
 	}
 
 	blob, err := shardInfoToBlob(shardInfo)
@@ -148,13 +148,15 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 			return fmt.Errorf("%v rows affected instead of 1", rowsAffected)
 		}
 		resp = &persistence.LeaseTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
-			DomainID:    request.DomainID,
-			Name:        request.TaskList,
-			TaskType:    request.TaskType,
-			RangeID:     rangeID + 1,
-			AckLevel:    ackLevel,
-			Kind:        request.TaskListKind,
-			LastUpdated: now,
+			DomainID:                  request.DomainID,
+			Name:                      request.TaskList,
+			TaskType:                  request.TaskType,
+			RangeID:                   rangeID + 1,
+			AckLevel:                  ackLevel,
+			Kind:                      request.TaskListKind,
+			LastUpdated:               now,
+			DefaultBinaryChecksum:     tlInfo.GetDefaultBinaryChecksum(),
+			CompatibleBinaryChecksums: tlInfo.GetCompatibleBinaryChecksums(),
 		}}
 		return nil
 	})
@@ -165,10 +167,12 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 	shardID := m.shardID(request.TaskListInfo.DomainID, request.TaskListInfo.Name)
 	domainID := sqldb.MustParseUUID(request.TaskListInfo.DomainID)
 	tlInfo := &sqlblobs.TaskListInfo{
-		AckLevel:         common.Int64Ptr(request.TaskListInfo.AckLevel),
-		Kind:             common.Int16Ptr(int16(request.TaskListInfo.Kind)),
-		ExpiryTimeNanos:  common.Int64Ptr(0),
-		LastUpdatedNanos: common.TimeNowNanosPtr(),
+		AckLevel:                  common.Int64Ptr(request.TaskListInfo.AckLevel),
+		Kind:                      common.Int16Ptr(int16(request.TaskListInfo.Kind)),
+		ExpiryTimeNanos:           common.Int64Ptr(0),
+		LastUpdatedNanos:          common.TimeNowNanosPtr(),
+		DefaultBinaryChecksum:     common.StringPtr(request.TaskListInfo.DefaultBinaryChecksum),
+		CompatibleBinaryChecksums: request.TaskListInfo.CompatibleBinaryChecksums,
 	}
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		tlInfo.ExpiryTimeNanos = common.Int64Ptr(stickyTaskListTTL().UnixNano())
@@ -296,6 +300,8 @@ func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest)
 		resp.Items[i].AckLevel = info.GetAckLevel()
 		resp.Items[i].Expiry = time.Unix(0, info.GetExpiryTimeNanos())
 		resp.Items[i].LastUpdated = time.Unix(0, info.GetLastUpdatedNanos())
+		resp.Items[i].DefaultBinaryChecksum = info.GetDefaultBinaryChecksum()
+		resp.Items[i].CompatibleBinaryChecksums = info.GetCompatibleBinaryChecksums()
 	}
 
 	return resp, nil
@@ -331,10 +337,13 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 			expiryTime = time.Now().Add(time.Second * time.Duration(v.Data.ScheduleToStartTimeout))
 		}
 		blob, err := taskInfoToBlob(&sqlblobs.TaskInfo{
-			WorkflowID:      &v.Data.WorkflowID,
-			RunID:           sqldb.MustParseUUID(v.Data.RunID),
-			ScheduleID:      &v.Data.ScheduleID,
-			ExpiryTimeNanos: common.Int64Ptr(expiryTime.UnixNano()),
+			WorkflowID:       &v.Data.WorkflowID,
+			RunID:            sqldb.MustParseUUID(v.Data.RunID),
+			ScheduleID:       &v.Data.ScheduleID,
+			ExpiryTimeNanos:  common.Int64Ptr(expiryTime.UnixNano()),
+			ActivityType:     &v.Data.ActivityType,
+			BinaryChecksum:   &v.Data.BinaryChecksum,
+			CreatedTimeNanos: common.Int64Ptr(v.Data.CreatedTime.UnixNano()),
 		})
 		if err != nil {
 			return nil, err
@@ -390,12 +399,15 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 			return nil, err
 		}
 		tasks[i] = &persistence.TaskInfo{
-			DomainID:   request.DomainID,
-			WorkflowID: info.GetWorkflowID(),
-			RunID:      sqldb.UUID(info.RunID).String(),
-			TaskID:     v.TaskID,
-			ScheduleID: info.GetScheduleID(),
-			Expiry:     time.Unix(0, info.GetExpiryTimeNanos()),
+			DomainID:       request.DomainID,
+			WorkflowID:     info.GetWorkflowID(),
+			RunID:          sqldb.UUID(info.RunID).String(),
+			TaskID:         v.TaskID,
+			ScheduleID:     info.GetScheduleID(),
+			Expiry:         time.Unix(0, info.GetExpiryTimeNanos()),
+			ActivityType:   info.GetActivityType(),
+			BinaryChecksum: info.GetBinaryChecksum(),
+			CreatedTime:    time.Unix(0, info.GetCreatedTimeNanos()),
 		}
 	}
 
@@ -28,10 +28,12 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/storage"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/persistence/visibilityquery"
 	"github.com/uber/cadence/common/service/config"
 )
 
@@ -245,15 +247,92 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDelete
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := s.parseQuery(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	return s.queryWorkflowExecutions("ListWorkflowExecutions", request, query)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := s.parseQuery(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	return s.queryWorkflowExecutions("ScanWorkflowExecutions", request, query)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := s.parseQuery(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.db.CountFromVisibilityByQuery(&sqldb.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err),
+		}
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *sqlVisibilityStore) parseQuery(query string) (*visibilityquery.Query, error) {
+	result, err := visibilityquery.Parse(query)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	// results are paginated on (start_time DESC, run_id), so that is the only order supported
+	for _, order := range result.OrderBy {
+		if order.Field != es.StartTime || !order.Desc {
+			return nil, &workflow.BadRequestError{Message: "Only ORDER BY StartTime DESC is supported by sql visibility store"}
+		}
+	}
+	return result, nil
+}
+
+func (s *sqlVisibilityStore) queryWorkflowExecutions(opName string, request *p.ListWorkflowExecutionsRequestV2, query *visibilityquery.Query) (*p.InternalListWorkflowExecutionsResponse, error) {
+	filter := &sqldb.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+		PageSize: &request.PageSize,
+	}
+	if len(request.NextPageToken) > 0 {
+		readLevel, err := s.deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid next page token: %v", err)}
+		}
+		filter.MaxStartTime = &readLevel.Time
+		filter.RunID = &readLevel.RunID
+	}
+	rows, err := s.db.SelectFromVisibilityByQuery(filter)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Select failed: %v", opName, err),
+		}
+	}
+
+	var infos = make([]*p.VisibilityWorkflowExecutionInfo, len(rows))
+	for i, row := range rows {
+		infos[i] = s.rowToInfo(&row)
+	}
+	var nextPageToken []byte
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		nextPageToken, err = s.serializePageToken(&visibilityPageToken{
+			Time:  lastRow.StartTime,
+			RunID: lastRow.RunID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqldb.VisibilityRow) *p.VisibilityWorkflowExecutionInfo {
@@ -70,6 +70,16 @@ const (
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
 
+	templateQuerySelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
+		 FROM executions_visibility WHERE domain_id = ? `
+
+	// RunID condition is needed for correct pagination
+	templateQueryReadLevel = `AND (start_time < ? OR (start_time = ? AND run_id > ?)) `
+
+	templateQueryOrderBy = `ORDER BY start_time DESC, run_id LIMIT ?`
+
+	templateQueryCount = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ? `
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
 )
 
@@ -192,6 +202,58 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 	if err != nil {
 		return nil, err
 	}
+	mdb.fromMySQLVisibilityRows(rows)
+	return rows, err
+}
+
+// SelectFromVisibilityByQuery reads one or more rows from visibility table that match the query
+func (mdb *DB) SelectFromVisibilityByQuery(filter *sqldb.VisibilityQueryFilter) ([]sqldb.VisibilityRow, error) {
+	builder := newVisibilityQueryBuilder(mdb.converter)
+	builder.buf.WriteString(templateQuerySelect)
+	builder.args = append(builder.args, filter.DomainID)
+	if filter.Query.Filter != nil {
+		builder.buf.WriteString("AND (")
+		if err := builder.where(filter.Query.Filter); err != nil {
+			return nil, err
+		}
+		builder.buf.WriteString(") ")
+	}
+	if filter.MaxStartTime != nil && filter.RunID != nil {
+		maxStartTime := mdb.converter.ToMySQLDateTime(*filter.MaxStartTime)
+		builder.buf.WriteString(templateQueryReadLevel)
+		builder.args = append(builder.args, maxStartTime, maxStartTime, *filter.RunID)
+	}
+	builder.buf.WriteString(templateQueryOrderBy)
+	builder.args = append(builder.args, *filter.PageSize)
+
+	var rows []sqldb.VisibilityRow
+	if err := mdb.conn.Select(&rows, builder.buf.String(), builder.args...); err != nil {
+		return nil, err
+	}
+	mdb.fromMySQLVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows in visibility table that match the query
+func (mdb *DB) CountFromVisibilityByQuery(filter *sqldb.VisibilityQueryFilter) (int64, error) {
+	builder := newVisibilityQueryBuilder(mdb.converter)
+	builder.buf.WriteString(templateQueryCount)
+	builder.args = append(builder.args, filter.DomainID)
+	if filter.Query.Filter != nil {
+		builder.buf.WriteString("AND ")
+		if err := builder.where(filter.Query.Filter); err != nil {
+			return 0, err
+		}
+	}
+
+	var count int64
+	if err := mdb.conn.Get(&count, builder.buf.String(), builder.args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (mdb *DB) fromMySQLVisibilityRows(rows []sqldb.VisibilityRow) {
 	for i := range rows {
 		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
 		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
@@ -200,5 +262,4 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 			rows[i].CloseTime = &closeTime
 		}
 	}
-	return rows, err
 }
@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/persistence/visibilityquery"
+)
+
+// visibilityColumns maps visibility query fields to executions_visibility columns
+var visibilityColumns = map[string]string{
+	es.WorkflowID:    "workflow_id",
+	es.RunID:         "run_id",
+	es.WorkflowType:  "workflow_type_name",
+	es.StartTime:     "start_time",
+	es.ExecutionTime: "execution_time",
+	es.CloseTime:     "close_time",
+	es.CloseStatus:   "close_status",
+	es.HistoryLength: "history_length",
+}
+
+// visibilityQueryBuilder translates a parsed visibility query into
+// a parameterized WHERE clause on executions_visibility
+type visibilityQueryBuilder struct {
+	converter DataConverter
+	buf       strings.Builder
+	args      []interface{}
+}
+
+func newVisibilityQueryBuilder(converter DataConverter) *visibilityQueryBuilder {
+	return &visibilityQueryBuilder{converter: converter}
+}
+
+func (b *visibilityQueryBuilder) where(expr visibilityquery.Expression) error {
+	switch expr := expr.(type) {
+	case *visibilityquery.AndExpression:
+		return b.binary(expr.Left, expr.Right, "AND")
+	case *visibilityquery.OrExpression:
+		return b.binary(expr.Left, expr.Right, "OR")
+	case *visibilityquery.NotExpression:
+		b.buf.WriteString("NOT (")
+		if err := b.where(expr.Expression); err != nil {
+			return err
+		}
+		b.buf.WriteString(")")
+	case *visibilityquery.ComparisonExpression:
+		column, err := b.column(expr.Field)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b.buf, "%v %v ?", column, expr.Operator)
+		b.args = append(b.args, b.value(expr.Field, expr.Value))
+	case *visibilityquery.RangeExpression:
+		column, err := b.column(expr.Field)
+		if err != nil {
+			return err
+		}
+		operator := "BETWEEN"
+		if expr.Not {
+			operator = "NOT BETWEEN"
+		}
+		fmt.Fprintf(&b.buf, "%v %v ? AND ?", column, operator)
+		b.args = append(b.args, b.value(expr.Field, expr.From), b.value(expr.Field, expr.To))
+	case *visibilityquery.InExpression:
+		column, err := b.column(expr.Field)
+		if err != nil {
+			return err
+		}
+		operator := "IN"
+		if expr.Not {
+			operator = "NOT IN"
+		}
+		placeholders := make([]string, len(expr.Values))
+		for i, v := range expr.Values {
+			placeholders[i] = "?"
+			b.args = append(b.args, b.value(expr.Field, v))
+		}
+		fmt.Fprintf(&b.buf, "%v %v (%v)", column, operator, strings.Join(placeholders, ", "))
+	case *visibilityquery.MissingExpression:
+		column, err := b.column(expr.Field)
+		if err != nil {
+			return err
+		}
+		operator := "IS NULL"
+		if expr.Not {
+			operator = "IS NOT NULL"
+		}
+		fmt.Fprintf(&b.buf, "%v %v", column, operator)
+	default:
+		return fmt.Errorf("unsupported expression: %T", expr)
+	}
+	return nil
+}
+
+func (b *visibilityQueryBuilder) binary(left, right visibilityquery.Expression, operator string) error {
+	b.buf.WriteString("(")
+	if err := b.where(left); err != nil {
+		return err
+	}
+	fmt.Fprintf(&b.buf, " %v ", operator)
+	if err := b.where(right); err != nil {
+		return err
+	}
+	b.buf.WriteString(")")
+	return nil
+}
+
+func (b *visibilityQueryBuilder) column(field string) (string, error) {
+	column, ok := visibilityColumns[field]
+	if !ok {
+		return "", fmt.Errorf("unsupported field: %v", field)
+	}
+	return column, nil
+}
+
+func (b *visibilityQueryBuilder) value(field string, value interface{}) interface{} {
+	switch field {
+	case es.StartTime, es.ExecutionTime, es.CloseTime:
+		if nanos, ok := value.(int64); ok {
+			return b.converter.ToMySQLDateTime(time.Unix(0, nanos))
+		}
+	}
+	return value
+}
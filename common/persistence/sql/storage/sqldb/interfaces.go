@@ -23,6 +23,8 @@ package sqldb
 import (
 	"database/sql"
 	"time"
+
+	"github.com/uber/cadence/common/persistence/visibilityquery"
 )
 
 type (
@@ -279,6 +281,8 @@ type (
 		// Exclusive
 		MaxNodeID *int64
 		PageSize  *int
+		// Select the nodes in descending order of node_id
+		Reverse bool
 	}
 
 	// HistoryTreeRow represents a row in history_tree table
@@ -481,6 +485,36 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the parsed visibility query that is
+	// translated into the WHERE clause on visibility table. Rows are ordered
+	// by (start_time DESC, run_id) and MaxStartTime / RunID, when set, are
+	// the position of the last row of the previous page
+	VisibilityQueryFilter struct {
+		DomainID     string
+		Query        *visibilityquery.Query
+		MaxStartTime *time.Time
+		RunID        *string
+		PageSize     *int
+	}
+
+	// ClusterMembershipRow represents a row in cluster_membership table
+	ClusterMembershipRow struct {
+		Role          string
+		RPCAddress    string
+		SessionStart  time.Time
+		LastHeartbeat time.Time
+		RecordExpiry  time.Time
+	}
+
+	// ClusterMembershipFilter contains the column names within cluster_membership table that
+	// can be used to filter results through a WHERE clause
+	ClusterMembershipFilter struct {
+		Role               string
+		LastHeartbeatAfter time.Time
+		RecordExpiryBefore time.Time
+		PageSize           *int
+	}
+
 	// tableCRUD defines the API for interacting with the database tables
 	tableCRUD interface {
 		InsertIntoDomain(rows *DomainRow) (sql.Result, error)
@@ -679,7 +713,22 @@ type (
 		//   - OPTIONALLY specify one of following params
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(filter *VisibilityFilter) ([]VisibilityRow, error)
+		// SelectFromVisibilityByQuery returns one or more rows from visibility table that match the query
+		// Required filter params - {domainID, query, pageSize}
+		// Optional filter params - {maxStartTime, runID}
+		SelectFromVisibilityByQuery(filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows in visibility table that match the query
+		// Required filter params - {domainID, query}
+		CountFromVisibilityByQuery(filter *VisibilityQueryFilter) (int64, error)
 		DeleteFromVisibility(filter *VisibilityFilter) (sql.Result, error)
+
+		UpsertClusterMembership(row *ClusterMembershipRow) (sql.Result, error)
+		// SelectFromClusterMembership returns the rows of a role which heartbeated after the given time
+		// Required filter params - {role, lastHeartbeatAfter}
+		SelectFromClusterMembership(filter *ClusterMembershipFilter) ([]ClusterMembershipRow, error)
+		// DeleteFromClusterMembership deletes up to pageSize rows whose record expiry has passed
+		// Required filter params - {recordExpiryBefore, pageSize}
+		DeleteFromClusterMembership(filter *ClusterMembershipFilter) (sql.Result, error)
 	}
 
 	// Tx defines the API for a SQL transaction
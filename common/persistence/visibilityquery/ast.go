@@ -0,0 +1,123 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+type (
+	// Query is the parsed form of a visibility query string such as
+	// "WorkflowType = 'foo' and StartTime > '2019-01-01T00:00:00Z' order by StartTime desc"
+	Query struct {
+		// Filter is the parsed where clause, nil if the query has no filter
+		Filter Expression
+		// OrderBy is the requested ordering, empty means the default ordering of the store
+		OrderBy []OrderBy
+	}
+
+	// OrderBy is a single item of the order by clause
+	OrderBy struct {
+		Field string
+		Desc  bool
+	}
+
+	// Expression is a node of the filter tree
+	Expression interface {
+		expression()
+	}
+
+	// AndExpression matches if both sides match
+	AndExpression struct {
+		Left  Expression
+		Right Expression
+	}
+
+	// OrExpression matches if either side matches
+	OrExpression struct {
+		Left  Expression
+		Right Expression
+	}
+
+	// NotExpression negates the inner expression
+	NotExpression struct {
+		Expression Expression
+	}
+
+	// ComparisonExpression compares a field against a value
+	ComparisonExpression struct {
+		Field    string
+		Operator Operator
+		Value    interface{}
+	}
+
+	// RangeExpression matches if the field is within [From, To]
+	RangeExpression struct {
+		Field string
+		From  interface{}
+		To    interface{}
+		Not   bool
+	}
+
+	// InExpression matches if the field equals any of the values
+	InExpression struct {
+		Field  string
+		Values []interface{}
+		Not    bool
+	}
+
+	// MissingExpression matches if the field is not set, such as the CloseTime of an open workflow
+	MissingExpression struct {
+		Field string
+		Not   bool
+	}
+
+	// Operator is a comparison operator
+	Operator int
+)
+
+// Operators supported by ComparisonExpression
+const (
+	OperatorEqual Operator = iota
+	OperatorNotEqual
+	OperatorLessThan
+	OperatorLessThanOrEqual
+	OperatorGreaterThan
+	OperatorGreaterThanOrEqual
+)
+
+var operatorStrings = map[Operator]string{
+	OperatorEqual:              "=",
+	OperatorNotEqual:           "!=",
+	OperatorLessThan:           "<",
+	OperatorLessThanOrEqual:    "<=",
+	OperatorGreaterThan:        ">",
+	OperatorGreaterThanOrEqual: ">=",
+}
+
+// String returns the SQL representation of the operator
+func (o Operator) String() string {
+	return operatorStrings[o]
+}
+
+func (*AndExpression) expression()        {}
+func (*OrExpression) expression()         {}
+func (*NotExpression) expression()        {}
+func (*ComparisonExpression) expression() {}
+func (*RangeExpression) expression()      {}
+func (*InExpression) expression()         {}
+func (*MissingExpression) expression()    {}
@@ -0,0 +1,287 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/xwb1989/sqlparser"
+)
+
+type fieldType int
+
+const (
+	fieldTypeString fieldType = iota
+	fieldTypeInt
+	fieldTypeTime
+	fieldTypeCloseStatus
+)
+
+const (
+	// valueMissing is the keyword used to query for unset fields, e.g. "CloseTime = missing"
+	valueMissing = "missing"
+
+	timeFormat = time.RFC3339
+)
+
+var fieldTypes = map[string]fieldType{
+	es.WorkflowID:    fieldTypeString,
+	es.RunID:         fieldTypeString,
+	es.WorkflowType:  fieldTypeString,
+	es.StartTime:     fieldTypeTime,
+	es.ExecutionTime: fieldTypeTime,
+	es.CloseTime:     fieldTypeTime,
+	es.CloseStatus:   fieldTypeCloseStatus,
+	es.HistoryLength: fieldTypeInt,
+}
+
+var comparisonOperators = map[string]Operator{
+	sqlparser.EqualStr:        OperatorEqual,
+	sqlparser.NotEqualStr:     OperatorNotEqual,
+	sqlparser.LessThanStr:     OperatorLessThan,
+	sqlparser.LessEqualStr:    OperatorLessThanOrEqual,
+	sqlparser.GreaterThanStr:  OperatorGreaterThan,
+	sqlparser.GreaterEqualStr: OperatorGreaterThanOrEqual,
+}
+
+// Parse parses the where clause (with an optional order by) of a visibility query.
+// Values of time fields are converted to unix nanoseconds and close status names
+// are converted to their int value, so stores only deal with string and int64 values.
+func Parse(query string) (*Query, error) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return &Query{}, nil
+	}
+
+	stmt, err := sqlparser.Parse(fmt.Sprintf("select * from dummy where %s", query))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return nil, fmt.Errorf("invalid query: %v", query)
+	}
+
+	filter, err := convertExpr(sel.Where.Expr)
+	if err != nil {
+		return nil, err
+	}
+	result := &Query{Filter: filter}
+	for _, order := range sel.OrderBy {
+		field, err := convertField(order.Expr)
+		if err != nil {
+			return nil, err
+		}
+		result.OrderBy = append(result.OrderBy, OrderBy{
+			Field: field,
+			Desc:  order.Direction == sqlparser.DescScr,
+		})
+	}
+	return result, nil
+}
+
+func convertExpr(expr sqlparser.Expr) (Expression, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.ParenExpr:
+		return convertExpr(expr.Expr)
+	case *sqlparser.AndExpr:
+		left, right, err := convertBinary(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &AndExpression{Left: left, Right: right}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := convertBinary(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &OrExpression{Left: left, Right: right}, nil
+	case *sqlparser.NotExpr:
+		inner, err := convertExpr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpression{Expression: inner}, nil
+	case *sqlparser.ComparisonExpr:
+		return convertComparison(expr)
+	case *sqlparser.RangeCond:
+		return convertRange(expr)
+	case *sqlparser.IsExpr:
+		return convertIs(expr)
+	default:
+		return nil, fmt.Errorf("unsupported expression: %v", sqlparser.String(expr))
+	}
+}
+
+func convertBinary(left, right sqlparser.Expr) (Expression, Expression, error) {
+	l, err := convertExpr(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := convertExpr(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, r, nil
+}
+
+func convertComparison(expr *sqlparser.ComparisonExpr) (Expression, error) {
+	field, err := convertField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid values for %v: %v", field, sqlparser.String(expr.Right))
+		}
+		values := make([]interface{}, 0, len(tuple))
+		for _, v := range tuple {
+			value, err := convertValue(field, v)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return &InExpression{Field: field, Values: values, Not: expr.Operator == sqlparser.NotInStr}, nil
+	}
+
+	operator, ok := comparisonOperators[expr.Operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported operator: %v", expr.Operator)
+	}
+	if isMissing(expr.Right) {
+		switch operator {
+		case OperatorEqual:
+			return &MissingExpression{Field: field}, nil
+		case OperatorNotEqual:
+			return &MissingExpression{Field: field, Not: true}, nil
+		default:
+			return nil, fmt.Errorf("unsupported operator for %v: %v", valueMissing, expr.Operator)
+		}
+	}
+	value, err := convertValue(field, expr.Right)
+	if err != nil {
+		return nil, err
+	}
+	return &ComparisonExpression{Field: field, Operator: operator, Value: value}, nil
+}
+
+func convertRange(expr *sqlparser.RangeCond) (Expression, error) {
+	field, err := convertField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	from, err := convertValue(field, expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := convertValue(field, expr.To)
+	if err != nil {
+		return nil, err
+	}
+	return &RangeExpression{Field: field, From: from, To: to, Not: expr.Operator == sqlparser.NotBetweenStr}, nil
+}
+
+func convertIs(expr *sqlparser.IsExpr) (Expression, error) {
+	field, err := convertField(expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.IsNullStr:
+		return &MissingExpression{Field: field}, nil
+	case sqlparser.IsNotNullStr:
+		return &MissingExpression{Field: field, Not: true}, nil
+	default:
+		return nil, fmt.Errorf("unsupported operator: %v", expr.Operator)
+	}
+}
+
+func convertField(expr sqlparser.Expr) (string, error) {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", fmt.Errorf("invalid field: %v", sqlparser.String(expr))
+	}
+	field := col.Name.String()
+	if _, ok := fieldTypes[field]; !ok {
+		return "", fmt.Errorf("unsupported field: %v", field)
+	}
+	return field, nil
+}
+
+func isMissing(expr sqlparser.Expr) bool {
+	col, ok := expr.(*sqlparser.ColName)
+	return ok && col.Name.EqualString(valueMissing)
+}
+
+func convertValue(field string, expr sqlparser.Expr) (interface{}, error) {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok || (val.Type != sqlparser.StrVal && val.Type != sqlparser.IntVal) {
+		return nil, fmt.Errorf("invalid value for %v: %v", field, sqlparser.String(expr))
+	}
+	str := string(val.Val)
+
+	switch fieldTypes[field] {
+	case fieldTypeString:
+		return str, nil
+	case fieldTypeInt:
+		return parseInt(field, str)
+	case fieldTypeTime:
+		if val.Type == sqlparser.IntVal {
+			return parseInt(field, str)
+		}
+		if nanos, err := strconv.ParseInt(str, 10, 64); err == nil {
+			return nanos, nil
+		}
+		t, err := time.Parse(timeFormat, str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %v, expect unix nanoseconds or %v: %v", field, timeFormat, str)
+		}
+		return t.UnixNano(), nil
+	case fieldTypeCloseStatus:
+		if val.Type == sqlparser.IntVal {
+			return parseInt(field, str)
+		}
+		var status workflow.WorkflowExecutionCloseStatus
+		if err := status.UnmarshalText([]byte(strings.ToUpper(str))); err != nil {
+			return nil, fmt.Errorf("invalid value for %v: %v", field, str)
+		}
+		return int64(status), nil
+	default:
+		return nil, fmt.Errorf("unsupported field: %v", field)
+	}
+}
+
+func parseInt(field string, str string) (int64, error) {
+	value, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %v, expect integer: %v", field, str)
+	}
+	return value, nil
+}
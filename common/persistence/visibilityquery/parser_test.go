@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type parserSuite struct {
+	suite.Suite
+	// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+	// not merely log an error
+	*require.Assertions
+}
+
+func TestParserSuite(t *testing.T) {
+	suite.Run(t, new(parserSuite))
+}
+
+func (s *parserSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *parserSuite) TestParse_Empty() {
+	query, err := Parse("  ")
+	s.NoError(err)
+	s.Nil(query.Filter)
+	s.Empty(query.OrderBy)
+}
+
+func (s *parserSuite) TestParse_Comparison() {
+	query, err := Parse(`WorkflowID = 'wid' and HistoryLength >= 10`)
+	s.NoError(err)
+	s.Equal(&AndExpression{
+		Left:  &ComparisonExpression{Field: "WorkflowID", Operator: OperatorEqual, Value: "wid"},
+		Right: &ComparisonExpression{Field: "HistoryLength", Operator: OperatorGreaterThanOrEqual, Value: int64(10)},
+	}, query.Filter)
+}
+
+func (s *parserSuite) TestParse_OrNot() {
+	query, err := Parse(`not (WorkflowType = 'a' or WorkflowType != 'b')`)
+	s.NoError(err)
+	s.Equal(&NotExpression{
+		Expression: &OrExpression{
+			Left:  &ComparisonExpression{Field: "WorkflowType", Operator: OperatorEqual, Value: "a"},
+			Right: &ComparisonExpression{Field: "WorkflowType", Operator: OperatorNotEqual, Value: "b"},
+		},
+	}, query.Filter)
+}
+
+func (s *parserSuite) TestParse_Time() {
+	startTime := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	query, err := Parse(`StartTime between '2019-03-01T00:00:00Z' and 1551484800000000000`)
+	s.NoError(err)
+	s.Equal(&RangeExpression{Field: "StartTime", From: startTime.UnixNano(), To: int64(1551484800000000000)}, query.Filter)
+
+	_, err = Parse(`StartTime > 'yesterday'`)
+	s.Error(err)
+}
+
+func (s *parserSuite) TestParse_CloseStatus() {
+	query, err := Parse(`CloseStatus in ('completed', 2)`)
+	s.NoError(err)
+	s.Equal(&InExpression{Field: "CloseStatus", Values: []interface{}{int64(0), int64(2)}}, query.Filter)
+
+	_, err = Parse(`CloseStatus = 'unknown'`)
+	s.Error(err)
+}
+
+func (s *parserSuite) TestParse_Missing() {
+	query, err := Parse(`CloseTime = missing`)
+	s.NoError(err)
+	s.Equal(&MissingExpression{Field: "CloseTime"}, query.Filter)
+
+	query, err = Parse(`CloseTime is not null`)
+	s.NoError(err)
+	s.Equal(&MissingExpression{Field: "CloseTime", Not: true}, query.Filter)
+
+	_, err = Parse(`CloseTime > missing`)
+	s.Error(err)
+}
+
+func (s *parserSuite) TestParse_OrderBy() {
+	query, err := Parse(`RunID not in ('a', 'b') order by StartTime desc, WorkflowID`)
+	s.NoError(err)
+	s.Equal(&InExpression{Field: "RunID", Values: []interface{}{"a", "b"}, Not: true}, query.Filter)
+	s.Equal([]OrderBy{{Field: "StartTime", Desc: true}, {Field: "WorkflowID"}}, query.OrderBy)
+}
+
+func (s *parserSuite) TestParse_Invalid() {
+	invalidQueries := []string{
+		`WorkflowID like 'w%'`,
+		`CustomKeywordField = 'a'`,
+		`WorkflowID = RunID`,
+		`HistoryLength = 'ten'`,
+		`WorkflowID = 'a' limit 10`,
+		`WorkflowID = `,
+	}
+	for _, query := range invalidQueries {
+		_, err := Parse(query)
+		s.Error(err, query)
+	}
+}
@@ -0,0 +1,813 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+)
+
+// FromWorkflowIdReusePolicy converts thrift WorkflowIdReusePolicy type to proto
+func FromWorkflowIdReusePolicy(t *shared.WorkflowIdReusePolicy) apiv1.WorkflowIdReusePolicy {
+	if t == nil {
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
+	}
+	switch *t {
+	case shared.WorkflowIdReusePolicyAllowDuplicateFailedOnly:
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY
+	case shared.WorkflowIdReusePolicyAllowDuplicate:
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	case shared.WorkflowIdReusePolicyRejectDuplicate:
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE
+	case shared.WorkflowIdReusePolicyTerminateIfRunning:
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING
+	}
+	return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
+}
+
+// ToWorkflowIdReusePolicy converts proto WorkflowIdReusePolicy type to thrift
+func ToWorkflowIdReusePolicy(p apiv1.WorkflowIdReusePolicy) *shared.WorkflowIdReusePolicy {
+	switch p {
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY:
+		return shared.WorkflowIdReusePolicyAllowDuplicateFailedOnly.Ptr()
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE:
+		return shared.WorkflowIdReusePolicyAllowDuplicate.Ptr()
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE:
+		return shared.WorkflowIdReusePolicyRejectDuplicate.Ptr()
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING:
+		return shared.WorkflowIdReusePolicyTerminateIfRunning.Ptr()
+	}
+	return nil
+}
+
+// FromDomainStatus converts thrift DomainStatus type to proto
+func FromDomainStatus(t *shared.DomainStatus) apiv1.DomainStatus {
+	if t == nil {
+		return apiv1.DomainStatus_DOMAIN_STATUS_INVALID
+	}
+	switch *t {
+	case shared.DomainStatusRegistered:
+		return apiv1.DomainStatus_DOMAIN_STATUS_REGISTERED
+	case shared.DomainStatusDeprecated:
+		return apiv1.DomainStatus_DOMAIN_STATUS_DEPRECATED
+	case shared.DomainStatusDeleted:
+		return apiv1.DomainStatus_DOMAIN_STATUS_DELETED
+	}
+	return apiv1.DomainStatus_DOMAIN_STATUS_INVALID
+}
+
+// ToDomainStatus converts proto DomainStatus type to thrift
+func ToDomainStatus(p apiv1.DomainStatus) *shared.DomainStatus {
+	switch p {
+	case apiv1.DomainStatus_DOMAIN_STATUS_REGISTERED:
+		return shared.DomainStatusRegistered.Ptr()
+	case apiv1.DomainStatus_DOMAIN_STATUS_DEPRECATED:
+		return shared.DomainStatusDeprecated.Ptr()
+	case apiv1.DomainStatus_DOMAIN_STATUS_DELETED:
+		return shared.DomainStatusDeleted.Ptr()
+	}
+	return nil
+}
+
+// FromTimeoutType converts thrift TimeoutType type to proto
+func FromTimeoutType(t *shared.TimeoutType) apiv1.TimeoutType {
+	if t == nil {
+		return apiv1.TimeoutType_TIMEOUT_TYPE_INVALID
+	}
+	switch *t {
+	case shared.TimeoutTypeStartToClose:
+		return apiv1.TimeoutType_TIMEOUT_TYPE_START_TO_CLOSE
+	case shared.TimeoutTypeScheduleToStart:
+		return apiv1.TimeoutType_TIMEOUT_TYPE_SCHEDULE_TO_START
+	case shared.TimeoutTypeScheduleToClose:
+		return apiv1.TimeoutType_TIMEOUT_TYPE_SCHEDULE_TO_CLOSE
+	case shared.TimeoutTypeHeartbeat:
+		return apiv1.TimeoutType_TIMEOUT_TYPE_HEARTBEAT
+	}
+	return apiv1.TimeoutType_TIMEOUT_TYPE_INVALID
+}
+
+// ToTimeoutType converts proto TimeoutType type to thrift
+func ToTimeoutType(p apiv1.TimeoutType) *shared.TimeoutType {
+	switch p {
+	case apiv1.TimeoutType_TIMEOUT_TYPE_START_TO_CLOSE:
+		return shared.TimeoutTypeStartToClose.Ptr()
+	case apiv1.TimeoutType_TIMEOUT_TYPE_SCHEDULE_TO_START:
+		return shared.TimeoutTypeScheduleToStart.Ptr()
+	case apiv1.TimeoutType_TIMEOUT_TYPE_SCHEDULE_TO_CLOSE:
+		return shared.TimeoutTypeScheduleToClose.Ptr()
+	case apiv1.TimeoutType_TIMEOUT_TYPE_HEARTBEAT:
+		return shared.TimeoutTypeHeartbeat.Ptr()
+	}
+	return nil
+}
+
+// FromDecisionType converts thrift DecisionType type to proto
+func FromDecisionType(t *shared.DecisionType) apiv1.DecisionType {
+	if t == nil {
+		return apiv1.DecisionType_DECISION_TYPE_INVALID
+	}
+	switch *t {
+	case shared.DecisionTypeScheduleActivityTask:
+		return apiv1.DecisionType_DECISION_TYPE_SCHEDULE_ACTIVITY_TASK
+	case shared.DecisionTypeRequestCancelActivityTask:
+		return apiv1.DecisionType_DECISION_TYPE_REQUEST_CANCEL_ACTIVITY_TASK
+	case shared.DecisionTypeStartTimer:
+		return apiv1.DecisionType_DECISION_TYPE_START_TIMER
+	case shared.DecisionTypeCompleteWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_COMPLETE_WORKFLOW_EXECUTION
+	case shared.DecisionTypeFailWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_FAIL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeCancelTimer:
+		return apiv1.DecisionType_DECISION_TYPE_CANCEL_TIMER
+	case shared.DecisionTypeCancelWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_CANCEL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeRequestCancelExternalWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeRecordMarker:
+		return apiv1.DecisionType_DECISION_TYPE_RECORD_MARKER
+	case shared.DecisionTypeContinueAsNewWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION
+	case shared.DecisionTypeStartChildWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_START_CHILD_WORKFLOW_EXECUTION
+	case shared.DecisionTypeSignalExternalWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION
+	}
+	return apiv1.DecisionType_DECISION_TYPE_INVALID
+}
+
+// ToDecisionType converts proto DecisionType type to thrift
+func ToDecisionType(p apiv1.DecisionType) *shared.DecisionType {
+	switch p {
+	case apiv1.DecisionType_DECISION_TYPE_SCHEDULE_ACTIVITY_TASK:
+		return shared.DecisionTypeScheduleActivityTask.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_REQUEST_CANCEL_ACTIVITY_TASK:
+		return shared.DecisionTypeRequestCancelActivityTask.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_START_TIMER:
+		return shared.DecisionTypeStartTimer.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_COMPLETE_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeCompleteWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_FAIL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeFailWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_CANCEL_TIMER:
+		return shared.DecisionTypeCancelTimer.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_CANCEL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeCancelWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeRequestCancelExternalWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_RECORD_MARKER:
+		return shared.DecisionTypeRecordMarker.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeContinueAsNewWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_START_CHILD_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeStartChildWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeSignalExternalWorkflowExecution.Ptr()
+	}
+	return nil
+}
+
+// FromEventType converts thrift EventType type to proto
+func FromEventType(t *shared.EventType) apiv1.EventType {
+	if t == nil {
+		return apiv1.EventType_EVENT_TYPE_INVALID
+	}
+	switch *t {
+	case shared.EventTypeWorkflowExecutionStarted:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_STARTED
+	case shared.EventTypeWorkflowExecutionCompleted:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED
+	case shared.EventTypeWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeWorkflowExecutionTimedOut:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT
+	case shared.EventTypeDecisionTaskScheduled:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_SCHEDULED
+	case shared.EventTypeDecisionTaskStarted:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_STARTED
+	case shared.EventTypeDecisionTaskCompleted:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_COMPLETED
+	case shared.EventTypeDecisionTaskTimedOut:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_TIMED_OUT
+	case shared.EventTypeDecisionTaskFailed:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_FAILED
+	case shared.EventTypeActivityTaskScheduled:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_SCHEDULED
+	case shared.EventTypeActivityTaskStarted:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_STARTED
+	case shared.EventTypeActivityTaskCompleted:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_COMPLETED
+	case shared.EventTypeActivityTaskFailed:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_FAILED
+	case shared.EventTypeActivityTaskTimedOut:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT
+	case shared.EventTypeActivityTaskCancelRequested:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED
+	case shared.EventTypeRequestCancelActivityTaskFailed:
+		return apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED
+	case shared.EventTypeActivityTaskCanceled:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCELED
+	case shared.EventTypeTimerStarted:
+		return apiv1.EventType_EVENT_TYPE_TIMER_STARTED
+	case shared.EventTypeTimerFired:
+		return apiv1.EventType_EVENT_TYPE_TIMER_FIRED
+	case shared.EventTypeCancelTimerFailed:
+		return apiv1.EventType_EVENT_TYPE_CANCEL_TIMER_FAILED
+	case shared.EventTypeTimerCanceled:
+		return apiv1.EventType_EVENT_TYPE_TIMER_CANCELED
+	case shared.EventTypeWorkflowExecutionCancelRequested:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED
+	case shared.EventTypeWorkflowExecutionCanceled:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED
+	case shared.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
+		return apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED
+	case shared.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeExternalWorkflowExecutionCancelRequested:
+		return apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED
+	case shared.EventTypeMarkerRecorded:
+		return apiv1.EventType_EVENT_TYPE_MARKER_RECORDED
+	case shared.EventTypeWorkflowExecutionSignaled:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED
+	case shared.EventTypeWorkflowExecutionTerminated:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED
+	case shared.EventTypeWorkflowExecutionContinuedAsNew:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW
+	case shared.EventTypeStartChildWorkflowExecutionInitiated:
+		return apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED
+	case shared.EventTypeStartChildWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeChildWorkflowExecutionStarted:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED
+	case shared.EventTypeChildWorkflowExecutionCompleted:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED
+	case shared.EventTypeChildWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeChildWorkflowExecutionCanceled:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED
+	case shared.EventTypeChildWorkflowExecutionTimedOut:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT
+	case shared.EventTypeChildWorkflowExecutionTerminated:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED
+	case shared.EventTypeSignalExternalWorkflowExecutionInitiated:
+		return apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED
+	case shared.EventTypeSignalExternalWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeExternalWorkflowExecutionSignaled:
+		return apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED
+	case shared.EventTypeWorkflowExecutionUpdateAccepted:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED
+	}
+	return apiv1.EventType_EVENT_TYPE_INVALID
+}
+
+// ToEventType converts proto EventType type to thrift
+func ToEventType(p apiv1.EventType) *shared.EventType {
+	switch p {
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+		return shared.EventTypeWorkflowExecutionStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return shared.EventTypeWorkflowExecutionCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return shared.EventTypeWorkflowExecutionTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_SCHEDULED:
+		return shared.EventTypeDecisionTaskScheduled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_STARTED:
+		return shared.EventTypeDecisionTaskStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_COMPLETED:
+		return shared.EventTypeDecisionTaskCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_TIMED_OUT:
+		return shared.EventTypeDecisionTaskTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_FAILED:
+		return shared.EventTypeDecisionTaskFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+		return shared.EventTypeActivityTaskScheduled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_STARTED:
+		return shared.EventTypeActivityTaskStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+		return shared.EventTypeActivityTaskCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_FAILED:
+		return shared.EventTypeActivityTaskFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+		return shared.EventTypeActivityTaskTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED:
+		return shared.EventTypeActivityTaskCancelRequested.Ptr()
+	case apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED:
+		return shared.EventTypeRequestCancelActivityTaskFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+		return shared.EventTypeActivityTaskCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_TIMER_STARTED:
+		return shared.EventTypeTimerStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_TIMER_FIRED:
+		return shared.EventTypeTimerFired.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CANCEL_TIMER_FAILED:
+		return shared.EventTypeCancelTimerFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_TIMER_CANCELED:
+		return shared.EventTypeTimerCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
+		return shared.EventTypeWorkflowExecutionCancelRequested.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return shared.EventTypeWorkflowExecutionCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
+		return shared.EventTypeRequestCancelExternalWorkflowExecutionInitiated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeRequestCancelExternalWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
+		return shared.EventTypeExternalWorkflowExecutionCancelRequested.Ptr()
+	case apiv1.EventType_EVENT_TYPE_MARKER_RECORDED:
+		return shared.EventTypeMarkerRecorded.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		return shared.EventTypeWorkflowExecutionSignaled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		return shared.EventTypeWorkflowExecutionTerminated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return shared.EventTypeWorkflowExecutionContinuedAsNew.Ptr()
+	case apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+		return shared.EventTypeStartChildWorkflowExecutionInitiated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeStartChildWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+		return shared.EventTypeChildWorkflowExecutionStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+		return shared.EventTypeChildWorkflowExecutionCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeChildWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+		return shared.EventTypeChildWorkflowExecutionCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+		return shared.EventTypeChildWorkflowExecutionTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+		return shared.EventTypeChildWorkflowExecutionTerminated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
+		return shared.EventTypeSignalExternalWorkflowExecutionInitiated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeSignalExternalWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED:
+		return shared.EventTypeExternalWorkflowExecutionSignaled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+		return shared.EventTypeWorkflowExecutionUpdateAccepted.Ptr()
+	}
+	return nil
+}
+
+// FromDecisionTaskFailedCause converts thrift DecisionTaskFailedCause type to proto
+func FromDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) apiv1.DecisionTaskFailedCause {
+	if t == nil {
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.DecisionTaskFailedCauseUnhandledDecision:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_UNHANDLED_DECISION
+	case shared.DecisionTaskFailedCauseBadScheduleActivityAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadRequestCancelActivityAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadStartTimerAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_START_TIMER_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadCancelTimerAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_TIMER_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadRecordMarkerAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadContinueAsNewAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CONTINUE_AS_NEW_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseStartTimerDuplicateID:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_START_TIMER_DUPLICATE_ID
+	case shared.DecisionTaskFailedCauseResetStickyTasklist:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_RESET_STICKY_TASKLIST
+	case shared.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE
+	case shared.DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadStartChildExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseForceCloseDecision:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_FORCE_CLOSE_DECISION
+	case shared.DecisionTaskFailedCauseFailoverCloseDecision:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_FAILOVER_CLOSE_DECISION
+	case shared.DecisionTaskFailedCauseBadSignalInputSize:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_INPUT_SIZE
+	case shared.DecisionTaskFailedCauseResetWorkflow:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_RESET_WORKFLOW
+	case shared.DecisionTaskFailedCauseBadBinary:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_BINARY
+	}
+	return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID
+}
+
+// ToDecisionTaskFailedCause converts proto DecisionTaskFailedCause type to thrift
+func ToDecisionTaskFailedCause(p apiv1.DecisionTaskFailedCause) *shared.DecisionTaskFailedCause {
+	switch p {
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_UNHANDLED_DECISION:
+		return shared.DecisionTaskFailedCauseUnhandledDecision.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadScheduleActivityAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadRequestCancelActivityAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_START_TIMER_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadStartTimerAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_TIMER_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadCancelTimerAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadRecordMarkerAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CONTINUE_AS_NEW_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadContinueAsNewAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_START_TIMER_DUPLICATE_ID:
+		return shared.DecisionTaskFailedCauseStartTimerDuplicateID.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_RESET_STICKY_TASKLIST:
+		return shared.DecisionTaskFailedCauseResetStickyTasklist.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE:
+		return shared.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadStartChildExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_FORCE_CLOSE_DECISION:
+		return shared.DecisionTaskFailedCauseForceCloseDecision.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_FAILOVER_CLOSE_DECISION:
+		return shared.DecisionTaskFailedCauseFailoverCloseDecision.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_INPUT_SIZE:
+		return shared.DecisionTaskFailedCauseBadSignalInputSize.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_RESET_WORKFLOW:
+		return shared.DecisionTaskFailedCauseResetWorkflow.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_BINARY:
+		return shared.DecisionTaskFailedCauseBadBinary.Ptr()
+	}
+	return nil
+}
+
+// FromCancelExternalWorkflowExecutionFailedCause converts thrift CancelExternalWorkflowExecutionFailedCause type to proto
+func FromCancelExternalWorkflowExecutionFailedCause(t *shared.CancelExternalWorkflowExecutionFailedCause) apiv1.CancelExternalWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.CancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution:
+		return apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION
+	}
+	return apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+// ToCancelExternalWorkflowExecutionFailedCause converts proto CancelExternalWorkflowExecutionFailedCause type to thrift
+func ToCancelExternalWorkflowExecutionFailedCause(p apiv1.CancelExternalWorkflowExecutionFailedCause) *shared.CancelExternalWorkflowExecutionFailedCause {
+	switch p {
+	case apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.CancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution.Ptr()
+	}
+	return nil
+}
+
+// FromSignalExternalWorkflowExecutionFailedCause converts thrift SignalExternalWorkflowExecutionFailedCause type to proto
+func FromSignalExternalWorkflowExecutionFailedCause(t *shared.SignalExternalWorkflowExecutionFailedCause) apiv1.SignalExternalWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution:
+		return apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION
+	}
+	return apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+// ToSignalExternalWorkflowExecutionFailedCause converts proto SignalExternalWorkflowExecutionFailedCause type to thrift
+func ToSignalExternalWorkflowExecutionFailedCause(p apiv1.SignalExternalWorkflowExecutionFailedCause) *shared.SignalExternalWorkflowExecutionFailedCause {
+	switch p {
+	case apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution.Ptr()
+	}
+	return nil
+}
+
+// FromChildWorkflowExecutionFailedCause converts thrift ChildWorkflowExecutionFailedCause type to proto
+func FromChildWorkflowExecutionFailedCause(t *shared.ChildWorkflowExecutionFailedCause) apiv1.ChildWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning:
+		return apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_RUNNING
+	}
+	return apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+// ToChildWorkflowExecutionFailedCause converts proto ChildWorkflowExecutionFailedCause type to thrift
+func ToChildWorkflowExecutionFailedCause(p apiv1.ChildWorkflowExecutionFailedCause) *shared.ChildWorkflowExecutionFailedCause {
+	switch p {
+	case apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_RUNNING:
+		return shared.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning.Ptr()
+	}
+	return nil
+}
+
+// FromWorkflowExecutionCloseStatus converts thrift WorkflowExecutionCloseStatus type to proto
+func FromWorkflowExecutionCloseStatus(t *shared.WorkflowExecutionCloseStatus) apiv1.WorkflowExecutionCloseStatus {
+	if t == nil {
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+	}
+	switch *t {
+	case shared.WorkflowExecutionCloseStatusCompleted:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_COMPLETED
+	case shared.WorkflowExecutionCloseStatusFailed:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_FAILED
+	case shared.WorkflowExecutionCloseStatusCanceled:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_CANCELED
+	case shared.WorkflowExecutionCloseStatusTerminated:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_TERMINATED
+	case shared.WorkflowExecutionCloseStatusContinuedAsNew:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_CONTINUED_AS_NEW
+	case shared.WorkflowExecutionCloseStatusTimedOut:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_TIMED_OUT
+	}
+	return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+}
+
+// ToWorkflowExecutionCloseStatus converts proto WorkflowExecutionCloseStatus type to thrift
+func ToWorkflowExecutionCloseStatus(p apiv1.WorkflowExecutionCloseStatus) *shared.WorkflowExecutionCloseStatus {
+	switch p {
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_COMPLETED:
+		return shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_FAILED:
+		return shared.WorkflowExecutionCloseStatusFailed.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_CANCELED:
+		return shared.WorkflowExecutionCloseStatusCanceled.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_TERMINATED:
+		return shared.WorkflowExecutionCloseStatusTerminated.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_CONTINUED_AS_NEW:
+		return shared.WorkflowExecutionCloseStatusContinuedAsNew.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_TIMED_OUT:
+		return shared.WorkflowExecutionCloseStatusTimedOut.Ptr()
+	}
+	return nil
+}
+
+// FromChildPolicy converts thrift ChildPolicy type to proto
+func FromChildPolicy(t *shared.ChildPolicy) apiv1.ChildPolicy {
+	if t == nil {
+		return apiv1.ChildPolicy_CHILD_POLICY_INVALID
+	}
+	switch *t {
+	case shared.ChildPolicyTerminate:
+		return apiv1.ChildPolicy_CHILD_POLICY_TERMINATE
+	case shared.ChildPolicyRequestCancel:
+		return apiv1.ChildPolicy_CHILD_POLICY_REQUEST_CANCEL
+	case shared.ChildPolicyAbandon:
+		return apiv1.ChildPolicy_CHILD_POLICY_ABANDON
+	}
+	return apiv1.ChildPolicy_CHILD_POLICY_INVALID
+}
+
+// ToChildPolicy converts proto ChildPolicy type to thrift
+func ToChildPolicy(p apiv1.ChildPolicy) *shared.ChildPolicy {
+	switch p {
+	case apiv1.ChildPolicy_CHILD_POLICY_TERMINATE:
+		return shared.ChildPolicyTerminate.Ptr()
+	case apiv1.ChildPolicy_CHILD_POLICY_REQUEST_CANCEL:
+		return shared.ChildPolicyRequestCancel.Ptr()
+	case apiv1.ChildPolicy_CHILD_POLICY_ABANDON:
+		return shared.ChildPolicyAbandon.Ptr()
+	}
+	return nil
+}
+
+// FromQueryTaskCompletedType converts thrift QueryTaskCompletedType type to proto
+func FromQueryTaskCompletedType(t *shared.QueryTaskCompletedType) apiv1.QueryTaskCompletedType {
+	if t == nil {
+		return apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_INVALID
+	}
+	switch *t {
+	case shared.QueryTaskCompletedTypeCompleted:
+		return apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_COMPLETED
+	case shared.QueryTaskCompletedTypeFailed:
+		return apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_FAILED
+	}
+	return apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_INVALID
+}
+
+// ToQueryTaskCompletedType converts proto QueryTaskCompletedType type to thrift
+func ToQueryTaskCompletedType(p apiv1.QueryTaskCompletedType) *shared.QueryTaskCompletedType {
+	switch p {
+	case apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_COMPLETED:
+		return shared.QueryTaskCompletedTypeCompleted.Ptr()
+	case apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_FAILED:
+		return shared.QueryTaskCompletedTypeFailed.Ptr()
+	}
+	return nil
+}
+
+// FromWorkflowUpdateResultType converts thrift WorkflowUpdateResultType type to proto
+func FromWorkflowUpdateResultType(t *shared.WorkflowUpdateResultType) apiv1.WorkflowUpdateResultType {
+	if t == nil {
+		return apiv1.WorkflowUpdateResultType_WORKFLOW_UPDATE_RESULT_TYPE_INVALID
+	}
+	switch *t {
+	case shared.WorkflowUpdateResultTypeAccepted:
+		return apiv1.WorkflowUpdateResultType_WORKFLOW_UPDATE_RESULT_TYPE_ACCEPTED
+	case shared.WorkflowUpdateResultTypeRejected:
+		return apiv1.WorkflowUpdateResultType_WORKFLOW_UPDATE_RESULT_TYPE_REJECTED
+	}
+	return apiv1.WorkflowUpdateResultType_WORKFLOW_UPDATE_RESULT_TYPE_INVALID
+}
+
+// ToWorkflowUpdateResultType converts proto WorkflowUpdateResultType type to thrift
+func ToWorkflowUpdateResultType(p apiv1.WorkflowUpdateResultType) *shared.WorkflowUpdateResultType {
+	switch p {
+	case apiv1.WorkflowUpdateResultType_WORKFLOW_UPDATE_RESULT_TYPE_ACCEPTED:
+		return shared.WorkflowUpdateResultTypeAccepted.Ptr()
+	case apiv1.WorkflowUpdateResultType_WORKFLOW_UPDATE_RESULT_TYPE_REJECTED:
+		return shared.WorkflowUpdateResultTypeRejected.Ptr()
+	}
+	return nil
+}
+
+// FromPendingActivityState converts thrift PendingActivityState type to proto
+func FromPendingActivityState(t *shared.PendingActivityState) apiv1.PendingActivityState {
+	if t == nil {
+		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_INVALID
+	}
+	switch *t {
+	case shared.PendingActivityStateScheduled:
+		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_SCHEDULED
+	case shared.PendingActivityStateStarted:
+		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_STARTED
+	case shared.PendingActivityStateCancelRequested:
+		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_CANCEL_REQUESTED
+	}
+	return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_INVALID
+}
+
+// ToPendingActivityState converts proto PendingActivityState type to thrift
+func ToPendingActivityState(p apiv1.PendingActivityState) *shared.PendingActivityState {
+	switch p {
+	case apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_SCHEDULED:
+		return shared.PendingActivityStateScheduled.Ptr()
+	case apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_STARTED:
+		return shared.PendingActivityStateStarted.Ptr()
+	case apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_CANCEL_REQUESTED:
+		return shared.PendingActivityStateCancelRequested.Ptr()
+	}
+	return nil
+}
+
+// FromHistoryEventFilterType converts thrift HistoryEventFilterType type to proto
+func FromHistoryEventFilterType(t *shared.HistoryEventFilterType) apiv1.HistoryEventFilterType {
+	if t == nil {
+		return apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_INVALID
+	}
+	switch *t {
+	case shared.HistoryEventFilterTypeAllEvent:
+		return apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_ALL_EVENT
+	case shared.HistoryEventFilterTypeCloseEvent:
+		return apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT
+	}
+	return apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_INVALID
+}
+
+// ToHistoryEventFilterType converts proto HistoryEventFilterType type to thrift
+func ToHistoryEventFilterType(p apiv1.HistoryEventFilterType) *shared.HistoryEventFilterType {
+	switch p {
+	case apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_ALL_EVENT:
+		return shared.HistoryEventFilterTypeAllEvent.Ptr()
+	case apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT:
+		return shared.HistoryEventFilterTypeCloseEvent.Ptr()
+	}
+	return nil
+}
+
+// FromTaskListKind converts thrift TaskListKind type to proto
+func FromTaskListKind(t *shared.TaskListKind) apiv1.TaskListKind {
+	if t == nil {
+		return apiv1.TaskListKind_TASK_LIST_KIND_INVALID
+	}
+	switch *t {
+	case shared.TaskListKindNormal:
+		return apiv1.TaskListKind_TASK_LIST_KIND_NORMAL
+	case shared.TaskListKindSticky:
+		return apiv1.TaskListKind_TASK_LIST_KIND_STICKY
+	}
+	return apiv1.TaskListKind_TASK_LIST_KIND_INVALID
+}
+
+// ToTaskListKind converts proto TaskListKind type to thrift
+func ToTaskListKind(p apiv1.TaskListKind) *shared.TaskListKind {
+	switch p {
+	case apiv1.TaskListKind_TASK_LIST_KIND_NORMAL:
+		return shared.TaskListKindNormal.Ptr()
+	case apiv1.TaskListKind_TASK_LIST_KIND_STICKY:
+		return shared.TaskListKindSticky.Ptr()
+	}
+	return nil
+}
+
+// FromArchivalStatus converts thrift ArchivalStatus type to proto
+func FromArchivalStatus(t *shared.ArchivalStatus) apiv1.ArchivalStatus {
+	if t == nil {
+		return apiv1.ArchivalStatus_ARCHIVAL_STATUS_INVALID
+	}
+	switch *t {
+	case shared.ArchivalStatusDisabled:
+		return apiv1.ArchivalStatus_ARCHIVAL_STATUS_DISABLED
+	case shared.ArchivalStatusEnabled:
+		return apiv1.ArchivalStatus_ARCHIVAL_STATUS_ENABLED
+	}
+	return apiv1.ArchivalStatus_ARCHIVAL_STATUS_INVALID
+}
+
+// ToArchivalStatus converts proto ArchivalStatus type to thrift
+func ToArchivalStatus(p apiv1.ArchivalStatus) *shared.ArchivalStatus {
+	switch p {
+	case apiv1.ArchivalStatus_ARCHIVAL_STATUS_DISABLED:
+		return shared.ArchivalStatusDisabled.Ptr()
+	case apiv1.ArchivalStatus_ARCHIVAL_STATUS_ENABLED:
+		return shared.ArchivalStatusEnabled.Ptr()
+	}
+	return nil
+}
+
+// FromContinueAsNewInitiator converts thrift ContinueAsNewInitiator type to proto
+func FromContinueAsNewInitiator(t *shared.ContinueAsNewInitiator) apiv1.ContinueAsNewInitiator {
+	if t == nil {
+		return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID
+	}
+	switch *t {
+	case shared.ContinueAsNewInitiatorDecider:
+		return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_DECIDER
+	case shared.ContinueAsNewInitiatorRetryPolicy:
+		return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_RETRY_POLICY
+	case shared.ContinueAsNewInitiatorCronSchedule:
+		return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_CRON_SCHEDULE
+	}
+	return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID
+}
+
+// ToContinueAsNewInitiator converts proto ContinueAsNewInitiator type to thrift
+func ToContinueAsNewInitiator(p apiv1.ContinueAsNewInitiator) *shared.ContinueAsNewInitiator {
+	switch p {
+	case apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_DECIDER:
+		return shared.ContinueAsNewInitiatorDecider.Ptr()
+	case apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_RETRY_POLICY:
+		return shared.ContinueAsNewInitiatorRetryPolicy.Ptr()
+	case apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_CRON_SCHEDULE:
+		return shared.ContinueAsNewInitiatorCronSchedule.Ptr()
+	}
+	return nil
+}
+
+// FromTaskListType converts thrift TaskListType type to proto
+func FromTaskListType(t *shared.TaskListType) apiv1.TaskListType {
+	if t == nil {
+		return apiv1.TaskListType_TASK_LIST_TYPE_INVALID
+	}
+	switch *t {
+	case shared.TaskListTypeDecision:
+		return apiv1.TaskListType_TASK_LIST_TYPE_DECISION
+	case shared.TaskListTypeActivity:
+		return apiv1.TaskListType_TASK_LIST_TYPE_ACTIVITY
+	}
+	return apiv1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+// ToTaskListType converts proto TaskListType type to thrift
+func ToTaskListType(p apiv1.TaskListType) *shared.TaskListType {
+	switch p {
+	case apiv1.TaskListType_TASK_LIST_TYPE_DECISION:
+		return shared.TaskListTypeDecision.Ptr()
+	case apiv1.TaskListType_TASK_LIST_TYPE_ACTIVITY:
+		return shared.TaskListTypeActivity.Ptr()
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/gogo/status"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc/yarpcerrors"
+	"google.golang.org/grpc/codes"
+)
+
+// names attached to the yarpc status so that the thrift error type survives
+// the round trip over the gRPC transport
+const (
+	errNameBadRequest                      = "bad-request"
+	errNameInternalService                 = "internal-service"
+	errNameDomainAlreadyExists             = "domain-already-exists"
+	errNameWorkflowExecutionAlreadyStarted = "workflow-execution-already-started"
+	errNameEntityNotExists                 = "entity-not-exists"
+	errNameServiceBusy                     = "service-busy"
+	errNameCancellationAlreadyRequested    = "cancellation-already-requested"
+	errNameQueryFailed                     = "query-failed"
+	errNameDomainNotActive                 = "domain-not-active"
+	errNameLimitExceeded                   = "limit-exceeded"
+	errNameAccessDenied                    = "access-denied"
+	errNameClientVersionNotSupported       = "client-version-not-supported"
+)
+
+// FromError converts a thrift error returned by the frontend into a yarpc status
+// error which can be sent over the gRPC transport. The errors which carry more than
+// a message are converted into a gRPC status with the proto error as its details.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch e := err.(type) {
+	case *shared.BadRequestError:
+		return newStatus(yarpcerrors.CodeInvalidArgument, errNameBadRequest, e.Message)
+	case *shared.InternalServiceError:
+		return newStatus(yarpcerrors.CodeInternal, errNameInternalService, e.Message)
+	case *shared.DomainAlreadyExistsError:
+		return newStatus(yarpcerrors.CodeAlreadyExists, errNameDomainAlreadyExists, e.Message)
+	case *shared.WorkflowExecutionAlreadyStartedError:
+		return newStatusWithDetails(yarpcerrors.CodeAlreadyExists, errNameWorkflowExecutionAlreadyStarted, e.GetMessage(),
+			&apiv1.WorkflowExecutionAlreadyStartedError{
+				StartRequestId: e.GetStartRequestId(),
+				RunId:          e.GetRunId(),
+			})
+	case *shared.EntityNotExistsError:
+		return newStatus(yarpcerrors.CodeNotFound, errNameEntityNotExists, e.Message)
+	case *shared.ServiceBusyError:
+		return newStatus(yarpcerrors.CodeResourceExhausted, errNameServiceBusy, e.Message)
+	case *shared.CancellationAlreadyRequestedError:
+		return newStatus(yarpcerrors.CodeAlreadyExists, errNameCancellationAlreadyRequested, e.Message)
+	case *shared.QueryFailedError:
+		return newStatus(yarpcerrors.CodeFailedPrecondition, errNameQueryFailed, e.Message)
+	case *shared.DomainNotActiveError:
+		return newStatusWithDetails(yarpcerrors.CodeFailedPrecondition, errNameDomainNotActive, e.Message,
+			&apiv1.DomainNotActiveError{
+				Domain:         e.DomainName,
+				CurrentCluster: e.CurrentCluster,
+				ActiveCluster:  e.ActiveCluster,
+			})
+	case *shared.LimitExceededError:
+		return newStatus(yarpcerrors.CodeResourceExhausted, errNameLimitExceeded, e.Message)
+	case *shared.AccessDeniedError:
+		return newStatus(yarpcerrors.CodePermissionDenied, errNameAccessDenied, e.Message)
+	case *shared.ClientVersionNotSupportedError:
+		return newStatusWithDetails(yarpcerrors.CodeFailedPrecondition, errNameClientVersionNotSupported, e.Error(),
+			&apiv1.ClientVersionNotSupportedError{
+				FeatureVersion:    e.FeatureVersion,
+				ClientImpl:        e.ClientImpl,
+				SupportedVersions: e.SupportedVersions,
+			})
+	}
+	return err
+}
+
+// ToError converts a yarpc or gRPC status error received over the gRPC transport
+// back into the thrift error returned by the frontend
+func ToError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if grpcStatus, ok := status.FromError(err); ok {
+		return fromStatusDetails(err, grpcStatus)
+	}
+	if !yarpcerrors.IsStatus(err) {
+		return err
+	}
+
+	yarpcStatus := yarpcerrors.FromError(err)
+	message := yarpcStatus.Message()
+	switch yarpcStatus.Name() {
+	case errNameBadRequest:
+		return &shared.BadRequestError{Message: message}
+	case errNameInternalService:
+		return &shared.InternalServiceError{Message: message}
+	case errNameDomainAlreadyExists:
+		return &shared.DomainAlreadyExistsError{Message: message}
+	case errNameWorkflowExecutionAlreadyStarted:
+		return &shared.WorkflowExecutionAlreadyStartedError{Message: common.StringPtr(message)}
+	case errNameEntityNotExists:
+		return &shared.EntityNotExistsError{Message: message}
+	case errNameServiceBusy:
+		return &shared.ServiceBusyError{Message: message}
+	case errNameCancellationAlreadyRequested:
+		return &shared.CancellationAlreadyRequestedError{Message: message}
+	case errNameQueryFailed:
+		return &shared.QueryFailedError{Message: message}
+	case errNameDomainNotActive:
+		return &shared.DomainNotActiveError{Message: message}
+	case errNameLimitExceeded:
+		return &shared.LimitExceededError{Message: message}
+	case errNameAccessDenied:
+		return &shared.AccessDeniedError{Message: message}
+	}
+	return err
+}
+
+func newStatus(code yarpcerrors.Code, name string, message string) error {
+	return yarpcerrors.Newf(code, "%s", message).WithName(name)
+}
+
+// newStatusWithDetails creates a gRPC status with the proto error as its details,
+// the named yarpc status is created instead if the details cannot be attached
+func newStatusWithDetails(code yarpcerrors.Code, name string, message string, details gogoproto.Message) error {
+	// yarpc codes have the values of the gRPC codes
+	grpcStatus, err := status.New(codes.Code(code), message).WithDetails(details)
+	if err != nil {
+		return newStatus(code, name, message)
+	}
+	return grpcStatus.Err()
+}
+
+// fromStatusDetails converts a gRPC status error into the thrift error its details describe,
+// the error is returned as is if it has no known details
+func fromStatusDetails(err error, grpcStatus *status.Status) error {
+	for _, details := range grpcStatus.Details() {
+		switch d := details.(type) {
+		case *apiv1.WorkflowExecutionAlreadyStartedError:
+			return &shared.WorkflowExecutionAlreadyStartedError{
+				Message:        common.StringPtr(grpcStatus.Message()),
+				StartRequestId: stringPtr(d.StartRequestId),
+				RunId:          stringPtr(d.RunId),
+			}
+		case *apiv1.DomainNotActiveError:
+			return &shared.DomainNotActiveError{
+				Message:        grpcStatus.Message(),
+				DomainName:     d.Domain,
+				CurrentCluster: d.CurrentCluster,
+				ActiveCluster:  d.ActiveCluster,
+			}
+		case *apiv1.ClientVersionNotSupportedError:
+			return &shared.ClientVersionNotSupportedError{
+				FeatureVersion:    d.FeatureVersion,
+				ClientImpl:        d.ClientImpl,
+				SupportedVersions: d.SupportedVersions,
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gogo/status"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc/yarpcerrors"
+	"google.golang.org/grpc/codes"
+)
+
+type (
+	errorsSuite struct {
+		suite.Suite
+	}
+)
+
+func TestErrorsSuite(t *testing.T) {
+	s := new(errorsSuite)
+	suite.Run(t, s)
+}
+
+func (s *errorsSuite) TestRoundTrip() {
+	for _, err := range []error{
+		&shared.BadRequestError{Message: "bad request"},
+		&shared.InternalServiceError{Message: "internal"},
+		&shared.DomainAlreadyExistsError{Message: "domain already exists"},
+		&shared.WorkflowExecutionAlreadyStartedError{Message: common.StringPtr("already started")},
+		&shared.EntityNotExistsError{Message: "not exists"},
+		&shared.ServiceBusyError{Message: "busy"},
+		&shared.CancellationAlreadyRequestedError{Message: "cancellation already requested"},
+		&shared.QueryFailedError{Message: "query failed"},
+		&shared.DomainNotActiveError{Message: "domain not active"},
+		&shared.LimitExceededError{Message: "limit exceeded"},
+		&shared.AccessDeniedError{Message: "access denied"},
+		&shared.WorkflowExecutionAlreadyStartedError{
+			Message:        common.StringPtr("already started"),
+			StartRequestId: common.StringPtr("request-id"),
+			RunId:          common.StringPtr("run-id"),
+		},
+		&shared.DomainNotActiveError{
+			Message:        "domain not active",
+			DomainName:     "domain",
+			CurrentCluster: "cluster-a",
+			ActiveCluster:  "cluster-b",
+		},
+		&shared.ClientVersionNotSupportedError{
+			FeatureVersion:    "1.0.0",
+			ClientImpl:        "uber-go",
+			SupportedVersions: "<1.0.0",
+		},
+	} {
+		converted := FromError(err)
+		_, isGRPCStatus := status.FromError(converted)
+		s.True(yarpcerrors.IsStatus(converted) || isGRPCStatus)
+		s.Equal(err, ToError(converted))
+	}
+}
+
+func (s *errorsSuite) TestDetails() {
+	err := FromError(&shared.DomainNotActiveError{
+		Message:        "domain not active",
+		DomainName:     "domain",
+		CurrentCluster: "cluster-a",
+		ActiveCluster:  "cluster-b",
+	})
+	grpcStatus, ok := status.FromError(err)
+	s.True(ok)
+	s.Equal(codes.FailedPrecondition, grpcStatus.Code())
+	// the details are attached to the status, not packed into its message
+	s.Equal("domain not active", grpcStatus.Message())
+	s.Equal([]interface{}{&apiv1.DomainNotActiveError{
+		Domain:         "domain",
+		CurrentCluster: "cluster-a",
+		ActiveCluster:  "cluster-b",
+	}}, grpcStatus.Details())
+}
+
+func (s *errorsSuite) TestCodes() {
+	s.Equal(yarpcerrors.CodeInvalidArgument, yarpcerrors.FromError(FromError(&shared.BadRequestError{})).Code())
+	s.Equal(yarpcerrors.CodeNotFound, yarpcerrors.FromError(FromError(&shared.EntityNotExistsError{})).Code())
+	s.Equal(yarpcerrors.CodeResourceExhausted, yarpcerrors.FromError(FromError(&shared.ServiceBusyError{})).Code())
+	s.Equal(codes.FailedPrecondition, status.Code(FromError(&shared.ClientVersionNotSupportedError{})))
+	s.Equal(codes.AlreadyExists, status.Code(FromError(&shared.WorkflowExecutionAlreadyStartedError{})))
+}
+
+func (s *errorsSuite) TestPassThrough() {
+	s.Nil(FromError(nil))
+	s.Nil(ToError(nil))
+
+	err := errors.New("some random error")
+	s.Equal(err, FromError(err))
+	s.Equal(err, ToError(err))
+
+	yarpcStatus := yarpcerrors.UnavailableErrorf("unavailable")
+	s.Equal(yarpcStatus, ToError(yarpcStatus))
+
+	// a gRPC status without known details is returned as is
+	grpcStatus := status.Error(codes.Unavailable, "unavailable")
+	s.Equal(grpcStatus, ToError(grpcStatus))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"context"
+
+	"github.com/gogo/googleapis/google/rpc"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/gogo/status"
+	"go.uber.org/yarpc/api/middleware"
+	"go.uber.org/yarpc/api/transport"
+)
+
+// errorStatusHeader carries the gRPC status of an error with details. The yarpc gRPC outbound
+// does not pass the status details on, so the status is sent in a response header as well.
+const errorStatusHeader = "cadence-error-status-bin"
+
+type (
+	// ErrorDetailsInboundMiddleware sends the gRPC status of the errors with details
+	// in a response header, so that yarpc clients can read the details
+	ErrorDetailsInboundMiddleware struct{}
+
+	// ErrorDetailsOutboundMiddleware restores the gRPC status of the errors with details
+	// from the response header written by ErrorDetailsInboundMiddleware
+	ErrorDetailsOutboundMiddleware struct{}
+)
+
+var _ middleware.UnaryInbound = ErrorDetailsInboundMiddleware{}
+var _ middleware.UnaryOutbound = ErrorDetailsOutboundMiddleware{}
+
+// Handle implements middleware.UnaryInbound
+func (ErrorDetailsInboundMiddleware) Handle(
+	ctx context.Context,
+	request *transport.Request,
+	responseWriter transport.ResponseWriter,
+	handler transport.UnaryHandler,
+) error {
+
+	err := handler.Handle(ctx, request, responseWriter)
+	if err == nil {
+		return nil
+	}
+	grpcStatus, ok := status.FromError(err)
+	if !ok || len(grpcStatus.Details()) == 0 {
+		return err
+	}
+	if data, marshalErr := gogoproto.Marshal(grpcStatus.Proto()); marshalErr == nil {
+		responseWriter.AddHeaders(transport.NewHeaders().With(errorStatusHeader, string(data)))
+	}
+	return err
+}
+
+// Call implements middleware.UnaryOutbound
+func (ErrorDetailsOutboundMiddleware) Call(
+	ctx context.Context,
+	request *transport.Request,
+	outbound transport.UnaryOutbound,
+) (*transport.Response, error) {
+
+	response, err := outbound.Call(ctx, request)
+	if err == nil || response == nil {
+		return response, err
+	}
+	data, ok := response.Headers.Get(errorStatusHeader)
+	if !ok {
+		return response, err
+	}
+	grpcStatus := &rpc.Status{}
+	if unmarshalErr := gogoproto.Unmarshal([]byte(data), grpcStatus); unmarshalErr != nil {
+		return response, err
+	}
+	return response, status.ErrorProto(grpcStatus)
+}
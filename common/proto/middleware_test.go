@@ -0,0 +1,88 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/transport/transporttest"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+type (
+	errorHandler struct {
+		err error
+	}
+
+	// headersOutbound returns the response headers along with the error,
+	// the status details are dropped like the yarpc gRPC outbound does
+	headersOutbound struct {
+		transport.UnaryOutbound
+		headers transport.Headers
+		err     error
+	}
+)
+
+func (h errorHandler) Handle(context.Context, *transport.Request, transport.ResponseWriter) error {
+	return h.err
+}
+
+func (o headersOutbound) Call(context.Context, *transport.Request) (*transport.Response, error) {
+	return &transport.Response{Headers: o.headers}, o.err
+}
+
+func TestErrorDetailsMiddleware(t *testing.T) {
+	err := &shared.WorkflowExecutionAlreadyStartedError{
+		Message:        common.StringPtr("already started"),
+		StartRequestId: common.StringPtr("request-id"),
+		RunId:          common.StringPtr("run-id"),
+	}
+	responseWriter := &transporttest.FakeResponseWriter{}
+	handlerErr := ErrorDetailsInboundMiddleware{}.Handle(context.Background(), &transport.Request{}, responseWriter,
+		errorHandler{err: FromError(err)})
+	require.Error(t, handlerErr)
+	_, ok := responseWriter.Headers.Get(errorStatusHeader)
+	require.True(t, ok)
+
+	outbound := headersOutbound{
+		headers: responseWriter.Headers,
+		err:     yarpcerrors.AlreadyExistsErrorf("already started"),
+	}
+	_, callErr := ErrorDetailsOutboundMiddleware{}.Call(context.Background(), &transport.Request{}, outbound)
+	require.Equal(t, err, ToError(callErr))
+}
+
+func TestErrorDetailsMiddleware_NoDetails(t *testing.T) {
+	responseWriter := &transporttest.FakeResponseWriter{}
+	err := FromError(&shared.BadRequestError{Message: "bad request"})
+	require.Equal(t, err, ErrorDetailsInboundMiddleware{}.Handle(context.Background(), &transport.Request{},
+		responseWriter, errorHandler{err: err}))
+	require.Equal(t, 0, responseWriter.Headers.Len())
+
+	outbound := headersOutbound{err: err}
+	_, callErr := ErrorDetailsOutboundMiddleware{}.Call(context.Background(), &transport.Request{}, outbound)
+	require.Equal(t, err, callErr)
+}
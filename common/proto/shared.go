@@ -0,0 +1,4478 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+)
+
+// FromHeader converts thrift Header type to proto
+func FromHeader(t *shared.Header) *apiv1.Header {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Header{
+		Fields: t.Fields,
+	}
+}
+
+// ToHeader converts proto Header type to thrift
+func ToHeader(p *apiv1.Header) *shared.Header {
+	if p == nil {
+		return nil
+	}
+	return &shared.Header{
+		Fields: p.Fields,
+	}
+}
+
+// FromWorkflowType converts thrift WorkflowType type to proto
+func FromWorkflowType(t *shared.WorkflowType) *apiv1.WorkflowType {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowType{
+		Name: t.GetName(),
+	}
+}
+
+// ToWorkflowType converts proto WorkflowType type to thrift
+func ToWorkflowType(p *apiv1.WorkflowType) *shared.WorkflowType {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowType{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// FromActivityType converts thrift ActivityType type to proto
+func FromActivityType(t *shared.ActivityType) *apiv1.ActivityType {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityType{
+		Name: t.GetName(),
+	}
+}
+
+// ToActivityType converts proto ActivityType type to thrift
+func ToActivityType(p *apiv1.ActivityType) *shared.ActivityType {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityType{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// FromTaskList converts thrift TaskList type to proto
+func FromTaskList(t *shared.TaskList) *apiv1.TaskList {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskList{
+		Name: t.GetName(),
+		Kind: FromTaskListKind(t.Kind),
+	}
+}
+
+// ToTaskList converts proto TaskList type to thrift
+func ToTaskList(p *apiv1.TaskList) *shared.TaskList {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskList{
+		Name: stringPtr(p.Name),
+		Kind: ToTaskListKind(p.Kind),
+	}
+}
+
+// FromTaskListMetadata converts thrift TaskListMetadata type to proto
+func FromTaskListMetadata(t *shared.TaskListMetadata) *apiv1.TaskListMetadata {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListMetadata{
+		MaxTasksPerSecond: t.GetMaxTasksPerSecond(),
+	}
+}
+
+// ToTaskListMetadata converts proto TaskListMetadata type to thrift
+func ToTaskListMetadata(p *apiv1.TaskListMetadata) *shared.TaskListMetadata {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskListMetadata{
+		MaxTasksPerSecond: common.Float64Ptr(p.MaxTasksPerSecond),
+	}
+}
+
+// FromWorkflowExecution converts thrift WorkflowExecution type to proto
+func FromWorkflowExecution(t *shared.WorkflowExecution) *apiv1.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecution{
+		WorkflowId: t.GetWorkflowId(),
+		RunId:      t.GetRunId(),
+	}
+}
+
+// ToWorkflowExecution converts proto WorkflowExecution type to thrift
+func ToWorkflowExecution(p *apiv1.WorkflowExecution) *shared.WorkflowExecution {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecution{
+		WorkflowId: stringPtr(p.WorkflowId),
+		RunId:      stringPtr(p.RunId),
+	}
+}
+
+// FromMemo converts thrift Memo type to proto
+func FromMemo(t *shared.Memo) *apiv1.Memo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Memo{
+		Fields: t.Fields,
+	}
+}
+
+// ToMemo converts proto Memo type to thrift
+func ToMemo(p *apiv1.Memo) *shared.Memo {
+	if p == nil {
+		return nil
+	}
+	return &shared.Memo{
+		Fields: p.Fields,
+	}
+}
+
+// FromPayloadReference converts thrift PayloadReference type to proto
+func FromPayloadReference(t *shared.PayloadReference) *apiv1.PayloadReference {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PayloadReference{
+		Bucket: t.GetBucket(),
+		Key:    t.GetKey(),
+		Size_:  t.GetSize(),
+	}
+}
+
+// ToPayloadReference converts proto PayloadReference type to thrift
+func ToPayloadReference(p *apiv1.PayloadReference) *shared.PayloadReference {
+	if p == nil {
+		return nil
+	}
+	return &shared.PayloadReference{
+		Bucket: stringPtr(p.Bucket),
+		Key:    stringPtr(p.Key),
+		Size:   common.Int64Ptr(p.Size_),
+	}
+}
+
+// FromWorkflowExecutionInfo converts thrift WorkflowExecutionInfo type to proto
+func FromWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *apiv1.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionInfo{
+		Execution:                       FromWorkflowExecution(t.Execution),
+		Type:                            FromWorkflowType(t.Type),
+		StartTime:                       t.GetStartTime(),
+		CloseTime:                       t.GetCloseTime(),
+		CloseStatus:                     FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		HistoryLength:                   t.GetHistoryLength(),
+		ParentDomainId:                  t.GetParentDomainId(),
+		ParentExecution:                 FromWorkflowExecution(t.ParentExecution),
+		ExecutionTime:                   t.GetExecutionTime(),
+		Memo:                            FromMemo(t.Memo),
+		AutoResetPoints:                 FromResetPoints(t.AutoResetPoints),
+		WorkflowExecutionExpirationTime: t.GetWorkflowExecutionExpirationTime(),
+	}
+}
+
+// ToWorkflowExecutionInfo converts proto WorkflowExecutionInfo type to thrift
+func ToWorkflowExecutionInfo(p *apiv1.WorkflowExecutionInfo) *shared.WorkflowExecutionInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionInfo{
+		Execution:                       ToWorkflowExecution(p.Execution),
+		Type:                            ToWorkflowType(p.Type),
+		StartTime:                       common.Int64Ptr(p.StartTime),
+		CloseTime:                       common.Int64Ptr(p.CloseTime),
+		CloseStatus:                     ToWorkflowExecutionCloseStatus(p.CloseStatus),
+		HistoryLength:                   common.Int64Ptr(p.HistoryLength),
+		ParentDomainId:                  stringPtr(p.ParentDomainId),
+		ParentExecution:                 ToWorkflowExecution(p.ParentExecution),
+		ExecutionTime:                   common.Int64Ptr(p.ExecutionTime),
+		Memo:                            ToMemo(p.Memo),
+		AutoResetPoints:                 ToResetPoints(p.AutoResetPoints),
+		WorkflowExecutionExpirationTime: common.Int64Ptr(p.WorkflowExecutionExpirationTime),
+	}
+}
+
+// FromWorkflowExecutionConfiguration converts thrift WorkflowExecutionConfiguration type to proto
+func FromWorkflowExecutionConfiguration(t *shared.WorkflowExecutionConfiguration) *apiv1.WorkflowExecutionConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionConfiguration{
+		TaskList:                            FromTaskList(t.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         FromChildPolicy(t.ChildPolicy),
+		RetentionPeriodInDays:               t.GetRetentionPeriodInDays(),
+		WorkflowExecutionTimeoutSeconds:     t.GetWorkflowExecutionTimeoutSeconds(),
+	}
+}
+
+// ToWorkflowExecutionConfiguration converts proto WorkflowExecutionConfiguration type to thrift
+func ToWorkflowExecutionConfiguration(p *apiv1.WorkflowExecutionConfiguration) *shared.WorkflowExecutionConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionConfiguration{
+		TaskList:                            ToTaskList(p.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         ToChildPolicy(p.ChildPolicy),
+		RetentionPeriodInDays:               common.Int32Ptr(p.RetentionPeriodInDays),
+		WorkflowExecutionTimeoutSeconds:     common.Int32Ptr(p.WorkflowExecutionTimeoutSeconds),
+	}
+}
+
+// FromScheduleActivityTaskDecisionAttributes converts thrift ScheduleActivityTaskDecisionAttributes type to proto
+func FromScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDecisionAttributes) *apiv1.ScheduleActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    t.GetActivityId(),
+		ActivityType:                  FromActivityType(t.ActivityType),
+		Domain:                        t.GetDomain(),
+		TaskList:                      FromTaskList(t.TaskList),
+		Input:                         t.Input,
+		ScheduleToCloseTimeoutSeconds: t.GetScheduleToCloseTimeoutSeconds(),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:    t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:       t.GetHeartbeatTimeoutSeconds(),
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+		Header:                        FromHeader(t.Header),
+	}
+}
+
+// ToScheduleActivityTaskDecisionAttributes converts proto ScheduleActivityTaskDecisionAttributes type to thrift
+func ToScheduleActivityTaskDecisionAttributes(p *apiv1.ScheduleActivityTaskDecisionAttributes) *shared.ScheduleActivityTaskDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    stringPtr(p.ActivityId),
+		ActivityType:                  ToActivityType(p.ActivityType),
+		Domain:                        stringPtr(p.Domain),
+		TaskList:                      ToTaskList(p.TaskList),
+		Input:                         p.Input,
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(p.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(p.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(p.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(p.HeartbeatTimeoutSeconds),
+		RetryPolicy:                   ToRetryPolicy(p.RetryPolicy),
+		Header:                        ToHeader(p.Header),
+	}
+}
+
+// FromRequestCancelActivityTaskDecisionAttributes converts thrift RequestCancelActivityTaskDecisionAttributes type to proto
+func FromRequestCancelActivityTaskDecisionAttributes(t *shared.RequestCancelActivityTaskDecisionAttributes) *apiv1.RequestCancelActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: t.GetActivityId(),
+	}
+}
+
+// ToRequestCancelActivityTaskDecisionAttributes converts proto RequestCancelActivityTaskDecisionAttributes type to thrift
+func ToRequestCancelActivityTaskDecisionAttributes(p *apiv1.RequestCancelActivityTaskDecisionAttributes) *shared.RequestCancelActivityTaskDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: stringPtr(p.ActivityId),
+	}
+}
+
+// FromStartTimerDecisionAttributes converts thrift StartTimerDecisionAttributes type to proto
+func FromStartTimerDecisionAttributes(t *shared.StartTimerDecisionAttributes) *apiv1.StartTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartTimerDecisionAttributes{
+		TimerId:                   t.GetTimerId(),
+		StartToFireTimeoutSeconds: t.GetStartToFireTimeoutSeconds(),
+	}
+}
+
+// ToStartTimerDecisionAttributes converts proto StartTimerDecisionAttributes type to thrift
+func ToStartTimerDecisionAttributes(p *apiv1.StartTimerDecisionAttributes) *shared.StartTimerDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartTimerDecisionAttributes{
+		TimerId:                   stringPtr(p.TimerId),
+		StartToFireTimeoutSeconds: common.Int64Ptr(p.StartToFireTimeoutSeconds),
+	}
+}
+
+// FromCompleteWorkflowExecutionDecisionAttributes converts thrift CompleteWorkflowExecutionDecisionAttributes type to proto
+func FromCompleteWorkflowExecutionDecisionAttributes(t *shared.CompleteWorkflowExecutionDecisionAttributes) *apiv1.CompleteWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CompleteWorkflowExecutionDecisionAttributes{
+		Result: t.Result,
+	}
+}
+
+// ToCompleteWorkflowExecutionDecisionAttributes converts proto CompleteWorkflowExecutionDecisionAttributes type to thrift
+func ToCompleteWorkflowExecutionDecisionAttributes(p *apiv1.CompleteWorkflowExecutionDecisionAttributes) *shared.CompleteWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CompleteWorkflowExecutionDecisionAttributes{
+		Result: p.Result,
+	}
+}
+
+// FromFailWorkflowExecutionDecisionAttributes converts thrift FailWorkflowExecutionDecisionAttributes type to proto
+func FromFailWorkflowExecutionDecisionAttributes(t *shared.FailWorkflowExecutionDecisionAttributes) *apiv1.FailWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.FailWorkflowExecutionDecisionAttributes{
+		Reason:  t.GetReason(),
+		Details: t.Details,
+	}
+}
+
+// ToFailWorkflowExecutionDecisionAttributes converts proto FailWorkflowExecutionDecisionAttributes type to thrift
+func ToFailWorkflowExecutionDecisionAttributes(p *apiv1.FailWorkflowExecutionDecisionAttributes) *shared.FailWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.FailWorkflowExecutionDecisionAttributes{
+		Reason:  stringPtr(p.Reason),
+		Details: p.Details,
+	}
+}
+
+// FromCancelTimerDecisionAttributes converts thrift CancelTimerDecisionAttributes type to proto
+func FromCancelTimerDecisionAttributes(t *shared.CancelTimerDecisionAttributes) *apiv1.CancelTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelTimerDecisionAttributes{
+		TimerId: t.GetTimerId(),
+	}
+}
+
+// ToCancelTimerDecisionAttributes converts proto CancelTimerDecisionAttributes type to thrift
+func ToCancelTimerDecisionAttributes(p *apiv1.CancelTimerDecisionAttributes) *shared.CancelTimerDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancelTimerDecisionAttributes{
+		TimerId: stringPtr(p.TimerId),
+	}
+}
+
+// FromCancelWorkflowExecutionDecisionAttributes converts thrift CancelWorkflowExecutionDecisionAttributes type to proto
+func FromCancelWorkflowExecutionDecisionAttributes(t *shared.CancelWorkflowExecutionDecisionAttributes) *apiv1.CancelWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelWorkflowExecutionDecisionAttributes{
+		Details: t.Details,
+	}
+}
+
+// ToCancelWorkflowExecutionDecisionAttributes converts proto CancelWorkflowExecutionDecisionAttributes type to thrift
+func ToCancelWorkflowExecutionDecisionAttributes(p *apiv1.CancelWorkflowExecutionDecisionAttributes) *shared.CancelWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancelWorkflowExecutionDecisionAttributes{
+		Details: p.Details,
+	}
+}
+
+// FromRequestCancelExternalWorkflowExecutionDecisionAttributes converts thrift RequestCancelExternalWorkflowExecutionDecisionAttributes type to proto
+func FromRequestCancelExternalWorkflowExecutionDecisionAttributes(t *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes) *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.GetDomain(),
+		WorkflowId:        t.GetWorkflowId(),
+		RunId:             t.GetRunId(),
+		Control:           t.Control,
+		ChildWorkflowOnly: t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToRequestCancelExternalWorkflowExecutionDecisionAttributes converts proto RequestCancelExternalWorkflowExecutionDecisionAttributes type to thrift
+func ToRequestCancelExternalWorkflowExecutionDecisionAttributes(p *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes) *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            stringPtr(p.Domain),
+		WorkflowId:        stringPtr(p.WorkflowId),
+		RunId:             stringPtr(p.RunId),
+		Control:           p.Control,
+		ChildWorkflowOnly: common.BoolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// FromSignalExternalWorkflowExecutionDecisionAttributes converts thrift SignalExternalWorkflowExecutionDecisionAttributes type to proto
+func FromSignalExternalWorkflowExecutionDecisionAttributes(t *shared.SignalExternalWorkflowExecutionDecisionAttributes) *apiv1.SignalExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.GetDomain(),
+		Execution:         FromWorkflowExecution(t.Execution),
+		SignalName:        t.GetSignalName(),
+		Input:             t.Input,
+		Control:           t.Control,
+		ChildWorkflowOnly: t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToSignalExternalWorkflowExecutionDecisionAttributes converts proto SignalExternalWorkflowExecutionDecisionAttributes type to thrift
+func ToSignalExternalWorkflowExecutionDecisionAttributes(p *apiv1.SignalExternalWorkflowExecutionDecisionAttributes) *shared.SignalExternalWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            stringPtr(p.Domain),
+		Execution:         ToWorkflowExecution(p.Execution),
+		SignalName:        stringPtr(p.SignalName),
+		Input:             p.Input,
+		Control:           p.Control,
+		ChildWorkflowOnly: common.BoolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// FromRecordMarkerDecisionAttributes converts thrift RecordMarkerDecisionAttributes type to proto
+func FromRecordMarkerDecisionAttributes(t *shared.RecordMarkerDecisionAttributes) *apiv1.RecordMarkerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordMarkerDecisionAttributes{
+		MarkerName: t.GetMarkerName(),
+		Details:    t.Details,
+		Header:     FromHeader(t.Header),
+	}
+}
+
+// ToRecordMarkerDecisionAttributes converts proto RecordMarkerDecisionAttributes type to thrift
+func ToRecordMarkerDecisionAttributes(p *apiv1.RecordMarkerDecisionAttributes) *shared.RecordMarkerDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordMarkerDecisionAttributes{
+		MarkerName: stringPtr(p.MarkerName),
+		Details:    p.Details,
+		Header:     ToHeader(p.Header),
+	}
+}
+
+// FromContinueAsNewWorkflowExecutionDecisionAttributes converts thrift ContinueAsNewWorkflowExecutionDecisionAttributes type to proto
+func FromContinueAsNewWorkflowExecutionDecisionAttributes(t *shared.ContinueAsNewWorkflowExecutionDecisionAttributes) *apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		BackoffStartIntervalInSeconds:       t.GetBackoffStartIntervalInSeconds(),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		Initiator:                           FromContinueAsNewInitiator(t.Initiator),
+		FailureReason:                       t.GetFailureReason(),
+		FailureDetails:                      t.FailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		CronSchedule:                        t.GetCronSchedule(),
+		Header:                              FromHeader(t.Header),
+		RetentionPeriodInDays:               t.GetRetentionPeriodInDays(),
+	}
+}
+
+// ToContinueAsNewWorkflowExecutionDecisionAttributes converts proto ContinueAsNewWorkflowExecutionDecisionAttributes type to thrift
+func ToContinueAsNewWorkflowExecutionDecisionAttributes(p *apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes) *shared.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		BackoffStartIntervalInSeconds:       common.Int32Ptr(p.BackoffStartIntervalInSeconds),
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+		Initiator:                           ToContinueAsNewInitiator(p.Initiator),
+		FailureReason:                       stringPtr(p.FailureReason),
+		FailureDetails:                      p.FailureDetails,
+		LastCompletionResult:                p.LastCompletionResult,
+		CronSchedule:                        stringPtr(p.CronSchedule),
+		Header:                              ToHeader(p.Header),
+		RetentionPeriodInDays:               common.Int32Ptr(p.RetentionPeriodInDays),
+	}
+}
+
+// FromStartChildWorkflowExecutionDecisionAttributes converts thrift StartChildWorkflowExecutionDecisionAttributes type to proto
+func FromStartChildWorkflowExecutionDecisionAttributes(t *shared.StartChildWorkflowExecutionDecisionAttributes) *apiv1.StartChildWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         FromChildPolicy(t.ChildPolicy),
+		Control:                             t.Control,
+		WorkflowIdReusePolicy:               FromWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+		Header:                              FromHeader(t.Header),
+	}
+}
+
+// ToStartChildWorkflowExecutionDecisionAttributes converts proto StartChildWorkflowExecutionDecisionAttributes type to thrift
+func ToStartChildWorkflowExecutionDecisionAttributes(p *apiv1.StartChildWorkflowExecutionDecisionAttributes) *shared.StartChildWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         ToChildPolicy(p.ChildPolicy),
+		Control:                             p.Control,
+		WorkflowIdReusePolicy:               ToWorkflowIdReusePolicy(p.WorkflowIdReusePolicy),
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+		CronSchedule:                        stringPtr(p.CronSchedule),
+		Header:                              ToHeader(p.Header),
+	}
+}
+
+// FromDecision converts thrift Decision type to proto
+func FromDecision(t *shared.Decision) *apiv1.Decision {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Decision{
+		DecisionType:                                             FromDecisionType(t.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   FromScheduleActivityTaskDecisionAttributes(t.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             FromStartTimerDecisionAttributes(t.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              FromCompleteWorkflowExecutionDecisionAttributes(t.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  FromFailWorkflowExecutionDecisionAttributes(t.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              FromRequestCancelActivityTaskDecisionAttributes(t.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            FromCancelTimerDecisionAttributes(t.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                FromCancelWorkflowExecutionDecisionAttributes(t.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: FromRequestCancelExternalWorkflowExecutionDecisionAttributes(t.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           FromRecordMarkerDecisionAttributes(t.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         FromContinueAsNewWorkflowExecutionDecisionAttributes(t.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            FromStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        FromSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
+	}
+}
+
+// ToDecision converts proto Decision type to thrift
+func ToDecision(p *apiv1.Decision) *shared.Decision {
+	if p == nil {
+		return nil
+	}
+	return &shared.Decision{
+		DecisionType:                                             ToDecisionType(p.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   ToScheduleActivityTaskDecisionAttributes(p.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             ToStartTimerDecisionAttributes(p.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              ToCompleteWorkflowExecutionDecisionAttributes(p.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  ToFailWorkflowExecutionDecisionAttributes(p.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              ToRequestCancelActivityTaskDecisionAttributes(p.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            ToCancelTimerDecisionAttributes(p.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                ToCancelWorkflowExecutionDecisionAttributes(p.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: ToRequestCancelExternalWorkflowExecutionDecisionAttributes(p.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           ToRecordMarkerDecisionAttributes(p.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         ToContinueAsNewWorkflowExecutionDecisionAttributes(p.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            ToStartChildWorkflowExecutionDecisionAttributes(p.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        ToSignalExternalWorkflowExecutionDecisionAttributes(p.SignalExternalWorkflowExecutionDecisionAttributes),
+	}
+}
+
+// FromWorkflowExecutionStartedEventAttributes converts thrift WorkflowExecutionStartedEventAttributes type to proto
+func FromWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStartedEventAttributes) *apiv1.WorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                         FromWorkflowType(t.WorkflowType),
+		ParentWorkflowDomain:                 t.GetParentWorkflowDomain(),
+		ParentWorkflowExecution:              FromWorkflowExecution(t.ParentWorkflowExecution),
+		ParentInitiatedEventId:               t.GetParentInitiatedEventId(),
+		TaskList:                             FromTaskList(t.TaskList),
+		Input:                                t.Input,
+		ExecutionStartToCloseTimeoutSeconds:  t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:       t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                          FromChildPolicy(t.ChildPolicy),
+		ContinuedExecutionRunId:              t.GetContinuedExecutionRunId(),
+		Initiator:                            FromContinueAsNewInitiator(t.Initiator),
+		ContinuedFailureReason:               t.GetContinuedFailureReason(),
+		ContinuedFailureDetails:              t.ContinuedFailureDetails,
+		LastCompletionResult:                 t.LastCompletionResult,
+		Identity:                             t.GetIdentity(),
+		RetryPolicy:                          FromRetryPolicy(t.RetryPolicy),
+		Attempt:                              t.GetAttempt(),
+		ExpirationTimestamp:                  t.GetExpirationTimestamp(),
+		CronSchedule:                         t.GetCronSchedule(),
+		FirstDecisionTaskBackoffSeconds:      t.GetFirstDecisionTaskBackoffSeconds(),
+		Memo:                                 FromMemo(t.Memo),
+		PrevAutoResetPoints:                  FromResetPoints(t.PrevAutoResetPoints),
+		Header:                               FromHeader(t.Header),
+		RetentionPeriodInDays:                t.GetRetentionPeriodInDays(),
+		InputReference:                       FromPayloadReference(t.InputReference),
+		WorkflowExecutionTimeoutSeconds:      t.GetWorkflowExecutionTimeoutSeconds(),
+		WorkflowExecutionExpirationTimestamp: t.GetWorkflowExecutionExpirationTimestamp(),
+	}
+}
+
+// ToWorkflowExecutionStartedEventAttributes converts proto WorkflowExecutionStartedEventAttributes type to thrift
+func ToWorkflowExecutionStartedEventAttributes(p *apiv1.WorkflowExecutionStartedEventAttributes) *shared.WorkflowExecutionStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                         ToWorkflowType(p.WorkflowType),
+		ParentWorkflowDomain:                 stringPtr(p.ParentWorkflowDomain),
+		ParentWorkflowExecution:              ToWorkflowExecution(p.ParentWorkflowExecution),
+		ParentInitiatedEventId:               common.Int64Ptr(p.ParentInitiatedEventId),
+		TaskList:                             ToTaskList(p.TaskList),
+		Input:                                p.Input,
+		ExecutionStartToCloseTimeoutSeconds:  common.Int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:       common.Int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                          ToChildPolicy(p.ChildPolicy),
+		ContinuedExecutionRunId:              stringPtr(p.ContinuedExecutionRunId),
+		Initiator:                            ToContinueAsNewInitiator(p.Initiator),
+		ContinuedFailureReason:               stringPtr(p.ContinuedFailureReason),
+		ContinuedFailureDetails:              p.ContinuedFailureDetails,
+		LastCompletionResult:                 p.LastCompletionResult,
+		Identity:                             stringPtr(p.Identity),
+		RetryPolicy:                          ToRetryPolicy(p.RetryPolicy),
+		Attempt:                              common.Int32Ptr(p.Attempt),
+		ExpirationTimestamp:                  common.Int64Ptr(p.ExpirationTimestamp),
+		CronSchedule:                         stringPtr(p.CronSchedule),
+		FirstDecisionTaskBackoffSeconds:      common.Int32Ptr(p.FirstDecisionTaskBackoffSeconds),
+		Memo:                                 ToMemo(p.Memo),
+		PrevAutoResetPoints:                  ToResetPoints(p.PrevAutoResetPoints),
+		Header:                               ToHeader(p.Header),
+		RetentionPeriodInDays:                common.Int32Ptr(p.RetentionPeriodInDays),
+		InputReference:                       ToPayloadReference(p.InputReference),
+		WorkflowExecutionTimeoutSeconds:      common.Int32Ptr(p.WorkflowExecutionTimeoutSeconds),
+		WorkflowExecutionExpirationTimestamp: common.Int64Ptr(p.WorkflowExecutionExpirationTimestamp),
+	}
+}
+
+// FromResetPoints converts thrift ResetPoints type to proto
+func FromResetPoints(t *shared.ResetPoints) *apiv1.ResetPoints {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetPoints{
+		Points: fromResetPointInfoArray(t.Points),
+	}
+}
+
+// ToResetPoints converts proto ResetPoints type to thrift
+func ToResetPoints(p *apiv1.ResetPoints) *shared.ResetPoints {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetPoints{
+		Points: toResetPointInfoArray(p.Points),
+	}
+}
+
+// FromResetPointInfo converts thrift ResetPointInfo type to proto
+func FromResetPointInfo(t *shared.ResetPointInfo) *apiv1.ResetPointInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetPointInfo{
+		BinaryChecksum:           t.GetBinaryChecksum(),
+		RunId:                    t.GetRunId(),
+		FirstDecisionCompletedId: t.GetFirstDecisionCompletedId(),
+		CreatedTimeNano:          t.GetCreatedTimeNano(),
+		ExpiringTimeNano:         t.GetExpiringTimeNano(),
+		Resettable:               t.GetResettable(),
+	}
+}
+
+// ToResetPointInfo converts proto ResetPointInfo type to thrift
+func ToResetPointInfo(p *apiv1.ResetPointInfo) *shared.ResetPointInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetPointInfo{
+		BinaryChecksum:           stringPtr(p.BinaryChecksum),
+		RunId:                    stringPtr(p.RunId),
+		FirstDecisionCompletedId: common.Int64Ptr(p.FirstDecisionCompletedId),
+		CreatedTimeNano:          common.Int64Ptr(p.CreatedTimeNano),
+		ExpiringTimeNano:         common.Int64Ptr(p.ExpiringTimeNano),
+		Resettable:               common.BoolPtr(p.Resettable),
+	}
+}
+
+// FromWorkflowExecutionCompletedEventAttributes converts thrift WorkflowExecutionCompletedEventAttributes type to proto
+func FromWorkflowExecutionCompletedEventAttributes(t *shared.WorkflowExecutionCompletedEventAttributes) *apiv1.WorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCompletedEventAttributes{
+		Result:                       t.Result,
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		ResultReference:              FromPayloadReference(t.ResultReference),
+	}
+}
+
+// ToWorkflowExecutionCompletedEventAttributes converts proto WorkflowExecutionCompletedEventAttributes type to thrift
+func ToWorkflowExecutionCompletedEventAttributes(p *apiv1.WorkflowExecutionCompletedEventAttributes) *shared.WorkflowExecutionCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCompletedEventAttributes{
+		Result:                       p.Result,
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		ResultReference:              ToPayloadReference(p.ResultReference),
+	}
+}
+
+// FromWorkflowExecutionFailedEventAttributes converts thrift WorkflowExecutionFailedEventAttributes type to proto
+func FromWorkflowExecutionFailedEventAttributes(t *shared.WorkflowExecutionFailedEventAttributes) *apiv1.WorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionFailedEventAttributes{
+		Reason:                       t.GetReason(),
+		Details:                      t.Details,
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToWorkflowExecutionFailedEventAttributes converts proto WorkflowExecutionFailedEventAttributes type to thrift
+func ToWorkflowExecutionFailedEventAttributes(p *apiv1.WorkflowExecutionFailedEventAttributes) *shared.WorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFailedEventAttributes{
+		Reason:                       stringPtr(p.Reason),
+		Details:                      p.Details,
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromWorkflowExecutionTimedOutEventAttributes converts thrift WorkflowExecutionTimedOutEventAttributes type to proto
+func FromWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimedOutEventAttributes) *apiv1.WorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: FromTimeoutType(t.TimeoutType),
+	}
+}
+
+// ToWorkflowExecutionTimedOutEventAttributes converts proto WorkflowExecutionTimedOutEventAttributes type to thrift
+func ToWorkflowExecutionTimedOutEventAttributes(p *apiv1.WorkflowExecutionTimedOutEventAttributes) *shared.WorkflowExecutionTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: ToTimeoutType(p.TimeoutType),
+	}
+}
+
+// FromWorkflowExecutionContinuedAsNewEventAttributes converts thrift WorkflowExecutionContinuedAsNewEventAttributes type to proto
+func FromWorkflowExecutionContinuedAsNewEventAttributes(t *shared.WorkflowExecutionContinuedAsNewEventAttributes) *apiv1.WorkflowExecutionContinuedAsNewEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   t.GetNewExecutionRunId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		DecisionTaskCompletedEventId:        t.GetDecisionTaskCompletedEventId(),
+		BackoffStartIntervalInSeconds:       t.GetBackoffStartIntervalInSeconds(),
+		Initiator:                           FromContinueAsNewInitiator(t.Initiator),
+		FailureReason:                       t.GetFailureReason(),
+		FailureDetails:                      t.FailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		Header:                              FromHeader(t.Header),
+	}
+}
+
+// ToWorkflowExecutionContinuedAsNewEventAttributes converts proto WorkflowExecutionContinuedAsNewEventAttributes type to thrift
+func ToWorkflowExecutionContinuedAsNewEventAttributes(p *apiv1.WorkflowExecutionContinuedAsNewEventAttributes) *shared.WorkflowExecutionContinuedAsNewEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   stringPtr(p.NewExecutionRunId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		DecisionTaskCompletedEventId:        common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		BackoffStartIntervalInSeconds:       common.Int32Ptr(p.BackoffStartIntervalInSeconds),
+		Initiator:                           ToContinueAsNewInitiator(p.Initiator),
+		FailureReason:                       stringPtr(p.FailureReason),
+		FailureDetails:                      p.FailureDetails,
+		LastCompletionResult:                p.LastCompletionResult,
+		Header:                              ToHeader(p.Header),
+	}
+}
+
+// FromDecisionTaskScheduledEventAttributes converts thrift DecisionTaskScheduledEventAttributes type to proto
+func FromDecisionTaskScheduledEventAttributes(t *shared.DecisionTaskScheduledEventAttributes) *apiv1.DecisionTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskScheduledEventAttributes{
+		TaskList:                   FromTaskList(t.TaskList),
+		StartToCloseTimeoutSeconds: t.GetStartToCloseTimeoutSeconds(),
+		Attempt:                    t.GetAttempt(),
+	}
+}
+
+// ToDecisionTaskScheduledEventAttributes converts proto DecisionTaskScheduledEventAttributes type to thrift
+func ToDecisionTaskScheduledEventAttributes(p *apiv1.DecisionTaskScheduledEventAttributes) *shared.DecisionTaskScheduledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskScheduledEventAttributes{
+		TaskList:                   ToTaskList(p.TaskList),
+		StartToCloseTimeoutSeconds: common.Int32Ptr(p.StartToCloseTimeoutSeconds),
+		Attempt:                    common.Int64Ptr(p.Attempt),
+	}
+}
+
+// FromDecisionTaskStartedEventAttributes converts thrift DecisionTaskStartedEventAttributes type to proto
+func FromDecisionTaskStartedEventAttributes(t *shared.DecisionTaskStartedEventAttributes) *apiv1.DecisionTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		Identity:         t.GetIdentity(),
+		RequestId:        t.GetRequestId(),
+	}
+}
+
+// ToDecisionTaskStartedEventAttributes converts proto DecisionTaskStartedEventAttributes type to thrift
+func ToDecisionTaskStartedEventAttributes(p *apiv1.DecisionTaskStartedEventAttributes) *shared.DecisionTaskStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: common.Int64Ptr(p.ScheduledEventId),
+		Identity:         stringPtr(p.Identity),
+		RequestId:        stringPtr(p.RequestId),
+	}
+}
+
+// FromDecisionTaskCompletedEventAttributes converts thrift DecisionTaskCompletedEventAttributes type to proto
+func FromDecisionTaskCompletedEventAttributes(t *shared.DecisionTaskCompletedEventAttributes) *apiv1.DecisionTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: t.ExecutionContext,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+		BinaryChecksum:   t.GetBinaryChecksum(),
+	}
+}
+
+// ToDecisionTaskCompletedEventAttributes converts proto DecisionTaskCompletedEventAttributes type to thrift
+func ToDecisionTaskCompletedEventAttributes(p *apiv1.DecisionTaskCompletedEventAttributes) *shared.DecisionTaskCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: p.ExecutionContext,
+		ScheduledEventId: common.Int64Ptr(p.ScheduledEventId),
+		StartedEventId:   common.Int64Ptr(p.StartedEventId),
+		Identity:         stringPtr(p.Identity),
+		BinaryChecksum:   stringPtr(p.BinaryChecksum),
+	}
+}
+
+// FromDecisionTaskTimedOutEventAttributes converts thrift DecisionTaskTimedOutEventAttributes type to proto
+func FromDecisionTaskTimedOutEventAttributes(t *shared.DecisionTaskTimedOutEventAttributes) *apiv1.DecisionTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		TimeoutType:      FromTimeoutType(t.TimeoutType),
+	}
+}
+
+// ToDecisionTaskTimedOutEventAttributes converts proto DecisionTaskTimedOutEventAttributes type to thrift
+func ToDecisionTaskTimedOutEventAttributes(p *apiv1.DecisionTaskTimedOutEventAttributes) *shared.DecisionTaskTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: common.Int64Ptr(p.ScheduledEventId),
+		StartedEventId:   common.Int64Ptr(p.StartedEventId),
+		TimeoutType:      ToTimeoutType(p.TimeoutType),
+	}
+}
+
+// FromDecisionTaskFailedEventAttributes converts thrift DecisionTaskFailedEventAttributes type to proto
+func FromDecisionTaskFailedEventAttributes(t *shared.DecisionTaskFailedEventAttributes) *apiv1.DecisionTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Cause:            FromDecisionTaskFailedCause(t.Cause),
+		Details:          t.Details,
+		Identity:         t.GetIdentity(),
+		Reason:           t.GetReason(),
+		BaseRunId:        t.GetBaseRunId(),
+		NewRunId:         t.GetNewRunId(),
+		ForkEventVersion: t.GetForkEventVersion(),
+	}
+}
+
+// ToDecisionTaskFailedEventAttributes converts proto DecisionTaskFailedEventAttributes type to thrift
+func ToDecisionTaskFailedEventAttributes(p *apiv1.DecisionTaskFailedEventAttributes) *shared.DecisionTaskFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: common.Int64Ptr(p.ScheduledEventId),
+		StartedEventId:   common.Int64Ptr(p.StartedEventId),
+		Cause:            ToDecisionTaskFailedCause(p.Cause),
+		Details:          p.Details,
+		Identity:         stringPtr(p.Identity),
+		Reason:           stringPtr(p.Reason),
+		BaseRunId:        stringPtr(p.BaseRunId),
+		NewRunId:         stringPtr(p.NewRunId),
+		ForkEventVersion: common.Int64Ptr(p.ForkEventVersion),
+	}
+}
+
+// FromActivityTaskScheduledEventAttributes converts thrift ActivityTaskScheduledEventAttributes type to proto
+func FromActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEventAttributes) *apiv1.ActivityTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    t.GetActivityId(),
+		ActivityType:                  FromActivityType(t.ActivityType),
+		Domain:                        t.GetDomain(),
+		TaskList:                      FromTaskList(t.TaskList),
+		Input:                         t.Input,
+		ScheduleToCloseTimeoutSeconds: t.GetScheduleToCloseTimeoutSeconds(),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:    t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:       t.GetHeartbeatTimeoutSeconds(),
+		DecisionTaskCompletedEventId:  t.GetDecisionTaskCompletedEventId(),
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+		Header:                        FromHeader(t.Header),
+		InputReference:                FromPayloadReference(t.InputReference),
+	}
+}
+
+// ToActivityTaskScheduledEventAttributes converts proto ActivityTaskScheduledEventAttributes type to thrift
+func ToActivityTaskScheduledEventAttributes(p *apiv1.ActivityTaskScheduledEventAttributes) *shared.ActivityTaskScheduledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    stringPtr(p.ActivityId),
+		ActivityType:                  ToActivityType(p.ActivityType),
+		Domain:                        stringPtr(p.Domain),
+		TaskList:                      ToTaskList(p.TaskList),
+		Input:                         p.Input,
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(p.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(p.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(p.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(p.HeartbeatTimeoutSeconds),
+		DecisionTaskCompletedEventId:  common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		RetryPolicy:                   ToRetryPolicy(p.RetryPolicy),
+		Header:                        ToHeader(p.Header),
+		InputReference:                ToPayloadReference(p.InputReference),
+	}
+}
+
+// FromActivityTaskStartedEventAttributes converts thrift ActivityTaskStartedEventAttributes type to proto
+func FromActivityTaskStartedEventAttributes(t *shared.ActivityTaskStartedEventAttributes) *apiv1.ActivityTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		Identity:         t.GetIdentity(),
+		RequestId:        t.GetRequestId(),
+		Attempt:          t.GetAttempt(),
+	}
+}
+
+// ToActivityTaskStartedEventAttributes converts proto ActivityTaskStartedEventAttributes type to thrift
+func ToActivityTaskStartedEventAttributes(p *apiv1.ActivityTaskStartedEventAttributes) *shared.ActivityTaskStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: common.Int64Ptr(p.ScheduledEventId),
+		Identity:         stringPtr(p.Identity),
+		RequestId:        stringPtr(p.RequestId),
+		Attempt:          common.Int32Ptr(p.Attempt),
+	}
+}
+
+// FromActivityTaskCompletedEventAttributes converts thrift ActivityTaskCompletedEventAttributes type to proto
+func FromActivityTaskCompletedEventAttributes(t *shared.ActivityTaskCompletedEventAttributes) *apiv1.ActivityTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCompletedEventAttributes{
+		Result:           t.Result,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+		ResultReference:  FromPayloadReference(t.ResultReference),
+	}
+}
+
+// ToActivityTaskCompletedEventAttributes converts proto ActivityTaskCompletedEventAttributes type to thrift
+func ToActivityTaskCompletedEventAttributes(p *apiv1.ActivityTaskCompletedEventAttributes) *shared.ActivityTaskCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCompletedEventAttributes{
+		Result:           p.Result,
+		ScheduledEventId: common.Int64Ptr(p.ScheduledEventId),
+		StartedEventId:   common.Int64Ptr(p.StartedEventId),
+		Identity:         stringPtr(p.Identity),
+		ResultReference:  ToPayloadReference(p.ResultReference),
+	}
+}
+
+// FromActivityTaskFailedEventAttributes converts thrift ActivityTaskFailedEventAttributes type to proto
+func FromActivityTaskFailedEventAttributes(t *shared.ActivityTaskFailedEventAttributes) *apiv1.ActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskFailedEventAttributes{
+		Reason:           t.GetReason(),
+		Details:          t.Details,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// ToActivityTaskFailedEventAttributes converts proto ActivityTaskFailedEventAttributes type to thrift
+func ToActivityTaskFailedEventAttributes(p *apiv1.ActivityTaskFailedEventAttributes) *shared.ActivityTaskFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskFailedEventAttributes{
+		Reason:           stringPtr(p.Reason),
+		Details:          p.Details,
+		ScheduledEventId: common.Int64Ptr(p.ScheduledEventId),
+		StartedEventId:   common.Int64Ptr(p.StartedEventId),
+		Identity:         stringPtr(p.Identity),
+	}
+}
+
+// FromActivityTaskTimedOutEventAttributes converts thrift ActivityTaskTimedOutEventAttributes type to proto
+func FromActivityTaskTimedOutEventAttributes(t *shared.ActivityTaskTimedOutEventAttributes) *apiv1.ActivityTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskTimedOutEventAttributes{
+		Details:          t.Details,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		TimeoutType:      FromTimeoutType(t.TimeoutType),
+	}
+}
+
+// ToActivityTaskTimedOutEventAttributes converts proto ActivityTaskTimedOutEventAttributes type to thrift
+func ToActivityTaskTimedOutEventAttributes(p *apiv1.ActivityTaskTimedOutEventAttributes) *shared.ActivityTaskTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskTimedOutEventAttributes{
+		Details:          p.Details,
+		ScheduledEventId: common.Int64Ptr(p.ScheduledEventId),
+		StartedEventId:   common.Int64Ptr(p.StartedEventId),
+		TimeoutType:      ToTimeoutType(p.TimeoutType),
+	}
+}
+
+// FromActivityTaskCancelRequestedEventAttributes converts thrift ActivityTaskCancelRequestedEventAttributes type to proto
+func FromActivityTaskCancelRequestedEventAttributes(t *shared.ActivityTaskCancelRequestedEventAttributes) *apiv1.ActivityTaskCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   t.GetActivityId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToActivityTaskCancelRequestedEventAttributes converts proto ActivityTaskCancelRequestedEventAttributes type to thrift
+func ToActivityTaskCancelRequestedEventAttributes(p *apiv1.ActivityTaskCancelRequestedEventAttributes) *shared.ActivityTaskCancelRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   stringPtr(p.ActivityId),
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromRequestCancelActivityTaskFailedEventAttributes converts thrift RequestCancelActivityTaskFailedEventAttributes type to proto
+func FromRequestCancelActivityTaskFailedEventAttributes(t *shared.RequestCancelActivityTaskFailedEventAttributes) *apiv1.RequestCancelActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   t.GetActivityId(),
+		Cause:                        t.GetCause(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToRequestCancelActivityTaskFailedEventAttributes converts proto RequestCancelActivityTaskFailedEventAttributes type to thrift
+func ToRequestCancelActivityTaskFailedEventAttributes(p *apiv1.RequestCancelActivityTaskFailedEventAttributes) *shared.RequestCancelActivityTaskFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   stringPtr(p.ActivityId),
+		Cause:                        stringPtr(p.Cause),
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromActivityTaskCanceledEventAttributes converts thrift ActivityTaskCanceledEventAttributes type to proto
+func FromActivityTaskCanceledEventAttributes(t *shared.ActivityTaskCanceledEventAttributes) *apiv1.ActivityTaskCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCanceledEventAttributes{
+		Details:                      t.Details,
+		LatestCancelRequestedEventId: t.GetLatestCancelRequestedEventId(),
+		ScheduledEventId:             t.GetScheduledEventId(),
+		StartedEventId:               t.GetStartedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// ToActivityTaskCanceledEventAttributes converts proto ActivityTaskCanceledEventAttributes type to thrift
+func ToActivityTaskCanceledEventAttributes(p *apiv1.ActivityTaskCanceledEventAttributes) *shared.ActivityTaskCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCanceledEventAttributes{
+		Details:                      p.Details,
+		LatestCancelRequestedEventId: common.Int64Ptr(p.LatestCancelRequestedEventId),
+		ScheduledEventId:             common.Int64Ptr(p.ScheduledEventId),
+		StartedEventId:               common.Int64Ptr(p.StartedEventId),
+		Identity:                     stringPtr(p.Identity),
+	}
+}
+
+// FromTimerStartedEventAttributes converts thrift TimerStartedEventAttributes type to proto
+func FromTimerStartedEventAttributes(t *shared.TimerStartedEventAttributes) *apiv1.TimerStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerStartedEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		StartToFireTimeoutSeconds:    t.GetStartToFireTimeoutSeconds(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToTimerStartedEventAttributes converts proto TimerStartedEventAttributes type to thrift
+func ToTimerStartedEventAttributes(p *apiv1.TimerStartedEventAttributes) *shared.TimerStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.TimerStartedEventAttributes{
+		TimerId:                      stringPtr(p.TimerId),
+		StartToFireTimeoutSeconds:    common.Int64Ptr(p.StartToFireTimeoutSeconds),
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromTimerFiredEventAttributes converts thrift TimerFiredEventAttributes type to proto
+func FromTimerFiredEventAttributes(t *shared.TimerFiredEventAttributes) *apiv1.TimerFiredEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerFiredEventAttributes{
+		TimerId:        t.GetTimerId(),
+		StartedEventId: t.GetStartedEventId(),
+	}
+}
+
+// ToTimerFiredEventAttributes converts proto TimerFiredEventAttributes type to thrift
+func ToTimerFiredEventAttributes(p *apiv1.TimerFiredEventAttributes) *shared.TimerFiredEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.TimerFiredEventAttributes{
+		TimerId:        stringPtr(p.TimerId),
+		StartedEventId: common.Int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromTimerCanceledEventAttributes converts thrift TimerCanceledEventAttributes type to proto
+func FromTimerCanceledEventAttributes(t *shared.TimerCanceledEventAttributes) *apiv1.TimerCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerCanceledEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		StartedEventId:               t.GetStartedEventId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// ToTimerCanceledEventAttributes converts proto TimerCanceledEventAttributes type to thrift
+func ToTimerCanceledEventAttributes(p *apiv1.TimerCanceledEventAttributes) *shared.TimerCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.TimerCanceledEventAttributes{
+		TimerId:                      stringPtr(p.TimerId),
+		StartedEventId:               common.Int64Ptr(p.StartedEventId),
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		Identity:                     stringPtr(p.Identity),
+	}
+}
+
+// FromCancelTimerFailedEventAttributes converts thrift CancelTimerFailedEventAttributes type to proto
+func FromCancelTimerFailedEventAttributes(t *shared.CancelTimerFailedEventAttributes) *apiv1.CancelTimerFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelTimerFailedEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		Cause:                        t.GetCause(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// ToCancelTimerFailedEventAttributes converts proto CancelTimerFailedEventAttributes type to thrift
+func ToCancelTimerFailedEventAttributes(p *apiv1.CancelTimerFailedEventAttributes) *shared.CancelTimerFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancelTimerFailedEventAttributes{
+		TimerId:                      stringPtr(p.TimerId),
+		Cause:                        stringPtr(p.Cause),
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		Identity:                     stringPtr(p.Identity),
+	}
+}
+
+// FromWorkflowExecutionCancelRequestedEventAttributes converts thrift WorkflowExecutionCancelRequestedEventAttributes type to proto
+func FromWorkflowExecutionCancelRequestedEventAttributes(t *shared.WorkflowExecutionCancelRequestedEventAttributes) *apiv1.WorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     t.GetCause(),
+		ExternalInitiatedEventId:  t.GetExternalInitiatedEventId(),
+		ExternalWorkflowExecution: FromWorkflowExecution(t.ExternalWorkflowExecution),
+		Identity:                  t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionCancelRequestedEventAttributes converts proto WorkflowExecutionCancelRequestedEventAttributes type to thrift
+func ToWorkflowExecutionCancelRequestedEventAttributes(p *apiv1.WorkflowExecutionCancelRequestedEventAttributes) *shared.WorkflowExecutionCancelRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     stringPtr(p.Cause),
+		ExternalInitiatedEventId:  common.Int64Ptr(p.ExternalInitiatedEventId),
+		ExternalWorkflowExecution: ToWorkflowExecution(p.ExternalWorkflowExecution),
+		Identity:                  stringPtr(p.Identity),
+	}
+}
+
+// FromWorkflowExecutionCanceledEventAttributes converts thrift WorkflowExecutionCanceledEventAttributes type to proto
+func FromWorkflowExecutionCanceledEventAttributes(t *shared.WorkflowExecutionCanceledEventAttributes) *apiv1.WorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Details:                      t.Details,
+	}
+}
+
+// ToWorkflowExecutionCanceledEventAttributes converts proto WorkflowExecutionCanceledEventAttributes type to thrift
+func ToWorkflowExecutionCanceledEventAttributes(p *apiv1.WorkflowExecutionCanceledEventAttributes) *shared.WorkflowExecutionCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		Details:                      p.Details,
+	}
+}
+
+// FromMarkerRecordedEventAttributes converts thrift MarkerRecordedEventAttributes type to proto
+func FromMarkerRecordedEventAttributes(t *shared.MarkerRecordedEventAttributes) *apiv1.MarkerRecordedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.MarkerRecordedEventAttributes{
+		MarkerName:                   t.GetMarkerName(),
+		Details:                      t.Details,
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Header:                       FromHeader(t.Header),
+	}
+}
+
+// ToMarkerRecordedEventAttributes converts proto MarkerRecordedEventAttributes type to thrift
+func ToMarkerRecordedEventAttributes(p *apiv1.MarkerRecordedEventAttributes) *shared.MarkerRecordedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.MarkerRecordedEventAttributes{
+		MarkerName:                   stringPtr(p.MarkerName),
+		Details:                      p.Details,
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		Header:                       ToHeader(p.Header),
+	}
+}
+
+// FromWorkflowExecutionSignaledEventAttributes converts thrift WorkflowExecutionSignaledEventAttributes type to proto
+func FromWorkflowExecutionSignaledEventAttributes(t *shared.WorkflowExecutionSignaledEventAttributes) *apiv1.WorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionSignaledEventAttributes{
+		SignalName:     t.GetSignalName(),
+		Input:          t.Input,
+		Identity:       t.GetIdentity(),
+		InputReference: FromPayloadReference(t.InputReference),
+		RequestId:      t.GetRequestId(),
+	}
+}
+
+// ToWorkflowExecutionSignaledEventAttributes converts proto WorkflowExecutionSignaledEventAttributes type to thrift
+func ToWorkflowExecutionSignaledEventAttributes(p *apiv1.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionSignaledEventAttributes{
+		SignalName:     stringPtr(p.SignalName),
+		Input:          p.Input,
+		Identity:       stringPtr(p.Identity),
+		InputReference: ToPayloadReference(p.InputReference),
+		RequestId:      stringPtr(p.RequestId),
+	}
+}
+
+// FromWorkflowExecutionUpdateAcceptedEventAttributes converts thrift WorkflowExecutionUpdateAcceptedEventAttributes type to proto
+func FromWorkflowExecutionUpdateAcceptedEventAttributes(t *shared.WorkflowExecutionUpdateAcceptedEventAttributes) *apiv1.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateId:                     t.GetUpdateId(),
+		UpdateName:                   t.GetUpdateName(),
+		Input:                        t.Input,
+		Result:                       t.Result,
+		Identity:                     t.GetIdentity(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToWorkflowExecutionUpdateAcceptedEventAttributes converts proto WorkflowExecutionUpdateAcceptedEventAttributes type to thrift
+func ToWorkflowExecutionUpdateAcceptedEventAttributes(p *apiv1.WorkflowExecutionUpdateAcceptedEventAttributes) *shared.WorkflowExecutionUpdateAcceptedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateId:                     stringPtr(p.UpdateId),
+		UpdateName:                   stringPtr(p.UpdateName),
+		Input:                        p.Input,
+		Result:                       p.Result,
+		Identity:                     stringPtr(p.Identity),
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromWorkflowExecutionTerminatedEventAttributes converts thrift WorkflowExecutionTerminatedEventAttributes type to proto
+func FromWorkflowExecutionTerminatedEventAttributes(t *shared.WorkflowExecutionTerminatedEventAttributes) *apiv1.WorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   t.GetReason(),
+		Details:  t.Details,
+		Identity: t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionTerminatedEventAttributes converts proto WorkflowExecutionTerminatedEventAttributes type to thrift
+func ToWorkflowExecutionTerminatedEventAttributes(p *apiv1.WorkflowExecutionTerminatedEventAttributes) *shared.WorkflowExecutionTerminatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   stringPtr(p.Reason),
+		Details:  p.Details,
+		Identity: stringPtr(p.Identity),
+	}
+}
+
+// FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes converts thrift RequestCancelExternalWorkflowExecutionInitiatedEventAttributes type to proto
+func FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		Control:                      t.Control,
+		ChildWorkflowOnly:            t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes converts proto RequestCancelExternalWorkflowExecutionInitiatedEventAttributes type to thrift
+func ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(p *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            ToWorkflowExecution(p.WorkflowExecution),
+		Control:                      p.Control,
+		ChildWorkflowOnly:            common.BoolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// FromRequestCancelExternalWorkflowExecutionFailedEventAttributes converts thrift RequestCancelExternalWorkflowExecutionFailedEventAttributes type to proto
+func FromRequestCancelExternalWorkflowExecutionFailedEventAttributes(t *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        FromCancelExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		Control:                      t.Control,
+	}
+}
+
+// ToRequestCancelExternalWorkflowExecutionFailedEventAttributes converts proto RequestCancelExternalWorkflowExecutionFailedEventAttributes type to thrift
+func ToRequestCancelExternalWorkflowExecutionFailedEventAttributes(p *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        ToCancelExternalWorkflowExecutionFailedCause(p.Cause),
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            ToWorkflowExecution(p.WorkflowExecution),
+		InitiatedEventId:             common.Int64Ptr(p.InitiatedEventId),
+		Control:                      p.Control,
+	}
+}
+
+// FromExternalWorkflowExecutionCancelRequestedEventAttributes converts thrift ExternalWorkflowExecutionCancelRequestedEventAttributes type to proto
+func FromExternalWorkflowExecutionCancelRequestedEventAttributes(t *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes) *apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// ToExternalWorkflowExecutionCancelRequestedEventAttributes converts proto ExternalWorkflowExecutionCancelRequestedEventAttributes type to thrift
+func ToExternalWorkflowExecutionCancelRequestedEventAttributes(p *apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes) *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  common.Int64Ptr(p.InitiatedEventId),
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+	}
+}
+
+// FromSignalExternalWorkflowExecutionInitiatedEventAttributes converts thrift SignalExternalWorkflowExecutionInitiatedEventAttributes type to proto
+func FromSignalExternalWorkflowExecutionInitiatedEventAttributes(t *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		SignalName:                   t.GetSignalName(),
+		Input:                        t.Input,
+		Control:                      t.Control,
+		ChildWorkflowOnly:            t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToSignalExternalWorkflowExecutionInitiatedEventAttributes converts proto SignalExternalWorkflowExecutionInitiatedEventAttributes type to thrift
+func ToSignalExternalWorkflowExecutionInitiatedEventAttributes(p *apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes) *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            ToWorkflowExecution(p.WorkflowExecution),
+		SignalName:                   stringPtr(p.SignalName),
+		Input:                        p.Input,
+		Control:                      p.Control,
+		ChildWorkflowOnly:            common.BoolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// FromSignalExternalWorkflowExecutionFailedEventAttributes converts thrift SignalExternalWorkflowExecutionFailedEventAttributes type to proto
+func FromSignalExternalWorkflowExecutionFailedEventAttributes(t *shared.SignalExternalWorkflowExecutionFailedEventAttributes) *apiv1.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        FromSignalExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		Control:                      t.Control,
+	}
+}
+
+// ToSignalExternalWorkflowExecutionFailedEventAttributes converts proto SignalExternalWorkflowExecutionFailedEventAttributes type to thrift
+func ToSignalExternalWorkflowExecutionFailedEventAttributes(p *apiv1.SignalExternalWorkflowExecutionFailedEventAttributes) *shared.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        ToSignalExternalWorkflowExecutionFailedCause(p.Cause),
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            ToWorkflowExecution(p.WorkflowExecution),
+		InitiatedEventId:             common.Int64Ptr(p.InitiatedEventId),
+		Control:                      p.Control,
+	}
+}
+
+// FromExternalWorkflowExecutionSignaledEventAttributes converts thrift ExternalWorkflowExecutionSignaledEventAttributes type to proto
+func FromExternalWorkflowExecutionSignaledEventAttributes(t *shared.ExternalWorkflowExecutionSignaledEventAttributes) *apiv1.ExternalWorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Control:           t.Control,
+	}
+}
+
+// ToExternalWorkflowExecutionSignaledEventAttributes converts proto ExternalWorkflowExecutionSignaledEventAttributes type to thrift
+func ToExternalWorkflowExecutionSignaledEventAttributes(p *apiv1.ExternalWorkflowExecutionSignaledEventAttributes) *shared.ExternalWorkflowExecutionSignaledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  common.Int64Ptr(p.InitiatedEventId),
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		Control:           p.Control,
+	}
+}
+
+// FromStartChildWorkflowExecutionInitiatedEventAttributes converts thrift StartChildWorkflowExecutionInitiatedEventAttributes type to proto
+func FromStartChildWorkflowExecutionInitiatedEventAttributes(t *shared.StartChildWorkflowExecutionInitiatedEventAttributes) *apiv1.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         FromChildPolicy(t.ChildPolicy),
+		Control:                             t.Control,
+		DecisionTaskCompletedEventId:        t.GetDecisionTaskCompletedEventId(),
+		WorkflowIdReusePolicy:               FromWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+		Header:                              FromHeader(t.Header),
+	}
+}
+
+// ToStartChildWorkflowExecutionInitiatedEventAttributes converts proto StartChildWorkflowExecutionInitiatedEventAttributes type to thrift
+func ToStartChildWorkflowExecutionInitiatedEventAttributes(p *apiv1.StartChildWorkflowExecutionInitiatedEventAttributes) *shared.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         ToChildPolicy(p.ChildPolicy),
+		Control:                             p.Control,
+		DecisionTaskCompletedEventId:        common.Int64Ptr(p.DecisionTaskCompletedEventId),
+		WorkflowIdReusePolicy:               ToWorkflowIdReusePolicy(p.WorkflowIdReusePolicy),
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+		CronSchedule:                        stringPtr(p.CronSchedule),
+		Header:                              ToHeader(p.Header),
+	}
+}
+
+// FromStartChildWorkflowExecutionFailedEventAttributes converts thrift StartChildWorkflowExecutionFailedEventAttributes type to proto
+func FromStartChildWorkflowExecutionFailedEventAttributes(t *shared.StartChildWorkflowExecutionFailedEventAttributes) *apiv1.StartChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       t.GetDomain(),
+		WorkflowId:                   t.GetWorkflowId(),
+		WorkflowType:                 FromWorkflowType(t.WorkflowType),
+		Cause:                        FromChildWorkflowExecutionFailedCause(t.Cause),
+		Control:                      t.Control,
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToStartChildWorkflowExecutionFailedEventAttributes converts proto StartChildWorkflowExecutionFailedEventAttributes type to thrift
+func ToStartChildWorkflowExecutionFailedEventAttributes(p *apiv1.StartChildWorkflowExecutionFailedEventAttributes) *shared.StartChildWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       stringPtr(p.Domain),
+		WorkflowId:                   stringPtr(p.WorkflowId),
+		WorkflowType:                 ToWorkflowType(p.WorkflowType),
+		Cause:                        ToChildWorkflowExecutionFailedCause(p.Cause),
+		Control:                      p.Control,
+		InitiatedEventId:             common.Int64Ptr(p.InitiatedEventId),
+		DecisionTaskCompletedEventId: common.Int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionStartedEventAttributes converts thrift ChildWorkflowExecutionStartedEventAttributes type to proto
+func FromChildWorkflowExecutionStartedEventAttributes(t *shared.ChildWorkflowExecutionStartedEventAttributes) *apiv1.ChildWorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            t.GetDomain(),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		Header:            FromHeader(t.Header),
+	}
+}
+
+// ToChildWorkflowExecutionStartedEventAttributes converts proto ChildWorkflowExecutionStartedEventAttributes type to thrift
+func ToChildWorkflowExecutionStartedEventAttributes(p *apiv1.ChildWorkflowExecutionStartedEventAttributes) *shared.ChildWorkflowExecutionStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            stringPtr(p.Domain),
+		InitiatedEventId:  common.Int64Ptr(p.InitiatedEventId),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		Header:            ToHeader(p.Header),
+	}
+}
+
+// FromChildWorkflowExecutionCompletedEventAttributes converts thrift ChildWorkflowExecutionCompletedEventAttributes type to proto
+func FromChildWorkflowExecutionCompletedEventAttributes(t *shared.ChildWorkflowExecutionCompletedEventAttributes) *apiv1.ChildWorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            t.Result,
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionCompletedEventAttributes converts proto ChildWorkflowExecutionCompletedEventAttributes type to thrift
+func ToChildWorkflowExecutionCompletedEventAttributes(p *apiv1.ChildWorkflowExecutionCompletedEventAttributes) *shared.ChildWorkflowExecutionCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            p.Result,
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  common.Int64Ptr(p.InitiatedEventId),
+		StartedEventId:    common.Int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionFailedEventAttributes converts thrift ChildWorkflowExecutionFailedEventAttributes type to proto
+func FromChildWorkflowExecutionFailedEventAttributes(t *shared.ChildWorkflowExecutionFailedEventAttributes) *apiv1.ChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            t.GetReason(),
+		Details:           t.Details,
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionFailedEventAttributes converts proto ChildWorkflowExecutionFailedEventAttributes type to thrift
+func ToChildWorkflowExecutionFailedEventAttributes(p *apiv1.ChildWorkflowExecutionFailedEventAttributes) *shared.ChildWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            stringPtr(p.Reason),
+		Details:           p.Details,
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  common.Int64Ptr(p.InitiatedEventId),
+		StartedEventId:    common.Int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionCanceledEventAttributes converts thrift ChildWorkflowExecutionCanceledEventAttributes type to proto
+func FromChildWorkflowExecutionCanceledEventAttributes(t *shared.ChildWorkflowExecutionCanceledEventAttributes) *apiv1.ChildWorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           t.Details,
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionCanceledEventAttributes converts proto ChildWorkflowExecutionCanceledEventAttributes type to thrift
+func ToChildWorkflowExecutionCanceledEventAttributes(p *apiv1.ChildWorkflowExecutionCanceledEventAttributes) *shared.ChildWorkflowExecutionCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           p.Details,
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  common.Int64Ptr(p.InitiatedEventId),
+		StartedEventId:    common.Int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionTimedOutEventAttributes converts thrift ChildWorkflowExecutionTimedOutEventAttributes type to proto
+func FromChildWorkflowExecutionTimedOutEventAttributes(t *shared.ChildWorkflowExecutionTimedOutEventAttributes) *apiv1.ChildWorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       FromTimeoutType(t.TimeoutType),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionTimedOutEventAttributes converts proto ChildWorkflowExecutionTimedOutEventAttributes type to thrift
+func ToChildWorkflowExecutionTimedOutEventAttributes(p *apiv1.ChildWorkflowExecutionTimedOutEventAttributes) *shared.ChildWorkflowExecutionTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       ToTimeoutType(p.TimeoutType),
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  common.Int64Ptr(p.InitiatedEventId),
+		StartedEventId:    common.Int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionTerminatedEventAttributes converts thrift ChildWorkflowExecutionTerminatedEventAttributes type to proto
+func FromChildWorkflowExecutionTerminatedEventAttributes(t *shared.ChildWorkflowExecutionTerminatedEventAttributes) *apiv1.ChildWorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionTerminatedEventAttributes converts proto ChildWorkflowExecutionTerminatedEventAttributes type to thrift
+func ToChildWorkflowExecutionTerminatedEventAttributes(p *apiv1.ChildWorkflowExecutionTerminatedEventAttributes) *shared.ChildWorkflowExecutionTerminatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(p.WorkflowType),
+		InitiatedEventId:  common.Int64Ptr(p.InitiatedEventId),
+		StartedEventId:    common.Int64Ptr(p.StartedEventId),
+	}
+}
+
+// FromHistoryEvent converts thrift HistoryEvent type to proto
+func FromHistoryEvent(t *shared.HistoryEvent) *apiv1.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.HistoryEvent{
+		EventId:                                 t.GetEventId(),
+		Timestamp:                               t.GetTimestamp(),
+		EventType:                               FromEventType(t.EventType),
+		Version:                                 t.GetVersion(),
+		TaskId:                                  t.GetTaskId(),
+		WorkflowExecutionStartedEventAttributes: FromWorkflowExecutionStartedEventAttributes(t.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      FromWorkflowExecutionCompletedEventAttributes(t.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         FromWorkflowExecutionFailedEventAttributes(t.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       FromWorkflowExecutionTimedOutEventAttributes(t.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           FromDecisionTaskScheduledEventAttributes(t.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             FromDecisionTaskStartedEventAttributes(t.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           FromDecisionTaskCompletedEventAttributes(t.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            FromDecisionTaskTimedOutEventAttributes(t.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              FromDecisionTaskFailedEventAttributes(t.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           FromActivityTaskScheduledEventAttributes(t.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             FromActivityTaskStartedEventAttributes(t.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           FromActivityTaskCompletedEventAttributes(t.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              FromActivityTaskFailedEventAttributes(t.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            FromActivityTaskTimedOutEventAttributes(t.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    FromTimerStartedEventAttributes(t.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      FromTimerFiredEventAttributes(t.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     FromActivityTaskCancelRequestedEventAttributes(t.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 FromRequestCancelActivityTaskFailedEventAttributes(t.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            FromActivityTaskCanceledEventAttributes(t.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   FromTimerCanceledEventAttributes(t.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               FromCancelTimerFailedEventAttributes(t.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  FromMarkerRecordedEventAttributes(t.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       FromWorkflowExecutionSignaledEventAttributes(t.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     FromWorkflowExecutionTerminatedEventAttributes(t.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                FromWorkflowExecutionCancelRequestedEventAttributes(t.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       FromWorkflowExecutionCanceledEventAttributes(t.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    FromRequestCancelExternalWorkflowExecutionFailedEventAttributes(t.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        FromExternalWorkflowExecutionCancelRequestedEventAttributes(t.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 FromWorkflowExecutionContinuedAsNewEventAttributes(t.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            FromStartChildWorkflowExecutionInitiatedEventAttributes(t.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               FromStartChildWorkflowExecutionFailedEventAttributes(t.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   FromChildWorkflowExecutionStartedEventAttributes(t.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 FromChildWorkflowExecutionCompletedEventAttributes(t.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    FromChildWorkflowExecutionFailedEventAttributes(t.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  FromChildWorkflowExecutionCanceledEventAttributes(t.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  FromChildWorkflowExecutionTimedOutEventAttributes(t.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                FromChildWorkflowExecutionTerminatedEventAttributes(t.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        FromSignalExternalWorkflowExecutionInitiatedEventAttributes(t.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionUpdateAcceptedEventAttributes:                 FromWorkflowExecutionUpdateAcceptedEventAttributes(t.WorkflowExecutionUpdateAcceptedEventAttributes),
+	}
+}
+
+// ToHistoryEvent converts proto HistoryEvent type to thrift
+func ToHistoryEvent(p *apiv1.HistoryEvent) *shared.HistoryEvent {
+	if p == nil {
+		return nil
+	}
+	return &shared.HistoryEvent{
+		EventId:                                 common.Int64Ptr(p.EventId),
+		Timestamp:                               common.Int64Ptr(p.Timestamp),
+		EventType:                               ToEventType(p.EventType),
+		Version:                                 common.Int64Ptr(p.Version),
+		TaskId:                                  common.Int64Ptr(p.TaskId),
+		WorkflowExecutionStartedEventAttributes: ToWorkflowExecutionStartedEventAttributes(p.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      ToWorkflowExecutionCompletedEventAttributes(p.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         ToWorkflowExecutionFailedEventAttributes(p.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       ToWorkflowExecutionTimedOutEventAttributes(p.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           ToDecisionTaskScheduledEventAttributes(p.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             ToDecisionTaskStartedEventAttributes(p.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           ToDecisionTaskCompletedEventAttributes(p.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            ToDecisionTaskTimedOutEventAttributes(p.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              ToDecisionTaskFailedEventAttributes(p.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           ToActivityTaskScheduledEventAttributes(p.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             ToActivityTaskStartedEventAttributes(p.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           ToActivityTaskCompletedEventAttributes(p.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              ToActivityTaskFailedEventAttributes(p.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            ToActivityTaskTimedOutEventAttributes(p.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    ToTimerStartedEventAttributes(p.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      ToTimerFiredEventAttributes(p.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     ToActivityTaskCancelRequestedEventAttributes(p.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 ToRequestCancelActivityTaskFailedEventAttributes(p.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            ToActivityTaskCanceledEventAttributes(p.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   ToTimerCanceledEventAttributes(p.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               ToCancelTimerFailedEventAttributes(p.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  ToMarkerRecordedEventAttributes(p.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       ToWorkflowExecutionSignaledEventAttributes(p.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     ToWorkflowExecutionTerminatedEventAttributes(p.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                ToWorkflowExecutionCancelRequestedEventAttributes(p.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       ToWorkflowExecutionCanceledEventAttributes(p.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    ToRequestCancelExternalWorkflowExecutionFailedEventAttributes(p.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        ToExternalWorkflowExecutionCancelRequestedEventAttributes(p.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 ToWorkflowExecutionContinuedAsNewEventAttributes(p.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            ToStartChildWorkflowExecutionInitiatedEventAttributes(p.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               ToStartChildWorkflowExecutionFailedEventAttributes(p.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   ToChildWorkflowExecutionStartedEventAttributes(p.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 ToChildWorkflowExecutionCompletedEventAttributes(p.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    ToChildWorkflowExecutionFailedEventAttributes(p.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  ToChildWorkflowExecutionCanceledEventAttributes(p.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  ToChildWorkflowExecutionTimedOutEventAttributes(p.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                ToChildWorkflowExecutionTerminatedEventAttributes(p.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        ToSignalExternalWorkflowExecutionInitiatedEventAttributes(p.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(p.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(p.ExternalWorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionUpdateAcceptedEventAttributes:                 ToWorkflowExecutionUpdateAcceptedEventAttributes(p.WorkflowExecutionUpdateAcceptedEventAttributes),
+	}
+}
+
+// FromHistory converts thrift History type to proto
+func FromHistory(t *shared.History) *apiv1.History {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.History{
+		Events: fromHistoryEventArray(t.Events),
+	}
+}
+
+// ToHistory converts proto History type to thrift
+func ToHistory(p *apiv1.History) *shared.History {
+	if p == nil {
+		return nil
+	}
+	return &shared.History{
+		Events: toHistoryEventArray(p.Events),
+	}
+}
+
+// FromWorkflowExecutionFilter converts thrift WorkflowExecutionFilter type to proto
+func FromWorkflowExecutionFilter(t *shared.WorkflowExecutionFilter) *apiv1.WorkflowExecutionFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionFilter{
+		WorkflowId: t.GetWorkflowId(),
+	}
+}
+
+// ToWorkflowExecutionFilter converts proto WorkflowExecutionFilter type to thrift
+func ToWorkflowExecutionFilter(p *apiv1.WorkflowExecutionFilter) *shared.WorkflowExecutionFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFilter{
+		WorkflowId: stringPtr(p.WorkflowId),
+	}
+}
+
+// FromWorkflowTypeFilter converts thrift WorkflowTypeFilter type to proto
+func FromWorkflowTypeFilter(t *shared.WorkflowTypeFilter) *apiv1.WorkflowTypeFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowTypeFilter{
+		Name: t.GetName(),
+	}
+}
+
+// ToWorkflowTypeFilter converts proto WorkflowTypeFilter type to thrift
+func ToWorkflowTypeFilter(p *apiv1.WorkflowTypeFilter) *shared.WorkflowTypeFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowTypeFilter{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// FromStartTimeFilter converts thrift StartTimeFilter type to proto
+func FromStartTimeFilter(t *shared.StartTimeFilter) *apiv1.StartTimeFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartTimeFilter{
+		EarliestTime: t.GetEarliestTime(),
+		LatestTime:   t.GetLatestTime(),
+	}
+}
+
+// ToStartTimeFilter converts proto StartTimeFilter type to thrift
+func ToStartTimeFilter(p *apiv1.StartTimeFilter) *shared.StartTimeFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(p.EarliestTime),
+		LatestTime:   common.Int64Ptr(p.LatestTime),
+	}
+}
+
+// FromDomainInfo converts thrift DomainInfo type to proto
+func FromDomainInfo(t *shared.DomainInfo) *apiv1.DomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainInfo{
+		Name:        t.GetName(),
+		Status:      FromDomainStatus(t.Status),
+		Description: t.GetDescription(),
+		OwnerEmail:  t.GetOwnerEmail(),
+		Data:        t.Data,
+		Uuid:        t.GetUUID(),
+	}
+}
+
+// ToDomainInfo converts proto DomainInfo type to thrift
+func ToDomainInfo(p *apiv1.DomainInfo) *shared.DomainInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainInfo{
+		Name:        stringPtr(p.Name),
+		Status:      ToDomainStatus(p.Status),
+		Description: stringPtr(p.Description),
+		OwnerEmail:  stringPtr(p.OwnerEmail),
+		Data:        p.Data,
+		UUID:        stringPtr(p.Uuid),
+	}
+}
+
+// FromDomainConfiguration converts thrift DomainConfiguration type to proto
+func FromDomainConfiguration(t *shared.DomainConfiguration) *apiv1.DomainConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: t.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:                             t.GetEmitMetric(),
+		ArchivalBucketName:                     t.GetArchivalBucketName(),
+		ArchivalRetentionPeriodInDays:          t.GetArchivalRetentionPeriodInDays(),
+		ArchivalStatus:                         FromArchivalStatus(t.ArchivalStatus),
+		ArchivalBucketOwner:                    t.GetArchivalBucketOwner(),
+		BadBinaries:                            FromBadBinaries(t.BadBinaries),
+	}
+}
+
+// ToDomainConfiguration converts proto DomainConfiguration type to thrift
+func ToDomainConfiguration(p *apiv1.DomainConfiguration) *shared.DomainConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(p.WorkflowExecutionRetentionPeriodInDays),
+		EmitMetric:                             common.BoolPtr(p.EmitMetric),
+		ArchivalBucketName:                     stringPtr(p.ArchivalBucketName),
+		ArchivalRetentionPeriodInDays:          common.Int32Ptr(p.ArchivalRetentionPeriodInDays),
+		ArchivalStatus:                         ToArchivalStatus(p.ArchivalStatus),
+		ArchivalBucketOwner:                    stringPtr(p.ArchivalBucketOwner),
+		BadBinaries:                            ToBadBinaries(p.BadBinaries),
+	}
+}
+
+// FromBadBinaries converts thrift BadBinaries type to proto
+func FromBadBinaries(t *shared.BadBinaries) *apiv1.BadBinaries {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BadBinaries{
+		Binaries: fromBadBinaryInfoMap(t.Binaries),
+	}
+}
+
+// ToBadBinaries converts proto BadBinaries type to thrift
+func ToBadBinaries(p *apiv1.BadBinaries) *shared.BadBinaries {
+	if p == nil {
+		return nil
+	}
+	return &shared.BadBinaries{
+		Binaries: toBadBinaryInfoMap(p.Binaries),
+	}
+}
+
+// FromBadBinaryInfo converts thrift BadBinaryInfo type to proto
+func FromBadBinaryInfo(t *shared.BadBinaryInfo) *apiv1.BadBinaryInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BadBinaryInfo{
+		Reason:          t.GetReason(),
+		Operator:        t.GetOperator(),
+		CreatedTimeNano: t.GetCreatedTimeNano(),
+	}
+}
+
+// ToBadBinaryInfo converts proto BadBinaryInfo type to thrift
+func ToBadBinaryInfo(p *apiv1.BadBinaryInfo) *shared.BadBinaryInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.BadBinaryInfo{
+		Reason:          stringPtr(p.Reason),
+		Operator:        stringPtr(p.Operator),
+		CreatedTimeNano: common.Int64Ptr(p.CreatedTimeNano),
+	}
+}
+
+// FromUpdateDomainInfo converts thrift UpdateDomainInfo type to proto
+func FromUpdateDomainInfo(t *shared.UpdateDomainInfo) *apiv1.UpdateDomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainInfo{
+		Description: t.GetDescription(),
+		OwnerEmail:  t.GetOwnerEmail(),
+		Data:        t.Data,
+	}
+}
+
+// ToUpdateDomainInfo converts proto UpdateDomainInfo type to thrift
+func ToUpdateDomainInfo(p *apiv1.UpdateDomainInfo) *shared.UpdateDomainInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateDomainInfo{
+		Description: stringPtr(p.Description),
+		OwnerEmail:  stringPtr(p.OwnerEmail),
+		Data:        p.Data,
+	}
+}
+
+// FromClusterReplicationConfiguration converts thrift ClusterReplicationConfiguration type to proto
+func FromClusterReplicationConfiguration(t *shared.ClusterReplicationConfiguration) *apiv1.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ClusterReplicationConfiguration{
+		ClusterName: t.GetClusterName(),
+	}
+}
+
+// ToClusterReplicationConfiguration converts proto ClusterReplicationConfiguration type to thrift
+func ToClusterReplicationConfiguration(p *apiv1.ClusterReplicationConfiguration) *shared.ClusterReplicationConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.ClusterReplicationConfiguration{
+		ClusterName: stringPtr(p.ClusterName),
+	}
+}
+
+// FromDomainReplicationConfiguration converts thrift DomainReplicationConfiguration type to proto
+func FromDomainReplicationConfiguration(t *shared.DomainReplicationConfiguration) *apiv1.DomainReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainReplicationConfiguration{
+		ActiveClusterName: t.GetActiveClusterName(),
+		Clusters:          fromClusterReplicationConfigurationArray(t.Clusters),
+	}
+}
+
+// ToDomainReplicationConfiguration converts proto DomainReplicationConfiguration type to thrift
+func ToDomainReplicationConfiguration(p *apiv1.DomainReplicationConfiguration) *shared.DomainReplicationConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainReplicationConfiguration{
+		ActiveClusterName: stringPtr(p.ActiveClusterName),
+		Clusters:          toClusterReplicationConfigurationArray(p.Clusters),
+	}
+}
+
+// FromRegisterDomainRequest converts thrift RegisterDomainRequest type to proto
+func FromRegisterDomainRequest(t *shared.RegisterDomainRequest) *apiv1.RegisterDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RegisterDomainRequest{
+		Name:                                   t.GetName(),
+		Description:                            t.GetDescription(),
+		OwnerEmail:                             t.GetOwnerEmail(),
+		WorkflowExecutionRetentionPeriodInDays: t.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:                             t.GetEmitMetric(),
+		Clusters:                               fromClusterReplicationConfigurationArray(t.Clusters),
+		ActiveClusterName:                      t.GetActiveClusterName(),
+		Data:                                   t.Data,
+		SecurityToken:                          t.GetSecurityToken(),
+		ArchivalStatus:                         FromArchivalStatus(t.ArchivalStatus),
+		ArchivalBucketName:                     t.GetArchivalBucketName(),
+	}
+}
+
+// ToRegisterDomainRequest converts proto RegisterDomainRequest type to thrift
+func ToRegisterDomainRequest(p *apiv1.RegisterDomainRequest) *shared.RegisterDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RegisterDomainRequest{
+		Name:                                   stringPtr(p.Name),
+		Description:                            stringPtr(p.Description),
+		OwnerEmail:                             stringPtr(p.OwnerEmail),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(p.WorkflowExecutionRetentionPeriodInDays),
+		EmitMetric:                             common.BoolPtr(p.EmitMetric),
+		Clusters:                               toClusterReplicationConfigurationArray(p.Clusters),
+		ActiveClusterName:                      stringPtr(p.ActiveClusterName),
+		Data:                                   p.Data,
+		SecurityToken:                          stringPtr(p.SecurityToken),
+		ArchivalStatus:                         ToArchivalStatus(p.ArchivalStatus),
+		ArchivalBucketName:                     stringPtr(p.ArchivalBucketName),
+	}
+}
+
+// FromListDomainsRequest converts thrift ListDomainsRequest type to proto
+func FromListDomainsRequest(t *shared.ListDomainsRequest) *apiv1.ListDomainsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListDomainsRequest{
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListDomainsRequest converts proto ListDomainsRequest type to thrift
+func ToListDomainsRequest(p *apiv1.ListDomainsRequest) *shared.ListDomainsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListDomainsRequest{
+		PageSize:      common.Int32Ptr(p.PageSize),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromListDomainsResponse converts thrift ListDomainsResponse type to proto
+func FromListDomainsResponse(t *shared.ListDomainsResponse) *apiv1.ListDomainsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListDomainsResponse{
+		Domains:       fromDescribeDomainResponseArray(t.Domains),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListDomainsResponse converts proto ListDomainsResponse type to thrift
+func ToListDomainsResponse(p *apiv1.ListDomainsResponse) *shared.ListDomainsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListDomainsResponse{
+		Domains:       toDescribeDomainResponseArray(p.Domains),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromDescribeDomainRequest converts thrift DescribeDomainRequest type to proto
+func FromDescribeDomainRequest(t *shared.DescribeDomainRequest) *apiv1.DescribeDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeDomainRequest{
+		Name: t.GetName(),
+		Uuid: t.GetUUID(),
+	}
+}
+
+// ToDescribeDomainRequest converts proto DescribeDomainRequest type to thrift
+func ToDescribeDomainRequest(p *apiv1.DescribeDomainRequest) *shared.DescribeDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeDomainRequest{
+		Name: stringPtr(p.Name),
+		UUID: stringPtr(p.Uuid),
+	}
+}
+
+// FromWorkflowTypeConcurrency converts thrift WorkflowTypeConcurrency type to proto
+func FromWorkflowTypeConcurrency(t *shared.WorkflowTypeConcurrency) *apiv1.WorkflowTypeConcurrency {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowTypeConcurrency{
+		WorkflowType:      t.GetWorkflowType(),
+		RunningExecutions: t.GetRunningExecutions(),
+		Limit:             t.GetLimit(),
+	}
+}
+
+// ToWorkflowTypeConcurrency converts proto WorkflowTypeConcurrency type to thrift
+func ToWorkflowTypeConcurrency(p *apiv1.WorkflowTypeConcurrency) *shared.WorkflowTypeConcurrency {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowTypeConcurrency{
+		WorkflowType:      stringPtr(p.WorkflowType),
+		RunningExecutions: common.Int64Ptr(p.RunningExecutions),
+		Limit:             common.Int32Ptr(p.Limit),
+	}
+}
+
+// FromDescribeDomainResponse converts thrift DescribeDomainResponse type to proto
+func FromDescribeDomainResponse(t *shared.DescribeDomainResponse) *apiv1.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeDomainResponse{
+		DomainInfo:               FromDomainInfo(t.DomainInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          t.GetFailoverVersion(),
+		IsGlobalDomain:           t.GetIsGlobalDomain(),
+		WorkflowTypeConcurrency:  fromWorkflowTypeConcurrencyArray(t.WorkflowTypeConcurrency),
+	}
+}
+
+// ToDescribeDomainResponse converts proto DescribeDomainResponse type to thrift
+func ToDescribeDomainResponse(p *apiv1.DescribeDomainResponse) *shared.DescribeDomainResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeDomainResponse{
+		DomainInfo:               ToDomainInfo(p.DomainInfo),
+		Configuration:            ToDomainConfiguration(p.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(p.ReplicationConfiguration),
+		FailoverVersion:          common.Int64Ptr(p.FailoverVersion),
+		IsGlobalDomain:           common.BoolPtr(p.IsGlobalDomain),
+		WorkflowTypeConcurrency:  toWorkflowTypeConcurrencyArray(p.WorkflowTypeConcurrency),
+	}
+}
+
+// FromUpdateDomainRequest converts thrift UpdateDomainRequest type to proto
+func FromUpdateDomainRequest(t *shared.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainRequest{
+		Name:                     t.GetName(),
+		UpdatedInfo:              FromUpdateDomainInfo(t.UpdatedInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		SecurityToken:            t.GetSecurityToken(),
+		DeleteBadBinary:          t.GetDeleteBadBinary(),
+	}
+}
+
+// ToUpdateDomainRequest converts proto UpdateDomainRequest type to thrift
+func ToUpdateDomainRequest(p *apiv1.UpdateDomainRequest) *shared.UpdateDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateDomainRequest{
+		Name:                     stringPtr(p.Name),
+		UpdatedInfo:              ToUpdateDomainInfo(p.UpdatedInfo),
+		Configuration:            ToDomainConfiguration(p.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(p.ReplicationConfiguration),
+		SecurityToken:            stringPtr(p.SecurityToken),
+		DeleteBadBinary:          stringPtr(p.DeleteBadBinary),
+	}
+}
+
+// FromUpdateDomainResponse converts thrift UpdateDomainResponse type to proto
+func FromUpdateDomainResponse(t *shared.UpdateDomainResponse) *apiv1.UpdateDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainResponse{
+		DomainInfo:               FromDomainInfo(t.DomainInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          t.GetFailoverVersion(),
+		IsGlobalDomain:           t.GetIsGlobalDomain(),
+	}
+}
+
+// ToUpdateDomainResponse converts proto UpdateDomainResponse type to thrift
+func ToUpdateDomainResponse(p *apiv1.UpdateDomainResponse) *shared.UpdateDomainResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateDomainResponse{
+		DomainInfo:               ToDomainInfo(p.DomainInfo),
+		Configuration:            ToDomainConfiguration(p.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(p.ReplicationConfiguration),
+		FailoverVersion:          common.Int64Ptr(p.FailoverVersion),
+		IsGlobalDomain:           common.BoolPtr(p.IsGlobalDomain),
+	}
+}
+
+// FromDeprecateDomainRequest converts thrift DeprecateDomainRequest type to proto
+func FromDeprecateDomainRequest(t *shared.DeprecateDomainRequest) *apiv1.DeprecateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DeprecateDomainRequest{
+		Name:          t.GetName(),
+		SecurityToken: t.GetSecurityToken(),
+	}
+}
+
+// ToDeprecateDomainRequest converts proto DeprecateDomainRequest type to thrift
+func ToDeprecateDomainRequest(p *apiv1.DeprecateDomainRequest) *shared.DeprecateDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DeprecateDomainRequest{
+		Name:          stringPtr(p.Name),
+		SecurityToken: stringPtr(p.SecurityToken),
+	}
+}
+
+// FromStartWorkflowExecutionRequest converts thrift StartWorkflowExecutionRequest type to proto
+func FromStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *apiv1.StartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionRequest{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		Identity:                            t.GetIdentity(),
+		RequestId:                           t.GetRequestId(),
+		WorkflowIdReusePolicy:               FromWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		ChildPolicy:                         FromChildPolicy(t.ChildPolicy),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+		Memo:                                FromMemo(t.Memo),
+		Header:                              FromHeader(t.Header),
+		RetentionPeriodInDays:               t.GetRetentionPeriodInDays(),
+		DelayStartSeconds:                   t.GetDelayStartSeconds(),
+		WorkflowExecutionTimeoutSeconds:     t.GetWorkflowExecutionTimeoutSeconds(),
+	}
+}
+
+// ToStartWorkflowExecutionRequest converts proto StartWorkflowExecutionRequest type to thrift
+func ToStartWorkflowExecutionRequest(p *apiv1.StartWorkflowExecutionRequest) *shared.StartWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionRequest{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		Identity:                            stringPtr(p.Identity),
+		RequestId:                           stringPtr(p.RequestId),
+		WorkflowIdReusePolicy:               ToWorkflowIdReusePolicy(p.WorkflowIdReusePolicy),
+		ChildPolicy:                         ToChildPolicy(p.ChildPolicy),
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+		CronSchedule:                        stringPtr(p.CronSchedule),
+		Memo:                                ToMemo(p.Memo),
+		Header:                              ToHeader(p.Header),
+		RetentionPeriodInDays:               common.Int32Ptr(p.RetentionPeriodInDays),
+		DelayStartSeconds:                   common.Int32Ptr(p.DelayStartSeconds),
+		WorkflowExecutionTimeoutSeconds:     common.Int32Ptr(p.WorkflowExecutionTimeoutSeconds),
+	}
+}
+
+// FromStartWorkflowExecutionResponse converts thrift StartWorkflowExecutionResponse type to proto
+func FromStartWorkflowExecutionResponse(t *shared.StartWorkflowExecutionResponse) *apiv1.StartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionResponse{
+		RunId: t.GetRunId(),
+	}
+}
+
+// ToStartWorkflowExecutionResponse converts proto StartWorkflowExecutionResponse type to thrift
+func ToStartWorkflowExecutionResponse(p *apiv1.StartWorkflowExecutionResponse) *shared.StartWorkflowExecutionResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionResponse{
+		RunId: stringPtr(p.RunId),
+	}
+}
+
+// FromStartWorkflowExecutionBatchRequest converts thrift StartWorkflowExecutionBatchRequest type to proto
+func FromStartWorkflowExecutionBatchRequest(t *shared.StartWorkflowExecutionBatchRequest) *apiv1.StartWorkflowExecutionBatchRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionBatchRequest{
+		Requests: fromStartWorkflowExecutionRequestArray(t.Requests),
+	}
+}
+
+// ToStartWorkflowExecutionBatchRequest converts proto StartWorkflowExecutionBatchRequest type to thrift
+func ToStartWorkflowExecutionBatchRequest(p *apiv1.StartWorkflowExecutionBatchRequest) *shared.StartWorkflowExecutionBatchRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionBatchRequest{
+		Requests: toStartWorkflowExecutionRequestArray(p.Requests),
+	}
+}
+
+// FromStartWorkflowExecutionBatchResult converts thrift StartWorkflowExecutionBatchResult type to proto
+func FromStartWorkflowExecutionBatchResult(t *shared.StartWorkflowExecutionBatchResult) *apiv1.StartWorkflowExecutionBatchResult {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionBatchResult{
+		RunId:        t.GetRunId(),
+		ErrorType:    t.GetErrorType(),
+		ErrorMessage: t.GetErrorMessage(),
+	}
+}
+
+// ToStartWorkflowExecutionBatchResult converts proto StartWorkflowExecutionBatchResult type to thrift
+func ToStartWorkflowExecutionBatchResult(p *apiv1.StartWorkflowExecutionBatchResult) *shared.StartWorkflowExecutionBatchResult {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionBatchResult{
+		RunId:        stringPtr(p.RunId),
+		ErrorType:    stringPtr(p.ErrorType),
+		ErrorMessage: stringPtr(p.ErrorMessage),
+	}
+}
+
+// FromStartWorkflowExecutionBatchResponse converts thrift StartWorkflowExecutionBatchResponse type to proto
+func FromStartWorkflowExecutionBatchResponse(t *shared.StartWorkflowExecutionBatchResponse) *apiv1.StartWorkflowExecutionBatchResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionBatchResponse{
+		Results: fromStartWorkflowExecutionBatchResultArray(t.Results),
+	}
+}
+
+// ToStartWorkflowExecutionBatchResponse converts proto StartWorkflowExecutionBatchResponse type to thrift
+func ToStartWorkflowExecutionBatchResponse(p *apiv1.StartWorkflowExecutionBatchResponse) *shared.StartWorkflowExecutionBatchResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionBatchResponse{
+		Results: toStartWorkflowExecutionBatchResultArray(p.Results),
+	}
+}
+
+// FromPollForDecisionTaskRequest converts thrift PollForDecisionTaskRequest type to proto
+func FromPollForDecisionTaskRequest(t *shared.PollForDecisionTaskRequest) *apiv1.PollForDecisionTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForDecisionTaskRequest{
+		Domain:         t.GetDomain(),
+		TaskList:       FromTaskList(t.TaskList),
+		Identity:       t.GetIdentity(),
+		BinaryChecksum: t.GetBinaryChecksum(),
+	}
+}
+
+// ToPollForDecisionTaskRequest converts proto PollForDecisionTaskRequest type to thrift
+func ToPollForDecisionTaskRequest(p *apiv1.PollForDecisionTaskRequest) *shared.PollForDecisionTaskRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskRequest{
+		Domain:         stringPtr(p.Domain),
+		TaskList:       ToTaskList(p.TaskList),
+		Identity:       stringPtr(p.Identity),
+		BinaryChecksum: stringPtr(p.BinaryChecksum),
+	}
+}
+
+// FromPollForDecisionTaskResponse converts thrift PollForDecisionTaskResponse type to proto
+func FromPollForDecisionTaskResponse(t *shared.PollForDecisionTaskResponse) *apiv1.PollForDecisionTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForDecisionTaskResponse{
+		TaskToken:                 t.TaskToken,
+		WorkflowExecution:         FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:              FromWorkflowType(t.WorkflowType),
+		PreviousStartedEventId:    t.GetPreviousStartedEventId(),
+		StartedEventId:            t.GetStartedEventId(),
+		Attempt:                   t.GetAttempt(),
+		BacklogCountHint:          t.GetBacklogCountHint(),
+		History:                   FromHistory(t.History),
+		NextPageToken:             t.NextPageToken,
+		Query:                     FromWorkflowQuery(t.Query),
+		WorkflowExecutionTaskList: FromTaskList(t.WorkflowExecutionTaskList),
+		Updates:                   fromWorkflowUpdateMap(t.Updates),
+	}
+}
+
+// ToPollForDecisionTaskResponse converts proto PollForDecisionTaskResponse type to thrift
+func ToPollForDecisionTaskResponse(p *apiv1.PollForDecisionTaskResponse) *shared.PollForDecisionTaskResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskResponse{
+		TaskToken:                 p.TaskToken,
+		WorkflowExecution:         ToWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:              ToWorkflowType(p.WorkflowType),
+		PreviousStartedEventId:    common.Int64Ptr(p.PreviousStartedEventId),
+		StartedEventId:            common.Int64Ptr(p.StartedEventId),
+		Attempt:                   common.Int64Ptr(p.Attempt),
+		BacklogCountHint:          common.Int64Ptr(p.BacklogCountHint),
+		History:                   ToHistory(p.History),
+		NextPageToken:             p.NextPageToken,
+		Query:                     ToWorkflowQuery(p.Query),
+		WorkflowExecutionTaskList: ToTaskList(p.WorkflowExecutionTaskList),
+		Updates:                   toWorkflowUpdateMap(p.Updates),
+	}
+}
+
+// FromStickyExecutionAttributes converts thrift StickyExecutionAttributes type to proto
+func FromStickyExecutionAttributes(t *shared.StickyExecutionAttributes) *apiv1.StickyExecutionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StickyExecutionAttributes{
+		WorkerTaskList:                FromTaskList(t.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+	}
+}
+
+// ToStickyExecutionAttributes converts proto StickyExecutionAttributes type to thrift
+func ToStickyExecutionAttributes(p *apiv1.StickyExecutionAttributes) *shared.StickyExecutionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StickyExecutionAttributes{
+		WorkerTaskList:                ToTaskList(p.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(p.ScheduleToStartTimeoutSeconds),
+	}
+}
+
+// FromRespondDecisionTaskCompletedRequest converts thrift RespondDecisionTaskCompletedRequest type to proto
+func FromRespondDecisionTaskCompletedRequest(t *shared.RespondDecisionTaskCompletedRequest) *apiv1.RespondDecisionTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskCompletedRequest{
+		TaskToken:                  t.TaskToken,
+		Decisions:                  fromDecisionArray(t.Decisions),
+		ExecutionContext:           t.ExecutionContext,
+		Identity:                   t.GetIdentity(),
+		StickyAttributes:           FromStickyExecutionAttributes(t.StickyAttributes),
+		ReturnNewDecisionTask:      t.GetReturnNewDecisionTask(),
+		ForceCreateNewDecisionTask: t.GetForceCreateNewDecisionTask(),
+		BinaryChecksum:             t.GetBinaryChecksum(),
+		UpdateResults:              fromWorkflowUpdateResultMap(t.UpdateResults),
+	}
+}
+
+// ToRespondDecisionTaskCompletedRequest converts proto RespondDecisionTaskCompletedRequest type to thrift
+func ToRespondDecisionTaskCompletedRequest(p *apiv1.RespondDecisionTaskCompletedRequest) *shared.RespondDecisionTaskCompletedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskCompletedRequest{
+		TaskToken:                  p.TaskToken,
+		Decisions:                  toDecisionArray(p.Decisions),
+		ExecutionContext:           p.ExecutionContext,
+		Identity:                   stringPtr(p.Identity),
+		StickyAttributes:           ToStickyExecutionAttributes(p.StickyAttributes),
+		ReturnNewDecisionTask:      common.BoolPtr(p.ReturnNewDecisionTask),
+		ForceCreateNewDecisionTask: common.BoolPtr(p.ForceCreateNewDecisionTask),
+		BinaryChecksum:             stringPtr(p.BinaryChecksum),
+		UpdateResults:              toWorkflowUpdateResultMap(p.UpdateResults),
+	}
+}
+
+// FromRespondDecisionTaskCompletedResponse converts thrift RespondDecisionTaskCompletedResponse type to proto
+func FromRespondDecisionTaskCompletedResponse(t *shared.RespondDecisionTaskCompletedResponse) *apiv1.RespondDecisionTaskCompletedResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskCompletedResponse{
+		DecisionTask: FromPollForDecisionTaskResponse(t.DecisionTask),
+	}
+}
+
+// ToRespondDecisionTaskCompletedResponse converts proto RespondDecisionTaskCompletedResponse type to thrift
+func ToRespondDecisionTaskCompletedResponse(p *apiv1.RespondDecisionTaskCompletedResponse) *shared.RespondDecisionTaskCompletedResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskCompletedResponse{
+		DecisionTask: ToPollForDecisionTaskResponse(p.DecisionTask),
+	}
+}
+
+// FromRespondDecisionTaskFailedRequest converts thrift RespondDecisionTaskFailedRequest type to proto
+func FromRespondDecisionTaskFailedRequest(t *shared.RespondDecisionTaskFailedRequest) *apiv1.RespondDecisionTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskFailedRequest{
+		TaskToken: t.TaskToken,
+		Cause:     FromDecisionTaskFailedCause(t.Cause),
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondDecisionTaskFailedRequest converts proto RespondDecisionTaskFailedRequest type to thrift
+func ToRespondDecisionTaskFailedRequest(p *apiv1.RespondDecisionTaskFailedRequest) *shared.RespondDecisionTaskFailedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskFailedRequest{
+		TaskToken: p.TaskToken,
+		Cause:     ToDecisionTaskFailedCause(p.Cause),
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromPollForActivityTaskRequest converts thrift PollForActivityTaskRequest type to proto
+func FromPollForActivityTaskRequest(t *shared.PollForActivityTaskRequest) *apiv1.PollForActivityTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForActivityTaskRequest{
+		Domain:           t.GetDomain(),
+		TaskList:         FromTaskList(t.TaskList),
+		Identity:         t.GetIdentity(),
+		TaskListMetadata: FromTaskListMetadata(t.TaskListMetadata),
+	}
+}
+
+// ToPollForActivityTaskRequest converts proto PollForActivityTaskRequest type to thrift
+func ToPollForActivityTaskRequest(p *apiv1.PollForActivityTaskRequest) *shared.PollForActivityTaskRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskRequest{
+		Domain:           stringPtr(p.Domain),
+		TaskList:         ToTaskList(p.TaskList),
+		Identity:         stringPtr(p.Identity),
+		TaskListMetadata: ToTaskListMetadata(p.TaskListMetadata),
+	}
+}
+
+// FromPollForActivityTaskResponse converts thrift PollForActivityTaskResponse type to proto
+func FromPollForActivityTaskResponse(t *shared.PollForActivityTaskResponse) *apiv1.PollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForActivityTaskResponse{
+		TaskToken:                       t.TaskToken,
+		WorkflowExecution:               FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:                      t.GetActivityId(),
+		ActivityType:                    FromActivityType(t.ActivityType),
+		Input:                           t.Input,
+		ScheduledTimestamp:              t.GetScheduledTimestamp(),
+		ScheduleToCloseTimeoutSeconds:   t.GetScheduleToCloseTimeoutSeconds(),
+		StartedTimestamp:                t.GetStartedTimestamp(),
+		StartToCloseTimeoutSeconds:      t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:         t.GetHeartbeatTimeoutSeconds(),
+		Attempt:                         t.GetAttempt(),
+		ScheduledTimestampOfThisAttempt: t.GetScheduledTimestampOfThisAttempt(),
+		HeartbeatDetails:                t.HeartbeatDetails,
+		WorkflowType:                    FromWorkflowType(t.WorkflowType),
+		WorkflowDomain:                  t.GetWorkflowDomain(),
+		Header:                          FromHeader(t.Header),
+		InputReference:                  FromPayloadReference(t.InputReference),
+	}
+}
+
+// ToPollForActivityTaskResponse converts proto PollForActivityTaskResponse type to thrift
+func ToPollForActivityTaskResponse(p *apiv1.PollForActivityTaskResponse) *shared.PollForActivityTaskResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskResponse{
+		TaskToken:                       p.TaskToken,
+		WorkflowExecution:               ToWorkflowExecution(p.WorkflowExecution),
+		ActivityId:                      stringPtr(p.ActivityId),
+		ActivityType:                    ToActivityType(p.ActivityType),
+		Input:                           p.Input,
+		ScheduledTimestamp:              common.Int64Ptr(p.ScheduledTimestamp),
+		ScheduleToCloseTimeoutSeconds:   common.Int32Ptr(p.ScheduleToCloseTimeoutSeconds),
+		StartedTimestamp:                common.Int64Ptr(p.StartedTimestamp),
+		StartToCloseTimeoutSeconds:      common.Int32Ptr(p.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:         common.Int32Ptr(p.HeartbeatTimeoutSeconds),
+		Attempt:                         common.Int32Ptr(p.Attempt),
+		ScheduledTimestampOfThisAttempt: common.Int64Ptr(p.ScheduledTimestampOfThisAttempt),
+		HeartbeatDetails:                p.HeartbeatDetails,
+		WorkflowType:                    ToWorkflowType(p.WorkflowType),
+		WorkflowDomain:                  stringPtr(p.WorkflowDomain),
+		Header:                          ToHeader(p.Header),
+		InputReference:                  ToPayloadReference(p.InputReference),
+	}
+}
+
+// FromRecordActivityTaskHeartbeatRequest converts thrift RecordActivityTaskHeartbeatRequest type to proto
+func FromRecordActivityTaskHeartbeatRequest(t *shared.RecordActivityTaskHeartbeatRequest) *apiv1.RecordActivityTaskHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatRequest{
+		TaskToken: t.TaskToken,
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRecordActivityTaskHeartbeatRequest converts proto RecordActivityTaskHeartbeatRequest type to thrift
+func ToRecordActivityTaskHeartbeatRequest(p *apiv1.RecordActivityTaskHeartbeatRequest) *shared.RecordActivityTaskHeartbeatRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatRequest{
+		TaskToken: p.TaskToken,
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromRecordActivityTaskHeartbeatByIDRequest converts thrift RecordActivityTaskHeartbeatByIDRequest type to proto
+func FromRecordActivityTaskHeartbeatByIDRequest(t *shared.RecordActivityTaskHeartbeatByIDRequest) *apiv1.RecordActivityTaskHeartbeatByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Details:    t.Details,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRecordActivityTaskHeartbeatByIDRequest converts proto RecordActivityTaskHeartbeatByIDRequest type to thrift
+func ToRecordActivityTaskHeartbeatByIDRequest(p *apiv1.RecordActivityTaskHeartbeatByIDRequest) *shared.RecordActivityTaskHeartbeatByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Details:    p.Details,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromRecordActivityTaskHeartbeatResponse converts thrift RecordActivityTaskHeartbeatResponse type to proto
+func FromRecordActivityTaskHeartbeatResponse(t *shared.RecordActivityTaskHeartbeatResponse) *apiv1.RecordActivityTaskHeartbeatResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: t.GetCancelRequested(),
+	}
+}
+
+// ToRecordActivityTaskHeartbeatResponse converts proto RecordActivityTaskHeartbeatResponse type to thrift
+func ToRecordActivityTaskHeartbeatResponse(p *apiv1.RecordActivityTaskHeartbeatResponse) *shared.RecordActivityTaskHeartbeatResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: common.BoolPtr(p.CancelRequested),
+	}
+}
+
+// FromRespondActivityTaskCompletedRequest converts thrift RespondActivityTaskCompletedRequest type to proto
+func FromRespondActivityTaskCompletedRequest(t *shared.RespondActivityTaskCompletedRequest) *apiv1.RespondActivityTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCompletedRequest{
+		TaskToken: t.TaskToken,
+		Result:    t.Result,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCompletedRequest converts proto RespondActivityTaskCompletedRequest type to thrift
+func ToRespondActivityTaskCompletedRequest(p *apiv1.RespondActivityTaskCompletedRequest) *shared.RespondActivityTaskCompletedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedRequest{
+		TaskToken: p.TaskToken,
+		Result:    p.Result,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskFailedRequest converts thrift RespondActivityTaskFailedRequest type to proto
+func FromRespondActivityTaskFailedRequest(t *shared.RespondActivityTaskFailedRequest) *apiv1.RespondActivityTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskFailedRequest{
+		TaskToken: t.TaskToken,
+		Reason:    t.GetReason(),
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskFailedRequest converts proto RespondActivityTaskFailedRequest type to thrift
+func ToRespondActivityTaskFailedRequest(p *apiv1.RespondActivityTaskFailedRequest) *shared.RespondActivityTaskFailedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedRequest{
+		TaskToken: p.TaskToken,
+		Reason:    stringPtr(p.Reason),
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskCanceledRequest converts thrift RespondActivityTaskCanceledRequest type to proto
+func FromRespondActivityTaskCanceledRequest(t *shared.RespondActivityTaskCanceledRequest) *apiv1.RespondActivityTaskCanceledRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCanceledRequest{
+		TaskToken: t.TaskToken,
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCanceledRequest converts proto RespondActivityTaskCanceledRequest type to thrift
+func ToRespondActivityTaskCanceledRequest(p *apiv1.RespondActivityTaskCanceledRequest) *shared.RespondActivityTaskCanceledRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledRequest{
+		TaskToken: p.TaskToken,
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskCompletedByIDRequest converts thrift RespondActivityTaskCompletedByIDRequest type to proto
+func FromRespondActivityTaskCompletedByIDRequest(t *shared.RespondActivityTaskCompletedByIDRequest) *apiv1.RespondActivityTaskCompletedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCompletedByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Result:     t.Result,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCompletedByIDRequest converts proto RespondActivityTaskCompletedByIDRequest type to thrift
+func ToRespondActivityTaskCompletedByIDRequest(p *apiv1.RespondActivityTaskCompletedByIDRequest) *shared.RespondActivityTaskCompletedByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Result:     p.Result,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskFailedByIDRequest converts thrift RespondActivityTaskFailedByIDRequest type to proto
+func FromRespondActivityTaskFailedByIDRequest(t *shared.RespondActivityTaskFailedByIDRequest) *apiv1.RespondActivityTaskFailedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskFailedByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Reason:     t.GetReason(),
+		Details:    t.Details,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskFailedByIDRequest converts proto RespondActivityTaskFailedByIDRequest type to thrift
+func ToRespondActivityTaskFailedByIDRequest(p *apiv1.RespondActivityTaskFailedByIDRequest) *shared.RespondActivityTaskFailedByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Reason:     stringPtr(p.Reason),
+		Details:    p.Details,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromRespondActivityTaskCanceledByIDRequest converts thrift RespondActivityTaskCanceledByIDRequest type to proto
+func FromRespondActivityTaskCanceledByIDRequest(t *shared.RespondActivityTaskCanceledByIDRequest) *apiv1.RespondActivityTaskCanceledByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCanceledByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Details:    t.Details,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCanceledByIDRequest converts proto RespondActivityTaskCanceledByIDRequest type to thrift
+func ToRespondActivityTaskCanceledByIDRequest(p *apiv1.RespondActivityTaskCanceledByIDRequest) *shared.RespondActivityTaskCanceledByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Details:    p.Details,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromRequestCancelWorkflowExecutionRequest converts thrift RequestCancelWorkflowExecutionRequest type to proto
+func FromRequestCancelWorkflowExecutionRequest(t *shared.RequestCancelWorkflowExecutionRequest) *apiv1.RequestCancelWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.GetIdentity(),
+		RequestId:         t.GetRequestId(),
+	}
+}
+
+// ToRequestCancelWorkflowExecutionRequest converts proto RequestCancelWorkflowExecutionRequest type to thrift
+func ToRequestCancelWorkflowExecutionRequest(p *apiv1.RequestCancelWorkflowExecutionRequest) *shared.RequestCancelWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		Identity:          stringPtr(p.Identity),
+		RequestId:         stringPtr(p.RequestId),
+	}
+}
+
+// FromGetWorkflowExecutionHistoryRequest converts thrift GetWorkflowExecutionHistoryRequest type to proto
+func FromGetWorkflowExecutionHistoryRequest(t *shared.GetWorkflowExecutionHistoryRequest) *apiv1.GetWorkflowExecutionHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionHistoryRequest{
+		Domain:                 t.GetDomain(),
+		Execution:              FromWorkflowExecution(t.Execution),
+		MaximumPageSize:        t.GetMaximumPageSize(),
+		NextPageToken:          t.NextPageToken,
+		WaitForNewEvent:        t.GetWaitForNewEvent(),
+		HistoryEventFilterType: FromHistoryEventFilterType(t.HistoryEventFilterType),
+		EventFilter:            FromHistoryEventFilter(t.EventFilter),
+		Reverse:                t.GetReverse(),
+	}
+}
+
+// ToGetWorkflowExecutionHistoryRequest converts proto GetWorkflowExecutionHistoryRequest type to thrift
+func ToGetWorkflowExecutionHistoryRequest(p *apiv1.GetWorkflowExecutionHistoryRequest) *shared.GetWorkflowExecutionHistoryRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:                 stringPtr(p.Domain),
+		Execution:              ToWorkflowExecution(p.Execution),
+		MaximumPageSize:        common.Int32Ptr(p.MaximumPageSize),
+		NextPageToken:          p.NextPageToken,
+		WaitForNewEvent:        common.BoolPtr(p.WaitForNewEvent),
+		HistoryEventFilterType: ToHistoryEventFilterType(p.HistoryEventFilterType),
+		EventFilter:            ToHistoryEventFilter(p.EventFilter),
+		Reverse:                common.BoolPtr(p.Reverse),
+	}
+}
+
+// FromHistoryEventFilter converts thrift HistoryEventFilter type to proto
+func FromHistoryEventFilter(t *shared.HistoryEventFilter) *apiv1.HistoryEventFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.HistoryEventFilter{
+		EventTypes:   fromEventTypeArray(t.EventTypes),
+		MinEventId:   t.GetMinEventId(),
+		MaxEventId:   t.GetMaxEventId(),
+		MinTimestamp: t.GetMinTimestamp(),
+		MaxTimestamp: t.GetMaxTimestamp(),
+		ActivityId:   t.GetActivityId(),
+		TimerId:      t.GetTimerId(),
+	}
+}
+
+// ToHistoryEventFilter converts proto HistoryEventFilter type to thrift
+func ToHistoryEventFilter(p *apiv1.HistoryEventFilter) *shared.HistoryEventFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.HistoryEventFilter{
+		EventTypes:   toEventTypeArray(p.EventTypes),
+		MinEventId:   common.Int64Ptr(p.MinEventId),
+		MaxEventId:   common.Int64Ptr(p.MaxEventId),
+		MinTimestamp: common.Int64Ptr(p.MinTimestamp),
+		MaxTimestamp: common.Int64Ptr(p.MaxTimestamp),
+		ActivityId:   stringPtr(p.ActivityId),
+		TimerId:      stringPtr(p.TimerId),
+	}
+}
+
+// FromGetWorkflowExecutionHistoryResponse converts thrift GetWorkflowExecutionHistoryResponse type to proto
+func FromGetWorkflowExecutionHistoryResponse(t *shared.GetWorkflowExecutionHistoryResponse) *apiv1.GetWorkflowExecutionHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionHistoryResponse{
+		History:       FromHistory(t.History),
+		NextPageToken: t.NextPageToken,
+		Archived:      t.GetArchived(),
+	}
+}
+
+// ToGetWorkflowExecutionHistoryResponse converts proto GetWorkflowExecutionHistoryResponse type to thrift
+func ToGetWorkflowExecutionHistoryResponse(p *apiv1.GetWorkflowExecutionHistoryResponse) *shared.GetWorkflowExecutionHistoryResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryResponse{
+		History:       ToHistory(p.History),
+		NextPageToken: p.NextPageToken,
+		Archived:      common.BoolPtr(p.Archived),
+	}
+}
+
+// FromSignalWorkflowExecutionRequest converts thrift SignalWorkflowExecutionRequest type to proto
+func FromSignalWorkflowExecutionRequest(t *shared.SignalWorkflowExecutionRequest) *apiv1.SignalWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalWorkflowExecutionRequest{
+		Domain:             t.GetDomain(),
+		WorkflowExecution:  FromWorkflowExecution(t.WorkflowExecution),
+		SignalName:         t.GetSignalName(),
+		Input:              t.Input,
+		Identity:           t.GetIdentity(),
+		RequestId:          t.GetRequestId(),
+		Control:            t.Control,
+		DeliverAtTimestamp: t.GetDeliverAtTimestamp(),
+	}
+}
+
+// ToSignalWorkflowExecutionRequest converts proto SignalWorkflowExecutionRequest type to thrift
+func ToSignalWorkflowExecutionRequest(p *apiv1.SignalWorkflowExecutionRequest) *shared.SignalWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalWorkflowExecutionRequest{
+		Domain:             stringPtr(p.Domain),
+		WorkflowExecution:  ToWorkflowExecution(p.WorkflowExecution),
+		SignalName:         stringPtr(p.SignalName),
+		Input:              p.Input,
+		Identity:           stringPtr(p.Identity),
+		RequestId:          stringPtr(p.RequestId),
+		Control:            p.Control,
+		DeliverAtTimestamp: common.Int64Ptr(p.DeliverAtTimestamp),
+	}
+}
+
+// FromSignalWithStartWorkflowExecutionRequest converts thrift SignalWithStartWorkflowExecutionRequest type to proto
+func FromSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflowExecutionRequest) *apiv1.SignalWithStartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		Identity:                            t.GetIdentity(),
+		RequestId:                           t.GetRequestId(),
+		WorkflowIdReusePolicy:               FromWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		SignalName:                          t.GetSignalName(),
+		SignalInput:                         t.SignalInput,
+		Control:                             t.Control,
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+		Memo:                                FromMemo(t.Memo),
+		Header:                              FromHeader(t.Header),
+		RetentionPeriodInDays:               t.GetRetentionPeriodInDays(),
+		DelayStartSeconds:                   t.GetDelayStartSeconds(),
+		WorkflowExecutionTimeoutSeconds:     t.GetWorkflowExecutionTimeoutSeconds(),
+	}
+}
+
+// ToSignalWithStartWorkflowExecutionRequest converts proto SignalWithStartWorkflowExecutionRequest type to thrift
+func ToSignalWithStartWorkflowExecutionRequest(p *apiv1.SignalWithStartWorkflowExecutionRequest) *shared.SignalWithStartWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        ToWorkflowType(p.WorkflowType),
+		TaskList:                            ToTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		Identity:                            stringPtr(p.Identity),
+		RequestId:                           stringPtr(p.RequestId),
+		WorkflowIdReusePolicy:               ToWorkflowIdReusePolicy(p.WorkflowIdReusePolicy),
+		SignalName:                          stringPtr(p.SignalName),
+		SignalInput:                         p.SignalInput,
+		Control:                             p.Control,
+		RetryPolicy:                         ToRetryPolicy(p.RetryPolicy),
+		CronSchedule:                        stringPtr(p.CronSchedule),
+		Memo:                                ToMemo(p.Memo),
+		Header:                              ToHeader(p.Header),
+		RetentionPeriodInDays:               common.Int32Ptr(p.RetentionPeriodInDays),
+		DelayStartSeconds:                   common.Int32Ptr(p.DelayStartSeconds),
+		WorkflowExecutionTimeoutSeconds:     common.Int32Ptr(p.WorkflowExecutionTimeoutSeconds),
+	}
+}
+
+// FromTerminateWorkflowExecutionRequest converts thrift TerminateWorkflowExecutionRequest type to proto
+func FromTerminateWorkflowExecutionRequest(t *shared.TerminateWorkflowExecutionRequest) *apiv1.TerminateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TerminateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Details:           t.Details,
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToTerminateWorkflowExecutionRequest converts proto TerminateWorkflowExecutionRequest type to thrift
+func ToTerminateWorkflowExecutionRequest(p *apiv1.TerminateWorkflowExecutionRequest) *shared.TerminateWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.TerminateWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		Reason:            stringPtr(p.Reason),
+		Details:           p.Details,
+		Identity:          stringPtr(p.Identity),
+	}
+}
+
+// FromResetWorkflowExecutionRequest converts thrift ResetWorkflowExecutionRequest type to proto
+func FromResetWorkflowExecutionRequest(t *shared.ResetWorkflowExecutionRequest) *apiv1.ResetWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetWorkflowExecutionRequest{
+		Domain:                t.GetDomain(),
+		WorkflowExecution:     FromWorkflowExecution(t.WorkflowExecution),
+		Reason:                t.GetReason(),
+		DecisionFinishEventId: t.GetDecisionFinishEventId(),
+		RequestId:             t.GetRequestId(),
+	}
+}
+
+// ToResetWorkflowExecutionRequest converts proto ResetWorkflowExecutionRequest type to thrift
+func ToResetWorkflowExecutionRequest(p *apiv1.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetWorkflowExecutionRequest{
+		Domain:                stringPtr(p.Domain),
+		WorkflowExecution:     ToWorkflowExecution(p.WorkflowExecution),
+		Reason:                stringPtr(p.Reason),
+		DecisionFinishEventId: common.Int64Ptr(p.DecisionFinishEventId),
+		RequestId:             stringPtr(p.RequestId),
+	}
+}
+
+// FromResetWorkflowExecutionResponse converts thrift ResetWorkflowExecutionResponse type to proto
+func FromResetWorkflowExecutionResponse(t *shared.ResetWorkflowExecutionResponse) *apiv1.ResetWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetWorkflowExecutionResponse{
+		RunId: t.GetRunId(),
+	}
+}
+
+// ToResetWorkflowExecutionResponse converts proto ResetWorkflowExecutionResponse type to thrift
+func ToResetWorkflowExecutionResponse(p *apiv1.ResetWorkflowExecutionResponse) *shared.ResetWorkflowExecutionResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetWorkflowExecutionResponse{
+		RunId: stringPtr(p.RunId),
+	}
+}
+
+// FromListOpenWorkflowExecutionsRequest converts thrift ListOpenWorkflowExecutionsRequest type to proto
+func FromListOpenWorkflowExecutionsRequest(t *shared.ListOpenWorkflowExecutionsRequest) *apiv1.ListOpenWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListOpenWorkflowExecutionsRequest{
+		Domain:          t.GetDomain(),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.NextPageToken,
+		StartTimeFilter: FromStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: FromWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      FromWorkflowTypeFilter(t.TypeFilter),
+	}
+}
+
+// ToListOpenWorkflowExecutionsRequest converts proto ListOpenWorkflowExecutionsRequest type to thrift
+func ToListOpenWorkflowExecutionsRequest(p *apiv1.ListOpenWorkflowExecutionsRequest) *shared.ListOpenWorkflowExecutionsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsRequest{
+		Domain:          stringPtr(p.Domain),
+		MaximumPageSize: common.Int32Ptr(p.MaximumPageSize),
+		NextPageToken:   p.NextPageToken,
+		StartTimeFilter: ToStartTimeFilter(p.StartTimeFilter),
+		ExecutionFilter: ToWorkflowExecutionFilter(p.ExecutionFilter),
+		TypeFilter:      ToWorkflowTypeFilter(p.TypeFilter),
+	}
+}
+
+// FromListOpenWorkflowExecutionsResponse converts thrift ListOpenWorkflowExecutionsResponse type to proto
+func FromListOpenWorkflowExecutionsResponse(t *shared.ListOpenWorkflowExecutionsResponse) *apiv1.ListOpenWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListOpenWorkflowExecutionsResponse{
+		Executions:    fromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListOpenWorkflowExecutionsResponse converts proto ListOpenWorkflowExecutionsResponse type to thrift
+func ToListOpenWorkflowExecutionsResponse(p *apiv1.ListOpenWorkflowExecutionsResponse) *shared.ListOpenWorkflowExecutionsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsResponse{
+		Executions:    toWorkflowExecutionInfoArray(p.Executions),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromListClosedWorkflowExecutionsRequest converts thrift ListClosedWorkflowExecutionsRequest type to proto
+func FromListClosedWorkflowExecutionsRequest(t *shared.ListClosedWorkflowExecutionsRequest) *apiv1.ListClosedWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListClosedWorkflowExecutionsRequest{
+		Domain:          t.GetDomain(),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.NextPageToken,
+		StartTimeFilter: FromStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: FromWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      FromWorkflowTypeFilter(t.TypeFilter),
+		StatusFilter:    FromWorkflowExecutionCloseStatus(t.StatusFilter),
+	}
+}
+
+// ToListClosedWorkflowExecutionsRequest converts proto ListClosedWorkflowExecutionsRequest type to thrift
+func ToListClosedWorkflowExecutionsRequest(p *apiv1.ListClosedWorkflowExecutionsRequest) *shared.ListClosedWorkflowExecutionsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsRequest{
+		Domain:          stringPtr(p.Domain),
+		MaximumPageSize: common.Int32Ptr(p.MaximumPageSize),
+		NextPageToken:   p.NextPageToken,
+		StartTimeFilter: ToStartTimeFilter(p.StartTimeFilter),
+		ExecutionFilter: ToWorkflowExecutionFilter(p.ExecutionFilter),
+		TypeFilter:      ToWorkflowTypeFilter(p.TypeFilter),
+		StatusFilter:    ToWorkflowExecutionCloseStatus(p.StatusFilter),
+	}
+}
+
+// FromListClosedWorkflowExecutionsResponse converts thrift ListClosedWorkflowExecutionsResponse type to proto
+func FromListClosedWorkflowExecutionsResponse(t *shared.ListClosedWorkflowExecutionsResponse) *apiv1.ListClosedWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListClosedWorkflowExecutionsResponse{
+		Executions:    fromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListClosedWorkflowExecutionsResponse converts proto ListClosedWorkflowExecutionsResponse type to thrift
+func ToListClosedWorkflowExecutionsResponse(p *apiv1.ListClosedWorkflowExecutionsResponse) *shared.ListClosedWorkflowExecutionsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsResponse{
+		Executions:    toWorkflowExecutionInfoArray(p.Executions),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromListWorkflowExecutionsRequest converts thrift ListWorkflowExecutionsRequest type to proto
+func FromListWorkflowExecutionsRequest(t *shared.ListWorkflowExecutionsRequest) *apiv1.ListWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListWorkflowExecutionsRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+		Query:         t.GetQuery(),
+	}
+}
+
+// ToListWorkflowExecutionsRequest converts proto ListWorkflowExecutionsRequest type to thrift
+func ToListWorkflowExecutionsRequest(p *apiv1.ListWorkflowExecutionsRequest) *shared.ListWorkflowExecutionsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListWorkflowExecutionsRequest{
+		Domain:        stringPtr(p.Domain),
+		PageSize:      common.Int32Ptr(p.PageSize),
+		NextPageToken: p.NextPageToken,
+		Query:         stringPtr(p.Query),
+	}
+}
+
+// FromListWorkflowExecutionsResponse converts thrift ListWorkflowExecutionsResponse type to proto
+func FromListWorkflowExecutionsResponse(t *shared.ListWorkflowExecutionsResponse) *apiv1.ListWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListWorkflowExecutionsResponse{
+		Executions:    fromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListWorkflowExecutionsResponse converts proto ListWorkflowExecutionsResponse type to thrift
+func ToListWorkflowExecutionsResponse(p *apiv1.ListWorkflowExecutionsResponse) *shared.ListWorkflowExecutionsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListWorkflowExecutionsResponse{
+		Executions:    toWorkflowExecutionInfoArray(p.Executions),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// FromCountWorkflowExecutionsRequest converts thrift CountWorkflowExecutionsRequest type to proto
+func FromCountWorkflowExecutionsRequest(t *shared.CountWorkflowExecutionsRequest) *apiv1.CountWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CountWorkflowExecutionsRequest{
+		Domain: t.GetDomain(),
+		Query:  t.GetQuery(),
+	}
+}
+
+// ToCountWorkflowExecutionsRequest converts proto CountWorkflowExecutionsRequest type to thrift
+func ToCountWorkflowExecutionsRequest(p *apiv1.CountWorkflowExecutionsRequest) *shared.CountWorkflowExecutionsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsRequest{
+		Domain: stringPtr(p.Domain),
+		Query:  stringPtr(p.Query),
+	}
+}
+
+// FromCountWorkflowExecutionsResponse converts thrift CountWorkflowExecutionsResponse type to proto
+func FromCountWorkflowExecutionsResponse(t *shared.CountWorkflowExecutionsResponse) *apiv1.CountWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CountWorkflowExecutionsResponse{
+		Count: t.GetCount(),
+	}
+}
+
+// ToCountWorkflowExecutionsResponse converts proto CountWorkflowExecutionsResponse type to thrift
+func ToCountWorkflowExecutionsResponse(p *apiv1.CountWorkflowExecutionsResponse) *shared.CountWorkflowExecutionsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsResponse{
+		Count: common.Int64Ptr(p.Count),
+	}
+}
+
+// FromQueryWorkflowRequest converts thrift QueryWorkflowRequest type to proto
+func FromQueryWorkflowRequest(t *shared.QueryWorkflowRequest) *apiv1.QueryWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryWorkflowRequest{
+		Domain:    t.GetDomain(),
+		Execution: FromWorkflowExecution(t.Execution),
+		Query:     FromWorkflowQuery(t.Query),
+	}
+}
+
+// ToQueryWorkflowRequest converts proto QueryWorkflowRequest type to thrift
+func ToQueryWorkflowRequest(p *apiv1.QueryWorkflowRequest) *shared.QueryWorkflowRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowRequest{
+		Domain:    stringPtr(p.Domain),
+		Execution: ToWorkflowExecution(p.Execution),
+		Query:     ToWorkflowQuery(p.Query),
+	}
+}
+
+// FromQueryWorkflowResponse converts thrift QueryWorkflowResponse type to proto
+func FromQueryWorkflowResponse(t *shared.QueryWorkflowResponse) *apiv1.QueryWorkflowResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryWorkflowResponse{
+		QueryResult: t.QueryResult,
+	}
+}
+
+// ToQueryWorkflowResponse converts proto QueryWorkflowResponse type to thrift
+func ToQueryWorkflowResponse(p *apiv1.QueryWorkflowResponse) *shared.QueryWorkflowResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowResponse{
+		QueryResult: p.QueryResult,
+	}
+}
+
+// FromWorkflowQuery converts thrift WorkflowQuery type to proto
+func FromWorkflowQuery(t *shared.WorkflowQuery) *apiv1.WorkflowQuery {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowQuery{
+		QueryType: t.GetQueryType(),
+		QueryArgs: t.QueryArgs,
+	}
+}
+
+// ToWorkflowQuery converts proto WorkflowQuery type to thrift
+func ToWorkflowQuery(p *apiv1.WorkflowQuery) *shared.WorkflowQuery {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowQuery{
+		QueryType: stringPtr(p.QueryType),
+		QueryArgs: p.QueryArgs,
+	}
+}
+
+// FromWorkflowUpdate converts thrift WorkflowUpdate type to proto
+func FromWorkflowUpdate(t *shared.WorkflowUpdate) *apiv1.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowUpdate{
+		UpdateName: t.GetUpdateName(),
+		Input:      t.Input,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToWorkflowUpdate converts proto WorkflowUpdate type to thrift
+func ToWorkflowUpdate(p *apiv1.WorkflowUpdate) *shared.WorkflowUpdate {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowUpdate{
+		UpdateName: stringPtr(p.UpdateName),
+		Input:      p.Input,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// FromWorkflowUpdateResult converts thrift WorkflowUpdateResult type to proto
+func FromWorkflowUpdateResult(t *shared.WorkflowUpdateResult) *apiv1.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowUpdateResult{
+		ResultType:   FromWorkflowUpdateResultType(t.ResultType),
+		Result:       t.Result,
+		RejectReason: t.GetRejectReason(),
+	}
+}
+
+// ToWorkflowUpdateResult converts proto WorkflowUpdateResult type to thrift
+func ToWorkflowUpdateResult(p *apiv1.WorkflowUpdateResult) *shared.WorkflowUpdateResult {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowUpdateResult{
+		ResultType:   ToWorkflowUpdateResultType(p.ResultType),
+		Result:       p.Result,
+		RejectReason: stringPtr(p.RejectReason),
+	}
+}
+
+// FromUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to proto
+func FromUpdateWorkflowExecutionRequest(t *shared.UpdateWorkflowExecutionRequest) *apiv1.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		UpdateName:        t.GetUpdateName(),
+		Input:             t.Input,
+		Identity:          t.GetIdentity(),
+		RequestId:         t.GetRequestId(),
+		TimeoutSeconds:    t.GetTimeoutSeconds(),
+	}
+}
+
+// ToUpdateWorkflowExecutionRequest converts proto UpdateWorkflowExecutionRequest type to thrift
+func ToUpdateWorkflowExecutionRequest(p *apiv1.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: ToWorkflowExecution(p.WorkflowExecution),
+		UpdateName:        stringPtr(p.UpdateName),
+		Input:             p.Input,
+		Identity:          stringPtr(p.Identity),
+		RequestId:         stringPtr(p.RequestId),
+		TimeoutSeconds:    common.Int32Ptr(p.TimeoutSeconds),
+	}
+}
+
+// FromUpdateWorkflowExecutionResponse converts thrift UpdateWorkflowExecutionResponse type to proto
+func FromUpdateWorkflowExecutionResponse(t *shared.UpdateWorkflowExecutionResponse) *apiv1.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateWorkflowExecutionResponse{
+		RunId:        t.GetRunId(),
+		UpdateResult: FromWorkflowUpdateResult(t.UpdateResult),
+	}
+}
+
+// ToUpdateWorkflowExecutionResponse converts proto UpdateWorkflowExecutionResponse type to thrift
+func ToUpdateWorkflowExecutionResponse(p *apiv1.UpdateWorkflowExecutionResponse) *shared.UpdateWorkflowExecutionResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionResponse{
+		RunId:        stringPtr(p.RunId),
+		UpdateResult: ToWorkflowUpdateResult(p.UpdateResult),
+	}
+}
+
+// FromResetStickyTaskListRequest converts thrift ResetStickyTaskListRequest type to proto
+func FromResetStickyTaskListRequest(t *shared.ResetStickyTaskListRequest) *apiv1.ResetStickyTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetStickyTaskListRequest{
+		Domain:    t.GetDomain(),
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToResetStickyTaskListRequest converts proto ResetStickyTaskListRequest type to thrift
+func ToResetStickyTaskListRequest(p *apiv1.ResetStickyTaskListRequest) *shared.ResetStickyTaskListRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetStickyTaskListRequest{
+		Domain:    stringPtr(p.Domain),
+		Execution: ToWorkflowExecution(p.Execution),
+	}
+}
+
+// FromResetStickyTaskListResponse converts thrift ResetStickyTaskListResponse type to proto
+func FromResetStickyTaskListResponse(t *shared.ResetStickyTaskListResponse) *apiv1.ResetStickyTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetStickyTaskListResponse{}
+}
+
+// ToResetStickyTaskListResponse converts proto ResetStickyTaskListResponse type to thrift
+func ToResetStickyTaskListResponse(p *apiv1.ResetStickyTaskListResponse) *shared.ResetStickyTaskListResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetStickyTaskListResponse{}
+}
+
+// FromRespondQueryTaskCompletedRequest converts thrift RespondQueryTaskCompletedRequest type to proto
+func FromRespondQueryTaskCompletedRequest(t *shared.RespondQueryTaskCompletedRequest) *apiv1.RespondQueryTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondQueryTaskCompletedRequest{
+		TaskToken:     t.TaskToken,
+		CompletedType: FromQueryTaskCompletedType(t.CompletedType),
+		QueryResult:   t.QueryResult,
+		ErrorMessage:  t.GetErrorMessage(),
+	}
+}
+
+// ToRespondQueryTaskCompletedRequest converts proto RespondQueryTaskCompletedRequest type to thrift
+func ToRespondQueryTaskCompletedRequest(p *apiv1.RespondQueryTaskCompletedRequest) *shared.RespondQueryTaskCompletedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondQueryTaskCompletedRequest{
+		TaskToken:     p.TaskToken,
+		CompletedType: ToQueryTaskCompletedType(p.CompletedType),
+		QueryResult:   p.QueryResult,
+		ErrorMessage:  stringPtr(p.ErrorMessage),
+	}
+}
+
+// FromDescribeWorkflowExecutionRequest converts thrift DescribeWorkflowExecutionRequest type to proto
+func FromDescribeWorkflowExecutionRequest(t *shared.DescribeWorkflowExecutionRequest) *apiv1.DescribeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeWorkflowExecutionRequest{
+		Domain:    t.GetDomain(),
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToDescribeWorkflowExecutionRequest converts proto DescribeWorkflowExecutionRequest type to thrift
+func ToDescribeWorkflowExecutionRequest(p *apiv1.DescribeWorkflowExecutionRequest) *shared.DescribeWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionRequest{
+		Domain:    stringPtr(p.Domain),
+		Execution: ToWorkflowExecution(p.Execution),
+	}
+}
+
+// FromPendingActivityInfo converts thrift PendingActivityInfo type to proto
+func FromPendingActivityInfo(t *shared.PendingActivityInfo) *apiv1.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PendingActivityInfo{
+		ActivityId:             t.GetActivityID(),
+		ActivityType:           FromActivityType(t.ActivityType),
+		State:                  FromPendingActivityState(t.State),
+		HeartbeatDetails:       t.HeartbeatDetails,
+		LastHeartbeatTimestamp: t.GetLastHeartbeatTimestamp(),
+		LastStartedTimestamp:   t.GetLastStartedTimestamp(),
+		Attempt:                t.GetAttempt(),
+		MaximumAttempts:        t.GetMaximumAttempts(),
+		ScheduledTimestamp:     t.GetScheduledTimestamp(),
+		ExpirationTimestamp:    t.GetExpirationTimestamp(),
+	}
+}
+
+// ToPendingActivityInfo converts proto PendingActivityInfo type to thrift
+func ToPendingActivityInfo(p *apiv1.PendingActivityInfo) *shared.PendingActivityInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.PendingActivityInfo{
+		ActivityID:             stringPtr(p.ActivityId),
+		ActivityType:           ToActivityType(p.ActivityType),
+		State:                  ToPendingActivityState(p.State),
+		HeartbeatDetails:       p.HeartbeatDetails,
+		LastHeartbeatTimestamp: common.Int64Ptr(p.LastHeartbeatTimestamp),
+		LastStartedTimestamp:   common.Int64Ptr(p.LastStartedTimestamp),
+		Attempt:                common.Int32Ptr(p.Attempt),
+		MaximumAttempts:        common.Int32Ptr(p.MaximumAttempts),
+		ScheduledTimestamp:     common.Int64Ptr(p.ScheduledTimestamp),
+		ExpirationTimestamp:    common.Int64Ptr(p.ExpirationTimestamp),
+	}
+}
+
+// FromDescribeWorkflowExecutionResponse converts thrift DescribeWorkflowExecutionResponse type to proto
+func FromDescribeWorkflowExecutionResponse(t *shared.DescribeWorkflowExecutionResponse) *apiv1.DescribeWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: FromWorkflowExecutionConfiguration(t.ExecutionConfiguration),
+		WorkflowExecutionInfo:  FromWorkflowExecutionInfo(t.WorkflowExecutionInfo),
+		PendingActivities:      fromPendingActivityInfoArray(t.PendingActivities),
+	}
+}
+
+// ToDescribeWorkflowExecutionResponse converts proto DescribeWorkflowExecutionResponse type to thrift
+func ToDescribeWorkflowExecutionResponse(p *apiv1.DescribeWorkflowExecutionResponse) *shared.DescribeWorkflowExecutionResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: ToWorkflowExecutionConfiguration(p.ExecutionConfiguration),
+		WorkflowExecutionInfo:  ToWorkflowExecutionInfo(p.WorkflowExecutionInfo),
+		PendingActivities:      toPendingActivityInfoArray(p.PendingActivities),
+	}
+}
+
+// FromDescribeTaskListRequest converts thrift DescribeTaskListRequest type to proto
+func FromDescribeTaskListRequest(t *shared.DescribeTaskListRequest) *apiv1.DescribeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeTaskListRequest{
+		Domain:                t.GetDomain(),
+		TaskList:              FromTaskList(t.TaskList),
+		TaskListType:          FromTaskListType(t.TaskListType),
+		IncludeTaskListStatus: t.GetIncludeTaskListStatus(),
+	}
+}
+
+// ToDescribeTaskListRequest converts proto DescribeTaskListRequest type to thrift
+func ToDescribeTaskListRequest(p *apiv1.DescribeTaskListRequest) *shared.DescribeTaskListRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListRequest{
+		Domain:                stringPtr(p.Domain),
+		TaskList:              ToTaskList(p.TaskList),
+		TaskListType:          ToTaskListType(p.TaskListType),
+		IncludeTaskListStatus: common.BoolPtr(p.IncludeTaskListStatus),
+	}
+}
+
+// FromDescribeTaskListResponse converts thrift DescribeTaskListResponse type to proto
+func FromDescribeTaskListResponse(t *shared.DescribeTaskListResponse) *apiv1.DescribeTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeTaskListResponse{
+		Pollers:        fromPollerInfoArray(t.Pollers),
+		TaskListStatus: FromTaskListStatus(t.TaskListStatus),
+	}
+}
+
+// ToDescribeTaskListResponse converts proto DescribeTaskListResponse type to thrift
+func ToDescribeTaskListResponse(p *apiv1.DescribeTaskListResponse) *shared.DescribeTaskListResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListResponse{
+		Pollers:        toPollerInfoArray(p.Pollers),
+		TaskListStatus: ToTaskListStatus(p.TaskListStatus),
+	}
+}
+
+// FromTaskListStatus converts thrift TaskListStatus type to proto
+func FromTaskListStatus(t *shared.TaskListStatus) *apiv1.TaskListStatus {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListStatus{
+		BacklogCountHint:             t.GetBacklogCountHint(),
+		ReadLevel:                    t.GetReadLevel(),
+		AckLevel:                     t.GetAckLevel(),
+		RatePerSecond:                t.GetRatePerSecond(),
+		TaskIdBlock:                  FromTaskIDBlock(t.TaskIDBlock),
+		OldestUnackedTaskAgeInMillis: t.GetOldestUnackedTaskAgeInMillis(),
+		ScheduleToStartLatency:       FromTaskListLatencyStats(t.ScheduleToStartLatency),
+		SyncMatchCount:               t.GetSyncMatchCount(),
+		BacklogMatchCount:            t.GetBacklogMatchCount(),
+	}
+}
+
+// ToTaskListStatus converts proto TaskListStatus type to thrift
+func ToTaskListStatus(p *apiv1.TaskListStatus) *shared.TaskListStatus {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskListStatus{
+		BacklogCountHint:             common.Int64Ptr(p.BacklogCountHint),
+		ReadLevel:                    common.Int64Ptr(p.ReadLevel),
+		AckLevel:                     common.Int64Ptr(p.AckLevel),
+		RatePerSecond:                common.Float64Ptr(p.RatePerSecond),
+		TaskIDBlock:                  ToTaskIDBlock(p.TaskIdBlock),
+		OldestUnackedTaskAgeInMillis: common.Int64Ptr(p.OldestUnackedTaskAgeInMillis),
+		ScheduleToStartLatency:       ToTaskListLatencyStats(p.ScheduleToStartLatency),
+		SyncMatchCount:               common.Int64Ptr(p.SyncMatchCount),
+		BacklogMatchCount:            common.Int64Ptr(p.BacklogMatchCount),
+	}
+}
+
+// FromTaskListLatencyStats converts thrift TaskListLatencyStats type to proto
+func FromTaskListLatencyStats(t *shared.TaskListLatencyStats) *apiv1.TaskListLatencyStats {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListLatencyStats{
+		SampleCount: t.GetSampleCount(),
+		P50InMillis: t.GetP50InMillis(),
+		P95InMillis: t.GetP95InMillis(),
+		P99InMillis: t.GetP99InMillis(),
+		MaxInMillis: t.GetMaxInMillis(),
+	}
+}
+
+// ToTaskListLatencyStats converts proto TaskListLatencyStats type to thrift
+func ToTaskListLatencyStats(p *apiv1.TaskListLatencyStats) *shared.TaskListLatencyStats {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskListLatencyStats{
+		SampleCount: common.Int64Ptr(p.SampleCount),
+		P50InMillis: common.Int64Ptr(p.P50InMillis),
+		P95InMillis: common.Int64Ptr(p.P95InMillis),
+		P99InMillis: common.Int64Ptr(p.P99InMillis),
+		MaxInMillis: common.Int64Ptr(p.MaxInMillis),
+	}
+}
+
+// FromTaskIDBlock converts thrift TaskIDBlock type to proto
+func FromTaskIDBlock(t *shared.TaskIDBlock) *apiv1.TaskIDBlock {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskIDBlock{
+		StartId: t.GetStartID(),
+		EndId:   t.GetEndID(),
+	}
+}
+
+// ToTaskIDBlock converts proto TaskIDBlock type to thrift
+func ToTaskIDBlock(p *apiv1.TaskIDBlock) *shared.TaskIDBlock {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskIDBlock{
+		StartID: common.Int64Ptr(p.StartId),
+		EndID:   common.Int64Ptr(p.EndId),
+	}
+}
+
+// FromPollerInfo converts thrift PollerInfo type to proto
+func FromPollerInfo(t *shared.PollerInfo) *apiv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollerInfo{
+		LastAccessTime: t.GetLastAccessTime(),
+		Identity:       t.GetIdentity(),
+		RatePerSecond:  t.GetRatePerSecond(),
+	}
+}
+
+// ToPollerInfo converts proto PollerInfo type to thrift
+func ToPollerInfo(p *apiv1.PollerInfo) *shared.PollerInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollerInfo{
+		LastAccessTime: common.Int64Ptr(p.LastAccessTime),
+		Identity:       stringPtr(p.Identity),
+		RatePerSecond:  common.Float64Ptr(p.RatePerSecond),
+	}
+}
+
+// FromRetryPolicy converts thrift RetryPolicy type to proto
+func FromRetryPolicy(t *shared.RetryPolicy) *apiv1.RetryPolicy {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RetryPolicy{
+		InitialIntervalInSeconds:    t.GetInitialIntervalInSeconds(),
+		BackoffCoefficient:          t.GetBackoffCoefficient(),
+		MaximumIntervalInSeconds:    t.GetMaximumIntervalInSeconds(),
+		MaximumAttempts:             t.GetMaximumAttempts(),
+		NonRetriableErrorReasons:    t.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: t.GetExpirationIntervalInSeconds(),
+	}
+}
+
+// ToRetryPolicy converts proto RetryPolicy type to thrift
+func ToRetryPolicy(p *apiv1.RetryPolicy) *shared.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &shared.RetryPolicy{
+		InitialIntervalInSeconds:    common.Int32Ptr(p.InitialIntervalInSeconds),
+		BackoffCoefficient:          common.Float64Ptr(p.BackoffCoefficient),
+		MaximumIntervalInSeconds:    common.Int32Ptr(p.MaximumIntervalInSeconds),
+		MaximumAttempts:             common.Int32Ptr(p.MaximumAttempts),
+		NonRetriableErrorReasons:    p.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: common.Int32Ptr(p.ExpirationIntervalInSeconds),
+	}
+}
+
+func fromBadBinaryInfoMap(t map[string]*shared.BadBinaryInfo) map[string]*apiv1.BadBinaryInfo {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*apiv1.BadBinaryInfo, len(t))
+	for key := range t {
+		v[key] = FromBadBinaryInfo(t[key])
+	}
+	return v
+}
+
+func toBadBinaryInfoMap(p map[string]*apiv1.BadBinaryInfo) map[string]*shared.BadBinaryInfo {
+	if p == nil {
+		return nil
+	}
+	v := make(map[string]*shared.BadBinaryInfo, len(p))
+	for key := range p {
+		v[key] = ToBadBinaryInfo(p[key])
+	}
+	return v
+}
+
+func fromClusterReplicationConfigurationArray(t []*shared.ClusterReplicationConfiguration) []*apiv1.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ClusterReplicationConfiguration, len(t))
+	for i := range t {
+		v[i] = FromClusterReplicationConfiguration(t[i])
+	}
+	return v
+}
+
+func toClusterReplicationConfigurationArray(p []*apiv1.ClusterReplicationConfiguration) []*shared.ClusterReplicationConfiguration {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.ClusterReplicationConfiguration, len(p))
+	for i := range p {
+		v[i] = ToClusterReplicationConfiguration(p[i])
+	}
+	return v
+}
+
+func fromDecisionArray(t []*shared.Decision) []*apiv1.Decision {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.Decision, len(t))
+	for i := range t {
+		v[i] = FromDecision(t[i])
+	}
+	return v
+}
+
+func toDecisionArray(p []*apiv1.Decision) []*shared.Decision {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.Decision, len(p))
+	for i := range p {
+		v[i] = ToDecision(p[i])
+	}
+	return v
+}
+
+func fromDescribeDomainResponseArray(t []*shared.DescribeDomainResponse) []*apiv1.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.DescribeDomainResponse, len(t))
+	for i := range t {
+		v[i] = FromDescribeDomainResponse(t[i])
+	}
+	return v
+}
+
+func toDescribeDomainResponseArray(p []*apiv1.DescribeDomainResponse) []*shared.DescribeDomainResponse {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.DescribeDomainResponse, len(p))
+	for i := range p {
+		v[i] = ToDescribeDomainResponse(p[i])
+	}
+	return v
+}
+
+func fromEventTypeArray(t []shared.EventType) []apiv1.EventType {
+	if t == nil {
+		return nil
+	}
+	v := make([]apiv1.EventType, len(t))
+	for i := range t {
+		v[i] = FromEventType(&t[i])
+	}
+	return v
+}
+
+func toEventTypeArray(p []apiv1.EventType) []shared.EventType {
+	if p == nil {
+		return nil
+	}
+	v := make([]shared.EventType, len(p))
+	for i := range p {
+		v[i] = *ToEventType(p[i])
+	}
+	return v
+}
+
+func fromHistoryEventArray(t []*shared.HistoryEvent) []*apiv1.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.HistoryEvent, len(t))
+	for i := range t {
+		v[i] = FromHistoryEvent(t[i])
+	}
+	return v
+}
+
+func toHistoryEventArray(p []*apiv1.HistoryEvent) []*shared.HistoryEvent {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.HistoryEvent, len(p))
+	for i := range p {
+		v[i] = ToHistoryEvent(p[i])
+	}
+	return v
+}
+
+func fromPendingActivityInfoArray(t []*shared.PendingActivityInfo) []*apiv1.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PendingActivityInfo, len(t))
+	for i := range t {
+		v[i] = FromPendingActivityInfo(t[i])
+	}
+	return v
+}
+
+func toPendingActivityInfoArray(p []*apiv1.PendingActivityInfo) []*shared.PendingActivityInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.PendingActivityInfo, len(p))
+	for i := range p {
+		v[i] = ToPendingActivityInfo(p[i])
+	}
+	return v
+}
+
+func fromPollerInfoArray(t []*shared.PollerInfo) []*apiv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PollerInfo, len(t))
+	for i := range t {
+		v[i] = FromPollerInfo(t[i])
+	}
+	return v
+}
+
+func toPollerInfoArray(p []*apiv1.PollerInfo) []*shared.PollerInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.PollerInfo, len(p))
+	for i := range p {
+		v[i] = ToPollerInfo(p[i])
+	}
+	return v
+}
+
+func fromResetPointInfoArray(t []*shared.ResetPointInfo) []*apiv1.ResetPointInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ResetPointInfo, len(t))
+	for i := range t {
+		v[i] = FromResetPointInfo(t[i])
+	}
+	return v
+}
+
+func toResetPointInfoArray(p []*apiv1.ResetPointInfo) []*shared.ResetPointInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.ResetPointInfo, len(p))
+	for i := range p {
+		v[i] = ToResetPointInfo(p[i])
+	}
+	return v
+}
+
+func fromStartWorkflowExecutionBatchResultArray(t []*shared.StartWorkflowExecutionBatchResult) []*apiv1.StartWorkflowExecutionBatchResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.StartWorkflowExecutionBatchResult, len(t))
+	for i := range t {
+		v[i] = FromStartWorkflowExecutionBatchResult(t[i])
+	}
+	return v
+}
+
+func toStartWorkflowExecutionBatchResultArray(p []*apiv1.StartWorkflowExecutionBatchResult) []*shared.StartWorkflowExecutionBatchResult {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.StartWorkflowExecutionBatchResult, len(p))
+	for i := range p {
+		v[i] = ToStartWorkflowExecutionBatchResult(p[i])
+	}
+	return v
+}
+
+func fromStartWorkflowExecutionRequestArray(t []*shared.StartWorkflowExecutionRequest) []*apiv1.StartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.StartWorkflowExecutionRequest, len(t))
+	for i := range t {
+		v[i] = FromStartWorkflowExecutionRequest(t[i])
+	}
+	return v
+}
+
+func toStartWorkflowExecutionRequestArray(p []*apiv1.StartWorkflowExecutionRequest) []*shared.StartWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.StartWorkflowExecutionRequest, len(p))
+	for i := range p {
+		v[i] = ToStartWorkflowExecutionRequest(p[i])
+	}
+	return v
+}
+
+func fromWorkflowExecutionInfoArray(t []*shared.WorkflowExecutionInfo) []*apiv1.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecutionInfo, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecutionInfo(t[i])
+	}
+	return v
+}
+
+func toWorkflowExecutionInfoArray(p []*apiv1.WorkflowExecutionInfo) []*shared.WorkflowExecutionInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecutionInfo, len(p))
+	for i := range p {
+		v[i] = ToWorkflowExecutionInfo(p[i])
+	}
+	return v
+}
+
+func fromWorkflowTypeConcurrencyArray(t []*shared.WorkflowTypeConcurrency) []*apiv1.WorkflowTypeConcurrency {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowTypeConcurrency, len(t))
+	for i := range t {
+		v[i] = FromWorkflowTypeConcurrency(t[i])
+	}
+	return v
+}
+
+func toWorkflowTypeConcurrencyArray(p []*apiv1.WorkflowTypeConcurrency) []*shared.WorkflowTypeConcurrency {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowTypeConcurrency, len(p))
+	for i := range p {
+		v[i] = ToWorkflowTypeConcurrency(p[i])
+	}
+	return v
+}
+
+func fromWorkflowUpdateMap(t map[string]*shared.WorkflowUpdate) map[string]*apiv1.WorkflowUpdate {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*apiv1.WorkflowUpdate, len(t))
+	for key := range t {
+		v[key] = FromWorkflowUpdate(t[key])
+	}
+	return v
+}
+
+func toWorkflowUpdateMap(p map[string]*apiv1.WorkflowUpdate) map[string]*shared.WorkflowUpdate {
+	if p == nil {
+		return nil
+	}
+	v := make(map[string]*shared.WorkflowUpdate, len(p))
+	for key := range p {
+		v[key] = ToWorkflowUpdate(p[key])
+	}
+	return v
+}
+
+func fromWorkflowUpdateResultMap(t map[string]*shared.WorkflowUpdateResult) map[string]*apiv1.WorkflowUpdateResult {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*apiv1.WorkflowUpdateResult, len(t))
+	for key := range t {
+		v[key] = FromWorkflowUpdateResult(t[key])
+	}
+	return v
+}
+
+func toWorkflowUpdateResultMap(p map[string]*apiv1.WorkflowUpdateResult) map[string]*shared.WorkflowUpdateResult {
+	if p == nil {
+		return nil
+	}
+	v := make(map[string]*shared.WorkflowUpdateResult, len(p))
+	for key := range p {
+		v[key] = ToWorkflowUpdateResult(p[key])
+	}
+	return v
+}
+
+func stringPtr(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	sharedSuite struct {
+		suite.Suite
+	}
+)
+
+func TestSharedSuite(t *testing.T) {
+	s := new(sharedSuite)
+	suite.Run(t, s)
+}
+
+func (s *sharedSuite) TestStartWorkflowExecutionRequest() {
+	request := &shared.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr("some random domain"),
+		WorkflowId:                          common.StringPtr("some random workflow ID"),
+		WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("some random workflow type")},
+		TaskList:                            &shared.TaskList{Name: common.StringPtr("some random task list"), Kind: shared.TaskListKindSticky.Ptr()},
+		Input:                               []byte("some random input"),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr("some random identity"),
+		RequestId:                           common.StringPtr("some random request ID"),
+		WorkflowIdReusePolicy:               shared.WorkflowIdReusePolicyRejectDuplicate.Ptr(),
+		ChildPolicy:                         shared.ChildPolicyRequestCancel.Ptr(),
+		RetryPolicy: &shared.RetryPolicy{
+			InitialIntervalInSeconds:    common.Int32Ptr(1),
+			BackoffCoefficient:          common.Float64Ptr(2),
+			MaximumIntervalInSeconds:    common.Int32Ptr(10),
+			MaximumAttempts:             common.Int32Ptr(5),
+			NonRetriableErrorReasons:    []string{"some random reason"},
+			ExpirationIntervalInSeconds: common.Int32Ptr(100),
+		},
+		CronSchedule: common.StringPtr("* * * * *"),
+		Memo:         &shared.Memo{Fields: map[string][]byte{"some random key": []byte("some random value")}},
+		Header:       &shared.Header{Fields: map[string][]byte{"some random key": []byte("some random value")}},
+	}
+
+	s.Equal(request, ToStartWorkflowExecutionRequest(FromStartWorkflowExecutionRequest(request)))
+}
+
+func (s *sharedSuite) TestHistoryEvent() {
+	event := &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(130),
+		Timestamp: common.Int64Ptr(112345132134),
+		EventType: shared.EventTypeRequestCancelExternalWorkflowExecutionInitiated.Ptr(),
+		Version:   common.Int64Ptr(1234),
+		TaskId:    common.Int64Ptr(5678),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: &shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+			DecisionTaskCompletedEventId: common.Int64Ptr(129),
+			Domain:                       common.StringPtr("some random target domain"),
+			WorkflowExecution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr("some random target workflow ID"),
+				RunId:      common.StringPtr("some random target run ID"),
+			},
+			Control:           []byte("some random control"),
+			ChildWorkflowOnly: common.BoolPtr(true),
+		},
+	}
+	history := &shared.History{Events: []*shared.HistoryEvent{event, event}}
+
+	s.Equal(history, ToHistory(FromHistory(history)))
+}
+
+func (s *sharedSuite) TestDescribeDomainResponse() {
+	response := &shared.DescribeDomainResponse{
+		DomainInfo: &shared.DomainInfo{
+			Name:        common.StringPtr("some random domain"),
+			Status:      shared.DomainStatusRegistered.Ptr(),
+			Description: common.StringPtr("some random description"),
+			OwnerEmail:  common.StringPtr("some random email"),
+			Data:        map[string]string{"some random key": "some random value"},
+			UUID:        common.StringPtr("some random UUID"),
+		},
+		Configuration: &shared.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(7),
+			EmitMetric:                             common.BoolPtr(true),
+			ArchivalBucketName:                     common.StringPtr("some random bucket"),
+			ArchivalRetentionPeriodInDays:          common.Int32Ptr(30),
+			ArchivalStatus:                         shared.ArchivalStatusEnabled.Ptr(),
+			BadBinaries: &shared.BadBinaries{
+				Binaries: map[string]*shared.BadBinaryInfo{
+					"some random checksum": {
+						Reason:          common.StringPtr("some random reason"),
+						Operator:        common.StringPtr("some random operator"),
+						CreatedTimeNano: common.Int64Ptr(1234),
+					},
+				},
+			},
+		},
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr("active"),
+			Clusters: []*shared.ClusterReplicationConfiguration{
+				{ClusterName: common.StringPtr("active")},
+				{ClusterName: common.StringPtr("standby")},
+			},
+		},
+		FailoverVersion: common.Int64Ptr(1),
+		IsGlobalDomain:  common.BoolPtr(true),
+	}
+
+	s.Equal(response, ToDescribeDomainResponse(FromDescribeDomainResponse(response)))
+}
+
+func (s *sharedSuite) TestEmptyValues() {
+	s.Nil(FromStartWorkflowExecutionRequest(nil))
+	s.Nil(ToStartWorkflowExecutionRequest(nil))
+
+	// empty strings and unset enums are mapped back to nil, numbers keep their zero value
+	request := ToListOpenWorkflowExecutionsRequest(&apiv1.ListOpenWorkflowExecutionsRequest{
+		StartTimeFilter: &apiv1.StartTimeFilter{LatestTime: 10},
+	})
+	s.Nil(request.Domain)
+	s.Nil(request.NextPageToken)
+	s.Equal(int32(0), request.GetMaximumPageSize())
+	s.Equal(int64(0), *request.StartTimeFilter.EarliestTime)
+	s.Equal(int64(10), *request.StartTimeFilter.LatestTime)
+	s.Nil(ToTaskList(&apiv1.TaskList{Name: "some random task list"}).Kind)
+}
+
+func (s *sharedSuite) TestEnums() {
+	for _, eventType := range shared.EventType_Values() {
+		s.Equal(eventType, *ToEventType(FromEventType(eventType.Ptr())))
+	}
+	for _, decisionType := range shared.DecisionType_Values() {
+		s.Equal(decisionType, *ToDecisionType(FromDecisionType(decisionType.Ptr())))
+	}
+	for _, cause := range shared.DecisionTaskFailedCause_Values() {
+		s.Equal(cause, *ToDecisionTaskFailedCause(FromDecisionTaskFailedCause(cause.Ptr())))
+	}
+	s.Equal(apiv1.EventType_EVENT_TYPE_INVALID, FromEventType(nil))
+	s.Nil(ToEventType(apiv1.EventType_EVENT_TYPE_INVALID))
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	jaegercfg "github.com/uber/jaeger-client-go/config"
 	"github.com/uber/ringpop-go/discovery"
 )
 
@@ -39,6 +40,8 @@ type (
 	Config struct {
 		// Ringpop is the ringpop related configuration
 		Ringpop Ringpop `yaml:"ringpop"`
+		// Membership is the config for the membership provider, ringpop is used by default
+		Membership Membership `yaml:"membership"`
 		// Persistence contains the configuration for cadence datastores
 		Persistence Persistence `yaml:"persistence"`
 		// Log is the logging config
@@ -63,6 +66,8 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// Tracing is the config for distributed tracing
+		Tracing Tracing `yaml:"tracing"`
 	}
 
 	// Service contains the service specific config items
@@ -85,6 +90,8 @@ type (
 	RPC struct {
 		// Port is the port  on which the channel will bind to
 		Port int `yaml:"port"`
+		// GRPCPort is the port on which the gRPC inbound will bind to, gRPC is disabled when unset
+		GRPCPort int `yaml:"grpcPort"`
 		// BindOnLocalHost is true if localhost is the bind address
 		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
 		// BindOnIP can be used to bind service on specific ip (eg. `0.0.0.0`) -
@@ -100,7 +107,7 @@ type (
 	// Ringpop contains the ringpop config items
 	Ringpop struct {
 		// Name to be used in ringpop advertisement
-		Name string `yaml:"name" validate:"nonzero"`
+		Name string `yaml:"name"`
 		// BootstrapMode is a enum that defines the ringpop bootstrap method
 		BootstrapMode BootstrapMode `yaml:"bootstrapMode"`
 		// BootstrapHosts is a list of seed hosts to be used for ringpop bootstrap
@@ -113,6 +120,27 @@ type (
 		DiscoveryProvider discovery.DiscoverProvider `yaml:"-"`
 	}
 
+	// Membership contains the config items for the membership provider
+	Membership struct {
+		// Provider is the membership provider to use, either ringpop or persistence
+		Provider string `yaml:"provider"`
+		// Heartbeat is the config for the persistence provider, which discovers
+		// the hosts through the heartbeats recorded in the default datastore
+		Heartbeat HeartbeatMembership `yaml:"heartbeat"`
+	}
+
+	// HeartbeatMembership contains the config items for the persistence membership provider
+	HeartbeatMembership struct {
+		// HeartbeatInterval is how often a host records its heartbeat
+		HeartbeatInterval time.Duration `yaml:"heartbeatInterval"`
+		// HeartbeatTTL is how long a host is considered alive after its last heartbeat
+		HeartbeatTTL time.Duration `yaml:"heartbeatTTL"`
+		// RefreshInterval is how often the hosts of every service are reloaded
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// PruneInterval is how often the records of dead hosts are removed
+		PruneInterval time.Duration `yaml:"pruneInterval"`
+	}
+
 	// Persistence contains the configuration for data store / persistence layer
 	Persistence struct {
 		// DefaultStore is the name of the default data store to use
@@ -294,6 +322,12 @@ type (
 		Tags map[string]string `yaml:"tags"`
 	}
 
+	// Tracing contains the config items for distributed tracing
+	Tracing struct {
+		// Jaeger is the configuration for the jaeger tracer, tracing is disabled when unset
+		Jaeger *jaegercfg.Configuration `yaml:"jaeger"`
+	}
+
 	// Statsd contains the config items for statsd metrics reporter
 	Statsd struct {
 		// The host and port of the statsd server
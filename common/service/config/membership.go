@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"go.uber.org/yarpc"
+)
+
+const (
+	// MembershipProviderRingpop is the gossip based membership provider, it is the default
+	MembershipProviderRingpop = "ringpop"
+	// MembershipProviderPersistence is the membership provider backed by the heartbeat table in the default datastore
+	MembershipProviderPersistence = "persistence"
+)
+
+const (
+	defaultHeartbeatInterval        = 5 * time.Second
+	defaultHeartbeatTTL             = 20 * time.Second
+	defaultHeartbeatRefreshInterval = 5 * time.Second
+	defaultHeartbeatPruneInterval   = time.Minute
+)
+
+// HeartbeatFactory implements the MembershipMonitorFactory interface
+// with the persistence membership provider
+type HeartbeatFactory struct {
+	options     *membership.HeartbeatOptions
+	store       membership.HeartbeatStore
+	logger      log.Logger
+	serviceName string
+}
+
+// GetProvider returns the name of the configured membership provider
+func (m *Membership) GetProvider() (string, error) {
+	switch strings.ToLower(m.Provider) {
+	case "", MembershipProviderRingpop:
+		return MembershipProviderRingpop, nil
+	case MembershipProviderPersistence:
+		return MembershipProviderPersistence, nil
+	}
+	return "", fmt.Errorf("unknown membership provider %q", m.Provider)
+}
+
+// NewFactory builds a persistence membership factory which
+// records and reads the heartbeats through the given store
+func (hbConfig *HeartbeatMembership) NewFactory(
+	store membership.HeartbeatStore,
+	logger log.Logger,
+	serviceName string,
+) (*HeartbeatFactory, error) {
+	options := hbConfig.toOptions()
+	if options.HeartbeatTTL <= options.HeartbeatInterval {
+		return nil, fmt.Errorf("membership heartbeatTTL must be greater than heartbeatInterval")
+	}
+	return &HeartbeatFactory{
+		options:     options,
+		store:       store,
+		logger:      logger,
+		serviceName: serviceName,
+	}, nil
+}
+
+// Create is the implementation for MembershipMonitorFactory.Create
+func (factory *HeartbeatFactory) Create(dispatcher *yarpc.Dispatcher) (membership.Monitor, error) {
+	ch, err := getChannel(dispatcher)
+	if err != nil {
+		return nil, err
+	}
+
+	// advertise the tchannel address, the same one ringpop uses as the host identity
+	membershipMonitor := membership.NewHeartbeatMonitor(
+		CadenceServices,
+		factory.serviceName,
+		ch.PeerInfo().HostPort,
+		factory.store,
+		factory.options,
+		factory.logger,
+	)
+	if err = membershipMonitor.Start(); err != nil {
+		return nil, err
+	}
+	return membershipMonitor, nil
+}
+
+func (hbConfig *HeartbeatMembership) toOptions() *membership.HeartbeatOptions {
+	options := &membership.HeartbeatOptions{
+		HeartbeatInterval: hbConfig.HeartbeatInterval,
+		HeartbeatTTL:      hbConfig.HeartbeatTTL,
+		RefreshInterval:   hbConfig.RefreshInterval,
+		PruneInterval:     hbConfig.PruneInterval,
+	}
+	if options.HeartbeatInterval <= 0 {
+		options.HeartbeatInterval = defaultHeartbeatInterval
+	}
+	if options.HeartbeatTTL <= 0 {
+		options.HeartbeatTTL = defaultHeartbeatTTL
+	}
+	if options.RefreshInterval <= 0 {
+		options.RefreshInterval = defaultHeartbeatRefreshInterval
+	}
+	if options.PruneInterval <= 0 {
+		options.PruneInterval = defaultHeartbeatPruneInterval
+	}
+	return options
+}
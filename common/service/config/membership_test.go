@@ -0,0 +1,80 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"gopkg.in/yaml.v2"
+)
+
+type MembershipSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestMembershipSuite(t *testing.T) {
+	suite.Run(t, new(MembershipSuite))
+}
+
+func (s *MembershipSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *MembershipSuite) TestGetProvider() {
+	provider, err := (&Membership{}).GetProvider()
+	s.NoError(err)
+	s.Equal(MembershipProviderRingpop, provider)
+
+	provider, err = (&Membership{Provider: "Persistence"}).GetProvider()
+	s.NoError(err)
+	s.Equal(MembershipProviderPersistence, provider)
+
+	_, err = (&Membership{Provider: "zookeeper"}).GetProvider()
+	s.Error(err)
+}
+
+func (s *MembershipSuite) TestHeartbeatConfig() {
+	var cfg Membership
+	err := yaml.Unmarshal([]byte(`
+provider: persistence
+heartbeat:
+  heartbeatInterval: 2s
+  heartbeatTTL: 10s
+`), &cfg)
+	s.NoError(err)
+	s.Equal(MembershipProviderPersistence, cfg.Provider)
+
+	f, err := cfg.Heartbeat.NewFactory(nil, loggerimpl.NewNopLogger(), "test")
+	s.NoError(err)
+	s.Equal(2*time.Second, f.options.HeartbeatInterval)
+	s.Equal(10*time.Second, f.options.HeartbeatTTL)
+	s.Equal(defaultHeartbeatRefreshInterval, f.options.RefreshInterval)
+	s.Equal(defaultHeartbeatPruneInterval, f.options.PruneInterval)
+
+	cfg.Heartbeat.HeartbeatTTL = time.Second
+	_, err = cfg.Heartbeat.NewFactory(nil, loggerimpl.NewNopLogger(), "test")
+	s.Error(err)
+}
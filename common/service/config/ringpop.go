@@ -165,7 +165,7 @@ func (factory *RingpopFactory) Create(dispatcher *yarpc.Dispatcher) (membership.
 func (factory *RingpopFactory) createRingpop(dispatcher *yarpc.Dispatcher) (*ringpop.Ringpop, error) {
 	var ch *tcg.Channel
 	var err error
-	if ch, err = factory.getChannel(dispatcher); err != nil {
+	if ch, err = getChannel(dispatcher); err != nil {
 		return nil, err
 	}
 
@@ -191,7 +191,7 @@ func (factory *RingpopFactory) createRingpop(dispatcher *yarpc.Dispatcher) (*rin
 	return rp, nil
 }
 
-func (factory *RingpopFactory) getChannel(dispatcher *yarpc.Dispatcher) (*tcg.Channel, error) {
+func getChannel(dispatcher *yarpc.Dispatcher) (*tcg.Channel, error) {
 	t := dispatcher.Inbounds()[0].Transports()[0].(*tchannel.ChannelTransport)
 	ty := reflect.ValueOf(t.Channel())
 	var ch *tcg.Channel
@@ -24,9 +24,13 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/proto"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
@@ -56,17 +60,35 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 	hostAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.Port)
 	d.ch, err = tchannel.NewChannelTransport(
 		tchannel.ServiceName(d.serviceName),
-		tchannel.ListenAddr(hostAddress))
+		tchannel.ListenAddr(hostAddress),
+		tchannel.Tracer(opentracing.GlobalTracer()))
 	if err != nil {
 		d.logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
 	d.logger.Info("Created RPC dispatcher and listening", tag.Service(d.serviceName), tag.Address(hostAddress))
+	inbounds := yarpc.Inbounds{d.ch.NewInbound()}
+	if d.config.GRPCPort > 0 {
+		inbounds = append(inbounds, d.createGRPCInbound())
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     d.serviceName,
-		Inbounds: yarpc.Inbounds{d.ch.NewInbound()},
+		Inbounds: inbounds,
+		InboundMiddleware: yarpc.InboundMiddleware{
+			Unary: proto.ErrorDetailsInboundMiddleware{},
+		},
 	})
 }
 
+func (d *RPCFactory) createGRPCInbound() transport.Inbound {
+	grpcAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.GRPCPort)
+	listener, err := net.Listen("tcp", grpcAddress)
+	if err != nil {
+		d.logger.Fatal("Failed to listen on gRPC port", tag.Error(err))
+	}
+	d.logger.Info("Created gRPC listener", tag.Service(d.serviceName), tag.Address(grpcAddress))
+	return grpc.NewTransport(grpc.Tracer(opentracing.GlobalTracer())).NewInbound(listener)
+}
+
 // CreateDispatcherForOutbound creates a dispatcher for outbound connection
 func (d *RPCFactory) CreateDispatcherForOutbound(
 	callerName, serviceName, hostName string) *yarpc.Dispatcher {
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"io"
+	"io/ioutil"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const defaultTracingServiceName = "cadence"
+
+// NewTracer builds a new opentracing tracer for
+// this tracing configuration
+//
+// A no-op tracer is returned when no tracer is
+// configured. The returned closer must be closed
+// on shutdown to flush the buffered spans
+func (c *Tracing) NewTracer() (opentracing.Tracer, io.Closer, error) {
+	if c.Jaeger == nil || c.Jaeger.Disabled {
+		return opentracing.NoopTracer{}, ioutil.NopCloser(nil), nil
+	}
+	cfg := *c.Jaeger
+	if len(cfg.ServiceName) == 0 {
+		cfg.ServiceName = defaultTracingServiceName
+	}
+	return cfg.NewTracer()
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	jaegercfg "github.com/uber/jaeger-client-go/config"
+)
+
+type TracingSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestTracingSuite(t *testing.T) {
+	suite.Run(t, new(TracingSuite))
+}
+
+func (s *TracingSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *TracingSuite) TestNoTracer() {
+	config := new(Tracing)
+	tracer, closer, err := config.NewTracer()
+	s.NoError(err)
+	s.Equal(opentracing.NoopTracer{}, tracer)
+	s.NoError(closer.Close())
+}
+
+func (s *TracingSuite) TestJaegerDisabled() {
+	config := &Tracing{Jaeger: &jaegercfg.Configuration{Disabled: true}}
+	tracer, closer, err := config.NewTracer()
+	s.NoError(err)
+	s.Equal(opentracing.NoopTracer{}, tracer)
+	s.NoError(closer.Close())
+}
+
+func (s *TracingSuite) TestJaeger() {
+	config := &Tracing{Jaeger: &jaegercfg.Configuration{
+		Sampler: &jaegercfg.SamplerConfig{Type: "const", Param: 1},
+	}}
+	tracer, closer, err := config.NewTracer()
+	s.NoError(err)
+	s.NotNil(tracer)
+	s.NotEqual(opentracing.NoopTracer{}, tracer)
+	s.Empty(config.Jaeger.ServiceName)
+	s.NoError(closer.Close())
+}
@@ -71,6 +71,12 @@ type IntPropertyFnWithDomainFilter func(domain string) int
 // IntPropertyFnWithTaskListInfoFilters is a wrapper to get int property from dynamic config with three filters: domain, taskList, taskType
 type IntPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) int
 
+// IntPropertyFnWithWorkflowTypeFilter is a wrapper to get int property from dynamic config with domain and workflow type as filters
+type IntPropertyFnWithWorkflowTypeFilter func(domain string, workflowType string) int
+
+// IntPropertyFnWithActivityTypeFilter is a wrapper to get int property from dynamic config with domain and activity type as filters
+type IntPropertyFnWithActivityTypeFilter func(domain string, activityType string) int
+
 // FloatPropertyFn is a wrapper to get float property from dynamic config
 type FloatPropertyFn func(opts ...FilterOption) float64
 
@@ -143,6 +149,38 @@ func (c *Collection) GetIntPropertyFilteredByDomain(key Key, defaultValue int) I
 	}
 }
 
+// GetIntPropertyFilteredByWorkflowType gets property with domain and workflow type as filters and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByWorkflowType(key Key, defaultValue int) IntPropertyFnWithWorkflowTypeFilter {
+	return func(domain string, workflowType string) int {
+		val, err := c.client.GetIntValue(
+			key,
+			getFilterMap(DomainFilter(domain), WorkflowTypeFilter(workflowType)),
+			defaultValue,
+		)
+		if err != nil {
+			c.logNoValue(key, err)
+		}
+		c.logValue(key, val, defaultValue)
+		return val
+	}
+}
+
+// GetIntPropertyFilteredByActivityType gets property with domain and activity type as filters and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByActivityType(key Key, defaultValue int) IntPropertyFnWithActivityTypeFilter {
+	return func(domain string, activityType string) int {
+		val, err := c.client.GetIntValue(
+			key,
+			getFilterMap(DomainFilter(domain), ActivityTypeFilter(activityType)),
+			defaultValue,
+		)
+		if err != nil {
+			c.logNoValue(key, err)
+		}
+		c.logValue(key, val, defaultValue)
+		return val
+	}
+}
+
 // GetIntPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByTaskListInfo(key Key, defaultValue int) IntPropertyFnWithTaskListInfoFilters {
 	return func(domain string, taskList string, taskType int) int {
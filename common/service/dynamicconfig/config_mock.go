@@ -39,6 +39,16 @@ func GetIntPropertyFilteredByTaskListInfo(value int) func(domain string, taskLis
 	return func(domain string, taskList string, taskType int) int { return value }
 }
 
+// GetIntPropertyFilteredByWorkflowType returns value as IntPropertyFnWithWorkflowTypeFilter
+func GetIntPropertyFilteredByWorkflowType(value int) func(domain string, workflowType string) int {
+	return func(domain string, workflowType string) int { return value }
+}
+
+// GetIntPropertyFilteredByActivityType returns value as IntPropertyFnWithActivityTypeFilter
+func GetIntPropertyFilteredByActivityType(value int) func(domain string, activityType string) int {
+	return func(domain string, activityType string) int { return value }
+}
+
 // GetFloatPropertyFn returns value as FloatPropertyFn
 func GetFloatPropertyFn(value float64) func(opts ...FilterOption) float64 {
 	return func(...FilterOption) float64 { return value }
@@ -59,6 +69,11 @@ func GetDurationPropertyFn(value time.Duration) func(opts ...FilterOption) time.
 	return func(...FilterOption) time.Duration { return value }
 }
 
+// GetDurationPropertyFnFilteredByDomain returns value as DurationPropertyFnWithDomainFilter
+func GetDurationPropertyFnFilteredByDomain(value time.Duration) func(domain string) time.Duration {
+	return func(domain string) time.Duration { return value }
+}
+
 // GetDurationPropertyFnFilteredByTaskListInfo returns value as DurationPropertyFnWithTaskListInfoFilters
 func GetDurationPropertyFnFilteredByTaskListInfo(value time.Duration) func(domain string, taskList string, taskType int) time.Duration {
 	return func(domain string, taskList string, taskType int) time.Duration { return value }
@@ -50,15 +50,18 @@ var keys = map[Key]string{
 	testGetBoolPropertyFilteredByTaskListInfoKey:     "testGetBoolPropertyFilteredByTaskListInfoKey",
 
 	// system settings
-	EnableGlobalDomain:                  "system.enableGlobalDomain",
-	EnableNewKafkaClient:                "system.enableNewKafkaClient",
-	EnableVisibilitySampling:            "system.enableVisibilitySampling",
-	EnableReadFromClosedExecutionV2:     "system.enableReadFromClosedExecutionV2",
-	EnableVisibilityToKafka:             "system.enableVisibilityToKafka",
-	EnableReadVisibilityFromES:          "system.enableReadVisibilityFromES",
-	ArchivalStatus:                      "system.archivalStatus",
-	EnableReadFromArchival:              "system.enableReadFromArchival",
-	EnableDomainNotActiveAutoForwarding: "system.enableDomainNotActiveAutoForwarding",
+	EnableGlobalDomain:                   "system.enableGlobalDomain",
+	EnableNewKafkaClient:                 "system.enableNewKafkaClient",
+	EnableVisibilitySampling:             "system.enableVisibilitySampling",
+	EnableReadFromClosedExecutionV2:      "system.enableReadFromClosedExecutionV2",
+	EnableVisibilityToKafka:              "system.enableVisibilityToKafka",
+	EnableReadVisibilityFromES:           "system.enableReadVisibilityFromES",
+	ArchivalStatus:                       "system.archivalStatus",
+	EnableReadFromArchival:               "system.enableReadFromArchival",
+	EnableDomainNotActiveAutoForwarding:  "system.enableDomainNotActiveAutoForwarding",
+	EnableParentClosePolicyWorker:        "system.enableParentClosePolicyWorker",
+	PayloadOffloadBucket:                 "system.payloadOffloadBucket",
+	EnablePayloadOffloadForGlobalDomains: "system.enablePayloadOffloadForGlobalDomains",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -69,20 +72,29 @@ var keys = map[Key]string{
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
 	MaxIDLengthLimit:       "limit.maxIDLength",
 
+	PayloadOffloadThreshold:      "limit.payloadOffloadThreshold",
+	PayloadOffloadSizeLimitError: "limit.payloadOffloadSize.error",
+
+	WorkflowTypeConcurrencyLimit:     "limit.workflowTypeConcurrency",
+	WorkflowTypeConcurrencyLimitMode: "limit.workflowTypeConcurrencyMode",
+
 	// frontend settings
-	FrontendPersistenceMaxQPS:      "frontend.persistenceMaxQPS",
-	FrontendVisibilityMaxPageSize:  "frontend.visibilityMaxPageSize",
-	FrontendVisibilityListMaxQPS:   "frontend.visibilityListMaxQPS",
-	FrontendESVisibilityListMaxQPS: "frontend.esVisibilityListMaxQPS",
-	FrontendMaxBadBinaries:         "frontend.maxBadBinaries",
-	FrontendESIndexMaxResultWindow: "frontend.esIndexMaxResultWindow",
-	FrontendHistoryMaxPageSize:     "frontend.historyMaxPageSize",
-	FrontendRPS:                    "frontend.rps",
-	FrontendHistoryMgrNumConns:     "frontend.historyMgrNumConns",
-	MaxDecisionStartToCloseTimeout: "frontend.maxDecisionStartToCloseTimeout",
-	DisableListVisibilityByFilter:  "frontend.disableListVisibilityByFilter",
-	FrontendThrottledLogRPS:        "frontend.throttledLogRPS",
-	EnableClientVersionCheck:       "frontend.enableClientVersionCheck",
+	FrontendPersistenceMaxQPS:         "frontend.persistenceMaxQPS",
+	FrontendVisibilityMaxPageSize:     "frontend.visibilityMaxPageSize",
+	FrontendVisibilityListMaxQPS:      "frontend.visibilityListMaxQPS",
+	FrontendESVisibilityListMaxQPS:    "frontend.esVisibilityListMaxQPS",
+	FrontendMaxBadBinaries:            "frontend.maxBadBinaries",
+	FrontendStartWorkflowBatchMaxSize: "frontend.startWorkflowBatchMaxSize",
+	FrontendDomainRPS:                 "frontend.domainrps",
+	FrontendESIndexMaxResultWindow:    "frontend.esIndexMaxResultWindow",
+	FrontendHistoryMaxPageSize:        "frontend.historyMaxPageSize",
+	FrontendHistoryFilterMaxPages:     "frontend.historyFilterMaxPages",
+	FrontendRPS:                       "frontend.rps",
+	FrontendHistoryMgrNumConns:        "frontend.historyMgrNumConns",
+	MaxDecisionStartToCloseTimeout:    "frontend.maxDecisionStartToCloseTimeout",
+	DisableListVisibilityByFilter:     "frontend.disableListVisibilityByFilter",
+	FrontendThrottledLogRPS:           "frontend.throttledLogRPS",
+	EnableClientVersionCheck:          "frontend.enableClientVersionCheck",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -98,6 +110,12 @@ var keys = map[Key]string{
 	MatchingMaxTaskBatchSize:                "matching.maxTaskBatchSize",
 	MatchingMaxTaskDeleteBatchSize:          "matching.maxTaskDeleteBatchSize",
 	MatchingThrottledLogRPS:                 "matching.throttledLogRPS",
+	MatchingActivityTypeDispatchRPS:         "matching.activityTypeDispatchRPS",
+	MatchingBuildRoutingFallbackTimeout:     "matching.buildRoutingFallbackTimeout",
+	MatchingEnableTaskListMetrics:           "matching.enableTaskListMetrics",
+	MatchingMaxOutstandingPolls:             "matching.maxOutstandingPolls",
+	MatchingDomainMaxOutstandingPolls:       "matching.domainMaxOutstandingPolls",
+	MatchingPollAdmissionTimeout:            "matching.pollAdmissionTimeout",
 
 	// history settings
 	HistoryRPS:                                            "history.rps",
@@ -158,6 +176,15 @@ var keys = map[Key]string{
 	HistoryMgrNumConns:                                    "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                            "history.maximumBufferedEventsBatch",
 	MaximumSignalsPerExecution:                            "history.maximumSignalsPerExecution",
+	SignalRequestIDDedupeWindow:                           "history.signalRequestIDDedupeWindow",
+	MaxSignalRequestIDs:                                   "history.maxSignalRequestIDs",
+	WorkflowUpdateDefaultTimeout:                          "history.workflowUpdateDefaultTimeout",
+	WorkflowUpdateDedupeTTL:                               "history.workflowUpdateDedupeTTL",
+	WorkflowUpdateMaxDecisionAttempts:                     "history.workflowUpdateMaxDecisionAttempts",
+	MaxAcceptedWorkflowUpdates:                            "history.maxAcceptedWorkflowUpdates",
+	WorkflowConcurrencyQueueRetryInterval:                 "history.workflowConcurrencyQueueRetryInterval",
+	WorkflowConcurrencyCountCacheTTL:                      "history.workflowConcurrencyCountCacheTTL",
+	WorkflowConcurrencyCountReconcileInterval:             "history.workflowConcurrencyCountReconcileInterval",
 	ShardUpdateMinInterval:                                "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                                  "history.shardSyncMinInterval",
 	DefaultEventEncoding:                                  "history.defaultEventEncoding",
@@ -167,6 +194,11 @@ var keys = map[Key]string{
 	NumArchiveSystemWorkflows:                             "history.numArchiveSystemWorkflows",
 	EmitShardDiffLog:                                      "history.emitShardDiffLog",
 	HistoryThrottledLogRPS:                                "history.throttledLogRPS",
+	MinWorkflowRetentionDays:                              "history.minWorkflowRetentionDays",
+	MaxWorkflowRetentionDays:                              "history.maxWorkflowRetentionDays",
+	EnableParentClosePolicy:                               "history.enableParentClosePolicy",
+	ParentClosePolicyThreshold:                            "history.parentClosePolicyThreshold",
+	NumParentClosePolicySystemWorkflows:                   "history.numParentClosePolicySystemWorkflows",
 
 	WorkerPersistenceMaxQPS:                         "worker.persistenceMaxQPS",
 	WorkerReplicatorMetaTaskConcurrency:             "worker.replicatorMetaTaskConcurrency",
@@ -189,6 +221,10 @@ var keys = map[Key]string{
 	WorkerDeterministicConstructionCheckProbability: "worker.DeterministicConstructionCheckProbability",
 	WorkerThrottledLogRPS:                           "worker.throttledLogRPS",
 	ScannerPersistenceMaxQPS:                        "worker.scannerPersistenceMaxQPS",
+	WorkerParentClosePolicyConcurrency:              "worker.parentClosePolicyConcurrency",
+	WorkerParentClosePolicyRPS:                      "worker.parentClosePolicyRPS",
+	ScannerHistoryVerifierEnabled:                   "worker.scannerHistoryVerifierEnabled",
+	ScannerHistoryVerifierRPS:                       "worker.scannerHistoryVerifierRPS",
 }
 
 const (
@@ -231,6 +267,13 @@ const (
 	// EnableDomainNotActiveAutoForwarding whether enabling DC auto forwarding to active cluster
 	// for signal / start / signal with start API if domain is not active
 	EnableDomainNotActiveAutoForwarding
+	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
+	EnableParentClosePolicyWorker
+	// PayloadOffloadBucket is the blobstore bucket which offloaded payloads of a domain are written to
+	PayloadOffloadBucket
+	// EnablePayloadOffloadForGlobalDomains decides whether payloads of global domains are offloaded. Replicated
+	// history only carries the payload references, so the bucket must be readable from every cluster of the domain
+	EnablePayloadOffloadForGlobalDomains
 
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
@@ -249,6 +292,19 @@ const (
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
 	MaxIDLengthLimit
 
+	// PayloadOffloadThreshold is the per domain payload size above which activity, signal and workflow
+	// inputs and results are offloaded to the blobstore, 0 disables offloading
+	PayloadOffloadThreshold
+	// PayloadOffloadSizeLimitError is the size limit for payloads which can be offloaded to the blobstore
+	PayloadOffloadSizeLimitError
+
+	// WorkflowTypeConcurrencyLimit is the max number of running executions of a workflow type in a domain,
+	// 0 means unlimited. Child workflows are not subject to the limit.
+	WorkflowTypeConcurrencyLimit
+	// WorkflowTypeConcurrencyLimitMode is what happens to starts over the concurrency limit of a domain,
+	// "reject" fails them with LimitExceededError and "queue" delays them until capacity frees up
+	WorkflowTypeConcurrencyLimitMode
+
 	// key for frontend
 
 	// FrontendPersistenceMaxQPS is the max qps frontend host can query DB
@@ -263,6 +319,8 @@ const (
 	FrontendESIndexMaxResultWindow
 	// FrontendHistoryMaxPageSize is default max size for GetWorkflowExecutionHistory in one page
 	FrontendHistoryMaxPageSize
+	// FrontendHistoryFilterMaxPages is the max number of history pages read by one GetWorkflowExecutionHistory call with an event filter
+	FrontendHistoryFilterMaxPages
 	// FrontendRPS is workflow rate limit per second
 	FrontendRPS
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
@@ -275,6 +333,10 @@ const (
 	EnableClientVersionCheck
 	// FrontendMaxBadBinaries is the max number of bad banaries in domain config
 	FrontendMaxBadBinaries
+	// FrontendStartWorkflowBatchMaxSize is the max number of requests in a StartWorkflowExecutionBatch
+	FrontendStartWorkflowBatchMaxSize
+	// FrontendDomainRPS is the per domain rate limit of requests that are limited by domain
+	FrontendDomainRPS
 
 	// key for matching
 
@@ -304,6 +366,25 @@ const (
 	MatchingMaxTaskDeleteBatchSize
 	// MatchingThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	MatchingThrottledLogRPS
+	// MatchingActivityTypeDispatchRPS is the max rate activity tasks of a type are dispatched for a domain,
+	// shared by all task lists and matching hosts. 0 means unlimited
+	MatchingActivityTypeDispatchRPS
+	// MatchingBuildRoutingFallbackTimeout is how long a decision task waits for a poller of the worker build
+	// the workflow last made progress with, or of a build compatible with it, before it is dispatched to any
+	// poller. Tasks waiting for their build do not hold up other tasks. 0, the default, disables build routing
+	MatchingBuildRoutingFallbackTimeout
+	// MatchingEnableTaskListMetrics enables backlog and schedule to start metrics tagged by task list name,
+	// it is off by default to keep metric cardinality bounded
+	MatchingEnableTaskListMetrics
+	// MatchingMaxOutstandingPolls is the max number of outstanding polls a matching host holds, once it is
+	// reached polls are admitted fairly across domains as outstanding polls complete. 0 means unlimited
+	MatchingMaxOutstandingPolls
+	// MatchingDomainMaxOutstandingPolls is the max number of outstanding polls of a domain on a matching host,
+	// including polls waiting for admission. 0 means unlimited
+	MatchingDomainMaxOutstandingPolls
+	// MatchingPollAdmissionTimeout is how long a poll waits for admission when the matching host is at its
+	// outstanding poll limit, before it is rejected with a busy error
+	MatchingPollAdmissionTimeout
 
 	// key for history
 
@@ -421,6 +502,25 @@ const (
 	MaximumBufferedEventsBatch
 	// MaximumSignalsPerExecution is max number of signals supported by single execution
 	MaximumSignalsPerExecution
+	// SignalRequestIDDedupeWindow is how long the request ID of an applied signal is kept to deduplicate retries
+	SignalRequestIDDedupeWindow
+	// MaxSignalRequestIDs is the max number of recently applied signal request IDs kept in mutable state
+	MaxSignalRequestIDs
+	// WorkflowUpdateDefaultTimeout is how long an update waits for the worker when the request sets no timeout
+	WorkflowUpdateDefaultTimeout
+	// WorkflowUpdateDedupeTTL is how long the result of a completed update is kept to deduplicate retries
+	WorkflowUpdateDedupeTTL
+	// WorkflowUpdateMaxDecisionAttempts is how many decisions may leave an update unanswered before it fails
+	WorkflowUpdateMaxDecisionAttempts
+	// MaxAcceptedWorkflowUpdates is the max number of accepted update IDs kept in mutable state for deduplication
+	MaxAcceptedWorkflowUpdates
+	// WorkflowConcurrencyQueueRetryInterval is how often a start queued by the concurrency limit checks for capacity
+	WorkflowConcurrencyQueueRetryInterval
+	// WorkflowConcurrencyCountCacheTTL is how long a host caches the cluster wide running execution counts of a domain
+	WorkflowConcurrencyCountCacheTTL
+	// WorkflowConcurrencyCountReconcileInterval is how often a host recounts the running executions of the
+	// workflow types with a concurrency limit from visibility, 0 disables reconciliation
+	WorkflowConcurrencyCountReconcileInterval
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
@@ -441,6 +541,16 @@ const (
 	EnableEventsV2
 	// HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	HistoryThrottledLogRPS
+	// MinWorkflowRetentionDays is the lower bound of the per workflow retention
+	MinWorkflowRetentionDays
+	// MaxWorkflowRetentionDays is the upper bound of the per workflow retention
+	MaxWorkflowRetentionDays
+	// EnableParentClosePolicy is whether the child policy is applied to the running children of a closed workflow
+	EnableParentClosePolicy
+	// ParentClosePolicyThreshold is the number of children above which the parent close policy is applied by the system worker
+	ParentClosePolicyThreshold
+	// NumParentClosePolicySystemWorkflows is key for number of parent close policy system workflows running in total
+	NumParentClosePolicySystemWorkflows
 
 	// key for worker
 
@@ -486,6 +596,15 @@ const (
 	WorkerThrottledLogRPS
 	// ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner
 	ScannerPersistenceMaxQPS
+	// WorkerParentClosePolicyConcurrency is the max number of concurrent parent close policy activities per worker
+	WorkerParentClosePolicyConcurrency
+	// WorkerParentClosePolicyRPS is the max rate of terminate or cancel calls issued by the parent close policy worker
+	WorkerParentClosePolicyRPS
+	// ScannerHistoryVerifierEnabled decides whether or not the scanner replays the histories of open workflows
+	// and verifies them against their mutable states
+	ScannerHistoryVerifierEnabled
+	// ScannerHistoryVerifierRPS is the max rate of history verification calls issued by the scanner
+	ScannerHistoryVerifierRPS
 
 	// lastKeyForTest must be the last one in this const group for testing purpose
 	lastKeyForTest
@@ -495,7 +614,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskType {
+	if f <= unknownFilter || f > ActivityTypeName {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -506,6 +625,8 @@ var filters = []string{
 	"domainName",
 	"taskListName",
 	"taskType",
+	"workflowTypeName",
+	"activityTypeName",
 }
 
 const (
@@ -516,6 +637,10 @@ const (
 	TaskListName
 	// TaskType is the task type (0:Decision, 1:Activity)
 	TaskType
+	// WorkflowTypeName is the workflow type name
+	WorkflowTypeName
+	// ActivityTypeName is the activity type name
+	ActivityTypeName
 
 	// lastFilterTypeForTest must be the last one in this const group for testing purpose
 	lastFilterTypeForTest
@@ -544,3 +669,17 @@ func TaskTypeFilter(taskType int) FilterOption {
 		filterMap[TaskType] = taskType
 	}
 }
+
+// WorkflowTypeFilter filters by workflow type name
+func WorkflowTypeFilter(name string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[WorkflowTypeName] = name
+	}
+}
+
+// ActivityTypeFilter filters by activity type name
+func ActivityTypeFilter(name string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[ActivityTypeName] = name
+	}
+}
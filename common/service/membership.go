@@ -0,0 +1,72 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package service
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const heartbeatStoreMaxRecordsPruned = 100
+
+type persistenceHeartbeatStore struct {
+	manager persistence.ClusterMembershipManager
+}
+
+var _ membership.HeartbeatStore = (*persistenceHeartbeatStore)(nil)
+
+// NewPersistenceHeartbeatStore returns a membership heartbeat store
+// backed by the cluster membership table of the given manager
+func NewPersistenceHeartbeatStore(manager persistence.ClusterMembershipManager) membership.HeartbeatStore {
+	return &persistenceHeartbeatStore{manager: manager}
+}
+
+func (s *persistenceHeartbeatStore) Heartbeat(service string, address string, sessionStart time.Time, ttl time.Duration) error {
+	return s.manager.UpsertClusterMembership(&persistence.UpsertClusterMembershipRequest{
+		Role:         service,
+		RPCAddress:   address,
+		SessionStart: sessionStart,
+		RecordExpiry: ttl,
+	})
+}
+
+func (s *persistenceHeartbeatStore) GetLiveHosts(service string, ttl time.Duration) ([]string, error) {
+	resp, err := s.manager.GetClusterMembers(&persistence.GetClusterMembersRequest{
+		Role:                service,
+		LastHeartbeatWithin: ttl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]string, 0, len(resp.ActiveMembers))
+	for _, member := range resp.ActiveMembers {
+		addrs = append(addrs, member.RPCAddress)
+	}
+	return addrs, nil
+}
+
+func (s *persistenceHeartbeatStore) PruneExpiredHosts() error {
+	return s.manager.PruneClusterMembership(&persistence.PruneClusterMembershipRequest{
+		MaxRecordsPruned: heartbeatStoreMaxRecordsPruned,
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/uber/cadence/common/metrics"
+)
+
+// StartSpan starts a span named after the operation of the given metrics scope.
+// The span is a child of the span carried by ctx, if any, and the returned
+// context carries the new span so that it is propagated to the outbound call.
+func StartSpan(ctx context.Context, scope int) (opentracing.Span, context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return opentracing.StartSpanFromContext(ctx, metrics.GetCommonScopeOperation(scope))
+}
+
+// FinishSpan marks the span as failed if err is not nil and finishes it.
+// A nil span is ignored.
+func FinishSpan(span opentracing.Span, err error) {
+	if span == nil {
+		return
+	}
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogFields(otlog.Error(err))
+	}
+	span.Finish()
+}
+
+// Inject serializes the span context carried by ctx so that it can be stored
+// alongside a task. It returns nil if ctx does not carry a span.
+func Inject(ctx context.Context) []byte {
+	if ctx == nil {
+		return nil
+	}
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return nil
+	}
+	carrier := opentracing.TextMapCarrier{}
+	if err := span.Tracer().Inject(span.Context(), opentracing.TextMap, carrier); err != nil || len(carrier) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(carrier)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// StartSpanFollowsFrom starts a span which follows from the span context
+// serialized by Inject. A missing or corrupted trace context results in a
+// new root span.
+func StartSpanFollowsFrom(operationName string, traceContext []byte) (opentracing.Span, context.Context) {
+	var opts []opentracing.StartSpanOption
+	if len(traceContext) > 0 {
+		carrier := opentracing.TextMapCarrier{}
+		if err := json.Unmarshal(traceContext, &carrier); err == nil {
+			if spanContext, err := opentracing.GlobalTracer().Extract(opentracing.TextMap, carrier); err == nil {
+				opts = append(opts, opentracing.FollowsFrom(spanContext))
+			}
+		}
+	}
+	span := opentracing.StartSpan(operationName, opts...)
+	return span, opentracing.ContextWithSpan(context.Background(), span)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	tracingSuite struct {
+		suite.Suite
+		tracer *mocktracer.MockTracer
+	}
+)
+
+func TestTracingSuite(t *testing.T) {
+	suite.Run(t, new(tracingSuite))
+}
+
+func (s *tracingSuite) SetupTest() {
+	s.tracer = mocktracer.New()
+	opentracing.SetGlobalTracer(s.tracer)
+}
+
+func (s *tracingSuite) TearDownTest() {
+	opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+}
+
+func (s *tracingSuite) TestStartSpan_NilContext() {
+	span, ctx := StartSpan(nil, metrics.HistoryClientStartWorkflowExecutionScope)
+	s.NotNil(ctx)
+	s.Equal(span, opentracing.SpanFromContext(ctx))
+	FinishSpan(span, nil)
+
+	finished := s.tracer.FinishedSpans()
+	s.Len(finished, 1)
+	s.Equal(metrics.GetCommonScopeOperation(metrics.HistoryClientStartWorkflowExecutionScope), finished[0].OperationName)
+	s.Nil(finished[0].Tag("error"))
+}
+
+func (s *tracingSuite) TestStartSpan_ChildOfContext() {
+	parent, ctx := opentracing.StartSpanFromContext(context.Background(), "parent")
+	span, _ := StartSpan(ctx, metrics.MatchingClientAddDecisionTaskScope)
+	FinishSpan(span, errors.New("some random error"))
+	parent.Finish()
+
+	finished := s.tracer.FinishedSpans()
+	s.Len(finished, 2)
+	s.Equal(parent.(*mocktracer.MockSpan).SpanContext.SpanID, finished[0].ParentID)
+	s.Equal(true, finished[0].Tag("error"))
+}
+
+func (s *tracingSuite) TestInject_NoSpan() {
+	s.Nil(Inject(nil))
+	s.Nil(Inject(context.Background()))
+}
+
+func (s *tracingSuite) TestInjectAndFollowsFrom() {
+	parent, ctx := opentracing.StartSpanFromContext(context.Background(), "parent")
+	traceContext := Inject(ctx)
+	s.NotEmpty(traceContext)
+	parent.Finish()
+
+	span, spanCtx := StartSpanFollowsFrom("follower", traceContext)
+	s.Equal(span, opentracing.SpanFromContext(spanCtx))
+	span.Finish()
+
+	finished := s.tracer.FinishedSpans()
+	s.Len(finished, 2)
+	s.Equal("follower", finished[1].OperationName)
+	s.Equal(parent.(*mocktracer.MockSpan).SpanContext.TraceID, finished[1].SpanContext.TraceID)
+	s.Equal(parent.(*mocktracer.MockSpan).SpanContext.SpanID, finished[1].ParentID)
+}
+
+func (s *tracingSuite) TestFollowsFrom_InvalidTraceContext() {
+	for _, traceContext := range [][]byte{nil, []byte("some random garbage")} {
+		span, _ := StartSpanFollowsFrom("follower", traceContext)
+		span.Finish()
+	}
+
+	finished := s.tracer.FinishedSpans()
+	s.Len(finished, 2)
+	for _, span := range finished {
+		s.Equal(0, span.ParentID)
+	}
+}
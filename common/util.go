@@ -24,8 +24,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
+	"unicode"
 
 	farm "github.com/dgryski/go-farm"
 	h "github.com/uber/cadence/.gen/go/history"
@@ -79,6 +81,9 @@ const (
 	FailureReasonDecisionBlobSizeExceedsLimit = "DECISION_BLOB_SIZE_EXCEEDS_LIMIT"
 	// TerminateReasonSizeExceedsLimit is reason to terminate workflow when history size or count exceed limit
 	TerminateReasonSizeExceedsLimit = "HISTORY_EXCEEDS_LIMIT"
+	// TerminateReasonNewRunStarted is the reason to terminate a running workflow when a new run with the
+	// TerminateIfRunning workflow ID reuse policy is started, it is formatted with the ID of the new run
+	TerminateReasonNewRunStarted = "TERMINATED_BY_NEW_RUN: %v"
 )
 
 var (
@@ -203,6 +208,9 @@ func IsServiceNonRetryableError(err error) bool {
 		return true
 	case *workflow.CancellationAlreadyRequestedError:
 		return true
+	case *workflow.ServiceBusyError:
+		// a busy error with a retry hint asks the caller to back off, it is not retried on the way back
+		return err.(*workflow.ServiceBusyError).RetryAfterInMillis != nil
 	case *yarpcerrors.Status:
 		rpcErr := err.(*yarpcerrors.Status)
 		if rpcErr.Code() != yarpcerrors.CodeDeadlineExceeded {
@@ -224,7 +232,8 @@ func IsWhitelistServiceTransientError(err error) bool {
 	case *workflow.InternalServiceError:
 		return true
 	case *workflow.ServiceBusyError:
-		return true
+		// a busy error with a retry hint asks the caller to back off, it is not retried on the way back
+		return err.(*workflow.ServiceBusyError).RetryAfterInMillis == nil
 	case *workflow.LimitExceededError:
 		return true
 	case *h.ShardOwnershipLostError:
@@ -303,6 +312,7 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *h.RecordDecision
 		WorkflowExecutionTaskList: historyResponse.WorkflowExecutionTaskList,
 		EventStoreVersion:         historyResponse.EventStoreVersion,
 		BranchToken:               historyResponse.BranchToken,
+		Updates:                   historyResponse.Updates,
 	}
 	if historyResponse.GetPreviousStartedEventId() != EmptyEventID {
 		matchingResp.PreviousStartedEventId = historyResponse.PreviousStartedEventId
@@ -318,6 +328,14 @@ func MinInt64(a, b int64) int64 {
 	return b
 }
 
+// MaxInt64 returns the bigger of two given int64
+func MaxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 // MinInt32 return smaller one of two inputs int32
 func MinInt32(a, b int32) int32 {
 	if a < b {
@@ -326,6 +344,14 @@ func MinInt32(a, b int32) int32 {
 	return b
 }
 
+// MaxInt32 returns the bigger of two given int32
+func MaxInt32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 // MinInt returns the smaller of two given integers
 func MinInt(a, b int) int {
 	if a < b {
@@ -370,15 +396,48 @@ func CreateHistoryStartWorkflowRequest(domainID string, startRequest *workflow.S
 		DomainUUID:   StringPtr(domainID),
 		StartRequest: startRequest,
 	}
+	// the workflow is not started until the delay elapses, so both the retry expiration and
+	// the first cron schedule are computed from the delayed start time
+	now := time.Now()
+	delayStartSeconds := startRequest.GetDelayStartSeconds()
+	startTime := now.Add(time.Second * time.Duration(delayStartSeconds))
 	if startRequest.RetryPolicy != nil && startRequest.RetryPolicy.GetExpirationIntervalInSeconds() > 0 {
 		expirationInSeconds := startRequest.RetryPolicy.GetExpirationIntervalInSeconds()
-		deadline := time.Now().Add(time.Second * time.Duration(expirationInSeconds))
+		deadline := startTime.Add(time.Second * time.Duration(expirationInSeconds))
 		histRequest.ExpirationTimestamp = Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
 	}
-	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(cron.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), time.Now()))
+	cronBackoffSeconds := cron.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), startTime)
+	firstDecisionBackoffSeconds := delayStartSeconds + cronBackoffSeconds
+	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(firstDecisionBackoffSeconds)
+	// the workflow execution timeout covers the whole chain of runs, starting with the first one,
+	// which does not start before both the start delay and the cron backoff elapse
+	if startRequest.GetWorkflowExecutionTimeoutSeconds() > 0 {
+		firstRunStartTime := now.Add(time.Second * time.Duration(firstDecisionBackoffSeconds))
+		deadline := firstRunStartTime.Add(time.Second * time.Duration(startRequest.GetWorkflowExecutionTimeoutSeconds()))
+		histRequest.WorkflowExecutionExpirationTimestamp = Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
+	}
 	return histRequest
 }
 
+// CreateStartWorkflowExecutionBatchErrorResult creates the result of a request of a StartWorkflowExecutionBatch
+// which failed with the given error
+func CreateStartWorkflowExecutionBatchErrorResult(err error) *workflow.StartWorkflowExecutionBatchResult {
+	// thrift errors are reported by their type name, any other error is an internal error
+	errorType := fmt.Sprintf("%T", err)
+	errorType = errorType[strings.LastIndex(errorType, ".")+1:]
+	if errorType == "" || !unicode.IsUpper(rune(errorType[0])) {
+		errorType = "InternalServiceError"
+	}
+	errorMessage := err.Error()
+	if messageErr, ok := err.(interface{ GetMessage() string }); ok {
+		errorMessage = messageErr.GetMessage()
+	}
+	return &workflow.StartWorkflowExecutionBatchResult{
+		ErrorType:    StringPtr(errorType),
+		ErrorMessage: StringPtr(errorMessage),
+	}
+}
+
 // CheckEventBlobSizeLimit checks if a blob data exceeds limits. It logs a warning if it exceeds warnLimit,
 // and return ErrBlobSizeExceedsLimit if it exceeds errorLimit.
 func CheckEventBlobSizeLimit(actualSize, warnLimit, errorLimit int, domainID, workflowID, runID string, scope metrics.Scope, logger log.Logger) error {
@@ -23,10 +23,17 @@ package host
 import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"go.uber.org/yarpc"
 )
 
+const (
+	tchannelTransport = "tchannel"
+	grpcTransport     = "grpc"
+)
+
 // AdminClient is the interface exposed by admin service client
 type AdminClient interface {
 	adminserviceclient.Interface
@@ -46,3 +53,12 @@ func NewAdminClient(d *yarpc.Dispatcher) AdminClient {
 func NewFrontendClient(d *yarpc.Dispatcher) FrontendClient {
 	return workflowserviceclient.New(d.ClientConfig(common.FrontendServiceName))
 }
+
+// NewFrontendGRPCClient creates a client to cadence frontend which calls the frontend over gRPC
+func NewFrontendGRPCClient(d *yarpc.Dispatcher) FrontendClient {
+	return frontend.NewGRPCClient(apiv1.NewWorkflowServiceYARPCClient(d.ClientConfig(grpcOutboundName(common.FrontendServiceName))))
+}
+
+func grpcOutboundName(serviceName string) string {
+	return serviceName + "-grpc"
+}
@@ -136,6 +136,7 @@ func GetTestClusterConfig(configFile string) (*TestClusterConfig, error) {
 
 	options.EnableEventsV2 = TestFlags.EnableEventsV2
 	options.FrontendAddress = TestFlags.FrontendAddr
+	options.FrontendTransport = TestFlags.FrontendTransport
 	if options.ESConfig.Enable {
 		options.ESConfig.Indices[common.VisibilityAppName] += uuid.New()
 	}
@@ -23,6 +23,7 @@ package host
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 
 	"github.com/pborman/uuid"
@@ -43,7 +44,9 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/proto"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -56,7 +59,9 @@ import (
 	"github.com/uber/cadence/service/worker/replicator"
 	cwsc "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/middleware"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
@@ -104,6 +109,7 @@ type (
 		esConfig            *elasticsearch.Config
 		esClient            elasticsearch.Client
 		workerConfig        *WorkerConfig
+		frontendTransport   string
 	}
 
 	// HistoryConfig contains configs for history service
@@ -137,6 +143,7 @@ type (
 		ESConfig                      *elasticsearch.Config
 		ESClient                      elasticsearch.Client
 		WorkerConfig                  *WorkerConfig
+		FrontendTransport             string
 	}
 
 	membershipFactoryImpl struct {
@@ -169,6 +176,7 @@ func NewCadence(params *CadenceParams) Cadence {
 		blobstoreClient:     params.Blobstore,
 		historyConfig:       params.HistoryConfig,
 		workerConfig:        params.WorkerConfig,
+		frontendTransport:   params.FrontendTransport,
 	}
 }
 
@@ -239,6 +247,13 @@ func (c *cadenceImpl) FrontendAddress() string {
 	return cluster.TestCurrentClusterFrontendAddress
 }
 
+func (c *cadenceImpl) FrontendGRPCAddress() string {
+	if c.clusterNo != 0 {
+		return "127.0.0.1:8110"
+	}
+	return "127.0.0.1:7110"
+}
+
 func (c *cadenceImpl) FrontendPProfPort() int {
 	if c.clusterNo != 0 {
 		return 8105
@@ -309,6 +324,9 @@ func (c *cadenceImpl) GetAdminClient() adminserviceclient.Interface {
 }
 
 func (c *cadenceImpl) GetFrontendClient() workflowserviceclient.Interface {
+	if c.frontendTransport == grpcTransport {
+		return NewFrontendGRPCClient(c.frontEndService.GetDispatcher())
+	}
 	return NewFrontendClient(c.frontEndService.GetDispatcher())
 }
 
@@ -324,7 +342,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	params.Logger = c.logger
 	params.ThrottledLogger = c.logger
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.FrontendPProfPort())
-	params.RPCFactory = newRPCFactoryImpl(common.FrontendServiceName, c.FrontendAddress(), c.logger)
+	params.RPCFactory = newRPCFactoryImplWithGRPC(common.FrontendServiceName, c.FrontendAddress(), c.FrontendGRPCAddress(), c.logger)
 	params.MetricScope = tally.NewTestScope(common.FrontendServiceName, make(map[string]string))
 	params.MembershipFactory = newMembershipFactory(params.Name, hosts)
 	params.ClusterMetadata = c.clusterMetadata
@@ -362,6 +380,9 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	dcRedirectionHandler := frontend.NewDCRedirectionHandler(c.frontendHandler, params.DCRedirectionPolicy)
 	dcRedirectionHandler.RegisterHandler()
 
+	grpcHandler := frontend.NewGRPCHandler(c.frontEndService, dcRedirectionHandler)
+	grpcHandler.RegisterHandler()
+
 	// must start base service first
 	c.frontEndService.Start()
 	err = c.adminHandler.Start()
@@ -415,7 +436,7 @@ func (c *cadenceImpl) startHistory(hosts map[string][]string, startWG *sync.Wait
 			historyConfig.HistoryCountLimitError = dynamicconfig.GetIntPropertyFilteredByDomain(hConfig.HistoryCountLimitError)
 		}
 		handler := history.NewHandler(service, historyConfig, c.shardMgr, c.metadataMgr,
-			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.executionMgrFactory, params.PublicClient)
+			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.executionMgrFactory, params.PublicClient, c.blobstoreClient)
 		handler.RegisterHandler()
 
 		service.Start()
@@ -553,6 +574,7 @@ func (c *cadenceImpl) startWorkerClientWorker(params *service.BootstrapParams, s
 		HistoryManager:   c.historyMgr,
 		HistoryV2Manager: c.historyV2Mgr,
 		Blobstore:        blobstoreClient,
+		PayloadStore:     payload.NewStore(blobstoreClient),
 		DomainCache:      domainCache,
 		Config:           workerConfig.ArchiverConfig,
 	}
@@ -625,10 +647,11 @@ func newPProfInitializerImpl(logger log.Logger, port int) common.PProfInitialize
 }
 
 type rpcFactoryImpl struct {
-	ch          *tchannel.ChannelTransport
-	serviceName string
-	hostPort    string
-	logger      log.Logger
+	ch           *tchannel.ChannelTransport
+	serviceName  string
+	hostPort     string
+	grpcHostPort string
+	logger       log.Logger
 }
 
 func newRPCFactoryImpl(sName string, hostPort string, logger log.Logger) common.RPCFactory {
@@ -639,6 +662,15 @@ func newRPCFactoryImpl(sName string, hostPort string, logger log.Logger) common.
 	}
 }
 
+func newRPCFactoryImplWithGRPC(sName string, hostPort string, grpcHostPort string, logger log.Logger) common.RPCFactory {
+	return &rpcFactoryImpl{
+		serviceName:  sName,
+		hostPort:     hostPort,
+		grpcHostPort: grpcHostPort,
+		logger:       logger,
+	}
+}
+
 func (c *rpcFactoryImpl) CreateDispatcher() *yarpc.Dispatcher {
 	// Setup dispatcher for onebox
 	var err error
@@ -647,25 +679,41 @@ func (c *rpcFactoryImpl) CreateDispatcher() *yarpc.Dispatcher {
 	if err != nil {
 		c.logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
+	inbounds := yarpc.Inbounds{c.ch.NewInbound()}
+	// For integration tests to generate client out of the same outbound.
+	outbounds := yarpc.Outbounds{
+		c.serviceName: {Unary: c.ch.NewSingleOutbound(c.hostPort)},
+	}
+	if c.grpcHostPort != "" {
+		listener, err := net.Listen("tcp", c.grpcHostPort)
+		if err != nil {
+			c.logger.Fatal("Failed to listen on gRPC port", tag.Error(err))
+		}
+		grpcTransport := grpc.NewTransport()
+		inbounds = append(inbounds, grpcTransport.NewInbound(listener))
+		outbounds[grpcOutboundName(c.serviceName)] = transport.Outbounds{
+			ServiceName: c.serviceName,
+			Unary:       middleware.ApplyUnaryOutbound(grpcTransport.NewSingleOutbound(c.grpcHostPort), proto.ErrorDetailsOutboundMiddleware{}),
+		}
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
-		Name:     c.serviceName,
-		Inbounds: yarpc.Inbounds{c.ch.NewInbound()},
-		// For integration tests to generate client out of the same outbound.
-		Outbounds: yarpc.Outbounds{
-			c.serviceName: {Unary: c.ch.NewSingleOutbound(c.hostPort)},
-		},
+		Name:      c.serviceName,
+		Inbounds:  inbounds,
+		Outbounds: outbounds,
 		InboundMiddleware: yarpc.InboundMiddleware{
-			Unary: &versionMiddleware{},
+			Unary: &versionMiddleware{next: proto.ErrorDetailsInboundMiddleware{}},
 		},
 	})
 }
 
 type versionMiddleware struct {
+	// next is an optional middleware run after the version headers are set
+	next middleware.UnaryInbound
 }
 
 func (vm *versionMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, h transport.UnaryHandler) error {
 	req.Headers = req.Headers.With(common.LibraryVersionHeaderName, "1.0.0").With(common.FeatureVersionHeaderName, "1.0.0").With(common.ClientImplHeaderName, "uber-go")
-	return h.Handle(ctx, req, resw)
+	return middleware.ApplyUnaryInbound(h, vm.next).Handle(ctx, req, resw)
 }
 
 func (c *rpcFactoryImpl) CreateDispatcherForOutbound(
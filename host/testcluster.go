@@ -54,6 +54,7 @@ type (
 	// TestClusterConfig are config for a test cluster
 	TestClusterConfig struct {
 		FrontendAddress       string
+		FrontendTransport     string
 		EnableEventsV2        bool
 		EnableArchival        bool
 		IsMasterCluster       bool
@@ -159,6 +160,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		Blobstore:           blobstore.client,
 		HistoryConfig:       options.HistoryConfig,
 		WorkerConfig:        options.WorkerConfig,
+		FrontendTransport:   options.FrontendTransport,
 	}
 	cluster := NewCadence(cadenceParams)
 	if err := cluster.Start(); err != nil {
@@ -58,6 +58,45 @@ service AdminService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
     )
+
+  /**
+  * VerifyWorkflowExecutionHistory replays the history of the specified workflow execution into a fresh mutable
+  * state and reports mismatches against the persisted mutable state. An empty result means the history is consistent.
+  **/
+  VerifyWorkflowExecutionHistoryResponse VerifyWorkflowExecutionHistory(1: VerifyWorkflowExecutionHistoryRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * PromoteTaskListBuild promotes a worker binary checksum as the default build for new workflows on a decision
+  * task list. Their decision tasks are dispatched to pollers of that build first. An empty checksum clears it.
+  * The compatible checksums are builds which can continue the workflows of the default build and each other,
+  * decision tasks of workflows last advanced by any build of the set are dispatched to pollers of the whole set.
+  **/
+  void PromoteTaskListBuild(1: PromoteTaskListBuildRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * MigrateTaskListBacklog moves a page of the backlog of a task list to another task list of the domain, so that
+  * tasks are not stranded when a task list is renamed or its workers are retired. Call it again with the returned
+  * read level until there are no more tasks. With dryRun the tasks are only counted.
+  **/
+  MigrateTaskListBacklogResponse MigrateTaskListBacklog(1: MigrateTaskListBacklogRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
@@ -86,4 +125,37 @@ struct GetWorkflowExecutionRawHistoryResponse {
   20: optional list<shared.DataBlob> historyBatches
   30: optional map<string, shared.ReplicationInfo> replicationInfo
   40: optional i32 eventStoreVersion
-}
\ No newline at end of file
+}
+
+struct VerifyWorkflowExecutionHistoryRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+}
+
+struct VerifyWorkflowExecutionHistoryResponse {
+  10: optional list<string> mismatches
+}
+
+struct PromoteTaskListBuildRequest {
+  10: optional string domain
+  20: optional shared.TaskList taskList
+  30: optional string binaryChecksum
+  40: optional list<string> compatibleBinaryChecksums
+}
+
+struct MigrateTaskListBacklogRequest {
+  10: optional string domain
+  20: optional shared.TaskList sourceTaskList
+  30: optional shared.TaskList targetTaskList
+  40: optional shared.TaskListType taskListType
+  50: optional i64 (js.type = "Long") readLevel
+  60: optional i32 pageSize
+  70: optional bool dryRun
+}
+
+struct MigrateTaskListBacklogResponse {
+  10: optional i32 migratedTasks
+  20: optional i32 expiredTasks
+  30: optional i64 (js.type = "Long") nextReadLevel
+  40: optional bool hasMore
+}
@@ -116,6 +116,19 @@ service WorkflowService {
       8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * StartWorkflowExecutionBatch starts many workflow executions in one call. Each request is validated and handled
+  * like a StartWorkflowExecution, including its WorkflowIdReusePolicy, and the runId or the error of each request is
+  * returned at the same position of the results. Requests are sent to history in one call per shard.
+  **/
+  shared.StartWorkflowExecutionBatchResponse StartWorkflowExecutionBatch(1: shared.StartWorkflowExecutionBatchRequest batchRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * Returns the history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow
   * execution in unknown to the service.
@@ -380,6 +393,22 @@ service WorkflowService {
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * UpdateWorkflowExecution is used to send an update to a running workflow execution and wait for its result.
+  * The update is dispatched to the worker in a decision task, which either accepts it, resulting in a
+  * WorkflowExecutionUpdateAccepted event recorded in the history, or rejects it.
+  **/
+  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: shared.UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.
   * If the workflow is running, this results in WorkflowExecutionSignaled event being recorded in the history
@@ -49,6 +49,15 @@ struct StartWorkflowExecutionRequest {
   57: optional binary continuedFailureDetails
   58: optional binary lastCompletionResult
   60: optional i32 firstDecisionTaskBackoffSeconds
+  70: optional i64 (js.type = "Long") workflowExecutionExpirationTimestamp
+}
+
+struct StartWorkflowExecutionBatchRequest {
+  10: optional list<StartWorkflowExecutionRequest> startRequests
+}
+
+struct StartWorkflowExecutionBatchResponse {
+  10: optional list<shared.StartWorkflowExecutionBatchResult> results
 }
 
 struct DescribeMutableStateRequest{
@@ -61,6 +70,15 @@ struct DescribeMutableStateResponse{
   40: optional string mutableStateInDatabase
 }
 
+struct VerifyWorkflowExecutionHistoryRequest{
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+}
+
+struct VerifyWorkflowExecutionHistoryResponse{
+  10: optional list<string> mismatches
+}
+
 struct GetMutableStateRequest {
   10: optional string domainUUID
   20: optional shared.WorkflowExecution execution
@@ -169,6 +187,12 @@ struct RecordDecisionTaskStartedResponse {
   90: optional shared.TaskList WorkflowExecutionTaskList
   100: optional i32 eventStoreVersion
   110: optional binary branchToken
+  120: optional map<string, shared.WorkflowUpdate> updates
+}
+
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.UpdateWorkflowExecutionRequest updateRequest
 }
 
 struct SignalWorkflowExecutionRequest {
@@ -279,6 +303,18 @@ struct SyncActivityRequest {
   110: optional i32 attempt
 }
 
+struct GetRunningWorkflowCountsRequest {
+  10: optional string domainUUID
+  20: optional list<i32> shardIDs
+}
+
+struct GetRunningWorkflowCountsResponse {
+  // workflow type name -> number of running executions
+  10: optional map<string, i64> counts
+  // the shards which are owned by the host and included in the counts
+  20: optional list<i32> shardIDs
+}
+
 /**
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
@@ -301,6 +337,19 @@ service HistoryService {
       7: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * StartWorkflowExecutionBatch starts a batch of workflow executions whose workflowIds all belong to the same shard.
+  * Every request is handled as a StartWorkflowExecution, and its result or error is returned at the same position
+  * of the results.
+  **/
+  StartWorkflowExecutionBatchResponse StartWorkflowExecutionBatch(1: StartWorkflowExecutionBatchRequest batchRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * Returns the information from mutable state of workflow execution.
   * It fails with 'EntityNotExistError' if specified workflow execution in unknown to the service.
@@ -489,6 +538,21 @@ service HistoryService {
       7: shared.LimitExceededError limitExceededError,
     )
 
+  /**
+  * UpdateWorkflowExecution is used to send an update to a running workflow execution and wait until the update
+  * is accepted or rejected by the worker in a decision task.
+  **/
+  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.ServiceBusyError serviceBusyError,
+      7: shared.LimitExceededError limitExceededError,
+    )
+
   /**
   * SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
   * If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
@@ -677,6 +741,19 @@ service HistoryService {
       6: shared.LimitExceededError limitExceededError,
     )
 
+  /**
+  * VerifyWorkflowExecutionHistory replays the history of a workflow execution into a fresh mutable state and
+  * reports where the result differs from the persisted mutable state.
+  **/
+  VerifyWorkflowExecutionHistoryResponse VerifyWorkflowExecutionHistory(1: VerifyWorkflowExecutionHistoryRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * DescribeHistoryHost returns information about the internal states of a history host
   **/
@@ -686,4 +763,15 @@ service HistoryService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.AccessDeniedError accessDeniedError,
     )
+
+  /**
+  * GetRunningWorkflowCounts returns the number of running workflow executions of a domain per workflow type,
+  * summed over the requested shards which are owned by the host.
+  **/
+  GetRunningWorkflowCountsResponse GetRunningWorkflowCounts(1: GetRunningWorkflowCountsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
 }
@@ -43,6 +43,7 @@ struct PollForDecisionTaskResponse {
   100: optional shared.TaskList WorkflowExecutionTaskList
   110: optional i32 eventStoreVersion
   120: optional binary branchToken
+  130: optional map<string, shared.WorkflowUpdate> updates
 }
 
 struct PollForActivityTaskRequest {
@@ -57,6 +58,7 @@ struct AddDecisionTaskRequest {
   30: optional shared.TaskList taskList
   40: optional i64 (js.type = "Long") scheduleId
   50: optional i32 scheduleToStartTimeoutSeconds
+  60: optional string binaryChecksum
 }
 
 struct AddActivityTaskRequest {
@@ -66,6 +68,7 @@ struct AddActivityTaskRequest {
   40: optional shared.TaskList taskList
   50: optional i64 (js.type = "Long") scheduleId
   60: optional i32 scheduleToStartTimeoutSeconds
+  70: optional string activityType
 }
 
 struct QueryWorkflowRequest {
@@ -100,6 +103,40 @@ struct DescribeTaskListRequest {
 * decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
 * with completion or failure.
 **/
+struct AcquireDispatchTokensRequest {
+  10: optional string domainUUID
+  20: optional string activityType
+  30: optional i32 count
+}
+
+struct AcquireDispatchTokensResponse {
+  10: optional i32 granted
+}
+
+struct PromoteTaskListBuildRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional string binaryChecksum
+  40: optional list<string> compatibleBinaryChecksums
+}
+
+struct MigrateTaskListBacklogRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList sourceTaskList
+  30: optional shared.TaskList targetTaskList
+  40: optional shared.TaskListType taskListType
+  50: optional i64 (js.type = "Long") readLevel
+  60: optional i32 pageSize
+  70: optional bool dryRun
+}
+
+struct MigrateTaskListBacklogResponse {
+  10: optional i32 migratedTasks
+  20: optional i32 expiredTasks
+  30: optional i64 (js.type = "Long") nextReadLevel
+  40: optional bool hasMore
+}
+
 service MatchingService {
   /**
   * PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A
@@ -203,4 +240,37 @@ service MatchingService {
         3: shared.EntityNotExistsError entityNotExistError,
         4: shared.ServiceBusyError serviceBusyError,
       )
+
+  /**
+  * AcquireDispatchTokens is called by matching hosts on the host owning the dispatch budget of an activity type,
+  * to lease tokens for dispatching activity tasks of that type. Fewer tokens than requested may be granted.
+  **/
+  AcquireDispatchTokensResponse AcquireDispatchTokens(1: AcquireDispatchTokensRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * PromoteTaskListBuild sets the worker binary checksum whose pollers receive the decision tasks of new workflows
+  * on the decision task list, along with the builds compatible with it. An empty checksum clears the default build.
+  **/
+  void PromoteTaskListBuild(1: PromoteTaskListBuildRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * MigrateTaskListBacklog moves a page of the persisted tasks of a task list, starting after the read level, to
+  * another task list of the domain. The tasks are added to the target task list and completed in the source one.
+  **/
+  MigrateTaskListBacklogResponse MigrateTaskListBacklog(1: MigrateTaskListBacklogRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
 }
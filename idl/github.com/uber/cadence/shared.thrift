@@ -44,6 +44,7 @@ exception EntityNotExistsError {
 
 exception ServiceBusyError {
   1: required string message
+  2: optional i64 (js.type = "Long") retryAfterInMillis
 }
 
 exception CancellationAlreadyRequestedError {
@@ -99,6 +100,11 @@ enum WorkflowIdReusePolicy {
    * do not allow start a workflow execution using the same workflow ID at all
    */
   RejectDuplicate,
+  /*
+   * terminate the running workflow execution with the same workflow ID and start the new one
+   * in the same transaction, when workflow not running it is the same as AllowDuplicate.
+   */
+  TerminateIfRunning,
 }
 
 enum DomainStatus {
@@ -175,6 +181,7 @@ enum EventType {
   SignalExternalWorkflowExecutionInitiated,
   SignalExternalWorkflowExecutionFailed,
   ExternalWorkflowExecutionSignaled,
+  WorkflowExecutionUpdateAccepted,
 }
 
 enum DecisionTaskFailedCause {
@@ -233,6 +240,11 @@ enum QueryTaskCompletedType {
   FAILED,
 }
 
+enum WorkflowUpdateResultType {
+  ACCEPTED,
+  REJECTED,
+}
+
 enum PendingActivityState {
   SCHEDULED,
   STARTED,
@@ -298,6 +310,14 @@ struct Memo {
   10: optional map<string,binary> fields
 }
 
+// PayloadReference points to a payload which was offloaded to the blobstore
+// because it exceeded the domain's offload threshold.
+struct PayloadReference {
+  10: optional string bucket
+  20: optional string key
+  30: optional i64 (js.type = "Long") size
+}
+
 struct WorkflowExecutionInfo {
   10: optional WorkflowExecution execution
   20: optional WorkflowType type
@@ -310,6 +330,7 @@ struct WorkflowExecutionInfo {
   90: optional i64 (js.type = "Long") executionTime
   100: optional Memo memo
   110: optional ResetPoints autoResetPoints
+  120: optional i64 (js.type = "Long") workflowExecutionExpirationTime
 }
 
 struct WorkflowExecutionConfiguration {
@@ -317,6 +338,8 @@ struct WorkflowExecutionConfiguration {
   20: optional i32 executionStartToCloseTimeoutSeconds
   30: optional i32 taskStartToCloseTimeoutSeconds
   40: optional ChildPolicy childPolicy
+  50: optional i32 retentionPeriodInDays
+  60: optional i32 workflowExecutionTimeoutSeconds
 }
 
 struct TransientDecisionInfo {
@@ -401,6 +424,7 @@ struct ContinueAsNewWorkflowExecutionDecisionAttributes {
   110: optional binary lastCompletionResult
   120: optional string cronSchedule
   130: optional Header header
+  140: optional i32 retentionPeriodInDays
 }
 
 struct StartChildWorkflowExecutionDecisionAttributes {
@@ -459,12 +483,40 @@ struct WorkflowExecutionStartedEventAttributes {
   120: optional Memo memo
   130: optional ResetPoints prevAutoResetPoints
   140: optional Header header
+  150: optional i32 retentionPeriodInDays
+  160: optional PayloadReference inputReference
+  170: optional i32 workflowExecutionTimeoutSeconds
+  180: optional i64 (js.type = "Long") workflowExecutionExpirationTimestamp
 }
 
 struct ResetPoints{
   10: optional list<ResetPointInfo> points
 }
 
+struct DelayedSignals {
+  10: optional list<DelayedSignalInfo> signals
+}
+
+struct DelayedSignalInfo {
+  10: optional string requestId
+  20: optional string signalName
+  30: optional binary input
+  40: optional string identity
+  50: optional string runId // only set if the signal is for this run, otherwise it is delivered to the current run
+  60: optional i64 (js.type = "Long") deliverAtTimestamp
+  70: optional i64 (js.type = "Long") version
+}
+
+struct AcceptedWorkflowUpdates {
+  10: optional list<AcceptedWorkflowUpdateInfo> updates
+}
+
+struct AcceptedWorkflowUpdateInfo {
+  10: optional string updateId
+  20: optional i64 (js.type = "Long") eventId
+  30: optional i64 (js.type = "Long") eventBatchId
+}
+
  struct ResetPointInfo{
   10: optional string binaryChecksum
   20: optional string runId
@@ -477,6 +529,7 @@ struct ResetPoints{
 struct WorkflowExecutionCompletedEventAttributes {
   10: optional binary result
   20: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  30: optional PayloadReference resultReference
 }
 
 struct WorkflowExecutionFailedEventAttributes {
@@ -563,6 +616,7 @@ struct ActivityTaskScheduledEventAttributes {
   90: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   110: optional RetryPolicy retryPolicy
   120: optional Header header
+  130: optional PayloadReference inputReference
 }
 
 struct ActivityTaskStartedEventAttributes {
@@ -577,6 +631,7 @@ struct ActivityTaskCompletedEventAttributes {
   20: optional i64 (js.type = "Long") scheduledEventId
   30: optional i64 (js.type = "Long") startedEventId
   40: optional string identity
+  50: optional PayloadReference resultReference
 }
 
 struct ActivityTaskFailedEventAttributes {
@@ -661,6 +716,17 @@ struct WorkflowExecutionSignaledEventAttributes {
   10: optional string signalName
   20: optional binary input
   30: optional string identity
+  40: optional PayloadReference inputReference
+  50: optional string requestId
+}
+
+struct WorkflowExecutionUpdateAcceptedEventAttributes {
+  10: optional string updateId
+  20: optional string updateName
+  30: optional binary input
+  40: optional binary result
+  50: optional string identity
+  60: optional i64 (js.type = "Long") decisionTaskCompletedEventId
 }
 
 struct WorkflowExecutionTerminatedEventAttributes {
@@ -845,6 +911,7 @@ struct HistoryEvent {
   420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes
   430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
   440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
+  450: optional WorkflowExecutionUpdateAcceptedEventAttributes workflowExecutionUpdateAcceptedEventAttributes
 }
 
 struct History {
@@ -940,12 +1007,19 @@ struct DescribeDomainRequest {
   20: optional string uuid
 }
 
+struct WorkflowTypeConcurrency {
+  10: optional string workflowType
+  20: optional i64 (js.type = "Long") runningExecutions
+  30: optional i32 limit
+}
+
 struct DescribeDomainResponse {
   10: optional DomainInfo domainInfo
   20: optional DomainConfiguration configuration
   30: optional DomainReplicationConfiguration replicationConfiguration
   40: optional i64 (js.type = "Long") failoverVersion
   50: optional bool isGlobalDomain
+  60: optional list<WorkflowTypeConcurrency> workflowTypeConcurrency
 }
 
 struct UpdateDomainRequest {
@@ -986,12 +1060,31 @@ struct StartWorkflowExecutionRequest {
   130: optional string cronSchedule
   140: optional Memo memo
   150: optional Header header
+  160: optional i32 retentionPeriodInDays
+  170: optional i32 delayStartSeconds
+  180: optional i32 workflowExecutionTimeoutSeconds
 }
 
 struct StartWorkflowExecutionResponse {
   10: optional string runId
 }
 
+struct StartWorkflowExecutionBatchRequest {
+  10: optional list<StartWorkflowExecutionRequest> requests
+}
+
+// StartWorkflowExecutionBatchResult is the outcome of one request of a batch, either the runId of the started
+// workflow or the type and message of the error that failed the request
+struct StartWorkflowExecutionBatchResult {
+  10: optional string runId
+  20: optional string errorType
+  30: optional string errorMessage
+}
+
+struct StartWorkflowExecutionBatchResponse {
+  10: optional list<StartWorkflowExecutionBatchResult> results
+}
+
 struct PollForDecisionTaskRequest {
   10: optional string domain
   20: optional TaskList taskList
@@ -1011,6 +1104,7 @@ struct PollForDecisionTaskResponse {
   70: optional binary nextPageToken
   80: optional WorkflowQuery query
   90: optional TaskList WorkflowExecutionTaskList
+  100: optional map<string, WorkflowUpdate> updates
 }
 
 struct StickyExecutionAttributes {
@@ -1027,6 +1121,7 @@ struct RespondDecisionTaskCompletedRequest {
   60: optional bool returnNewDecisionTask
   70: optional bool forceCreateNewDecisionTask
   80: optional string binaryChecksum
+  90: optional map<string, WorkflowUpdateResult> updateResults
 }
 
 struct RespondDecisionTaskCompletedResponse {
@@ -1064,6 +1159,7 @@ struct PollForActivityTaskResponse {
   150: optional WorkflowType workflowType
   160: optional string workflowDomain
   170: optional Header header
+  180: optional PayloadReference inputReference
 }
 
 struct RecordActivityTaskHeartbeatRequest {
@@ -1146,6 +1242,25 @@ struct GetWorkflowExecutionHistoryRequest {
   40: optional binary nextPageToken
   50: optional bool waitForNewEvent
   60: optional HistoryEventFilterType HistoryEventFilterType
+  70: optional HistoryEventFilter eventFilter
+  // return the events in descending order of event ID, the latest events first
+  80: optional bool reverse
+}
+
+// HistoryEventFilter selects the events returned by GetWorkflowExecutionHistory, an event is returned when
+// it matches every field that is set. Pages of a filtered history may be smaller than maximumPageSize.
+struct HistoryEventFilter {
+  10: optional list<EventType> eventTypes
+  // inclusive event ID range
+  20: optional i64 (js.type = "Long") minEventId
+  30: optional i64 (js.type = "Long") maxEventId
+  // inclusive timestamp range in nanoseconds
+  40: optional i64 (js.type = "Long") minTimestamp
+  50: optional i64 (js.type = "Long") maxTimestamp
+  // events of the activity, including the events referring to its scheduled event
+  60: optional string activityId
+  // events of the timer
+  70: optional string timerId
 }
 
 struct GetWorkflowExecutionHistoryResponse {
@@ -1162,6 +1277,7 @@ struct SignalWorkflowExecutionRequest {
   50: optional string identity
   60: optional string requestId
   70: optional binary control
+  80: optional i64 (js.type = "Long") deliverAtTimestamp
 }
 
 struct SignalWithStartWorkflowExecutionRequest {
@@ -1182,6 +1298,9 @@ struct SignalWithStartWorkflowExecutionRequest {
   150: optional string cronSchedule
   160: optional Memo memo
   170: optional Header header
+  180: optional i32 retentionPeriodInDays
+  190: optional i32 delayStartSeconds
+  200: optional i32 workflowExecutionTimeoutSeconds
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1269,6 +1388,33 @@ struct WorkflowQuery {
   20: optional binary queryArgs
 }
 
+struct WorkflowUpdate {
+  10: optional string updateName
+  20: optional binary input
+  30: optional string identity
+}
+
+struct WorkflowUpdateResult {
+  10: optional WorkflowUpdateResultType resultType
+  20: optional binary result
+  30: optional string rejectReason
+}
+
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string updateName
+  40: optional binary input
+  50: optional string identity
+  60: optional string requestId
+  70: optional i32 timeoutSeconds
+}
+
+struct UpdateWorkflowExecutionResponse {
+  10: optional string runId
+  20: optional WorkflowUpdateResult updateResult
+}
+
 struct ResetStickyTaskListRequest {
   10: optional string domain
   20: optional WorkflowExecution execution
@@ -1328,6 +1474,18 @@ struct TaskListStatus {
   30: optional i64 (js.type = "Long") ackLevel
   35: optional double ratePerSecond
   40: optional TaskIDBlock taskIDBlock
+  50: optional i64 (js.type = "Long") oldestUnackedTaskAgeInMillis
+  60: optional TaskListLatencyStats scheduleToStartLatency
+  70: optional i64 (js.type = "Long") syncMatchCount
+  80: optional i64 (js.type = "Long") backlogMatchCount
+}
+
+struct TaskListLatencyStats {
+  10: optional i64 (js.type = "Long") sampleCount
+  20: optional i64 (js.type = "Long") p50InMillis
+  30: optional i64 (js.type = "Long") p95InMillis
+  40: optional i64 (js.type = "Long") p99InMillis
+  50: optional i64 (js.type = "Long") maxInMillis
 }
 
 struct TaskIDBlock {
@@ -32,6 +32,7 @@ struct ShardInfo {
   34: optional map<string, i64> clusterTransferAckLevel
   36: optional map<string, i64> clusterTimerAckLevel
   38: optional string owner
+  40: optional map<string, map<string, i64>> runningWorkflowCounts
 }
 
 struct DomainInfo {
@@ -120,6 +121,17 @@ struct WorkflowExecutionInfo {
   114: optional string clientImpl
   115: optional binary autoResetPoints
   116: optional string autoResetPointsEncoding
+  118: optional i32 retentionDays
+  120: optional i32 workflowExecutionTimeout
+  122: optional i64 (js.type = "Long") workflowExpirationTimeNanos
+  124: optional map<string, i64> recentSignalRequestIDs
+  126: optional i32 decisionHeartbeatCount
+  128: optional i64 (js.type = "Long") decisionHeartbeatTimeNanos
+  130: optional string lastBinaryChecksum
+  132: optional binary delayedSignals
+  134: optional string delayedSignalsEncoding
+  136: optional binary acceptedUpdates
+  138: optional string acceptedUpdatesEncoding
 }
 
 struct ActivityInfo {
@@ -193,6 +205,9 @@ struct TaskInfo {
   12: optional binary runID
   13: optional i64 (js.type = "Long") scheduleID
   14: optional i64 (js.type = "Long") expiryTimeNanos
+  16: optional string activityType
+  18: optional string binaryChecksum
+  20: optional i64 (js.type = "Long") createdTimeNanos
 }
 
 struct TaskListInfo {
@@ -200,6 +215,8 @@ struct TaskListInfo {
   12: optional i64 (js.type = "Long") ackLevel
   14: optional i64 (js.type = "Long") expiryTimeNanos
   16: optional i64 (js.type = "Long") lastUpdatedNanos
+  18: optional string defaultBinaryChecksum
+  20: optional list<string> compatibleBinaryChecksums
 }
 
 struct TransferTaskInfo {
@@ -215,6 +232,7 @@ struct TransferTaskInfo {
   28: optional i64 (js.type = "Long") scheduleID
   30: optional i64 (js.type = "Long") version
   32: optional i64 (js.type = "Long") visibilityTimestampNanos
+  34: optional binary traceContext
 }
 
 struct TimerTaskInfo {
@@ -226,6 +244,7 @@ struct TimerTaskInfo {
   20: optional i64 (js.type = "Long") version
   22: optional i64 (js.type = "Long") scheduleAttempt
   24: optional i64 (js.type = "Long") eventID
+  26: optional string signalRequestID
 }
 
 struct ReplicationTaskInfo {
@@ -245,4 +245,15 @@ CREATE TABLE history_tree (
   data           BLOB NOT NULL,
   data_encoding  VARCHAR(16) NOT NULL,
   PRIMARY KEY (shard_id, tree_id, branch_id)
-);
\ No newline at end of file
+);
+
+-- heartbeats of the hosts, used by the persistence based membership provider
+CREATE TABLE cluster_membership (
+  role VARCHAR(64) NOT NULL,
+  rpc_address VARCHAR(128) NOT NULL,
+  --
+  session_start DATETIME(6) NOT NULL,
+  last_heartbeat DATETIME(6) NOT NULL,
+  record_expiry DATETIME(6) NOT NULL,
+  PRIMARY KEY (role, rpc_address)
+);
@@ -0,0 +1,9 @@
+CREATE TABLE cluster_membership (
+  role VARCHAR(64) NOT NULL,
+  rpc_address VARCHAR(128) NOT NULL,
+  --
+  session_start DATETIME(6) NOT NULL,
+  last_heartbeat DATETIME(6) NOT NULL,
+  record_expiry DATETIME(6) NOT NULL,
+  PRIMARY KEY (role, rpc_address)
+);
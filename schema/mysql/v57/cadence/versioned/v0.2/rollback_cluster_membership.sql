@@ -0,0 +1 @@
+DROP TABLE cluster_membership;
@@ -33,8 +33,10 @@ import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
 	h "github.com/uber/cadence/.gen/go/history"
 	hist "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
@@ -54,6 +56,7 @@ type (
 		numberOfHistoryShards int
 		service.Service
 		history       history.Client
+		matching      matching.Client
 		domainCache   cache.DomainCache
 		metricsClient metrics.Client
 		historyMgr    persistence.HistoryManager
@@ -93,6 +96,8 @@ func (adh *AdminHandler) Start() error {
 	adh.domainCache.Start()
 
 	adh.history = adh.GetClientBean().GetHistoryClient()
+	adh.matching = matching.NewRetryableClient(adh.GetClientBean().GetMatchingClient(),
+		common.CreateMatchingServiceRetryPolicy(), common.IsWhitelistServiceTransientError)
 	adh.metricsClient = adh.Service.GetMetricsClient()
 	adh.startWG.Done()
 	return nil
@@ -146,6 +151,126 @@ func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request
 	}, err
 }
 
+// VerifyWorkflowExecutionHistory replays the history of the specified workflow execution and reports
+// where the replayed mutable state differs from the persisted one
+func (adh *AdminHandler) VerifyWorkflowExecutionHistory(
+	ctx context.Context, request *admin.VerifyWorkflowExecutionHistoryRequest) (resp *admin.VerifyWorkflowExecutionHistoryResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminVerifyWorkflowExecutionHistoryScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp2, err := adh.history.VerifyWorkflowExecutionHistory(ctx, &hist.VerifyWorkflowExecutionHistoryRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.VerifyWorkflowExecutionHistoryResponse{
+		Mismatches: resp2.Mismatches,
+	}, nil
+}
+
+// PromoteTaskListBuild promotes a worker build as the default for new workflows on a decision task list
+func (adh *AdminHandler) PromoteTaskListBuild(ctx context.Context, request *admin.PromoteTaskListBuildRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminPromoteTaskListBuildScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if request.TaskList == nil || request.TaskList.GetName() == "" {
+		return adh.error(errTaskListNotSet, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.matching.PromoteTaskListBuild(ctx, &m.PromoteTaskListBuildRequest{
+		DomainUUID:                common.StringPtr(domainID),
+		TaskList:                  request.TaskList,
+		BinaryChecksum:            request.BinaryChecksum,
+		CompatibleBinaryChecksums: request.CompatibleBinaryChecksums,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// MigrateTaskListBacklog moves a page of the backlog of a task list to another task list of the domain
+func (adh *AdminHandler) MigrateTaskListBacklog(
+	ctx context.Context,
+	request *admin.MigrateTaskListBacklogRequest,
+) (resp *admin.MigrateTaskListBacklogResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminMigrateTaskListBacklogScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.SourceTaskList == nil || request.SourceTaskList.GetName() == "" ||
+		request.TargetTaskList == nil || request.TargetTaskList.GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if request.SourceTaskList.GetName() == request.TargetTaskList.GetName() {
+		return nil, adh.error(&gen.BadRequestError{Message: "Source and target task lists are the same."}, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	response, err := adh.matching.MigrateTaskListBacklog(ctx, &m.MigrateTaskListBacklogRequest{
+		DomainUUID:     common.StringPtr(domainID),
+		SourceTaskList: request.SourceTaskList,
+		TargetTaskList: request.TargetTaskList,
+		TaskListType:   request.TaskListType,
+		ReadLevel:      request.ReadLevel,
+		PageSize:       request.PageSize,
+		DryRun:         request.DryRun,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.MigrateTaskListBacklogResponse{
+		MigratedTasks: response.MigratedTasks,
+		ExpiredTasks:  response.ExpiredTasks,
+		NextReadLevel: response.NextReadLevel,
+		HasMore:       response.HasMore,
+	}, nil
+}
+
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.DescribeHistoryHostRequest) (resp *gen.DescribeHistoryHostResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
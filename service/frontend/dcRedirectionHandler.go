@@ -22,6 +22,7 @@ package frontend
 
 import (
 	"context"
+	"sync"
 
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
 	"github.com/uber/cadence/.gen/go/health"
@@ -697,6 +698,71 @@ func (handler *DCRedirectionHandlerImpl) StartWorkflowExecution(
 	return resp, err
 }
 
+// StartWorkflowExecutionBatch API call
+func (handler *DCRedirectionHandlerImpl) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionBatchRequest,
+) (*shared.StartWorkflowExecutionBatchResponse, error) {
+
+	if request == nil || len(request.Requests) == 0 || len(request.Requests) > handler.config.StartWorkflowBatchMaxSize() {
+		return handler.frontendHandler.StartWorkflowExecutionBatch(ctx, request)
+	}
+
+	// requests of a batch may target different domains, so they are grouped by the data center
+	// their domain is sent to, and every group is started as a batch of its own
+	results := make([]*shared.StartWorkflowExecutionBatchResult, len(request.Requests))
+	targetDCIndexes := make(map[string][]int)
+	for i, startRequest := range request.Requests {
+		targetDC := handler.currentClusterName
+		if startRequest != nil {
+			var err error
+			if targetDC, err = handler.getTargetDataCenter(startRequest.GetDomain()); err != nil {
+				results[i] = common.CreateStartWorkflowExecutionBatchErrorResult(err)
+				continue
+			}
+		}
+		targetDCIndexes[targetDC] = append(targetDCIndexes[targetDC], i)
+	}
+	if len(targetDCIndexes[handler.currentClusterName]) == len(request.Requests) {
+		return handler.frontendHandler.StartWorkflowExecutionBatch(ctx, request)
+	}
+
+	var wg sync.WaitGroup
+	for targetDC, indexes := range targetDCIndexes {
+		wg.Add(1)
+		go func(targetDC string, indexes []int) {
+			defer wg.Done()
+			// every group fills the results of its own requests only
+			batchRequest := &shared.StartWorkflowExecutionBatchRequest{}
+			for _, index := range indexes {
+				batchRequest.Requests = append(batchRequest.Requests, request.Requests[index])
+			}
+			var resp *shared.StartWorkflowExecutionBatchResponse
+			var err error
+			switch {
+			case targetDC == handler.currentClusterName:
+				resp, err = handler.frontendHandler.StartWorkflowExecutionBatch(ctx, batchRequest)
+			default:
+				remoteClient := handler.service.GetClientBean().GetRemoteFrontendClient(targetDC)
+				resp, err = remoteClient.StartWorkflowExecutionBatch(ctx, batchRequest)
+			}
+			if err == nil && len(resp.Results) != len(indexes) {
+				err = &shared.InternalServiceError{Message: "Unexpected number of results from " + targetDC + "."}
+			}
+			for j, index := range indexes {
+				if err != nil {
+					results[index] = common.CreateStartWorkflowExecutionBatchErrorResult(err)
+					continue
+				}
+				results[index] = resp.Results[j]
+			}
+		}(targetDC, indexes)
+	}
+	wg.Wait()
+
+	return &shared.StartWorkflowExecutionBatchResponse{Results: results}, nil
+}
+
 // TerminateWorkflowExecution API call
 func (handler *DCRedirectionHandlerImpl) TerminateWorkflowExecution(
 	ctx context.Context,
@@ -715,6 +781,32 @@ func (handler *DCRedirectionHandlerImpl) TerminateWorkflowExecution(
 	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).TerminateWorkflowExecution(ctx, request)
 }
 
+// UpdateWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	targetDC, err := handler.redirectionPolicy.GetTargetDataCenterByName(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *shared.UpdateWorkflowExecutionResponse
+
+	err = handler.withDomainNotActiveRedirect(request.GetDomain(), targetDC, func(targetDC string) error {
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.service.GetClientBean().GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.UpdateWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+	return resp, err
+}
+
 func (handler *DCRedirectionHandlerImpl) isDomainNotActiveError(err error) (string, bool) {
 	domainNotActiveErr, ok := err.(*shared.DomainNotActiveError)
 	if !ok {
@@ -746,6 +838,23 @@ func (handler *DCRedirectionHandlerImpl) enableDomainNotActiveAutoForwarding(dom
 	return true, domainEntry.GetReplicationConfig().ActiveClusterName, nil
 }
 
+// getTargetDataCenter returns the data center the requests of the domain are sent to, which is the active
+// cluster of the domain if domain not active forwarding is enabled
+func (handler *DCRedirectionHandlerImpl) getTargetDataCenter(domain string) (string, error) {
+	targetDC, err := handler.redirectionPolicy.GetTargetDataCenterByName(domain)
+	if err != nil {
+		return "", err
+	}
+	enableDomainNotActiveForwarding, activeCluster, err := handler.enableDomainNotActiveAutoForwarding(domain)
+	if err != nil {
+		return "", err
+	}
+	if enableDomainNotActiveForwarding {
+		targetDC = activeCluster
+	}
+	return targetDC, nil
+}
+
 func (handler *DCRedirectionHandlerImpl) withDomainNotActiveRedirect(domain string, targetDC string, call func(string) error) error {
 	enableDomainNotActiveForwarding, activeCluster, err := handler.enableDomainNotActiveAutoForwarding(domain)
 	if err != nil {
@@ -566,6 +566,39 @@ func (s *dcRedirectionHandlerSuite) TestStartWorkflow_DomainNotActive_GlobalDoma
 	s.Equal(resp, result)
 }
 
+func (s *dcRedirectionHandlerSuite) TestStartWorkflowBatch_DomainActive_LocalDomain() {
+	req := s.getStartRequest()
+	result := &shared.StartWorkflowExecutionBatchResult{RunId: common.StringPtr(uuid.New())}
+
+	s.setupLocalDomain()
+	s.mockHistoryClient.On("StartWorkflowExecutionBatch", mock.Anything, mock.MatchedBy(func(input *h.StartWorkflowExecutionBatchRequest) bool {
+		return len(input.StartRequests) == 1 && reflect.DeepEqual(req, input.StartRequests[0].StartRequest)
+	})).Return(&h.StartWorkflowExecutionBatchResponse{Results: []*shared.StartWorkflowExecutionBatchResult{result}}, nil).Once()
+	resp, err := s.handler.StartWorkflowExecutionBatch(context.Background(), &shared.StartWorkflowExecutionBatchRequest{
+		Requests: []*shared.StartWorkflowExecutionRequest{req},
+	})
+	s.Nil(err)
+	s.Equal([]*shared.StartWorkflowExecutionBatchResult{result}, resp.Results)
+}
+
+func (s *dcRedirectionHandlerSuite) TestStartWorkflowBatch_DomainNotActive_GlobalDomain_TwoCluster_ForwardingEnabled_DomainRecordNotActive() {
+	req := s.getStartRequest()
+	result := &shared.StartWorkflowExecutionBatchResult{RunId: common.StringPtr(uuid.New())}
+
+	s.setupGlobalDomainWithTwoReplicationCluster(true, false)
+	// the request of the global domain is forwarded to its active cluster, the invalid one is rejected locally
+	s.mockRemoteFrontendClient.On("StartWorkflowExecutionBatch", mock.Anything, &shared.StartWorkflowExecutionBatchRequest{
+		Requests: []*shared.StartWorkflowExecutionRequest{req},
+	}).Return(&shared.StartWorkflowExecutionBatchResponse{Results: []*shared.StartWorkflowExecutionBatchResult{result}}, nil).Once()
+	resp, err := s.handler.StartWorkflowExecutionBatch(context.Background(), &shared.StartWorkflowExecutionBatchRequest{
+		Requests: []*shared.StartWorkflowExecutionRequest{req, nil},
+	})
+	s.Nil(err)
+	s.Len(resp.Results, 2)
+	s.Equal(result, resp.Results[0])
+	s.Equal("BadRequestError", resp.Results[1].GetErrorType())
+}
+
 func (s *dcRedirectionHandlerSuite) setupLocalDomain() {
 	domainName := "some random domain name"
 	domainID := "some random domain ID"
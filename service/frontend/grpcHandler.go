@@ -0,0 +1,508 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common/proto"
+	"github.com/uber/cadence/common/service"
+)
+
+var _ apiv1.WorkflowServiceYARPCServer = (*GRPCHandler)(nil)
+
+type (
+	// GRPCHandler serves the protobuf flavour of the WorkflowService. Every call is
+	// translated into its thrift equivalent and served by the thrift handler.
+	GRPCHandler struct {
+		service service.Service
+		handler workflowserviceserver.Interface
+	}
+)
+
+// NewGRPCHandler creates a gRPC handler for the cadence service, frontend
+func NewGRPCHandler(service service.Service, handler workflowserviceserver.Interface) *GRPCHandler {
+	return &GRPCHandler{
+		service: service,
+		handler: handler,
+	}
+}
+
+// RegisterHandler register this handler, must be called before Start()
+func (g *GRPCHandler) RegisterHandler() {
+	g.service.GetDispatcher().Register(apiv1.BuildWorkflowServiceYARPCProcedures(g))
+}
+
+// CountWorkflowExecutions API call
+func (g *GRPCHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.CountWorkflowExecutionsRequest,
+) (*apiv1.CountWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.CountWorkflowExecutions(ctx, proto.ToCountWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromCountWorkflowExecutionsResponse(response), nil
+}
+
+// DeprecateDomain API call
+func (g *GRPCHandler) DeprecateDomain(
+	ctx context.Context,
+	request *apiv1.DeprecateDomainRequest,
+) (*apiv1.DeprecateDomainResponse, error) {
+
+	if err := g.handler.DeprecateDomain(ctx, proto.ToDeprecateDomainRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.DeprecateDomainResponse{}, nil
+}
+
+// DescribeDomain API call
+func (g *GRPCHandler) DescribeDomain(
+	ctx context.Context,
+	request *apiv1.DescribeDomainRequest,
+) (*apiv1.DescribeDomainResponse, error) {
+
+	response, err := g.handler.DescribeDomain(ctx, proto.ToDescribeDomainRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeDomainResponse(response), nil
+}
+
+// DescribeTaskList API call
+func (g *GRPCHandler) DescribeTaskList(
+	ctx context.Context,
+	request *apiv1.DescribeTaskListRequest,
+) (*apiv1.DescribeTaskListResponse, error) {
+
+	response, err := g.handler.DescribeTaskList(ctx, proto.ToDescribeTaskListRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeTaskListResponse(response), nil
+}
+
+// DescribeWorkflowExecution API call
+func (g *GRPCHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.DescribeWorkflowExecutionRequest,
+) (*apiv1.DescribeWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.DescribeWorkflowExecution(ctx, proto.ToDescribeWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeWorkflowExecutionResponse(response), nil
+}
+
+// GetWorkflowExecutionHistory API call
+func (g *GRPCHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *apiv1.GetWorkflowExecutionHistoryRequest,
+) (*apiv1.GetWorkflowExecutionHistoryResponse, error) {
+
+	response, err := g.handler.GetWorkflowExecutionHistory(ctx, proto.ToGetWorkflowExecutionHistoryRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromGetWorkflowExecutionHistoryResponse(response), nil
+}
+
+// ListClosedWorkflowExecutions API call
+func (g *GRPCHandler) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListClosedWorkflowExecutionsRequest,
+) (*apiv1.ListClosedWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ListClosedWorkflowExecutions(ctx, proto.ToListClosedWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListClosedWorkflowExecutionsResponse(response), nil
+}
+
+// ListDomains API call
+func (g *GRPCHandler) ListDomains(
+	ctx context.Context,
+	request *apiv1.ListDomainsRequest,
+) (*apiv1.ListDomainsResponse, error) {
+
+	response, err := g.handler.ListDomains(ctx, proto.ToListDomainsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListDomainsResponse(response), nil
+}
+
+// ListOpenWorkflowExecutions API call
+func (g *GRPCHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListOpenWorkflowExecutionsRequest,
+) (*apiv1.ListOpenWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ListOpenWorkflowExecutions(ctx, proto.ToListOpenWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListOpenWorkflowExecutionsResponse(response), nil
+}
+
+// ListWorkflowExecutions API call
+func (g *GRPCHandler) ListWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListWorkflowExecutionsRequest,
+) (*apiv1.ListWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ListWorkflowExecutions(ctx, proto.ToListWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListWorkflowExecutionsResponse(response), nil
+}
+
+// PollForActivityTask API call
+func (g *GRPCHandler) PollForActivityTask(
+	ctx context.Context,
+	request *apiv1.PollForActivityTaskRequest,
+) (*apiv1.PollForActivityTaskResponse, error) {
+
+	response, err := g.handler.PollForActivityTask(ctx, proto.ToPollForActivityTaskRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromPollForActivityTaskResponse(response), nil
+}
+
+// PollForDecisionTask API call
+func (g *GRPCHandler) PollForDecisionTask(
+	ctx context.Context,
+	request *apiv1.PollForDecisionTaskRequest,
+) (*apiv1.PollForDecisionTaskResponse, error) {
+
+	response, err := g.handler.PollForDecisionTask(ctx, proto.ToPollForDecisionTaskRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromPollForDecisionTaskResponse(response), nil
+}
+
+// QueryWorkflow API call
+func (g *GRPCHandler) QueryWorkflow(
+	ctx context.Context,
+	request *apiv1.QueryWorkflowRequest,
+) (*apiv1.QueryWorkflowResponse, error) {
+
+	response, err := g.handler.QueryWorkflow(ctx, proto.ToQueryWorkflowRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromQueryWorkflowResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeat API call
+func (g *GRPCHandler) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *apiv1.RecordActivityTaskHeartbeatRequest,
+) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := g.handler.RecordActivityTaskHeartbeat(ctx, proto.ToRecordActivityTaskHeartbeatRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeatByID API call
+func (g *GRPCHandler) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *apiv1.RecordActivityTaskHeartbeatByIDRequest,
+) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := g.handler.RecordActivityTaskHeartbeatByID(ctx, proto.ToRecordActivityTaskHeartbeatByIDRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RegisterDomain API call
+func (g *GRPCHandler) RegisterDomain(
+	ctx context.Context,
+	request *apiv1.RegisterDomainRequest,
+) (*apiv1.RegisterDomainResponse, error) {
+
+	if err := g.handler.RegisterDomain(ctx, proto.ToRegisterDomainRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RegisterDomainResponse{}, nil
+}
+
+// RequestCancelWorkflowExecution API call
+func (g *GRPCHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.RequestCancelWorkflowExecutionRequest,
+) (*apiv1.RequestCancelWorkflowExecutionResponse, error) {
+
+	if err := g.handler.RequestCancelWorkflowExecution(ctx, proto.ToRequestCancelWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RequestCancelWorkflowExecutionResponse{}, nil
+}
+
+// ResetStickyTaskList API call
+func (g *GRPCHandler) ResetStickyTaskList(
+	ctx context.Context,
+	request *apiv1.ResetStickyTaskListRequest,
+) (*apiv1.ResetStickyTaskListResponse, error) {
+
+	response, err := g.handler.ResetStickyTaskList(ctx, proto.ToResetStickyTaskListRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromResetStickyTaskListResponse(response), nil
+}
+
+// ResetWorkflowExecution API call
+func (g *GRPCHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.ResetWorkflowExecutionRequest,
+) (*apiv1.ResetWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.ResetWorkflowExecution(ctx, proto.ToResetWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromResetWorkflowExecutionResponse(response), nil
+}
+
+// RespondActivityTaskCanceled API call
+func (g *GRPCHandler) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCanceledRequest,
+) (*apiv1.RespondActivityTaskCanceledResponse, error) {
+
+	if err := g.handler.RespondActivityTaskCanceled(ctx, proto.ToRespondActivityTaskCanceledRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCanceledResponse{}, nil
+}
+
+// RespondActivityTaskCanceledByID API call
+func (g *GRPCHandler) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCanceledByIDRequest,
+) (*apiv1.RespondActivityTaskCanceledByIDResponse, error) {
+
+	if err := g.handler.RespondActivityTaskCanceledByID(ctx, proto.ToRespondActivityTaskCanceledByIDRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCanceledByIDResponse{}, nil
+}
+
+// RespondActivityTaskCompleted API call
+func (g *GRPCHandler) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCompletedRequest,
+) (*apiv1.RespondActivityTaskCompletedResponse, error) {
+
+	if err := g.handler.RespondActivityTaskCompleted(ctx, proto.ToRespondActivityTaskCompletedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCompletedResponse{}, nil
+}
+
+// RespondActivityTaskCompletedByID API call
+func (g *GRPCHandler) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCompletedByIDRequest,
+) (*apiv1.RespondActivityTaskCompletedByIDResponse, error) {
+
+	if err := g.handler.RespondActivityTaskCompletedByID(ctx, proto.ToRespondActivityTaskCompletedByIDRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCompletedByIDResponse{}, nil
+}
+
+// RespondActivityTaskFailed API call
+func (g *GRPCHandler) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskFailedRequest,
+) (*apiv1.RespondActivityTaskFailedResponse, error) {
+
+	if err := g.handler.RespondActivityTaskFailed(ctx, proto.ToRespondActivityTaskFailedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskFailedResponse{}, nil
+}
+
+// RespondActivityTaskFailedByID API call
+func (g *GRPCHandler) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskFailedByIDRequest,
+) (*apiv1.RespondActivityTaskFailedByIDResponse, error) {
+
+	if err := g.handler.RespondActivityTaskFailedByID(ctx, proto.ToRespondActivityTaskFailedByIDRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskFailedByIDResponse{}, nil
+}
+
+// RespondDecisionTaskCompleted API call
+func (g *GRPCHandler) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *apiv1.RespondDecisionTaskCompletedRequest,
+) (*apiv1.RespondDecisionTaskCompletedResponse, error) {
+
+	response, err := g.handler.RespondDecisionTaskCompleted(ctx, proto.ToRespondDecisionTaskCompletedRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromRespondDecisionTaskCompletedResponse(response), nil
+}
+
+// RespondDecisionTaskFailed API call
+func (g *GRPCHandler) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *apiv1.RespondDecisionTaskFailedRequest,
+) (*apiv1.RespondDecisionTaskFailedResponse, error) {
+
+	if err := g.handler.RespondDecisionTaskFailed(ctx, proto.ToRespondDecisionTaskFailedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondDecisionTaskFailedResponse{}, nil
+}
+
+// RespondQueryTaskCompleted API call
+func (g *GRPCHandler) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *apiv1.RespondQueryTaskCompletedRequest,
+) (*apiv1.RespondQueryTaskCompletedResponse, error) {
+
+	if err := g.handler.RespondQueryTaskCompleted(ctx, proto.ToRespondQueryTaskCompletedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondQueryTaskCompletedResponse{}, nil
+}
+
+// ScanWorkflowExecutions API call
+func (g *GRPCHandler) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListWorkflowExecutionsRequest,
+) (*apiv1.ListWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ScanWorkflowExecutions(ctx, proto.ToListWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListWorkflowExecutionsResponse(response), nil
+}
+
+// SignalWithStartWorkflowExecution API call
+func (g *GRPCHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.SignalWithStartWorkflowExecutionRequest,
+) (*apiv1.StartWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.SignalWithStartWorkflowExecution(ctx, proto.ToSignalWithStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromStartWorkflowExecutionResponse(response), nil
+}
+
+// SignalWorkflowExecution API call
+func (g *GRPCHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.SignalWorkflowExecutionRequest,
+) (*apiv1.SignalWorkflowExecutionResponse, error) {
+
+	if err := g.handler.SignalWorkflowExecution(ctx, proto.ToSignalWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.SignalWorkflowExecutionResponse{}, nil
+}
+
+// StartWorkflowExecution API call
+func (g *GRPCHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.StartWorkflowExecutionRequest,
+) (*apiv1.StartWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.StartWorkflowExecution(ctx, proto.ToStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromStartWorkflowExecutionResponse(response), nil
+}
+
+// StartWorkflowExecutionBatch API call
+func (g *GRPCHandler) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	request *apiv1.StartWorkflowExecutionBatchRequest,
+) (*apiv1.StartWorkflowExecutionBatchResponse, error) {
+
+	response, err := g.handler.StartWorkflowExecutionBatch(ctx, proto.ToStartWorkflowExecutionBatchRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromStartWorkflowExecutionBatchResponse(response), nil
+}
+
+// TerminateWorkflowExecution API call
+func (g *GRPCHandler) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.TerminateWorkflowExecutionRequest,
+) (*apiv1.TerminateWorkflowExecutionResponse, error) {
+
+	if err := g.handler.TerminateWorkflowExecution(ctx, proto.ToTerminateWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.TerminateWorkflowExecutionResponse{}, nil
+}
+
+// UpdateDomain API call
+func (g *GRPCHandler) UpdateDomain(
+	ctx context.Context,
+	request *apiv1.UpdateDomainRequest,
+) (*apiv1.UpdateDomainResponse, error) {
+
+	response, err := g.handler.UpdateDomain(ctx, proto.ToUpdateDomainRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromUpdateDomainResponse(response), nil
+}
+
+// UpdateWorkflowExecution API call
+func (g *GRPCHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.UpdateWorkflowExecutionRequest,
+) (*apiv1.UpdateWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.UpdateWorkflowExecution(ctx, proto.ToUpdateWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromUpdateWorkflowExecutionResponse(response), nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sort"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	// historyEventFilter applies the HistoryEventFilter of a GetWorkflowExecutionHistory request to the pages of
+	// a history, the scheduled event IDs of the filtered activity are carried across pages by the continuation token
+	historyEventFilter struct {
+		filter                    *gen.HistoryEventFilter
+		eventTypes                map[gen.EventType]struct{}
+		activityScheduledEventIDs map[int64]struct{}
+	}
+)
+
+var (
+	errInvalidEventIDRange          = &gen.BadRequestError{Message: "MinEventId of the event filter is larger than MaxEventId."}
+	errInvalidEventTimestampRange   = &gen.BadRequestError{Message: "MinTimestamp of the event filter is larger than MaxTimestamp."}
+	errEventFilterWithCloseEvent    = &gen.BadRequestError{Message: "Event filter cannot be used with the CLOSE_EVENT filter type."}
+	errEventFilterWithActivityTimer = &gen.BadRequestError{Message: "Event filter cannot select both an ActivityId and a TimerId."}
+)
+
+func validateHistoryEventFilter(filter *gen.HistoryEventFilter, filterType gen.HistoryEventFilterType) error {
+	if filter == nil {
+		return nil
+	}
+	if filterType == gen.HistoryEventFilterTypeCloseEvent {
+		return errEventFilterWithCloseEvent
+	}
+	if filter.MinEventId != nil && filter.MaxEventId != nil && filter.GetMinEventId() > filter.GetMaxEventId() {
+		return errInvalidEventIDRange
+	}
+	if filter.MinTimestamp != nil && filter.MaxTimestamp != nil && filter.GetMinTimestamp() > filter.GetMaxTimestamp() {
+		return errInvalidEventTimestampRange
+	}
+	if filter.GetActivityId() != "" && filter.GetTimerId() != "" {
+		return errEventFilterWithActivityTimer
+	}
+	return nil
+}
+
+func newHistoryEventFilter(filter *gen.HistoryEventFilter, activityScheduledEventIDs []int64) *historyEventFilter {
+	f := &historyEventFilter{
+		filter:                    filter,
+		activityScheduledEventIDs: make(map[int64]struct{}, len(activityScheduledEventIDs)),
+	}
+	if len(filter.EventTypes) > 0 {
+		f.eventTypes = make(map[gen.EventType]struct{}, len(filter.EventTypes))
+		for _, eventType := range filter.EventTypes {
+			f.eventTypes[eventType] = struct{}{}
+		}
+	}
+	for _, eventID := range activityScheduledEventIDs {
+		f.activityScheduledEventIDs[eventID] = struct{}{}
+	}
+	return f
+}
+
+// firstEventID returns the event ID to start reading the history from, the events before MinEventId are skipped
+// unless the filter selects an activity, whose scheduled event can be before the range
+func (f *historyEventFilter) firstEventID(firstEventID int64) int64 {
+	if f.filter.MinEventId == nil || f.filter.GetActivityId() != "" || f.filter.GetMinEventId() <= firstEventID {
+		return firstEventID
+	}
+	return f.filter.GetMinEventId()
+}
+
+// filterEvents returns the matching events of a page, and whether the page reached the end of the event ID
+// or timestamp range of the filter, so no later event can match
+func (f *historyEventFilter) filterEvents(events []*gen.HistoryEvent) ([]*gen.HistoryEvent, bool) {
+	matched := []*gen.HistoryEvent{}
+	for _, event := range events {
+		if f.isAfterRange(event) {
+			return matched, true
+		}
+		if f.matches(event) {
+			matched = append(matched, event)
+		}
+	}
+	return matched, false
+}
+
+// getActivityScheduledEventIDs returns the scheduled event IDs of the filtered activity seen so far
+func (f *historyEventFilter) getActivityScheduledEventIDs() []int64 {
+	if len(f.activityScheduledEventIDs) == 0 {
+		return nil
+	}
+	eventIDs := make([]int64, 0, len(f.activityScheduledEventIDs))
+	for eventID := range f.activityScheduledEventIDs {
+		eventIDs = append(eventIDs, eventID)
+	}
+	sort.Slice(eventIDs, func(i, j int) bool { return eventIDs[i] < eventIDs[j] })
+	return eventIDs
+}
+
+func (f *historyEventFilter) isAfterRange(event *gen.HistoryEvent) bool {
+	if f.filter.MaxEventId != nil && event.GetEventId() > f.filter.GetMaxEventId() {
+		return true
+	}
+	return f.filter.MaxTimestamp != nil && event.GetTimestamp() > f.filter.GetMaxTimestamp()
+}
+
+func (f *historyEventFilter) matches(event *gen.HistoryEvent) bool {
+	if f.filter.MinEventId != nil && event.GetEventId() < f.filter.GetMinEventId() {
+		// the scheduled event of the activity is remembered even when it is before the range
+		f.matchesActivity(event)
+		return false
+	}
+	if f.filter.MinTimestamp != nil && event.GetTimestamp() < f.filter.GetMinTimestamp() {
+		f.matchesActivity(event)
+		return false
+	}
+	if f.eventTypes != nil {
+		if _, ok := f.eventTypes[event.GetEventType()]; !ok {
+			f.matchesActivity(event)
+			return false
+		}
+	}
+	if f.filter.GetActivityId() != "" {
+		return f.matchesActivity(event)
+	}
+	if f.filter.GetTimerId() != "" {
+		return f.matchesTimer(event)
+	}
+	return true
+}
+
+func (f *historyEventFilter) matchesActivity(event *gen.HistoryEvent) bool {
+	activityID := f.filter.GetActivityId()
+	if activityID == "" {
+		return false
+	}
+
+	var scheduledEventID int64
+	switch event.GetEventType() {
+	case gen.EventTypeActivityTaskScheduled:
+		if event.ActivityTaskScheduledEventAttributes.GetActivityId() != activityID {
+			return false
+		}
+		f.activityScheduledEventIDs[event.GetEventId()] = struct{}{}
+		return true
+	case gen.EventTypeActivityTaskCancelRequested:
+		return event.ActivityTaskCancelRequestedEventAttributes.GetActivityId() == activityID
+	case gen.EventTypeRequestCancelActivityTaskFailed:
+		return event.RequestCancelActivityTaskFailedEventAttributes.GetActivityId() == activityID
+	case gen.EventTypeActivityTaskStarted:
+		scheduledEventID = event.ActivityTaskStartedEventAttributes.GetScheduledEventId()
+	case gen.EventTypeActivityTaskCompleted:
+		scheduledEventID = event.ActivityTaskCompletedEventAttributes.GetScheduledEventId()
+	case gen.EventTypeActivityTaskFailed:
+		scheduledEventID = event.ActivityTaskFailedEventAttributes.GetScheduledEventId()
+	case gen.EventTypeActivityTaskTimedOut:
+		scheduledEventID = event.ActivityTaskTimedOutEventAttributes.GetScheduledEventId()
+	case gen.EventTypeActivityTaskCanceled:
+		scheduledEventID = event.ActivityTaskCanceledEventAttributes.GetScheduledEventId()
+	default:
+		return false
+	}
+	_, ok := f.activityScheduledEventIDs[scheduledEventID]
+	return ok
+}
+
+func (f *historyEventFilter) matchesTimer(event *gen.HistoryEvent) bool {
+	timerID := f.filter.GetTimerId()
+	switch event.GetEventType() {
+	case gen.EventTypeTimerStarted:
+		return event.TimerStartedEventAttributes.GetTimerId() == timerID
+	case gen.EventTypeTimerFired:
+		return event.TimerFiredEventAttributes.GetTimerId() == timerID
+	case gen.EventTypeTimerCanceled:
+		return event.TimerCanceledEventAttributes.GetTimerId() == timerID
+	case gen.EventTypeCancelTimerFailed:
+		return event.CancelTimerFailedEventAttributes.GetTimerId() == timerID
+	}
+	return false
+}
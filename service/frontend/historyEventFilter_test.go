@@ -0,0 +1,203 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	historyEventFilterSuite struct {
+		suite.Suite
+		events []*gen.HistoryEvent
+	}
+)
+
+func TestHistoryEventFilterSuite(t *testing.T) {
+	s := new(historyEventFilterSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyEventFilterSuite) SetupTest() {
+	s.events = []*gen.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			Timestamp: common.Int64Ptr(100),
+			EventType: gen.EventTypeWorkflowExecutionStarted.Ptr(),
+		},
+		{
+			EventId:   common.Int64Ptr(2),
+			Timestamp: common.Int64Ptr(200),
+			EventType: gen.EventTypeActivityTaskScheduled.Ptr(),
+			ActivityTaskScheduledEventAttributes: &gen.ActivityTaskScheduledEventAttributes{
+				ActivityId: common.StringPtr("activity-1"),
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(3),
+			Timestamp: common.Int64Ptr(300),
+			EventType: gen.EventTypeTimerStarted.Ptr(),
+			TimerStartedEventAttributes: &gen.TimerStartedEventAttributes{
+				TimerId: common.StringPtr("timer-1"),
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(4),
+			Timestamp: common.Int64Ptr(400),
+			EventType: gen.EventTypeActivityTaskStarted.Ptr(),
+			ActivityTaskStartedEventAttributes: &gen.ActivityTaskStartedEventAttributes{
+				ScheduledEventId: common.Int64Ptr(2),
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(5),
+			Timestamp: common.Int64Ptr(500),
+			EventType: gen.EventTypeActivityTaskFailed.Ptr(),
+			ActivityTaskFailedEventAttributes: &gen.ActivityTaskFailedEventAttributes{
+				ScheduledEventId: common.Int64Ptr(2),
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(6),
+			Timestamp: common.Int64Ptr(600),
+			EventType: gen.EventTypeTimerFired.Ptr(),
+			TimerFiredEventAttributes: &gen.TimerFiredEventAttributes{
+				TimerId: common.StringPtr("timer-1"),
+			},
+		},
+	}
+}
+
+func (s *historyEventFilterSuite) TestValidateHistoryEventFilter() {
+	s.NoError(validateHistoryEventFilter(nil, gen.HistoryEventFilterTypeCloseEvent))
+	s.NoError(validateHistoryEventFilter(&gen.HistoryEventFilter{}, gen.HistoryEventFilterTypeAllEvent))
+	s.Equal(errEventFilterWithCloseEvent, validateHistoryEventFilter(&gen.HistoryEventFilter{}, gen.HistoryEventFilterTypeCloseEvent))
+	s.Equal(errInvalidEventIDRange, validateHistoryEventFilter(&gen.HistoryEventFilter{
+		MinEventId: common.Int64Ptr(5),
+		MaxEventId: common.Int64Ptr(4),
+	}, gen.HistoryEventFilterTypeAllEvent))
+	s.Equal(errInvalidEventTimestampRange, validateHistoryEventFilter(&gen.HistoryEventFilter{
+		MinTimestamp: common.Int64Ptr(5),
+		MaxTimestamp: common.Int64Ptr(4),
+	}, gen.HistoryEventFilterTypeAllEvent))
+	s.Equal(errEventFilterWithActivityTimer, validateHistoryEventFilter(&gen.HistoryEventFilter{
+		ActivityId: common.StringPtr("activity-1"),
+		TimerId:    common.StringPtr("timer-1"),
+	}, gen.HistoryEventFilterTypeAllEvent))
+}
+
+func (s *historyEventFilterSuite) TestFirstEventID() {
+	filter := newHistoryEventFilter(&gen.HistoryEventFilter{}, nil)
+	s.Equal(int64(1), filter.firstEventID(1))
+
+	filter = newHistoryEventFilter(&gen.HistoryEventFilter{
+		MinEventId: common.Int64Ptr(4),
+	}, nil)
+	s.Equal(int64(4), filter.firstEventID(1))
+	s.Equal(int64(6), filter.firstEventID(6))
+
+	// the scheduled event of the activity has to be read even when it is before the range
+	filter = newHistoryEventFilter(&gen.HistoryEventFilter{
+		MinEventId: common.Int64Ptr(4),
+		ActivityId: common.StringPtr("activity-1"),
+	}, nil)
+	s.Equal(int64(1), filter.firstEventID(1))
+}
+
+func (s *historyEventFilterSuite) TestFilterEvents_EventTypes() {
+	filter := newHistoryEventFilter(&gen.HistoryEventFilter{
+		EventTypes: []gen.EventType{gen.EventTypeActivityTaskFailed, gen.EventTypeTimerFired},
+	}, nil)
+	events, isAfterRange := filter.filterEvents(s.events)
+	s.False(isAfterRange)
+	s.Equal([]int64{5, 6}, eventIDs(events))
+}
+
+func (s *historyEventFilterSuite) TestFilterEvents_EventIDRange() {
+	filter := newHistoryEventFilter(&gen.HistoryEventFilter{
+		MinEventId: common.Int64Ptr(2),
+		MaxEventId: common.Int64Ptr(3),
+	}, nil)
+	events, isAfterRange := filter.filterEvents(s.events)
+	s.True(isAfterRange)
+	s.Equal([]int64{2, 3}, eventIDs(events))
+}
+
+func (s *historyEventFilterSuite) TestFilterEvents_TimestampRange() {
+	filter := newHistoryEventFilter(&gen.HistoryEventFilter{
+		MinTimestamp: common.Int64Ptr(350),
+	}, nil)
+	events, isAfterRange := filter.filterEvents(s.events)
+	s.False(isAfterRange)
+	s.Equal([]int64{4, 5, 6}, eventIDs(events))
+
+	filter = newHistoryEventFilter(&gen.HistoryEventFilter{
+		MaxTimestamp: common.Int64Ptr(150),
+	}, nil)
+	events, isAfterRange = filter.filterEvents(s.events)
+	s.True(isAfterRange)
+	s.Equal([]int64{1}, eventIDs(events))
+}
+
+func (s *historyEventFilterSuite) TestFilterEvents_ActivityID() {
+	filter := newHistoryEventFilter(&gen.HistoryEventFilter{
+		ActivityId: common.StringPtr("activity-1"),
+	}, nil)
+	events, isAfterRange := filter.filterEvents(s.events)
+	s.False(isAfterRange)
+	s.Equal([]int64{2, 4, 5}, eventIDs(events))
+	s.Equal([]int64{2}, filter.getActivityScheduledEventIDs())
+}
+
+func (s *historyEventFilterSuite) TestFilterEvents_ActivityID_AcrossPages() {
+	filter := newHistoryEventFilter(&gen.HistoryEventFilter{
+		ActivityId: common.StringPtr("activity-1"),
+		EventTypes: []gen.EventType{gen.EventTypeActivityTaskFailed},
+	}, nil)
+	events, _ := filter.filterEvents(s.events[:3])
+	s.Empty(events)
+
+	// the scheduled event ID is carried by the continuation token to the filter of the next page
+	filter = newHistoryEventFilter(filter.filter, filter.getActivityScheduledEventIDs())
+	events, _ = filter.filterEvents(s.events[3:])
+	s.Equal([]int64{5}, eventIDs(events))
+}
+
+func (s *historyEventFilterSuite) TestFilterEvents_TimerID() {
+	filter := newHistoryEventFilter(&gen.HistoryEventFilter{
+		TimerId: common.StringPtr("timer-1"),
+	}, nil)
+	events, isAfterRange := filter.filterEvents(s.events)
+	s.False(isAfterRange)
+	s.Equal([]int64{3, 6}, eventIDs(events))
+}
+
+func eventIDs(events []*gen.HistoryEvent) []int64 {
+	var ids []int64
+	for _, event := range events {
+		ids = append(ids, event.GetEventId())
+	}
+	return ids
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -50,6 +51,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/tokenbucket"
@@ -62,25 +64,33 @@ var _ workflowserviceserver.Interface = (*WorkflowHandler)(nil)
 type (
 	// WorkflowHandler - Thrift handler interface for workflow service
 	WorkflowHandler struct {
-		domainCache       cache.DomainCache
-		metadataMgr       persistence.MetadataManager
-		historyMgr        persistence.HistoryManager
-		historyV2Mgr      persistence.HistoryV2Manager
-		visibilityMgr     persistence.VisibilityManager
-		history           history.Client
-		matching          matching.Client
-		matchingRawClient matching.Client
-		tokenSerializer   common.TaskTokenSerializer
-		metricsClient     metrics.Client
-		startWG           sync.WaitGroup
-		rateLimiter       tokenbucket.TokenBucket
-		config            *Config
-		blobstoreClient   blobstore.Client
-		versionChecker    *versionChecker
-		domainHandler     *domainHandlerImpl
+		domainCache        cache.DomainCache
+		metadataMgr        persistence.MetadataManager
+		historyMgr         persistence.HistoryManager
+		historyV2Mgr       persistence.HistoryV2Manager
+		visibilityMgr      persistence.VisibilityManager
+		history            history.Client
+		matching           matching.Client
+		matchingRawClient  matching.Client
+		tokenSerializer    common.TaskTokenSerializer
+		metricsClient      metrics.Client
+		startWG            sync.WaitGroup
+		rateLimiter        tokenbucket.TokenBucket
+		domainRateLimiters cache.Cache
+		config             *Config
+		blobstoreClient    blobstore.Client
+		payloadStore       payload.Store
+		versionChecker     *versionChecker
+		domainHandler      *domainHandlerImpl
 		service.Service
 	}
 
+	domainRateLimiter struct {
+		sync.Mutex
+		rps int
+		tokenbucket.TokenBucket
+	}
+
 	getHistoryContinuationToken struct {
 		RunID             string
 		FirstEventID      int64
@@ -91,13 +101,24 @@ type (
 		EventStoreVersion int32
 		BranchToken       []byte
 		ReplicationInfo   map[string]*gen.ReplicationInfo
+		// scheduled event IDs of the activity selected by the event filter
+		ActivityScheduledEventIDs []int64
+		// whether the events are read in descending order of event ID
+		Reverse bool
 	}
 
 	getHistoryContinuationTokenArchival struct {
-		BlobstorePageToken int
+		BlobstorePageToken        int
+		ActivityScheduledEventIDs []int64
 	}
 )
 
+const (
+	// domainRateLimitersMaxSize bounds the number of domains with a rate limiter, the limiters are keyed by
+	// domain ID and the least recently used one is evicted beyond it
+	domainRateLimitersMaxSize = 10000
+)
+
 var (
 	errDomainNotSet                               = &gen.BadRequestError{Message: "Domain not set on request."}
 	errTaskTokenNotSet                            = &gen.BadRequestError{Message: "Task token not set on request."}
@@ -112,6 +133,9 @@ var (
 	errInvalidRunID                               = &gen.BadRequestError{Message: "Invalid RunId."}
 	errInvalidNextPageToken                       = &gen.BadRequestError{Message: "Invalid NextPageToken."}
 	errNextPageTokenRunIDMismatch                 = &gen.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
+	errNextPageTokenReverseMismatch               = &gen.BadRequestError{Message: "Reverse in the request does not match the NextPageToken."}
+	errInvalidReverseHistoryRequest               = &gen.BadRequestError{Message: "Reverse cannot be used with WaitForNewEvent, close event filter type or event filter."}
+	errReverseHistoryNotSupported                 = &gen.BadRequestError{Message: "Reverse is not supported by the history of this workflow."}
 	errQueryNotSet                                = &gen.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	errQueryTypeNotSet                            = &gen.BadRequestError{Message: "QueryType is not set on request."}
 	errRequestNotSet                              = &gen.BadRequestError{Message: "Request is nil."}
@@ -120,7 +144,14 @@ var (
 	errWorkflowTypeNotSet                         = &gen.BadRequestError{Message: "WorkflowType is not set on request."}
 	errInvalidExecutionStartToCloseTimeoutSeconds = &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	errInvalidRetentionPeriodInDays               = &gen.BadRequestError{Message: "RetentionPeriodInDays cannot be negative."}
+	errInvalidDelayStartSeconds                   = &gen.BadRequestError{Message: "DelayStartSeconds cannot be negative."}
+	errInvalidWorkflowExecutionTimeoutSeconds     = &gen.BadRequestError{Message: "WorkflowExecutionTimeoutSeconds cannot be negative."}
+	errUpdateNameNotSet                           = &gen.BadRequestError{Message: "UpdateName is not set on request."}
+	errInvalidUpdateTimeoutSeconds                = &gen.BadRequestError{Message: "TimeoutSeconds cannot be negative."}
 	errClientVersionNotSet                        = &gen.BadRequestError{Message: "Client version is not set on request."}
+	errBatchRequestsNotSet                        = &gen.BadRequestError{Message: "Requests are not set on request."}
+	errBatchTooLarge                              = &gen.BadRequestError{Message: "Number of requests exceeds limit."}
 
 	// err for archival
 	errDomainHasNeverBeenEnabledForArchival = &gen.BadRequestError{Message: "Attempted to fetch history from archival, but domain has never been enabled for archival."}
@@ -131,6 +162,7 @@ var (
 	errWorkflowTypeTooLong = &gen.BadRequestError{Message: "WorkflowType length exceeds limit."}
 	errWorkflowIDTooLong   = &gen.BadRequestError{Message: "WorkflowID length exceeds limit."}
 	errSignalNameTooLong   = &gen.BadRequestError{Message: "SignalName length exceeds limit."}
+	errUpdateNameTooLong   = &gen.BadRequestError{Message: "UpdateName length exceeds limit."}
 	errTaskListTooLong     = &gen.BadRequestError{Message: "TaskList length exceeds limit."}
 	errRequestIDTooLong    = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &gen.BadRequestError{Message: "Identity length exceeds limit."}
@@ -151,17 +183,19 @@ func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persi
 	visibilityMgr persistence.VisibilityManager, kafkaProducer messaging.Producer,
 	blobstoreClient blobstore.Client) *WorkflowHandler {
 	handler := &WorkflowHandler{
-		Service:         sVice,
-		config:          config,
-		metadataMgr:     metadataMgr,
-		historyMgr:      historyMgr,
-		historyV2Mgr:    historyV2Mgr,
-		visibilityMgr:   visibilityMgr,
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
-		domainCache:     cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
-		rateLimiter:     tokenbucket.New(config.RPS(), clock.NewRealTimeSource()),
-		blobstoreClient: blobstoreClient,
-		versionChecker:  &versionChecker{checkVersion: config.EnableClientVersionCheck()},
+		Service:            sVice,
+		config:             config,
+		metadataMgr:        metadataMgr,
+		historyMgr:         historyMgr,
+		historyV2Mgr:       historyV2Mgr,
+		visibilityMgr:      visibilityMgr,
+		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
+		domainCache:        cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
+		rateLimiter:        tokenbucket.New(config.RPS(), clock.NewRealTimeSource()),
+		domainRateLimiters: cache.New(domainRateLimitersMaxSize, &cache.Options{}),
+		blobstoreClient:    blobstoreClient,
+		payloadStore:       payload.NewStore(blobstoreClient),
+		versionChecker:     &versionChecker{checkVersion: config.EnableClientVersionCheck()},
 		domainHandler: newDomainHandler(
 			config,
 			sVice.GetLogger(),
@@ -269,9 +303,47 @@ func (wh *WorkflowHandler) DescribeDomain(ctx context.Context,
 	if err != nil {
 		return resp, wh.error(err, scope)
 	}
+	resp.WorkflowTypeConcurrency = wh.getWorkflowTypeConcurrency(ctx, resp.DomainInfo)
 	return resp, err
 }
 
+// getWorkflowTypeConcurrency returns the number of running executions of each workflow type of a domain
+// together with the configured concurrency limit. The usage is best effort and left out if it cannot be
+// collected from all history shards.
+func (wh *WorkflowHandler) getWorkflowTypeConcurrency(ctx context.Context,
+	domainInfo *gen.DomainInfo) []*gen.WorkflowTypeConcurrency {
+
+	resp, err := wh.history.GetRunningWorkflowCounts(ctx, &h.GetRunningWorkflowCountsRequest{
+		DomainUUID: domainInfo.UUID,
+	})
+	if err != nil {
+		wh.Service.GetLogger().Warn("Failed to get running workflow counts.",
+			tag.WorkflowDomainName(domainInfo.GetName()), tag.Error(err))
+		return nil
+	}
+	if len(resp.ShardIDs) < wh.config.NumHistoryShards {
+		wh.Service.GetLogger().Warn("Running workflow counts are incomplete.",
+			tag.WorkflowDomainName(domainInfo.GetName()), tag.Number(int64(len(resp.ShardIDs))))
+		return nil
+	}
+
+	workflowTypes := make([]string, 0, len(resp.Counts))
+	for workflowType := range resp.Counts {
+		workflowTypes = append(workflowTypes, workflowType)
+	}
+	sort.Strings(workflowTypes)
+
+	concurrency := make([]*gen.WorkflowTypeConcurrency, 0, len(workflowTypes))
+	for _, workflowType := range workflowTypes {
+		concurrency = append(concurrency, &gen.WorkflowTypeConcurrency{
+			WorkflowType:      common.StringPtr(workflowType),
+			RunningExecutions: common.Int64Ptr(resp.Counts[workflowType]),
+			Limit:             common.Int32Ptr(int32(wh.config.WorkflowTypeConcurrencyLimit(domainInfo.GetName(), workflowType))),
+		})
+	}
+	return concurrency
+}
+
 // UpdateDomain is used to update the information and configuration for a registered domain.
 func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 	updateRequest *gen.UpdateDomainRequest) (resp *gen.UpdateDomainResponse, retError error) {
@@ -390,6 +462,12 @@ func (wh *WorkflowHandler) PollForActivityTask(
 			return nil, wh.error(err, scope)
 		}
 	}
+	if resp.GetInputReference() != nil {
+		if resp.Input, err = wh.payloadStore.Resolve(ctx, resp.InputReference); err != nil {
+			return nil, wh.error(err, scope)
+		}
+		resp.InputReference = nil
+	}
 	return resp, nil
 }
 
@@ -759,7 +837,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
@@ -861,7 +939,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
+	sizeLimitError := wh.getPayloadSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
@@ -1486,28 +1564,132 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
+	domainID, scope, err := wh.validateStartWorkflowExecutionRequest(startRequest, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if !wh.allowDomainRequest(domainID, startRequest.GetDomain()) {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	wh.Service.GetLogger().Debug("Start workflow execution request domainID", tag.WorkflowDomainID(domainID))
+
+	resp, err = wh.history.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(domainID, startRequest))
+
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// StartWorkflowExecutionBatch - Creates a batch of new workflow executions, the requests are sent to history in
+// one call per shard and the result of every request is returned at its position in the batch
+func (wh *WorkflowHandler) StartWorkflowExecutionBatch(
+	ctx context.Context,
+	batchRequest *gen.StartWorkflowExecutionBatchRequest) (resp *gen.StartWorkflowExecutionBatchResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfile(metrics.FrontendStartWorkflowExecutionBatchScope)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if batchRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if len(batchRequest.Requests) == 0 {
+		return nil, wh.error(errBatchRequestsNotSet, scope)
+	}
+
+	if len(batchRequest.Requests) > wh.config.StartWorkflowBatchMaxSize() {
+		return nil, wh.error(errBatchTooLarge, scope)
+	}
+
+	results := make([]*gen.StartWorkflowExecutionBatchResult, len(batchRequest.Requests))
+	shardIndexes := make(map[int][]int)
+	shardRequests := make(map[int][]*h.StartWorkflowExecutionRequest)
+	for i, startRequest := range batchRequest.Requests {
+		if startRequest == nil {
+			results[i] = common.CreateStartWorkflowExecutionBatchErrorResult(wh.error(errRequestNotSet, scope))
+			continue
+		}
+		// every request of the batch counts against the rate limits like a single start
+		if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+			results[i] = common.CreateStartWorkflowExecutionBatchErrorResult(wh.error(createServiceBusyError(), scope))
+			continue
+		}
+		domainID, _, err := wh.validateStartWorkflowExecutionRequest(startRequest, scope)
+		if err != nil {
+			results[i] = common.CreateStartWorkflowExecutionBatchErrorResult(err)
+			continue
+		}
+		if !wh.allowDomainRequest(domainID, startRequest.GetDomain()) {
+			results[i] = common.CreateStartWorkflowExecutionBatchErrorResult(wh.error(createServiceBusyError(), scope))
+			continue
+		}
+		shardID := common.WorkflowIDToHistoryShard(startRequest.GetWorkflowId(), wh.config.NumHistoryShards)
+		shardIndexes[shardID] = append(shardIndexes[shardID], i)
+		shardRequests[shardID] = append(shardRequests[shardID], common.CreateHistoryStartWorkflowRequest(domainID, startRequest))
+	}
+
+	var wg sync.WaitGroup
+	for shardID, requests := range shardRequests {
+		wg.Add(1)
+		go func(indexes []int, requests []*h.StartWorkflowExecutionRequest) {
+			defer wg.Done()
+			// every shard fills the results of its own requests only
+			response, err := wh.history.StartWorkflowExecutionBatch(ctx, &h.StartWorkflowExecutionBatchRequest{
+				StartRequests: requests,
+			})
+			if err == nil && len(response.Results) != len(requests) {
+				err = &gen.InternalServiceError{Message: "Unexpected number of results from history."}
+			}
+			for j, index := range indexes {
+				if err != nil {
+					results[index] = common.CreateStartWorkflowExecutionBatchErrorResult(wh.error(err, scope))
+					continue
+				}
+				results[index] = response.Results[j]
+			}
+		}(shardIndexes[shardID], requests)
+	}
+	wg.Wait()
+
+	return &gen.StartWorkflowExecutionBatchResponse{Results: results}, nil
+}
+
+// validateStartWorkflowExecutionRequest validates a start request, resolves the ID of its domain and returns the
+// scope tagged with the domain
+func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(
+	startRequest *gen.StartWorkflowExecutionRequest,
+	scope metrics.Scope,
+) (string, metrics.Scope, error) {
 	if startRequest.GetDomain() == "" {
-		return nil, wh.error(errDomainNotSet, scope)
+		return "", scope, wh.error(errDomainNotSet, scope)
 	}
 
 	if len(startRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errDomainTooLong, scope)
+		return "", scope, wh.error(errDomainTooLong, scope)
 	}
 
 	if startRequest.GetWorkflowId() == "" {
-		return nil, wh.error(errWorkflowIDNotSet, scope)
+		return "", scope, wh.error(errWorkflowIDNotSet, scope)
 	}
 
 	if len(startRequest.GetWorkflowId()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errWorkflowIDTooLong, scope)
+		return "", scope, wh.error(errWorkflowIDTooLong, scope)
 	}
 
 	if err := common.ValidateRetryPolicy(startRequest.RetryPolicy); err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
 
 	if err := cron.ValidateSchedule(startRequest.GetCronSchedule()); err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
 
 	wh.Service.GetLogger().Debug(
@@ -1515,31 +1697,43 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		tag.WorkflowID(startRequest.GetWorkflowId()))
 
 	if startRequest.WorkflowType == nil || startRequest.WorkflowType.GetName() == "" {
-		return nil, wh.error(errWorkflowTypeNotSet, scope)
+		return "", scope, wh.error(errWorkflowTypeNotSet, scope)
 	}
 
 	if len(startRequest.WorkflowType.GetName()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errWorkflowTypeTooLong, scope)
+		return "", scope, wh.error(errWorkflowTypeTooLong, scope)
 	}
 
 	if err := wh.validateTaskList(startRequest.TaskList, scope); err != nil {
-		return nil, err
+		return "", scope, err
 	}
 
 	if startRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
-		return nil, wh.error(errInvalidExecutionStartToCloseTimeoutSeconds, scope)
+		return "", scope, wh.error(errInvalidExecutionStartToCloseTimeoutSeconds, scope)
 	}
 
 	if startRequest.GetTaskStartToCloseTimeoutSeconds() <= 0 {
-		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope)
+		return "", scope, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope)
+	}
+
+	if startRequest.GetRetentionPeriodInDays() < 0 {
+		return "", scope, wh.error(errInvalidRetentionPeriodInDays, scope)
+	}
+
+	if startRequest.GetDelayStartSeconds() < 0 {
+		return "", scope, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
+	if startRequest.GetWorkflowExecutionTimeoutSeconds() < 0 {
+		return "", scope, wh.error(errInvalidWorkflowExecutionTimeoutSeconds, scope)
 	}
 
 	if startRequest.GetRequestId() == "" {
-		return nil, wh.error(errRequestIDNotSet, scope)
+		return "", scope, wh.error(errRequestIDNotSet, scope)
 	}
 
 	if len(startRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errRequestIDTooLong, scope)
+		return "", scope, wh.error(errRequestIDTooLong, scope)
 	}
 
 	maxDecisionTimeout := int32(wh.config.MaxDecisionStartToCloseTimeout(startRequest.GetDomain()))
@@ -1562,7 +1756,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	}
 	if startRequest.GetTaskStartToCloseTimeoutSeconds() > startRequest.GetExecutionStartToCloseTimeoutSeconds() ||
 		startRequest.GetTaskStartToCloseTimeoutSeconds() > maxDecisionTimeout {
-		return nil, wh.error(&gen.BadRequestError{
+		return "", scope, wh.error(&gen.BadRequestError{
 			Message: fmt.Sprintf("TaskStartToCloseTimeoutSeconds is larger than ExecutionStartToCloseTimeout or MaxDecisionStartToCloseTimeout (%ds).", maxDecisionTimeout)}, scope)
 	}
 
@@ -1570,13 +1764,13 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	wh.Service.GetLogger().Debug("Start workflow execution request domain", tag.WorkflowDomainName(domainName))
 	domainID, err := wh.domainCache.GetDomainID(domainName)
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
 
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainName))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(startRequest.GetDomain())
+	sizeLimitError := wh.getPayloadSizeLimitError(startRequest.GetDomain())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(startRequest.GetDomain())
 	actualSize := len(startRequest.Input) + common.GetSizeOfMapStringToByteArray(startRequest.Memo.GetFields())
 	if err := common.CheckEventBlobSizeLimit(
@@ -1589,17 +1783,9 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		scope,
 		wh.GetThrottledLogger(),
 	); err != nil {
-		return nil, wh.error(err, scope)
-	}
-
-	wh.Service.GetLogger().Debug("Start workflow execution request domainID", tag.WorkflowDomainID(domainID))
-
-	resp, err = wh.history.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(domainID, startRequest))
-
-	if err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
-	return resp, nil
+	return domainID, scope, nil
 }
 
 // GetWorkflowExecutionHistory - retrieves the history of workflow execution
@@ -1635,6 +1821,16 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		getRequest.MaximumPageSize = common.Int32Ptr(int32(wh.config.HistoryMaxPageSize(getRequest.GetDomain())))
 	}
 
+	if err := validateHistoryEventFilter(getRequest.EventFilter, getRequest.GetHistoryEventFilterType()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	isReverse := getRequest.GetReverse()
+	if isReverse && (getRequest.GetWaitForNewEvent() ||
+		getRequest.GetHistoryEventFilterType() == gen.HistoryEventFilterTypeCloseEvent || getRequest.EventFilter != nil) {
+		return nil, wh.error(errInvalidReverseHistoryRequest, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(getRequest.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -1656,6 +1852,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	enableArchivalRead := wh.GetClusterMetadata().ArchivalConfig().EnableReadFromArchival()
 	historyArchived := wh.historyArchived(ctx, getRequest, domainID)
 	if configuredForArchival && enableArchivalRead && historyArchived {
+		if isReverse {
+			return nil, wh.error(errReverseHistoryNotSupported, scope)
+		}
 		return wh.getArchivedHistory(ctx, getRequest, domainID, scope)
 	}
 
@@ -1699,6 +1898,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		if execution.RunId != nil && execution.GetRunId() != token.RunID {
 			return nil, wh.error(errNextPageTokenRunIDMismatch, scope)
 		}
+		if token.Reverse != isReverse {
+			return nil, wh.error(errNextPageTokenReverseMismatch, scope)
+		}
 
 		execution.RunId = common.StringPtr(token.RunID)
 
@@ -1731,6 +1933,15 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		token.NextEventID = nextEventID
 		token.IsWorkflowRunning = isWorkflowRunning
 		token.PersistenceToken = nil
+		token.Reverse = isReverse
+	}
+
+	var filter *historyEventFilter
+	if getRequest.EventFilter != nil {
+		filter = newHistoryEventFilter(getRequest.EventFilter, token.ActivityScheduledEventIDs)
+		if getRequest.NextPageToken == nil {
+			token.FirstEventID = filter.firstEventID(token.FirstEventID)
+		}
 	}
 
 	history := &gen.History{}
@@ -1738,12 +1949,14 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	if isCloseEventOnly {
 		if !isWorkflowRunning {
 			history, _, err = wh.getHistory(
+				ctx,
 				scope,
 				domainID,
 				*execution,
 				lastFirstEventID,
 				nextEventID,
 				getRequest.GetMaximumPageSize(),
+				false,
 				nil,
 				token.TransientDecision,
 				token.EventStoreVersion,
@@ -1770,25 +1983,42 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 				token = nil
 			}
 		} else {
-			history, token.PersistenceToken, err = wh.getHistory(
-				scope,
-				domainID,
-				*execution,
-				token.FirstEventID,
-				token.NextEventID,
-				getRequest.GetMaximumPageSize(),
-				token.PersistenceToken,
-				token.TransientDecision,
-				token.EventStoreVersion,
-				token.BranchToken,
-			)
+			isAfterFilterRange := false
+			if filter == nil {
+				history, token.PersistenceToken, err = wh.getHistory(
+					ctx,
+					scope,
+					domainID,
+					*execution,
+					token.FirstEventID,
+					token.NextEventID,
+					getRequest.GetMaximumPageSize(),
+					isReverse,
+					token.PersistenceToken,
+					token.TransientDecision,
+					token.EventStoreVersion,
+					token.BranchToken,
+				)
+			} else {
+				history, isAfterFilterRange, err = wh.getFilteredHistory(
+					ctx,
+					scope,
+					domainID,
+					*execution,
+					token,
+					filter,
+					getRequest.GetMaximumPageSize(),
+					wh.config.HistoryFilterMaxPages(getRequest.GetDomain()),
+					isReverse,
+				)
+			}
 			if err != nil {
 				return nil, wh.error(err, scope)
 			}
 
 			// here, for long pull on history events, we need to intercept the paging token from cassandra
 			// and do something clever
-			if len(token.PersistenceToken) == 0 && (!token.IsWorkflowRunning || !isLongPoll) {
+			if isAfterFilterRange || (len(token.PersistenceToken) == 0 && (!token.IsWorkflowRunning || !isLongPoll)) {
 				// meaning, there is no more history to be returned
 				token = nil
 			}
@@ -1859,7 +2089,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(signalRequest.GetDomain()))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(signalRequest.GetDomain())
+	sizeLimitError := wh.getPayloadSizeLimitError(signalRequest.GetDomain())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(signalRequest.GetDomain())
 	if err := common.CheckEventBlobSizeLimit(
 		len(signalRequest.Input),
@@ -1885,6 +2115,96 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// UpdateWorkflowExecution is used to send an update to a running workflow execution and wait for the result.
+// The update is dispatched to the worker on the next decision task. If the worker accepts it, this results in
+// WorkflowExecutionUpdateAccepted event recorded in the history. The call blocks until the worker accepts or
+// rejects the update, or the timeout elapses.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(ctx context.Context,
+	updateRequest *gen.UpdateWorkflowExecutionRequest) (resp *gen.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfile(metrics.FrontendUpdateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if updateRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if len(updateRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errDomainTooLong, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(updateRequest.WorkflowExecution, scope); err != nil {
+		return nil, err
+	}
+
+	if updateRequest.GetUpdateName() == "" {
+		return nil, wh.error(errUpdateNameNotSet, scope)
+	}
+
+	if len(updateRequest.GetUpdateName()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errUpdateNameTooLong, scope)
+	}
+
+	if len(updateRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errRequestIDTooLong, scope)
+	}
+
+	if updateRequest.GetTimeoutSeconds() < 0 {
+		return nil, wh.error(errInvalidUpdateTimeoutSeconds, scope)
+	}
+
+	if updateRequest.GetRequestId() == "" {
+		// the request ID doubles as the update ID, so every update needs one
+		updateRequest.RequestId = common.StringPtr(uuid.New())
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(updateRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// add domain tag to scope, so further metrics will have the domain tag
+	scope = scope.Tagged(metrics.DomainTag(updateRequest.GetDomain()))
+
+	sizeLimitError := wh.config.BlobSizeLimitError(updateRequest.GetDomain())
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(updateRequest.GetDomain())
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.Input),
+		sizeLimitWarn,
+		sizeLimitError,
+		domainID,
+		updateRequest.GetWorkflowExecution().GetWorkflowId(),
+		updateRequest.GetWorkflowExecution().GetRunId(),
+		scope,
+		wh.GetThrottledLogger(),
+	); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err = wh.history.UpdateWorkflowExecution(ctx, &h.UpdateWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -1959,6 +2279,18 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	if signalWithStartRequest.GetRetentionPeriodInDays() < 0 {
+		return nil, wh.error(errInvalidRetentionPeriodInDays, scope)
+	}
+
+	if signalWithStartRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
+	if signalWithStartRequest.GetWorkflowExecutionTimeoutSeconds() < 0 {
+		return nil, wh.error(errInvalidWorkflowExecutionTimeoutSeconds, scope)
+	}
+
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -1999,7 +2331,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(signalWithStartRequest.GetDomain()))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(signalWithStartRequest.GetDomain())
+	sizeLimitError := wh.getPayloadSizeLimitError(signalWithStartRequest.GetDomain())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(signalWithStartRequest.GetDomain())
 	if err := common.CheckEventBlobSizeLimit(
 		len(signalWithStartRequest.SignalInput),
@@ -2816,12 +3148,59 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 	return response, nil
 }
 
+// getFilteredHistory reads the pages of the history until some events match the filter, so callers are not sent
+// a run of empty pages, it stops after maxPages pages and returns the events matched so far, which can be none,
+// with the persistence token of the next page
+func (wh *WorkflowHandler) getFilteredHistory(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainID string,
+	execution gen.WorkflowExecution,
+	token *getHistoryContinuationToken,
+	filter *historyEventFilter,
+	pageSize int32,
+	maxPages int,
+	reverse bool,
+) (*gen.History, bool, error) {
+
+	filteredEvents := []*gen.HistoryEvent{}
+	for page := 1; ; page++ {
+		history, persistenceToken, err := wh.getHistory(
+			ctx,
+			scope,
+			domainID,
+			execution,
+			token.FirstEventID,
+			token.NextEventID,
+			pageSize,
+			reverse,
+			token.PersistenceToken,
+			token.TransientDecision,
+			token.EventStoreVersion,
+			token.BranchToken,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+		token.PersistenceToken = persistenceToken
+
+		matchedEvents, isAfterFilterRange := filter.filterEvents(history.Events)
+		filteredEvents = append(filteredEvents, matchedEvents...)
+		if isAfterFilterRange || len(filteredEvents) > 0 || len(token.PersistenceToken) == 0 || page >= maxPages {
+			token.ActivityScheduledEventIDs = filter.getActivityScheduledEventIDs()
+			return &gen.History{Events: filteredEvents}, isAfterFilterRange, nil
+		}
+	}
+}
+
 func (wh *WorkflowHandler) getHistory(
+	ctx context.Context,
 	scope metrics.Scope,
 	domainID string,
 	execution gen.WorkflowExecution,
 	firstEventID, nextEventID int64,
 	pageSize int32,
+	reverse bool,
 	nextPageToken []byte,
 	transientDecision *gen.TransientDecisionInfo,
 	eventStoreVersion int32,
@@ -2840,11 +3219,15 @@ func (wh *WorkflowHandler) getHistory(
 			PageSize:      int(pageSize),
 			NextPageToken: nextPageToken,
 			ShardID:       common.IntPtr(shardID),
+			Reverse:       reverse,
 		})
 		if err != nil {
 			return nil, nil, err
 		}
 	} else {
+		if reverse {
+			return nil, nil, errReverseHistoryNotSupported
+		}
 		response, err := wh.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
 			DomainID:      domainID,
 			Execution:     execution,
@@ -2882,11 +3265,59 @@ func (wh *WorkflowHandler) getHistory(
 		historyEvents = append(historyEvents, transientDecision.ScheduledEvent, transientDecision.StartedEvent)
 	}
 
+	if payload.HasReferences(historyEvents) {
+		if err := wh.payloadStore.ResolveEvents(ctx, historyEvents); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	executionHistory := &gen.History{}
 	executionHistory.Events = historyEvents
 	return executionHistory, nextPageToken, nil
 }
 
+// allowDomainRequest consumes a token from the rate limiter of the domain, the limiter is created on first
+// use and follows the dynamic config of the domain. The domain must be resolved already, so requests for
+// unknown domains do not create limiters.
+func (wh *WorkflowHandler) allowDomainRequest(domainID string, domainName string) bool {
+	rps := wh.config.DomainRPS(domainName)
+
+	value := wh.domainRateLimiters.Get(domainID)
+	if value == nil {
+		newLimiter := &domainRateLimiter{rps: rps, TokenBucket: tokenbucket.New(rps, clock.NewRealTimeSource())}
+		var err error
+		if value, err = wh.domainRateLimiters.PutIfNotExist(domainID, newLimiter); err != nil {
+			value = newLimiter
+		}
+	}
+	limiter := value.(*domainRateLimiter)
+
+	limiter.Lock()
+	if limiter.rps != rps {
+		limiter.rps = rps
+		limiter.Reset(rps)
+	}
+	limiter.Unlock()
+
+	ok, _ := limiter.TryConsume(1)
+	return ok
+}
+
+// getPayloadSizeLimitError returns the size limit for payloads which history offloads to the blobstore
+// when they exceed the domain's offload threshold, the regular blob size limit applies otherwise
+func (wh *WorkflowHandler) getPayloadSizeLimitError(domainName string) int {
+	if wh.config.PayloadOffloadThreshold(domainName) <= 0 || len(wh.config.PayloadOffloadBucket(domainName)) == 0 {
+		return wh.config.BlobSizeLimitError(domainName)
+	}
+	if !wh.config.EnablePayloadOffloadForGlobalDomains(domainName) {
+		domainEntry, err := wh.domainCache.GetDomain(domainName)
+		if err != nil || domainEntry.IsGlobalDomain() {
+			return wh.config.BlobSizeLimitError(domainName)
+		}
+	}
+	return wh.config.PayloadOffloadSizeLimitError(domainName)
+}
+
 func (wh *WorkflowHandler) getLoggerForTask(taskToken []byte) log.Logger {
 	logger := wh.Service.GetLogger()
 	task, err := wh.tokenSerializer.Deserialize(taskToken)
@@ -3058,12 +3489,14 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		}
 		scope = scope.Tagged(metrics.DomainTag(domain.GetInfo().Name))
 		history, persistenceToken, err = wh.getHistory(
+			ctx,
 			scope,
 			domainID,
 			*matchingResp.WorkflowExecution,
 			firstEventID,
 			nextEventID,
 			int32(wh.config.HistoryMaxPageSize(domain.GetInfo().Name)),
+			false,
 			nil,
 			matchingResp.DecisionInfo, eventStoreVersion, branchToken,
 		)
@@ -3099,6 +3532,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		History:                   history,
 		NextPageToken:             continuation,
 		WorkflowExecutionTaskList: matchingResp.WorkflowExecutionTaskList,
+		Updates:                   matchingResp.Updates,
 	}
 
 	return resp, nil
@@ -3209,10 +3643,20 @@ func (wh *WorkflowHandler) getArchivedHistory(
 			return nil, wh.error(err, scope)
 		}
 	}
+	history := historyBlob.Body
+	isAfterFilterRange := false
+	var activityScheduledEventIDs []int64
+	if request.EventFilter != nil {
+		filter := newHistoryEventFilter(request.EventFilter, token.ActivityScheduledEventIDs)
+		history = &gen.History{}
+		history.Events, isAfterFilterRange = filter.filterEvents(historyBlob.Body.GetEvents())
+		activityScheduledEventIDs = filter.getActivityScheduledEventIDs()
+	}
 	token = &getHistoryContinuationTokenArchival{
-		BlobstorePageToken: *historyBlob.Header.NextPageToken,
+		BlobstorePageToken:        *historyBlob.Header.NextPageToken,
+		ActivityScheduledEventIDs: activityScheduledEventIDs,
 	}
-	if *historyBlob.Header.IsLast {
+	if *historyBlob.Header.IsLast || isAfterFilterRange {
 		token = nil
 	}
 	nextToken, err := serializeHistoryTokenArchival(token)
@@ -3220,7 +3664,7 @@ func (wh *WorkflowHandler) getArchivedHistory(
 		return nil, wh.error(err, scope)
 	}
 	return &gen.GetWorkflowExecutionHistoryResponse{
-		History:       historyBlob.Body,
+		History:       history,
 		NextPageToken: nextToken,
 		Archived:      common.BoolPtr(true),
 	}, nil
@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/shared"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -448,6 +449,116 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	assert.Equal(s.T(), errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecutionBatch_Failed_RequestsNotSet() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	_, err := wh.StartWorkflowExecutionBatch(context.Background(), &shared.StartWorkflowExecutionBatchRequest{})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errBatchRequestsNotSet, err)
+
+	config.StartWorkflowBatchMaxSize = dc.GetIntPropertyFn(1)
+	_, err = wh.StartWorkflowExecutionBatch(context.Background(), &shared.StartWorkflowExecutionBatchRequest{
+		Requests: []*shared.StartWorkflowExecutionRequest{newStartWorkflowExecutionRequest("wid1"), newStartWorkflowExecutionRequest("wid2")},
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errBatchTooLarge, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecutionBatch() {
+	domainID := uuid.New()
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+	mockDomainCache := &cache.DomainCacheMock{}
+	mockHistoryClient := &mocks.HistoryClient{}
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = mockDomainCache
+	wh.history = mockHistoryClient
+	wh.startWG.Done()
+	defer mockHistoryClient.AssertExpectations(s.T())
+
+	mockDomainCache.On("GetDomainID", "test-domain").Return(domainID, nil)
+	mockHistoryClient.On("StartWorkflowExecutionBatch", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *history.StartWorkflowExecutionBatchRequest) *history.StartWorkflowExecutionBatchResponse {
+			var results []*shared.StartWorkflowExecutionBatchResult
+			for _, startRequest := range request.StartRequests {
+				s.Equal(domainID, startRequest.GetDomainUUID())
+				results = append(results, &shared.StartWorkflowExecutionBatchResult{
+					RunId: common.StringPtr(startRequest.StartRequest.GetWorkflowId() + "-run"),
+				})
+			}
+			return &history.StartWorkflowExecutionBatchResponse{Results: results}
+		}, nil)
+
+	invalidRequest := newStartWorkflowExecutionRequest("wid2")
+	invalidRequest.RequestId = nil
+	resp, err := wh.StartWorkflowExecutionBatch(context.Background(), &shared.StartWorkflowExecutionBatchRequest{
+		Requests: []*shared.StartWorkflowExecutionRequest{
+			newStartWorkflowExecutionRequest("wid1"),
+			invalidRequest,
+			newStartWorkflowExecutionRequest("wid3"),
+		},
+	})
+	s.NoError(err)
+	s.Len(resp.Results, 3)
+	s.Equal("wid1-run", resp.Results[0].GetRunId())
+	s.Nil(resp.Results[0].ErrorType)
+	s.Nil(resp.Results[1].RunId)
+	s.Equal("BadRequestError", resp.Results[1].GetErrorType())
+	s.Equal(errRequestIDNotSet.Message, resp.Results[1].GetErrorMessage())
+	s.Equal("wid3-run", resp.Results[2].GetRunId())
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_DomainRateLimited() {
+	config := s.newConfig()
+	config.DomainRPS = dc.GetIntPropertyFilteredByDomain(0)
+	wh := s.getWorkflowHandler(config)
+	mockDomainCache := &cache.DomainCacheMock{}
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = mockDomainCache
+	wh.startWG.Done()
+
+	mockDomainCache.On("GetDomainID", "test-domain").Return(uuid.New(), nil)
+	_, err := wh.StartWorkflowExecution(context.Background(), newStartWorkflowExecutionRequest("wid1"))
+	s.IsType(&shared.ServiceBusyError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestAllowDomainRequest_KeyedByDomainID() {
+	config := s.newConfig()
+	config.DomainRPS = func(domain string) int {
+		if domain == "throttled-domain" {
+			return 0
+		}
+		return 100
+	}
+	wh := s.getWorkflowHandler(config)
+
+	domainID := uuid.New()
+	s.True(wh.allowDomainRequest(domainID, "test-domain"))
+	s.True(wh.allowDomainRequest(domainID, "test-domain"))
+	s.False(wh.allowDomainRequest(uuid.New(), "throttled-domain"))
+	s.True(wh.allowDomainRequest(uuid.New(), "test-domain"))
+	s.Equal(3, wh.domainRateLimiters.Size())
+}
+
+func newStartWorkflowExecutionRequest(workflowID string) *shared.StartWorkflowExecutionRequest {
+	return &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr(workflowID),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(10),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+}
+
 func (s *workflowHandlerSuite) getWorkflowHandlerWithParams(mService cs.Service, config *Config,
 	mMetadataManager persistence.MetadataManager, blobStore blobstore.Client) *WorkflowHandler {
 	return NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
@@ -587,6 +698,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalNeverEnabled()
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, s.mockBlobstoreClient)
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
+	wh.history = runningWorkflowCountsHistoryClient(map[string]int64{"test-workflow-type": 2})
 
 	req := &shared.DescribeDomainRequest{
 		Name: common.StringPtr("test-domain"),
@@ -600,6 +712,11 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalNeverEnabled()
 	assert.Equal(s.T(), result.Configuration.GetArchivalBucketName(), "")
 	assert.Equal(s.T(), result.Configuration.GetArchivalRetentionPeriodInDays(), int32(0))
 	assert.Equal(s.T(), result.Configuration.GetArchivalBucketOwner(), "")
+	assert.Equal(s.T(), []*shared.WorkflowTypeConcurrency{{
+		WorkflowType:      common.StringPtr("test-workflow-type"),
+		RunningExecutions: common.Int64Ptr(2),
+		Limit:             common.Int32Ptr(0),
+	}}, result.WorkflowTypeConcurrency)
 	mBlobstore.AssertNotCalled(s.T(), "BucketMetadata", mock.Anything, mock.Anything)
 }
 
@@ -615,6 +732,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalEnabled() {
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, mBlobstore)
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
+	wh.history = runningWorkflowCountsHistoryClient(map[string]int64{"test-workflow-type": 2})
 
 	req := &shared.DescribeDomainRequest{
 		Name: common.StringPtr("test-domain"),
@@ -643,6 +761,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalDisabled() {
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, mBlobstore)
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
+	wh.history = runningWorkflowCountsHistoryClient(map[string]int64{"test-workflow-type": 2})
 
 	req := &shared.DescribeDomainRequest{
 		Name: common.StringPtr("test-domain"),
@@ -671,6 +790,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_BlobstoreReturnsError(
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, mBlobstore)
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
+	wh.history = runningWorkflowCountsHistoryClient(map[string]int64{"test-workflow-type": 2})
 
 	req := &shared.DescribeDomainRequest{
 		Name: common.StringPtr("test-domain"),
@@ -1105,16 +1225,133 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, mBlobstore)
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	scope := wh.metricsClient.Scope(0)
-	history, token, err := wh.getHistory(scope, domainID, we, firstEventID, nextEventID, 0, []byte{}, nil, persistence.EventStoreVersionV2, []byte{})
+	history, token, err := wh.getHistory(context.Background(), scope, domainID, we, firstEventID, nextEventID, 0, false, []byte{}, nil, persistence.EventStoreVersionV2, []byte{})
 	s.NotNil(history)
 	s.Equal([]byte{}, token)
 	s.NoError(err)
 }
 
+func (s *workflowHandlerSuite) TestGetHistory_Reverse() {
+	config := s.newConfig()
+	domainID := uuid.New()
+	firstEventID := int64(1)
+	nextEventID := int64(101)
+	we := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("wid"),
+		RunId:      common.StringPtr("rid"),
+	}
+	shardID := common.WorkflowIDToHistoryShard(*we.WorkflowId, numHistoryShards)
+	req := &persistence.ReadHistoryBranchRequest{
+		BranchToken:   []byte{},
+		MinEventID:    firstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      2,
+		NextPageToken: []byte{},
+		ShardID:       common.IntPtr(shardID),
+		Reverse:       true,
+	}
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", req).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*workflow.HistoryEvent{
+			{EventId: common.Int64Ptr(int64(100))},
+			{EventId: common.Int64Ptr(int64(99))},
+		},
+		NextPageToken:    []byte{1},
+		Size:             1,
+		LastFirstEventID: 99,
+	}, nil).Once()
+	clusterMetadata := &mocks.ClusterMetadata{}
+	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean)
+	mMetadataManager := &mocks.MetadataManager{}
+	mBlobstore := &mocks.BlobstoreClient{}
+	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, mBlobstore)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	scope := wh.metricsClient.Scope(0)
+	history, token, err := wh.getHistory(context.Background(), scope, domainID, we, firstEventID, nextEventID, 2, true, []byte{}, nil, persistence.EventStoreVersionV2, []byte{})
+	s.NoError(err)
+	s.Equal([]byte{1}, token)
+	s.Len(history.Events, 2)
+	s.Equal(int64(100), history.Events[0].GetEventId())
+
+	_, _, err = wh.getHistory(context.Background(), scope, domainID, we, firstEventID, nextEventID, 2, true, []byte{}, nil, 0, nil)
+	s.Equal(errReverseHistoryNotSupported, err)
+}
+
+func (s *workflowHandlerSuite) TestGetFilteredHistory_MaxPages() {
+	config := s.newConfig()
+	we := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("wid"),
+		RunId:      common.StringPtr("rid"),
+	}
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*workflow.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(int64(1)),
+				EventType: gen.EventTypeWorkflowExecutionStarted.Ptr(),
+			},
+		},
+		NextPageToken: []byte{1},
+		Size:          1,
+	}, nil).Twice()
+	clusterMetadata := &mocks.ClusterMetadata{}
+	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean)
+	mMetadataManager := &mocks.MetadataManager{}
+	mBlobstore := &mocks.BlobstoreClient{}
+	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, mBlobstore)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	scope := wh.metricsClient.Scope(0)
+
+	token := &getHistoryContinuationToken{
+		FirstEventID:      common.FirstEventID,
+		NextEventID:       101,
+		EventStoreVersion: persistence.EventStoreVersionV2,
+		BranchToken:       []byte{},
+	}
+	filter := newHistoryEventFilter(&gen.HistoryEventFilter{
+		EventTypes: []gen.EventType{gen.EventTypeTimerFired},
+	}, nil)
+	// no event matches, so the call gives up after two pages with an empty page and the token of the next one
+	history, isAfterFilterRange, err := wh.getFilteredHistory(context.Background(), scope, uuid.New(), we, token, filter, 1, 2, false)
+	s.NoError(err)
+	s.False(isAfterFilterRange)
+	s.Empty(history.Events)
+	s.Equal([]byte{1}, token.PersistenceToken)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionHistory_Failed_InvalidReverse() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	_, err := wh.GetWorkflowExecutionHistory(context.Background(), &gen.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr("test-domain"),
+		Execution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("wid"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		WaitForNewEvent: common.BoolPtr(true),
+		Reverse:         common.BoolPtr(true),
+	})
+	s.Equal(errInvalidReverseHistoryRequest, err)
+}
+
 func (s *workflowHandlerSuite) newConfig() *Config {
 	return NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards, false, false)
 }
 
+func runningWorkflowCountsHistoryClient(counts map[string]int64) *mocks.HistoryClient {
+	var shardIDs []int32
+	for shardID := 0; shardID < numHistoryShards; shardID++ {
+		shardIDs = append(shardIDs, int32(shardID))
+	}
+	historyClient := &mocks.HistoryClient{}
+	historyClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&history.GetRunningWorkflowCountsResponse{
+		Counts:   counts,
+		ShardIDs: shardIDs,
+	}, nil)
+	return historyClient
+}
+
 func bucketMetadataResponse(owner string, retentionDays int) *blobstore.BucketMetadataResponse {
 	return &blobstore.BucketMetadataResponse{
 		Owner:         owner,
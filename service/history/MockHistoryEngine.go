@@ -89,6 +89,29 @@ func (_m *MockHistoryEngine) DescribeMutableState(ctx context.Context, request *
 	return r0, r1
 }
 
+// VerifyWorkflowExecutionHistory is mock implementation for VerifyWorkflowExecutionHistory of HistoryEngine
+func (_m *MockHistoryEngine) VerifyWorkflowExecutionHistory(ctx context.Context, request *gohistory.VerifyWorkflowExecutionHistoryRequest) (*gohistory.VerifyWorkflowExecutionHistoryResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *gohistory.VerifyWorkflowExecutionHistoryResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.VerifyWorkflowExecutionHistoryRequest) *gohistory.VerifyWorkflowExecutionHistoryResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.VerifyWorkflowExecutionHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.VerifyWorkflowExecutionHistoryRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMutableState is mock implementation for GetMutableState of HistoryEngine
 func (_m *MockHistoryEngine) GetMutableState(ctx context.Context, request *gohistory.GetMutableStateRequest) (*gohistory.GetMutableStateResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -334,6 +357,30 @@ func (_m *MockHistoryEngine) SignalWorkflowExecution(ctx context.Context, reques
 	return r0
 }
 
+// UpdateWorkflowExecution is mock implementation for UpdateWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) UpdateWorkflowExecution(ctx context.Context, request *gohistory.UpdateWorkflowExecutionRequest) (
+	*shared.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignalWithStartWorkflowExecution is mock implementation for SignalWithStartWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) SignalWithStartWorkflowExecution(ctx context.Context, request *gohistory.SignalWithStartWorkflowExecutionRequest) (
 	*shared.StartWorkflowExecutionResponse, error) {
@@ -493,4 +540,25 @@ func (_m *MockHistoryEngine) SyncActivity(ctx context.Context, request *gohistor
 	return r0
 }
 
+// GetRunningWorkflowCounts is mock implementation for GetRunningWorkflowCounts of HistoryEngine
+func (_m *MockHistoryEngine) GetRunningWorkflowCounts(domainID string) map[string]int64 {
+	ret := _m.Called(domainID)
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func(string) map[string]int64); ok {
+		r0 = rf(domainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	return r0
+}
+
+// SetRunningWorkflowCount is mock implementation for SetRunningWorkflowCount of HistoryEngine
+func (_m *MockHistoryEngine) SetRunningWorkflowCount(domainID string, workflowType string, count int64) {
+	_m.Called(domainID, workflowType, count)
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
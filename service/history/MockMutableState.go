@@ -628,6 +628,11 @@ func (_m *mockMutableState) AddSignalExternalWorkflowExecutionInitiatedEvent(_a0
 	return r0, r1
 }
 
+// AddDelayedSignal provides a mock function with given fields: _a0
+func (_m *mockMutableState) AddDelayedSignal(_a0 *shared.DelayedSignalInfo) {
+	_m.Called(_a0)
+}
+
 // AddSignalRequested provides a mock function with given fields: requestID
 func (_m *mockMutableState) AddSignalRequested(requestID string) {
 	_m.Called(requestID)
@@ -779,13 +784,29 @@ func (_m *mockMutableState) AddWorkflowExecutionCanceledEvent(_a0 int64, _a1 *sh
 	return r0
 }
 
-// AddWorkflowExecutionSignaled provides a mock function with given fields: _a0
-func (_m *mockMutableState) AddWorkflowExecutionSignaled(_a0 string, _a1 []byte, _a2 string) *shared.HistoryEvent {
-	ret := _m.Called(_a0, _a1, _a2)
+// AddWorkflowExecutionUpdateAcceptedEvent provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *mockMutableState) AddWorkflowExecutionUpdateAcceptedEvent(_a0 int64, _a1 string, _a2 *shared.WorkflowUpdate, _a3 []byte, _a4 string) *shared.HistoryEvent {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	var r0 *shared.HistoryEvent
-	if rf, ok := ret.Get(0).(func(string, []byte, string) *shared.HistoryEvent); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(int64, string, *shared.WorkflowUpdate, []byte, string) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	return r0
+}
+
+// AddWorkflowExecutionSignaled provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *mockMutableState) AddWorkflowExecutionSignaled(_a0 string, _a1 []byte, _a2 string, _a3 string) *shared.HistoryEvent {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, []byte, string, string) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*shared.HistoryEvent)
@@ -1014,6 +1035,11 @@ func (_m *mockMutableState) DeleteDecision() {
 	_m.Called()
 }
 
+// DeleteDelayedSignal provides a mock function with given fields: requestID
+func (_m *mockMutableState) DeleteDelayedSignal(requestID string) {
+	_m.Called(requestID)
+}
+
 // DeletePendingChildExecution provides a mock function with given fields: _a0
 func (_m *mockMutableState) DeletePendingChildExecution(_a0 int64) {
 	_m.Called(_a0)
@@ -1545,6 +1571,29 @@ func (_m *mockMutableState) GetRetryBackoffDuration(errReason string) time.Durat
 	return r0
 }
 
+// GetDelayedSignal provides a mock function with given fields: requestID
+func (_m *mockMutableState) GetDelayedSignal(requestID string) (*shared.DelayedSignalInfo, bool) {
+	ret := _m.Called(requestID)
+
+	var r0 *shared.DelayedSignalInfo
+	if rf, ok := ret.Get(0).(func(string) *shared.DelayedSignalInfo); ok {
+		r0 = rf(requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DelayedSignalInfo)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(requestID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetCronBackoffDuration provides a mock function
 func (_m *mockMutableState) GetCronBackoffDuration() time.Duration {
 	ret := _m.Called()
@@ -1658,6 +1707,43 @@ func (_m *mockMutableState) GetWorkflowType() *shared.WorkflowType {
 	return r0
 }
 
+// GetWorkflowUpdateAcceptedEvent provides a mock function with given fields: updateID
+func (_m *mockMutableState) GetWorkflowUpdateAcceptedEvent(updateID string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(updateID)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string) *shared.HistoryEvent); ok {
+		r0 = rf(updateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(updateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasAppliedSignalRequestID provides a mock function with given fields: requestID
+func (_m *mockMutableState) HasAppliedSignalRequestID(requestID string) bool {
+	ret := _m.Called(requestID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(requestID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // HasBufferedEvents provides a mock function with given fields:
 func (_m *mockMutableState) HasBufferedEvents() bool {
 	ret := _m.Called()
@@ -1742,6 +1828,22 @@ func (_m *mockMutableState) HasProcessedOrPendingDecisionTask() bool {
 	return r0
 }
 
+// HeartbeatDecisionTask provides a mock function with given fields: scheduleEventID
+func (_m *mockMutableState) HeartbeatDecisionTask(scheduleEventID int64) *decisionInfo {
+	ret := _m.Called(scheduleEventID)
+
+	var r0 *decisionInfo
+	if rf, ok := ret.Get(0).(func(int64) *decisionInfo); ok {
+		r0 = rf(scheduleEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*decisionInfo)
+		}
+	}
+
+	return r0
+}
+
 // IncrementHistorySize provides a mock function with given fields: appendSize
 func (_m *mockMutableState) IncrementHistorySize(appendSize int) {
 	_m.Called(appendSize)
@@ -2165,6 +2267,11 @@ func (_m *mockMutableState) ReplicateWorkflowExecutionTimedoutEvent(_a0 int64, _
 	_m.Called(_a0, _a1)
 }
 
+// ReplicateWorkflowExecutionUpdateAccepted provides a mock function with given fields: _a0, _a1
+func (_m *mockMutableState) ReplicateWorkflowExecutionUpdateAccepted(_a0 int64, _a1 *shared.HistoryEvent) {
+	_m.Called(_a0, _a1)
+}
+
 // ResetSnapshot provides a mock function with given fields:
 func (_m *mockMutableState) ResetSnapshot(_a0 string) *persistence.ResetMutableStateRequest {
 	ret := _m.Called(_a0)
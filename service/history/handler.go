@@ -34,6 +34,7 @@ import (
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
@@ -41,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/tokenbucket"
@@ -62,6 +64,8 @@ type (
 		historyServiceClient  hc.Client
 		matchingServiceClient matching.Client
 		publicClient          workflowserviceclient.Interface
+		blobstoreClient       blobstore.Client
+		payloadStore          payload.Store
 		hServiceResolver      membership.ServiceResolver
 		controller            *shardController
 		tokenSerializer       common.TaskTokenSerializer
@@ -71,6 +75,8 @@ type (
 		historyEventNotifier  historyEventNotifier
 		publisher             messaging.Producer
 		rateLimiter           tokenbucket.TokenBucket
+		concurrencyLimiter    *workflowConcurrencyLimiterImpl
+		concurrencyReconciler *workflowConcurrencyReconciler
 		service.Service
 	}
 )
@@ -94,7 +100,8 @@ var (
 func NewHandler(sVice service.Service, config *Config, shardManager persistence.ShardManager,
 	metadataMgr persistence.MetadataManager, visibilityMgr persistence.VisibilityManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
-	executionMgrFactory persistence.ExecutionManagerFactory, publicClient workflowserviceclient.Interface) *Handler {
+	executionMgrFactory persistence.ExecutionManagerFactory, publicClient workflowserviceclient.Interface,
+	blobstoreClient blobstore.Client) *Handler {
 	handler := &Handler{
 		Service:             sVice,
 		config:              config,
@@ -107,6 +114,7 @@ func NewHandler(sVice service.Service, config *Config, shardManager persistence.
 		tokenSerializer:     common.NewJSONTaskTokenSerializer(),
 		rateLimiter:         tokenbucket.New(config.RPS(), clock.NewRealTimeSource()),
 		publicClient:        publicClient,
+		blobstoreClient:     blobstoreClient,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -136,6 +144,13 @@ func (h *Handler) Start() error {
 		common.IsWhitelistServiceTransientError,
 	)
 
+	h.concurrencyLimiter = newWorkflowConcurrencyLimiter(
+		h.historyServiceClient,
+		clock.NewRealTimeSource(),
+		h.config.NumberOfShards,
+		h.config.WorkflowConcurrencyCountCacheTTL,
+	)
+
 	hServiceResolver, err1 := h.GetMembershipMonitor().GetResolver(common.HistoryServiceName)
 	if err1 != nil {
 		h.Service.GetLogger().Fatal("Unable to get history service resolver", tag.Error(err1))
@@ -153,19 +168,33 @@ func (h *Handler) Start() error {
 
 	h.domainCache = cache.NewDomainCache(h.metadataMgr, h.GetClusterMetadata(), h.GetMetricsClient(), h.GetLogger())
 	h.domainCache.Start()
+
+	// blobstore is only available when archival is configured, payload offloading is disabled otherwise
+	var payloadBlobstore blobstore.Client
+	if h.blobstoreClient != nil {
+		payloadBlobstore = blobstore.NewRetryableClient(
+			blobstore.NewMetricClient(h.blobstoreClient, h.GetMetricsClient()),
+			h.blobstoreClient.GetRetryPolicy(),
+			h.blobstoreClient.IsRetryableError)
+	}
+	h.payloadStore = payload.NewStore(payloadBlobstore)
 	h.controller = newShardController(h.Service, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr, h.historyV2Mgr,
-		h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
+		h.domainCache, h.executionMgrFactory, h.payloadStore, h, h.config, h.GetLogger(), h.GetMetricsClient())
+	h.concurrencyReconciler = newWorkflowConcurrencyReconciler(h.concurrencyLimiter, h.controller, h.visibilityMgr,
+		h.domainCache, h.config.NumberOfShards, h.config.WorkflowConcurrencyCountReconcileInterval, h.GetLogger())
 	h.metricsClient = h.GetMetricsClient()
 	h.historyEventNotifier = newHistoryEventNotifier(h.GetMetricsClient(), h.config.GetShardID)
 	// events notifier must starts before controller
 	h.historyEventNotifier.Start()
 	h.controller.Start()
+	h.concurrencyReconciler.Start()
 	h.startWG.Done()
 	return nil
 }
 
 // Stop stops the handler
 func (h *Handler) Stop() {
+	h.concurrencyReconciler.Stop()
 	h.domainCache.Stop()
 	h.controller.Stop()
 	h.shardManager.Close()
@@ -183,7 +212,7 @@ func (h *Handler) Stop() {
 // CreateEngine is implementation for HistoryEngineFactory used for creating the engine instance for shard
 func (h *Handler) CreateEngine(context ShardContext) Engine {
 	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient,
-		h.publicClient, h.historyEventNotifier, h.publisher, h.config)
+		h.publicClient, h.historyEventNotifier, h.publisher, h.concurrencyLimiter, h.config)
 }
 
 // Health is for health check
@@ -602,6 +631,62 @@ func (h *Handler) StartWorkflowExecution(ctx context.Context,
 	return response, nil
 }
 
+// StartWorkflowExecutionBatch starts a batch of workflow executions whose workflowIDs belong to the same shard.
+// Each request is handled as a StartWorkflowExecution and its result is returned at its position in the batch.
+func (h *Handler) StartWorkflowExecutionBatch(ctx context.Context,
+	batchRequest *hist.StartWorkflowExecutionBatchRequest) (resp *hist.StartWorkflowExecutionBatchResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryStartWorkflowExecutionBatchScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	results := make([]*gen.StartWorkflowExecutionBatchResult, len(batchRequest.StartRequests))
+	for i, wrappedRequest := range batchRequest.StartRequests {
+		response, err := h.startWorkflowExecutionOfBatch(ctx, wrappedRequest)
+		if err != nil {
+			// the whole batch is redirected to the new owner of the shard, the requests started
+			// already are deduplicated there by their requestID
+			if _, ok := err.(*hist.ShardOwnershipLostError); ok {
+				return nil, err
+			}
+			results[i] = common.CreateStartWorkflowExecutionBatchErrorResult(err)
+			continue
+		}
+		results[i] = &gen.StartWorkflowExecutionBatchResult{RunId: response.RunId}
+	}
+
+	return &hist.StartWorkflowExecutionBatchResponse{Results: results}, nil
+}
+
+func (h *Handler) startWorkflowExecutionOfBatch(ctx context.Context,
+	wrappedRequest *hist.StartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+
+	scope := metrics.HistoryStartWorkflowExecutionBatchScope
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowID := wrappedRequest.StartRequest.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	response, err2 := engine.StartWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return response, nil
+}
+
 // DescribeHistoryHost returns information about the internal states of a history host
 func (h *Handler) DescribeHistoryHost(ctx context.Context,
 	request *gen.DescribeHistoryHostRequest) (resp *gen.DescribeHistoryHostResponse, retError error) {
@@ -669,6 +754,40 @@ func (h *Handler) DescribeMutableState(ctx context.Context,
 	return resp, nil
 }
 
+// VerifyWorkflowExecutionHistory - replays the history of workflow execution and diffs it against the mutable state
+func (h *Handler) VerifyWorkflowExecutionHistory(ctx context.Context,
+	request *hist.VerifyWorkflowExecutionHistoryRequest) (resp *hist.VerifyWorkflowExecutionHistoryResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryVerifyWorkflowExecutionHistoryScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.VerifyWorkflowExecutionHistory(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
 // GetMutableState - returns the id of the next event in the execution's history
 func (h *Handler) GetMutableState(ctx context.Context,
 	getRequest *hist.GetMutableStateRequest) (resp *hist.GetMutableStateResponse, retError error) {
@@ -813,6 +932,42 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// UpdateWorkflowExecution is used to send an update to a running workflow execution and block until the
+// worker accepts or rejects it on a decision task.
+func (h *Handler) UpdateWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.UpdateWorkflowExecutionRequest) (resp *gen.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpdateWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.UpdateRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.UpdateWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -1187,6 +1342,45 @@ func (h *Handler) SyncShardStatus(ctx context.Context, syncShardStatusRequest *h
 	return nil
 }
 
+// GetRunningWorkflowCounts returns the number of running executions per workflow type of a domain
+// on the requested shards owned by this host
+func (h *Handler) GetRunningWorkflowCounts(ctx context.Context,
+	request *hist.GetRunningWorkflowCountsRequest) (resp *hist.GetRunningWorkflowCountsResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetRunningWorkflowCountsScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	resp = &hist.GetRunningWorkflowCountsResponse{
+		Counts: make(map[string]int64),
+	}
+	for _, shardID := range request.ShardIDs {
+		// shards which are not owned by this host are left out of the response for the caller to retry
+		engine, err := h.controller.getEngineForShard(int(shardID))
+		if err != nil {
+			continue
+		}
+		for workflowType, count := range engine.GetRunningWorkflowCounts(domainID) {
+			resp.Counts[workflowType] += count
+		}
+		resp.ShardIDs = append(resp.ShardIDs, shardID)
+	}
+
+	return resp, nil
+}
+
 // SyncActivity is called by processor to sync activity
 func (h *Handler) SyncActivity(ctx context.Context, syncActivityRequest *hist.SyncActivityRequest) (retError error) {
 	defer log.CapturePanic(h.GetLogger(), &retError)
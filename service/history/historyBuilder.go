@@ -364,8 +364,15 @@ func (b *historyBuilder) AddMarkerRecordedEvent(decisionCompletedEventID int64,
 }
 
 func (b *historyBuilder) AddWorkflowExecutionSignaledEvent(
-	signalName string, input []byte, identity string) *workflow.HistoryEvent {
-	event := b.newWorkflowExecutionSignaledEvent(signalName, input, identity)
+	signalName string, input []byte, identity string, requestID string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionSignaledEvent(signalName, input, identity, requestID)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID int64,
+	updateID string, update *workflow.WorkflowUpdate, result []byte, identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID, updateID, update, result, identity)
 
 	return b.addEventToHistory(event)
 }
@@ -483,6 +490,9 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.Initiator = startRequest.ContinueAsNewInitiator
 	attributes.FirstDecisionTaskBackoffSeconds = startRequest.FirstDecisionTaskBackoffSeconds
 	attributes.Memo = request.Memo
+	attributes.RetentionPeriodInDays = request.RetentionPeriodInDays
+	attributes.WorkflowExecutionTimeoutSeconds = request.WorkflowExecutionTimeoutSeconds
+	attributes.WorkflowExecutionExpirationTimestamp = startRequest.WorkflowExecutionExpirationTimestamp
 
 	parentInfo := startRequest.ParentExecutionInfo
 	if parentInfo != nil {
@@ -660,17 +670,35 @@ func (b *historyBuilder) newTimeoutWorkflowExecutionEvent() *workflow.HistoryEve
 }
 
 func (b *historyBuilder) newWorkflowExecutionSignaledEvent(
-	signalName string, input []byte, identity string) *workflow.HistoryEvent {
+	signalName string, input []byte, identity string, requestID string) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionSignaled)
 	attributes := &workflow.WorkflowExecutionSignaledEventAttributes{}
 	attributes.SignalName = common.StringPtr(signalName)
 	attributes.Input = input
 	attributes.Identity = common.StringPtr(identity)
+	if requestID != "" {
+		attributes.RequestId = common.StringPtr(requestID)
+	}
 	historyEvent.WorkflowExecutionSignaledEventAttributes = attributes
 
 	return historyEvent
 }
 
+func (b *historyBuilder) newWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID int64,
+	updateID string, update *workflow.WorkflowUpdate, result []byte, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUpdateAccepted)
+	attributes := &workflow.WorkflowExecutionUpdateAcceptedEventAttributes{}
+	attributes.UpdateId = common.StringPtr(updateID)
+	attributes.UpdateName = common.StringPtr(update.GetUpdateName())
+	attributes.Input = update.Input
+	attributes.Result = result
+	attributes.Identity = common.StringPtr(identity)
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionCompletedEventID)
+	historyEvent.WorkflowExecutionUpdateAcceptedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newWorkflowExecutionTerminatedEvent(
 	request *workflow.TerminateWorkflowExecutionRequest) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionTerminated)
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -43,11 +44,15 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/cadence/service/worker/archiver"
+	"github.com/uber/cadence/service/worker/parentclosepolicy"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/cadence/.gen/go/shared"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/yarpcerrors"
 )
 
 const (
@@ -59,26 +64,29 @@ const (
 
 type (
 	historyEngineImpl struct {
-		currentClusterName   string
-		shard                ShardContext
-		historyMgr           persistence.HistoryManager
-		historyV2Mgr         persistence.HistoryV2Manager
-		executionManager     persistence.ExecutionManager
-		visibilityMgr        persistence.VisibilityManager
-		txProcessor          transferQueueProcessor
-		timerProcessor       timerQueueProcessor
-		taskAllocator        taskAllocator
-		replicator           *historyReplicator
-		replicatorProcessor  queueProcessor
-		historyEventNotifier historyEventNotifier
-		tokenSerializer      common.TaskTokenSerializer
-		historyCache         *historyCache
-		metricsClient        metrics.Client
-		logger               log.Logger
-		throttledLogger      log.Logger
-		config               *Config
-		archivalClient       archiver.Client
-		resetor              workflowResetor
+		currentClusterName      string
+		shard                   ShardContext
+		historyMgr              persistence.HistoryManager
+		historyV2Mgr            persistence.HistoryV2Manager
+		executionManager        persistence.ExecutionManager
+		visibilityMgr           persistence.VisibilityManager
+		txProcessor             transferQueueProcessor
+		timerProcessor          timerQueueProcessor
+		taskAllocator           taskAllocator
+		replicator              *historyReplicator
+		replicatorProcessor     queueProcessor
+		historyEventNotifier    historyEventNotifier
+		tokenSerializer         common.TaskTokenSerializer
+		historyCache            *historyCache
+		metricsClient           metrics.Client
+		logger                  log.Logger
+		throttledLogger         log.Logger
+		config                  *Config
+		archivalClient          archiver.Client
+		parentClosePolicyClient parentclosepolicy.Client
+		resetor                 workflowResetor
+		updateRegistry          *workflowUpdateRegistry
+		concurrencyLimiter      workflowConcurrencyLimiter
 	}
 
 	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor on new tasks.
@@ -123,8 +131,16 @@ var (
 	ErrBufferedEventsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for buffered events"}
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for signal events"}
+	// ErrDelayedSignalInputTooLarge is the error indicating the input of a delayed signal exceeds the blob size limit
+	ErrDelayedSignalInputTooLarge = &workflow.BadRequestError{Message: "Input of delayed signal exceeds size limit."}
 	// ErrEventsAterWorkflowFinish is the error indicating server error trying to write events after workflow finish event
 	ErrEventsAterWorkflowFinish = &shared.InternalServiceError{Message: "error validating last event being workflow finish event."}
+	// ErrWorkflowUpdateTimedOut is the error indicating the worker did not accept or reject an update in time
+	ErrWorkflowUpdateTimedOut = yarpcerrors.DeadlineExceededErrorf("Timed out waiting for the workflow update result.")
+	// ErrWorkflowUpdateNotHandled is the error indicating the worker completed decisions without answering an update
+	ErrWorkflowUpdateNotHandled = &workflow.BadRequestError{Message: "The worker did not accept or reject the workflow update."}
+	// errWorkflowUpdateAlreadyAccepted stops an update action once the update is found accepted in the mutable state
+	errWorkflowUpdateAlreadyAccepted = errors.New("workflow update is already accepted")
 
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -145,6 +161,7 @@ func NewEngineWithShardContext(
 	publicClient workflowserviceclient.Interface,
 	historyEventNotifier historyEventNotifier,
 	publisher messaging.Producer,
+	concurrencyLimiter workflowConcurrencyLimiter,
 	config *Config,
 ) Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
@@ -160,20 +177,23 @@ func NewEngineWithShardContext(
 	historyV2Manager := shard.GetHistoryV2Manager()
 	historyCache := newHistoryCache(shard)
 	historyEngImpl := &historyEngineImpl{
-		currentClusterName:   currentClusterName,
-		shard:                shard,
-		historyMgr:           historyManager,
-		historyV2Mgr:         historyV2Manager,
-		executionManager:     executionManager,
-		visibilityMgr:        visibilityMgr,
-		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
-		historyCache:         historyCache,
-		logger:               logger.WithTags(tag.ComponentMatchingEngine),
-		throttledLogger:      shard.GetThrottledLogger().WithTags(tag.ComponentMatchingEngine),
-		metricsClient:        shard.GetMetricsClient(),
-		historyEventNotifier: historyEventNotifier,
-		config:               config,
-		archivalClient:       archiver.NewClient(shard.GetMetricsClient(), shard.GetLogger(), publicClient, shard.GetConfig().NumArchiveSystemWorkflows),
+		currentClusterName:      currentClusterName,
+		shard:                   shard,
+		historyMgr:              historyManager,
+		historyV2Mgr:            historyV2Manager,
+		executionManager:        executionManager,
+		visibilityMgr:           visibilityMgr,
+		tokenSerializer:         common.NewJSONTaskTokenSerializer(),
+		historyCache:            historyCache,
+		logger:                  logger.WithTags(tag.ComponentMatchingEngine),
+		throttledLogger:         shard.GetThrottledLogger().WithTags(tag.ComponentMatchingEngine),
+		metricsClient:           shard.GetMetricsClient(),
+		historyEventNotifier:    historyEventNotifier,
+		config:                  config,
+		archivalClient:          archiver.NewClient(shard.GetMetricsClient(), shard.GetLogger(), publicClient, shard.GetConfig().NumArchiveSystemWorkflows),
+		parentClosePolicyClient: parentclosepolicy.NewClient(shard.GetMetricsClient(), shard.GetLogger(), publicClient, config.NumParentClosePolicySystemWorkflows),
+		updateRegistry:          newWorkflowUpdateRegistry(config.WorkflowUpdateDedupeTTL()),
+		concurrencyLimiter:      concurrencyLimiter,
 	}
 
 	txProcessor := newTransferQueueProcessor(shard, historyEngImpl, visibilityMgr, matching, historyClient, logger)
@@ -328,7 +348,7 @@ func (e *historyEngineImpl) createMutableState(clusterMetadata cluster.Metadata,
 	return msBuilder
 }
 
-func (e *historyEngineImpl) generateFirstDecisionTask(domainID string, msBuilder mutableState, parentInfo *h.ParentExecutionInfo,
+func (e *historyEngineImpl) generateFirstDecisionTask(ctx ctx.Context, domainID string, msBuilder mutableState, parentInfo *h.ParentExecutionInfo,
 	taskListName string, cronBackoffSeconds int32) ([]persistence.Task, *decisionInfo, error) {
 	di := &decisionInfo{
 		TaskList:        taskListName,
@@ -349,7 +369,7 @@ func (e *historyEngineImpl) generateFirstDecisionTask(domainID string, msBuilder
 			}
 
 			transferTasks = append(transferTasks, &persistence.DecisionTask{
-				DomainID: domainID, TaskList: taskListName, ScheduleID: di.ScheduleID,
+				DomainID: domainID, TaskList: taskListName, ScheduleID: di.ScheduleID, TraceContext: tracing.Inject(ctx),
 			})
 		}
 	}
@@ -388,6 +408,18 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx ctx.Context, startRequest
 		}
 	}
 
+	backoffTimeoutType := getFirstDecisionBackoffTimeoutType(request)
+	if startRequest.ParentExecutionInfo == nil && startRequest.GetFirstDecisionTaskBackoffSeconds() == 0 {
+		queued, err := e.checkWorkflowConcurrencyLimit(domainEntry, startRequest, metrics.HistoryStartWorkflowExecutionScope)
+		if err != nil {
+			retError = err
+			return
+		}
+		if queued {
+			backoffTimeoutType = persistence.WorkflowBackoffTimeoutTypeConcurrencyLimit
+		}
+	}
+
 	startedEvent := msBuilder.AddWorkflowExecutionStartedEvent(execution, startRequest)
 	if startedEvent == nil {
 		retError = &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
@@ -397,7 +429,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx ctx.Context, startRequest
 	taskList := request.TaskList.GetName()
 	cronBackoffSeconds := startRequest.GetFirstDecisionTaskBackoffSeconds()
 	// Generate first decision task event if not child WF and no first decision task backoff
-	transferTasks, _, retError := e.generateFirstDecisionTask(domainID, msBuilder, startRequest.ParentExecutionInfo, taskList, cronBackoffSeconds)
+	transferTasks, _, retError := e.generateFirstDecisionTask(ctx, domainID, msBuilder, startRequest.ParentExecutionInfo, taskList, cronBackoffSeconds)
 	if retError != nil {
 		return
 	}
@@ -406,14 +438,14 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx ctx.Context, startRequest
 	cronBackoffDuration := time.Duration(cronBackoffSeconds) * time.Second
 	timeoutDuration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds)*time.Second + cronBackoffDuration
 	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
-		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(timeoutDuration),
+		VisibilityTimestamp: getWorkflowTimeoutDeadline(msBuilder.GetExecutionInfo(), e.shard.GetTimeSource().Now().Add(timeoutDuration)),
 	}}
 
 	// Only schedule the backoff timer task if not child WF and there's first decision task backoff
 	if cronBackoffSeconds != 0 && startRequest.ParentExecutionInfo == nil {
 		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
 			VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(cronBackoffDuration),
-			TimeoutType:         persistence.WorkflowBackoffTimeoutTypeCron,
+			TimeoutType:         backoffTimeoutType,
 		})
 	}
 
@@ -468,11 +500,16 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx ctx.Context, startRequest
 			if retError != nil {
 				return
 			}
-			retError = context.createWorkflowExecution(
-				msBuilder, e.currentClusterName, createReplicationTask, time.Now(),
-				transferTasks, timerTasks,
-				createMode, prevRunID, prevLastWriteVersion,
-			)
+			if t.State != persistence.WorkflowStateCompleted {
+				// the reuse policy is TerminateIfRunning, the running execution is terminated together with the start
+				retError = e.terminateRunningAndCreateWorkflowExecution(ctx, domainEntry, prevRunID, msBuilder, transferTasks, timerTasks)
+			} else {
+				retError = context.createWorkflowExecution(
+					msBuilder, e.currentClusterName, createReplicationTask, time.Now(),
+					transferTasks, timerTasks,
+					createMode, prevRunID, prevLastWriteVersion,
+				)
+			}
 		}
 	}
 
@@ -637,6 +674,47 @@ func (e *historyEngineImpl) DescribeMutableState(ctx ctx.Context,
 	return
 }
 
+// VerifyWorkflowExecutionHistory replays the history of a workflow execution into a fresh mutable state
+// and returns the mismatches against the mutable state in the database.
+func (e *historyEngineImpl) VerifyWorkflowExecutionHistory(ctx ctx.Context,
+	request *h.VerifyWorkflowExecutionHistoryRequest) (retResp *h.VerifyWorkflowExecutionHistoryResponse, retError error) {
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	// the lock of the execution is only held to read a consistent snapshot of the mutable state, the history
+	// up to the next event ID of the snapshot does not change, so it is replayed without blocking updates
+	context, release, err := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	// always verify the mutable state in the database, the one in cache could be ahead of it
+	dbContext := newWorkflowExecutionContext(domainID, *context.getExecution(), e.shard, e.executionManager, e.logger)
+	msBuilder, err := dbContext.loadWorkflowExecution()
+	if err != nil {
+		release(err)
+		return nil, err
+	}
+	snapshot := msBuilder.CopyToPersistence()
+	release(nil)
+
+	verifier := newHistoryVerifier(e.shard, e.historyMgr, e.historyV2Mgr, e.logger)
+	mismatches, err := verifier.verify(domainID, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &h.VerifyWorkflowExecutionHistoryResponse{
+		Mismatches: mismatches,
+	}, nil
+}
+
 func (e *historyEngineImpl) toMutableStateJSON(msb mutableState) (*string, error) {
 	ms := msb.CopyToPersistence()
 
@@ -697,6 +775,11 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx ctx.Context,
 		return nil, err1
 	}
 	executionInfo := msBuilder.GetExecutionInfo()
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+	retentionDays := getWorkflowRetentionDays(e.config, executionInfo, domainEntry.GetRetentionDays(executionInfo.WorkflowID))
 
 	result := &workflow.DescribeWorkflowExecutionResponse{
 		ExecutionConfiguration: &workflow.WorkflowExecutionConfiguration{
@@ -704,6 +787,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx ctx.Context,
 			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(executionInfo.WorkflowTimeout),
 			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(executionInfo.DecisionTimeoutValue),
 			ChildPolicy:                         common.ChildPolicyPtr(workflow.ChildPolicyTerminate),
+			RetentionPeriodInDays:               common.Int32Ptr(retentionDays),
 		},
 		WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{
 			Execution: &workflow.WorkflowExecution{
@@ -723,6 +807,10 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx ctx.Context,
 		}
 		result.WorkflowExecutionInfo.ParentDomainId = common.StringPtr(executionInfo.ParentDomainID)
 	}
+	if isWorkflowExpirationSet(executionInfo) {
+		result.ExecutionConfiguration.WorkflowExecutionTimeoutSeconds = common.Int32Ptr(executionInfo.WorkflowExecutionTimeout)
+		result.WorkflowExecutionInfo.WorkflowExecutionExpirationTime = common.Int64Ptr(executionInfo.WorkflowExpirationTime.UnixNano())
+	}
 	if executionInfo.State == persistence.WorkflowStateCompleted {
 		// for closed workflow
 		closeStatus := getWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
@@ -965,22 +1053,33 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(ctx ctx.Context,
 }
 
 type decisionBlobSizeChecker struct {
-	sizeLimitWarn  int
-	sizeLimitError int
-	domainID       string
-	workflowID     string
-	runID          string
-	metricsClient  metrics.Client
-	logger         log.Logger
-	msBuilder      mutableState
-	completedID    int64
+	sizeLimitWarn         int
+	sizeLimitError        int
+	payloadSizeLimitError int
+	domainID              string
+	workflowID            string
+	runID                 string
+	metricsClient         metrics.Client
+	logger                log.Logger
+	msBuilder             mutableState
+	completedID           int64
 }
 
 func (c *decisionBlobSizeChecker) failWorkflowIfBlobSizeExceedsLimit(blob []byte, message string) (bool, error) {
+	return c.failWorkflowIfSizeExceedsLimit(blob, c.sizeLimitError, message)
+}
+
+// failWorkflowIfPayloadSizeExceedsLimit checks payloads which can be offloaded to the blobstore,
+// they are bound by the offload size limit instead of the blob size limit when offloading is enabled
+func (c *decisionBlobSizeChecker) failWorkflowIfPayloadSizeExceedsLimit(blob []byte, message string) (bool, error) {
+	return c.failWorkflowIfSizeExceedsLimit(blob, c.payloadSizeLimitError, message)
+}
+
+func (c *decisionBlobSizeChecker) failWorkflowIfSizeExceedsLimit(blob []byte, sizeLimitError int, message string) (bool, error) {
 	err := common.CheckEventBlobSizeLimit(
 		len(blob),
 		c.sizeLimitWarn,
-		c.sizeLimitError,
+		sizeLimitError,
 		c.domainID,
 		c.workflowID,
 		c.runID,
@@ -1043,16 +1142,21 @@ func (e *historyEngineImpl) RespondDecisionTaskCompleted(
 
 	sizeLimitError := e.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := e.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
+	payloadSizeLimitError := sizeLimitError
+	if e.isPayloadOffloadEnabled(domainEntry) {
+		payloadSizeLimitError = e.config.PayloadOffloadSizeLimitError(domainEntry.GetInfo().Name)
+	}
 	maxIDLengthLimit := e.config.MaxIDLengthLimit()
 
 	sizeChecker := &decisionBlobSizeChecker{
-		sizeLimitWarn:  sizeLimitWarn,
-		sizeLimitError: sizeLimitError,
-		domainID:       domainID,
-		workflowID:     token.WorkflowID,
-		runID:          token.RunID,
-		metricsClient:  e.metricsClient,
-		logger:         e.throttledLogger,
+		sizeLimitWarn:         sizeLimitWarn,
+		sizeLimitError:        sizeLimitError,
+		payloadSizeLimitError: payloadSizeLimitError,
+		domainID:              domainID,
+		workflowID:            token.WorkflowID,
+		runID:                 token.RunID,
+		metricsClient:         e.metricsClient,
+		logger:                e.throttledLogger,
 	}
 
 Update_History_Loop:
@@ -1085,6 +1189,25 @@ Update_History_Loop:
 			return nil, &workflow.EntityNotExistsError{Message: "Decision task not found."}
 		}
 
+		// a heartbeat extends the decision without any history events, unless there is something new to decide on
+		_, isBadBinary := domainEntry.GetConfig().BadBinaries.Binaries[request.GetBinaryChecksum()]
+		decisionHeartbeatLimitExceeded := false
+		if isDecisionHeartbeat(request, di) && !isBadBinary && !msBuilder.HasBufferedEvents() &&
+			!e.updateRegistry.hasPending(newWorkflowUpdateKey(domainID, executionInfo.WorkflowID, executionInfo.RunID), nil) {
+			if di.HeartbeatCount < getMaxDecisionHeartbeats(executionInfo.WorkflowTimeout, di.DecisionTimeout) {
+				response, err := e.heartbeatDecisionTask(context, msBuilder, domainID, scheduleID, request)
+				if err == ErrConflict {
+					e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
+						metrics.ConcurrencyUpdateFailureCounter)
+					continue Update_History_Loop
+				}
+				return response, err
+			}
+			e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
+				metrics.DecisionHeartbeatLimitExceededCounter)
+			decisionHeartbeatLimitExceeded = true
+		}
+
 		startedID := di.StartedID
 		maxResetPoints := e.config.MaxAutoResetPoints(domainEntry.GetInfo().Name)
 		if msBuilder.GetExecutionInfo().AutoResetPoints != nil && maxResetPoints == len(msBuilder.GetExecutionInfo().AutoResetPoints.Points) {
@@ -1130,11 +1253,22 @@ Update_History_Loop:
 		sizeChecker.completedID = completedID
 		sizeChecker.msBuilder = msBuilder
 
+		// updates accepted by the worker are recorded ahead of the decisions made while handling them
+		updateKey := newWorkflowUpdateKey(domainID, executionInfo.WorkflowID, executionInfo.RunID)
+		if err := e.addWorkflowUpdateAcceptedEvents(msBuilder, updateKey, completedID, request); err != nil {
+			return nil, err
+		}
+
 		binChecksum := request.GetBinaryChecksum()
-		if _, ok := domainEntry.GetConfig().BadBinaries.Binaries[binChecksum]; ok {
+		if isBadBinary {
 			failDecision = true
 			failCause = workflow.DecisionTaskFailedCauseBadBinary
 			failMessage = fmt.Sprintf("binary %v is already marked as bad deployment", binChecksum)
+		} else if decisionHeartbeatLimitExceeded {
+			failDecision = true
+			failCause = workflow.DecisionTaskFailedCauseForceCloseDecision
+			failMessage = fmt.Sprintf("decision heartbeated %v times, which is the most allowed within the workflow run timeout",
+				di.HeartbeatCount)
 		} else {
 		Process_Decision_Loop:
 			for _, d := range request.Decisions {
@@ -1161,7 +1295,7 @@ Update_History_Loop:
 						break Process_Decision_Loop
 					}
 
-					failWorkflow, err := sizeChecker.failWorkflowIfBlobSizeExceedsLimit(attributes.Input, "ScheduleActivityTaskDecisionAttributes.Input exceeds size limit.")
+					failWorkflow, err := sizeChecker.failWorkflowIfPayloadSizeExceedsLimit(attributes.Input, "ScheduleActivityTaskDecisionAttributes.Input exceeds size limit.")
 					if err != nil {
 						return nil, err
 					}
@@ -1171,9 +1305,10 @@ Update_History_Loop:
 
 					scheduleEvent, _ := msBuilder.AddActivityTaskScheduledEvent(completedID, attributes)
 					transferTasks = append(transferTasks, &persistence.ActivityTask{
-						DomainID:   targetDomainID,
-						TaskList:   *attributes.TaskList.Name,
-						ScheduleID: *scheduleEvent.EventId,
+						DomainID:     targetDomainID,
+						TaskList:     *attributes.TaskList.Name,
+						ScheduleID:   *scheduleEvent.EventId,
+						TraceContext: tracing.Inject(ctx),
 					})
 					hasDecisionScheduleActivityTask = true
 
@@ -1200,7 +1335,7 @@ Update_History_Loop:
 						failMessage = err.Error()
 						break Process_Decision_Loop
 					}
-					failWorkflow, err := sizeChecker.failWorkflowIfBlobSizeExceedsLimit(attributes.Result, "CompleteWorkflowExecutionDecisionAttributes.Result exceeds size limit.")
+					failWorkflow, err := sizeChecker.failWorkflowIfPayloadSizeExceedsLimit(attributes.Result, "CompleteWorkflowExecutionDecisionAttributes.Result exceeds size limit.")
 					if err != nil {
 						return nil, err
 					}
@@ -1223,17 +1358,22 @@ Update_History_Loop:
 						}
 
 						startAttributes := startEvent.WorkflowExecutionStartedEventAttributes
+						startInput, err := resolvePayload(e.shard, startAttributes.Input, startAttributes.InputReference)
+						if err != nil {
+							return nil, err
+						}
 						continueAsNewAttributes := &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
 							WorkflowType:                        startAttributes.WorkflowType,
 							TaskList:                            startAttributes.TaskList,
 							RetryPolicy:                         startAttributes.RetryPolicy,
-							Input:                               startAttributes.Input,
+							Input:                               startInput,
 							ExecutionStartToCloseTimeoutSeconds: startAttributes.ExecutionStartToCloseTimeoutSeconds,
 							TaskStartToCloseTimeoutSeconds:      startAttributes.TaskStartToCloseTimeoutSeconds,
 							BackoffStartIntervalInSeconds:       common.Int32Ptr(int32(cronBackoff.Seconds())),
 							Initiator:                           workflow.ContinueAsNewInitiatorCronSchedule.Ptr(),
 							LastCompletionResult:                attributes.Result,
 							CronSchedule:                        common.StringPtr(msBuilder.GetExecutionInfo().CronSchedule),
+							RetentionPeriodInDays:               startAttributes.RetentionPeriodInDays,
 						}
 
 						if _, continueAsNewBuilder, err = msBuilder.AddContinueAsNewEvent(completedID, completedID, domainEntry,
@@ -1296,11 +1436,15 @@ Update_History_Loop:
 						}
 
 						startAttributes := startEvent.WorkflowExecutionStartedEventAttributes
+						startInput, err := resolvePayload(e.shard, startAttributes.Input, startAttributes.InputReference)
+						if err != nil {
+							return nil, err
+						}
 						continueAsNewAttributes := &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
 							WorkflowType:                        startAttributes.WorkflowType,
 							TaskList:                            startAttributes.TaskList,
 							RetryPolicy:                         startAttributes.RetryPolicy,
-							Input:                               startAttributes.Input,
+							Input:                               startInput,
 							ExecutionStartToCloseTimeoutSeconds: startAttributes.ExecutionStartToCloseTimeoutSeconds,
 							TaskStartToCloseTimeoutSeconds:      startAttributes.TaskStartToCloseTimeoutSeconds,
 							BackoffStartIntervalInSeconds:       common.Int32Ptr(int32(backoffInterval.Seconds())),
@@ -1309,6 +1453,7 @@ Update_History_Loop:
 							FailureDetails:                      failedAttributes.Details,
 							LastCompletionResult:                startAttributes.LastCompletionResult,
 							CronSchedule:                        common.StringPtr(msBuilder.GetExecutionInfo().CronSchedule),
+							RetentionPeriodInDays:               startAttributes.RetentionPeriodInDays,
 						}
 
 						if _, continueAsNewBuilder, err = msBuilder.AddContinueAsNewEvent(completedID, completedID, domainEntry,
@@ -1542,7 +1687,7 @@ Update_History_Loop:
 						failMessage = err.Error()
 						break Process_Decision_Loop
 					}
-					failWorkflow, err := sizeChecker.failWorkflowIfBlobSizeExceedsLimit(attributes.Input, "ContinueAsNewWorkflowExecutionDecisionAttributes.Input exceeds size limit.")
+					failWorkflow, err := sizeChecker.failWorkflowIfPayloadSizeExceedsLimit(attributes.Input, "ContinueAsNewWorkflowExecutionDecisionAttributes.Input exceeds size limit.")
 					if err != nil {
 						return nil, err
 					}
@@ -1642,9 +1787,15 @@ Update_History_Loop:
 			}
 		}
 
+		// updates which came in while the worker was making this decision need another decision task, unless the
+		// worker already left them unanswered too often
+		e.updateRegistry.failUnanswered(updateKey, request.UpdateResults,
+			e.config.WorkflowUpdateMaxDecisionAttempts(domainEntry.GetInfo().Name), ErrWorkflowUpdateNotHandled)
+		hasUnansweredUpdates := e.updateRegistry.hasPending(updateKey, request.UpdateResults)
+
 		// Schedule another decision task if new events came in during this decision or if request forced to
 		createNewDecisionTask := !isComplete && (hasUnhandledEvents ||
-			request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
+			request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled || hasUnansweredUpdates)
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
 			di := msBuilder.AddDecisionTaskScheduledEvent()
@@ -1655,9 +1806,10 @@ Update_History_Loop:
 			// skip transfer task for decision if request asking to return new decision task
 			if !request.GetReturnNewDecisionTask() {
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
-					DomainID:   domainID,
-					TaskList:   di.TaskList,
-					ScheduleID: di.ScheduleID,
+					DomainID:     domainID,
+					TaskList:     di.TaskList,
+					ScheduleID:   di.ScheduleID,
+					TraceContext: tracing.Inject(ctx),
 				})
 				if msBuilder.IsStickyTaskListEnabled() {
 					tBuilder := e.getTimerBuilder(context.getExecution())
@@ -1679,8 +1831,7 @@ Update_History_Loop:
 
 		if isComplete {
 			tranT, timerT, err := e.getWorkflowHistoryCleanupTasks(
-				domainID,
-				workflowExecution.GetWorkflowId(),
+				executionInfo,
 				tBuilder)
 			if err != nil {
 				return nil, err
@@ -1717,6 +1868,18 @@ Update_History_Loop:
 			return nil, updateErr
 		}
 
+		if !failDecision {
+			for updateID, result := range request.UpdateResults {
+				e.updateRegistry.complete(updateKey, updateID, &workflowUpdateOutcome{
+					runID:  updateKey.runID,
+					result: result,
+				})
+			}
+		}
+		if isComplete {
+			e.updateRegistry.completeAll(updateKey, ErrWorkflowCompleted)
+		}
+
 		// add continueAsNewTimerTask
 		timerTasks = append(timerTasks, continueAsNewTimerTasks...)
 		// Inform timer about the new ones.
@@ -1736,6 +1899,72 @@ Update_History_Loop:
 	return nil, ErrMaxAttemptsExceeded
 }
 
+// heartbeatDecisionTask restarts the in flight decision for the worker holding it and hands it back right away
+func (e *historyEngineImpl) heartbeatDecisionTask(context workflowExecutionContext, msBuilder mutableState, domainID string,
+	scheduleID int64, request *workflow.RespondDecisionTaskCompletedRequest) (*h.RespondDecisionTaskCompletedResponse, error) {
+	di := msBuilder.HeartbeatDecisionTask(scheduleID)
+	if di == nil {
+		return nil, &workflow.InternalServiceError{Message: "Unable to heartbeat decision task."}
+	}
+	e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.DecisionHeartbeatCounter)
+
+	// the earlier timeout timers of this decision are ignored since they fire before the heartbeat deadline
+	tBuilder := e.getTimerBuilder(context.getExecution())
+	timerTasks := []persistence.Task{tBuilder.AddStartToCloseDecisionTimoutTask(di.ScheduleID, di.Attempt, di.DecisionTimeout)}
+
+	transactionID, err := e.shard.GetNextTransferTaskID()
+	if err != nil {
+		return nil, err
+	}
+	if err := context.updateWorkflowExecution(nil, timerTasks, transactionID); err != nil {
+		return nil, err
+	}
+	e.timerProcessor.NotifyNewTimers(e.currentClusterName, e.shard.GetCurrentTime(e.currentClusterName), timerTasks)
+
+	response := &h.RespondDecisionTaskCompletedResponse{
+		StartedResponse: e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, di, request.GetIdentity()),
+	}
+	// sticky is always enabled when worker request for new decision task from RespondDecisionTaskCompleted
+	response.StartedResponse.StickyExecutionEnabled = common.BoolPtr(true)
+	return response, nil
+}
+
+func (e *historyEngineImpl) addWorkflowUpdateAcceptedEvents(msBuilder mutableState, updateKey workflowUpdateKey,
+	decisionCompletedEventID int64, request *workflow.RespondDecisionTaskCompletedRequest) error {
+	updateIDs := make([]string, 0, len(request.UpdateResults))
+	for updateID, result := range request.UpdateResults {
+		if result == nil {
+			return &workflow.BadRequestError{Message: fmt.Sprintf("Result of update %v is not set.", updateID)}
+		}
+		if result.GetResultType() == workflow.WorkflowUpdateResultTypeAccepted {
+			updateIDs = append(updateIDs, updateID)
+		}
+	}
+	// keep the events in a stable order
+	sort.Strings(updateIDs)
+
+	for _, updateID := range updateIDs {
+		// the update may have been handed out again after it was accepted, e.g. after a shard movement
+		acceptedEvent, err := msBuilder.GetWorkflowUpdateAcceptedEvent(updateID)
+		if err != nil {
+			return err
+		}
+		if acceptedEvent != nil {
+			continue
+		}
+		update := e.updateRegistry.get(updateKey, updateID)
+		if update == nil {
+			// the update is no longer known to this host, the worker applied it so it is recorded anyway
+			update = &workflow.WorkflowUpdate{}
+		}
+		if msBuilder.AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID, updateID, update,
+			request.UpdateResults[updateID].Result, request.GetIdentity()) == nil {
+			return &workflow.InternalServiceError{Message: "Unable to add WorkflowExecutionUpdateAccepted event to history."}
+		}
+	}
+	return nil
+}
+
 func (e *historyEngineImpl) RespondDecisionTaskFailed(ctx ctx.Context, req *h.RespondDecisionTaskFailedRequest) error {
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
@@ -2100,8 +2329,8 @@ func (e *historyEngineImpl) SignalWorkflowExecution(ctx ctx.Context, signalReque
 	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 		executionInfo := msBuilder.GetExecutionInfo()
 		createDecisionTask := true
-		// Do not create decision task when the workflow is cron and the cron has not been started yet
-		if msBuilder.GetExecutionInfo().CronSchedule != "" && !msBuilder.HasProcessedOrPendingDecisionTask() {
+		// Do not create decision task when the workflow is cron or delayed and it has not been started yet
+		if isFirstDecisionTaskBackoffPending(msBuilder) {
 			createDecisionTask = false
 		}
 		postActions := &updateWorkflowAction{
@@ -2115,14 +2344,6 @@ func (e *historyEngineImpl) SignalWorkflowExecution(ctx ctx.Context, signalReque
 		}
 
 		maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
-		if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
-			e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
-				tag.WorkflowID(execution.GetWorkflowId()),
-				tag.WorkflowRunID(execution.GetRunId()),
-				tag.WorkflowDomainID(domainID))
-			return nil, ErrSignalsLimitExceeded
-		}
-
 		if childWorkflowOnly {
 			parentWorkflowID := executionInfo.ParentWorkflowID
 			parentRunID := executionInfo.ParentRunID
@@ -2132,15 +2353,73 @@ func (e *historyEngineImpl) SignalWorkflowExecution(ctx ctx.Context, signalReque
 			}
 		}
 
-		// deduplicate by request id for signal decision
-		if requestID := request.GetRequestId(); requestID != "" {
-			if msBuilder.IsSignalRequested(requestID) {
+		// signals to be delivered in the future are kept in the mutable state of this run, a timer task
+		// signals the target run once they are due, which is the current run unless the caller pinned a run
+		if deliverAt := request.GetDeliverAtTimestamp(); deliverAt > e.shard.GetTimeSource().Now().UnixNano() {
+			postActions.createDecision = false
+			requestID := request.GetRequestId()
+			if requestID == "" {
+				requestID = uuid.New()
+			}
+			if _, ok := msBuilder.GetDelayedSignal(requestID); ok || msBuilder.HasAppliedSignalRequestID(requestID) {
 				return postActions, nil
 			}
+			pendingSignals := 0
+			now := e.shard.GetTimeSource().Now().UnixNano()
+			for _, signal := range executionInfo.DelayedSignals.GetSignals() {
+				// entries which are due are being delivered, or were forwarded after a failover
+				if signal.GetDeliverAtTimestamp() > now {
+					pendingSignals++
+				}
+			}
+			if maxAllowedSignals > 0 && int(executionInfo.SignalCount)+pendingSignals >= maxAllowedSignals {
+				e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
+					tag.WorkflowID(execution.GetWorkflowId()),
+					tag.WorkflowRunID(execution.GetRunId()),
+					tag.WorkflowDomainID(domainID))
+				return nil, ErrSignalsLimitExceeded
+			}
+			// the input is kept in the mutable state until the signal is due, so it is never offloaded
+			if len(request.Input) > e.config.BlobSizeLimitError(domainEntry.GetInfo().Name) {
+				return nil, ErrDelayedSignalInputTooLarge
+			}
+
+			msBuilder.AddDelayedSignal(&workflow.DelayedSignalInfo{
+				RequestId:          common.StringPtr(requestID),
+				SignalName:         common.StringPtr(request.GetSignalName()),
+				Input:              request.Input,
+				Identity:           common.StringPtr(request.GetIdentity()),
+				RunId:              common.StringPtr(execution.GetRunId()),
+				DeliverAtTimestamp: common.Int64Ptr(deliverAt),
+				Version:            common.Int64Ptr(msBuilder.GetCurrentVersion()),
+			})
+			postActions.timerTasks = []persistence.Task{&persistence.DelayedSignalTask{
+				VisibilityTimestamp: time.Unix(0, deliverAt),
+				RequestID:           requestID,
+			}}
+			return postActions, nil
+		}
+
+		// deduplicate by request id, both for signal decisions and for client retries of recently applied signals,
+		// before the limit is checked so a retry of a signal that reached the limit is still a no-op
+		requestID := request.GetRequestId()
+		if requestID != "" && (msBuilder.IsSignalRequested(requestID) || msBuilder.HasAppliedSignalRequestID(requestID)) {
+			return postActions, nil
+		}
+
+		if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
+			e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.GetRunId()),
+				tag.WorkflowDomainID(domainID))
+			return nil, ErrSignalsLimitExceeded
+		}
+
+		if requestID != "" {
 			msBuilder.AddSignalRequested(requestID)
 		}
 
-		if msBuilder.AddWorkflowExecutionSignaled(request.GetSignalName(), request.GetInput(), request.GetIdentity()) == nil {
+		if msBuilder.AddWorkflowExecutionSignaled(request.GetSignalName(), request.GetInput(), request.GetIdentity(), request.GetRequestId()) == nil {
 			return nil, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
 		}
 
@@ -2148,6 +2427,105 @@ func (e *historyEngineImpl) SignalWorkflowExecution(ctx ctx.Context, signalReque
 	})
 }
 
+// UpdateWorkflowExecution hands an update to the worker on the next decision task and blocks until
+// the worker accepts or rejects it. The request ID is used as the update ID to deduplicate retries.
+func (e *historyEngineImpl) UpdateWorkflowExecution(ctx ctx.Context, updateRequest *h.UpdateWorkflowExecutionRequest) (
+	*workflow.UpdateWorkflowExecutionResponse, error) {
+
+	domainEntry, err := e.getActiveDomainEntry(updateRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := updateRequest.UpdateRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+	updateID := request.GetRequestId()
+	if updateID == "" {
+		return nil, &workflow.BadRequestError{Message: "RequestId is not set on request."}
+	}
+
+	if outcome, ok := e.updateRegistry.getCompleted(domainID, execution.GetWorkflowId(), updateID); ok {
+		return newUpdateWorkflowExecutionResponse(outcome), nil
+	}
+
+	update := &workflow.WorkflowUpdate{
+		UpdateName: request.UpdateName,
+		Input:      request.Input,
+		Identity:   request.Identity,
+	}
+	var key workflowUpdateKey
+	var entry *pendingWorkflowUpdate
+	var accepted *workflowUpdateOutcome
+	err = e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+		executionInfo := msBuilder.GetExecutionInfo()
+		// the completed outcome may not be cached on this host, an update accepted by the run is answered from its history
+		acceptedEvent, err := msBuilder.GetWorkflowUpdateAcceptedEvent(updateID)
+		if err != nil {
+			return nil, err
+		}
+		if acceptedEvent != nil {
+			accepted = &workflowUpdateOutcome{
+				runID: executionInfo.RunID,
+				result: &workflow.WorkflowUpdateResult{
+					ResultType: workflow.WorkflowUpdateResultTypeAccepted.Ptr(),
+					Result:     acceptedEvent.WorkflowExecutionUpdateAcceptedEventAttributes.Result,
+				},
+			}
+			return nil, errWorkflowUpdateAlreadyAccepted
+		}
+
+		if !msBuilder.IsWorkflowExecutionRunning() {
+			return nil, ErrWorkflowCompleted
+		}
+
+		// the action is retried on conflicts, register the update only once
+		if entry == nil {
+			key = newWorkflowUpdateKey(domainID, executionInfo.WorkflowID, executionInfo.RunID)
+			entry = e.updateRegistry.register(key, updateID, update)
+		}
+
+		// a pending decision picks up the update when it is started, or forces a new decision when it is
+		// completed, so a decision only needs to be scheduled when there is none
+		return &updateWorkflowAction{
+			createDecision: !isFirstDecisionTaskBackoffPending(msBuilder),
+		}, nil
+	})
+	if err == errWorkflowUpdateAlreadyAccepted {
+		return newUpdateWorkflowExecutionResponse(accepted), nil
+	}
+	if err != nil {
+		if entry != nil {
+			e.updateRegistry.unregister(key, updateID, entry)
+		}
+		return nil, err
+	}
+
+	timeout := time.Duration(request.GetTimeoutSeconds()) * time.Second
+	if timeout == 0 {
+		timeout = e.config.WorkflowUpdateDefaultTimeout(domainEntry.GetInfo().Name)
+	}
+	outcome, err := entry.wait(ctx, timeout)
+	if err != nil {
+		e.updateRegistry.unregister(key, updateID, entry)
+		return nil, err
+	}
+	if outcome.err != nil {
+		return nil, outcome.err
+	}
+	return newUpdateWorkflowExecutionResponse(outcome), nil
+}
+
+func newUpdateWorkflowExecutionResponse(outcome *workflowUpdateOutcome) *workflow.UpdateWorkflowExecutionResponse {
+	return &workflow.UpdateWorkflowExecutionResponse{
+		RunId:        common.StringPtr(outcome.runID),
+		UpdateResult: outcome.result,
+	}
+}
+
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx ctx.Context, signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (
 	retResp *workflow.StartWorkflowExecutionResponse, retError error) {
 
@@ -2166,6 +2544,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx ctx.Context, si
 	attempt := 0
 
 	context, release, err0 := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, execution)
+	prevContext := context
 
 	if err0 == nil {
 		defer func() { release(retError) }()
@@ -2184,6 +2563,16 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx ctx.Context, si
 				prevMutableState = msBuilder
 				break
 			}
+			// workflow running and the reuse policy terminates it, will restart workflow then signal
+			if sRequest.GetWorkflowIdReusePolicy() == workflow.WorkflowIdReusePolicyTerminateIfRunning {
+				prevMutableState = msBuilder
+				break
+			}
+
+			// a retry of a signal that was already applied to the running workflow is a no-op
+			if msBuilder.HasAppliedSignalRequestID(sRequest.GetRequestId()) {
+				return &workflow.StartWorkflowExecutionResponse{RunId: context.getExecution().RunId}, nil
+			}
 
 			executionInfo := msBuilder.GetExecutionInfo()
 			maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
@@ -2195,22 +2584,23 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx ctx.Context, si
 				return nil, ErrSignalsLimitExceeded
 			}
 
-			if msBuilder.AddWorkflowExecutionSignaled(sRequest.GetSignalName(), sRequest.GetSignalInput(), sRequest.GetIdentity()) == nil {
+			if msBuilder.AddWorkflowExecutionSignaled(sRequest.GetSignalName(), sRequest.GetSignalInput(), sRequest.GetIdentity(), sRequest.GetRequestId()) == nil {
 				return nil, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
 
 			var transferTasks []persistence.Task
 			var timerTasks []persistence.Task
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, unless the start of the workflow is delayed
+			if !msBuilder.HasPendingDecisionTask() && !isDelayedStartPending(msBuilder) {
 				di := msBuilder.AddDecisionTaskScheduledEvent()
 				if di == nil {
 					return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
 				}
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
-					DomainID:   domainID,
-					TaskList:   di.TaskList,
-					ScheduleID: di.ScheduleID,
+					DomainID:     domainID,
+					TaskList:     di.TaskList,
+					ScheduleID:   di.ScheduleID,
+					TraceContext: tracing.Inject(ctx),
 				})
 				if msBuilder.IsStickyTaskListEnabled() {
 					tBuilder := e.getTimerBuilder(context.getExecution())
@@ -2291,6 +2681,22 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx ctx.Context, si
 		}
 	}
 
+	// first decision task, which is only backed off if the start is delayed or queued by the concurrency limit
+	var backoffSeconds int32
+	backoffTimeoutType := getFirstDecisionBackoffTimeoutType(request)
+	if request.GetDelayStartSeconds() > 0 {
+		backoffSeconds = startRequest.GetFirstDecisionTaskBackoffSeconds()
+	} else {
+		queued, err := e.checkWorkflowConcurrencyLimit(domainEntry, startRequest, metrics.HistorySignalWithStartWorkflowExecutionScope)
+		if err != nil {
+			return nil, err
+		}
+		if queued {
+			backoffSeconds = startRequest.GetFirstDecisionTaskBackoffSeconds()
+			backoffTimeoutType = persistence.WorkflowBackoffTimeoutTypeConcurrencyLimit
+		}
+	}
+
 	// Generate first decision task event.
 	taskList := request.TaskList.GetName()
 	// Add WF start event
@@ -2299,21 +2705,27 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx ctx.Context, si
 		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
 	// Add signal event
-	if msBuilder.AddWorkflowExecutionSignaled(sRequest.GetSignalName(), sRequest.GetSignalInput(), sRequest.GetIdentity()) == nil {
+	if msBuilder.AddWorkflowExecutionSignaled(sRequest.GetSignalName(), sRequest.GetSignalInput(), sRequest.GetIdentity(), sRequest.GetRequestId()) == nil {
 		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
 	}
-	// first decision task
 	var transferTasks []persistence.Task
-	transferTasks, _, retError = e.generateFirstDecisionTask(domainID, msBuilder, startRequest.ParentExecutionInfo, taskList, 0)
+	transferTasks, _, retError = e.generateFirstDecisionTask(ctx, domainID, msBuilder, startRequest.ParentExecutionInfo, taskList, backoffSeconds)
 	if retError != nil {
 		return
 	}
 
 	// first timer task
-	duration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds) * time.Second
+	backoffDuration := time.Duration(backoffSeconds) * time.Second
+	duration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds)*time.Second + backoffDuration
 	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
-		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
+		VisibilityTimestamp: getWorkflowTimeoutDeadline(msBuilder.GetExecutionInfo(), e.shard.GetTimeSource().Now().Add(duration)),
 	}}
+	if backoffSeconds != 0 {
+		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
+			VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(backoffDuration),
+			TimeoutType:         backoffTimeoutType,
+		})
+	}
 
 	context = newWorkflowExecutionContext(domainID, execution, e.shard, e.executionManager, e.logger)
 	createReplicationTask := domainEntry.CanReplicateEvent()
@@ -2338,11 +2750,16 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx ctx.Context, si
 		prevRunID = prevMutableState.GetExecutionInfo().RunID
 		prevLastWriteVersion = prevMutableState.GetLastWriteVersion()
 	}
-	retError = context.createWorkflowExecution(
-		msBuilder, e.currentClusterName, createReplicationTask, time.Now(),
-		transferTasks, timerTasks,
-		createMode, prevRunID, prevLastWriteVersion,
-	)
+	if prevMutableState != nil && prevMutableState.IsWorkflowExecutionRunning() {
+		// the reuse policy is TerminateIfRunning, the context of the running execution is already locked
+		retError = e.terminateAndCreateWorkflowExecution(domainEntry, prevContext, prevMutableState, msBuilder, transferTasks, timerTasks)
+	} else {
+		retError = context.createWorkflowExecution(
+			msBuilder, e.currentClusterName, createReplicationTask, time.Now(),
+			transferTasks, timerTasks,
+			createMode, prevRunID, prevLastWriteVersion,
+		)
+	}
 
 	t, ok := retError.(*persistence.WorkflowExecutionAlreadyStartedError)
 	if ok {
@@ -2472,6 +2889,14 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(ctx ctx.Context, compl
 		RunId:      completionRequest.WorkflowExecution.RunId,
 	}
 
+	// the child result is copied into the parent history, it must not reference a blob owned by the child
+	completionEvents := []*workflow.HistoryEvent{completionRequest.CompletionEvent}
+	if payload.HasReferences(completionEvents) {
+		if err := e.shard.GetPayloadStore().ResolveEvents(ctx, completionEvents); err != nil {
+			return err
+		}
+	}
+
 	return e.updateWorkflowExecution(ctx, domainID, execution, false, true,
 		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.IsWorkflowExecutionRunning() {
@@ -2536,6 +2961,14 @@ func (e *historyEngineImpl) SyncActivity(ctx ctx.Context, request *h.SyncActivit
 	return e.replicator.SyncActivity(ctx, request)
 }
 
+func (e *historyEngineImpl) GetRunningWorkflowCounts(domainID string) map[string]int64 {
+	return e.shard.GetRunningWorkflowCounts(domainID)
+}
+
+func (e *historyEngineImpl) SetRunningWorkflowCount(domainID string, workflowType string, count int64) {
+	e.shard.SetRunningWorkflowCount(domainID, workflowType, count)
+}
+
 func (e *historyEngineImpl) ResetWorkflowExecution(ctx ctx.Context,
 	resetRequest *h.ResetWorkflowExecutionRequest) (response *workflow.ResetWorkflowExecutionResponse, retError error) {
 
@@ -2672,8 +3105,7 @@ Update_History_Loop:
 		transferTasks, timerTasks := postActions.transferTasks, postActions.timerTasks
 		if postActions.deleteWorkflow {
 			tranT, timerT, err := e.getWorkflowHistoryCleanupTasks(
-				domainID,
-				execution.GetWorkflowId(),
+				msBuilder.GetExecutionInfo(),
 				tBuilder)
 			if err != nil {
 				return err
@@ -2690,9 +3122,10 @@ Update_History_Loop:
 					return &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
 				}
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
-					DomainID:   domainID,
-					TaskList:   di.TaskList,
-					ScheduleID: di.ScheduleID,
+					DomainID:     domainID,
+					TaskList:     di.TaskList,
+					ScheduleID:   di.ScheduleID,
+					TraceContext: tracing.Inject(ctx),
 				})
 				if msBuilder.IsStickyTaskListEnabled() {
 					tBuilder := e.getTimerBuilder(context.getExecution())
@@ -2742,31 +3175,76 @@ func (e *historyEngineImpl) updateWorkflowExecution(ctx ctx.Context, domainID st
 }
 
 func (e *historyEngineImpl) getWorkflowHistoryCleanupTasks(
-	domainID, workflowID string,
+	executionInfo *persistence.WorkflowExecutionInfo,
 	tBuilder *timerBuilder,
 ) (persistence.Task, persistence.Task, error) {
-	return getWorkflowHistoryCleanupTasksFromShard(e.shard, domainID, workflowID, tBuilder)
+	return getWorkflowHistoryCleanupTasksFromShard(e.shard, executionInfo, tBuilder)
 }
 
 func getWorkflowHistoryCleanupTasksFromShard(
 	shard ShardContext,
-	domainID, workflowID string,
+	executionInfo *persistence.WorkflowExecutionInfo,
 	tBuilder *timerBuilder,
 ) (persistence.Task, persistence.Task, error) {
 
 	var retentionInDays int32
-	domainEntry, err := shard.GetDomainCache().GetDomainByID(domainID)
+	domainEntry, err := shard.GetDomainCache().GetDomainByID(executionInfo.DomainID)
 	if err != nil {
 		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
 			return nil, nil, err
 		}
 	} else {
-		retentionInDays = domainEntry.GetRetentionDays(workflowID)
+		retentionInDays = domainEntry.GetRetentionDays(executionInfo.WorkflowID)
 	}
+	retentionInDays = getWorkflowRetentionDays(shard.GetConfig(), executionInfo, retentionInDays)
 	deleteTask := createDeleteHistoryEventTimerTask(tBuilder, retentionInDays)
 	return &persistence.CloseExecutionTask{}, deleteTask, nil
 }
 
+// isDecisionHeartbeat returns true if the worker only asks for more time to finish the decision
+func isDecisionHeartbeat(request *workflow.RespondDecisionTaskCompletedRequest, di *decisionInfo) bool {
+	return request.GetForceCreateNewDecisionTask() && request.GetReturnNewDecisionTask() &&
+		len(request.Decisions) == 0 && len(request.UpdateResults) == 0 && di.Attempt == 0
+}
+
+// getMaxDecisionHeartbeats bounds heartbeats so a single decision can not outlive the workflow run timeout
+func getMaxDecisionHeartbeats(workflowTimeoutSeconds, decisionTimeoutSeconds int32) int32 {
+	if decisionTimeoutSeconds <= 0 || workflowTimeoutSeconds <= decisionTimeoutSeconds {
+		return 1
+	}
+	return workflowTimeoutSeconds / decisionTimeoutSeconds
+}
+
+// isWorkflowExpirationSet returns true if the workflow execution timeout covering the whole chain of
+// retries, cron runs and continue-as-new is set
+func isWorkflowExpirationSet(executionInfo *persistence.WorkflowExecutionInfo) bool {
+	return executionInfo.WorkflowExecutionTimeout > 0
+}
+
+// getWorkflowTimeoutDeadline bounds the run timeout deadline by the workflow execution expiration,
+// so the workflow timeout timer times out whichever run is current when the whole chain expires
+func getWorkflowTimeoutDeadline(executionInfo *persistence.WorkflowExecutionInfo, runDeadline time.Time) time.Time {
+	if isWorkflowExpirationSet(executionInfo) && executionInfo.WorkflowExpirationTime.Before(runDeadline) {
+		return executionInfo.WorkflowExpirationTime
+	}
+	return runDeadline
+}
+
+// isWorkflowExpired returns true if the workflow execution timeout covering the whole chain has expired
+func isWorkflowExpired(executionInfo *persistence.WorkflowExecutionInfo, now time.Time) bool {
+	return isWorkflowExpirationSet(executionInfo) && !now.Before(executionInfo.WorkflowExpirationTime)
+}
+
+// getWorkflowRetentionDays returns the per workflow retention bounded by dynamic config if it is set,
+// otherwise the given domain retention
+func getWorkflowRetentionDays(config *Config, executionInfo *persistence.WorkflowExecutionInfo, domainRetentionDays int32) int32 {
+	if executionInfo.RetentionDays <= 0 {
+		return domainRetentionDays
+	}
+	retentionDays := common.MaxInt32(executionInfo.RetentionDays, int32(config.MinWorkflowRetentionDays()))
+	return common.MinInt32(retentionDays, int32(config.MaxWorkflowRetentionDays()))
+}
+
 func createDeleteHistoryEventTimerTask(tBuilder *timerBuilder, retentionInDays int32) *persistence.DeleteHistoryEventTask {
 	retention := time.Duration(retentionInDays) * time.Hour * 24
 	if tBuilder != nil {
@@ -2809,6 +3287,7 @@ func (e *historyEngineImpl) createRecordDecisionTaskStartedResponse(domainID str
 	}
 	response.EventStoreVersion = common.Int32Ptr(msBuilder.GetEventStoreVersion())
 	response.BranchToken = msBuilder.GetCurrentBranch()
+	response.Updates = e.updateRegistry.dispatch(newWorkflowUpdateKey(domainID, executionInfo.WorkflowID, executionInfo.RunID))
 
 	return response
 }
@@ -3136,6 +3615,15 @@ func validateContinueAsNewWorkflowExecutionAttributes(executionInfo *persistence
 		attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(executionInfo.DecisionTimeoutValue)
 	}
 
+	if attributes.GetRetentionPeriodInDays() < 0 {
+		return &workflow.BadRequestError{Message: "RetentionPeriodInDays cannot be negative."}
+	}
+
+	// Inherit retention from previous execution if not provided on decision
+	if attributes.GetRetentionPeriodInDays() == 0 && executionInfo.RetentionDays > 0 {
+		attributes.RetentionPeriodInDays = common.Int32Ptr(executionInfo.RetentionDays)
+	}
+
 	return nil
 }
 
@@ -3209,6 +3697,12 @@ func validateStartWorkflowExecutionRequest(request *workflow.StartWorkflowExecut
 	if request.TaskStartToCloseTimeoutSeconds == nil || request.GetTaskStartToCloseTimeoutSeconds() <= 0 {
 		return &workflow.BadRequestError{Message: "Missing or invalid TaskStartToCloseTimeoutSeconds."}
 	}
+	if request.GetRetentionPeriodInDays() < 0 {
+		return &workflow.BadRequestError{Message: "Invalid RetentionPeriodInDays."}
+	}
+	if request.GetDelayStartSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "Invalid DelayStartSeconds."}
+	}
 	if request.TaskList == nil || request.TaskList.Name == nil || request.TaskList.GetName() == "" {
 		return &workflow.BadRequestError{Message: "Missing Tasklist."}
 	}
@@ -3244,6 +3738,23 @@ func (e *historyEngineImpl) getActiveDomainEntry(domainUUID *string) (*cache.Dom
 	return getActiveDomainEntryFromShard(e.shard, domainUUID)
 }
 
+func (e *historyEngineImpl) isPayloadOffloadEnabled(domainEntry *cache.DomainCacheEntry) bool {
+	domainName := domainEntry.GetInfo().Name
+	if domainEntry.IsGlobalDomain() && !e.config.EnablePayloadOffloadForGlobalDomains(domainName) {
+		return false
+	}
+	return e.config.PayloadOffloadThreshold(domainName) > 0 && len(e.config.PayloadOffloadBucket(domainName)) > 0
+}
+
+// resolvePayload returns the payload kept in history or downloads it if it was offloaded,
+// a payload copied into a new run must never share the blob of the run it was copied from
+func resolvePayload(shard ShardContext, data []byte, ref *workflow.PayloadReference) ([]byte, error) {
+	if ref == nil {
+		return data, nil
+	}
+	return shard.GetPayloadStore().Resolve(ctx.Background(), ref)
+}
+
 func getActiveDomainEntryFromShard(shard ShardContext, domainUUID *string) (*cache.DomainCacheEntry, error) {
 	domainID, err := validateDomainUUID(domainUUID)
 	if err != nil {
@@ -3288,12 +3799,102 @@ func getStartRequest(domainID string,
 		CronSchedule:                        request.CronSchedule,
 		Memo:                                request.Memo,
 		Header:                              request.Header,
+		RetentionPeriodInDays:               request.RetentionPeriodInDays,
+		DelayStartSeconds:                   request.DelayStartSeconds,
+		WorkflowExecutionTimeoutSeconds:     request.WorkflowExecutionTimeoutSeconds,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
 	return startRequest
 }
 
+// isFirstDecisionTaskBackoffPending returns true if the first decision of the workflow is still
+// deferred by its cron schedule or by a delayed start
+func isFirstDecisionTaskBackoffPending(msBuilder mutableState) bool {
+	if msBuilder.HasProcessedOrPendingDecisionTask() {
+		return false
+	}
+	return msBuilder.GetExecutionInfo().CronSchedule != "" || isDelayedStartPending(msBuilder)
+}
+
+// isDelayedStartPending returns true if the workflow was started with a delay which has not elapsed yet
+func isDelayedStartPending(msBuilder mutableState) bool {
+	if msBuilder.HasProcessedOrPendingDecisionTask() || msBuilder.GetNextEventID() <= common.FirstEventID {
+		return false
+	}
+	startEvent, ok := msBuilder.GetStartEvent()
+	if !ok {
+		return false
+	}
+	attributes := startEvent.WorkflowExecutionStartedEventAttributes
+	return attributes.GetContinuedExecutionRunId() == "" && attributes.GetFirstDecisionTaskBackoffSeconds() > 0
+}
+
+// checkWorkflowConcurrencyLimit enforces the concurrency limit of the workflow type of a new top level execution
+// which is about to be started right away. Depending on the limit mode of the domain, a start over the limit is
+// either rejected, or queued by deferring its first decision for the queue retry interval of the domain.
+func (e *historyEngineImpl) checkWorkflowConcurrencyLimit(
+	domainEntry *cache.DomainCacheEntry,
+	startRequest *h.StartWorkflowExecutionRequest,
+	scope int,
+) (queued bool, retError error) {
+
+	domainName := domainEntry.GetInfo().Name
+	request := startRequest.StartRequest
+	workflowType := request.WorkflowType.GetName()
+	limit := e.config.WorkflowTypeConcurrencyLimit(domainName, workflowType)
+	if limit <= 0 || e.concurrencyLimiter == nil {
+		return false, nil
+	}
+
+	ok, err := e.concurrencyLimiter.tryAcquire(domainEntry.GetInfo().ID, workflowType, limit)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		return false, nil
+	}
+
+	if e.config.WorkflowTypeConcurrencyLimitMode(domainName) == workflowConcurrencyLimitModeQueue {
+		e.metricsClient.IncCounter(scope, metrics.WorkflowConcurrencyLimitBackoffTimerCount)
+		startRequest.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(getWorkflowConcurrencyQueueBackoffSeconds(e.config, domainName))
+		return true, nil
+	}
+
+	// a retry of a start which already went through must not be rejected, let it be deduplicated instead
+	current, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainEntry.GetInfo().ID,
+		WorkflowID: request.GetWorkflowId(),
+	})
+	if err == nil && current.StartRequestID == request.GetRequestId() {
+		return false, nil
+	}
+
+	e.metricsClient.IncCounter(scope, metrics.WorkflowConcurrencyLimitExceededCount)
+	return false, &workflow.LimitExceededError{
+		Message: fmt.Sprintf("Domain %v has reached the limit of %v running executions of workflow type %v.", domainName, limit, workflowType),
+	}
+}
+
+// getWorkflowConcurrencyQueueBackoffSeconds returns how long a start queued by the concurrency limit waits
+// before checking for capacity again
+func getWorkflowConcurrencyQueueBackoffSeconds(config *Config, domainName string) int32 {
+	backoffSeconds := int32(config.WorkflowConcurrencyQueueRetryInterval(domainName) / time.Second)
+	if backoffSeconds < 1 {
+		backoffSeconds = 1
+	}
+	return backoffSeconds
+}
+
+// getFirstDecisionBackoffTimeoutType returns the timeout type of the backoff timer which defers the
+// first decision of a newly started workflow
+func getFirstDecisionBackoffTimeoutType(request *workflow.StartWorkflowExecutionRequest) int {
+	if request.GetCronSchedule() != "" {
+		return persistence.WorkflowBackoffTimeoutTypeCron
+	}
+	return persistence.WorkflowBackoffTimeoutTypeDelayStart
+}
+
 func getWorkflowStartedEvent(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, eventStoreVersion int32, branchToken []byte, logger log.Logger, domainID, workflowID, runID string, shardID *int) (*workflow.HistoryEvent, error) {
 	var events []*workflow.HistoryEvent
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
@@ -3368,6 +3969,10 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(prevStartRequestID,
 	// here we know there is some information about the prev workflow, i.e. either running right now
 	// or has history check if the this workflow is finished
 	if prevState != persistence.WorkflowStateCompleted {
+		if wfIDReusePolicy == workflow.WorkflowIdReusePolicyTerminateIfRunning {
+			// the running workflow is terminated by the caller in the transaction creating the new run
+			return nil
+		}
 		msg := "Workflow execution is already running. WorkflowId: %v, RunId: %v."
 		return getWorkflowAlreadyStartedError(msg, prevStartRequestID, execution.GetWorkflowId(), prevRunID)
 	}
@@ -3377,7 +3982,7 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(prevStartRequestID,
 			msg := "Workflow execution already finished successfully. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: allow duplicate workflow ID if last run failed."
 			return getWorkflowAlreadyStartedError(msg, prevStartRequestID, execution.GetWorkflowId(), prevRunID)
 		}
-	case workflow.WorkflowIdReusePolicyAllowDuplicate:
+	case workflow.WorkflowIdReusePolicyAllowDuplicate, workflow.WorkflowIdReusePolicyTerminateIfRunning:
 		// as long as workflow not running, so this case has no check
 	case workflow.WorkflowIdReusePolicyRejectDuplicate:
 		msg := "Workflow execution already finished. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: reject duplicate workflow ID."
@@ -3389,6 +3994,85 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(prevStartRequestID,
 	return nil
 }
 
+// terminateRunningAndCreateWorkflowExecution locks the running execution of the workflow and terminates it
+// in the transaction creating the new run
+func (e *historyEngineImpl) terminateRunningAndCreateWorkflowExecution(ctx ctx.Context, domainEntry *cache.DomainCacheEntry,
+	prevRunID string, newMutableState mutableState, newTransferTasks, newTimerTasks []persistence.Task) (retError error) {
+
+	prevExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(newMutableState.GetExecutionInfo().WorkflowID),
+		RunId:      common.StringPtr(prevRunID),
+	}
+	prevContext, release, retError := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainEntry.GetInfo().ID, prevExecution)
+	if retError != nil {
+		return
+	}
+	defer func() { release(retError) }()
+
+	prevMutableState, retError := prevContext.loadWorkflowExecution()
+	if retError != nil {
+		return
+	}
+	return e.terminateAndCreateWorkflowExecution(domainEntry, prevContext, prevMutableState, newMutableState, newTransferTasks, newTimerTasks)
+}
+
+// terminateAndCreateWorkflowExecution terminates the previous run if it is still running and creates the new run
+// in the same shard transaction, the termination reason points at the new run. The context of the previous run
+// must be locked, on a conflict the previous run is reloaded and the transaction retried.
+func (e *historyEngineImpl) terminateAndCreateWorkflowExecution(domainEntry *cache.DomainCacheEntry,
+	prevContext workflowExecutionContext, prevMutableState mutableState,
+	newMutableState mutableState, newTransferTasks, newTimerTasks []persistence.Task) error {
+
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		if attempt > 0 {
+			var err error
+			if prevMutableState, err = prevContext.loadWorkflowExecution(); err != nil {
+				return err
+			}
+		}
+
+		prevRunVersion := prevMutableState.GetLastWriteVersion()
+		terminatePrev := prevMutableState.IsWorkflowExecutionRunning()
+		var closeTask, cleanupTask persistence.Task
+		if terminatePrev {
+			if prevMutableState.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+				Reason:   common.StringPtr(fmt.Sprintf(common.TerminateReasonNewRunStarted, newMutableState.GetExecutionInfo().RunID)),
+				Identity: common.StringPtr(identityHistoryService),
+			}) == nil {
+				return &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+			}
+
+			var err error
+			closeTask, cleanupTask, err = e.getWorkflowHistoryCleanupTasks(
+				prevMutableState.GetExecutionInfo(),
+				e.getTimerBuilder(prevContext.getExecution()),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := prevContext.terminateAndCreateWorkflowExecution(
+			prevMutableState, terminatePrev, closeTask, cleanupTask,
+			newMutableState, newTransferTasks, newTimerTasks,
+			domainEntry.CanReplicateEvent(), prevRunVersion,
+		)
+		if err == ErrConflict {
+			// the previous run was updated meanwhile, the context is cleared and the run reloaded on retry
+			continue
+		}
+		if err == nil || persistence.IsTimeoutError(err) {
+			e.txProcessor.NotifyNewTask(e.currentClusterName, newTransferTasks)
+			if terminatePrev {
+				e.txProcessor.NotifyNewTask(e.currentClusterName, []persistence.Task{closeTask})
+				e.timerProcessor.NotifyNewTimers(e.currentClusterName, e.shard.GetCurrentTime(e.currentClusterName), []persistence.Task{cleanupTask})
+			}
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
 func getWorkflowAlreadyStartedError(errMsg string, createRequestID string, workflowID string, runID string) error {
 	return &workflow.WorkflowExecutionAlreadyStartedError{
 		Message:        common.StringPtr(fmt.Sprintf(errMsg, workflowID, runID)),
@@ -24,7 +24,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
@@ -45,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 )
 
@@ -151,6 +154,7 @@ func (s *engine2Suite) SetupTest() {
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		config:             s.config,
 		archivalClient:     s.mockArchivalClient,
+		updateRegistry:     newWorkflowUpdateRegistry(s.config.WorkflowUpdateDedupeTTL()),
 	}
 	h.txProcessor = newTransferQueueProcessor(mockShard, h, s.mockVisibilityMgr, s.mockMatchingClient, s.mockHistoryClient, s.logger)
 	h.timerProcessor = newTimerQueueProcessor(mockShard, h, s.mockMatchingClient, s.logger)
@@ -1151,6 +1155,152 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup() {
 	s.Nil(resp)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_TerminateIfRunning() {
+	domainID := validDomainID
+	workflowID := "workflowID"
+	runID := validRunID
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	var appendRequests []*p.AppendHistoryNodesRequest
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Run(func(args mock.Arguments) {
+		appendRequests = append(appendRequests, args.Get(0).(*p.AppendHistoryNodesRequest))
+	}).Twice()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            runID,
+		State:            p.WorkflowStateRunning,
+		CloseStatus:      p.WorkflowCloseStatusNone,
+		LastWriteVersion: common.EmptyVersion,
+	}).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	var resetRequest *p.ResetWorkflowExecutionRequest
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resetRequest = args.Get(0).(*p.ResetWorkflowExecutionRequest)
+	}).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr(identity),
+			RequestId:                           common.StringPtr("newRequestID"),
+			WorkflowIdReusePolicy:               workflow.WorkflowIdReusePolicyTerminateIfRunning.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.NotEqual(runID, resp.GetRunId())
+
+	s.True(resetRequest.UpdateCurr)
+	s.Equal(p.WorkflowStateRunning, resetRequest.PrevRunState)
+	s.Equal(runID, resetRequest.CurrExecutionInfo.RunID)
+	s.Equal(p.WorkflowStateCompleted, resetRequest.CurrExecutionInfo.State)
+	s.Equal(p.WorkflowCloseStatusTerminated, resetRequest.CurrExecutionInfo.CloseStatus)
+	s.Equal(resp.GetRunId(), resetRequest.InsertExecutionInfo.RunID)
+
+	s.Len(appendRequests, 2)
+	terminatedEvent := appendRequests[1].Events[len(appendRequests[1].Events)-1]
+	s.Equal(workflow.EventTypeWorkflowExecutionTerminated, terminatedEvent.GetEventType())
+	s.Equal(fmt.Sprintf(common.TerminateReasonNewRunStarted, resp.GetRunId()),
+		terminatedEvent.WorkflowExecutionTerminatedEventAttributes.GetReason())
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_TerminateIfRunning_Conflict() {
+	domainID := validDomainID
+	workflowID := "workflowID"
+	runID := validRunID
+
+	newGetWorkflowExecutionResponse := func() *p.GetWorkflowExecutionResponse {
+		msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.historyEngine.shard, s.mockEventsCache,
+			loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+		return &p.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}
+	}
+
+	var appendRequests []*p.AppendHistoryNodesRequest
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Run(func(args mock.Arguments) {
+		appendRequests = append(appendRequests, args.Get(0).(*p.AppendHistoryNodesRequest))
+	}).Times(3)
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            runID,
+		State:            p.WorkflowStateRunning,
+		CloseStatus:      p.WorkflowCloseStatusNone,
+		LastWriteVersion: common.EmptyVersion,
+	}).Once()
+	// the previous run is reloaded after the conflict
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(newGetWorkflowExecutionResponse(), nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(newGetWorkflowExecutionResponse(), nil).Once()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(&p.ConditionFailedError{}).Once()
+	var resetRequest *p.ResetWorkflowExecutionRequest
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resetRequest = args.Get(0).(*p.ResetWorkflowExecutionRequest)
+	}).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("workflowType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr("testTaskList")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr("testIdentity"),
+			RequestId:                           common.StringPtr("newRequestID"),
+			WorkflowIdReusePolicy:               workflow.WorkflowIdReusePolicyTerminateIfRunning.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.Equal(resp.GetRunId(), resetRequest.InsertExecutionInfo.RunID)
+	s.Equal(p.WorkflowCloseStatusTerminated, resetRequest.CurrExecutionInfo.CloseStatus)
+	s.mockExecutionMgr.AssertExpectations(s.T())
+
+	// the termination is appended once per attempt, against the reloaded run
+	s.Len(appendRequests, 3)
+	s.Equal(appendRequests[1].Events[0].GetEventId(), appendRequests[2].Events[0].GetEventId())
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_TimeoutError() {
 	domainID := validDomainID
 	workflowID := "workflowID"
@@ -1433,6 +1583,57 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	s.Equal(runID, resp.GetRunId())
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal_DuplicateRecentRequest() {
+	domainID := validDomainID
+	workflowID := "wId"
+	runID := validRunID
+	requestID := uuid.New()
+	sRequest := &h.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			Domain:     common.StringPtr(domainID),
+			WorkflowId: common.StringPtr(workflowID),
+			Identity:   common.StringPtr("testIdentity"),
+			SignalName: common.StringPtr("my signal name"),
+			Input:      []byte("test input"),
+			RequestId:  common.StringPtr(requestID),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+	ms := createMutableState(msBuilder)
+	// the signal was applied a minute ago, and its retry is a no-op even though the signal limit is reached
+	ms.ExecutionInfo.RecentSignalRequestIDs = map[string]time.Time{requestID: time.Now().Add(-time.Minute)}
+	ms.ExecutionInfo.SignalCount = 1
+	maximumSignalsPerExecution := s.config.MaximumSignalsPerExecution
+	s.config.MaximumSignalsPerExecution = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+	defer func() { s.config.MaximumSignalsPerExecution = maximumSignalsPerExecution }()
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.Equal(runID, resp.GetRunId())
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
 	sRequest := &h.SignalWithStartWorkflowExecutionRequest{}
 	_, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
@@ -1605,6 +1806,69 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotRunning()
 	s.NotEqual(runID, resp.GetRunId())
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowRunning_TerminateIfRunning() {
+	domainID := validDomainID
+	workflowID := "wId"
+	runID := validRunID
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	signalName := "my signal name"
+	input := []byte("test input")
+	sRequest := &h.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr(identity),
+			SignalName:                          common.StringPtr(signalName),
+			Input:                               input,
+			RequestId:                           common.StringPtr(uuid.New()),
+			WorkflowIdReusePolicy:               workflow.WorkflowIdReusePolicyTerminateIfRunning.Ptr(),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Twice()
+	var resetRequest *p.ResetWorkflowExecutionRequest
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resetRequest = args.Get(0).(*p.ResetWorkflowExecutionRequest)
+	}).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.NotEqual(runID, resp.GetRunId())
+	s.True(resetRequest.UpdateCurr)
+	s.Equal(runID, resetRequest.CurrExecutionInfo.RunID)
+	s.Equal(p.WorkflowCloseStatusTerminated, resetRequest.CurrExecutionInfo.CloseStatus)
+	s.Equal(resp.GetRunId(), resetRequest.InsertExecutionInfo.RunID)
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_Start_DuplicateRequests() {
 	domainID := validDomainID
 	workflowID := "wId"
@@ -46,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 )
 
@@ -171,6 +172,7 @@ func (s *engineSuite) SetupTest() {
 		historyEventNotifier: historyEventNotifier,
 		config:               NewDynamicConfigForTest(),
 		archivalClient:       s.mockArchivalClient,
+		updateRegistry:       newWorkflowUpdateRegistry(NewDynamicConfigForTest().WorkflowUpdateDedupeTTL()),
 	}
 	h.txProcessor = newTransferQueueProcessor(shardContextWrapper, h, s.mockVisibilityMgr, s.mockMatchingClient, s.mockHistoryClient, s.logger)
 	h.timerProcessor = newTimerQueueProcessor(shardContextWrapper, h, s.mockMatchingClient, s.logger)
@@ -784,6 +786,42 @@ func (s *engineSuite) TestValidateSignalRequest() {
 	s.Error(err, "startRequest doesn't have request id, it should error out")
 }
 
+func (s *engineSuite) TestGetWorkflowRetentionDays() {
+	config := NewDynamicConfigForTest()
+	config.MinWorkflowRetentionDays = dynamicconfig.GetIntPropertyFn(2)
+	config.MaxWorkflowRetentionDays = dynamicconfig.GetIntPropertyFn(30)
+
+	executionInfo := &persistence.WorkflowExecutionInfo{}
+	s.Equal(int32(7), getWorkflowRetentionDays(config, executionInfo, 7))
+
+	executionInfo.RetentionDays = 14
+	s.Equal(int32(14), getWorkflowRetentionDays(config, executionInfo, 7))
+
+	executionInfo.RetentionDays = 1
+	s.Equal(int32(2), getWorkflowRetentionDays(config, executionInfo, 7))
+
+	executionInfo.RetentionDays = 365
+	s.Equal(int32(30), getWorkflowRetentionDays(config, executionInfo, 7))
+}
+
+func (s *engineSuite) TestGetWorkflowTimeoutDeadline() {
+	now := time.Now()
+	runDeadline := now.Add(time.Hour)
+
+	executionInfo := &persistence.WorkflowExecutionInfo{}
+	s.Equal(runDeadline, getWorkflowTimeoutDeadline(executionInfo, runDeadline))
+	s.False(isWorkflowExpired(executionInfo, runDeadline))
+
+	executionInfo.WorkflowExecutionTimeout = 600
+	executionInfo.WorkflowExpirationTime = now.Add(10 * time.Minute)
+	s.Equal(executionInfo.WorkflowExpirationTime, getWorkflowTimeoutDeadline(executionInfo, runDeadline))
+	s.False(isWorkflowExpired(executionInfo, now))
+	s.True(isWorkflowExpired(executionInfo, executionInfo.WorkflowExpirationTime))
+
+	executionInfo.WorkflowExpirationTime = now.Add(2 * time.Hour)
+	s.Equal(runDeadline, getWorkflowTimeoutDeadline(executionInfo, runDeadline))
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedMaxAttemptsExceeded() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -1330,11 +1368,136 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedBadBinary() {
 	executionBuilder := s.getBuilder(domainID, we)
 	s.Equal(int64(6), executionBuilder.GetExecutionInfo().NextEventID)
 	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
+	s.Empty(executionBuilder.GetExecutionInfo().LastBinaryChecksum)
 	s.Equal(executionContext, executionBuilder.GetExecutionInfo().ExecutionContext)
 	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
 	s.True(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedDecisionHeartbeat() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 10, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// no history events are written for a heartbeat
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	resp, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:                  taskToken,
+			Identity:                   &identity,
+			ForceCreateNewDecisionTask: common.BoolPtr(true),
+			ReturnNewDecisionTask:      common.BoolPtr(true),
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	s.NotNil(resp.StartedResponse)
+	s.Equal(int64(2), resp.StartedResponse.GetScheduledEventId())
+	s.Equal(int64(3), resp.StartedResponse.GetStartedEventId())
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(4), executionBuilder.GetExecutionInfo().NextEventID)
+	di, ok := executionBuilder.GetInFlightDecisionTask()
+	s.True(ok)
+	s.Equal(int64(2), di.ScheduleID)
+	s.Equal(int32(1), di.HeartbeatCount)
+	s.True(di.HeartbeatTimestamp > 0)
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompletedDecisionHeartbeatLimitExceeded() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 10, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	// the run timeout allows 10 heartbeats of the decision timeout
+	msBuilder.GetExecutionInfo().DecisionHeartbeatCount = 10
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:                  taskToken,
+			Identity:                   &identity,
+			ForceCreateNewDecisionTask: common.BoolPtr(true),
+			ReturnNewDecisionTask:      common.BoolPtr(true),
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
+	s.True(executionBuilder.HasPendingDecisionTask())
+	// the decision was force closed, the new one starts without heartbeats
+	s.Equal(int64(1), executionBuilder.GetExecutionInfo().DecisionAttempt)
+	s.Equal(int32(0), executionBuilder.GetExecutionInfo().DecisionHeartbeatCount)
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedSingleActivityScheduledDecision() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -1493,6 +1656,151 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowSuccess()
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedUpdateAccepted() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	update := &workflow.WorkflowUpdate{
+		UpdateName: common.StringPtr("update-name"),
+		Input:      []byte("update-input"),
+	}
+	updateResult := &workflow.WorkflowUpdateResult{
+		ResultType: workflow.WorkflowUpdateResultTypeAccepted.Ptr(),
+		Result:     []byte("update-result"),
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	updateKey := newWorkflowUpdateKey(domainID, we.GetWorkflowId(), we.GetRunId())
+	entry := s.mockHistoryEngine.updateRegistry.register(updateKey, "update-id", update)
+	s.mockHistoryEngine.updateRegistry.dispatch(updateKey)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:     taskToken,
+			UpdateResults: map[string]*workflow.WorkflowUpdateResult{"update-id": updateResult},
+			Identity:      &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(6), executionBuilder.GetExecutionInfo().NextEventID)
+	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+
+	acceptedUpdates := executionBuilder.GetExecutionInfo().AcceptedUpdates.GetUpdates()
+	s.Equal(1, len(acceptedUpdates))
+	s.Equal("update-id", acceptedUpdates[0].GetUpdateId())
+	s.Equal(int64(5), acceptedUpdates[0].GetEventId())
+	s.Equal(int64(4), acceptedUpdates[0].GetEventBatchId())
+
+	outcome, err := entry.wait(context.Background(), time.Second)
+	s.NoError(err)
+	s.Equal(we.GetRunId(), outcome.runID)
+	s.Equal(updateResult, outcome.result)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecutionAcceptedInMutableState() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = domainID
+	// the update was accepted before the shard moved to this host, so only the mutable state knows about it
+	ms.ExecutionInfo.AcceptedUpdates = &workflow.AcceptedWorkflowUpdates{
+		Updates: []*workflow.AcceptedWorkflowUpdateInfo{{
+			UpdateId:     common.StringPtr("update-id"),
+			EventId:      common.Int64Ptr(5),
+			EventBatchId: common.Int64Ptr(4),
+		}},
+	}
+	s.eventsCache.putEvent(domainID, we.GetWorkflowId(), we.GetRunId(), 5, &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(5),
+		EventType: workflow.EventTypeWorkflowExecutionUpdateAccepted.Ptr(),
+		WorkflowExecutionUpdateAcceptedEventAttributes: &workflow.WorkflowExecutionUpdateAcceptedEventAttributes{
+			UpdateId: common.StringPtr("update-id"),
+			Result:   []byte("update-result"),
+		},
+	})
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), &history.UpdateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			UpdateName:        common.StringPtr("update-name"),
+			RequestId:         common.StringPtr("update-id"),
+		},
+	})
+	s.NoError(err)
+	s.Equal(we.GetRunId(), resp.GetRunId())
+	s.Equal(workflow.WorkflowUpdateResultTypeAccepted, resp.UpdateResult.GetResultType())
+	s.Equal([]byte("update-result"), resp.UpdateResult.Result)
+	// nothing is written and the update is not handed to the worker again
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything)
+	s.False(s.mockHistoryEngine.updateRegistry.hasPending(newWorkflowUpdateKey(domainID, we.GetWorkflowId(), we.GetRunId()), nil))
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowSuccess() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -4688,6 +4996,223 @@ func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	s.Nil(err)
 }
 
+// Test client retry of a signal that was recently applied
+func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRecentRequest() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId2"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	identity := "testIdentity2"
+	signalName := "my signal name 2"
+	input := []byte("test input 2")
+	requestID := uuid.New()
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			SignalName:        common.StringPtr(signalName),
+			Input:             input,
+			RequestId:         common.StringPtr(requestID),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	ms := createMutableState(msBuilder)
+	// assume the signal was applied a minute ago
+	ms.ExecutionInfo.RecentSignalRequestIDs = map[string]time.Time{requestID: time.Now().Add(-time.Minute)}
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	s.Equal(int64(0), ms.ExecutionInfo.SignalCount)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRecentRequest_SignalsLimitReached() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId2"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	identity := "testIdentity2"
+	signalName := "my signal name 2"
+	input := []byte("test input 2")
+	requestID := uuid.New()
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			SignalName:        common.StringPtr(signalName),
+			Input:             input,
+			RequestId:         common.StringPtr(requestID),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	ms := createMutableState(msBuilder)
+	// assume the signal was applied a minute ago
+	ms.ExecutionInfo.RecentSignalRequestIDs = map[string]time.Time{requestID: time.Now().Add(-time.Minute)}
+	// the retried signal is a no-op rather than a limit error, even though the limit is reached
+	ms.ExecutionInfo.SignalCount = 1
+	maximumSignalsPerExecution := s.mockHistoryEngine.config.MaximumSignalsPerExecution
+	s.mockHistoryEngine.config.MaximumSignalsPerExecution = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+	defer func() { s.mockHistoryEngine.config.MaximumSignalsPerExecution = maximumSignalsPerExecution }()
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	s.Equal(int64(1), ms.ExecutionInfo.SignalCount)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_Delayed() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	identity := "testIdentity"
+	signalName := "my signal name"
+	input := []byte("test input")
+	requestID := uuid.New()
+	deliverAt := time.Now().Add(time.Hour)
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:             common.StringPtr(domainID),
+			WorkflowExecution:  &we,
+			Identity:           common.StringPtr(identity),
+			SignalName:         common.StringPtr(signalName),
+			Input:              input,
+			RequestId:          common.StringPtr(requestID),
+			DeliverAtTimestamp: common.Int64Ptr(deliverAt.UnixNano()),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		if len(request.TimerTasks) != 1 {
+			return false
+		}
+		task, ok := request.TimerTasks[0].(*persistence.DelayedSignalTask)
+		signals := request.ExecutionInfo.DelayedSignals.GetSignals()
+		return ok && task.RequestID == requestID && task.GetVisibilityTimestamp().Equal(time.Unix(0, deliverAt.UnixNano())) &&
+			len(request.TransferTasks) == 0 && len(signals) == 1 && signals[0].GetRequestId() == requestID &&
+			signals[0].GetSignalName() == signalName && signals[0].GetRunId() == validRunID
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DelayedInputTooLarge() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:             common.StringPtr(domainID),
+			WorkflowExecution:  &we,
+			Identity:           common.StringPtr("testIdentity"),
+			SignalName:         common.StringPtr("my signal name"),
+			Input:              []byte("test input"),
+			RequestId:          common.StringPtr(uuid.New()),
+			DeliverAtTimestamp: common.Int64Ptr(time.Now().Add(time.Hour).UnixNano()),
+		},
+	}
+	blobSizeLimitError := s.config.BlobSizeLimitError
+	s.config.BlobSizeLimitError = dynamicconfig.GetIntPropertyFilteredByDomain(4)
+	defer func() { s.config.BlobSizeLimitError = blobSizeLimitError }()
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Equal(ErrDelayedSignalInputTooLarge, err)
+}
+
 func (s *engineSuite) TestSignalWorkflowExecution_Failed() {
 	signalRequest := &history.SignalWorkflowExecutionRequest{}
 	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
@@ -5148,6 +5673,7 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		LastUpdatedTimestamp:         sourceInfo.LastUpdatedTimestamp,
 		CreateRequestID:              sourceInfo.CreateRequestID,
 		SignalCount:                  sourceInfo.SignalCount,
+		RecentSignalRequestIDs:       sourceInfo.RecentSignalRequestIDs,
 		HistorySize:                  sourceInfo.HistorySize,
 		DecisionVersion:              sourceInfo.DecisionVersion,
 		DecisionScheduleID:           sourceInfo.DecisionScheduleID,
@@ -5156,6 +5682,9 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		DecisionTimeout:              sourceInfo.DecisionTimeout,
 		DecisionAttempt:              sourceInfo.DecisionAttempt,
 		DecisionTimestamp:            sourceInfo.DecisionTimestamp,
+		DecisionHeartbeatCount:       sourceInfo.DecisionHeartbeatCount,
+		DecisionHeartbeatTimestamp:   sourceInfo.DecisionHeartbeatTimestamp,
+		LastBinaryChecksum:           sourceInfo.LastBinaryChecksum,
 		CancelRequested:              sourceInfo.CancelRequested,
 		CancelRequestID:              sourceInfo.CancelRequestID,
 		CronSchedule:                 sourceInfo.CronSchedule,
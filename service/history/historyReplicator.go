@@ -1058,7 +1058,6 @@ func (r *historyReplicator) garbageCollectSignals(context workflowExecutionConte
 	// we are garbage collecting signals already applied to mutable states,
 	// so targeting child workflow only check is not necessary
 
-	// TODO should we also include the request ID in the signal request in the event?
 	updateMutableState := false
 	msBuilder.UpdateReplicationStateVersion(msBuilder.GetLastWriteVersion(), true)
 	for _, event := range events {
@@ -1066,7 +1065,7 @@ func (r *historyReplicator) garbageCollectSignals(context workflowExecutionConte
 		case workflow.EventTypeWorkflowExecutionSignaled:
 			updateMutableState = true
 			attr := event.WorkflowExecutionSignaledEventAttributes
-			if msBuilder.AddWorkflowExecutionSignaled(attr.GetSignalName(), attr.Input, attr.GetIdentity()) == nil {
+			if msBuilder.AddWorkflowExecutionSignaled(attr.GetSignalName(), attr.Input, attr.GetIdentity(), attr.GetRequestId()) == nil {
 				return false, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
 		}
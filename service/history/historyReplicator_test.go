@@ -1142,6 +1142,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingLes
 	signalName := "some random signal name"
 	signalInput := []byte("some random signal input")
 	signalIdentity := "some random signal identity"
+	signalRequestID := "some random signal request ID"
 
 	context := &mockWorkflowExecutionContext{}
 	defer context.AssertExpectations(s.T())
@@ -1157,6 +1158,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingLes
 					SignalName: common.StringPtr(signalName),
 					Input:      signalInput,
 					Identity:   common.StringPtr(signalIdentity),
+					RequestId:  common.StringPtr(signalRequestID),
 				},
 			},
 		}},
@@ -1165,7 +1167,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingLes
 	msBuilderIn.On("GetLastWriteVersion").Return(currentLastWriteVersion)
 	msBuilderIn.On("IsWorkflowExecutionRunning").Return(true)
 	msBuilderIn.On("UpdateReplicationStateVersion", currentLastWriteVersion, true).Once()
-	msBuilderIn.On("AddWorkflowExecutionSignaled", signalName, signalInput, signalIdentity).Return(&shared.HistoryEvent{
+	msBuilderIn.On("AddWorkflowExecutionSignaled", signalName, signalInput, signalIdentity, signalRequestID).Return(&shared.HistoryEvent{
 		EventType: shared.EventTypeWorkflowExecutionSignaled.Ptr(),
 		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
 		WorkflowExecutionSignaledEventAttributes: &shared.WorkflowExecutionSignaledEventAttributes{
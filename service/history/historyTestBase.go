@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
 	"github.com/uber/cadence/common/service"
@@ -72,6 +73,7 @@ type (
 		historyV2Mgr           persistence.HistoryV2Manager
 		executionMgr           persistence.ExecutionManager
 		domainCache            cache.DomainCache
+		payloadStore           payload.Store
 		eventsCache            eventsCache
 
 		config                    *Config
@@ -127,6 +129,7 @@ func newTestShardContext(shardInfo *persistence.ShardInfo, transferSequenceNumbe
 		historyV2Mgr:              historyV2Mgr,
 		executionMgr:              executionMgr,
 		domainCache:               domainCache,
+		payloadStore:              payload.NewStore(nil),
 		config:                    config,
 		logger:                    logger,
 		metricsClient:             metricsClient,
@@ -168,6 +171,11 @@ func (s *TestShardContext) GetDomainCache() cache.DomainCache {
 	return s.domainCache
 }
 
+// GetPayloadStore test implementation
+func (s *TestShardContext) GetPayloadStore() payload.Store {
+	return s.payloadStore
+}
+
 // GetEventsCache test implementation
 func (s *TestShardContext) GetEventsCache() eventsCache {
 	return s.eventsCache
@@ -364,6 +372,46 @@ func (s *TestShardContext) UpdateDomainNotificationVersion(domainNotificationVer
 	return nil
 }
 
+// UpdateRunningWorkflowCount test implementation
+func (s *TestShardContext) UpdateRunningWorkflowCount(domainID string, workflowType string, delta int64) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.RunningWorkflowCounts == nil {
+		s.shardInfo.RunningWorkflowCounts = make(map[string]map[string]int64)
+	}
+	if _, ok := s.shardInfo.RunningWorkflowCounts[domainID]; !ok {
+		s.shardInfo.RunningWorkflowCounts[domainID] = make(map[string]int64)
+	}
+	s.shardInfo.RunningWorkflowCounts[domainID][workflowType] += delta
+}
+
+// SetRunningWorkflowCount test implementation
+func (s *TestShardContext) SetRunningWorkflowCount(domainID string, workflowType string, count int64) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.RunningWorkflowCounts == nil {
+		s.shardInfo.RunningWorkflowCounts = make(map[string]map[string]int64)
+	}
+	if _, ok := s.shardInfo.RunningWorkflowCounts[domainID]; !ok {
+		s.shardInfo.RunningWorkflowCounts[domainID] = make(map[string]int64)
+	}
+	s.shardInfo.RunningWorkflowCounts[domainID][workflowType] = count
+}
+
+// GetRunningWorkflowCounts test implementation
+func (s *TestShardContext) GetRunningWorkflowCounts(domainID string) map[string]int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	ret := make(map[string]int64)
+	for workflowType, count := range s.shardInfo.RunningWorkflowCounts[domainID] {
+		ret[workflowType] = count
+	}
+	return ret
+}
+
 // CreateWorkflowExecution test implementation
 func (s *TestShardContext) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
 	*persistence.CreateWorkflowExecutionResponse, error) {
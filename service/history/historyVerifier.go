@@ -0,0 +1,306 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pborman/uuid"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// historyVerifier replays the history of a workflow execution into a fresh mutable state
+	// and reports where the result differs from the persisted mutable state
+	historyVerifier struct {
+		shard        ShardContext
+		historyMgr   persistence.HistoryManager
+		historyV2Mgr persistence.HistoryV2Manager
+		logger       log.Logger
+	}
+)
+
+func newHistoryVerifier(shard ShardContext, historyMgr persistence.HistoryManager,
+	historyV2Mgr persistence.HistoryV2Manager, logger log.Logger) *historyVerifier {
+
+	return &historyVerifier{
+		shard:        shard,
+		historyMgr:   historyMgr,
+		historyV2Mgr: historyV2Mgr,
+		logger:       logger,
+	}
+}
+
+// verify replays the history up to the next event ID of the given mutable state snapshot and returns the
+// mismatches between the replayed mutable state and the snapshot, an empty result means the history is consistent
+func (v *historyVerifier) verify(domainID string, persisted *persistence.WorkflowMutableState) ([]string, error) {
+	if len(persisted.BufferedEvents) > 0 || len(persisted.BufferedReplicationTasks) > 0 {
+		// buffered events are already reflected in the mutable state but not yet in the history
+		return nil, &workflow.BadRequestError{Message: "Workflow execution has buffered events, retry later."}
+	}
+
+	replayed, mismatches, err := v.replay(domainID, persisted.ExecutionInfo, persisted.ReplicationState)
+	if err != nil {
+		return nil, err
+	}
+	return append(mismatches, diffMutableStates(replayed, persisted)...), nil
+}
+
+// replay applies the history events of the execution to a new mutable state builder,
+// gaps in the event IDs of the history are returned as mismatches
+func (v *historyVerifier) replay(domainID string, info *persistence.WorkflowExecutionInfo,
+	replicationState *persistence.ReplicationState) (*persistence.WorkflowMutableState, []string, error) {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(info.WorkflowID),
+		RunId:      common.StringPtr(info.RunID),
+	}
+	requestID := uuid.New()
+	currentCluster := v.shard.GetService().GetClusterMetadata().GetCurrentClusterName()
+
+	var replayBuilder *mutableStateBuilder
+	var sBuilder stateBuilder
+	var nextPageToken []byte
+	lastEventID := common.FirstEventID - 1
+	var mismatches []string
+	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
+		batches, newPageToken, err := v.getHistory(domainID, execution, info, nextPageToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		nextPageToken = newPageToken
+
+		// each batch is applied on its own, the same way the events are replicated
+		for _, batch := range batches {
+			var events []*workflow.HistoryEvent
+			for _, event := range batch.Events {
+				if event.GetEventId() >= info.NextEventID {
+					// the last batch could be read past the next event ID, those events are not committed
+					break
+				}
+				if event.GetEventId() != lastEventID+1 {
+					mismatches = append(mismatches, fmt.Sprintf("history event ID %v follows event ID %v", event.GetEventId(), lastEventID))
+				}
+				lastEventID = event.GetEventId()
+				// the first event of the new run is not part of this history, and the continue as new event
+				// only closes the execution, so it is not applied
+				if event.GetEventType() != workflow.EventTypeWorkflowExecutionContinuedAsNew {
+					events = append(events, event)
+				}
+			}
+			if len(events) == 0 {
+				continue
+			}
+
+			if replayBuilder == nil {
+				if replicationState != nil {
+					replayBuilder = newMutableStateBuilderWithReplicationState(
+						currentCluster,
+						v.shard,
+						v.shard.GetEventsCache(),
+						v.logger,
+						events[0].GetVersion(),
+					)
+				} else {
+					replayBuilder = newMutableStateBuilder(currentCluster, v.shard, v.shard.GetEventsCache(), v.logger)
+				}
+				replayBuilder.executionInfo.EventStoreVersion = info.EventStoreVersion
+				sBuilder = newStateBuilder(v.shard, replayBuilder, v.logger)
+			}
+
+			// NOTE: the replayed mutable state is never persisted, so the tasks generated by applying the events are dropped
+			if _, _, _, err := sBuilder.applyEvents(domainID, requestID, execution, events, nil, info.EventStoreVersion, 0); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if replayBuilder == nil {
+		return nil, nil, &workflow.InternalServiceError{Message: "Workflow execution history is empty."}
+	}
+
+	// the continue as new event is not applied, so the next event ID is set past the last event in the history
+	replayBuilder.executionInfo.SetNextEventID(lastEventID + 1)
+	return replayBuilder.CopyToPersistence(), mismatches, nil
+}
+
+func (v *historyVerifier) getHistory(domainID string, execution workflow.WorkflowExecution,
+	info *persistence.WorkflowExecutionInfo, nextPageToken []byte) ([]*workflow.History, []byte, error) {
+
+	_, batches, nextPageToken, _, err := PaginateHistory(
+		v.historyMgr,
+		v.historyV2Mgr,
+		v.shard.GetMetricsClient(),
+		v.logger,
+		true,
+		domainID,
+		execution.GetWorkflowId(),
+		execution.GetRunId(),
+		common.FirstEventID,
+		info.NextEventID,
+		nextPageToken,
+		info.EventStoreVersion,
+		info.GetCurrentBranch(),
+		defaultHistoryPageSize,
+		common.IntPtr(v.shard.GetShardID()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return batches, nextPageToken, nil
+}
+
+// diffMutableStates compares the pending activities, timers, child executions, request cancels, signals
+// and the next event ID of the replayed mutable state with the persisted one
+func diffMutableStates(replayed, persisted *persistence.WorkflowMutableState) []string {
+	var mismatches []string
+	mismatch := func(format string, args ...interface{}) {
+		mismatches = append(mismatches, fmt.Sprintf(format, args...))
+	}
+
+	if replayed.ExecutionInfo.NextEventID != persisted.ExecutionInfo.NextEventID {
+		mismatch("next event ID is %v in history but %v in mutable state",
+			replayed.ExecutionInfo.NextEventID, persisted.ExecutionInfo.NextEventID)
+	}
+	if replayed.ExecutionInfo.SignalCount != persisted.ExecutionInfo.SignalCount {
+		mismatch("signal count is %v in history but %v in mutable state",
+			replayed.ExecutionInfo.SignalCount, persisted.ExecutionInfo.SignalCount)
+	}
+
+	for _, scheduleID := range sortedInt64Keys(replayed.ActivityInfos, persisted.ActivityInfos) {
+		r, inHistory := replayed.ActivityInfos[scheduleID]
+		p, inMutableState := persisted.ActivityInfos[scheduleID]
+		switch {
+		case !inMutableState:
+			mismatch("activity %v scheduled at event ID %v is pending in history but not in mutable state", r.ActivityID, scheduleID)
+		case !inHistory:
+			mismatch("activity %v scheduled at event ID %v is pending in mutable state but not in history", p.ActivityID, scheduleID)
+		case r.ActivityID != p.ActivityID:
+			mismatch("activity scheduled at event ID %v has activity ID %v in history but %v in mutable state", scheduleID, r.ActivityID, p.ActivityID)
+		case p.StartedID != common.TransientEventID && r.StartedID != p.StartedID:
+			// the started event of an activity with retry policy is only written to history when the activity completes
+			mismatch("activity %v has started event ID %v in history but %v in mutable state", p.ActivityID, r.StartedID, p.StartedID)
+		}
+	}
+
+	var timerIDs []string
+	for timerID := range replayed.TimerInfos {
+		timerIDs = append(timerIDs, timerID)
+	}
+	for timerID := range persisted.TimerInfos {
+		if _, ok := replayed.TimerInfos[timerID]; !ok {
+			timerIDs = append(timerIDs, timerID)
+		}
+	}
+	sort.Strings(timerIDs)
+	for _, timerID := range timerIDs {
+		r, inHistory := replayed.TimerInfos[timerID]
+		p, inMutableState := persisted.TimerInfos[timerID]
+		switch {
+		case !inMutableState:
+			mismatch("timer %v is pending in history but not in mutable state", timerID)
+		case !inHistory:
+			mismatch("timer %v is pending in mutable state but not in history", timerID)
+		case r.StartedID != p.StartedID:
+			mismatch("timer %v has started event ID %v in history but %v in mutable state", timerID, r.StartedID, p.StartedID)
+		}
+	}
+
+	for _, initiatedID := range sortedInt64Keys(replayed.ChildExecutionInfos, persisted.ChildExecutionInfos) {
+		r, inHistory := replayed.ChildExecutionInfos[initiatedID]
+		p, inMutableState := persisted.ChildExecutionInfos[initiatedID]
+		switch {
+		case !inMutableState:
+			mismatch("child execution initiated at event ID %v is pending in history but not in mutable state", initiatedID)
+		case !inHistory:
+			mismatch("child execution initiated at event ID %v is pending in mutable state but not in history", initiatedID)
+		case r.StartedID != p.StartedID:
+			mismatch("child execution initiated at event ID %v has started event ID %v in history but %v in mutable state",
+				initiatedID, r.StartedID, p.StartedID)
+		case r.StartedRunID != p.StartedRunID:
+			mismatch("child execution initiated at event ID %v has run ID %v in history but %v in mutable state",
+				initiatedID, r.StartedRunID, p.StartedRunID)
+		}
+	}
+
+	for _, initiatedID := range sortedInt64Keys(replayed.RequestCancelInfos, persisted.RequestCancelInfos) {
+		_, inHistory := replayed.RequestCancelInfos[initiatedID]
+		_, inMutableState := persisted.RequestCancelInfos[initiatedID]
+		if inHistory != inMutableState {
+			mismatch("request cancel initiated at event ID %v is pending in %v", initiatedID, pendingIn(inHistory))
+		}
+	}
+
+	for _, initiatedID := range sortedInt64Keys(replayed.SignalInfos, persisted.SignalInfos) {
+		_, inHistory := replayed.SignalInfos[initiatedID]
+		_, inMutableState := persisted.SignalInfos[initiatedID]
+		if inHistory != inMutableState {
+			mismatch("signal initiated at event ID %v is pending in %v", initiatedID, pendingIn(inHistory))
+		}
+	}
+
+	return mismatches
+}
+
+func pendingIn(inHistory bool) string {
+	if inHistory {
+		return "history but not in mutable state"
+	}
+	return "mutable state but not in history"
+}
+
+// sortedInt64Keys returns the union of the keys of the given maps in ascending order
+func sortedInt64Keys(maps ...interface{}) []int64 {
+	seen := make(map[int64]struct{})
+	var keys []int64
+	add := func(key int64) {
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	for _, m := range maps {
+		switch m := m.(type) {
+		case map[int64]*persistence.ActivityInfo:
+			for key := range m {
+				add(key)
+			}
+		case map[int64]*persistence.ChildExecutionInfo:
+			for key := range m {
+				add(key)
+			}
+		case map[int64]*persistence.RequestCancelInfo:
+			for key := range m {
+				add(key)
+			}
+		case map[int64]*persistence.SignalInfo:
+			for key := range m {
+				add(key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
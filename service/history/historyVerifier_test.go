@@ -0,0 +1,126 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/zap"
+)
+
+type (
+	historyVerifierSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestHistoryVerifierSuite(t *testing.T) {
+	s := new(historyVerifierSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyVerifierSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *historyVerifierSuite) newMutableState() *persistence.WorkflowMutableState {
+	return &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			NextEventID: 20,
+			SignalCount: 2,
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {ScheduleID: 5, ActivityID: "activity-1", StartedID: 7},
+			6: {ScheduleID: 6, ActivityID: "activity-2", StartedID: common.EmptyEventID},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"timer-1": {TimerID: "timer-1", StartedID: 8},
+		},
+		ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{
+			9: {InitiatedID: 9, StartedID: 11, StartedRunID: "child-run-id"},
+		},
+		RequestCancelInfos: map[int64]*persistence.RequestCancelInfo{
+			12: {InitiatedID: 12},
+		},
+		SignalInfos: map[int64]*persistence.SignalInfo{
+			13: {InitiatedID: 13},
+		},
+	}
+}
+
+func (s *historyVerifierSuite) TestDiffMutableStates_Consistent() {
+	s.Empty(diffMutableStates(s.newMutableState(), s.newMutableState()))
+}
+
+func (s *historyVerifierSuite) TestDiffMutableStates_TransientActivityStart() {
+	persisted := s.newMutableState()
+	// the started event of an activity with retry policy is not in history until the activity completes
+	persisted.ActivityInfos[6].StartedID = common.TransientEventID
+	s.Empty(diffMutableStates(s.newMutableState(), persisted))
+}
+
+func (s *historyVerifierSuite) TestDiffMutableStates_Mismatches() {
+	replayed := s.newMutableState()
+	persisted := s.newMutableState()
+
+	persisted.ExecutionInfo.NextEventID = 21
+	persisted.ExecutionInfo.SignalCount = 3
+	delete(persisted.ActivityInfos, 5)
+	persisted.ActivityInfos[14] = &persistence.ActivityInfo{ScheduleID: 14, ActivityID: "activity-3"}
+	persisted.ActivityInfos[6].StartedID = 15
+	persisted.TimerInfos["timer-1"].StartedID = 10
+	persisted.TimerInfos["timer-2"] = &persistence.TimerInfo{TimerID: "timer-2"}
+	persisted.ChildExecutionInfos[9].StartedRunID = "other-run-id"
+	delete(persisted.RequestCancelInfos, 12)
+	persisted.SignalInfos[16] = &persistence.SignalInfo{InitiatedID: 16}
+
+	s.Equal([]string{
+		"next event ID is 20 in history but 21 in mutable state",
+		"signal count is 2 in history but 3 in mutable state",
+		"activity activity-1 scheduled at event ID 5 is pending in history but not in mutable state",
+		"activity activity-2 has started event ID -23 in history but 15 in mutable state",
+		"activity activity-3 scheduled at event ID 14 is pending in mutable state but not in history",
+		"timer timer-1 has started event ID 8 in history but 10 in mutable state",
+		"timer timer-2 is pending in mutable state but not in history",
+		"child execution initiated at event ID 9 has run ID child-run-id in history but other-run-id in mutable state",
+		"request cancel initiated at event ID 12 is pending in history but not in mutable state",
+		"signal initiated at event ID 16 is pending in mutable state but not in history",
+	}, diffMutableStates(replayed, persisted))
+}
+
+func (s *historyVerifierSuite) TestVerify_BufferedEvents() {
+	persisted := s.newMutableState()
+	persisted.BufferedEvents = []*shared.HistoryEvent{{EventId: common.Int64Ptr(common.BufferedEventID)}}
+
+	verifier := newHistoryVerifier(nil, nil, nil, loggerimpl.NewLogger(zap.NewNop()))
+	_, err := verifier.verify("domain-id", persisted)
+	s.IsType(&shared.BadRequestError{}, err)
+}
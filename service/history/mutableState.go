@@ -40,6 +40,9 @@ type (
 		TaskList        string // This is only needed to communicate tasklist used after AddDecisionTaskScheduledEvent
 		Attempt         int64
 		Timestamp       int64
+		// heartbeats extend the in flight decision without writing history events
+		HeartbeatCount     int32
+		HeartbeatTimestamp int64
 	}
 
 	mutableState interface {
@@ -65,10 +68,12 @@ type (
 		AddDecisionTaskScheduledEvent() *decisionInfo
 		AddDecisionTaskStartedEvent(int64, string, *workflow.PollForDecisionTaskRequest) (*workflow.HistoryEvent, *decisionInfo)
 		AddDecisionTaskTimedOutEvent(int64, int64) *workflow.HistoryEvent
+		AddDelayedSignal(*workflow.DelayedSignalInfo)
 		AddExternalWorkflowExecutionCancelRequested(int64, string, string, string) *workflow.HistoryEvent
 		AddExternalWorkflowExecutionSignaled(int64, string, string, string, []uint8) *workflow.HistoryEvent
 		AddFailWorkflowEvent(int64, *workflow.FailWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent
 		AddRecordMarkerEvent(int64, *workflow.RecordMarkerDecisionAttributes) *workflow.HistoryEvent
+		AddWorkflowExecutionUpdateAcceptedEvent(int64, string, *workflow.WorkflowUpdate, []byte, string) *workflow.HistoryEvent
 		AddRequestCancelActivityTaskFailedEvent(int64, string, string) *workflow.HistoryEvent
 		AddRequestCancelExternalWorkflowExecutionFailedEvent(int64, int64, string, string, string, workflow.CancelExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent
 		AddRequestCancelExternalWorkflowExecutionInitiatedEvent(int64, string, *workflow.RequestCancelExternalWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent, *persistence.RequestCancelInfo)
@@ -83,7 +88,7 @@ type (
 		AddTimerStartedEvent(int64, *workflow.StartTimerDecisionAttributes) (*workflow.HistoryEvent, *persistence.TimerInfo)
 		AddWorkflowExecutionCancelRequestedEvent(string, *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent
 		AddWorkflowExecutionCanceledEvent(int64, *workflow.CancelWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent
-		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) *workflow.HistoryEvent
+		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string, requestID string) *workflow.HistoryEvent
 		AddWorkflowExecutionStartedEvent(workflow.WorkflowExecution, *h.StartWorkflowExecutionRequest) *workflow.HistoryEvent
 		AddWorkflowExecutionTerminatedEvent(*workflow.TerminateWorkflowExecutionRequest) *workflow.HistoryEvent
 		BufferReplicationTask(*h.ReplicateEventsRequest) error
@@ -99,6 +104,7 @@ type (
 		DeleteActivity(int64) error
 		DeleteBufferedReplicationTask(int64)
 		DeleteDecision()
+		DeleteDelayedSignal(requestID string)
 		DeletePendingChildExecution(int64)
 		DeletePendingRequestCancel(int64)
 		DeleteSignalRequested(requestID string)
@@ -134,6 +140,7 @@ type (
 		GetRequestCancelInfo(int64) (*persistence.RequestCancelInfo, bool)
 		GetRetryBackoffDuration(errReason string) time.Duration
 		GetCronBackoffDuration() time.Duration
+		GetDelayedSignal(requestID string) (*workflow.DelayedSignalInfo, bool)
 		GetScheduleIDByActivityID(string) (int64, bool)
 		GetSignalInfo(int64) (*persistence.SignalInfo, bool)
 		GetAllSignalsToSend() map[int64]*persistence.SignalInfo
@@ -141,12 +148,15 @@ type (
 		GetStartVersion() int64
 		GetUserTimer(string) (bool, *persistence.TimerInfo)
 		GetWorkflowType() *workflow.WorkflowType
+		GetWorkflowUpdateAcceptedEvent(updateID string) (*workflow.HistoryEvent, error)
+		HasAppliedSignalRequestID(requestID string) bool
 		HasBufferedEvents() bool
 		HasBufferedReplicationTasks() bool
 		HasInFlightDecisionTask() bool
 		HasParentExecution() bool
 		HasPendingDecisionTask() bool
 		HasProcessedOrPendingDecisionTask() bool
+		HeartbeatDecisionTask(scheduleEventID int64) *decisionInfo
 		IncrementHistorySize(int)
 		IsCancelRequested() (bool, string)
 		IsSignalRequested(requestID string) bool
@@ -193,6 +203,7 @@ type (
 		ReplicateWorkflowExecutionStartedEvent(string, *string, workflow.WorkflowExecution, string, *workflow.HistoryEvent)
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *workflow.HistoryEvent)
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *workflow.HistoryEvent)
+		ReplicateWorkflowExecutionUpdateAccepted(int64, *workflow.HistoryEvent)
 		ResetSnapshot(string) *persistence.ResetMutableStateRequest
 		SetHistoryBuilder(hBuilder *historyBuilder)
 		SetHistoryTree(treeID string) error
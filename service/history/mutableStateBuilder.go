@@ -833,6 +833,7 @@ func (e *mutableStateBuilder) shouldBufferEvent(eventType workflow.EventType) bo
 		workflow.EventTypeCancelTimerFailed,
 		workflow.EventTypeRequestCancelExternalWorkflowExecutionInitiated,
 		workflow.EventTypeMarkerRecorded,
+		workflow.EventTypeWorkflowExecutionUpdateAccepted,
 		workflow.EventTypeStartChildWorkflowExecutionInitiated,
 		workflow.EventTypeSignalExternalWorkflowExecutionInitiated:
 		// do not buffer event if event is directly generated from a corresponding decision
@@ -939,7 +940,8 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(errReason string) time.Dur
 		return common.NoRetryBackoff
 	}
 
-	return getBackoffInterval(info.Attempt, info.MaximumAttempts, info.InitialInterval, info.MaximumInterval, info.BackoffCoefficient, time.Now(), info.ExpirationTime, errReason, info.NonRetriableErrors)
+	backoffInterval := getBackoffInterval(info.Attempt, info.MaximumAttempts, info.InitialInterval, info.MaximumInterval, info.BackoffCoefficient, time.Now(), info.ExpirationTime, errReason, info.NonRetriableErrors)
+	return e.getBackoffWithinWorkflowExpiration(backoffInterval)
 }
 
 func (e *mutableStateBuilder) GetCronBackoffDuration() time.Duration {
@@ -947,7 +949,19 @@ func (e *mutableStateBuilder) GetCronBackoffDuration() time.Duration {
 	if len(info.CronSchedule) == 0 {
 		return cron.NoBackoff
 	}
-	return cron.GetBackoffForNextSchedule(info.CronSchedule, time.Now())
+	return e.getBackoffWithinWorkflowExpiration(cron.GetBackoffForNextSchedule(info.CronSchedule, time.Now()))
+}
+
+// getBackoffWithinWorkflowExpiration returns NoBackoff if the next run would only start after
+// the workflow execution timeout covering the whole chain has expired
+func (e *mutableStateBuilder) getBackoffWithinWorkflowExpiration(backoffInterval time.Duration) time.Duration {
+	if backoffInterval == cron.NoBackoff || !isWorkflowExpirationSet(e.executionInfo) {
+		return backoffInterval
+	}
+	if !time.Now().Add(backoffInterval).Before(e.executionInfo.WorkflowExpirationTime) {
+		return cron.NoBackoff
+	}
+	return backoffInterval
 }
 
 // GetSignalInfo get details about a signal request that is currently in progress.
@@ -1133,6 +1147,9 @@ func (e *mutableStateBuilder) getDecisionInfo() *decisionInfo {
 		DecisionTimeout: e.executionInfo.DecisionTimeout,
 		Attempt:         e.executionInfo.DecisionAttempt,
 		Timestamp:       e.executionInfo.DecisionTimestamp,
+
+		HeartbeatCount:     e.executionInfo.DecisionHeartbeatCount,
+		HeartbeatTimestamp: e.executionInfo.DecisionHeartbeatTimestamp,
 	}
 }
 
@@ -1210,6 +1227,8 @@ func (e *mutableStateBuilder) UpdateDecision(di *decisionInfo) {
 	e.executionInfo.DecisionTimeout = di.DecisionTimeout
 	e.executionInfo.DecisionAttempt = di.Attempt
 	e.executionInfo.DecisionTimestamp = di.Timestamp
+	e.executionInfo.DecisionHeartbeatCount = di.HeartbeatCount
+	e.executionInfo.DecisionHeartbeatTimestamp = di.HeartbeatTimestamp
 
 	e.logger.Debug(fmt.Sprintf("Decision Updated: {Schedule: %v, Started: %v, ID: %v, Timeout: %v, Attempt: %v, Timestamp: %v}",
 		di.ScheduleID, di.StartedID, di.RequestID, di.DecisionTimeout, di.Attempt, di.Timestamp))
@@ -1305,6 +1324,35 @@ func (e *mutableStateBuilder) DeleteSignalRequested(requestID string) {
 	e.deleteSignalRequestedID = requestID
 }
 
+// AddDelayedSignal remembers a signal accepted by this run which is delivered once its timer task fires
+func (e *mutableStateBuilder) AddDelayedSignal(signal *workflow.DelayedSignalInfo) {
+	if e.executionInfo.DelayedSignals == nil {
+		e.executionInfo.DelayedSignals = &workflow.DelayedSignals{}
+	}
+	e.executionInfo.DelayedSignals.Signals = append(e.executionInfo.DelayedSignals.Signals, signal)
+}
+
+// GetDelayedSignal returns the pending delayed signal with the request ID
+func (e *mutableStateBuilder) GetDelayedSignal(requestID string) (*workflow.DelayedSignalInfo, bool) {
+	for _, signal := range e.executionInfo.DelayedSignals.GetSignals() {
+		if signal.GetRequestId() == requestID {
+			return signal, true
+		}
+	}
+	return nil, false
+}
+
+// DeleteDelayedSignal removes the pending delayed signal with the request ID once it is delivered
+func (e *mutableStateBuilder) DeleteDelayedSignal(requestID string) {
+	signals := e.executionInfo.DelayedSignals.GetSignals()
+	for i, signal := range signals {
+		if signal.GetRequestId() == requestID {
+			e.executionInfo.DelayedSignals.Signals = append(signals[:i], signals[i+1:]...)
+			return
+		}
+	}
+}
+
 func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(domainID string,
 	parentExecutionInfo *h.ParentExecutionInfo, execution workflow.WorkflowExecution, previousExecutionState mutableState,
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
@@ -1340,6 +1388,11 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(d
 		Header:                              attributes.Header,
 		RetryPolicy:                         attributes.RetryPolicy,
 		CronSchedule:                        attributes.CronSchedule,
+		RetentionPeriodInDays:               attributes.RetentionPeriodInDays,
+	}
+	// the workflow execution timeout covers the whole chain, so it is carried over as is
+	if isWorkflowExpirationSet(previousExecutionInfo) {
+		createRequest.WorkflowExecutionTimeoutSeconds = common.Int32Ptr(previousExecutionInfo.WorkflowExecutionTimeout)
 	}
 
 	req := &h.StartWorkflowExecutionRequest{
@@ -1368,6 +1421,9 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(d
 			req.ExpirationTimestamp = common.Int64Ptr(expirationTime.UnixNano())
 		}
 	}
+	if isWorkflowExpirationSet(previousExecutionInfo) {
+		req.WorkflowExecutionExpirationTimestamp = common.Int64Ptr(previousExecutionInfo.WorkflowExpirationTime.UnixNano())
+	}
 
 	// History event only has domainName so domainID has to be passed in explicitly to update the mutable state
 	var parentDomainID *string
@@ -1430,6 +1486,11 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(domainID st
 	e.executionInfo.DecisionTimeout = 0
 
 	e.executionInfo.CronSchedule = event.GetCronSchedule()
+	e.executionInfo.RetentionDays = event.GetRetentionPeriodInDays()
+	if event.GetWorkflowExecutionTimeoutSeconds() > 0 && event.GetWorkflowExecutionExpirationTimestamp() > 0 {
+		e.executionInfo.WorkflowExecutionTimeout = event.GetWorkflowExecutionTimeoutSeconds()
+		e.executionInfo.WorkflowExpirationTime = time.Unix(0, event.GetWorkflowExecutionExpirationTimestamp())
+	}
 
 	if parentDomainID != nil {
 		e.executionInfo.ParentDomainID = *parentDomainID
@@ -1621,6 +1682,26 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskStartedEvent(di *decisionInfo
 	return di
 }
 
+// HeartbeatDecisionTask extends the in flight decision for the worker holding it. Instead of writing completed,
+// scheduled and started events for every heartbeat, only the decision in mutable state is restarted.
+func (e *mutableStateBuilder) HeartbeatDecisionTask(scheduleEventID int64) *decisionInfo {
+	di, ok := e.GetPendingDecision(scheduleEventID)
+	if !ok || di.StartedID == common.EmptyEventID || !e.IsWorkflowExecutionRunning() {
+		e.logger.Warn(mutableStateInvalidHistoryActionMsg,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowActionDecisionTaskHeartbeat,
+			tag.WorkflowScheduleID(scheduleEventID))
+		return nil
+	}
+
+	di.HeartbeatCount++
+	// timer tasks are persisted with millisecond precision, the timeout check compares against this timestamp
+	di.HeartbeatTimestamp = e.shard.GetTimeSource().Now().Truncate(time.Millisecond).UnixNano()
+	e.UpdateDecision(di)
+	return di
+}
+
 func (e *mutableStateBuilder) CreateTransientDecisionEvents(di *decisionInfo, identity string) (*workflow.HistoryEvent,
 	*workflow.HistoryEvent) {
 	tasklist := e.executionInfo.TaskList
@@ -1639,6 +1720,8 @@ func (e *mutableStateBuilder) beforeAddDecisionTaskCompletedEvent() {
 
 func (e *mutableStateBuilder) afterAddDecisionTaskCompletedEvent(event *workflow.HistoryEvent, maxResetPoints int) {
 	e.executionInfo.LastProcessedEvent = event.GetDecisionTaskCompletedEventAttributes().GetStartedEventId()
+	// the worker build which advanced the workflow last is the one its decision tasks are routed to
+	e.executionInfo.LastBinaryChecksum = event.GetDecisionTaskCompletedEventAttributes().GetBinaryChecksum()
 	e.addBinaryCheckSumIfNotExists(event, maxResetPoints)
 }
 
@@ -2632,6 +2715,61 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(decisionCompletedEventID int6
 	return e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes)
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID int64,
+	updateID string, update *workflow.WorkflowUpdate, result []byte, identity string) *workflow.HistoryEvent {
+
+	if !e.IsWorkflowExecutionRunning() {
+		e.logger.Warn(mutableStateInvalidHistoryActionMsg,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowActionWorkflowUpdateAccepted,
+			tag.WorkflowState(e.executionInfo.State),
+		)
+		return nil
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID, updateID, update, result, identity)
+	e.ReplicateWorkflowExecutionUpdateAccepted(decisionCompletedEventID, event)
+	return event
+}
+
+// ReplicateWorkflowExecutionUpdateAccepted remembers where the accepted update is in the history, so retries of
+// the update are deduplicated with its result, this also runs on standby clusters
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateAccepted(firstEventID int64, event *workflow.HistoryEvent) {
+	maxUpdates := e.config.MaxAcceptedWorkflowUpdates(e.getDomainName())
+	if maxUpdates <= 0 {
+		e.executionInfo.AcceptedUpdates = nil
+		return
+	}
+
+	if e.executionInfo.AcceptedUpdates == nil {
+		e.executionInfo.AcceptedUpdates = &workflow.AcceptedWorkflowUpdates{}
+	}
+	// updates are appended in event ID order, the oldest ones are evicted first
+	updates := append(e.executionInfo.AcceptedUpdates.Updates, &workflow.AcceptedWorkflowUpdateInfo{
+		UpdateId:     common.StringPtr(event.WorkflowExecutionUpdateAcceptedEventAttributes.GetUpdateId()),
+		EventId:      common.Int64Ptr(event.GetEventId()),
+		EventBatchId: common.Int64Ptr(firstEventID),
+	})
+	if len(updates) > maxUpdates {
+		updates = updates[len(updates)-maxUpdates:]
+	}
+	e.executionInfo.AcceptedUpdates.Updates = updates
+}
+
+// GetWorkflowUpdateAcceptedEvent returns the event recording the accepted update, or nil if the update is not
+// known to be accepted by this run
+func (e *mutableStateBuilder) GetWorkflowUpdateAcceptedEvent(updateID string) (*workflow.HistoryEvent, error) {
+	for _, update := range e.executionInfo.AcceptedUpdates.GetUpdates() {
+		if update.GetUpdateId() == updateID {
+			return e.eventsCache.getEvent(e.executionInfo.DomainID, e.executionInfo.WorkflowID,
+				e.executionInfo.RunID, update.GetEventBatchId(), update.GetEventId(), e.executionInfo.EventStoreVersion,
+				e.executionInfo.GetCurrentBranch())
+		}
+	}
+	return nil, nil
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	request *workflow.TerminateWorkflowExecutionRequest) *workflow.HistoryEvent {
 	if !e.IsWorkflowExecutionRunning() {
@@ -2657,7 +2795,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionTerminatedEvent(firstEve
 }
 
 func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
-	signalName string, input []byte, identity string) *workflow.HistoryEvent {
+	signalName string, input []byte, identity string, requestID string) *workflow.HistoryEvent {
 
 	if !e.IsWorkflowExecutionRunning() {
 		e.logger.Warn(mutableStateInvalidHistoryActionMsg,
@@ -2669,7 +2807,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 		return nil
 	}
 
-	event := e.hBuilder.AddWorkflowExecutionSignaledEvent(signalName, input, identity)
+	event := e.hBuilder.AddWorkflowExecutionSignaledEvent(signalName, input, identity, requestID)
 	e.ReplicateWorkflowExecutionSignaled(event)
 	return event
 }
@@ -2677,6 +2815,64 @@ func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(event *workflow.HistoryEvent) {
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
+	// Remember the request ID so retries of the same signal are deduplicated, this also runs on standby clusters
+	if requestID := event.WorkflowExecutionSignaledEventAttributes.GetRequestId(); requestID != "" {
+		e.recordSignalRequestID(requestID, time.Unix(0, event.GetTimestamp()))
+	}
+}
+
+// HasAppliedSignalRequestID returns true if a signal with the request ID was applied within the dedupe window
+func (e *mutableStateBuilder) HasAppliedSignalRequestID(requestID string) bool {
+	if requestID == "" {
+		return false
+	}
+	appliedTime, ok := e.executionInfo.RecentSignalRequestIDs[requestID]
+	if !ok {
+		return false
+	}
+	window := e.config.SignalRequestIDDedupeWindow(e.getDomainName())
+	return window > 0 && e.shard.GetTimeSource().Now().Sub(appliedTime) < window
+}
+
+func (e *mutableStateBuilder) recordSignalRequestID(requestID string, appliedTime time.Time) {
+	domainName := e.getDomainName()
+	window := e.config.SignalRequestIDDedupeWindow(domainName)
+	maxRequestIDs := e.config.MaxSignalRequestIDs(domainName)
+	if window <= 0 || maxRequestIDs <= 0 {
+		e.executionInfo.RecentSignalRequestIDs = nil
+		return
+	}
+
+	if e.executionInfo.RecentSignalRequestIDs == nil {
+		e.executionInfo.RecentSignalRequestIDs = make(map[string]time.Time)
+	}
+	e.executionInfo.RecentSignalRequestIDs[requestID] = appliedTime
+
+	expiry := appliedTime.Add(-window)
+	for id, t := range e.executionInfo.RecentSignalRequestIDs {
+		if t.Before(expiry) {
+			delete(e.executionInfo.RecentSignalRequestIDs, id)
+		}
+	}
+	for len(e.executionInfo.RecentSignalRequestIDs) > maxRequestIDs {
+		oldestID := ""
+		var oldestTime time.Time
+		for id, t := range e.executionInfo.RecentSignalRequestIDs {
+			if oldestID == "" || t.Before(oldestTime) {
+				oldestID = id
+				oldestTime = t
+			}
+		}
+		delete(e.executionInfo.RecentSignalRequestIDs, oldestID)
+	}
+}
+
+func (e *mutableStateBuilder) getDomainName() string {
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(e.executionInfo.DomainID)
+	if err != nil {
+		return ""
+	}
+	return domainEntry.GetInfo().Name
 }
 
 func (e *mutableStateBuilder) AddContinueAsNewEvent(firstEventID, decisionCompletedEventID int64, domainEntry *cache.DomainCacheEntry,
@@ -2840,11 +3036,26 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(firs
 		ReplicationState:        newStateBuilder.GetReplicationState(),
 		HasRetryPolicy:          startedAttributes.RetryPolicy != nil,
 		CronSchedule:            startedAttributes.GetCronSchedule(),
+		RetentionDays:           startedAttributes.GetRetentionPeriodInDays(),
 		EventStoreVersion:       newStateBuilder.GetEventStoreVersion(),
 		BranchToken:             newStateBuilder.GetCurrentBranch(),
 		PreviousAutoResetPoints: rolloverAutoResetPointsWithExpiringTime(startedAttributes.GetPrevAutoResetPoints(), prevRunID, domainRetentionDays),
 	}
 
+	if isWorkflowExpirationSet(newExecutionInfo) {
+		continueAsNew.WorkflowExecutionTimeout = newExecutionInfo.WorkflowExecutionTimeout
+		continueAsNew.WorkflowExpirationTime = newExecutionInfo.WorkflowExpirationTime
+	}
+
+	// signal request IDs stay deduplicated across the continue as new boundary
+	if len(e.executionInfo.RecentSignalRequestIDs) > 0 {
+		newExecutionInfo.RecentSignalRequestIDs = make(map[string]time.Time, len(e.executionInfo.RecentSignalRequestIDs))
+		for requestID, appliedTime := range e.executionInfo.RecentSignalRequestIDs {
+			newExecutionInfo.RecentSignalRequestIDs[requestID] = appliedTime
+		}
+		continueAsNew.RecentSignalRequestIDs = newExecutionInfo.RecentSignalRequestIDs
+	}
+
 	if continueAsNew.HasRetryPolicy {
 		continueAsNew.InitialInterval = startedAttributes.RetryPolicy.GetInitialIntervalInSeconds()
 		continueAsNew.BackoffCoefficient = startedAttributes.RetryPolicy.GetBackoffCoefficient()
@@ -2876,6 +3087,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(firs
 		// expire before timeout
 		timeoutDeadline = continueAsNew.ExpirationTime
 	}
+	timeoutDeadline = getWorkflowTimeoutDeadline(newExecutionInfo, timeoutDeadline)
 	continueAsNew.TransferTasks = []persistence.Task{&persistence.RecordWorkflowStartedTask{}}
 	continueAsNew.TimerTasks = []persistence.Task{&persistence.WorkflowTimeoutTask{
 		VisibilityTimestamp: timeoutDeadline,
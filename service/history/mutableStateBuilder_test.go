@@ -24,13 +24,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/cron"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -293,3 +297,72 @@ func (s *mutableStateSuite) TestTrimEvents() {
 		},
 	}, output)
 }
+
+func (s *mutableStateSuite) TestGetCronBackoffDurationWithinWorkflowExpiration() {
+	executionInfo := s.msBuilder.GetExecutionInfo()
+	executionInfo.CronSchedule = "@every 1h"
+	s.NotEqual(cron.NoBackoff, s.msBuilder.GetCronBackoffDuration())
+
+	executionInfo.WorkflowExecutionTimeout = 7200
+	executionInfo.WorkflowExpirationTime = time.Now().Add(2 * time.Hour)
+	s.NotEqual(cron.NoBackoff, s.msBuilder.GetCronBackoffDuration())
+
+	// the next cron run would start after the whole chain expired
+	executionInfo.WorkflowExpirationTime = time.Now().Add(30 * time.Minute)
+	s.Equal(cron.NoBackoff, s.msBuilder.GetCronBackoffDuration())
+}
+
+func (s *mutableStateSuite) TestRecentSignalRequestIDs() {
+	domainCache := &cache.DomainCacheMock{}
+	domainCache.On("GetDomainByID", mock.Anything).Return(cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{}, nil), nil)
+	s.mockShard.domainCache = domainCache
+	s.mockShard.config.SignalRequestIDDedupeWindow = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Hour)
+	s.mockShard.config.MaxSignalRequestIDs = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+
+	now := time.Now()
+	signaledEvent := func(requestID string, appliedTime time.Time) *workflow.HistoryEvent {
+		return &workflow.HistoryEvent{
+			EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionSignaled),
+			Timestamp: common.Int64Ptr(appliedTime.UnixNano()),
+			WorkflowExecutionSignaledEventAttributes: &workflow.WorkflowExecutionSignaledEventAttributes{
+				SignalName: common.StringPtr("signal"),
+				RequestId:  common.StringPtr(requestID),
+			},
+		}
+	}
+
+	s.msBuilder.ReplicateWorkflowExecutionSignaled(signaledEvent("expired", now.Add(-2*time.Hour)))
+	s.False(s.msBuilder.HasAppliedSignalRequestID("expired"))
+
+	s.msBuilder.ReplicateWorkflowExecutionSignaled(signaledEvent("first", now.Add(-2*time.Minute)))
+	s.msBuilder.ReplicateWorkflowExecutionSignaled(signaledEvent("second", now.Add(-time.Minute)))
+	s.True(s.msBuilder.HasAppliedSignalRequestID("first"))
+	s.True(s.msBuilder.HasAppliedSignalRequestID("second"))
+	s.False(s.msBuilder.HasAppliedSignalRequestID(""))
+	s.NotContains(s.msBuilder.GetExecutionInfo().RecentSignalRequestIDs, "expired")
+
+	// the oldest request ID is evicted once the bound is reached
+	s.msBuilder.ReplicateWorkflowExecutionSignaled(signaledEvent("third", now))
+	s.Equal(2, len(s.msBuilder.GetExecutionInfo().RecentSignalRequestIDs))
+	s.False(s.msBuilder.HasAppliedSignalRequestID("first"))
+	s.True(s.msBuilder.HasAppliedSignalRequestID("third"))
+	s.Equal(int64(4), s.msBuilder.GetExecutionInfo().SignalCount)
+}
+
+func (s *mutableStateSuite) TestDelayedSignals() {
+	_, ok := s.msBuilder.GetDelayedSignal("first")
+	s.False(ok)
+
+	s.msBuilder.AddDelayedSignal(&workflow.DelayedSignalInfo{RequestId: common.StringPtr("first"), SignalName: common.StringPtr("signal")})
+	s.msBuilder.AddDelayedSignal(&workflow.DelayedSignalInfo{RequestId: common.StringPtr("second"), SignalName: common.StringPtr("signal")})
+	signal, ok := s.msBuilder.GetDelayedSignal("first")
+	s.True(ok)
+	s.Equal("signal", signal.GetSignalName())
+
+	s.msBuilder.DeleteDelayedSignal("first")
+	_, ok = s.msBuilder.GetDelayedSignal("first")
+	s.False(ok)
+	_, ok = s.msBuilder.GetDelayedSignal("second")
+	s.True(ok)
+	s.Equal(1, len(s.msBuilder.GetExecutionInfo().DelayedSignals.GetSignals()))
+}
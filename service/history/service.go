@@ -119,6 +119,19 @@ type Config struct {
 	// System Limits
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
+	// SignalRequestIDDedupeWindow is how long the request ID of an applied signal is kept for deduplication
+	SignalRequestIDDedupeWindow dynamicconfig.DurationPropertyFnWithDomainFilter
+	// MaxSignalRequestIDs is the max number of recently applied signal request IDs kept per execution
+	MaxSignalRequestIDs dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// WorkflowUpdateDefaultTimeout is used when an update request does not set its own timeout
+	WorkflowUpdateDefaultTimeout dynamicconfig.DurationPropertyFnWithDomainFilter
+	// WorkflowUpdateDedupeTTL is how long completed update results are kept for request ID deduplication
+	WorkflowUpdateDedupeTTL dynamicconfig.DurationPropertyFn
+	// WorkflowUpdateMaxDecisionAttempts is how many decisions may leave an update unanswered before it fails
+	WorkflowUpdateMaxDecisionAttempts dynamicconfig.IntPropertyFnWithDomainFilter
+	// MaxAcceptedWorkflowUpdates is the max number of accepted update IDs kept per execution
+	MaxAcceptedWorkflowUpdates dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
@@ -136,6 +149,14 @@ type Config struct {
 
 	NumArchiveSystemWorkflows dynamicconfig.IntPropertyFn
 
+	// EnableParentClosePolicy is whether the child policy is applied when a parent workflow closes
+	EnableParentClosePolicy dynamicconfig.BoolPropertyFnWithDomainFilter
+	// ParentClosePolicyThreshold is the number of children above which the parent close policy
+	// is applied by the system worker instead of the transfer queue processor
+	ParentClosePolicyThreshold          dynamicconfig.IntPropertyFnWithDomainFilter
+	EnableParentClosePolicyWorker       dynamicconfig.BoolPropertyFn
+	NumParentClosePolicySystemWorkflows dynamicconfig.IntPropertyFn
+
 	BlobSizeLimitError     dynamicconfig.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn      dynamicconfig.IntPropertyFnWithDomainFilter
 	HistorySizeLimitError  dynamicconfig.IntPropertyFnWithDomainFilter
@@ -143,7 +164,24 @@ type Config struct {
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// payloads above the offload threshold are written to the blobstore bucket and referenced from history
+	PayloadOffloadThreshold              dynamicconfig.IntPropertyFnWithDomainFilter
+	PayloadOffloadSizeLimitError         dynamicconfig.IntPropertyFnWithDomainFilter
+	PayloadOffloadBucket                 dynamicconfig.StringPropertyFnWithDomainFilter
+	EnablePayloadOffloadForGlobalDomains dynamicconfig.BoolPropertyFnWithDomainFilter
+
+	// running executions of a workflow type are limited per domain, starts over the limit are rejected or queued
+	WorkflowTypeConcurrencyLimit              dynamicconfig.IntPropertyFnWithWorkflowTypeFilter
+	WorkflowTypeConcurrencyLimitMode          dynamicconfig.StringPropertyFnWithDomainFilter
+	WorkflowConcurrencyQueueRetryInterval     dynamicconfig.DurationPropertyFnWithDomainFilter
+	WorkflowConcurrencyCountCacheTTL          dynamicconfig.DurationPropertyFn
+	WorkflowConcurrencyCountReconcileInterval dynamicconfig.DurationPropertyFn
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
+
+	// MinWorkflowRetentionDays and MaxWorkflowRetentionDays bound the per workflow retention
+	MinWorkflowRetentionDays dynamicconfig.IntPropertyFn
+	MaxWorkflowRetentionDays dynamicconfig.IntPropertyFn
 }
 
 const (
@@ -214,6 +252,12 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		HistoryMgrNumConns:                                    dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
 		MaximumBufferedEventsBatch:                            dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
 		MaximumSignalsPerExecution:                            dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution, 0),
+		SignalRequestIDDedupeWindow:                           dc.GetDurationPropertyFilteredByDomain(dynamicconfig.SignalRequestIDDedupeWindow, 24*time.Hour),
+		MaxSignalRequestIDs:                                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxSignalRequestIDs, 100),
+		WorkflowUpdateDefaultTimeout:                          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.WorkflowUpdateDefaultTimeout, 20*time.Second),
+		WorkflowUpdateDedupeTTL:                               dc.GetDurationProperty(dynamicconfig.WorkflowUpdateDedupeTTL, 10*time.Minute),
+		WorkflowUpdateMaxDecisionAttempts:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.WorkflowUpdateMaxDecisionAttempts, 3),
+		MaxAcceptedWorkflowUpdates:                            dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxAcceptedWorkflowUpdates, 100),
 		ShardUpdateMinInterval:                                dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
 		ShardSyncMinInterval:                                  dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
 
@@ -224,6 +268,11 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 
 		NumArchiveSystemWorkflows: dc.GetIntProperty(dynamicconfig.NumArchiveSystemWorkflows, 1000),
 
+		EnableParentClosePolicy:             dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableParentClosePolicy, false),
+		ParentClosePolicyThreshold:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.ParentClosePolicyThreshold, 10),
+		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
+		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
+
 		BlobSizeLimitError:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 256*1024),
 		HistorySizeLimitError:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitError, 200*1024*1024),
@@ -231,7 +280,21 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
 
+		PayloadOffloadThreshold:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadThreshold, 0),
+		PayloadOffloadSizeLimitError:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadSizeLimitError, 64*1024*1024),
+		PayloadOffloadBucket:                 dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.PayloadOffloadBucket, ""),
+		EnablePayloadOffloadForGlobalDomains: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnablePayloadOffloadForGlobalDomains, false),
+
+		WorkflowTypeConcurrencyLimit:              dc.GetIntPropertyFilteredByWorkflowType(dynamicconfig.WorkflowTypeConcurrencyLimit, 0),
+		WorkflowTypeConcurrencyLimitMode:          dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.WorkflowTypeConcurrencyLimitMode, workflowConcurrencyLimitModeReject),
+		WorkflowConcurrencyQueueRetryInterval:     dc.GetDurationPropertyFilteredByDomain(dynamicconfig.WorkflowConcurrencyQueueRetryInterval, 10*time.Second),
+		WorkflowConcurrencyCountCacheTTL:          dc.GetDurationProperty(dynamicconfig.WorkflowConcurrencyCountCacheTTL, 5*time.Second),
+		WorkflowConcurrencyCountReconcileInterval: dc.GetDurationProperty(dynamicconfig.WorkflowConcurrencyCountReconcileInterval, time.Hour),
+
 		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 20),
+
+		MinWorkflowRetentionDays: dc.GetIntProperty(dynamicconfig.MinWorkflowRetentionDays, 1),
+		MaxWorkflowRetentionDays: dc.GetIntProperty(dynamicconfig.MaxWorkflowRetentionDays, 3650),
 	}
 
 	return cfg
@@ -325,7 +388,7 @@ func (s *Service) Start() {
 		log.Fatal("Creating historyV2 manager persistence failed", tag.Error(err))
 	}
 
-	handler := NewHandler(base, s.config, shardMgr, metadata, visibility, history, historyV2, pFactory, params.PublicClient)
+	handler := NewHandler(base, s.config, shardMgr, metadata, visibility, history, historyV2, pFactory, params.PublicClient, params.BlobstoreClient)
 	handler.RegisterHandler()
 
 	// must start base service first
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -34,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 )
@@ -47,6 +49,7 @@ type (
 		GetHistoryManager() persistence.HistoryManager
 		GetHistoryV2Manager() persistence.HistoryV2Manager
 		GetDomainCache() cache.DomainCache
+		GetPayloadStore() payload.Store
 		GetNextTransferTaskID() (int64, error)
 		GetTransferTaskIDs(number int) ([]int64, error)
 		GetTransferMaxReadLevel() int64
@@ -68,6 +71,9 @@ type (
 		GetAllTimerFailoverLevels() map[string]persistence.TimerFailoverLevel
 		GetDomainNotificationVersion() int64
 		UpdateDomainNotificationVersion(domainNotificationVersion int64) error
+		UpdateRunningWorkflowCount(domainID string, workflowType string, delta int64)
+		GetRunningWorkflowCounts(domainID string) map[string]int64
+		SetRunningWorkflowCount(domainID string, workflowType string, count int64)
 		CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
 			*persistence.CreateWorkflowExecutionResponse, error)
 		UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error)
@@ -99,6 +105,7 @@ type (
 		historyV2Mgr     persistence.HistoryV2Manager
 		executionManager persistence.ExecutionManager
 		domainCache      cache.DomainCache
+		payloadStore     payload.Store
 		eventsCache      eventsCache
 		closeCh          chan<- int
 		isClosed         bool
@@ -152,6 +159,10 @@ func (s *shardContextImpl) GetDomainCache() cache.DomainCache {
 	return s.domainCache
 }
 
+func (s *shardContextImpl) GetPayloadStore() payload.Store {
+	return s.payloadStore
+}
+
 func (s *shardContextImpl) GetNextTransferTaskID() (int64, error) {
 	s.Lock()
 	defer s.Unlock()
@@ -346,6 +357,73 @@ func (s *shardContextImpl) UpdateDomainNotificationVersion(domainNotificationVer
 	return s.updateShardInfoLocked()
 }
 
+// UpdateRunningWorkflowCount adjusts the number of running executions of a workflow type in a domain on this shard.
+// The counts are persisted together with the rest of the shard info on the next (throttled) shard update.
+func (s *shardContextImpl) UpdateRunningWorkflowCount(domainID string, workflowType string, delta int64) {
+	if delta == 0 {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.RunningWorkflowCounts == nil {
+		s.shardInfo.RunningWorkflowCounts = make(map[string]map[string]int64)
+	}
+	counts, ok := s.shardInfo.RunningWorkflowCounts[domainID]
+	if !ok {
+		counts = make(map[string]int64)
+		s.shardInfo.RunningWorkflowCounts[domainID] = counts
+	}
+	count := counts[workflowType] + delta
+	if count <= 0 {
+		delete(counts, workflowType)
+		if len(counts) == 0 {
+			delete(s.shardInfo.RunningWorkflowCounts, domainID)
+		}
+		return
+	}
+	counts[workflowType] = count
+}
+
+// SetRunningWorkflowCount overwrites the number of running executions of a workflow type in a domain on this shard,
+// it corrects counts which drifted because deltas applied after the last shard update were lost with a shard move.
+func (s *shardContextImpl) SetRunningWorkflowCount(domainID string, workflowType string, count int64) {
+	s.Lock()
+	defer s.Unlock()
+
+	counts, ok := s.shardInfo.RunningWorkflowCounts[domainID]
+	if count <= 0 {
+		if ok {
+			delete(counts, workflowType)
+			if len(counts) == 0 {
+				delete(s.shardInfo.RunningWorkflowCounts, domainID)
+			}
+		}
+		return
+	}
+	if s.shardInfo.RunningWorkflowCounts == nil {
+		s.shardInfo.RunningWorkflowCounts = make(map[string]map[string]int64)
+	}
+	if !ok {
+		counts = make(map[string]int64)
+		s.shardInfo.RunningWorkflowCounts[domainID] = counts
+	}
+	counts[workflowType] = count
+}
+
+// GetRunningWorkflowCounts returns the number of running executions per workflow type of a domain on this shard
+func (s *shardContextImpl) GetRunningWorkflowCounts(domainID string) map[string]int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	ret := make(map[string]int64)
+	for workflowType, count := range s.shardInfo.RunningWorkflowCounts[domainID] {
+		ret[workflowType] = count
+	}
+	return ret
+}
+
 func (s *shardContextImpl) GetTimerMaxReadLevel(cluster string) time.Time {
 	s.RLock()
 	defer s.RUnlock()
@@ -461,6 +539,34 @@ func (s *shardContextImpl) getDefaultEncoding(domainEntry *cache.DomainCacheEntr
 	return common.EncodingType(s.config.EventEncodingType(domainEntry.GetInfo().Name))
 }
 
+// offloadPayloads moves the payloads above the domain's offload threshold to the blobstore
+// so that only a reference to them is persisted in history. The returned events with offloaded
+// payloads are copies, the in memory events keep their payloads.
+func (s *shardContextImpl) offloadPayloads(domainEntry *cache.DomainCacheEntry, domainID string,
+	execution shared.WorkflowExecution, events []*shared.HistoryEvent) ([]*shared.HistoryEvent, error) {
+
+	domainName := domainEntry.GetInfo().Name
+	threshold := s.config.PayloadOffloadThreshold(domainName)
+	bucket := s.config.PayloadOffloadBucket(domainName)
+	if threshold <= 0 || len(bucket) == 0 {
+		return events, nil
+	}
+	if domainEntry.IsGlobalDomain() && !s.config.EnablePayloadOffloadForGlobalDomains(domainName) {
+		return events, nil
+	}
+	offloaded, err := s.payloadStore.OffloadEvents(context.Background(), bucket, threshold, domainID,
+		execution.GetWorkflowId(), execution.GetRunId(), events)
+	if err != nil {
+		s.logger.Error("Failed to offload payloads to blobstore",
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.WorkflowDomainID(domainID),
+			tag.Error(err))
+		return nil, err
+	}
+	return offloaded, nil
+}
+
 func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
 
 	// do not try to get domain cache within shard lock
@@ -470,6 +576,20 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry)
 
+	// buffered events are kept in the mutable state until the in-flight decision completes,
+	// their payloads must not be kept there
+	if len(request.NewBufferedEvents) > 0 {
+		execution := shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(request.ExecutionInfo.WorkflowID),
+			RunId:      common.StringPtr(request.ExecutionInfo.RunID),
+		}
+		bufferedEvents, err := s.offloadPayloads(domainEntry, request.ExecutionInfo.DomainID, execution, request.NewBufferedEvents)
+		if err != nil {
+			return nil, err
+		}
+		request.NewBufferedEvents = bufferedEvents
+	}
+
 	s.Lock()
 	defer s.Unlock()
 
@@ -741,6 +861,11 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry)
 	request.ShardID = common.IntPtr(s.shardID)
+	events, err := s.offloadPayloads(domainEntry, domainID, execution, request.Events)
+	if err != nil {
+		return 0, err
+	}
+	request.Events = events
 	size := 0
 	defer func() {
 		// N.B. - Dual emit here makes sense so that we can see aggregate timer stats across all
@@ -771,6 +896,11 @@ func (s *shardContextImpl) AppendHistoryEvents(request *persistence.AppendHistor
 		return 0, err
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry)
+	events, err := s.offloadPayloads(domainEntry, request.DomainID, request.Execution, request.Events)
+	if err != nil {
+		return 0, err
+	}
+	request.Events = events
 
 	size := 0
 	defer func() {
@@ -1161,6 +1291,7 @@ func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContex
 		historyV2Mgr:              shardItem.historyV2Mgr,
 		executionManager:          shardItem.executionMgr,
 		domainCache:               shardItem.domainCache,
+		payloadStore:              shardItem.payloadStore,
 		shardInfo:                 updatedShardInfo,
 		closeCh:                   closeCh,
 		metricsClient:             shardItem.metricsClient,
@@ -1197,6 +1328,14 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 	for k, v := range shardInfo.ClusterTimerAckLevel {
 		clusterTimerAckLevel[k] = v
 	}
+	runningWorkflowCounts := make(map[string]map[string]int64)
+	for domainID, counts := range shardInfo.RunningWorkflowCounts {
+		countsCopy := make(map[string]int64, len(counts))
+		for workflowType, count := range counts {
+			countsCopy[workflowType] = count
+		}
+		runningWorkflowCounts[domainID] = countsCopy
+	}
 	shardInfoCopy := &persistence.ShardInfo{
 		ShardID:                   shardInfo.ShardID,
 		Owner:                     shardInfo.Owner,
@@ -1210,6 +1349,7 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 		ClusterTransferAckLevel:   clusterTransferAckLevel,
 		ClusterTimerAckLevel:      clusterTimerAckLevel,
 		DomainNotificationVersion: shardInfo.DomainNotificationVersion,
+		RunningWorkflowCounts:     runningWorkflowCounts,
 	}
 
 	return shardInfoCopy
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 )
@@ -51,6 +52,7 @@ type (
 		historyV2Mgr        persistence.HistoryV2Manager
 		executionMgrFactory persistence.ExecutionManagerFactory
 		domainCache         cache.DomainCache
+		payloadStore        payload.Store
 		engineFactory       EngineFactory
 		shardClosedCh       chan int
 		isStarted           int32
@@ -79,6 +81,7 @@ type (
 		historyV2Mgr    persistence.HistoryV2Manager
 		executionMgr    persistence.ExecutionManager
 		domainCache     cache.DomainCache
+		payloadStore    payload.Store
 		engineFactory   EngineFactory
 		host            *membership.HostInfo
 		engine          Engine
@@ -97,7 +100,7 @@ const (
 
 func newShardController(svc service.Service, host *membership.HostInfo, resolver membership.ServiceResolver,
 	shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, domainCache cache.DomainCache,
-	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory,
+	executionMgrFactory persistence.ExecutionManagerFactory, payloadStore payload.Store, factory EngineFactory,
 	config *Config, logger log.Logger, metricsClient metrics.Client) *shardController {
 	logger = logger.WithTags(tag.ComponentShardController)
 	return &shardController{
@@ -110,6 +113,7 @@ func newShardController(svc service.Service, host *membership.HostInfo, resolver
 		historyV2Mgr:        historyV2Mgr,
 		executionMgrFactory: executionMgrFactory,
 		domainCache:         domainCache,
+		payloadStore:        payloadStore,
 		engineFactory:       factory,
 		historyShards:       make(map[int]*historyShardsItem),
 		shardClosedCh:       make(chan int, config.NumberOfShards),
@@ -123,7 +127,7 @@ func newShardController(svc service.Service, host *membership.HostInfo, resolver
 
 func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence.ShardManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, domainCache cache.DomainCache,
-	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory, host *membership.HostInfo,
+	executionMgrFactory persistence.ExecutionManagerFactory, payloadStore payload.Store, factory EngineFactory, host *membership.HostInfo,
 	config *Config, logger log.Logger, throttledLog log.Logger, metricsClient metrics.Client) (*historyShardsItem, error) {
 
 	executionMgr, err := executionMgrFactory.NewExecutionManager(shardID)
@@ -140,6 +144,7 @@ func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence
 		historyV2Mgr:    historyV2Mgr,
 		executionMgr:    executionMgr,
 		domainCache:     domainCache,
+		payloadStore:    payloadStore,
 		engineFactory:   factory,
 		host:            host,
 		config:          config,
@@ -241,7 +246,7 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShar
 
 	if info.Identity() == c.host.Identity() {
 		shardItem, err := newHistoryShardsItem(shardID, c.service, c.shardMgr, c.historyMgr, c.historyV2Mgr, c.domainCache,
-			c.executionMgrFactory, c.engineFactory, c.host, c.config, c.logger, c.throttledLoggger, c.metricsClient)
+			c.executionMgrFactory, c.payloadStore, c.engineFactory, c.host, c.config, c.logger, c.throttledLoggger, c.metricsClient)
 		if err != nil {
 			return nil, err
 		}
@@ -358,6 +358,9 @@ func (b *stateBuilderImpl) applyEvents(domainID, requestID string, execution sha
 		case shared.EventTypeMarkerRecorded:
 			// No mutable state action is needed
 
+		case shared.EventTypeWorkflowExecutionUpdateAccepted:
+			b.msBuilder.ReplicateWorkflowExecutionUpdateAccepted(firstEvent.GetEventId(), event)
+
 		case shared.EventTypeWorkflowExecutionSignaled:
 			b.msBuilder.ReplicateWorkflowExecutionSignaled(event)
 
@@ -558,15 +561,25 @@ func (b *stateBuilderImpl) scheduleWorkflowTimerTask(event *shared.HistoryEvent,
 	timeout := now.Add(time.Duration(msBuilder.GetExecutionInfo().WorkflowTimeout) * time.Second)
 
 	cronSchedule := b.msBuilder.GetExecutionInfo().CronSchedule
-	cronBackoffDuration := cron.GetBackoffForNextSchedule(cronSchedule, now)
-	if cronBackoffDuration != cron.NoBackoff {
-		timeout = timeout.Add(cronBackoffDuration)
+	backoffDuration := cron.GetBackoffForNextSchedule(cronSchedule, now)
+	backoffTimeoutType := persistence.WorkflowBackoffTimeoutTypeCron
+	// the backoff of a new workflow already includes the delayed start, if any
+	attributes := event.WorkflowExecutionStartedEventAttributes
+	if attributes.GetContinuedExecutionRunId() == "" && attributes.GetFirstDecisionTaskBackoffSeconds() > 0 {
+		backoffDuration = time.Duration(attributes.GetFirstDecisionTaskBackoffSeconds()) * time.Second
+		if cronSchedule == "" {
+			backoffTimeoutType = persistence.WorkflowBackoffTimeoutTypeDelayStart
+		}
+	}
+	if backoffDuration != cron.NoBackoff {
+		timeout = timeout.Add(backoffDuration)
 		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
-			VisibilityTimestamp: now.Add(cronBackoffDuration),
-			TimeoutType:         persistence.WorkflowBackoffTimeoutTypeCron,
+			VisibilityTimestamp: now.Add(backoffDuration),
+			TimeoutType:         backoffTimeoutType,
 		})
 	}
 
+	timeout = getWorkflowTimeoutDeadline(msBuilder.GetExecutionInfo(), timeout)
 	timerTasks = append(timerTasks, &persistence.WorkflowTimeoutTask{VisibilityTimestamp: timeout})
 	return timerTasks
 }
@@ -581,6 +594,7 @@ func (b *stateBuilderImpl) scheduleDeleteHistoryTimerTask(event *shared.HistoryE
 	} else {
 		retentionInDays = domainEntry.GetRetentionDays(workflowID)
 	}
+	retentionInDays = getWorkflowRetentionDays(b.shard.GetConfig(), b.msBuilder.GetExecutionInfo(), retentionInDays)
 	return b.getTimerBuilder(event).createDeleteHistoryEventTimerTask(time.Duration(retentionInDays) * time.Hour * 24), nil
 }
 
@@ -21,10 +21,12 @@
 package history
 
 import (
+	ctx "context"
 	"fmt"
 	"time"
 
 	"github.com/pborman/uuid"
+	h "github.com/uber/cadence/.gen/go/history"
 	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/matching"
@@ -242,6 +244,12 @@ func (t *timerQueueActiveProcessorImpl) process(timerTask *persistence.TimerTask
 		}
 		return metrics.TimerActiveTaskWorkflowBackoffTimerScope, err
 
+	case persistence.TaskTypeDelayedSignal:
+		if shouldProcessTask {
+			err = t.processDelayedSignal(timerTask)
+		}
+		return metrics.TimerActiveTaskDelayedSignalScope, err
+
 	case persistence.TaskTypeDeleteHistoryEvent:
 		if shouldProcessTask {
 			err = t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
@@ -512,7 +520,7 @@ Update_History_Loop:
 		switch task.TimeoutType {
 		case int(workflow.TimeoutTypeStartToClose):
 			t.metricsClient.IncCounter(metrics.TimerActiveTaskDecisionTimeoutScope, metrics.StartToCloseTimeoutCounter)
-			if di.Attempt == task.ScheduleAttempt {
+			if di.Attempt == task.ScheduleAttempt && !isDecisionTimeoutExtended(di, task) {
 				// Add a decision task timeout event.
 				msBuilder.AddDecisionTaskTimedOutEvent(scheduleID, di.StartedID)
 				scheduleNewDecision = true
@@ -558,9 +566,14 @@ func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(task *persis
 	}
 	defer func() { release(retError) }()
 
-	if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeRetry {
+	switch task.TimeoutType {
+	case persistence.WorkflowBackoffTimeoutTypeRetry:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
-	} else {
+	case persistence.WorkflowBackoffTimeoutTypeDelayStart:
+		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowDelayStartBackoffTimerCount)
+	case persistence.WorkflowBackoffTimeoutTypeConcurrencyLimit:
+		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowConcurrencyLimitBackoffTimerCount)
+	default:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowCronBackoffTimerCount)
 	}
 
@@ -578,8 +591,20 @@ Update_History_Loop:
 			return nil
 		}
 
-		// schedule first decision task
-		err = t.updateWorkflowExecution(context, msBuilder, true, false, nil)
+		hasCapacity, backoff, err := t.hasWorkflowConcurrencyCapacity(msBuilder)
+		if err != nil {
+			return err
+		}
+		if hasCapacity {
+			// schedule first decision task
+			err = t.updateWorkflowExecution(context, msBuilder, true, false, nil)
+		} else {
+			// the workflow type is at its concurrency limit, check again later
+			err = t.updateWorkflowExecution(context, msBuilder, false, false, []persistence.Task{&persistence.WorkflowBackoffTimerTask{
+				VisibilityTimestamp: t.now().Add(backoff),
+				TimeoutType:         persistence.WorkflowBackoffTimeoutTypeConcurrencyLimit,
+			}})
+		}
 		if err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
@@ -591,6 +616,128 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
+// hasWorkflowConcurrencyCapacity returns whether the first decision of a top level workflow can be scheduled
+// without going over the concurrency limit of its workflow type, and otherwise when to check again
+func (t *timerQueueActiveProcessorImpl) hasWorkflowConcurrencyCapacity(msBuilder mutableState) (bool, time.Duration, error) {
+	executionInfo := msBuilder.GetExecutionInfo()
+	if executionInfo.ParentDomainID != "" || t.historyService.concurrencyLimiter == nil {
+		return true, 0, nil
+	}
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(executionInfo.DomainID)
+	if err != nil {
+		return false, 0, err
+	}
+	domainName := domainEntry.GetInfo().Name
+	config := t.shard.GetConfig()
+	limit := config.WorkflowTypeConcurrencyLimit(domainName, executionInfo.WorkflowTypeName)
+	if limit <= 0 {
+		return true, 0, nil
+	}
+
+	hasCapacity, err := t.historyService.concurrencyLimiter.tryAcquire(executionInfo.DomainID, executionInfo.WorkflowTypeName, limit)
+	if err != nil {
+		return false, 0, err
+	}
+	backoff := time.Duration(getWorkflowConcurrencyQueueBackoffSeconds(config, domainName)) * time.Second
+	return hasCapacity, backoff, nil
+}
+
+func (t *timerQueueActiveProcessorImpl) processDelayedSignal(task *persistence.TimerTaskInfo) error {
+	domainID, execution := t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task)
+	context, release, err := t.cache.getOrCreateWorkflowExecution(domainID, execution)
+	if err != nil {
+		return err
+	}
+	msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+	if err != nil {
+		release(err)
+		return err
+	}
+	var signal *workflow.DelayedSignalInfo
+	pending := false
+	if msBuilder != nil {
+		signal, pending = msBuilder.GetDelayedSignal(task.SignalRequestID)
+	}
+	// release the lock before signaling, the target could be this run
+	release(nil)
+	if !pending {
+		// the signal was already delivered
+		return nil
+	}
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// domain is deleted, nothing to deliver
+			return nil
+		}
+		return err
+	}
+
+	// an empty run ID signals the current run, so the signal survives continue as new and retries
+	target := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(signal.GetRunId()),
+	}
+	err = t.historyService.SignalWorkflowExecution(ctx.Background(), &h.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainEntry.GetInfo().Name),
+			WorkflowExecution: &target,
+			SignalName:        common.StringPtr(signal.GetSignalName()),
+			Input:             signal.Input,
+			Identity:          common.StringPtr(signal.GetIdentity()),
+			RequestId:         common.StringPtr(signal.GetRequestId()),
+		},
+	})
+	switch err.(type) {
+	case nil:
+	case *workflow.EntityNotExistsError, *workflow.LimitExceededError:
+		// the workflow is gone or cannot accept more signals, the signal is dropped
+		t.logger.Info("Dropping delayed signal.",
+			tag.WorkflowDomainID(domainID),
+			tag.WorkflowID(target.GetWorkflowId()),
+			tag.WorkflowRunID(target.GetRunId()),
+			tag.Error(err))
+	default:
+		return err
+	}
+
+	return t.deleteDelayedSignal(task)
+}
+
+func (t *timerQueueActiveProcessorImpl) deleteDelayedSignal(task *persistence.TimerTaskInfo) (retError error) {
+	context, release, err0 := t.cache.getOrCreateWorkflowExecution(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task))
+	if err0 != nil {
+		return err0
+	}
+	defer func() { release(retError) }()
+
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+		if err != nil {
+			return err
+		} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
+			return nil
+		}
+		if _, ok := msBuilder.GetDelayedSignal(task.SignalRequestID); !ok {
+			return nil
+		}
+
+		msBuilder.DeleteDelayedSignal(task.SignalRequestID)
+		err = t.updateWorkflowExecution(context, msBuilder, false, false, nil)
+		if err != nil {
+			if err == ErrConflict {
+				continue
+			}
+			return err
+		}
+		return nil
+	}
+	return ErrMaxAttemptsExceeded
+}
+
 func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persistence.TimerTaskInfo) error {
 
 	processFn := func() error {
@@ -660,6 +807,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persiste
 			TaskList:                      taskList,
 			ScheduleId:                    &scheduledID,
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+			ActivityType:                  common.StringPtr(scheduledEvent.ActivityTaskScheduledEventAttributes.GetActivityType().GetName()),
 		})
 
 		t.logger.Debug(fmt.Sprintf("Adding ActivityTask for retry, WorkflowID: %v, RunID: %v, ScheduledID: %v, TaskList: %v, Attempt: %v, Err: %v",
@@ -713,6 +861,10 @@ Update_History_Loop:
 			backoffInterval = msBuilder.GetCronBackoffDuration()
 			continueAsNewInitiator = workflow.ContinueAsNewInitiatorCronSchedule
 		}
+		if isWorkflowExpired(msBuilder.GetExecutionInfo(), task.VisibilityTimestamp) {
+			// the whole workflow execution chain has expired, neither retry nor cron applies
+			backoffInterval = cron.NoBackoff
+		}
 		if backoffInterval == cron.NoBackoff {
 			if e := msBuilder.AddTimeoutWorkflowEvent(); e == nil {
 				// If we failed to add the event that means the workflow is already completed.
@@ -738,17 +890,22 @@ Update_History_Loop:
 		}
 
 		startAttributes := startEvent.WorkflowExecutionStartedEventAttributes
+		startInput, err := resolvePayload(t.shard, startAttributes.Input, startAttributes.InputReference)
+		if err != nil {
+			return err
+		}
 		continueAsnewAttributes := &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
 			WorkflowType:                        startAttributes.WorkflowType,
 			TaskList:                            startAttributes.TaskList,
 			RetryPolicy:                         startAttributes.RetryPolicy,
-			Input:                               startAttributes.Input,
+			Input:                               startInput,
 			ExecutionStartToCloseTimeoutSeconds: startAttributes.ExecutionStartToCloseTimeoutSeconds,
 			TaskStartToCloseTimeoutSeconds:      startAttributes.TaskStartToCloseTimeoutSeconds,
 			BackoffStartIntervalInSeconds:       common.Int32Ptr(int32(backoffInterval.Seconds())),
 			Initiator:                           continueAsNewInitiator.Ptr(),
 			FailureReason:                       common.StringPtr(timeoutReason),
 			CronSchedule:                        common.StringPtr(msBuilder.GetExecutionInfo().CronSchedule),
+			RetentionPeriodInDays:               startAttributes.RetentionPeriodInDays,
 		}
 		domainEntry, err := getActiveDomainEntryFromShard(t.shard, &domainID)
 		if err != nil {
@@ -768,8 +925,7 @@ Update_History_Loop:
 		var transferTasks, timerTasks []persistence.Task
 		tranT, timerT, err := getWorkflowHistoryCleanupTasksFromShard(
 			t.shard,
-			domainID,
-			executionInfo.GetWorkflowId(),
+			msBuilder.GetExecutionInfo(),
 			tBuilder)
 		if err != nil {
 			return err
@@ -819,8 +975,7 @@ func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 	if createDeletionTask {
 		tBuilder := t.historyService.getTimerBuilder(context.getExecution())
 		tranT, timerT, err := t.historyService.getWorkflowHistoryCleanupTasks(
-			executionInfo.DomainID,
-			executionInfo.WorkflowID,
+			executionInfo,
 			tBuilder)
 		if err != nil {
 			return err
@@ -848,3 +1003,12 @@ func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 	t.historyService.timerProcessor.NotifyNewTimers(t.currentClusterName, t.shard.GetCurrentTime(t.currentClusterName), timerTasks)
 	return nil
 }
+
+// isDecisionTimeoutExtended returns true if the decision was heartbeated after the timeout timer was created
+func isDecisionTimeoutExtended(di *decisionInfo, task *persistence.TimerTaskInfo) bool {
+	if di.HeartbeatTimestamp == 0 {
+		return false
+	}
+	deadline := time.Unix(0, di.HeartbeatTimestamp).Add(time.Duration(di.DecisionTimeout) * time.Second)
+	return task.VisibilityTimestamp.Before(deadline)
+}
@@ -21,9 +21,11 @@
 package history
 
 import (
+	ctx "context"
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -37,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/common/tokenbucket"
@@ -265,6 +268,12 @@ func (t *timerQueueProcessorBase) notifyNewTimers(timerTasks []persistence.Task)
 			} else {
 				t.metricsClient.IncCounter(metrics.TimerStandbyTaskWorkflowBackoffTimerScope, metrics.NewTimerCounter)
 			}
+		case persistence.TaskTypeDelayedSignal:
+			if isActive {
+				t.metricsClient.IncCounter(metrics.TimerActiveTaskDelayedSignalScope, metrics.NewTimerCounter)
+			} else {
+				t.metricsClient.IncCounter(metrics.TimerStandbyTaskDelayedSignalScope, metrics.NewTimerCounter)
+			}
 			// TODO add default
 		}
 	}
@@ -589,26 +598,31 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 		return err
 	}
 	domainArchivalStatus := domainCacheEntry.GetConfig().ArchivalStatus
+	payloadBuckets, err := t.getWorkflowPayloadBuckets(task, msBuilder)
+	if err != nil {
+		return err
+	}
 	switch clusterArchivalStatus {
 	case cluster.ArchivalDisabled:
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
-		return t.deleteWorkflow(task, msBuilder, context)
+		return t.deleteWorkflow(task, msBuilder, context, payloadBuckets)
 	case cluster.ArchivalPaused:
 		// TODO: @dandrew once archival backfill is in place cluster:paused && domain:enabled should be a nop rather than a delete
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
-		return t.deleteWorkflow(task, msBuilder, context)
+		return t.deleteWorkflow(task, msBuilder, context, payloadBuckets)
 	case cluster.ArchivalEnabled:
 		if domainArchivalStatus == workflow.ArchivalStatusDisabled {
 			t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
-			return t.deleteWorkflow(task, msBuilder, context)
+			return t.deleteWorkflow(task, msBuilder, context, payloadBuckets)
 		}
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupArchiveCount)
-		return t.archiveWorkflow(task, msBuilder, context)
+		return t.archiveWorkflow(task, msBuilder, context, payloadBuckets)
 	}
 	return nil
 }
 
-func (t *timerQueueProcessorBase) deleteWorkflow(task *persistence.TimerTaskInfo, msBuilder mutableState, context workflowExecutionContext) error {
+func (t *timerQueueProcessorBase) deleteWorkflow(task *persistence.TimerTaskInfo, msBuilder mutableState,
+	context workflowExecutionContext, payloadBuckets []string) error {
 	if err := t.deleteCurrentWorkflowExecution(task); err != nil {
 		return err
 	}
@@ -621,6 +635,10 @@ func (t *timerQueueProcessorBase) deleteWorkflow(task *persistence.TimerTaskInfo
 		return err
 	}
 
+	if err := t.deleteWorkflowPayloads(task, payloadBuckets); err != nil {
+		return err
+	}
+
 	if err := t.deleteWorkflowVisibility(task); err != nil {
 		return err
 	}
@@ -630,7 +648,8 @@ func (t *timerQueueProcessorBase) deleteWorkflow(task *persistence.TimerTaskInfo
 	return nil
 }
 
-func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInfo, msBuilder mutableState, context workflowExecutionContext) error {
+func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInfo, msBuilder mutableState,
+	context workflowExecutionContext, payloadBuckets []string) error {
 	req := &archiver.ArchiveRequest{
 		ShardID:              t.shard.GetShardID(),
 		DomainID:             task.DomainID,
@@ -640,6 +659,7 @@ func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInf
 		BranchToken:          msBuilder.GetCurrentBranch(),
 		NextEventID:          msBuilder.GetNextEventID(),
 		CloseFailoverVersion: msBuilder.GetLastWriteVersion(),
+		PayloadBuckets:       payloadBuckets,
 	}
 
 	// send signal before deleting mutable state to make sure archival is idempotent
@@ -713,6 +733,62 @@ func (t *timerQueueProcessorBase) deleteWorkflowHistory(task *persistence.TimerT
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
+// getWorkflowPayloadBuckets returns the blobstore buckets the payloads of the workflow were offloaded to,
+// they are taken from the payload references in its history since the configured bucket may have changed
+func (t *timerQueueProcessorBase) getWorkflowPayloadBuckets(task *persistence.TimerTaskInfo, msBuilder mutableState) ([]string, error) {
+	if !t.shard.GetPayloadStore().Enabled() {
+		return nil, nil
+	}
+	buckets := make(map[string]struct{})
+	var token []byte
+	for {
+		events, _, nextToken, _, err := PaginateHistory(
+			t.historyService.historyMgr,
+			t.historyService.historyV2Mgr,
+			t.metricsClient,
+			t.logger,
+			false,
+			task.DomainID,
+			task.WorkflowID,
+			task.RunID,
+			common.FirstEventID,
+			msBuilder.GetNextEventID(),
+			token,
+			msBuilder.GetEventStoreVersion(),
+			msBuilder.GetCurrentBranch(),
+			defaultHistoryPageSize,
+			common.IntPtr(t.shard.GetShardID()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		payload.AddReferencedBuckets(buckets, events)
+		if len(nextToken) == 0 {
+			break
+		}
+		token = nextToken
+	}
+
+	result := make([]string, 0, len(buckets))
+	for bucket := range buckets {
+		result = append(result, bucket)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// deleteWorkflowPayloads deletes the payloads which were offloaded to the blobstore,
+// the blobstore client already retries transient errors
+func (t *timerQueueProcessorBase) deleteWorkflowPayloads(task *persistence.TimerTaskInfo, payloadBuckets []string) error {
+	for _, bucket := range payloadBuckets {
+		err := t.shard.GetPayloadStore().DeleteExecution(ctx.Background(), bucket, task.DomainID, task.WorkflowID, task.RunID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t *timerQueueProcessorBase) deleteWorkflowVisibility(task *persistence.TimerTaskInfo) error {
 	op := func() error {
 		return t.historyService.DeleteExecutionFromVisibility(task)
@@ -736,6 +812,8 @@ func (t *timerQueueProcessorBase) getTimerTaskType(taskType int) string {
 		return "ActivityRetryTimerTask"
 	case persistence.TaskTypeWorkflowBackoffTimer:
 		return "WorkflowBackoffTimerTask"
+	case persistence.TaskTypeDelayedSignal:
+		return "DelayedSignalTask"
 	}
 	return "UnKnown"
 }
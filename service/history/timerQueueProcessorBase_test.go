@@ -31,6 +31,7 @@ import (
 	"github.com/uber-go/tally"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
@@ -38,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -205,10 +207,50 @@ func (s *timerQueueProcessorBaseSuite) TestDeleteWorkflow_NoErr() {
 	ms.On("GetEventStoreVersion").Return(persistence.EventStoreVersionV2).Once()
 	ms.On("GetCurrentBranch").Return([]byte{}).Once()
 
-	err := s.timerQueueProcessor.deleteWorkflow(task, ms, ctx)
+	err := s.timerQueueProcessor.deleteWorkflow(task, ms, ctx, nil)
 	s.NoError(err)
 }
 
+func (s *timerQueueProcessorBaseSuite) TestGetWorkflowPayloadBuckets() {
+	task := &persistence.TimerTaskInfo{
+		DomainID:   uuid.New().String(),
+		WorkflowID: uuid.New().String(),
+		RunID:      uuid.New().String(),
+	}
+	s.mockShard.(*shardContextImpl).payloadStore = payload.NewStore(&mocks.BlobstoreClient{})
+	mockHistoryV2Mgr := &mocks.HistoryV2Manager{}
+	s.timerQueueProcessor.historyService.historyV2Mgr = mockHistoryV2Mgr
+	ms := &mockMutableState{}
+	ms.On("GetNextEventID").Return(int64(3))
+	ms.On("GetEventStoreVersion").Return(persistence.EventStoreVersionV2)
+	ms.On("GetCurrentBranch").Return([]byte{})
+
+	// payloads offloaded before the configured bucket changed are referenced from the old bucket
+	mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*workflow.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(1),
+				EventType: workflow.EventTypeWorkflowExecutionStarted.Ptr(),
+				WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{
+					InputReference: &workflow.PayloadReference{Bucket: common.StringPtr("old-bucket")},
+				},
+			},
+			{
+				EventId:   common.Int64Ptr(2),
+				EventType: workflow.EventTypeWorkflowExecutionSignaled.Ptr(),
+				WorkflowExecutionSignaledEventAttributes: &workflow.WorkflowExecutionSignaledEventAttributes{
+					InputReference: &workflow.PayloadReference{Bucket: common.StringPtr("new-bucket")},
+				},
+			},
+		},
+	}, nil).Once()
+
+	buckets, err := s.timerQueueProcessor.getWorkflowPayloadBuckets(task, ms)
+	s.NoError(err)
+	s.Equal([]string{"new-bucket", "old-bucket"}, buckets)
+	mockHistoryV2Mgr.AssertExpectations(s.T())
+}
+
 func (s *timerQueueProcessorBaseSuite) TestHandleTaskError_EntiryNotExists() {
 	err := &workflow.EntityNotExistsError{}
 	s.Nil(s.timerQueueProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
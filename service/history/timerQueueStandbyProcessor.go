@@ -178,6 +178,12 @@ func (t *timerQueueStandbyProcessorImpl) process(timerTask *persistence.TimerTas
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope, err
 
+	case persistence.TaskTypeDelayedSignal:
+		if shouldProcessTask {
+			err = t.processDelayedSignal(timerTask)
+		}
+		return metrics.TimerStandbyTaskDelayedSignalScope, err
+
 	case persistence.TaskTypeDeleteHistoryEvent:
 		// guarantee the processing of workflow execution history deletion
 		return metrics.TimerStandbyTaskDeleteHistoryEventScope, t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
@@ -446,6 +452,60 @@ func (t *timerQueueStandbyProcessorImpl) processWorkflowTimeout(timerTask *persi
 	}, postProcessingFn)
 }
 
+// processDelayedSignal forwards a delayed signal accepted by this cluster before a failover to the active cluster,
+// pending delayed signals are not replicated so no other cluster can deliver it
+func (t *timerQueueStandbyProcessorImpl) processDelayedSignal(timerTask *persistence.TimerTaskInfo) error {
+	context, release, err := t.cache.getOrCreateWorkflowExecution(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(timerTask))
+	if err != nil {
+		return err
+	}
+	msBuilder, err := loadMutableStateForTimerTask(context, timerTask, t.metricsClient, t.logger)
+	var signal *workflow.DelayedSignalInfo
+	pending := false
+	if err == nil && msBuilder != nil {
+		signal, pending = msBuilder.GetDelayedSignal(timerTask.SignalRequestID)
+	}
+	release(err)
+	if err != nil || !pending {
+		return err
+	}
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(timerTask.DomainID)
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// domain is deleted, nothing to deliver
+			return nil
+		}
+		return err
+	}
+
+	// the request ID deduplicates the signal in case the active cluster delivered it already
+	activeCluster := domainEntry.GetReplicationConfig().ActiveClusterName
+	err = t.shard.GetService().GetClientBean().GetRemoteFrontendClient(activeCluster).SignalWorkflowExecution(nil, &workflow.SignalWorkflowExecutionRequest{
+		Domain: common.StringPtr(domainEntry.GetInfo().Name),
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(timerTask.WorkflowID),
+			RunId:      common.StringPtr(signal.GetRunId()),
+		},
+		SignalName: common.StringPtr(signal.GetSignalName()),
+		Input:      signal.Input,
+		Identity:   common.StringPtr(signal.GetIdentity()),
+		RequestId:  common.StringPtr(signal.GetRequestId()),
+	})
+	switch err.(type) {
+	case *workflow.EntityNotExistsError, *workflow.LimitExceededError:
+		// the workflow is gone or cannot accept more signals, the signal is dropped
+		t.logger.Info("Dropping delayed signal.",
+			tag.WorkflowDomainID(timerTask.DomainID),
+			tag.WorkflowID(timerTask.WorkflowID),
+			tag.WorkflowRunID(signal.GetRunId()),
+			tag.ClusterName(activeCluster),
+			tag.Error(err))
+		return nil
+	}
+	return err
+}
+
 func (t *timerQueueStandbyProcessorImpl) getStandbyClusterTime() time.Time {
 	// time of remote cluster in the shard is delayed by "StandbyClusterDelay"
 	// so to get the current accurate remote cluster time, need to add it back
@@ -37,9 +37,13 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/parentclosepolicy"
 )
 
-const identityHistoryService = "history-service"
+const (
+	identityHistoryService           = "history-service"
+	parentClosePolicyTerminateReason = "by parent close policy"
+)
 
 type (
 	transferQueueActiveProcessorImpl struct {
@@ -292,11 +296,17 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 		return nil
 	}
 
+	scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(task.ScheduleID)
+	if !ok {
+		return &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
+	}
+	activityType := scheduledEvent.ActivityTaskScheduledEventAttributes.GetActivityType().GetName()
+
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, timeout)
+	return t.pushActivity(task, activityType, timeout)
 }
 
 func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence.TransferTaskInfo) (retError error) {
@@ -354,8 +364,9 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
+	binaryChecksum := executionInfo.LastBinaryChecksum
 	release(nil)
-	return t.pushDecision(task, tasklist, decisionTimeout)
+	return t.pushDecision(task, tasklist, binaryChecksum, decisionTimeout)
 }
 
 func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persistence.TransferTaskInfo) (retError error) {
@@ -407,6 +418,7 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 	workflowCloseTimestamp := wfCloseTime
 	workflowCloseStatus := getWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
 	workflowHistoryLength := msBuilder.GetNextEventID() - 1
+	workflowRetentionDays := getWorkflowRetentionDays(t.shard.GetConfig(), executionInfo, 0)
 
 	startEvent, ok := msBuilder.GetStartEvent()
 	if !ok {
@@ -415,12 +427,17 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 	workflowExecutionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
 	visibilityMemo := getVisibilityMemo(startEvent)
 
+	children, err := getChildrenForParentClosePolicy(msBuilder)
+	if err != nil {
+		return err
+	}
+
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 	err = t.recordWorkflowClosed(
 		domainID, execution, workflowTypeName, workflowStartTimestamp, workflowExecutionTimestamp.UnixNano(),
-		workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength, task.GetTaskID(), visibilityMemo,
+		workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength, workflowRetentionDays, task.GetTaskID(), visibilityMemo,
 	)
 	if err != nil {
 		return err
@@ -447,6 +464,129 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 		case *workflow.EntityNotExistsError:
 			err = nil
 		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return t.processParentClosePolicy(domainID, execution, children)
+}
+
+// processParentClosePolicy applies the child policy to the running children of a closed workflow,
+// parents with many children are handed over to the parent close policy system workflow
+func (t *transferQueueActiveProcessorImpl) processParentClosePolicy(domainID string, execution workflow.WorkflowExecution,
+	children []parentclosepolicy.RequestDetail) error {
+
+	if len(children) == 0 {
+		return nil
+	}
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return err
+	}
+	domainName := domainEntry.GetInfo().Name
+	config := t.shard.GetConfig()
+	if !config.EnableParentClosePolicy(domainName) {
+		return nil
+	}
+
+	targets := make([]parentclosepolicy.RequestDetail, 0, len(children))
+	for _, child := range children {
+		if child.DomainName == "" {
+			child.DomainName = domainName
+		}
+		if child.DomainName == domainName {
+			child.DomainID = domainID
+		} else {
+			childDomainEntry, err := t.shard.GetDomainCache().GetDomain(child.DomainName)
+			if err != nil {
+				if _, ok := err.(*workflow.EntityNotExistsError); ok {
+					// the child domain is deleted, nothing to apply
+					continue
+				}
+				return err
+			}
+			child.DomainID = childDomainEntry.GetInfo().ID
+		}
+		targets = append(targets, child)
+	}
+
+	if config.EnableParentClosePolicyWorker() && len(targets) >= config.ParentClosePolicyThreshold(domainName) {
+		err = t.historyService.parentClosePolicyClient.SendParentClosePolicyRequest(parentclosepolicy.Request{
+			ParentDomainID:   domainID,
+			ParentWorkflowID: execution.GetWorkflowId(),
+			ParentRunID:      execution.GetRunId(),
+			Executions:       targets,
+		})
+		if err != nil {
+			return err
+		}
+		t.metricsClient.AddCounter(metrics.TransferActiveTaskCloseExecutionScope, metrics.ParentClosePolicyDelegatedCount, int64(len(targets)))
+		return nil
+	}
+
+	for _, child := range targets {
+		if err := t.applyParentClosePolicy(child); err != nil {
+			t.logger.Error("failed to apply parent close policy",
+				tag.WorkflowDomainID(child.DomainID),
+				tag.WorkflowID(child.WorkflowID),
+				tag.WorkflowRunID(child.RunID),
+				tag.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *transferQueueActiveProcessorImpl) applyParentClosePolicy(child parentclosepolicy.RequestDetail) error {
+	childExecution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(child.WorkflowID),
+		RunId:      common.StringPtr(child.RunID),
+	}
+
+	var err error
+	switch child.Policy {
+	case workflow.ChildPolicyTerminate:
+		err = t.historyClient.TerminateWorkflowExecution(nil, &h.TerminateWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(child.DomainID),
+			TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+				Domain:            common.StringPtr(child.DomainName),
+				WorkflowExecution: childExecution,
+				Reason:            common.StringPtr(parentClosePolicyTerminateReason),
+				Identity:          common.StringPtr(identityHistoryService),
+			},
+		})
+		if err == nil {
+			t.metricsClient.IncCounter(metrics.TransferActiveTaskCloseExecutionScope, metrics.ParentClosePolicyTerminateCount)
+		}
+	case workflow.ChildPolicyRequestCancel:
+		err = t.historyClient.RequestCancelWorkflowExecution(nil, &h.RequestCancelWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(child.DomainID),
+			CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+				Domain:            common.StringPtr(child.DomainName),
+				WorkflowExecution: childExecution,
+				Identity:          common.StringPtr(identityHistoryService),
+			},
+		})
+		if err == nil {
+			t.metricsClient.IncCounter(metrics.TransferActiveTaskCloseExecutionScope, metrics.ParentClosePolicyRequestCancelCount)
+		}
+	}
+
+	switch err.(type) {
+	case *workflow.EntityNotExistsError, *workflow.CancellationAlreadyRequestedError:
+		// the child is already closed or its cancellation is already requested
+		return nil
+	case *workflow.DomainNotActiveError:
+		// the child domain is active in another cluster, retrying will not help
+		t.logger.Warn("skip parent close policy for child in domain not active in current cluster",
+			tag.WorkflowDomainID(child.DomainID),
+			tag.WorkflowID(child.WorkflowID),
+			tag.WorkflowRunID(child.RunID),
+			tag.Error(err))
+		t.metricsClient.IncCounter(metrics.TransferActiveTaskCloseExecutionScope, metrics.ParentClosePolicySkippedCount)
+		return nil
 	}
 	return err
 }
@@ -1264,3 +1404,29 @@ func getWorkflowExecutionCloseStatus(status int) workflow.WorkflowExecutionClose
 		panic("Invalid value for enum WorkflowExecutionCloseStatus")
 	}
 }
+
+// getChildrenForParentClosePolicy returns the started children whose child policy is not abandon
+func getChildrenForParentClosePolicy(msBuilder mutableState) ([]parentclosepolicy.RequestDetail, error) {
+	var children []parentclosepolicy.RequestDetail
+	for initiatedID, childInfo := range msBuilder.GetPendingChildExecutionInfos() {
+		if childInfo.StartedID == common.EmptyEventID {
+			// the start child task is dropped once the parent is closed
+			continue
+		}
+		initiatedEvent, ok := msBuilder.GetChildExecutionInitiatedEvent(initiatedID)
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to get child execution initiated event."}
+		}
+		policy := initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes.GetChildPolicy()
+		if policy == workflow.ChildPolicyAbandon {
+			continue
+		}
+		children = append(children, parentclosepolicy.RequestDetail{
+			DomainName: childInfo.DomainName,
+			WorkflowID: childInfo.StartedWorkflowID,
+			RunID:      childInfo.StartedRunID,
+			Policy:     policy,
+		})
+	}
+	return children, nil
+}
@@ -44,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -229,7 +230,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessActivityTask_Success() {
 
 	persistenceMutableState := createMutableState(msBuilder)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
-	s.mockMatchingClient.On("AddActivityTask", nil, s.createAddActivityTaskRequest(transferTask, ai)).Once().Return(nil)
+	s.mockMatchingClient.On("AddActivityTask", mock.Anything, s.createAddActivityTaskRequest(transferTask, ai, activityType)).Once().Return(nil)
 
 	_, err := s.transferQueueActiveProcessor.process(transferTask, true)
 	s.Nil(err)
@@ -335,7 +336,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessDecisionTask_FirstDecisio
 
 	persistenceMutableState := createMutableState(msBuilder)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
-	s.mockMatchingClient.On("AddDecisionTask", nil, s.createAddDecisionTaskRequest(transferTask, msBuilder)).Once().Return(nil)
+	s.mockMatchingClient.On("AddDecisionTask", mock.Anything, s.createAddDecisionTaskRequest(transferTask, msBuilder)).Once().Return(nil)
 
 	_, err := s.transferQueueActiveProcessor.process(transferTask, true)
 	s.Nil(err)
@@ -388,7 +389,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessDecisionTask_NonFirstDeci
 
 	persistenceMutableState := createMutableState(msBuilder)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
-	s.mockMatchingClient.On("AddDecisionTask", nil, s.createAddDecisionTaskRequest(transferTask, msBuilder)).Once().Return(nil)
+	s.mockMatchingClient.On("AddDecisionTask", mock.Anything, s.createAddDecisionTaskRequest(transferTask, msBuilder)).Once().Return(nil)
 
 	_, err := s.transferQueueActiveProcessor.process(transferTask, true)
 	s.Nil(err)
@@ -447,7 +448,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessDecisionTask_Sticky_NonFi
 
 	persistenceMutableState := createMutableState(msBuilder)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
-	s.mockMatchingClient.On("AddDecisionTask", nil, s.createAddDecisionTaskRequest(transferTask, msBuilder)).Once().Return(nil)
+	s.mockMatchingClient.On("AddDecisionTask", mock.Anything, s.createAddDecisionTaskRequest(transferTask, msBuilder)).Once().Return(nil)
 
 	_, err := s.transferQueueActiveProcessor.process(transferTask, true)
 	s.Nil(err)
@@ -506,7 +507,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessDecisionTask_DecisionNotS
 
 	persistenceMutableState := createMutableState(msBuilder)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
-	s.mockMatchingClient.On("AddDecisionTask", nil, s.createAddDecisionTaskRequest(transferTask, msBuilder)).Once().Return(nil)
+	s.mockMatchingClient.On("AddDecisionTask", mock.Anything, s.createAddDecisionTaskRequest(transferTask, msBuilder)).Once().Return(nil)
 
 	_, err := s.transferQueueActiveProcessor.process(transferTask, true)
 	s.Nil(err)
@@ -686,6 +687,113 @@ func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent()
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent_HasFewChildren() {
+	s.processCloseExecutionWithChild(true, nil)
+}
+
+func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent_HasFewChildren_PolicyDisabled() {
+	s.processCloseExecutionWithChild(false, nil)
+}
+
+func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent_HasFewChildren_ChildDomainNotActive() {
+	s.processCloseExecutionWithChild(true, &workflow.DomainNotActiveError{})
+}
+
+func (s *transferQueueActiveProcessorSuite) processCloseExecutionWithChild(enableParentClosePolicy bool, terminateErr error) {
+	s.mockShard.GetConfig().EnableParentClosePolicy = dynamicconfig.GetBoolPropertyFnFilteredByDomain(enableParentClosePolicy)
+	domainID := "some random domain ID"
+	domainName := "some random domain Name"
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	childDomainID := "some random child domain ID"
+	childDomainName := "some random child domain Name"
+	childWorkflowID := "some random child workflow ID"
+	childRunID := uuid.New()
+	childWorkflowType := "some random child workflow type"
+	childTaskListName := "some random child task list"
+
+	msBuilder := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(),
+		s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	msBuilder.AddWorkflowExecutionStartedEvent(
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	event := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, nil, "some random identity")
+	decisionCompletedID := event.GetEventId()
+
+	// the child initiated by the helper uses the terminate child policy
+	event, _ = addStartChildWorkflowExecutionInitiatedEvent(msBuilder, decisionCompletedID, uuid.New(),
+		childDomainName, childWorkflowID, childWorkflowType, childTaskListName, nil, 1, 1)
+	addChildWorkflowExecutionStartedEvent(msBuilder, event.GetEventId(), childDomainName, childWorkflowID, childRunID, childWorkflowType)
+
+	taskID := int64(59)
+	event = addCompleteWorkflowEvent(msBuilder, decisionCompletedID, nil)
+	msBuilder.UpdateReplicationStateLastEventID(s.mockClusterMetadata.GetCurrentClusterName(), s.version, event.GetEventId())
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		TaskID:     taskID,
+		TaskList:   taskListName,
+		TaskType:   persistence.TransferTaskTypeCloseExecution,
+		ScheduleID: event.GetEventId(),
+	}
+
+	persistenceMutableState := createMutableState(msBuilder)
+	s.mockMetadataMgr.ExpectedCalls = nil
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(&persistence.GetDomainResponse{
+		Info:              &persistence.DomainInfo{ID: domainID, Name: domainName},
+		Config:            &persistence.DomainConfig{Retention: 1},
+		ReplicationConfig: &persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+		FailoverVersion:   s.version,
+		TableVersion:      persistence.DomainTableVersionV1,
+	}, nil)
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: childDomainName}).Return(&persistence.GetDomainResponse{
+		Info:              &persistence.DomainInfo{ID: childDomainID, Name: childDomainName},
+		Config:            &persistence.DomainConfig{Retention: 1},
+		ReplicationConfig: &persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+		TableVersion:      persistence.DomainTableVersionV1,
+	}, nil)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Once()
+	if enableParentClosePolicy {
+		s.mockHistoryClient.On("TerminateWorkflowExecution", nil, &history.TerminateWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(childDomainID),
+			TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+				Domain: common.StringPtr(childDomainName),
+				WorkflowExecution: &workflow.WorkflowExecution{
+					WorkflowId: common.StringPtr(childWorkflowID),
+					RunId:      common.StringPtr(childRunID),
+				},
+				Reason:   common.StringPtr(parentClosePolicyTerminateReason),
+				Identity: common.StringPtr(identityHistoryService),
+			},
+		}).Return(terminateErr).Once()
+	}
+
+	_, err := s.transferQueueActiveProcessor.process(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferQueueActiveProcessorSuite) TestProcessCancelExecution_Success() {
 	domainID := "some random domain ID"
 	execution := workflow.WorkflowExecution{
@@ -1502,7 +1610,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessRecordWorkflowStartedTask
 }
 
 func (s *transferQueueActiveProcessorSuite) createAddActivityTaskRequest(task *persistence.TransferTaskInfo,
-	ai *persistence.ActivityInfo) *matching.AddActivityTaskRequest {
+	ai *persistence.ActivityInfo, activityType string) *matching.AddActivityTaskRequest {
 	execution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
 		RunId:      common.StringPtr(task.RunID),
@@ -1516,6 +1624,7 @@ func (s *transferQueueActiveProcessorSuite) createAddActivityTaskRequest(task *p
 		TaskList:                      taskList,
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(ai.ScheduleToStartTimeout),
+		ActivityType:                  common.StringPtr(activityType),
 	}
 }
 
@@ -1539,6 +1648,7 @@ func (s *transferQueueActiveProcessorSuite) createAddDecisionTaskRequest(task *p
 		TaskList:                      taskList,
 		ScheduleId:                    common.Int64Ptr(task.ScheduleID),
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(timeout),
+		BinaryChecksum:                common.StringPtr(executionInfo.LastBinaryChecksum),
 	}
 }
 
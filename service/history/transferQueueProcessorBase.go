@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 	"time"
 )
 
@@ -50,7 +51,12 @@ type (
 	}
 )
 
-const defaultDomainName = "defaultDomainName"
+const (
+	defaultDomainName = "defaultDomainName"
+
+	pushActivitySpanName = "TransferQueuePushActivity"
+	pushDecisionSpanName = "TransferQueuePushDecision"
+)
 
 func newTransferQueueProcessorBase(shard ShardContext, options *QueueProcessorOptions,
 	visibilityMgr persistence.VisibilityManager, matchingClient matching.Client,
@@ -96,12 +102,14 @@ func (t *transferQueueProcessorBase) queueShutdown() error {
 	return t.transferQueueShutdown()
 }
 
-func (t *transferQueueProcessorBase) pushActivity(task *persistence.TransferTaskInfo, activityScheduleToStartTimeout int32) error {
+func (t *transferQueueProcessorBase) pushActivity(task *persistence.TransferTaskInfo, activityType string, activityScheduleToStartTimeout int32) error {
 	if task.TaskType != persistence.TransferTaskTypeActivityTask {
 		t.logger.Fatal("Cannot process non activity task", tag.TaskType(task.GetTaskType()))
 	}
 
-	err := t.matchingClient.AddActivityTask(nil, &m.AddActivityTaskRequest{
+	// link the async hop to matching back to the request which scheduled the activity
+	span, ctx := tracing.StartSpanFollowsFrom(pushActivitySpanName, task.TraceContext)
+	err := t.matchingClient.AddActivityTask(ctx, &m.AddActivityTaskRequest{
 		DomainUUID:       common.StringPtr(task.TargetDomainID),
 		SourceDomainUUID: common.StringPtr(task.DomainID),
 		Execution: &workflow.WorkflowExecution{
@@ -111,17 +119,21 @@ func (t *transferQueueProcessorBase) pushActivity(task *persistence.TransferTask
 		TaskList:                      &workflow.TaskList{Name: &task.TaskList},
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
+		ActivityType:                  common.StringPtr(activityType),
 	})
+	tracing.FinishSpan(span, err)
 
 	return err
 }
 
-func (t *transferQueueProcessorBase) pushDecision(task *persistence.TransferTaskInfo, tasklist *workflow.TaskList, decisionScheduleToStartTimeout int32) error {
+func (t *transferQueueProcessorBase) pushDecision(task *persistence.TransferTaskInfo, tasklist *workflow.TaskList, binaryChecksum string, decisionScheduleToStartTimeout int32) error {
 	if task.TaskType != persistence.TransferTaskTypeDecisionTask {
 		t.logger.Fatal("Cannot process non decision task", tag.TaskType(task.GetTaskType()))
 	}
 
-	err := t.matchingClient.AddDecisionTask(nil, &m.AddDecisionTaskRequest{
+	// link the async hop to matching back to the request which scheduled the decision
+	span, ctx := tracing.StartSpanFollowsFrom(pushDecisionSpanName, task.TraceContext)
+	err := t.matchingClient.AddDecisionTask(ctx, &m.AddDecisionTaskRequest{
 		DomainUUID: common.StringPtr(task.DomainID),
 		Execution: &workflow.WorkflowExecution{
 			WorkflowId: common.StringPtr(task.WorkflowID),
@@ -130,7 +142,9 @@ func (t *transferQueueProcessorBase) pushDecision(task *persistence.TransferTask
 		TaskList:                      tasklist,
 		ScheduleId:                    common.Int64Ptr(task.ScheduleID),
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
+		BinaryChecksum:                common.StringPtr(binaryChecksum),
 	})
+	tracing.FinishSpan(span, err)
 
 	return err
 }
@@ -176,7 +190,7 @@ func (t *transferQueueProcessorBase) recordWorkflowStarted(
 func (t *transferQueueProcessorBase) recordWorkflowClosed(
 	domainID string, execution workflow.WorkflowExecution, workflowTypeName string,
 	startTimeUnixNano int64, executionTimeUnixNano int64, endTimeUnixNano int64, closeStatus workflow.WorkflowExecutionCloseStatus,
-	historyLength int64, taskID int64, visibilityMemo *workflow.Memo) error {
+	historyLength int64, workflowRetentionDays int32, taskID int64, visibilityMemo *workflow.Memo) error {
 
 	// Record closing in visibility store
 	retentionSeconds := int64(0)
@@ -196,6 +210,10 @@ func (t *transferQueueProcessorBase) recordWorkflowClosed(
 		domain = domainEntry.GetInfo().Name
 		isSampledEnabled = domainEntry.IsSampledForLongerRetentionEnabled(wid)
 	}
+	if workflowRetentionDays > 0 {
+		// per workflow retention takes precedence over domain retention
+		retentionSeconds = int64(workflowRetentionDays) * int64(secondsInDay)
+	}
 
 	// if sampled for longer retention is enabled, only record those sampled events
 	if isSampledEnabled && !domainEntry.IsSampledForLongerRetention(wid) {
@@ -177,6 +177,7 @@ func (t *transferQueueStandbyProcessorImpl) process(qTask queueTaskInfo, shouldP
 func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *persistence.TransferTaskInfo) error {
 
 	var activityScheduleToStartTimeout *int32
+	var activityType string
 	processTaskIfClosed := false
 	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		activityInfo, isPending := msBuilder.GetActivityInfo(transferTask.ScheduleID)
@@ -198,6 +199,11 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *pe
 				return ErrTaskRetry
 			}
 
+			scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(transferTask.ScheduleID)
+			if !ok {
+				return &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
+			}
+			activityType = scheduledEvent.ActivityTaskScheduledEventAttributes.GetActivityType().GetName()
 			activityScheduleToStartTimeout = common.Int32Ptr(common.MinInt32(activityInfo.ScheduleToStartTimeout, common.MaxTaskTimeout))
 			return nil
 		}
@@ -209,7 +215,7 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *pe
 		}
 
 		timeout := common.MinInt32(*activityScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushActivity(transferTask, timeout)
+		err := t.pushActivity(transferTask, activityType, timeout)
 		return err
 	})
 }
@@ -217,6 +223,7 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *pe
 func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *persistence.TransferTaskInfo) error {
 	var decisionScheduleToStartTimeout *int32
 	var tasklist *workflow.TaskList
+	var binaryChecksum string
 	processTaskIfClosed := false
 
 	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
@@ -245,6 +252,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 
 			decisionScheduleToStartTimeout = common.Int32Ptr(decisionTimeout)
 			tasklist = &workflow.TaskList{Name: &transferTask.TaskList}
+			binaryChecksum = executionInfo.LastBinaryChecksum
 			return nil
 		}
 
@@ -255,7 +263,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 		}
 
 		timeout := common.MinInt32(*decisionScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushDecision(transferTask, tasklist, timeout)
+		err := t.pushDecision(transferTask, tasklist, binaryChecksum, timeout)
 		return err
 	})
 }
@@ -287,6 +295,7 @@ func (t *transferQueueStandbyProcessorImpl) processCloseExecution(transferTask *
 		workflowCloseTimestamp := wfCloseTime
 		workflowCloseStatus := getWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
 		workflowHistoryLength := msBuilder.GetNextEventID() - 1
+		workflowRetentionDays := getWorkflowRetentionDays(t.shard.GetConfig(), executionInfo, 0)
 		startEvent, _ := msBuilder.GetStartEvent()
 		workflowExecutionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
 		visibilityMemo := getVisibilityMemo(startEvent)
@@ -303,7 +312,7 @@ func (t *transferQueueStandbyProcessorImpl) processCloseExecution(transferTask *
 
 		return t.recordWorkflowClosed(
 			transferTask.DomainID, execution, workflowTypeName, workflowStartTimestamp, workflowExecutionTimestamp.UnixNano(),
-			workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength, transferTask.GetTaskID(), visibilityMemo,
+			workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength, workflowRetentionDays, transferTask.GetTaskID(), visibilityMemo,
 		)
 	}, standbyTaskPostActionNoOp) // no op post action, since the entire workflow is finished
 }
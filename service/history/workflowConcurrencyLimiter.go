@@ -0,0 +1,222 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// workflowConcurrencyLimitModeReject fails starts over the limit with a LimitExceededError
+	workflowConcurrencyLimitModeReject = "reject"
+	// workflowConcurrencyLimitModeQueue delays starts over the limit until capacity frees up
+	workflowConcurrencyLimitModeQueue = "queue"
+
+	getRunningWorkflowCountsTimeout = 5 * time.Second
+)
+
+var (
+	// errRunningWorkflowCountsIncomplete is the error indicating some shards could not be counted,
+	// admitting starts based on the counts of the remaining shards would overshoot the limit
+	errRunningWorkflowCountsIncomplete = &workflow.ServiceBusyError{Message: "Running workflow counts are not available for all shards."}
+)
+
+type (
+	// workflowConcurrencyLimiter decides whether another execution of a workflow type can be started
+	// in a domain without going over the configured concurrency limit
+	workflowConcurrencyLimiter interface {
+		tryAcquire(domainID string, workflowType string, limit int) (bool, error)
+	}
+
+	// workflowConcurrencyLimiterImpl is shared by all shards of a history host. The running execution
+	// counts of all shards in the cluster are cached per domain, and each admitted start is added to the
+	// cached count so a burst of starts on one host does not overshoot the limit until the next refresh.
+	// Starts admitted concurrently on different hosts within one refresh interval can still overshoot.
+	// Counts are only replaced by a response covering every shard, the last full counts are used otherwise.
+	workflowConcurrencyLimiterImpl struct {
+		historyClient  history.Client
+		timeSource     clock.TimeSource
+		numberOfShards int
+		cacheTTL       dynamicconfig.DurationPropertyFn
+
+		sync.Mutex
+		domains map[string]*runningWorkflowCounts
+	}
+
+	runningWorkflowCounts struct {
+		sync.Mutex
+		counts    map[string]int64
+		refreshed time.Time
+		// refreshCh is closed when the refresh in flight completes, nil if there is none
+		refreshCh  chan struct{}
+		refreshErr error
+		// admitted are the starts admitted while a refresh is in flight, they are added to the refreshed counts
+		admitted map[string]int64
+		// workflowTypes are the workflow types of the domain which have a concurrency limit
+		workflowTypes map[string]struct{}
+	}
+)
+
+var _ workflowConcurrencyLimiter = (*workflowConcurrencyLimiterImpl)(nil)
+
+func newWorkflowConcurrencyLimiter(
+	historyClient history.Client,
+	timeSource clock.TimeSource,
+	numberOfShards int,
+	cacheTTL dynamicconfig.DurationPropertyFn,
+) *workflowConcurrencyLimiterImpl {
+	return &workflowConcurrencyLimiterImpl{
+		historyClient:  historyClient,
+		timeSource:     timeSource,
+		numberOfShards: numberOfShards,
+		cacheTTL:       cacheTTL,
+		domains:        make(map[string]*runningWorkflowCounts),
+	}
+}
+
+func (l *workflowConcurrencyLimiterImpl) tryAcquire(domainID string, workflowType string, limit int) (bool, error) {
+	if limit <= 0 {
+		return true, nil
+	}
+
+	l.Lock()
+	entry, ok := l.domains[domainID]
+	if !ok {
+		entry = &runningWorkflowCounts{workflowTypes: make(map[string]struct{})}
+		l.domains[domainID] = entry
+	}
+	l.Unlock()
+
+	entry.Lock()
+	defer entry.Unlock()
+
+	entry.workflowTypes[workflowType] = struct{}{}
+	for entry.counts == nil || l.timeSource.Now().Sub(entry.refreshed) >= l.cacheTTL() {
+		if refreshCh := entry.refreshCh; refreshCh != nil {
+			if entry.counts != nil {
+				// stale counts are good enough until the refresh in flight completes
+				break
+			}
+			entry.Unlock()
+			<-refreshCh
+			entry.Lock()
+			if entry.counts == nil {
+				return false, entry.refreshErr
+			}
+			continue
+		}
+		if err := l.refreshLocked(domainID, entry); err != nil {
+			if entry.counts == nil {
+				return false, err
+			}
+			break
+		}
+	}
+
+	if entry.counts[workflowType] >= int64(limit) {
+		return false, nil
+	}
+	entry.counts[workflowType]++
+	if entry.admitted != nil {
+		entry.admitted[workflowType]++
+	}
+	return true, nil
+}
+
+// refreshLocked fetches the running execution counts of all shards, the entry lock is released
+// while the fan-out to the history hosts is in flight
+func (l *workflowConcurrencyLimiterImpl) refreshLocked(domainID string, entry *runningWorkflowCounts) error {
+	refreshCh := make(chan struct{})
+	entry.refreshCh = refreshCh
+	entry.admitted = make(map[string]int64)
+	now := l.timeSource.Now()
+	entry.Unlock()
+
+	counts, err := l.getRunningWorkflowCounts(domainID)
+
+	entry.Lock()
+	if err == nil {
+		for workflowType, count := range entry.admitted {
+			counts[workflowType] += count
+		}
+		entry.counts = counts
+		entry.refreshed = now
+	}
+	entry.refreshErr = err
+	entry.refreshCh = nil
+	entry.admitted = nil
+	close(refreshCh)
+	return err
+}
+
+func (l *workflowConcurrencyLimiterImpl) getRunningWorkflowCounts(domainID string) (map[string]int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), getRunningWorkflowCountsTimeout)
+	defer cancel()
+	resp, err := l.historyClient.GetRunningWorkflowCounts(ctx, &h.GetRunningWorkflowCountsRequest{
+		DomainUUID: common.StringPtr(domainID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counted := make(map[int32]struct{}, len(resp.ShardIDs))
+	for _, shardID := range resp.ShardIDs {
+		counted[shardID] = struct{}{}
+	}
+	if len(counted) < l.numberOfShards {
+		return nil, errRunningWorkflowCountsIncomplete
+	}
+
+	counts := make(map[string]int64, len(resp.Counts))
+	for workflowType, count := range resp.Counts {
+		counts[workflowType] = count
+	}
+	return counts, nil
+}
+
+// limitedWorkflowTypes returns the workflow types with a concurrency limit seen by this host, keyed by domain ID
+func (l *workflowConcurrencyLimiterImpl) limitedWorkflowTypes() map[string][]string {
+	l.Lock()
+	entries := make(map[string]*runningWorkflowCounts, len(l.domains))
+	for domainID, entry := range l.domains {
+		entries[domainID] = entry
+	}
+	l.Unlock()
+
+	result := make(map[string][]string, len(entries))
+	for domainID, entry := range entries {
+		entry.Lock()
+		for workflowType := range entry.workflowTypes {
+			result[domainID] = append(result[domainID], workflowType)
+		}
+		entry.Unlock()
+	}
+	return result
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const testNumberOfShards = 2
+
+var allShardIDs = []int32{0, 1}
+
+type (
+	workflowConcurrencyLimiterSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		mockHistoryClient *mocks.HistoryClient
+		timeSource        *clock.EventTimeSource
+		limiter           *workflowConcurrencyLimiterImpl
+	}
+)
+
+func TestWorkflowConcurrencyLimiterSuite(t *testing.T) {
+	s := new(workflowConcurrencyLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowConcurrencyLimiterSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+	s.mockHistoryClient = &mocks.HistoryClient{}
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.limiter = newWorkflowConcurrencyLimiter(s.mockHistoryClient, s.timeSource, testNumberOfShards, dynamicconfig.GetDurationPropertyFn(time.Minute))
+}
+
+func (s *workflowConcurrencyLimiterSuite) TearDownTest() {
+	s.mockHistoryClient.AssertExpectations(s.T())
+}
+
+func (s *workflowConcurrencyLimiterSuite) TestTryAcquire_Unlimited() {
+	ok, err := s.limiter.tryAcquire("domain-id", "workflow-type", 0)
+	s.NoError(err)
+	s.True(ok)
+	s.mockHistoryClient.AssertNotCalled(s.T(), "GetRunningWorkflowCounts", mock.Anything, mock.Anything)
+}
+
+func (s *workflowConcurrencyLimiterSuite) TestTryAcquire_CountsAdmittedStarts() {
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&h.GetRunningWorkflowCountsResponse{
+		Counts:   map[string]int64{"workflow-type": 1},
+		ShardIDs: allShardIDs,
+	}, nil).Once()
+
+	ok, err := s.limiter.tryAcquire("domain-id", "workflow-type", 3)
+	s.NoError(err)
+	s.True(ok)
+	ok, err = s.limiter.tryAcquire("domain-id", "workflow-type", 3)
+	s.NoError(err)
+	s.True(ok)
+	// the two admitted starts fill up the limit until the counts are refreshed
+	ok, err = s.limiter.tryAcquire("domain-id", "workflow-type", 3)
+	s.NoError(err)
+	s.False(ok)
+	// other workflow types have their own limit
+	ok, err = s.limiter.tryAcquire("domain-id", "other-workflow-type", 3)
+	s.NoError(err)
+	s.True(ok)
+}
+
+func (s *workflowConcurrencyLimiterSuite) TestTryAcquire_RefreshesCounts() {
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&h.GetRunningWorkflowCountsResponse{
+		Counts:   map[string]int64{"workflow-type": 2},
+		ShardIDs: allShardIDs,
+	}, nil).Once()
+	ok, err := s.limiter.tryAcquire("domain-id", "workflow-type", 2)
+	s.NoError(err)
+	s.False(ok)
+
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&h.GetRunningWorkflowCountsResponse{
+		Counts:   map[string]int64{"workflow-type": 1},
+		ShardIDs: allShardIDs,
+	}, nil).Once()
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	ok, err = s.limiter.tryAcquire("domain-id", "workflow-type", 2)
+	s.NoError(err)
+	s.True(ok)
+}
+
+func (s *workflowConcurrencyLimiterSuite) TestTryAcquire_Error() {
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(nil, errors.New("some random error")).Once()
+	ok, err := s.limiter.tryAcquire("domain-id", "workflow-type", 2)
+	s.Error(err)
+	s.False(ok)
+}
+
+func (s *workflowConcurrencyLimiterSuite) TestTryAcquire_PartialCounts() {
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&h.GetRunningWorkflowCountsResponse{
+		Counts:   map[string]int64{"workflow-type": 1},
+		ShardIDs: []int32{0},
+	}, nil).Once()
+	ok, err := s.limiter.tryAcquire("domain-id", "workflow-type", 2)
+	s.Equal(errRunningWorkflowCountsIncomplete, err)
+	s.False(ok)
+}
+
+func (s *workflowConcurrencyLimiterSuite) TestTryAcquire_PartialCountsFallBackToLastFullCounts() {
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&h.GetRunningWorkflowCountsResponse{
+		Counts:   map[string]int64{"workflow-type": 1},
+		ShardIDs: allShardIDs,
+	}, nil).Once()
+	ok, err := s.limiter.tryAcquire("domain-id", "workflow-type", 2)
+	s.NoError(err)
+	s.True(ok)
+
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&h.GetRunningWorkflowCountsResponse{
+		Counts:   map[string]int64{},
+		ShardIDs: []int32{1},
+	}, nil).Once()
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	// the last full counts including the admitted start are kept, the partial response would allow another start
+	ok, err = s.limiter.tryAcquire("domain-id", "workflow-type", 2)
+	s.NoError(err)
+	s.False(ok)
+}
+
+func (s *workflowConcurrencyLimiterSuite) TestLimitedWorkflowTypes() {
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&h.GetRunningWorkflowCountsResponse{
+		Counts:   map[string]int64{},
+		ShardIDs: allShardIDs,
+	}, nil).Once()
+	_, err := s.limiter.tryAcquire("domain-id", "workflow-type", 2)
+	s.NoError(err)
+	_, err = s.limiter.tryAcquire("domain-id", "other-workflow-type", 2)
+	s.NoError(err)
+	_, err = s.limiter.tryAcquire("other-domain-id", "workflow-type", 0)
+	s.NoError(err)
+
+	workflowTypes := s.limiter.limitedWorkflowTypes()
+	s.Len(workflowTypes, 1)
+	s.ElementsMatch([]string{"workflow-type", "other-workflow-type"}, workflowTypes["domain-id"])
+}
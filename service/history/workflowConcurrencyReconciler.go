@@ -0,0 +1,184 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	workflowConcurrencyReconcilePageSize = 1000
+	// workflowConcurrencyReconcileDisabledDelay is how often a disabled reconciler checks whether it got enabled
+	workflowConcurrencyReconcileDisabledDelay = time.Minute
+)
+
+type (
+	// runningWorkflowCountShards gives access to the shards owned by this host
+	runningWorkflowCountShards interface {
+		shardIDs() []int32
+		getEngineForShard(shardID int) (Engine, error)
+	}
+
+	// workflowConcurrencyReconciler periodically recounts the running executions of the workflow types with
+	// a concurrency limit from visibility, and overwrites the counts of the shards owned by this host.
+	// Shards only persist their counts on the next shard update, so deltas applied since are lost when a shard
+	// moves, and executions started before a limit was configured were never counted. Visibility lags behind,
+	// so a reconciled count can be off by the executions started or closed within that lag.
+	workflowConcurrencyReconciler struct {
+		status         int32
+		shutdownCh     chan struct{}
+		limiter        *workflowConcurrencyLimiterImpl
+		shards         runningWorkflowCountShards
+		visibilityMgr  persistence.VisibilityManager
+		domainCache    cache.DomainCache
+		numberOfShards int
+		interval       dynamicconfig.DurationPropertyFn
+		logger         log.Logger
+	}
+)
+
+func newWorkflowConcurrencyReconciler(
+	limiter *workflowConcurrencyLimiterImpl,
+	shards runningWorkflowCountShards,
+	visibilityMgr persistence.VisibilityManager,
+	domainCache cache.DomainCache,
+	numberOfShards int,
+	interval dynamicconfig.DurationPropertyFn,
+	logger log.Logger,
+) *workflowConcurrencyReconciler {
+	return &workflowConcurrencyReconciler{
+		status:         common.DaemonStatusInitialized,
+		shutdownCh:     make(chan struct{}),
+		limiter:        limiter,
+		shards:         shards,
+		visibilityMgr:  visibilityMgr,
+		domainCache:    domainCache,
+		numberOfShards: numberOfShards,
+		interval:       interval,
+		logger:         logger,
+	}
+}
+
+func (r *workflowConcurrencyReconciler) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go r.reconcileLoop()
+}
+
+func (r *workflowConcurrencyReconciler) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(r.shutdownCh)
+}
+
+func (r *workflowConcurrencyReconciler) reconcileLoop() {
+	timer := time.NewTimer(r.nextReconcileDelay())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-timer.C:
+			if r.interval() > 0 {
+				r.reconcile()
+			}
+			timer.Reset(r.nextReconcileDelay())
+		}
+	}
+}
+
+func (r *workflowConcurrencyReconciler) nextReconcileDelay() time.Duration {
+	if interval := r.interval(); interval > 0 {
+		return interval
+	}
+	return workflowConcurrencyReconcileDisabledDelay
+}
+
+func (r *workflowConcurrencyReconciler) reconcile() {
+	for domainID, workflowTypes := range r.limiter.limitedWorkflowTypes() {
+		domainEntry, err := r.domainCache.GetDomainByID(domainID)
+		if err != nil {
+			r.logger.Warn("Failed to get domain for running workflow count reconciliation",
+				tag.WorkflowDomainID(domainID), tag.Error(err))
+			continue
+		}
+		for _, workflowType := range workflowTypes {
+			counts, err := r.countRunningWorkflows(domainEntry, workflowType)
+			if err != nil {
+				r.logger.Warn("Failed to count running workflows for reconciliation",
+					tag.WorkflowDomainID(domainID), tag.WorkflowType(workflowType), tag.Error(err))
+				continue
+			}
+			for _, shardID := range r.shards.shardIDs() {
+				engine, err := r.shards.getEngineForShard(int(shardID))
+				if err != nil {
+					// the shard moved to another host, which reconciles it
+					continue
+				}
+				engine.SetRunningWorkflowCount(domainID, workflowType, counts[shardID])
+			}
+		}
+	}
+}
+
+// countRunningWorkflows returns the number of open executions of the workflow type per shard according to visibility
+func (r *workflowConcurrencyReconciler) countRunningWorkflows(
+	domainEntry *cache.DomainCacheEntry,
+	workflowType string,
+) (map[int32]int64, error) {
+
+	counts := make(map[int32]int64)
+	request := &persistence.ListWorkflowExecutionsByTypeRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:        domainEntry.GetInfo().ID,
+			Domain:            domainEntry.GetInfo().Name,
+			EarliestStartTime: 0,
+			LatestStartTime:   time.Now().UnixNano(),
+			PageSize:          workflowConcurrencyReconcilePageSize,
+		},
+		WorkflowTypeName: workflowType,
+	}
+	for {
+		resp, err := r.visibilityMgr.ListOpenWorkflowExecutionsByType(request)
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range resp.Executions {
+			shardID := common.WorkflowIDToHistoryShard(execution.Execution.GetWorkflowId(), r.numberOfShards)
+			counts[int32(shardID)]++
+		}
+		if len(resp.NextPageToken) == 0 {
+			return counts, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
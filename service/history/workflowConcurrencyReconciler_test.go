@@ -0,0 +1,155 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	workflowConcurrencyReconcilerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		mockHistoryClient *mocks.HistoryClient
+		mockVisibilityMgr *mocks.VisibilityManager
+		mockDomainCache   *cache.DomainCacheMock
+		mockEngine        *MockHistoryEngine
+		limiter           *workflowConcurrencyLimiterImpl
+		reconciler        *workflowConcurrencyReconciler
+	}
+
+	testRunningWorkflowCountShards struct {
+		engines map[int]Engine
+	}
+)
+
+func TestWorkflowConcurrencyReconcilerSuite(t *testing.T) {
+	s := new(workflowConcurrencyReconcilerSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowConcurrencyReconcilerSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+	s.mockHistoryClient = &mocks.HistoryClient{}
+	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockEngine = &MockHistoryEngine{}
+	s.limiter = newWorkflowConcurrencyLimiter(s.mockHistoryClient, clock.NewRealTimeSource(), testNumberOfShards,
+		dynamicconfig.GetDurationPropertyFn(time.Minute))
+	// shard 1 is owned by another host
+	shards := &testRunningWorkflowCountShards{engines: map[int]Engine{0: s.mockEngine}}
+	s.reconciler = newWorkflowConcurrencyReconciler(s.limiter, shards, s.mockVisibilityMgr, s.mockDomainCache,
+		testNumberOfShards, dynamicconfig.GetDurationPropertyFn(time.Hour), loggerimpl.NewDevelopmentForTest(s.Suite))
+}
+
+func (s *workflowConcurrencyReconcilerSuite) TearDownTest() {
+	s.mockHistoryClient.AssertExpectations(s.T())
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+	s.mockDomainCache.AssertExpectations(s.T())
+	s.mockEngine.AssertExpectations(s.T())
+}
+
+func (s *workflowConcurrencyReconcilerSuite) TestReconcile() {
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&h.GetRunningWorkflowCountsResponse{
+		Counts:   map[string]int64{},
+		ShardIDs: allShardIDs,
+	}, nil).Once()
+	_, err := s.limiter.tryAcquire("domain-id", "workflow-type", 10)
+	s.NoError(err)
+
+	domainEntry := cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{ID: "domain-id", Name: "domain"}, nil)
+	s.mockDomainCache.On("GetDomainByID", "domain-id").Return(domainEntry, nil).Once()
+
+	var page1, page2 []*workflow.WorkflowExecutionInfo
+	expected := int64(0)
+	for i := 0; i < 5; i++ {
+		workflowID := fmt.Sprintf("workflow-id-%v", i)
+		if common.WorkflowIDToHistoryShard(workflowID, testNumberOfShards) == 0 {
+			expected++
+		}
+		info := &workflow.WorkflowExecutionInfo{
+			Execution: &workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+		}
+		if i < 3 {
+			page1 = append(page1, info)
+		} else {
+			page2 = append(page2, info)
+		}
+	}
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByType", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByTypeRequest) bool {
+		return request.WorkflowTypeName == "workflow-type" && request.DomainUUID == "domain-id" && len(request.NextPageToken) == 0
+	})).Return(&persistence.ListWorkflowExecutionsResponse{Executions: page1, NextPageToken: []byte("token")}, nil).Once()
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByType", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByTypeRequest) bool {
+		return string(request.NextPageToken) == "token"
+	})).Return(&persistence.ListWorkflowExecutionsResponse{Executions: page2}, nil).Once()
+	s.mockEngine.On("SetRunningWorkflowCount", "domain-id", "workflow-type", expected).Once()
+
+	s.reconciler.reconcile()
+}
+
+func (s *workflowConcurrencyReconcilerSuite) TestReconcile_VisibilityError() {
+	s.mockHistoryClient.On("GetRunningWorkflowCounts", mock.Anything, mock.Anything).Return(&h.GetRunningWorkflowCountsResponse{
+		Counts:   map[string]int64{},
+		ShardIDs: allShardIDs,
+	}, nil).Once()
+	_, err := s.limiter.tryAcquire("domain-id", "workflow-type", 10)
+	s.NoError(err)
+
+	domainEntry := cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{ID: "domain-id", Name: "domain"}, nil)
+	s.mockDomainCache.On("GetDomainByID", "domain-id").Return(domainEntry, nil).Once()
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByType", mock.Anything).Return(nil, errors.New("some random error")).Once()
+
+	// counts are left untouched when visibility cannot be read
+	s.reconciler.reconcile()
+	s.mockEngine.AssertNotCalled(s.T(), "SetRunningWorkflowCount", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *testRunningWorkflowCountShards) shardIDs() []int32 {
+	return allShardIDs
+}
+
+func (s *testRunningWorkflowCountShards) getEngineForShard(shardID int) (Engine, error) {
+	engine, ok := s.engines[shardID]
+	if !ok {
+		return nil, &persistence.ShardOwnershipLostError{ShardID: shardID}
+	}
+	return engine, nil
+}
@@ -64,6 +64,7 @@ type (
 		resetMutableState(prevRunID string, resetBuilder mutableState) (mutableState, error)
 		resetWorkflowExecution(currMutableState mutableState, updateCurr bool, closeTask, cleanupTask persistence.Task, newMutableState mutableState, transferTasks, timerTasks, currReplicationTasks, insertReplicationTasks []persistence.Task, baseRunID string, forkRunNextEventID, prevRunVersion int64) (retError error)
 		scheduleNewDecision(transferTasks []persistence.Task, timerTasks []persistence.Task) ([]persistence.Task, []persistence.Task, error)
+		terminateAndCreateWorkflowExecution(currMutableState mutableState, terminateCurr bool, closeTask, cleanupTask persistence.Task, newMutableState mutableState, newTransferTasks, newTimerTasks []persistence.Task, createReplicationTask bool, prevRunVersion int64) error
 		unlock()
 		updateWorkflowExecutionForStandby(transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64, now time.Time, createReplicationTask bool, standbyHistoryBuilder *historyBuilder, sourceCluster string) error
 		updateWorkflowExecution(transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error
@@ -85,6 +86,8 @@ type (
 		msBuilder             mutableState
 		updateCondition       int64
 		createReplicationTask bool
+		// state of the execution as last persisted, used to track running executions per workflow type
+		persistedState int
 	}
 )
 
@@ -176,6 +179,7 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal() error {
 		msBuilder.Load(state)
 		info := state.ExecutionInfo
 		c.updateCondition = info.NextEventID
+		c.persistedState = info.State
 	}
 
 	c.msBuilder = msBuilder
@@ -244,6 +248,10 @@ func (c *workflowExecutionContextImpl) createWorkflowExecution(
 		EventStoreVersion:           executionInfo.EventStoreVersion,
 		BranchToken:                 executionInfo.BranchToken,
 		CronSchedule:                executionInfo.CronSchedule,
+		RetentionDays:               executionInfo.RetentionDays,
+		WorkflowExecutionTimeout:    executionInfo.WorkflowExecutionTimeout,
+		WorkflowExpirationTime:      executionInfo.WorkflowExpirationTime,
+		RecentSignalRequestIDs:      executionInfo.RecentSignalRequestIDs,
 		ReplicationState:            replicationState,
 
 		// retry policy
@@ -263,7 +271,13 @@ func (c *workflowExecutionContextImpl) createWorkflowExecution(
 	}
 
 	_, err := c.shard.CreateWorkflowExecution(createRequest)
-	return err
+	if err != nil {
+		return err
+	}
+
+	c.updateRunningWorkflowCount(executionInfo.WorkflowTypeName, persistence.WorkflowStateCreated, executionInfo.State)
+	c.persistedState = executionInfo.State
+	return nil
 }
 
 func (c *workflowExecutionContextImpl) resetMutableState(prevRunID string, resetBuilder mutableState) (mutableState,
@@ -276,6 +290,7 @@ func (c *workflowExecutionContextImpl) resetMutableState(prevRunID string, reset
 	if err != nil {
 		return nil, err
 	}
+	c.updateRunningWorkflowCount(snapshotRequest.ExecutionInfo.WorkflowTypeName, c.persistedState, snapshotRequest.ExecutionInfo.State)
 
 	c.clear()
 	return c.loadWorkflowExecution()
@@ -390,7 +405,130 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(currMutableState m
 		InsertRequestCancelInfos: snapshotRequest.InsertRequestCancelInfos,
 	}
 
-	return c.shard.ResetWorkflowExecution(resetWFReq)
+	if retError = c.shard.ResetWorkflowExecution(resetWFReq); retError != nil {
+		return retError
+	}
+
+	currExecutionInfo := currMutableState.GetExecutionInfo()
+	c.updateRunningWorkflowCount(currExecutionInfo.WorkflowTypeName, c.persistedState, currExecutionInfo.State)
+	c.persistedState = currExecutionInfo.State
+	newExecutionInfo := newMutableState.GetExecutionInfo()
+	c.updateRunningWorkflowCount(newExecutionInfo.WorkflowTypeName, persistence.WorkflowStateCreated, newExecutionInfo.State)
+	return nil
+}
+
+// terminateAndCreateWorkflowExecution closes the current run and creates the new run of the workflow in one
+// transaction, the first batch of events of the new run must already be appended:
+// 1. append history to current run if it is terminated
+// 2. update mutableState of current run and create new run
+func (c *workflowExecutionContextImpl) terminateAndCreateWorkflowExecution(currMutableState mutableState, terminateCurr bool,
+	closeTask, cleanupTask persistence.Task, newMutableState mutableState, newTransferTasks, newTimerTasks []persistence.Task,
+	createReplicationTask bool, prevRunVersion int64) (retError error) {
+
+	defer func() {
+		if retError != nil {
+			// Clear all cached state in case of error
+			c.clear()
+		}
+	}()
+
+	now := time.Now()
+	currTransferTasks := []persistence.Task{}
+	currTimerTasks := []persistence.Task{}
+	if closeTask != nil {
+		currTransferTasks = append(currTransferTasks, closeTask)
+	}
+	if cleanupTask != nil {
+		currTimerTasks = append(currTimerTasks, cleanupTask)
+	}
+
+	currentClusterName := c.clusterMetadata.GetCurrentClusterName()
+	var currReplicationTasks, insertReplicationTasks []persistence.Task
+	if terminateCurr {
+		transactionID, err := c.shard.GetNextTransferTaskID()
+		if err != nil {
+			return err
+		}
+		// call FlushBufferedEvents to assign task id to event
+		// as well as update last event task id in ms state builder
+		if err := currMutableState.FlushBufferedEvents(); err != nil {
+			return err
+		}
+		history := currMutableState.GetHistoryBuilder().GetHistory().GetEvents()
+		size, err := c.appendHistoryEvents(history, transactionID, true)
+		if err != nil {
+			return err
+		}
+		currMutableState.IncrementHistorySize(size)
+		currMutableState.GetExecutionInfo().SetLastFirstEventID(history[0].GetEventId())
+
+		if currMutableState.GetReplicationState() != nil {
+			currMutableState.UpdateReplicationStateLastEventID(
+				currentClusterName,
+				currMutableState.GetCurrentVersion(),
+				currMutableState.GetNextEventID()-1,
+			)
+			if createReplicationTask {
+				currReplicationTasks = currMutableState.CreateReplicationTask(currReplicationTasks, 0, nil)
+			}
+		}
+	}
+
+	if newMutableState.GetReplicationState() != nil {
+		newMutableState.UpdateReplicationStateLastEventID(
+			currentClusterName,
+			newMutableState.GetCurrentVersion(),
+			newMutableState.GetNextEventID()-1,
+		)
+		if createReplicationTask {
+			insertReplicationTasks = newMutableState.CreateReplicationTask(insertReplicationTasks, 0, nil)
+		}
+	}
+	setTaskInfo(currMutableState.GetCurrentVersion(), now, currTransferTasks, currTimerTasks)
+	setTaskInfo(newMutableState.GetCurrentVersion(), now, newTransferTasks, newTimerTasks)
+
+	// NOTE: workflow_state in current record is either completed(2) or running(1), there is no 0(created)
+	prevRunState := persistence.WorkflowStateCompleted
+	if terminateCurr {
+		prevRunState = persistence.WorkflowStateRunning
+	}
+	currExecutionInfo := currMutableState.GetExecutionInfo()
+	err := c.shard.ResetWorkflowExecution(&persistence.ResetWorkflowExecutionRequest{
+		PrevRunVersion: prevRunVersion,
+		PrevRunState:   prevRunState,
+
+		Condition:  c.updateCondition,
+		UpdateCurr: terminateCurr,
+
+		// the current run is the base, so there is no base run to check
+		BaseRunID:          currExecutionInfo.RunID,
+		BaseRunNextEventID: currMutableState.GetNextEventID(),
+
+		CurrExecutionInfo:    currExecutionInfo,
+		CurrReplicationState: currMutableState.GetReplicationState(),
+		CurrReplicationTasks: currReplicationTasks,
+		CurrTransferTasks:    currTransferTasks,
+		CurrTimerTasks:       currTimerTasks,
+
+		InsertExecutionInfo:    newMutableState.GetExecutionInfo(),
+		InsertReplicationState: newMutableState.GetReplicationState(),
+		InsertTransferTasks:    newTransferTasks,
+		InsertTimerTasks:       newTimerTasks,
+		InsertReplicationTasks: insertReplicationTasks,
+	})
+	if err != nil {
+		if _, ok := err.(*persistence.ConditionFailedError); ok {
+			return ErrConflict
+		}
+		return err
+	}
+
+	c.updateCondition = currMutableState.GetNextEventID()
+	c.updateRunningWorkflowCount(currExecutionInfo.WorkflowTypeName, c.persistedState, currExecutionInfo.State)
+	c.persistedState = currExecutionInfo.State
+	newExecutionInfo := newMutableState.GetExecutionInfo()
+	c.updateRunningWorkflowCount(newExecutionInfo.WorkflowTypeName, persistence.WorkflowStateCreated, newExecutionInfo.State)
+	return nil
 }
 
 func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithContext(context []byte, transferTasks []persistence.Task,
@@ -695,7 +833,7 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 				executionInfo.SetLastFirstEventID(firstEvent.GetEventId())
 
 				// add clean up tasks
-				tranT, timerT, err := getWorkflowHistoryCleanupTasksFromShard(c.shard, executionInfo.DomainID, executionInfo.WorkflowID, nil)
+				tranT, timerT, err := getWorkflowHistoryCleanupTasksFromShard(c.shard, executionInfo, nil)
 				if err != nil {
 					return err
 				}
@@ -771,6 +909,11 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 
 	// Update went through so update the condition for new updates
 	c.updateCondition = c.msBuilder.GetNextEventID()
+	c.updateRunningWorkflowCount(executionInfo.WorkflowTypeName, c.persistedState, executionInfo.State)
+	c.persistedState = executionInfo.State
+	if continueAsNew != nil {
+		c.updateRunningWorkflowCount(continueAsNew.WorkflowTypeName, persistence.WorkflowStateCreated, continueAsNew.State)
+	}
 	c.msBuilder.GetExecutionInfo().LastUpdatedTimestamp = time.Now()
 
 	// for any change in the workflow, send a event
@@ -798,6 +941,19 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 	return nil
 }
 
+// updateRunningWorkflowCount adjusts the running execution count of the shard when the persisted state
+// of an execution moves into or out of the running state
+func (c *workflowExecutionContextImpl) updateRunningWorkflowCount(workflowType string, prevState int, currState int) {
+	if prevState == currState {
+		return
+	}
+	if currState == persistence.WorkflowStateRunning {
+		c.shard.UpdateRunningWorkflowCount(c.domainID, workflowType, 1)
+	} else if prevState == persistence.WorkflowStateRunning {
+		c.shard.UpdateRunningWorkflowCount(c.domainID, workflowType, -1)
+	}
+}
+
 func (c *workflowExecutionContextImpl) appendFirstBatchEventsForActive(msBuilder mutableState) (int, error) {
 	// call FlushBufferedEvents to assign task id to event
 	// as well as update last event task id in mutable state builder
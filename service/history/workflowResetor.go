@@ -335,8 +335,7 @@ func (w *workflowResetorImpl) terminateIfCurrIsRunning(currMutableState mutableS
 			Identity: common.StringPtr(identityHistoryService),
 		})
 		closeTask, cleanupTask, retError = w.eng.getWorkflowHistoryCleanupTasks(
-			currMutableState.GetExecutionInfo().DomainID,
-			currMutableState.GetExecutionInfo().WorkflowID,
+			currMutableState.GetExecutionInfo(),
 			w.eng.getTimerBuilder(&workflow.WorkflowExecution{
 				WorkflowId: common.StringPtr(currMutableState.GetExecutionInfo().WorkflowID),
 				RunId:      common.StringPtr(currMutableState.GetExecutionInfo().RunID),
@@ -400,12 +399,18 @@ func (w *workflowResetorImpl) generateReplicationTasksForReset(terminateCurr boo
 // replay signals in the base run, and also signals in all the runs along the chain of contineAsNew
 func (w *workflowResetorImpl) replayReceivedSignals(ctx context.Context, receivedSignals []*workflow.HistoryEvent, continueRunID string, newMutableState, currMutableState mutableState) error {
 	for _, se := range receivedSignals {
+		sigAttr := se.GetWorkflowExecutionSignaledEventAttributes()
+		input, err := resolvePayload(w.eng.shard, sigAttr.Input, sigAttr.InputReference)
+		if err != nil {
+			return err
+		}
 		sigReq := &workflow.SignalWorkflowExecutionRequest{
-			SignalName: se.GetWorkflowExecutionSignaledEventAttributes().SignalName,
-			Identity:   se.GetWorkflowExecutionSignaledEventAttributes().Identity,
-			Input:      se.GetWorkflowExecutionSignaledEventAttributes().Input,
+			SignalName: sigAttr.SignalName,
+			Identity:   sigAttr.Identity,
+			Input:      input,
+			RequestId:  sigAttr.RequestId,
 		}
-		newMutableState.AddWorkflowExecutionSignaled(sigReq.GetSignalName(), sigReq.GetInput(), sigReq.GetIdentity())
+		newMutableState.AddWorkflowExecutionSignaled(sigReq.GetSignalName(), sigReq.GetInput(), sigReq.GetIdentity(), sigReq.GetRequestId())
 	}
 	for {
 		if len(continueRunID) == 0 {
@@ -450,12 +455,18 @@ func (w *workflowResetorImpl) replayReceivedSignals(ctx context.Context, receive
 			for _, batch := range readResp.History {
 				for _, e := range batch.Events {
 					if e.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled {
+						sigAttr := e.GetWorkflowExecutionSignaledEventAttributes()
+						input, err := resolvePayload(w.eng.shard, sigAttr.Input, sigAttr.InputReference)
+						if err != nil {
+							return err
+						}
 						sigReq := &workflow.SignalWorkflowExecutionRequest{
-							SignalName: e.GetWorkflowExecutionSignaledEventAttributes().SignalName,
-							Identity:   e.GetWorkflowExecutionSignaledEventAttributes().Identity,
-							Input:      e.GetWorkflowExecutionSignaledEventAttributes().Input,
+							SignalName: sigAttr.SignalName,
+							Identity:   sigAttr.Identity,
+							Input:      input,
+							RequestId:  sigAttr.RequestId,
 						}
-						newMutableState.AddWorkflowExecutionSignaled(sigReq.GetSignalName(), sigReq.GetInput(), sigReq.GetIdentity())
+						newMutableState.AddWorkflowExecutionSignaled(sigReq.GetSignalName(), sigReq.GetInput(), sigReq.GetIdentity(), sigReq.GetRequestId())
 					} else if e.GetEventType() == workflow.EventTypeWorkflowExecutionContinuedAsNew {
 						attr := e.GetWorkflowExecutionContinuedAsNewEventAttributes()
 						continueRunID = attr.GetNewExecutionRunId()
@@ -478,7 +489,7 @@ func (w *workflowResetorImpl) generateTimerTasksForReset(msBuilder mutableState,
 	// WF timeout task
 	duration := time.Duration(wfTimeoutSecs) * time.Second
 	wfTimeoutTask := &persistence.WorkflowTimeoutTask{
-		VisibilityTimestamp: w.eng.shard.GetTimeSource().Now().Add(duration),
+		VisibilityTimestamp: getWorkflowTimeoutDeadline(msBuilder.GetExecutionInfo(), w.eng.shard.GetTimeSource().Now().Add(duration)),
 	}
 	timerTasks = append(timerTasks, wfTimeoutTask)
 
@@ -0,0 +1,268 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/cache"
+)
+
+const (
+	workflowUpdateDedupeCacheSize = 10000
+)
+
+type (
+	workflowUpdateKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	workflowUpdateDedupeKey struct {
+		domainID   string
+		workflowID string
+		updateID   string
+	}
+
+	workflowUpdateAbandonedKey struct {
+		workflowUpdateKey
+		updateID string
+	}
+
+	workflowUpdateOutcome struct {
+		runID  string
+		result *workflow.WorkflowUpdateResult
+		err    error
+	}
+
+	// pendingWorkflowUpdate is an update waiting for the worker to accept or reject it.
+	// All callers sharing the same update ID wait on the same entry.
+	pendingWorkflowUpdate struct {
+		update     *workflow.WorkflowUpdate
+		dispatched bool
+		// attempts is the number of decision tasks the update was handed to
+		attempts int
+		waiters  int
+		outcome  *workflowUpdateOutcome
+		doneCh   chan struct{}
+	}
+
+	// workflowUpdateRegistry tracks the updates of the workflows owned by a shard. Pending updates only
+	// live in memory and only as long as somebody waits for them: a caller whose update is lost on shard
+	// movement times out and retries with the same request ID. Accepted updates are also recorded in the
+	// mutable state, which deduplicates retries once the completed outcome is no longer cached here.
+	workflowUpdateRegistry struct {
+		sync.Mutex
+		pending   map[workflowUpdateKey]map[string]*pendingWorkflowUpdate
+		completed cache.Cache
+		// abandoned keeps the updates handed to the worker whose callers gave up, so the
+		// worker's answer can still be recorded with the update it applied
+		abandoned cache.Cache
+	}
+)
+
+func newWorkflowUpdateKey(domainID, workflowID, runID string) workflowUpdateKey {
+	return workflowUpdateKey{
+		domainID:   domainID,
+		workflowID: workflowID,
+		runID:      runID,
+	}
+}
+
+func newWorkflowUpdateRegistry(dedupeTTL time.Duration) *workflowUpdateRegistry {
+	return &workflowUpdateRegistry{
+		pending: make(map[workflowUpdateKey]map[string]*pendingWorkflowUpdate),
+		completed: cache.New(workflowUpdateDedupeCacheSize, &cache.Options{
+			TTL: dedupeTTL,
+		}),
+		abandoned: cache.New(workflowUpdateDedupeCacheSize, &cache.Options{
+			TTL: dedupeTTL,
+		}),
+	}
+}
+
+// getCompleted returns the outcome of an update which already completed, if it is still remembered
+func (r *workflowUpdateRegistry) getCompleted(domainID, workflowID, updateID string) (*workflowUpdateOutcome, bool) {
+	outcome, ok := r.completed.Get(workflowUpdateDedupeKey{
+		domainID:   domainID,
+		workflowID: workflowID,
+		updateID:   updateID,
+	}).(*workflowUpdateOutcome)
+	return outcome, ok
+}
+
+// register adds a waiter for the given update, creating the update if it is not pending yet
+func (r *workflowUpdateRegistry) register(key workflowUpdateKey, updateID string,
+	update *workflow.WorkflowUpdate) *pendingWorkflowUpdate {
+	r.Lock()
+	defer r.Unlock()
+
+	updates, ok := r.pending[key]
+	if !ok {
+		updates = make(map[string]*pendingWorkflowUpdate)
+		r.pending[key] = updates
+	}
+	entry, ok := updates[updateID]
+	if !ok {
+		entry = &pendingWorkflowUpdate{
+			update: update,
+			doneCh: make(chan struct{}),
+		}
+		updates[updateID] = entry
+	}
+	entry.waiters++
+	return entry
+}
+
+// unregister removes a waiter which gave up. An update nobody waits for anymore is dropped, updates
+// already handed to the worker are remembered until the worker answers or the entry expires.
+func (r *workflowUpdateRegistry) unregister(key workflowUpdateKey, updateID string, entry *pendingWorkflowUpdate) {
+	r.Lock()
+	defer r.Unlock()
+
+	entry.waiters--
+	if entry.waiters > 0 || entry.outcome != nil {
+		return
+	}
+	if entry.dispatched {
+		r.abandoned.Put(workflowUpdateAbandonedKey{workflowUpdateKey: key, updateID: updateID}, entry.update)
+	}
+	r.deletePendingLocked(key, updateID)
+}
+
+// dispatch returns the pending updates of the workflow so they can be sent with a decision task
+func (r *workflowUpdateRegistry) dispatch(key workflowUpdateKey) map[string]*workflow.WorkflowUpdate {
+	r.Lock()
+	defer r.Unlock()
+
+	updates, ok := r.pending[key]
+	if !ok || len(updates) == 0 {
+		return nil
+	}
+	result := make(map[string]*workflow.WorkflowUpdate, len(updates))
+	for updateID, entry := range updates {
+		entry.dispatched = true
+		entry.attempts++
+		result[updateID] = entry.update
+	}
+	return result
+}
+
+// failUnanswered fails the pending updates which were handed to maxAttempts decision tasks without being
+// answered by any of them, so a worker which does not handle updates is not given decisions forever
+func (r *workflowUpdateRegistry) failUnanswered(key workflowUpdateKey, answered map[string]*workflow.WorkflowUpdateResult,
+	maxAttempts int, err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	for updateID, entry := range r.pending[key] {
+		if _, ok := answered[updateID]; !ok && entry.attempts >= maxAttempts {
+			r.completeLocked(key, updateID, &workflowUpdateOutcome{runID: key.runID, err: err})
+		}
+	}
+}
+
+// get returns the pending or abandoned update with the given ID, or nil
+func (r *workflowUpdateRegistry) get(key workflowUpdateKey, updateID string) *workflow.WorkflowUpdate {
+	r.Lock()
+	defer r.Unlock()
+
+	if entry, ok := r.pending[key][updateID]; ok {
+		return entry.update
+	}
+	update, _ := r.abandoned.Get(workflowUpdateAbandonedKey{workflowUpdateKey: key, updateID: updateID}).(*workflow.WorkflowUpdate)
+	return update
+}
+
+// hasPending checks whether the workflow has pending updates which are not answered by the given results
+func (r *workflowUpdateRegistry) hasPending(key workflowUpdateKey, answered map[string]*workflow.WorkflowUpdateResult) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	for updateID := range r.pending[key] {
+		if _, ok := answered[updateID]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// complete records the outcome of an update and wakes up all its waiters
+func (r *workflowUpdateRegistry) complete(key workflowUpdateKey, updateID string, outcome *workflowUpdateOutcome) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.completeLocked(key, updateID, outcome)
+}
+
+// completeAll fails all pending updates of the workflow, used once the workflow is closed
+func (r *workflowUpdateRegistry) completeAll(key workflowUpdateKey, err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	for updateID := range r.pending[key] {
+		r.completeLocked(key, updateID, &workflowUpdateOutcome{runID: key.runID, err: err})
+	}
+}
+
+func (r *workflowUpdateRegistry) completeLocked(key workflowUpdateKey, updateID string, outcome *workflowUpdateOutcome) {
+	entry, ok := r.pending[key][updateID]
+	if ok {
+		entry.outcome = outcome
+		close(entry.doneCh)
+		r.deletePendingLocked(key, updateID)
+	}
+	r.abandoned.Delete(workflowUpdateAbandonedKey{workflowUpdateKey: key, updateID: updateID})
+	if outcome.err == nil {
+		r.completed.Put(workflowUpdateDedupeKey{
+			domainID:   key.domainID,
+			workflowID: key.workflowID,
+			updateID:   updateID,
+		}, outcome)
+	}
+}
+
+func (r *workflowUpdateRegistry) deletePendingLocked(key workflowUpdateKey, updateID string) {
+	updates := r.pending[key]
+	delete(updates, updateID)
+	if len(updates) == 0 {
+		delete(r.pending, key)
+	}
+}
+
+// wait blocks until the update completes, the timeout elapses or the context is done
+func (p *pendingWorkflowUpdate) wait(ctx context.Context, timeout time.Duration) (*workflowUpdateOutcome, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-p.doneCh:
+		return p.outcome, nil
+	case <-timer.C:
+		return nil, ErrWorkflowUpdateTimedOut
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	workflowUpdateRegistrySuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		key      workflowUpdateKey
+		registry *workflowUpdateRegistry
+	}
+)
+
+func TestWorkflowUpdateRegistrySuite(t *testing.T) {
+	s := new(workflowUpdateRegistrySuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowUpdateRegistrySuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+	s.key = newWorkflowUpdateKey("domain-id", "workflow-id", "run-id")
+	s.registry = newWorkflowUpdateRegistry(time.Minute)
+}
+
+func (s *workflowUpdateRegistrySuite) TestCompleteWakesUpAllWaiters() {
+	update := &shared.WorkflowUpdate{UpdateName: common.StringPtr("update-name")}
+	entry1 := s.registry.register(s.key, "update-id", update)
+	entry2 := s.registry.register(s.key, "update-id", update)
+	s.Equal(entry1, entry2)
+
+	updates := s.registry.dispatch(s.key)
+	s.Equal(map[string]*shared.WorkflowUpdate{"update-id": update}, updates)
+	s.True(s.registry.hasPending(s.key, nil))
+
+	result := &shared.WorkflowUpdateResult{
+		ResultType: shared.WorkflowUpdateResultTypeAccepted.Ptr(),
+		Result:     []byte("result"),
+	}
+	s.False(s.registry.hasPending(s.key, map[string]*shared.WorkflowUpdateResult{"update-id": result}))
+	s.registry.complete(s.key, "update-id", &workflowUpdateOutcome{runID: s.key.runID, result: result})
+
+	for _, entry := range []*pendingWorkflowUpdate{entry1, entry2} {
+		outcome, err := entry.wait(context.Background(), time.Second)
+		s.NoError(err)
+		s.Equal(result, outcome.result)
+	}
+	s.False(s.registry.hasPending(s.key, nil))
+	s.Nil(s.registry.dispatch(s.key))
+
+	outcome, ok := s.registry.getCompleted(s.key.domainID, s.key.workflowID, "update-id")
+	s.True(ok)
+	s.Equal(s.key.runID, outcome.runID)
+	s.Equal(result, outcome.result)
+}
+
+func (s *workflowUpdateRegistrySuite) TestWaitTimeout() {
+	update := &shared.WorkflowUpdate{UpdateName: common.StringPtr("update-name")}
+	entry := s.registry.register(s.key, "update-id", update)
+
+	_, err := entry.wait(context.Background(), time.Millisecond)
+	s.Equal(ErrWorkflowUpdateTimedOut, err)
+	s.registry.unregister(s.key, "update-id", entry)
+
+	// never handed to the worker, so it is gone
+	s.False(s.registry.hasPending(s.key, nil))
+	s.Nil(s.registry.get(s.key, "update-id"))
+}
+
+func (s *workflowUpdateRegistrySuite) TestAbandonedAfterDispatch() {
+	update := &shared.WorkflowUpdate{UpdateName: common.StringPtr("update-name")}
+	entry := s.registry.register(s.key, "update-id", update)
+	s.registry.dispatch(s.key)
+	s.registry.unregister(s.key, "update-id", entry)
+
+	// nobody waits anymore, but the worker's answer can still be recorded
+	s.False(s.registry.hasPending(s.key, nil))
+	s.Equal(update, s.registry.get(s.key, "update-id"))
+
+	s.registry.complete(s.key, "update-id", &workflowUpdateOutcome{
+		runID:  s.key.runID,
+		result: &shared.WorkflowUpdateResult{ResultType: shared.WorkflowUpdateResultTypeRejected.Ptr()},
+	})
+	s.Nil(s.registry.get(s.key, "update-id"))
+}
+
+func (s *workflowUpdateRegistrySuite) TestFailUnanswered() {
+	update := &shared.WorkflowUpdate{UpdateName: common.StringPtr("update-name")}
+	entry := s.registry.register(s.key, "update-id", update)
+	other := s.registry.register(s.key, "other-update-id", update)
+	answered := map[string]*shared.WorkflowUpdateResult{
+		"other-update-id": {ResultType: shared.WorkflowUpdateResultTypeAccepted.Ptr()},
+	}
+
+	s.registry.dispatch(s.key)
+	s.registry.failUnanswered(s.key, answered, 2, ErrWorkflowUpdateNotHandled)
+	s.True(s.registry.hasPending(s.key, answered))
+
+	s.registry.dispatch(s.key)
+	s.registry.failUnanswered(s.key, answered, 2, ErrWorkflowUpdateNotHandled)
+	s.False(s.registry.hasPending(s.key, answered))
+	outcome, err := entry.wait(context.Background(), time.Second)
+	s.NoError(err)
+	s.Equal(ErrWorkflowUpdateNotHandled, outcome.err)
+
+	// the answered update is left to be completed with its result
+	s.Equal(update, s.registry.get(s.key, "other-update-id"))
+	s.registry.unregister(s.key, "other-update-id", other)
+}
+
+func (s *workflowUpdateRegistrySuite) TestCompleteAll() {
+	update := &shared.WorkflowUpdate{UpdateName: common.StringPtr("update-name")}
+	entry := s.registry.register(s.key, "update-id", update)
+
+	s.registry.completeAll(s.key, ErrWorkflowCompleted)
+	outcome, err := entry.wait(context.Background(), time.Second)
+	s.NoError(err)
+	s.Equal(ErrWorkflowCompleted, outcome.err)
+
+	// failures are not remembered, a retry reaches the workflow again
+	_, ok := s.registry.getCompleted(s.key.domainID, s.key.workflowID, "update-id")
+	s.False(ok)
+}
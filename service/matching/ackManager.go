@@ -22,6 +22,7 @@ package matching
 
 import (
 	"sync"
+	"time"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -31,19 +32,26 @@ import (
 // Used to convert out of order acks into ackLevel movement.
 type ackManager struct {
 	sync.RWMutex
-	outstandingTasks map[int64]bool // key->TaskID, value->(true for acked, false->for non acked)
-	readLevel        int64          // Maximum TaskID inserted into outstandingTasks
-	ackLevel         int64          // Maximum TaskID below which all tasks are acked
+	outstandingTasks map[int64]bool      // key->TaskID, value->(true for acked, false->for non acked)
+	createdTimes     map[int64]time.Time // key->TaskID of non acked tasks, value->time the task was created
+	readLevel        int64               // Maximum TaskID inserted into outstandingTasks
+	ackLevel         int64               // Maximum TaskID below which all tasks are acked
 	backlogCounter   atomic.Int64
 	logger           log.Logger
 }
 
 func newAckManager(logger log.Logger) ackManager {
-	return ackManager{logger: logger, outstandingTasks: make(map[int64]bool), readLevel: -1, ackLevel: -1}
+	return ackManager{
+		logger:           logger,
+		outstandingTasks: make(map[int64]bool),
+		createdTimes:     make(map[int64]time.Time),
+		readLevel:        -1,
+		ackLevel:         -1,
+	}
 }
 
 // Registers task as in-flight and moves read level to it. Tasks can be added in increasing order of taskID only.
-func (m *ackManager) addTask(taskID int64) {
+func (m *ackManager) addTask(taskID int64, createdTime time.Time) {
 	m.Lock()
 	defer m.Unlock()
 	if m.readLevel >= taskID {
@@ -56,6 +64,9 @@ func (m *ackManager) addTask(taskID int64) {
 		m.logger.Fatal("Already present in outstanding tasks", tag.TaskID(taskID))
 	}
 	m.outstandingTasks[taskID] = false // true is for acked
+	if !createdTime.IsZero() {
+		m.createdTimes[taskID] = createdTime
+	}
 	m.backlogCounter.Inc()
 }
 
@@ -95,6 +106,7 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 	defer m.Unlock()
 	if completed, ok := m.outstandingTasks[taskID]; ok && !completed {
 		m.outstandingTasks[taskID] = true
+		delete(m.createdTimes, taskID)
 		m.backlogCounter.Dec()
 	}
 	// Update ackLevel
@@ -111,6 +123,22 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 	return m.ackLevel
 }
 
+// Returns the created time of the non acked task with the lowest taskID, zero time if there is no such task.
+// Tasks are read in increasing order of taskID, so this is the oldest task waiting in the backlog.
+func (m *ackManager) getOldestUnackedCreatedTime() time.Time {
+	m.RLock()
+	defer m.RUnlock()
+	var oldest time.Time
+	oldestTaskID := int64(-1)
+	for taskID, createdTime := range m.createdTimes {
+		if oldestTaskID == -1 || taskID < oldestTaskID {
+			oldestTaskID = taskID
+			oldest = createdTime
+		}
+	}
+	return oldest
+}
+
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCounter.Load()
 }
@@ -0,0 +1,287 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	m "github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/matching/matchingserviceclient"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/tokenbucket"
+)
+
+const (
+	// dispatchTokenLeaseInterval is how much of the dispatch budget of an activity type a matching host leases
+	// from the budget owner at a time, leased tokens not used within the interval are dropped
+	dispatchTokenLeaseInterval = 100 * time.Millisecond
+	// dispatchTokenRetryInterval is how long a matching host waits before asking the budget owner again,
+	// after it was refused tokens or failed to reach it
+	dispatchTokenRetryInterval = 50 * time.Millisecond
+	// dispatchTokenRequestTimeout is the timeout of a request for tokens to the budget owner
+	dispatchTokenRequestTimeout = 500 * time.Millisecond
+	// dispatchTokenLeaseIdleTimeout is how long the lease of an activity type is kept after its last use
+	dispatchTokenLeaseIdleTimeout = time.Minute
+)
+
+type (
+	activityTypeKey struct {
+		domainID     string
+		activityType string
+	}
+
+	// dispatchTokenFn asks the matching host owning the dispatch budget of an activity type for up to count tokens
+	dispatchTokenFn func(domainID string, activityType string, count int32) (int32, error)
+
+	// activityDispatchLimiter limits the rate activity tasks of a type are dispatched for a domain, across all
+	// task lists of the domain and all matching hosts. The budget of an activity type is kept by the matching
+	// host the domain and activity type hash to, the other hosts lease tokens from it in small batches.
+	activityDispatchLimiter struct {
+		config        *Config
+		domainCache   cache.DomainCache
+		acquireTokens dispatchTokenFn
+		timeSource    clock.TimeSource
+		metricsClient metrics.Client
+		logger        log.Logger
+
+		sync.Mutex
+		leases    map[activityTypeKey]*dispatchTokenLease
+		nextSweep time.Time
+	}
+
+	dispatchTokenLease struct {
+		sync.Mutex
+		tokens     int32
+		expiry     time.Time
+		retryAfter time.Time
+		// refreshing is set while a request for tokens is in flight, so only one caller asks the budget owner
+		refreshing bool
+		// lastUsed is guarded by the limiter lock, not the lease lock
+		lastUsed time.Time
+	}
+
+	// dispatchBudget keeps the dispatch budgets of the activity types owned by this matching host
+	dispatchBudget struct {
+		config      *Config
+		domainCache cache.DomainCache
+		timeSource  clock.TimeSource
+
+		sync.Mutex
+		buckets map[activityTypeKey]*dispatchBudgetBucket
+	}
+
+	dispatchBudgetBucket struct {
+		rps    int
+		bucket tokenbucket.TokenBucket
+	}
+)
+
+func newActivityDispatchLimiter(
+	config *Config,
+	domainCache cache.DomainCache,
+	acquireTokens dispatchTokenFn,
+	timeSource clock.TimeSource,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *activityDispatchLimiter {
+	return &activityDispatchLimiter{
+		config:        config,
+		domainCache:   domainCache,
+		acquireTokens: acquireTokens,
+		timeSource:    timeSource,
+		metricsClient: metricsClient,
+		logger:        logger,
+		leases:        make(map[activityTypeKey]*dispatchTokenLease),
+	}
+}
+
+// tryAcquire takes a token for dispatching an activity task of the given type, it returns false if the
+// dispatch budget of the activity type is used up. Tasks with no activity type are never limited.
+func (l *activityDispatchLimiter) tryAcquire(domainID string, activityType string) bool {
+	if activityType == "" {
+		return true
+	}
+	rps, err := getActivityTypeDispatchRPS(l.config, l.domainCache, domainID, activityType)
+	if err != nil || rps <= 0 {
+		return true
+	}
+
+	now := l.timeSource.Now()
+	lease := l.getLease(activityTypeKey{domainID: domainID, activityType: activityType}, now)
+	lease.Lock()
+	if lease.tokens > 0 && now.Before(lease.expiry) {
+		lease.tokens--
+		lease.Unlock()
+		return true
+	}
+	// callers arriving while the lease is being refreshed are refused rather than queued behind the request
+	if lease.refreshing || now.Before(lease.retryAfter) {
+		lease.Unlock()
+		return false
+	}
+	lease.refreshing = true
+	lease.Unlock()
+
+	count := int32(math.Ceil(float64(rps) * dispatchTokenLeaseInterval.Seconds()))
+	granted, err := l.acquireTokens(domainID, activityType, count)
+	if err != nil {
+		l.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.DispatchTokenAcquireFailureCounter)
+		l.logger.Warn("Failed to acquire activity dispatch tokens",
+			tag.WorkflowDomainID(domainID), tag.Value(activityType), tag.Error(err))
+	}
+
+	now = l.timeSource.Now()
+	lease.Lock()
+	defer lease.Unlock()
+	lease.refreshing = false
+	if err != nil || granted <= 0 {
+		lease.tokens = 0
+		lease.retryAfter = now.Add(dispatchTokenRetryInterval)
+		return false
+	}
+	lease.tokens = granted - 1
+	lease.expiry = now.Add(dispatchTokenLeaseInterval)
+	return true
+}
+
+// release gives back a token taken by tryAcquire which was not used for a dispatch
+func (l *activityDispatchLimiter) release(domainID string, activityType string) {
+	if activityType == "" {
+		return
+	}
+	l.Lock()
+	lease, ok := l.leases[activityTypeKey{domainID: domainID, activityType: activityType}]
+	l.Unlock()
+	if !ok {
+		return
+	}
+
+	lease.Lock()
+	defer lease.Unlock()
+	if l.timeSource.Now().Before(lease.expiry) {
+		lease.tokens++
+	}
+}
+
+func (l *activityDispatchLimiter) getLease(key activityTypeKey, now time.Time) *dispatchTokenLease {
+	l.Lock()
+	defer l.Unlock()
+	if !now.Before(l.nextSweep) {
+		l.sweepIdleLeasesLocked(now)
+	}
+	lease, ok := l.leases[key]
+	if !ok {
+		lease = &dispatchTokenLease{}
+		l.leases[key] = lease
+	}
+	lease.lastUsed = now
+	return lease
+}
+
+// sweepIdleLeasesLocked drops the leases of activity types not dispatched within the idle timeout, the tokens
+// left on them are expired by then
+func (l *activityDispatchLimiter) sweepIdleLeasesLocked(now time.Time) {
+	for key, lease := range l.leases {
+		if now.Sub(lease.lastUsed) >= dispatchTokenLeaseIdleTimeout {
+			delete(l.leases, key)
+		}
+	}
+	l.nextSweep = now.Add(dispatchTokenLeaseIdleTimeout)
+}
+
+// newRemoteDispatchTokenFn returns a dispatchTokenFn which asks the budget owner through the matching client,
+// which routes the request to the matching host the domain and activity type hash to
+func newRemoteDispatchTokenFn(matchingClient matchingserviceclient.Interface) dispatchTokenFn {
+	return func(domainID string, activityType string, count int32) (int32, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), dispatchTokenRequestTimeout)
+		defer cancel()
+		resp, err := matchingClient.AcquireDispatchTokens(ctx, &m.AcquireDispatchTokensRequest{
+			DomainUUID:   common.StringPtr(domainID),
+			ActivityType: common.StringPtr(activityType),
+			Count:        common.Int32Ptr(count),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return resp.GetGranted(), nil
+	}
+}
+
+func newDispatchBudget(config *Config, domainCache cache.DomainCache, timeSource clock.TimeSource) *dispatchBudget {
+	return &dispatchBudget{
+		config:      config,
+		domainCache: domainCache,
+		timeSource:  timeSource,
+		buckets:     make(map[activityTypeKey]*dispatchBudgetBucket),
+	}
+}
+
+// acquire grants up to count tokens from the dispatch budget of the activity type, the whole count is granted
+// if the activity type is not limited
+func (b *dispatchBudget) acquire(domainID string, activityType string, count int32) (int32, error) {
+	rps, err := getActivityTypeDispatchRPS(b.config, b.domainCache, domainID, activityType)
+	if err != nil {
+		return 0, err
+	}
+	if rps <= 0 {
+		return count, nil
+	}
+
+	b.Lock()
+	key := activityTypeKey{domainID: domainID, activityType: activityType}
+	bucket, ok := b.buckets[key]
+	if !ok {
+		bucket = &dispatchBudgetBucket{rps: rps, bucket: tokenbucket.New(rps, b.timeSource)}
+		b.buckets[key] = bucket
+	} else if bucket.rps != rps {
+		bucket.rps = rps
+		bucket.bucket.Reset(rps)
+	}
+	b.Unlock()
+
+	for n := count; n > 0; n /= 2 {
+		if ok, _ := bucket.bucket.TryConsume(int(n)); ok {
+			return n, nil
+		}
+	}
+	return 0, nil
+}
+
+func getActivityTypeDispatchRPS(
+	config *Config,
+	domainCache cache.DomainCache,
+	domainID string,
+	activityType string,
+) (int, error) {
+	domainEntry, err := domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return 0, err
+	}
+	return config.ActivityTypeDispatchRPS(domainEntry.GetInfo().Name, activityType), nil
+}
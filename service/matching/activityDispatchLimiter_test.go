@@ -0,0 +1,186 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	testDispatchDomainID     = "domain-id"
+	testDispatchActivityType = "activity-type"
+)
+
+type dispatchTokenCalls struct {
+	counts  []int32
+	granted int32
+	err     error
+}
+
+func (c *dispatchTokenCalls) acquire(domainID string, activityType string, count int32) (int32, error) {
+	c.counts = append(c.counts, count)
+	return c.granted, c.err
+}
+
+func newTestActivityDispatchLimiter(
+	t *testing.T, rps int, acquireTokens dispatchTokenFn, timeSource clock.TimeSource,
+) *activityDispatchLimiter {
+	config := defaultTestConfig()
+	config.ActivityTypeDispatchRPS = dynamicconfig.GetIntPropertyFilteredByActivityType(rps)
+	domainCache := &cache.DomainCacheMock{}
+	domainCache.On("GetDomainByID", mock.Anything).Return(cache.CreateDomainCacheEntry("domainName"), nil)
+	logger, err := loggerimpl.NewDevelopment()
+	require.NoError(t, err)
+	return newActivityDispatchLimiter(
+		config, domainCache, acquireTokens, timeSource, metrics.NewClient(tally.NoopScope, metrics.Matching), logger,
+	)
+}
+
+func TestActivityDispatchLimiter_Unlimited(t *testing.T) {
+	calls := &dispatchTokenCalls{}
+	limiter := newTestActivityDispatchLimiter(t, 0, calls.acquire, clock.NewEventTimeSource().Update(time.Now()))
+
+	require.True(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	require.True(t, limiter.tryAcquire(testDispatchDomainID, ""))
+	require.Empty(t, calls.counts)
+}
+
+func TestActivityDispatchLimiter_LeasesTokens(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	calls := &dispatchTokenCalls{granted: 3}
+	limiter := newTestActivityDispatchLimiter(t, 50, calls.acquire, timeSource)
+
+	for i := 0; i < 3; i++ {
+		require.True(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	}
+	// 100ms worth of the budget is requested once, and the granted tokens are used locally
+	require.Equal(t, []int32{5}, calls.counts)
+
+	calls.granted = 0
+	require.False(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	require.False(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	// the budget owner is not asked again until the retry interval passes
+	require.Equal(t, []int32{5, 5}, calls.counts)
+
+	timeSource.Update(timeSource.Now().Add(dispatchTokenRetryInterval))
+	calls.granted = 1
+	require.True(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	require.Equal(t, []int32{5, 5, 5}, calls.counts)
+}
+
+func TestActivityDispatchLimiter_ReleaseAndExpiry(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	calls := &dispatchTokenCalls{granted: 1}
+	limiter := newTestActivityDispatchLimiter(t, 10, calls.acquire, timeSource)
+
+	require.True(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	limiter.release(testDispatchDomainID, testDispatchActivityType)
+	require.True(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	require.Len(t, calls.counts, 1)
+
+	limiter.release(testDispatchDomainID, testDispatchActivityType)
+	timeSource.Update(timeSource.Now().Add(dispatchTokenLeaseInterval))
+	calls.granted = 0
+	// the released token expired with the lease
+	require.False(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	require.Len(t, calls.counts, 2)
+}
+
+func TestActivityDispatchLimiter_OwnerUnavailable(t *testing.T) {
+	calls := &dispatchTokenCalls{granted: 5, err: errors.New("owner unavailable")}
+	limiter := newTestActivityDispatchLimiter(t, 10, calls.acquire, clock.NewEventTimeSource().Update(time.Now()))
+
+	require.False(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+}
+
+func TestActivityDispatchLimiter_SingleRefresher(t *testing.T) {
+	var limiter *activityDispatchLimiter
+	calls := &dispatchTokenCalls{granted: 2}
+	acquireTokens := func(domainID string, activityType string, count int32) (int32, error) {
+		// the lease is not locked while the budget owner is asked, a concurrent caller is refused right away
+		require.False(t, limiter.tryAcquire(domainID, activityType))
+		return calls.acquire(domainID, activityType, count)
+	}
+	limiter = newTestActivityDispatchLimiter(t, 10, acquireTokens, clock.NewEventTimeSource().Update(time.Now()))
+
+	require.True(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	require.True(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	require.Len(t, calls.counts, 1)
+}
+
+func TestActivityDispatchLimiter_IdleLeasesExpire(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	calls := &dispatchTokenCalls{granted: 1}
+	limiter := newTestActivityDispatchLimiter(t, 10, calls.acquire, timeSource)
+
+	require.True(t, limiter.tryAcquire(testDispatchDomainID, testDispatchActivityType))
+	require.Len(t, limiter.leases, 1)
+
+	timeSource.Update(timeSource.Now().Add(dispatchTokenLeaseIdleTimeout))
+	require.True(t, limiter.tryAcquire(testDispatchDomainID, "other-activity-type"))
+	// the lease not used within the idle timeout is dropped
+	require.Len(t, limiter.leases, 1)
+	_, ok := limiter.leases[activityTypeKey{domainID: testDispatchDomainID, activityType: "other-activity-type"}]
+	require.True(t, ok)
+}
+
+func TestDispatchBudget_Acquire(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	config := defaultTestConfig()
+	config.ActivityTypeDispatchRPS = dynamicconfig.GetIntPropertyFilteredByActivityType(20)
+	domainCache := &cache.DomainCacheMock{}
+	domainCache.On("GetDomainByID", mock.Anything).Return(cache.CreateDomainCacheEntry("domainName"), nil)
+	budget := newDispatchBudget(config, domainCache, timeSource)
+
+	// 20 rps refills 2 tokens every 100ms, fewer tokens than requested are granted
+	granted, err := budget.acquire(testDispatchDomainID, testDispatchActivityType, 5)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), granted)
+	granted, err = budget.acquire(testDispatchDomainID, testDispatchActivityType, 5)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), granted)
+
+	// budgets of other activity types are independent
+	granted, err = budget.acquire(testDispatchDomainID, "other-activity-type", 1)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), granted)
+
+	timeSource.Update(timeSource.Now().Add(100 * time.Millisecond))
+	granted, err = budget.acquire(testDispatchDomainID, testDispatchActivityType, 5)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), granted)
+
+	config.ActivityTypeDispatchRPS = dynamicconfig.GetIntPropertyFilteredByActivityType(0)
+	granted, err = budget.acquire(testDispatchDomainID, testDispatchActivityType, 5)
+	require.NoError(t, err)
+	require.Equal(t, int32(5), granted)
+}
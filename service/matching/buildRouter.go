@@ -0,0 +1,191 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// buildRouter routes the decision tasks of a task list to pollers of the worker build, identified by its
+	// binary checksum, which the workflow last made progress with. This keeps workers of an old build from
+	// picking up workflows already advanced by a new and possibly incompatible build during a rolling deploy.
+	// The build promoted as default for the task list, together with the builds declared compatible with it,
+	// forms a compatible set whose pollers share the routed tasks of all builds of the set. Decision tasks of
+	// workflows which did not complete a decision yet are routed to the compatible set, if any. Tasks are
+	// routed only while pollers of their build are around, a build is considered gone once none of its
+	// pollers polled within the fallback timeout. Routing is off while the fallback timeout is zero.
+	buildRouter struct {
+		fallbackTimeout func() time.Duration
+		timeSource      clock.TimeSource
+		// pollerArrivedCh is signaled whenever a poll starts, so tasks waiting for pollers of their build
+		// are retried right away
+		pollerArrivedCh chan struct{}
+
+		sync.RWMutex
+		defaultBuild     string
+		compatibleBuilds []string
+		// groups are keyed by the default build for the builds of the compatible set, and by the build itself
+		// for any other build
+		groups     map[string]*buildPollers
+		compatible map[string]struct{}
+	}
+
+	// buildPollers tracks the pollers of a worker build, or of all builds of the compatible set
+	buildPollers struct {
+		// tasksForPoll delivers routed tasks to the pollers of the build. It must be unbuffered for the
+		// same reason as taskListManagerImpl.tasksForPoll
+		tasksForPoll     chan *getTaskResult
+		outstandingPolls int
+		lastPollTime     time.Time
+	}
+)
+
+func newBuildRouter(fallbackTimeout func() time.Duration, timeSource clock.TimeSource) *buildRouter {
+	return &buildRouter{
+		fallbackTimeout: fallbackTimeout,
+		timeSource:      timeSource,
+		pollerArrivedCh: make(chan struct{}, 1),
+		groups:          make(map[string]*buildPollers),
+		compatible:      make(map[string]struct{}),
+	}
+}
+
+// getBuilds returns the build promoted as default for new workflows and the builds compatible with it
+func (r *buildRouter) getBuilds() (string, []string) {
+	r.RLock()
+	defer r.RUnlock()
+	return r.defaultBuild, r.compatibleBuilds
+}
+
+// setBuilds promotes the given build as default for new workflows along with the builds compatible with it,
+// an empty defaultBuild clears the compatible set. Pollers of builds joining or leaving the set switch groups
+// with their next poll.
+func (r *buildRouter) setBuilds(defaultBuild string, compatibleBuilds []string) {
+	r.Lock()
+	defer r.Unlock()
+	r.defaultBuild = defaultBuild
+	r.compatibleBuilds = compatibleBuilds
+	r.compatible = make(map[string]struct{})
+	if defaultBuild == "" {
+		return
+	}
+	r.compatible[defaultBuild] = struct{}{}
+	for _, build := range compatibleBuilds {
+		r.compatible[build] = struct{}{}
+	}
+}
+
+// groupKeyLocked returns the key of the poller group of the given build
+func (r *buildRouter) groupKeyLocked(build string) string {
+	if _, ok := r.compatible[build]; ok {
+		return r.defaultBuild
+	}
+	return build
+}
+
+// pollStarted records a poll by a poller of the given build and returns the group of the poller, which it
+// receives routed tasks from. Every call must be followed by a call to pollDone once the poll returns.
+func (r *buildRouter) pollStarted(build string) *buildPollers {
+	r.Lock()
+	key := r.groupKeyLocked(build)
+	pollers, ok := r.groups[key]
+	if !ok {
+		r.evictGoneGroupsLocked()
+		pollers = &buildPollers{tasksForPoll: make(chan *getTaskResult)}
+		r.groups[key] = pollers
+	}
+	pollers.outstandingPolls++
+	pollers.lastPollTime = r.timeSource.Now()
+	r.Unlock()
+
+	select {
+	case r.pollerArrivedCh <- struct{}{}:
+	default:
+	}
+	return pollers
+}
+
+// pollDone records the end of a poll of the given poller group
+func (r *buildRouter) pollDone(pollers *buildPollers) {
+	r.Lock()
+	defer r.Unlock()
+	pollers.outstandingPolls--
+	pollers.lastPollTime = r.timeSource.Now()
+}
+
+// route returns the key of the poller group the given decision task has to be dispatched to along with the
+// channel of the group, or a nil channel if the task is not routed and can be dispatched to any poller
+func (r *buildRouter) route(task *persistence.TaskInfo) (string, chan *getTaskResult) {
+	if r.fallbackTimeout() <= 0 {
+		return "", nil
+	}
+
+	r.RLock()
+	defer r.RUnlock()
+	build := task.BinaryChecksum
+	if build == "" {
+		build = r.defaultBuild
+	}
+	if build == "" {
+		return "", nil
+	}
+	key := r.groupKeyLocked(build)
+	return key, r.tasksForPollLocked(key)
+}
+
+// tasksForPoll returns the channel routed tasks are delivered to the given poller group on, nil once the
+// group is gone or routing is off
+func (r *buildRouter) tasksForPoll(key string) chan *getTaskResult {
+	if r.fallbackTimeout() <= 0 {
+		return nil
+	}
+
+	r.RLock()
+	defer r.RUnlock()
+	return r.tasksForPollLocked(key)
+}
+
+func (r *buildRouter) tasksForPollLocked(key string) chan *getTaskResult {
+	pollers, ok := r.groups[key]
+	if !ok {
+		return nil
+	}
+	if pollers.outstandingPolls == 0 && r.timeSource.Now().Sub(pollers.lastPollTime) > r.fallbackTimeout() {
+		return nil
+	}
+	return pollers.tasksForPoll
+}
+
+// evictGoneGroupsLocked drops the poller groups of builds which are gone, so retired builds do not pile up
+func (r *buildRouter) evictGoneGroupsLocked() {
+	now := r.timeSource.Now()
+	fallbackTimeout := r.fallbackTimeout()
+	for key, pollers := range r.groups {
+		if pollers.outstandingPolls == 0 && now.Sub(pollers.lastPollTime) > fallbackTimeout {
+			delete(r.groups, key)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func newTestBuildRouter(fallbackTimeout time.Duration, timeSource clock.TimeSource) *buildRouter {
+	return newBuildRouter(func() time.Duration { return fallbackTimeout }, timeSource)
+}
+
+func TestBuildRouter_RoutesToBuildOfWorkflow(t *testing.T) {
+	router := newTestBuildRouter(10*time.Second, clock.NewEventTimeSource().Update(time.Now()))
+	pollers1 := router.pollStarted("build-1")
+	pollers2 := router.pollStarted("build-2")
+	require.NotEqual(t, pollers1.tasksForPoll, pollers2.tasksForPoll)
+
+	key, ch := router.route(&persistence.TaskInfo{BinaryChecksum: "build-1"})
+	require.Equal(t, "build-1", key)
+	require.Equal(t, pollers1.tasksForPoll, ch)
+	_, ch = router.route(&persistence.TaskInfo{BinaryChecksum: "build-2"})
+	require.Equal(t, pollers2.tasksForPoll, ch)
+	_, ch = router.route(&persistence.TaskInfo{BinaryChecksum: "build-3"})
+	require.Nil(t, ch)
+	_, ch = router.route(&persistence.TaskInfo{})
+	require.Nil(t, ch)
+}
+
+func TestBuildRouter_CompatibleBuildsSharePollers(t *testing.T) {
+	router := newTestBuildRouter(10*time.Second, clock.NewEventTimeSource().Update(time.Now()))
+	router.setBuilds("build-2", []string{"build-1"})
+	pollers1 := router.pollStarted("build-1")
+	pollers2 := router.pollStarted("build-2")
+	pollers3 := router.pollStarted("build-3")
+	require.Equal(t, pollers1, pollers2)
+	require.NotEqual(t, pollers2, pollers3)
+
+	// new workflows and workflows of any build of the compatible set go to the pollers of the set
+	for _, build := range []string{"", "build-1", "build-2"} {
+		key, ch := router.route(&persistence.TaskInfo{BinaryChecksum: build})
+		require.Equal(t, "build-2", key)
+		require.Equal(t, pollers2.tasksForPoll, ch)
+	}
+	_, ch := router.route(&persistence.TaskInfo{BinaryChecksum: "build-3"})
+	require.Equal(t, pollers3.tasksForPoll, ch)
+
+	defaultBuild, compatibleBuilds := router.getBuilds()
+	require.Equal(t, "build-2", defaultBuild)
+	require.Equal(t, []string{"build-1"}, compatibleBuilds)
+
+	// pollers of build-1 get their own group with their next poll once it leaves the set
+	router.setBuilds("build-2", nil)
+	require.NotEqual(t, pollers2, router.pollStarted("build-1"))
+	_, ch = router.route(&persistence.TaskInfo{})
+	require.Equal(t, pollers2.tasksForPoll, ch)
+
+	router.setBuilds("", nil)
+	_, ch = router.route(&persistence.TaskInfo{})
+	require.Nil(t, ch)
+}
+
+func TestBuildRouter_GoneBuildNotRouted(t *testing.T) {
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	router := newTestBuildRouter(10*time.Second, timeSource)
+	pollers := router.pollStarted("build-1")
+	task := &persistence.TaskInfo{BinaryChecksum: "build-1"}
+
+	// an outstanding poll keeps the build around however long it lasts
+	timeSource.Update(now.Add(time.Minute))
+	_, ch := router.route(task)
+	require.Equal(t, pollers.tasksForPoll, ch)
+
+	router.pollDone(pollers)
+	timeSource.Update(now.Add(time.Minute + 5*time.Second))
+	_, ch = router.route(task)
+	require.Equal(t, pollers.tasksForPoll, ch)
+
+	timeSource.Update(now.Add(time.Minute + 11*time.Second))
+	_, ch = router.route(task)
+	require.Nil(t, ch)
+	require.Nil(t, router.tasksForPoll("build-1"))
+
+	// gone builds are evicted once pollers of another build show up
+	router.pollStarted("build-2")
+	require.NotContains(t, router.groups, "build-1")
+	require.NotEqual(t, pollers, router.pollStarted("build-1"))
+}
+
+func TestBuildRouter_PollerArrivedSignaled(t *testing.T) {
+	router := newTestBuildRouter(10*time.Second, clock.NewEventTimeSource().Update(time.Now()))
+	router.pollStarted("build-1")
+	router.pollStarted("build-1")
+	select {
+	case <-router.pollerArrivedCh:
+	default:
+		require.Fail(t, "poller arrival not signaled")
+	}
+	select {
+	case <-router.pollerArrivedCh:
+		require.Fail(t, "poller arrival signaled more than once")
+	default:
+	}
+}
+
+func TestBuildRouter_Disabled(t *testing.T) {
+	router := newTestBuildRouter(0, clock.NewEventTimeSource().Update(time.Now()))
+	router.setBuilds("build-1", nil)
+	router.pollStarted("build-1")
+	_, ch := router.route(&persistence.TaskInfo{BinaryChecksum: "build-1"})
+	require.Nil(t, ch)
+	_, ch = router.route(&persistence.TaskInfo{})
+	require.Nil(t, ch)
+	require.Nil(t, router.tasksForPoll("build-1"))
+}
@@ -38,12 +38,19 @@ type (
 		taskType     int
 		rangeID      int64
 		ackLevel     int64
-		store        persistence.TaskManager
-		logger       log.Logger
+		// worker build promoted as default for new workflows on the task list
+		defaultBinaryChecksum string
+		// worker builds compatible with the default build
+		compatibleBinaryChecksums []string
+		store                     persistence.TaskManager
+		logger                    log.Logger
 	}
 	taskListState struct {
-		rangeID  int64
-		ackLevel int64
+		rangeID               int64
+		ackLevel              int64
+		defaultBinaryChecksum string
+		// compatibleBinaryChecksums are the worker builds compatible with the default build
+		compatibleBinaryChecksums []string
 	}
 )
 
@@ -92,7 +99,14 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	}
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
-	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
+	db.defaultBinaryChecksum = resp.TaskListInfo.DefaultBinaryChecksum
+	db.compatibleBinaryChecksums = resp.TaskListInfo.CompatibleBinaryChecksums
+	return taskListState{
+		rangeID:                   db.rangeID,
+		ackLevel:                  db.ackLevel,
+		defaultBinaryChecksum:     db.defaultBinaryChecksum,
+		compatibleBinaryChecksums: db.compatibleBinaryChecksums,
+	}, nil
 }
 
 // UpdateState updates the taskList state with the given value
@@ -101,16 +115,43 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	defer db.Unlock()
 	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:                  db.domainID,
+			Name:                      db.taskListName,
+			TaskType:                  db.taskType,
+			AckLevel:                  ackLevel,
+			RangeID:                   db.rangeID,
+			Kind:                      db.taskListKind,
+			DefaultBinaryChecksum:     db.defaultBinaryChecksum,
+			CompatibleBinaryChecksums: db.compatibleBinaryChecksums,
+		},
+	})
+	if err == nil {
+		db.ackLevel = ackLevel
+	}
+	return err
+}
+
+// UpdateBuilds updates the worker build promoted as default for new workflows on the taskList and the builds
+// compatible with it, along with the given ackLevel
+func (db *taskListDB) UpdateBuilds(ackLevel int64, binaryChecksum string, compatibleBinaryChecksums []string) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:                  db.domainID,
+			Name:                      db.taskListName,
+			TaskType:                  db.taskType,
+			AckLevel:                  ackLevel,
+			RangeID:                   db.rangeID,
+			Kind:                      db.taskListKind,
+			DefaultBinaryChecksum:     binaryChecksum,
+			CompatibleBinaryChecksums: compatibleBinaryChecksums,
 		},
 	})
 	if err == nil {
 		db.ackLevel = ackLevel
+		db.defaultBinaryChecksum = binaryChecksum
+		db.compatibleBinaryChecksums = compatibleBinaryChecksums
 	}
 	return err
 }
@@ -121,12 +162,14 @@ func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persist
 	defer db.Unlock()
 	return db.store.CreateTasks(&persistence.CreateTasksRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: db.ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:                  db.domainID,
+			Name:                      db.taskListName,
+			TaskType:                  db.taskType,
+			AckLevel:                  db.ackLevel,
+			RangeID:                   db.rangeID,
+			Kind:                      db.taskListKind,
+			DefaultBinaryChecksum:     db.defaultBinaryChecksum,
+			CompatibleBinaryChecksums: db.compatibleBinaryChecksums,
 		},
 		Tasks: tasks,
 	})
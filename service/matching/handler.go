@@ -52,6 +52,7 @@ type Handler struct {
 	startWG         sync.WaitGroup
 	domainCache     cache.DomainCache
 	rateLimiter     tokenbucket.TokenBucket
+	pollAdmitter    *pollAdmitter
 	service.Service
 }
 
@@ -67,6 +68,7 @@ func NewHandler(sVice service.Service, config *Config, taskPersistence persisten
 		metadataMgr:     metadataMgr,
 		config:          config,
 		rateLimiter:     tokenbucket.New(config.RPS(), clock.NewRealTimeSource()),
+		pollAdmitter:    newPollAdmitter(config),
 	}
 	// prevent us from trying to serve requests before matching engine is started and ready
 	handler.startWG.Add(1)
@@ -87,6 +89,7 @@ func (h *Handler) Start() error {
 	h.metricsClient = h.Service.GetMetricsClient()
 	h.engine = NewEngine(
 		h.taskPersistence, h.GetClientBean().GetHistoryClient(), h.config, h.Service.GetLogger(), h.Service.GetMetricsClient(), h.domainCache,
+		h.GetClientBean().GetMatchingClient(),
 	)
 	h.startWG.Done()
 	return nil
@@ -173,6 +176,12 @@ func (h *Handler) PollForActivityTask(ctx context.Context,
 		return nil, h.handleErr(err, scope)
 	}
 
+	release, err := h.admitPoll(ctx, pollRequest.GetDomainUUID(), scope)
+	if err != nil {
+		return nil, h.handleErr(err, scope)
+	}
+	defer release()
+
 	response, err := h.engine.PollForActivityTask(ctx, pollRequest)
 	return response, h.handleErr(err, scope)
 }
@@ -194,10 +203,58 @@ func (h *Handler) PollForDecisionTask(ctx context.Context,
 		return nil, h.handleErr(err, scope)
 	}
 
+	release, err := h.admitPoll(ctx, pollRequest.GetDomainUUID(), scope)
+	if err != nil {
+		return nil, h.handleErr(err, scope)
+	}
+	defer release()
+
 	response, err := h.engine.PollForDecisionTask(ctx, pollRequest)
 	return response, h.handleErr(err, scope)
 }
 
+// MigrateTaskListBacklog moves a page of the backlog of a task list to another task list.
+func (h *Handler) MigrateTaskListBacklog(ctx context.Context,
+	request *m.MigrateTaskListBacklogRequest) (resp *m.MigrateTaskListBacklogResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	scope := metrics.MatchingMigrateTaskListBacklogScope
+	sw := h.startRequestProfile("MigrateTaskListBacklog", scope)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	response, err := h.engine.MigrateTaskListBacklog(ctx, request)
+	return response, h.handleErr(err, scope)
+}
+
+// admitPoll waits for the admission of a poll of the domain, the returned func must be called once the
+// poll completes
+func (h *Handler) admitPoll(ctx context.Context, domainID string, scope int) (func(), error) {
+	domainEntry, err := h.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+	domainScope := h.metricsClient.Scope(scope, metrics.DomainTag(domainEntry.GetInfo().Name))
+
+	startTime := time.Now()
+	release, result, err := h.pollAdmitter.admit(ctx, domainEntry.GetInfo().Name)
+	if result.queued {
+		domainScope.IncCounter(metrics.PollAdmissionQueuedCounter)
+		domainScope.RecordTimer(metrics.PollAdmissionLatency, time.Since(startTime))
+	}
+	switch err {
+	case nil:
+		domainScope.IncCounter(metrics.PollAdmittedCounter)
+	case errDomainPollLimitExceeded:
+		domainScope.IncCounter(metrics.PollDomainLimitExceededCounter)
+	case errPollAdmissionTimeout:
+		domainScope.IncCounter(metrics.PollAdmissionTimeoutCounter)
+	}
+	return release, err
+}
+
 // QueryWorkflow queries a given workflow synchronously and return the query result.
 func (h *Handler) QueryWorkflow(ctx context.Context,
 	queryRequest *m.QueryWorkflowRequest) (resp *gen.QueryWorkflowResponse, retError error) {
@@ -260,6 +317,36 @@ func (h *Handler) DescribeTaskList(ctx context.Context, request *m.DescribeTaskL
 	return response, h.handleErr(err, scope)
 }
 
+// AcquireDispatchTokens leases tokens from the dispatch budget of an activity type owned by this host.
+func (h *Handler) AcquireDispatchTokens(ctx context.Context, request *m.AcquireDispatchTokensRequest) (resp *m.AcquireDispatchTokensResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	scope := metrics.MatchingAcquireDispatchTokensScope
+	sw := h.startRequestProfile("AcquireDispatchTokens", scope)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	response, err := h.engine.AcquireDispatchTokens(ctx, request)
+	return response, h.handleErr(err, scope)
+}
+
+// PromoteTaskListBuild promotes a worker build as the default for new workflows on a decision task list.
+func (h *Handler) PromoteTaskListBuild(ctx context.Context, request *m.PromoteTaskListBuildRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	scope := metrics.MatchingPromoteTaskListBuildScope
+	sw := h.startRequestProfile("PromoteTaskListBuild", scope)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	err := h.engine.PromoteTaskListBuild(ctx, request)
+	return h.handleErr(err, scope)
+}
+
 func (h *Handler) handleErr(err error, scope int) error {
 
 	if err == nil {
@@ -26,15 +26,18 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"time"
 
 	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
 	m "github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/matching/matchingserviceclient"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -47,6 +50,7 @@ import (
 type matchingEngineImpl struct {
 	taskManager     persistence.TaskManager
 	historyService  history.Client
+	matchingClient  matchingserviceclient.Interface
 	tokenSerializer common.TaskTokenSerializer
 	logger          log.Logger
 	metricsClient   metrics.Client
@@ -59,6 +63,10 @@ type matchingEngineImpl struct {
 	// unblock QueryWorkflow() call.
 	queryTaskMap map[string]chan *queryResult
 	domainCache  cache.DomainCache
+	// activityLimiter limits the dispatch rate of activity types across task lists and matching hosts,
+	// dispatchBudget keeps the budgets of the activity types owned by this host
+	activityLimiter *activityDispatchLimiter
+	dispatchBudget  *dispatchBudget
 }
 
 type taskListID struct {
@@ -69,6 +77,7 @@ type taskListID struct {
 
 type pollerIDCtxKey string
 type identityCtxKey string
+type binaryChecksumCtxKey string
 
 var (
 	// EmptyPollForDecisionTaskResponse is the response when there are no decision tasks to hand out
@@ -82,13 +91,16 @@ var (
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
 
-	pollerIDKey pollerIDCtxKey = "pollerID"
-	identityKey identityCtxKey = "identity"
+	pollerIDKey       pollerIDCtxKey       = "pollerID"
+	identityKey       identityCtxKey       = "identity"
+	binaryChecksumKey binaryChecksumCtxKey = "binaryChecksum"
 )
 
 const (
 	maxQueryWaitCount = 5
 	maxQueryLoopCount = 5
+
+	defaultMigrateTaskListPageSize = 100
 )
 
 func (t *taskListID) String() string {
@@ -113,18 +125,26 @@ func NewEngine(taskManager persistence.TaskManager,
 	logger log.Logger,
 	metricsClient metrics.Client,
 	domainCache cache.DomainCache,
+	matchingClient matchingserviceclient.Interface,
 ) Engine {
 
+	logger = logger.WithTags(tag.ComponentMatchingEngine)
+	timeSource := clock.NewRealTimeSource()
 	return &matchingEngineImpl{
 		taskManager:     taskManager,
 		historyService:  historyService,
+		matchingClient:  matchingClient,
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		taskLists:       make(map[taskListID]taskListManager),
-		logger:          logger.WithTags(tag.ComponentMatchingEngine),
+		logger:          logger,
 		metricsClient:   metricsClient,
 		config:          config,
 		queryTaskMap:    make(map[string]chan *queryResult),
 		domainCache:     domainCache,
+		activityLimiter: newActivityDispatchLimiter(
+			config, domainCache, newRemoteDispatchTokenFn(matchingClient), timeSource, metricsClient, logger,
+		),
+		dispatchBudget: newDispatchBudget(config, domainCache, timeSource),
 	}
 }
 
@@ -232,6 +252,8 @@ func (e *matchingEngineImpl) AddDecisionTask(addRequest *m.AddDecisionTaskReques
 		WorkflowID:             addRequest.Execution.GetWorkflowId(),
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
+		BinaryChecksum:         addRequest.GetBinaryChecksum(),
+		CreatedTime:            time.Now(),
 	}
 	return tlMgr.AddTask(addRequest.Execution, taskInfo)
 }
@@ -255,6 +277,8 @@ func (e *matchingEngineImpl) AddActivityTask(addRequest *m.AddActivityTaskReques
 		WorkflowID:             addRequest.Execution.GetWorkflowId(),
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
+		ActivityType:           addRequest.GetActivityType(),
+		CreatedTime:            time.Now(),
 	}
 	return tlMgr.AddTask(addRequest.Execution, taskInfo)
 }
@@ -279,6 +303,7 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, binaryChecksumKey, request.GetBinaryChecksum())
 		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
 		taskListKind := common.TaskListKindPtr(request.TaskList.GetKind())
 		tCtx, err := e.getTask(pollerCtx, taskList, nil, taskListKind)
@@ -407,6 +432,8 @@ pollLoop:
 			PollRequest:       request,
 		})
 		if err != nil {
+			// the task did not reach a worker, its dispatch token goes back to the activity type budget
+			e.activityLimiter.release(domainID, tCtx.info.ActivityType)
 			switch err.(type) {
 			case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError:
 				e.logger.Debug(fmt.Sprintf("Duplicated activity task taskList=%v, taskID=%v",
@@ -571,6 +598,75 @@ func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.De
 	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
 }
 
+// AcquireDispatchTokens grants tokens from the dispatch budget of an activity type owned by this host
+func (e *matchingEngineImpl) AcquireDispatchTokens(
+	ctx context.Context,
+	request *m.AcquireDispatchTokensRequest,
+) (*m.AcquireDispatchTokensResponse, error) {
+	if request.GetDomainUUID() == "" || request.GetActivityType() == "" || request.GetCount() <= 0 {
+		return nil, &workflow.BadRequestError{Message: "DomainUUID, ActivityType and a positive Count are required."}
+	}
+
+	granted, err := e.dispatchBudget.acquire(request.GetDomainUUID(), request.GetActivityType(), request.GetCount())
+	if err != nil {
+		return nil, err
+	}
+	return &m.AcquireDispatchTokensResponse{Granted: common.Int32Ptr(granted)}, nil
+}
+
+// PromoteTaskListBuild promotes a worker build as the default for new workflows on a decision task list
+func (e *matchingEngineImpl) PromoteTaskListBuild(ctx context.Context, request *m.PromoteTaskListBuildRequest) error {
+	domainID := request.GetDomainUUID()
+	taskListName := request.TaskList.GetName()
+	if domainID == "" || taskListName == "" {
+		return &workflow.BadRequestError{Message: "DomainUUID and TaskList are required."}
+	}
+
+	taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
+	tlMgr, err := e.getTaskListManager(taskList, common.TaskListKindPtr(workflow.TaskListKindNormal))
+	if err != nil {
+		return err
+	}
+	return tlMgr.PromoteBuild(request.GetBinaryChecksum(), request.GetCompatibleBinaryChecksums())
+}
+
+// MigrateTaskListBacklog moves a page of the persisted backlog of a task list to another task list of the domain
+func (e *matchingEngineImpl) MigrateTaskListBacklog(
+	ctx context.Context,
+	request *m.MigrateTaskListBacklogRequest,
+) (*m.MigrateTaskListBacklogResponse, error) {
+	domainID := request.GetDomainUUID()
+	sourceTaskListName := request.SourceTaskList.GetName()
+	targetTaskListName := request.TargetTaskList.GetName()
+	if domainID == "" || sourceTaskListName == "" || targetTaskListName == "" {
+		return nil, &workflow.BadRequestError{Message: "DomainUUID, SourceTaskList and TargetTaskList are required."}
+	}
+	if sourceTaskListName == targetTaskListName {
+		return nil, &workflow.BadRequestError{Message: "SourceTaskList and TargetTaskList must be different."}
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultMigrateTaskListPageSize
+	}
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == workflow.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+
+	// the source task list is loaded on this host, which makes it the owner of the task list while its
+	// backlog is migrated
+	taskList := newTaskListID(domainID, sourceTaskListName, taskListType)
+	tlMgr, err := e.getTaskListManager(taskList, common.TaskListKindPtr(workflow.TaskListKindNormal))
+	if err != nil {
+		return nil, err
+	}
+	targetTaskList := &workflow.TaskList{
+		Name: common.StringPtr(targetTaskListName),
+		Kind: common.TaskListKindPtr(workflow.TaskListKindNormal),
+	}
+	return tlMgr.MigrateBacklog(ctx, targetTaskList, request.GetReadLevel(), pageSize, request.GetDryRun())
+}
+
 // Loads a task from persistence and wraps it in a task context
 func (e *matchingEngineImpl) getTask(
 	ctx context.Context, taskList *taskListID, maxDispatchPerSecond *float64, taskListKind *workflow.TaskListKind,
@@ -648,6 +744,7 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(context *taskCont
 	response.ActivityType = attributes.ActivityType
 	response.Header = attributes.Header
 	response.Input = attributes.Input
+	response.InputReference = attributes.InputReference
 	response.WorkflowExecution = workflowExecutionPtr(context.workflowExecution)
 	response.ScheduledTimestampOfThisAttempt = historyResponse.ScheduledTimestampOfThisAttempt
 	response.ScheduledTimestamp = common.Int64Ptr(*scheduledEvent.Timestamp)
@@ -39,5 +39,8 @@ type (
 		RespondQueryTaskCompleted(ctx context.Context, request *m.RespondQueryTaskCompletedRequest) error
 		CancelOutstandingPoll(ctx context.Context, request *m.CancelOutstandingPollRequest) error
 		DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error)
+		AcquireDispatchTokens(ctx context.Context, request *m.AcquireDispatchTokensRequest) (*m.AcquireDispatchTokensResponse, error)
+		PromoteTaskListBuild(ctx context.Context, request *m.PromoteTaskListBuildRequest) error
+		MigrateTaskListBacklog(ctx context.Context, request *m.MigrateTaskListBacklogRequest) (*m.MigrateTaskListBacklogResponse, error)
 	}
 )
@@ -50,6 +50,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 )
 
 type (
@@ -120,15 +121,21 @@ func newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager, historyClient history.Client,
 	logger log.Logger, domainCache cache.DomainCache,
 ) *matchingEngineImpl {
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Matching)
+	budget := newDispatchBudget(config, domainCache, clock.NewRealTimeSource())
 	return &matchingEngineImpl{
 		taskManager:     taskMgr,
 		historyService:  historyClient,
 		taskLists:       make(map[taskListID]taskListManager),
 		logger:          logger,
-		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.Matching),
+		metricsClient:   metricsClient,
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		config:          config,
 		domainCache:     domainCache,
+		activityLimiter: newActivityDispatchLimiter(
+			config, domainCache, budget.acquire, clock.NewRealTimeSource(), metricsClient, logger,
+		),
+		dispatchBudget: budget,
 	}
 }
 
@@ -148,11 +155,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	const t4 = 340
 	const t5 = 360
 
-	m.addTask(t1)
+	m.addTask(t1, time.Time{})
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t1, m.getReadLevel())
 
-	m.addTask(t2)
+	m.addTask(t2, time.Time{})
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t2, m.getReadLevel())
 
@@ -168,11 +175,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(300, m.getReadLevel())
 
-	m.addTask(t3)
+	m.addTask(t3, time.Time{})
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t3, m.getReadLevel())
 
-	m.addTask(t4)
+	m.addTask(t4, time.Time{})
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t4, m.getReadLevel())
 
@@ -416,6 +423,104 @@ func (s *matchingEngineSuite) AddTasksTest(taskType int) {
 
 }
 
+func (s *matchingEngineSuite) TestPromoteTaskListBuild() {
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := &taskListID{domainID: domainID, taskListName: tl, taskType: persistence.TaskListTypeDecision}
+
+	err := s.matchingEngine.PromoteTaskListBuild(s.callContext, &matching.PromoteTaskListBuildRequest{
+		DomainUUID:                common.StringPtr(domainID),
+		TaskList:                  &workflow.TaskList{Name: common.StringPtr(tl)},
+		BinaryChecksum:            common.StringPtr("build-2"),
+		CompatibleBinaryChecksums: []string{"build-1"},
+	})
+	s.NoError(err)
+	s.Equal("build-2", s.taskManager.getTaskListManager(tlID).defaultBinaryChecksum)
+	s.Equal([]string{"build-1"}, s.taskManager.getTaskListManager(tlID).compatibleBuilds)
+
+	// reloading the task list picks up the promoted builds
+	s.matchingEngine.unloadTaskList(tlID)
+	mgr, err := s.matchingEngine.getTaskListManager(tlID, common.TaskListKindPtr(workflow.TaskListKindNormal))
+	s.NoError(err)
+	defaultBuild, compatibleBuilds := mgr.(*taskListManagerImpl).buildRouter.getBuilds()
+	s.Equal("build-2", defaultBuild)
+	s.Equal([]string{"build-1"}, compatibleBuilds)
+
+	// compatible builds require a default build
+	err = s.matchingEngine.PromoteTaskListBuild(s.callContext, &matching.PromoteTaskListBuildRequest{
+		DomainUUID:                common.StringPtr(domainID),
+		TaskList:                  &workflow.TaskList{Name: common.StringPtr(tl)},
+		CompatibleBinaryChecksums: []string{"build-1"},
+	})
+	s.Error(err)
+
+	err = s.matchingEngine.PromoteTaskListBuild(s.callContext, &matching.PromoteTaskListBuildRequest{
+		DomainUUID:     common.StringPtr(domainID),
+		BinaryChecksum: common.StringPtr("build-2"),
+	})
+	s.Error(err)
+}
+
+func (s *matchingEngineSuite) TestMigrateTaskListBacklog() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	matchingClient := &mocks.MatchingClient{}
+	s.matchingEngine.matchingClient = matchingClient
+
+	runID := "run1"
+	workflowID := "workflow1"
+	workflowExecution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	const taskCount = 5
+	domainID := "domainId"
+	tl := "makeToast"
+	targetTl := "makeToast-new"
+	tlID := &taskListID{domainID: domainID, taskListName: tl, taskType: persistence.TaskListTypeDecision}
+	taskList := &workflow.TaskList{Name: &tl}
+
+	for i := int64(0); i < taskCount; i++ {
+		scheduleID := i * 3
+		addRequest := matching.AddDecisionTaskRequest{
+			DomainUUID:                    common.StringPtr(domainID),
+			Execution:                     &workflowExecution,
+			ScheduleId:                    &scheduleID,
+			TaskList:                      taskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+		}
+		_, err := s.matchingEngine.AddDecisionTask(&addRequest)
+		s.NoError(err)
+	}
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	request := &matching.MigrateTaskListBacklogRequest{
+		DomainUUID:     common.StringPtr(domainID),
+		SourceTaskList: taskList,
+		TargetTaskList: &workflow.TaskList{Name: &targetTl},
+		TaskListType:   common.TaskListTypePtr(workflow.TaskListTypeDecision),
+		PageSize:       common.Int32Ptr(10),
+		DryRun:         common.BoolPtr(true),
+	}
+	resp, err := s.matchingEngine.MigrateTaskListBacklog(s.callContext, request)
+	s.NoError(err)
+	s.EqualValues(taskCount, resp.GetMigratedTasks())
+	s.False(resp.GetHasMore())
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	matchingClient.On("AddDecisionTask", mock.Anything, mock.MatchedBy(func(req *matching.AddDecisionTaskRequest) bool {
+		return req.TaskList.GetName() == targetTl && req.GetDomainUUID() == domainID &&
+			req.GetScheduleToStartTimeoutSeconds() > 0 && req.GetScheduleToStartTimeoutSeconds() <= 100
+	})).Return(nil).Times(taskCount)
+	request.DryRun = common.BoolPtr(false)
+	resp, err = s.matchingEngine.MigrateTaskListBacklog(s.callContext, request)
+	s.NoError(err)
+	s.EqualValues(taskCount, resp.GetMigratedTasks())
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+	matchingClient.AssertExpectations(s.T())
+
+	request.TargetTaskList = taskList
+	_, err = s.matchingEngine.MigrateTaskListBacklog(s.callContext, request)
+	s.Error(err)
+}
+
 func (s *matchingEngineSuite) TestTaskWriterShutdown() {
 	s.matchingEngine.config.RangeSize = 300 // override to low number for the test
 
@@ -1716,10 +1821,12 @@ func (m *testTaskManager) getTaskListManager(id *taskListID) *testTaskListManage
 
 type testTaskListManager struct {
 	sync.Mutex
-	rangeID         int64
-	ackLevel        int64
-	createTaskCount int
-	tasks           *treemap.Map
+	rangeID               int64
+	ackLevel              int64
+	defaultBinaryChecksum string
+	compatibleBuilds      []string
+	createTaskCount       int
+	tasks                 *treemap.Map
 }
 
 func Int64Comparator(a, b interface{}) int {
@@ -1755,6 +1862,9 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 			TaskType: request.TaskType,
 			RangeID:  tlm.rangeID,
 			Kind:     request.TaskListKind,
+
+			DefaultBinaryChecksum:     tlm.defaultBinaryChecksum,
+			CompatibleBinaryChecksums: tlm.compatibleBuilds,
 		},
 	}, nil
 }
@@ -1774,6 +1884,8 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.defaultBinaryChecksum = tli.DefaultBinaryChecksum
+	tlm.compatibleBuilds = tli.CompatibleBinaryChecksums
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -1858,11 +1970,12 @@ func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (
 	for _, task := range request.Tasks {
 		scheduleID := task.Data.ScheduleID
 		info := &persistence.TaskInfo{
-			DomainID:   domainID,
-			RunID:      *task.Execution.RunId,
-			ScheduleID: scheduleID,
-			TaskID:     task.TaskID,
-			WorkflowID: *task.Execution.WorkflowId,
+			DomainID:     domainID,
+			RunID:        *task.Execution.RunId,
+			ScheduleID:   scheduleID,
+			TaskID:       task.TaskID,
+			WorkflowID:   *task.Execution.WorkflowId,
+			ActivityType: task.Data.ActivityType,
 		}
 		if task.Data.ScheduleToStartTimeout != 0 {
 			info.Expiry = time.Now().Add(time.Duration(task.Data.ScheduleToStartTimeout) * time.Second)
@@ -0,0 +1,204 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+// pollAdmissionRetryAfter is the backoff hint returned to pollers rejected by poll admission
+const pollAdmissionRetryAfter = time.Second
+
+type (
+	// pollAdmitter limits the number of outstanding polls a matching host holds, so that a domain with lots of
+	// idle long polling workers can not starve other domains of connections and goroutines. A domain is limited
+	// to its own number of outstanding polls. Once the host is at its limit polls wait for admission in a queue
+	// per domain, and the domains are served round robin as outstanding polls complete.
+	pollAdmitter struct {
+		maxOutstandingPolls       dynamicconfig.IntPropertyFn
+		domainMaxOutstandingPolls dynamicconfig.IntPropertyFnWithDomainFilter
+		admissionTimeout          dynamicconfig.DurationPropertyFn
+
+		sync.Mutex
+		outstandingPolls int
+		domains          map[string]*domainPolls
+		// domains with polls waiting for admission, in the order they are served
+		waitingDomains []string
+	}
+
+	domainPolls struct {
+		outstandingPolls int
+		waiters          []chan struct{}
+	}
+
+	// pollAdmissionResult tells how a poll was admitted
+	pollAdmissionResult struct {
+		queued bool
+	}
+)
+
+var (
+	errPollAdmissionTimeout = &gen.ServiceBusyError{
+		Message:            fmt.Sprintf("Matching host is at its outstanding poll limit, retry after %v", pollAdmissionRetryAfter),
+		RetryAfterInMillis: common.Int64Ptr(int64(pollAdmissionRetryAfter / time.Millisecond)),
+	}
+	errDomainPollLimitExceeded = &gen.ServiceBusyError{
+		Message:            fmt.Sprintf("Domain is at its outstanding poll limit, retry after %v", pollAdmissionRetryAfter),
+		RetryAfterInMillis: common.Int64Ptr(int64(pollAdmissionRetryAfter / time.Millisecond)),
+	}
+)
+
+func newPollAdmitter(config *Config) *pollAdmitter {
+	return &pollAdmitter{
+		maxOutstandingPolls:       config.MaxOutstandingPolls,
+		domainMaxOutstandingPolls: config.DomainMaxOutstandingPolls,
+		admissionTimeout:          config.PollAdmissionTimeout,
+		domains:                   make(map[string]*domainPolls),
+	}
+}
+
+// admit blocks until a poll of the domain is admitted, the returned func must be called once the poll
+// completes. errDomainPollLimitExceeded or errPollAdmissionTimeout is returned when the poll is rejected.
+func (a *pollAdmitter) admit(ctx context.Context, domain string) (func(), pollAdmissionResult, error) {
+	a.Lock()
+	polls := a.getDomainPolls(domain)
+	domainLimit := a.domainMaxOutstandingPolls(domain)
+	if domainLimit > 0 && polls.outstandingPolls+len(polls.waiters) >= domainLimit {
+		a.cleanupDomain(domain, polls)
+		a.Unlock()
+		return nil, pollAdmissionResult{}, errDomainPollLimitExceeded
+	}
+	// polls waiting for admission go first
+	a.admitWaiting()
+	if len(a.waitingDomains) == 0 && a.hasCapacity() {
+		a.outstandingPolls++
+		polls.outstandingPolls++
+		a.Unlock()
+		return a.releaseFn(domain), pollAdmissionResult{}, nil
+	}
+
+	admitted := make(chan struct{})
+	if len(polls.waiters) == 0 {
+		a.waitingDomains = append(a.waitingDomains, domain)
+	}
+	polls.waiters = append(polls.waiters, admitted)
+	a.Unlock()
+
+	timer := time.NewTimer(a.admissionTimeout())
+	defer timer.Stop()
+	select {
+	case <-admitted:
+		return a.releaseFn(domain), pollAdmissionResult{queued: true}, nil
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	if !a.removeWaiter(domain, polls, admitted) {
+		// admitted while giving up
+		return a.releaseFn(domain), pollAdmissionResult{queued: true}, nil
+	}
+	return nil, pollAdmissionResult{queued: true}, errPollAdmissionTimeout
+}
+
+func (a *pollAdmitter) releaseFn(domain string) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() { a.release(domain) })
+	}
+}
+
+func (a *pollAdmitter) release(domain string) {
+	a.Lock()
+	defer a.Unlock()
+	a.outstandingPolls--
+	polls := a.domains[domain]
+	polls.outstandingPolls--
+	a.cleanupDomain(domain, polls)
+	a.admitWaiting()
+}
+
+// admitWaiting admits polls waiting for admission while the host has capacity, one domain at a time
+func (a *pollAdmitter) admitWaiting() {
+	for len(a.waitingDomains) > 0 && a.hasCapacity() {
+		next := a.waitingDomains[0]
+		a.waitingDomains = a.waitingDomains[1:]
+		nextPolls := a.domains[next]
+		admitted := nextPolls.waiters[0]
+		nextPolls.waiters = nextPolls.waiters[1:]
+		if len(nextPolls.waiters) > 0 {
+			a.waitingDomains = append(a.waitingDomains, next)
+		}
+		a.outstandingPolls++
+		nextPolls.outstandingPolls++
+		close(admitted)
+	}
+}
+
+// removeWaiter removes a poll from the admission queue of its domain, returns false if the poll was
+// already admitted
+func (a *pollAdmitter) removeWaiter(domain string, polls *domainPolls, admitted chan struct{}) bool {
+	for i, waiter := range polls.waiters {
+		if waiter != admitted {
+			continue
+		}
+		polls.waiters = append(polls.waiters[:i], polls.waiters[i+1:]...)
+		if len(polls.waiters) == 0 {
+			for j, waitingDomain := range a.waitingDomains {
+				if waitingDomain == domain {
+					a.waitingDomains = append(a.waitingDomains[:j], a.waitingDomains[j+1:]...)
+					break
+				}
+			}
+		}
+		a.cleanupDomain(domain, polls)
+		return true
+	}
+	return false
+}
+
+func (a *pollAdmitter) hasCapacity() bool {
+	limit := a.maxOutstandingPolls()
+	return limit <= 0 || a.outstandingPolls < limit
+}
+
+func (a *pollAdmitter) getDomainPolls(domain string) *domainPolls {
+	polls, ok := a.domains[domain]
+	if !ok {
+		polls = &domainPolls{}
+		a.domains[domain] = polls
+	}
+	return polls
+}
+
+func (a *pollAdmitter) cleanupDomain(domain string, polls *domainPolls) {
+	if polls.outstandingPolls == 0 && len(polls.waiters) == 0 {
+		delete(a.domains, domain)
+	}
+}
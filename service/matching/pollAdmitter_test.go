@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+func newTestPollAdmitter(maxOutstandingPolls int, domainMaxOutstandingPolls int, admissionTimeout time.Duration) *pollAdmitter {
+	return newPollAdmitter(&Config{
+		MaxOutstandingPolls:       dynamicconfig.GetIntPropertyFn(maxOutstandingPolls),
+		DomainMaxOutstandingPolls: func(domain string) int { return domainMaxOutstandingPolls },
+		PollAdmissionTimeout:      dynamicconfig.GetDurationPropertyFn(admissionTimeout),
+	})
+}
+
+// admitAsync starts a poll admission and waits until the poll is queued
+func admitAsync(t *testing.T, admitter *pollAdmitter, domain string) chan func() {
+	queuedPolls := func() int {
+		admitter.Lock()
+		defer admitter.Unlock()
+		return len(admitter.getDomainPolls(domain).waiters)
+	}
+	queued := queuedPolls()
+
+	ch := make(chan func(), 1)
+	go func() {
+		release, _, err := admitter.admit(context.Background(), domain)
+		if err == nil {
+			ch <- release
+		}
+	}()
+	for start := time.Now(); queuedPolls() != queued+1; time.Sleep(time.Millisecond) {
+		require.True(t, time.Since(start) < time.Second, "poll was not queued")
+	}
+	return ch
+}
+
+func TestPollAdmitter_DomainLimit(t *testing.T) {
+	admitter := newTestPollAdmitter(0, 2, time.Second)
+	release1, _, err := admitter.admit(context.Background(), "domain-a")
+	require.NoError(t, err)
+	_, _, err = admitter.admit(context.Background(), "domain-a")
+	require.NoError(t, err)
+	_, _, err = admitter.admit(context.Background(), "domain-a")
+	require.Equal(t, errDomainPollLimitExceeded, err)
+	require.NotNil(t, errDomainPollLimitExceeded.RetryAfterInMillis)
+
+	_, _, err = admitter.admit(context.Background(), "domain-b")
+	require.NoError(t, err)
+
+	release1()
+	release1() // releasing twice is a no-op
+	_, _, err = admitter.admit(context.Background(), "domain-a")
+	require.NoError(t, err)
+	_, _, err = admitter.admit(context.Background(), "domain-a")
+	require.Equal(t, errDomainPollLimitExceeded, err)
+}
+
+func TestPollAdmitter_FairAdmission(t *testing.T) {
+	admitter := newTestPollAdmitter(2, 0, time.Minute)
+	releaseA1, result, err := admitter.admit(context.Background(), "domain-a")
+	require.NoError(t, err)
+	require.False(t, result.queued)
+	releaseA2, _, err := admitter.admit(context.Background(), "domain-a")
+	require.NoError(t, err)
+
+	// domain-a queues two more polls before domain-b queues one
+	waitingA3 := admitAsync(t, admitter, "domain-a")
+	waitingA4 := admitAsync(t, admitter, "domain-a")
+	waitingB1 := admitAsync(t, admitter, "domain-b")
+
+	releaseA1()
+	releaseA3 := <-waitingA3
+	releaseA2()
+	// domain-b is served before the second waiting poll of domain-a
+	<-waitingB1
+	require.Len(t, waitingA4, 0)
+
+	releaseA3()
+	<-waitingA4
+	admitter.Lock()
+	defer admitter.Unlock()
+	require.Equal(t, 2, admitter.outstandingPolls)
+	require.Empty(t, admitter.waitingDomains)
+}
+
+func TestPollAdmitter_AdmissionTimeout(t *testing.T) {
+	admitter := newTestPollAdmitter(1, 0, 10*time.Millisecond)
+	release, _, err := admitter.admit(context.Background(), "domain-a")
+	require.NoError(t, err)
+
+	_, result, err := admitter.admit(context.Background(), "domain-b")
+	require.Equal(t, errPollAdmissionTimeout, err)
+	require.True(t, result.queued)
+
+	admitter.Lock()
+	require.Empty(t, admitter.waitingDomains)
+	require.NotContains(t, admitter.domains, "domain-b")
+	admitter.Unlock()
+
+	release()
+	_, _, err = admitter.admit(context.Background(), "domain-b")
+	require.NoError(t, err)
+}
@@ -52,6 +52,18 @@ type Config struct {
 	OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 	MaxTaskBatchSize                dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
+	// ActivityTypeDispatchRPS is the dispatch rate of an activity type in a domain, across all task lists and hosts
+	ActivityTypeDispatchRPS dynamicconfig.IntPropertyFnWithActivityTypeFilter
+	// BuildRoutingFallbackTimeout is how long a decision task waits for a poller of its worker build, 0 disables build routing
+	BuildRoutingFallbackTimeout dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+	// EnableTaskListMetrics enables metrics tagged by task list name
+	EnableTaskListMetrics dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+
+	// poll admission configuration
+	MaxOutstandingPolls       dynamicconfig.IntPropertyFn
+	DomainMaxOutstandingPolls dynamicconfig.IntPropertyFnWithDomainFilter
+	PollAdmissionTimeout      dynamicconfig.DurationPropertyFn
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 }
 
@@ -71,6 +83,12 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
 		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
+		ActivityTypeDispatchRPS:         dc.GetIntPropertyFilteredByActivityType(dynamicconfig.MatchingActivityTypeDispatchRPS, 0),
+		BuildRoutingFallbackTimeout:     dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingBuildRoutingFallbackTimeout, 0),
+		EnableTaskListMetrics:           dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableTaskListMetrics, false),
+		MaxOutstandingPolls:             dc.GetIntProperty(dynamicconfig.MatchingMaxOutstandingPolls, 0),
+		DomainMaxOutstandingPolls:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainMaxOutstandingPolls, 0),
+		PollAdmissionTimeout:            dc.GetDurationProperty(dynamicconfig.MatchingPollAdmissionTimeout, time.Second),
 		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
 	}
 }
@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,6 +35,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -66,6 +68,9 @@ type (
 		CancelPoller(pollerID string)
 		GetAllPollerInfo() []*s.PollerInfo
 		DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse
+		PromoteBuild(binaryChecksum string, compatibleBinaryChecksums []string) error
+		MigrateBacklog(ctx context.Context, target *s.TaskList, readLevel int64, pageSize int,
+			dryRun bool) (*m.MigrateTaskListBacklogResponse, error)
 		String() string
 	}
 
@@ -83,6 +88,10 @@ type (
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
 		MaxTaskBatchSize                func() int
+		// Time a decision task waits for a poller of its worker build before it is dispatched to any poller
+		BuildRoutingFallbackTimeout func() time.Duration
+		// Whether metrics tagged by task list name are emitted
+		EnableTaskListMetrics func() bool
 	}
 
 	// Contains information needed for current task transition from queue to Workflow execution history.
@@ -107,6 +116,8 @@ type (
 		logger        log.Logger
 		metricsClient metrics.Client
 		domainScope   metrics.Scope // domain tagged metric scope
+		// domain and task list tagged metric scope, nil for sticky task lists as their names are per worker
+		taskListScope metrics.Scope
 		engine        *matchingEngineImpl
 		config        *taskListConfig
 
@@ -152,6 +163,11 @@ type (
 		outstandingPollsMap  map[string]context.CancelFunc
 		// Rate limiter for task dispatch
 		rateLimiter *rateLimiter
+		// buildRouter routes decision tasks to pollers of compatible worker builds, nil unless the
+		// task list is a normal decision task list
+		buildRouter *buildRouter
+		// stats tracks sync vs backlog dispatches and schedule to start latencies for DescribeTaskList
+		stats *taskListStats
 
 		taskListKind int // sticky taskList has different process in persistence
 	}
@@ -213,6 +229,12 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		MaxTaskBatchSize: func() int {
 			return config.MaxTaskBatchSize(domain, taskListName, taskType)
 		},
+		BuildRoutingFallbackTimeout: func() time.Duration {
+			return config.BuildRoutingFallbackTimeout(domain, taskListName, taskType)
+		},
+		EnableTaskListMetrics: func() bool {
+			return config.EnableTaskListMetrics(domain, taskListName, taskType)
+		},
 	}, nil
 }
 
@@ -266,8 +288,20 @@ func newTaskListManagerWithRateLimiter(
 		pollerHistory:       newPollerHistory(),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
 		rateLimiter:         rl,
+		stats:               newTaskListStats(scheduleToStartLatencySampleSize),
 		taskListKind:        int(*taskListKind),
 	}
+	if *taskListKind == s.TaskListKindNormal {
+		taskListType := "decision"
+		if taskList.taskType == persistence.TaskListTypeActivity {
+			taskListType = "activity"
+		}
+		tlMgr.taskListScope = tlMgr.domainScope.Tagged(
+			metrics.TaskListTag(taskList.taskListName), metrics.TaskListTypeTag(taskListType))
+	}
+	if taskList.taskType == persistence.TaskListTypeDecision && *taskListKind == s.TaskListKindNormal {
+		tlMgr.buildRouter = newBuildRouter(config.BuildRoutingFallbackTimeout, clock.NewRealTimeSource())
+	}
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.startWG.Add(1)
 	return tlMgr
@@ -286,6 +320,9 @@ func (c *taskListManagerImpl) Start() error {
 	}
 
 	c.taskAckManager.setAckLevel(state.ackLevel)
+	if c.buildRouter != nil {
+		c.buildRouter.setBuilds(state.defaultBinaryChecksum, state.compatibleBinaryChecksums)
+	}
 	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
 	c.signalNewTask()
 	go c.getTasksPump()
@@ -442,6 +479,7 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	}
 
 	var tasksForPoll chan *getTaskResult
+	var buildTasksForPoll chan *getTaskResult
 	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
 	if err != nil {
 		return nil, err
@@ -449,6 +487,13 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	if domainEntry.GetDomainNotActiveErr() == nil {
 		// domain active
 		tasksForPoll = c.tasksForPoll
+		// pollers reporting their worker build also receive the decision tasks routed to it
+		build, ok := ctx.Value(binaryChecksumKey).(string)
+		if ok && build != "" && c.buildRouter != nil {
+			buildPollers := c.buildRouter.pollStarted(build)
+			defer c.buildRouter.pollDone(buildPollers)
+			buildTasksForPoll = buildPollers.tasksForPoll
+		}
 	}
 
 	// the desired global rate limit for the task list comes from the
@@ -464,6 +509,14 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 			c.domainScope.IncCounter(metrics.PollSuccessWithSyncCounter)
 		}
 		c.domainScope.IncCounter(metrics.PollSuccessCounter)
+		c.recordDispatch(result)
+		return result, nil
+	case result := <-buildTasksForPoll:
+		if result.syncMatch {
+			c.domainScope.IncCounter(metrics.PollSuccessWithSyncCounter)
+		}
+		c.domainScope.IncCounter(metrics.PollSuccessCounter)
+		c.recordDispatch(result)
 		return result, nil
 	case result := <-c.queryTasksForPoll:
 		if result.syncMatch {
@@ -477,6 +530,50 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	}
 }
 
+// recordDispatch records the dispatch of an activity or decision task to a poller in the task list stats
+// and metrics
+func (c *taskListManagerImpl) recordDispatch(result *getTaskResult) {
+	now := time.Now()
+	createdTime := result.task.CreatedTime
+	c.stats.recordDispatch(result.syncMatch, createdTime, now)
+	if !createdTime.IsZero() {
+		c.domainScope.RecordTimer(metrics.ScheduleToStartLatency, now.Sub(createdTime))
+	}
+	if !c.isTaskListMetricsEnabled() {
+		return
+	}
+	if result.syncMatch {
+		c.taskListScope.IncCounter(metrics.PollSuccessWithSyncCounter)
+	}
+	c.taskListScope.IncCounter(metrics.PollSuccessCounter)
+	if !createdTime.IsZero() {
+		c.taskListScope.RecordTimer(metrics.ScheduleToStartLatency, now.Sub(createdTime))
+	}
+}
+
+// emitBacklogMetrics reports the backlog size and the age of the oldest task waiting in the backlog
+func (c *taskListManagerImpl) emitBacklogMetrics() {
+	if !c.isTaskListMetricsEnabled() {
+		return
+	}
+	c.taskListScope.UpdateGauge(metrics.BacklogCountGauge, float64(c.taskAckManager.getBacklogCountHint()))
+	c.taskListScope.UpdateGauge(metrics.OldestUnackedTaskAgeGauge, c.getOldestUnackedTaskAge().Seconds())
+}
+
+func (c *taskListManagerImpl) isTaskListMetricsEnabled() bool {
+	return c.taskListScope != nil && c.config.EnableTaskListMetrics()
+}
+
+// getOldestUnackedTaskAge returns how long the oldest task in the backlog has been waiting, 0 if the backlog
+// is empty
+func (c *taskListManagerImpl) getOldestUnackedTaskAge() time.Duration {
+	createdTime := c.taskAckManager.getOldestUnackedCreatedTime()
+	if createdTime.IsZero() {
+		return 0
+	}
+	return time.Since(createdTime)
+}
+
 func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 	c.outstandingPollsLock.Lock()
 	cancel, ok := c.outstandingPollsMap[pollerID]
@@ -547,7 +644,8 @@ func (c *taskListManagerImpl) GetAllPollerInfo() []*s.PollerInfo {
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// (readLevel, ackLevel, backlogCountHint and taskIDBlock), the age of the oldest task in the backlog
+// and the dispatch stats of the tasklist.
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse {
 	response := &s.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
 	if !includeTaskListStatus {
@@ -564,11 +662,141 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.De
 			StartID: common.Int64Ptr(taskIDBlock.start),
 			EndID:   common.Int64Ptr(taskIDBlock.end),
 		},
+		OldestUnackedTaskAgeInMillis: common.Int64Ptr(int64(c.getOldestUnackedTaskAge() / time.Millisecond)),
 	}
+	c.stats.describe(response.TaskListStatus)
 
 	return response
 }
 
+// PromoteBuild promotes the given worker build as default for new workflows on the task list along with the
+// builds compatible with it, their decision tasks are routed to pollers of any build of the compatible set.
+// An empty binaryChecksum clears the default build.
+func (c *taskListManagerImpl) PromoteBuild(binaryChecksum string, compatibleBinaryChecksums []string) error {
+	c.startWG.Wait()
+	if c.buildRouter == nil {
+		return &s.BadRequestError{Message: "Builds can only be promoted on normal decision task lists."}
+	}
+	if binaryChecksum == "" && len(compatibleBinaryChecksums) > 0 {
+		return &s.BadRequestError{Message: "Compatible builds require a default build."}
+	}
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.UpdateBuilds(c.taskAckManager.getAckLevel(), binaryChecksum, compatibleBinaryChecksums)
+	})
+	if err != nil {
+		return err
+	}
+	c.buildRouter.setBuilds(binaryChecksum, compatibleBinaryChecksums)
+	return nil
+}
+
+// MigrateBacklog moves up to pageSize persisted tasks after the read level to the target task list. Each task is
+// added to the target task list before it is completed in this one, a task that is dispatched from both task lists
+// meanwhile fails to start in history the second time and is dropped, so in flight tasks are not started twice.
+// With dryRun the tasks are only counted.
+func (c *taskListManagerImpl) MigrateBacklog(
+	ctx context.Context,
+	target *s.TaskList,
+	readLevel int64,
+	pageSize int,
+	dryRun bool,
+) (*m.MigrateTaskListBacklogResponse, error) {
+	c.startWG.Wait()
+	// tasks up to the ack level are completed already
+	if ackLevel := c.taskAckManager.getAckLevel(); readLevel < ackLevel {
+		readLevel = ackLevel
+	}
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	response, err := c.executeWithRetry(func() (interface{}, error) {
+		return c.db.GetTasks(readLevel, maxReadLevel, pageSize)
+	})
+	if err != nil {
+		return nil, err
+	}
+	tasks := response.(*persistence.GetTasksResponse).Tasks
+
+	var migratedTasks, expiredTasks int32
+	now := time.Now()
+	for _, task := range tasks {
+		if c.isTaskExpired(task, now) {
+			expiredTasks++
+		} else {
+			if !dryRun {
+				if err := c.migrateTask(ctx, target, task, now); err != nil {
+					return nil, err
+				}
+			}
+			migratedTasks++
+		}
+		readLevel = task.TaskID
+	}
+	if migratedTasks > 0 {
+		c.domainScope.AddCounter(metrics.MigratedTasksCounter, int64(migratedTasks))
+	}
+	return &m.MigrateTaskListBacklogResponse{
+		MigratedTasks: common.Int32Ptr(migratedTasks),
+		ExpiredTasks:  common.Int32Ptr(expiredTasks),
+		NextReadLevel: common.Int64Ptr(readLevel),
+		HasMore:       common.BoolPtr(len(tasks) == pageSize),
+	}, nil
+}
+
+func (c *taskListManagerImpl) migrateTask(
+	ctx context.Context,
+	target *s.TaskList,
+	task *persistence.TaskInfo,
+	now time.Time,
+) error {
+	execution := &s.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+	// the task keeps its expiry in the target task list
+	var scheduleToStartTimeout int32
+	if task.Expiry.After(epochStartTime) {
+		scheduleToStartTimeout = int32(math.Ceil(task.Expiry.Sub(now).Seconds()))
+	}
+
+	var err error
+	if c.taskListID.taskType == persistence.TaskListTypeDecision {
+		err = c.engine.matchingClient.AddDecisionTask(ctx, &m.AddDecisionTaskRequest{
+			DomainUUID:                    common.StringPtr(c.taskListID.domainID),
+			Execution:                     execution,
+			TaskList:                      target,
+			ScheduleId:                    common.Int64Ptr(task.ScheduleID),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+			BinaryChecksum:                common.StringPtr(task.BinaryChecksum),
+		})
+	} else {
+		err = c.engine.matchingClient.AddActivityTask(ctx, &m.AddActivityTaskRequest{
+			DomainUUID:                    common.StringPtr(c.taskListID.domainID),
+			SourceDomainUUID:              common.StringPtr(task.DomainID),
+			Execution:                     execution,
+			TaskList:                      target,
+			ScheduleId:                    common.Int64Ptr(task.ScheduleID),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+			ActivityType:                  common.StringPtr(task.ActivityType),
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.CompleteTask(task.TaskID)
+	})
+	return err
+}
+
+// routeTask returns the key of the poller group and the channel the task has to be dispatched to if it is
+// routed to pollers of a worker build, a nil channel otherwise
+func (c *taskListManagerImpl) routeTask(task *persistence.TaskInfo) (string, chan *getTaskResult) {
+	if c.buildRouter == nil {
+		return "", nil
+	}
+	return c.buildRouter.route(task)
+}
+
 // Tries to match task to a poller that is already waiting on getTask.
 // When this method returns non nil response without error it is guaranteed that the task is started
 // and sent to a poller. So it not necessary to persist it.
@@ -581,22 +809,41 @@ func (c *taskListManagerImpl) trySyncMatch(task *persistence.TaskInfo) (*persist
 	// But it is getTask result from the point of view of a poll operation.
 	request := &getTaskResult{task: task, C: make(chan *syncMatchResponse, 1), syncMatch: true}
 
+	// activity types with a dispatch budget are throttled across task lists, the task goes to the backlog
+	// and waits for the budget there
+	activityLimiter := c.engine.activityLimiter
+	if !activityLimiter.tryAcquire(c.taskListID.domainID, task.ActivityType) {
+		c.domainScope.IncCounter(metrics.ActivityTypeSyncThrottleCounter)
+		return nil, errAddTasklistThrottled
+	}
+
 	rsv := c.rateLimiter.Reserve()
 	// If we have to wait too long for reservation, better to store in task buffer and handle later.
 	if !rsv.OK() || rsv.Delay() > time.Second {
 		if rsv.OK() { // if we were indeed given a reservation, return it before we bail out
 			rsv.Cancel()
 		}
+		activityLimiter.release(c.taskListID.domainID, task.ActivityType)
 		c.domainScope.IncCounter(metrics.SyncThrottleCounter)
 		return nil, errAddTasklistThrottled
 	}
 	time.Sleep(rsv.Delay())
+	// a task routed to a worker build can only be sync matched to a poller of that build
+	tasksForPoll := c.tasksForPoll
+	_, routedTasksForPoll := c.routeTask(task)
+	if routedTasksForPoll != nil {
+		tasksForPoll = routedTasksForPoll
+	}
 	select {
-	case c.tasksForPoll <- request: // poller goroutine picked up the task
+	case tasksForPoll <- request: // poller goroutine picked up the task
+		if routedTasksForPoll != nil {
+			c.domainScope.IncCounter(metrics.BuildRoutedTaskCounter)
+		}
 		r := <-request.C
 		return r.response, r.err
 	default: // no poller waiting for tasks
 		rsv.Cancel()
+		activityLimiter.release(c.taskListID.domainID, task.ActivityType)
 		return nil, nil
 	}
 }
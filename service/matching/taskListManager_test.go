@@ -32,6 +32,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/mocks"
@@ -76,6 +77,113 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	wg.Wait()
 }
 
+func TestDeliverBufferTasks_ThrottledActivityType(t *testing.T) {
+	tlm := createTestTaskListManager()
+	acquireTokens := func(domainID string, activityType string, count int32) (int32, error) {
+		if activityType == "throttled" {
+			return 0, nil
+		}
+		return count, nil
+	}
+	tlm.engine.activityLimiter = newTestActivityDispatchLimiter(t, 1, acquireTokens, clock.NewRealTimeSource())
+	tlm.taskBuffer <- &persistence.TaskInfo{TaskID: 1, ActivityType: "throttled"}
+	tlm.taskBuffer <- &persistence.TaskInfo{TaskID: 2, ActivityType: "throttled"}
+	tlm.taskBuffer <- &persistence.TaskInfo{TaskID: 3, ActivityType: "other"}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		tlm.deliverBufferTasksForPoll()
+		wg.Done()
+	}()
+
+	// tasks of the throttled activity type are parked instead of blocking the task behind them
+	select {
+	case result := <-tlm.tasksForPoll:
+		require.Equal(t, int64(3), result.task.TaskID)
+	case <-time.After(time.Second):
+		require.Fail(t, "task of other activity type was not dispatched")
+	}
+	close(tlm.deliverBufferShutdownCh)
+	wg.Wait()
+}
+
+func TestDeliverBufferTasks_RoutedTaskWaitsForItsBuild(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.config.BuildRoutingFallbackTimeout = func() time.Duration { return time.Minute }
+	tlm.buildRouter = newBuildRouter(tlm.config.BuildRoutingFallbackTimeout, clock.NewRealTimeSource())
+	pollers := tlm.buildRouter.pollStarted("build-1")
+	tlm.taskBuffer <- &persistence.TaskInfo{TaskID: 1, BinaryChecksum: "build-1"}
+	tlm.taskBuffer <- &persistence.TaskInfo{TaskID: 2}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		tlm.deliverBufferTasksForPoll()
+		wg.Done()
+	}()
+
+	// the routed task waits for a poller of its build without holding up the task behind it
+	select {
+	case result := <-tlm.tasksForPoll:
+		require.Equal(t, int64(2), result.task.TaskID)
+	case <-time.After(time.Second):
+		require.Fail(t, "unrouted task was not dispatched")
+	}
+	select {
+	case result := <-pollers.tasksForPoll:
+		require.Equal(t, int64(1), result.task.TaskID)
+	case <-time.After(time.Second):
+		require.Fail(t, "routed task was not dispatched to its build")
+	}
+	close(tlm.deliverBufferShutdownCh)
+	wg.Wait()
+}
+
+func TestDeliverBufferTasks_RoutedTaskFallsBack(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.config.BuildRoutingFallbackTimeout = func() time.Duration { return 100 * time.Millisecond }
+	tlm.buildRouter = newBuildRouter(tlm.config.BuildRoutingFallbackTimeout, clock.NewRealTimeSource())
+	tlm.buildRouter.pollStarted("build-1")
+	tlm.taskBuffer <- &persistence.TaskInfo{TaskID: 1, BinaryChecksum: "build-1"}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		tlm.deliverBufferTasksForPoll()
+		wg.Done()
+	}()
+
+	// no poller of the build took the task within the fallback timeout, it goes to any poller
+	select {
+	case result := <-tlm.tasksForPoll:
+		require.Equal(t, int64(1), result.task.TaskID)
+	case <-time.After(time.Second):
+		require.Fail(t, "routed task did not fall back to any poller")
+	}
+	close(tlm.deliverBufferShutdownCh)
+	wg.Wait()
+}
+
+func TestParkedTasks(t *testing.T) {
+	parked := newParkedTasks()
+	parked.add("a", &persistence.TaskInfo{TaskID: 1})
+	parked.add("a", &persistence.TaskInfo{TaskID: 2})
+	parked.add("b", &persistence.TaskInfo{TaskID: 3})
+	require.Equal(t, 3, parked.size)
+	require.True(t, parked.has("a"))
+
+	require.Nil(t, parked.next(func(key string) bool { return false }))
+	onlyA := func(key string) bool { return key == "a" }
+	require.Equal(t, int64(1), parked.next(onlyA).TaskID)
+	require.Equal(t, int64(2), parked.next(onlyA).TaskID)
+	require.Nil(t, parked.next(onlyA))
+	require.False(t, parked.has("a"))
+	require.Equal(t, []string{"b"}, parked.keys())
+	require.Equal(t, int64(3), parked.peek("b").task.TaskID)
+	parked.pop("b")
+	require.Nil(t, parked.peek("b"))
+	require.Nil(t, parked.next(func(key string) bool { return true }))
+	require.Equal(t, 0, parked.size)
+}
+
 func TestNewRateLimiter(t *testing.T) {
 	maxDispatch := float64(0.01)
 	rl := newRateLimiter(&maxDispatch, time.Second, _minBurst)
@@ -129,7 +237,7 @@ func TestDescribeTaskList(t *testing.T) {
 	tlm.taskAckManager.setAckLevel(tlm.db.ackLevel)
 
 	for i := int64(0); i < taskCount; i++ {
-		tlm.taskAckManager.addTask(startTaskID + i)
+		tlm.taskAckManager.addTask(startTaskID+i, time.Now().Add(-time.Minute))
 	}
 
 	includeTaskStatus := false
@@ -143,6 +251,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Zero(t, taskListStatus.GetAckLevel())
 	require.Equal(t, taskCount, taskListStatus.GetReadLevel())
 	require.Equal(t, taskCount, taskListStatus.GetBacklogCountHint())
+	require.True(t, taskListStatus.GetOldestUnackedTaskAgeInMillis() >= int64(time.Minute/time.Millisecond))
 	require.True(t, taskListStatus.GetRatePerSecond() > (_defaultTaskDispatchRPS-1))
 	require.True(t, taskListStatus.GetRatePerSecond() < (_defaultTaskDispatchRPS+1))
 	taskIDBlock := taskListStatus.GetTaskIDBlock()
@@ -172,6 +281,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.NotNil(t, taskListStatus)
 	require.Equal(t, taskCount, taskListStatus.GetAckLevel())
 	require.Zero(t, taskListStatus.GetBacklogCountHint())
+	require.Zero(t, taskListStatus.GetOldestUnackedTaskAgeInMillis())
 }
 
 func tlMgrStartWithoutNotifyEvent(tlm *taskListManagerImpl) {
@@ -0,0 +1,101 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+// Number of most recent schedule to start latencies the percentiles of a task list are computed from
+const scheduleToStartLatencySampleSize = 1000
+
+type (
+	// taskListStats tracks how tasks of a task list are dispatched since the task list was loaded: how many
+	// are sync matched vs dispatched from the backlog, and the schedule to start latency of recent tasks
+	taskListStats struct {
+		sync.Mutex
+		syncMatchCount    int64
+		backlogMatchCount int64
+		// ring buffer of the most recent schedule to start latencies
+		latencies    []time.Duration
+		nextLatency  int
+		maxLatencies int
+	}
+)
+
+func newTaskListStats(maxLatencies int) *taskListStats {
+	return &taskListStats{
+		latencies:    make([]time.Duration, 0, maxLatencies),
+		maxLatencies: maxLatencies,
+	}
+}
+
+// recordDispatch records a task dispatched to a poller, createdTime is zero for tasks persisted before
+// their created time was tracked, the latency of those is not known
+func (t *taskListStats) recordDispatch(syncMatch bool, createdTime time.Time, now time.Time) {
+	t.Lock()
+	defer t.Unlock()
+	if syncMatch {
+		t.syncMatchCount++
+	} else {
+		t.backlogMatchCount++
+	}
+	if createdTime.IsZero() {
+		return
+	}
+	latency := now.Sub(createdTime)
+	if len(t.latencies) < t.maxLatencies {
+		t.latencies = append(t.latencies, latency)
+		return
+	}
+	t.latencies[t.nextLatency] = latency
+	t.nextLatency = (t.nextLatency + 1) % t.maxLatencies
+}
+
+// describe fills the dispatch counts and the schedule to start latency percentiles of the task list status
+func (t *taskListStats) describe(status *s.TaskListStatus) {
+	t.Lock()
+	status.SyncMatchCount = common.Int64Ptr(t.syncMatchCount)
+	status.BacklogMatchCount = common.Int64Ptr(t.backlogMatchCount)
+	latencies := make([]time.Duration, len(t.latencies))
+	copy(latencies, t.latencies)
+	t.Unlock()
+
+	if len(latencies) == 0 {
+		return
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	percentile := func(p float64) *int64 {
+		return common.Int64Ptr(int64(latencies[int(p*float64(len(latencies)-1))] / time.Millisecond))
+	}
+	status.ScheduleToStartLatency = &s.TaskListLatencyStats{
+		SampleCount: common.Int64Ptr(int64(len(latencies))),
+		P50InMillis: percentile(0.50),
+		P95InMillis: percentile(0.95),
+		P99InMillis: percentile(0.99),
+		MaxInMillis: percentile(1),
+	}
+}
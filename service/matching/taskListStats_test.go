@@ -0,0 +1,74 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	s "github.com/uber/cadence/.gen/go/shared"
+)
+
+func TestTaskListStats_Describe(t *testing.T) {
+	stats := newTaskListStats(100)
+	now := time.Now()
+	for i := 1; i <= 100; i++ {
+		stats.recordDispatch(i%4 == 0, now.Add(-time.Duration(i)*time.Millisecond), now)
+	}
+	// tasks persisted before their created time was tracked only count as dispatches
+	stats.recordDispatch(false, time.Time{}, now)
+
+	status := &s.TaskListStatus{}
+	stats.describe(status)
+	require.Equal(t, int64(25), status.GetSyncMatchCount())
+	require.Equal(t, int64(76), status.GetBacklogMatchCount())
+	latency := status.GetScheduleToStartLatency()
+	require.Equal(t, int64(100), latency.GetSampleCount())
+	require.Equal(t, int64(50), latency.GetP50InMillis())
+	require.Equal(t, int64(95), latency.GetP95InMillis())
+	require.Equal(t, int64(99), latency.GetP99InMillis())
+	require.Equal(t, int64(100), latency.GetMaxInMillis())
+}
+
+func TestTaskListStats_KeepsMostRecentLatencies(t *testing.T) {
+	stats := newTaskListStats(10)
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		stats.recordDispatch(false, now.Add(-time.Hour), now)
+	}
+	for i := 0; i < 10; i++ {
+		stats.recordDispatch(true, now.Add(-time.Second), now)
+	}
+
+	status := &s.TaskListStatus{}
+	stats.describe(status)
+	require.Equal(t, int64(10), status.GetScheduleToStartLatency().GetSampleCount())
+	require.Equal(t, int64(1000), status.GetScheduleToStartLatency().GetMaxInMillis())
+}
+
+func TestTaskListStats_NoLatencies(t *testing.T) {
+	status := &s.TaskListStatus{}
+	newTaskListStats(10).describe(status)
+	require.Zero(t, status.GetSyncMatchCount())
+	require.Zero(t, status.GetBacklogMatchCount())
+	require.Nil(t, status.ScheduleToStartLatency)
+}
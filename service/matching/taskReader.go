@@ -33,37 +33,226 @@ import (
 
 var epochStartTime = time.Unix(0, 0)
 
+type (
+	// parkedTasks keeps buffered tasks which cannot be dispatched yet, so they do not hold up the tasks behind
+	// them in the task list. These are the tasks of activity types whose dispatch budget is used up, and the
+	// decision tasks waiting for pollers of the worker build they are routed to. Tasks of one key are
+	// dispatched in the order they were read.
+	parkedTasks struct {
+		tasks map[string][]*parkedTask
+		size  int
+	}
+
+	parkedTask struct {
+		task     *persistence.TaskInfo
+		parkedAt time.Time
+	}
+)
+
+func newParkedTasks() *parkedTasks {
+	return &parkedTasks{tasks: make(map[string][]*parkedTask)}
+}
+
+func (p *parkedTasks) add(key string, task *persistence.TaskInfo) {
+	p.tasks[key] = append(p.tasks[key], &parkedTask{task: task, parkedAt: time.Now()})
+	p.size++
+}
+
+func (p *parkedTasks) has(key string) bool {
+	return len(p.tasks[key]) > 0
+}
+
+// keys returns the keys which have parked tasks
+func (p *parkedTasks) keys() []string {
+	keys := make([]string, 0, len(p.tasks))
+	for key := range p.tasks {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// peek returns the first task of the key without removing it, nil if there is none
+func (p *parkedTasks) peek(key string) *parkedTask {
+	tasks := p.tasks[key]
+	if len(tasks) == 0 {
+		return nil
+	}
+	return tasks[0]
+}
+
+// pop removes the first task of the key
+func (p *parkedTasks) pop(key string) {
+	tasks := p.tasks[key]
+	if len(tasks) == 0 {
+		return
+	}
+	if len(tasks) == 1 {
+		delete(p.tasks, key)
+	} else {
+		p.tasks[key] = tasks[1:]
+	}
+	p.size--
+}
+
+// next removes and returns the first task of a key which can be dispatched now, nil if there is none
+func (p *parkedTasks) next(canDispatch func(key string) bool) *persistence.TaskInfo {
+	for key, tasks := range p.tasks {
+		if !canDispatch(key) {
+			continue
+		}
+		p.pop(key)
+		return tasks[0].task
+	}
+	return nil
+}
+
 func (c *taskListManagerImpl) deliverBufferTasksForPoll() {
+	activityLimiter := c.engine.activityLimiter
+	canDispatch := func(activityType string) bool {
+		return activityLimiter.tryAcquire(c.taskListID.domainID, activityType)
+	}
+	// the dispatch budget of an activity type is shared by all task lists of the domain, tasks of throttled
+	// activity types are parked until they get a token, up to the size of the task buffer
+	parked := newParkedTasks()
+	// decision tasks routed to a worker build wait for pollers of their build in a queue per poller group,
+	// they share the bound of the parked activity tasks
+	routed := newParkedTasks()
+	maxParked := cap(c.taskBuffer)
+	var pollerArrivedCh chan struct{}
+	if c.buildRouter != nil {
+		pollerArrivedCh = c.buildRouter.pollerArrivedCh
+	}
+
 deliverBufferTasksLoop:
 	for {
-		err := c.rateLimiter.Wait(c.cancelCtx)
-		if err != nil {
-			if err == context.Canceled {
-				c.logger.Info("Tasklist manager context is cancelled, shutting down")
+		if task := c.dispatchRoutedTasks(routed); task != nil {
+			// the task list rate limit was applied when the task was routed
+			if !c.dispatchToAnyPoller(task) {
 				break deliverBufferTasksLoop
 			}
-			c.logger.Debug(fmt.Sprintf(
-				"Unable to add buffer task, rate limit failed, domainId: %s, tasklist: %s, error: %s",
-				c.taskListID.domainID, c.taskListID.taskListName, err.Error()),
-			)
-			c.domainScope.IncCounter(metrics.BufferThrottleCounter)
-			// This is to prevent busy looping when throttling is set to 0
-			runtime.Gosched()
-			continue
+			continue deliverBufferTasksLoop
 		}
-		select {
-		case task, ok := <-c.taskBuffer:
-			if !ok { // Task list getTasks pump is shutdown
-				break deliverBufferTasksLoop
+
+		task := parked.next(canDispatch)
+		if task == nil {
+			taskBuffer := c.taskBuffer
+			if parked.size+routed.size >= maxParked {
+				taskBuffer = nil
+			}
+			var retryTimer <-chan time.Time
+			if parked.size+routed.size > 0 {
+				retryTimer = time.After(dispatchTokenRetryInterval)
 			}
 			select {
-			case c.tasksForPoll <- &getTaskResult{task: task}:
+			case bufferedTask, ok := <-taskBuffer:
+				if !ok { // Task list getTasks pump is shutdown
+					break deliverBufferTasksLoop
+				}
+				if parked.has(bufferedTask.ActivityType) || !canDispatch(bufferedTask.ActivityType) {
+					c.domainScope.IncCounter(metrics.ActivityTypeBufferThrottleCounter)
+					parked.add(bufferedTask.ActivityType, bufferedTask)
+					continue deliverBufferTasksLoop
+				}
+				task = bufferedTask
+			case <-retryTimer:
+				continue deliverBufferTasksLoop
+			case <-pollerArrivedCh:
+				continue deliverBufferTasksLoop
 			case <-c.deliverBufferShutdownCh:
 				break deliverBufferTasksLoop
 			}
-		case <-c.deliverBufferShutdownCh:
+		}
+
+		if !c.waitForDispatch() {
+			activityLimiter.release(c.taskListID.domainID, task.ActivityType)
 			break deliverBufferTasksLoop
 		}
+		if !c.dispatchBufferedTask(task, routed) {
+			// the task was not handed to a poller, its dispatch token is not used
+			activityLimiter.release(c.taskListID.domainID, task.ActivityType)
+			break deliverBufferTasksLoop
+		}
+	}
+}
+
+// waitForDispatch blocks until the task list rate limit allows another dispatch, it returns false
+// if the task list manager is shutting down
+func (c *taskListManagerImpl) waitForDispatch() bool {
+	for {
+		err := c.rateLimiter.Wait(c.cancelCtx)
+		if err == nil {
+			return true
+		}
+		if err == context.Canceled {
+			c.logger.Info("Tasklist manager context is cancelled, shutting down")
+			return false
+		}
+		c.logger.Debug(fmt.Sprintf(
+			"Unable to add buffer task, rate limit failed, domainId: %s, tasklist: %s, error: %s",
+			c.taskListID.domainID, c.taskListID.taskListName, err.Error()),
+		)
+		c.domainScope.IncCounter(metrics.BufferThrottleCounter)
+		// This is to prevent busy looping when throttling is set to 0
+		runtime.Gosched()
+	}
+}
+
+// dispatchBufferedTask hands the task to a poller. A decision task routed to a worker build is parked in routed
+// unless a poller of the build is waiting for it. It returns false if the task list manager is shutting down
+func (c *taskListManagerImpl) dispatchBufferedTask(task *persistence.TaskInfo, routed *parkedTasks) bool {
+	if key, routedTasksForPoll := c.routeTask(task); routedTasksForPoll != nil {
+		if routed.has(key) {
+			// keep the order of the tasks routed to the same pollers
+			routed.add(key, task)
+			return true
+		}
+		select {
+		case routedTasksForPoll <- &getTaskResult{task: task}:
+			c.domainScope.IncCounter(metrics.BuildRoutedTaskCounter)
+		default:
+			routed.add(key, task)
+		}
+		return true
+	}
+	return c.dispatchToAnyPoller(task)
+}
+
+// dispatchRoutedTasks hands parked routed tasks to waiting pollers of their build. It returns the first task
+// which has to fall back to any poller, since its build is gone or it waited for the fallback timeout already.
+func (c *taskListManagerImpl) dispatchRoutedTasks(routed *parkedTasks) *persistence.TaskInfo {
+	if routed.size == 0 {
+		return nil
+	}
+	fallbackTimeout := c.config.BuildRoutingFallbackTimeout()
+nextKeyLoop:
+	for _, key := range routed.keys() {
+		for parkedTask := routed.peek(key); parkedTask != nil; parkedTask = routed.peek(key) {
+			routedTasksForPoll := c.buildRouter.tasksForPoll(key)
+			if routedTasksForPoll == nil || time.Since(parkedTask.parkedAt) >= fallbackTimeout {
+				routed.pop(key)
+				c.domainScope.IncCounter(metrics.BuildRoutingFallbackCounter)
+				return parkedTask.task
+			}
+			select {
+			case routedTasksForPoll <- &getTaskResult{task: parkedTask.task}:
+				routed.pop(key)
+				c.domainScope.IncCounter(metrics.BuildRoutedTaskCounter)
+			default:
+				continue nextKeyLoop
+			}
+		}
+	}
+	return nil
+}
+
+// dispatchToAnyPoller hands the task to the next poller of the task list, it returns false if the task list
+// manager is shutting down
+func (c *taskListManagerImpl) dispatchToAnyPoller(task *persistence.TaskInfo) bool {
+	select {
+	case c.tasksForPoll <- &getTaskResult{task: task}:
+		return true
+	case <-c.deliverBufferShutdownCh:
+		return false
 	}
 }
 
@@ -120,6 +309,7 @@ getTasksPumpLoop:
 					}
 					// keep going as saving ack is not critical
 				}
+				c.emitBacklogMetrics()
 				c.signalNewTask() // periodically signal pump to check persistence for tasks
 				updateAckTimer = time.NewTimer(c.config.UpdateAckInterval())
 			}
@@ -206,7 +396,7 @@ func (c *taskListManagerImpl) addTasksToBuffer(
 
 func (c *taskListManagerImpl) addSingleTaskToBuffer(
 	task *persistence.TaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
-	c.taskAckManager.addTask(task.TaskID)
+	c.taskAckManager.addTask(task.TaskID, task.CreatedTime)
 	for {
 		select {
 		case c.taskBuffer <- task:
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
@@ -46,21 +47,23 @@ const (
 	deleteHistoryActivityFnName = "deleteHistoryActivity"
 	blobstoreTimeout            = 30 * time.Second
 
-	errGetDomainByID = "could not get domain cache entry"
-	errGetTags       = "could not get blob tags"
-	errUploadBlob    = "could not upload blob"
-	errReadBlob      = "could not read blob"
-	errEmptyBucket   = "domain is enabled for archival but bucket is not set"
-	errConstructBlob = "failed to construct blob"
-	errDownloadBlob  = "could not download existing blob"
+	errGetDomainByID  = "could not get domain cache entry"
+	errGetTags        = "could not get blob tags"
+	errUploadBlob     = "could not upload blob"
+	errReadBlob       = "could not read blob"
+	errEmptyBucket    = "domain is enabled for archival but bucket is not set"
+	errConstructBlob  = "failed to construct blob"
+	errDownloadBlob   = "could not download existing blob"
+	errResolvePayload = "could not resolve offloaded payloads"
 
 	errDeleteHistoryV1 = "failed to delete history from events_v1"
 	errDeleteHistoryV2 = "failed to delete history from events_v2"
+	errDeletePayloads  = "failed to delete offloaded payloads"
 )
 
 var (
-	uploadHistoryActivityNonRetryableErrors = []string{errGetDomainByID, errGetTags, errUploadBlob, errReadBlob, errEmptyBucket, errConstructBlob}
-	deleteHistoryActivityNonRetryableErrors = []string{errDeleteHistoryV1, errDeleteHistoryV2}
+	uploadHistoryActivityNonRetryableErrors = []string{errGetDomainByID, errGetTags, errUploadBlob, errReadBlob, errEmptyBucket, errConstructBlob, errResolvePayload}
+	deleteHistoryActivityNonRetryableErrors = []string{errDeleteHistoryV1, errDeleteHistoryV2, errDeletePayloads}
 	errContextTimeout                       = errors.New("activity aborted because context timed out")
 )
 
@@ -148,6 +151,11 @@ func uploadHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 			logger.Error(uploadErrorMsg, tag.UploadFailReason("could not get history blob from reader"), tag.ArchivalBucket(bucket))
 			return err
 		}
+		// offloaded payloads are archived inline since they are deleted together with the history
+		if err := resolvePayloads(ctx, container.PayloadStore, historyBlob); err != nil {
+			logger.Error(uploadErrorMsg, tag.UploadFailReason("could not resolve offloaded payloads"), tag.ArchivalBucket(bucket))
+			return err
+		}
 		if runConstTest {
 			// some tags are specific to the cluster and time a blob was uploaded from/when
 			// this only updates those specific tags, all other parts of the blob are left unchanged
@@ -201,12 +209,14 @@ func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 			logger.Error("failed to delete history from events v2", tag.Error(err))
 			return err
 		}
-		return nil
-	}
-	if err := deleteHistoryV1(ctx, container, request); err != nil {
+	} else if err := deleteHistoryV1(ctx, container, request); err != nil {
 		logger.Error("failed to delete history from events v1", tag.Error(err))
 		return err
 	}
+	if err := deletePayloads(ctx, container, request); err != nil {
+		logger.Error("failed to delete offloaded payloads", tag.Error(err))
+		return err
+	}
 	return nil
 }
 
@@ -228,6 +238,22 @@ func getBlob(ctx context.Context, historyBlobReader HistoryBlobReader, blobPage
 	return blob, nil
 }
 
+func resolvePayloads(ctx context.Context, payloadStore payload.Store, historyBlob *HistoryBlob) error {
+	if historyBlob.Body == nil || !payload.HasReferences(historyBlob.Body.Events) {
+		return nil
+	}
+	bCtx, cancel := context.WithTimeout(ctx, blobstoreTimeout)
+	err := payloadStore.ResolveEvents(bCtx, historyBlob.Body.Events)
+	cancel()
+	if err != nil {
+		if contextExpired(ctx) {
+			return errContextTimeout
+		}
+		return cadence.NewCustomError(errResolvePayload)
+	}
+	return nil
+}
+
 func getTags(ctx context.Context, blobstoreClient blobstore.Client, bucket string, key blob.Key) (map[string]string, error) {
 	bCtx, cancel := context.WithTimeout(ctx, blobstoreTimeout)
 	tags, err := blobstoreClient.GetTags(bCtx, bucket, key)
@@ -370,6 +396,21 @@ func deleteHistoryV2(ctx context.Context, container *BootstrapContainer, request
 	return nil
 }
 
+func deletePayloads(ctx context.Context, container *BootstrapContainer, request ArchiveRequest) error {
+	for _, bucket := range request.PayloadBuckets {
+		bCtx, cancel := context.WithTimeout(ctx, blobstoreTimeout)
+		err := container.PayloadStore.DeleteExecution(bCtx, bucket, request.DomainID, request.WorkflowID, request.RunID)
+		cancel()
+		if err != nil {
+			if contextExpired(ctx) {
+				return errContextTimeout
+			}
+			return cadence.NewCustomError(errDeletePayloads)
+		}
+	}
+	return nil
+}
+
 func contextExpired(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"go.uber.org/cadence/testsuite"
@@ -49,6 +50,7 @@ import (
 
 const (
 	testArchivalBucket     = "test-archival-bucket"
+	testPayloadBucket      = "test-payload-bucket"
 	testCurrentClusterName = "test-current-cluster-name"
 )
 
@@ -760,6 +762,38 @@ func (s *activitiesSuite) TestDeleteHistoryActivity_Success() {
 	s.NoError(err)
 }
 
+func (s *activitiesSuite) TestDeleteHistoryActivity_Success_DeletesPayloads() {
+	mockHistoryManager := &mocks.HistoryManager{}
+	mockHistoryManager.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil)
+	payloadKey, err := payload.NewKey(testDomainID, testWorkflowID, testRunID, common.FirstEventID, "input")
+	s.NoError(err)
+	mockBlobstore := &mocks.BlobstoreClient{}
+	mockBlobstore.On("ListByPrefix", mock.Anything, testPayloadBucket, mock.Anything).Return([]blob.Key{payloadKey}, nil).Once()
+	mockBlobstore.On("Delete", mock.Anything, testPayloadBucket, payloadKey).Return(true, nil).Once()
+	container := &BootstrapContainer{
+		Logger:         s.logger,
+		MetricsClient:  s.metricsClient,
+		HistoryManager: mockHistoryManager,
+		PayloadStore:   payload.NewStore(mockBlobstore),
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	request := ArchiveRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		PayloadBuckets:       []string{testPayloadBucket},
+	}
+	_, err = env.ExecuteActivity(deleteHistoryActivity, request)
+	s.NoError(err)
+	mockBlobstore.AssertExpectations(s.T())
+}
+
 func (s *activitiesSuite) archivalConfig(
 	domainEnablesArchival bool,
 	domainArchivalBucket string,
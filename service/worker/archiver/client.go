@@ -45,6 +45,7 @@ type (
 		BranchToken          []byte
 		NextEventID          int64
 		CloseFailoverVersion int64
+		PayloadBuckets       []string
 	}
 
 	// Client is used to archive workflow histories
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
@@ -60,6 +61,7 @@ type (
 		HistoryManager    persistence.HistoryManager
 		HistoryV2Manager  persistence.HistoryV2Manager
 		Blobstore         blobstore.Client
+		PayloadStore      payload.Store
 		DomainCache       cache.DomainCache
 		Config            *Config
 		HistoryBlobReader HistoryBlobReader // this is only set in testing code
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parentclosepolicy
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	cclient "go.uber.org/cadence/client"
+)
+
+type (
+	// RequestDetail defines the child execution which the parent close policy applies to
+	RequestDetail struct {
+		DomainID   string
+		DomainName string
+		WorkflowID string
+		RunID      string
+		Policy     shared.ChildPolicy
+	}
+
+	// Request defines the request sent to the parent close policy processor workflow
+	Request struct {
+		ParentDomainID   string
+		ParentWorkflowID string
+		ParentRunID      string
+		Executions       []RequestDetail
+	}
+
+	// Client is used to send request to the parent close policy processor workflow
+	Client interface {
+		SendParentClosePolicyRequest(Request) error
+	}
+
+	client struct {
+		metricsClient metrics.Client
+		logger        log.Logger
+		cadenceClient cclient.Client
+		numWorkflows  dynamicconfig.IntPropertyFn
+	}
+)
+
+// NewClient creates a new Client
+func NewClient(
+	metricsClient metrics.Client,
+	logger log.Logger,
+	publicClient workflowserviceclient.Interface,
+	numWorkflows dynamicconfig.IntPropertyFn,
+) Client {
+	return &client{
+		metricsClient: metricsClient,
+		logger:        logger,
+		cadenceClient: cclient.NewClient(publicClient, common.SystemDomainName, &cclient.Options{}),
+		numWorkflows:  numWorkflows,
+	}
+}
+
+// SendParentClosePolicyRequest signals the processor workflow to apply the parent close policy to the children
+func (c *client) SendParentClosePolicyRequest(request Request) error {
+	c.metricsClient.IncCounter(metrics.ParentClosePolicyClientScope, metrics.CadenceRequests)
+	workflowID := fmt.Sprintf("%v-%v", workflowIDPrefix, rand.Intn(c.numWorkflows()))
+	workflowOptions := cclient.StartWorkflowOptions{
+		ID:                              workflowID,
+		TaskList:                        processorTaskListName,
+		ExecutionStartToCloseTimeout:    workflowStartToCloseTimeout,
+		DecisionTaskStartToCloseTimeout: workflowTaskStartToCloseTimeout,
+		WorkflowIDReusePolicy:           cclient.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	_, err := c.cadenceClient.SignalWithStartWorkflow(context.Background(), workflowID, processorChannelName, request, workflowOptions, processorWFTypeName, nil)
+	if err != nil {
+		c.logger.Error("failed to send signal to parent close policy system workflow",
+			tag.WorkflowID(workflowID),
+			tag.WorkflowDomainID(request.ParentDomainID),
+			tag.Error(err))
+		c.metricsClient.IncCounter(metrics.ParentClosePolicyClientScope, metrics.CadenceFailures)
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parentclosepolicy
+
+import (
+	"context"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/tokenbucket"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/zap"
+)
+
+type (
+	// Config defines the configuration for parent close policy processor
+	Config struct {
+		// MaxConcurrentActivityExecutionSize is the max number of concurrent processor activities per worker
+		MaxConcurrentActivityExecutionSize dynamicconfig.IntPropertyFn
+		// ProcessorRPS is the max rate of terminate or cancel calls issued per worker
+		ProcessorRPS dynamicconfig.IntPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the parent close policy processor sub-system
+	BootstrapParams struct {
+		// Config contains the configuration for the processor
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// HistoryClient is used to terminate or cancel the children
+		HistoryClient history.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+	}
+
+	// Processor is the background sub-system that applies the parent
+	// close policy to the children of closed parent workflows
+	Processor struct {
+		svcClient     workflowserviceclient.Interface
+		historyClient history.Client
+		cfg           Config
+		rateLimiter   tokenbucket.TokenBucket
+		metricsClient metrics.Client
+		tallyScope    tally.Scope
+		logger        log.Logger
+	}
+)
+
+// New returns a new instance of parent close policy processor
+func New(params *BootstrapParams) *Processor {
+	return &Processor{
+		svcClient:     params.ServiceClient,
+		historyClient: params.HistoryClient,
+		cfg:           params.Config,
+		rateLimiter:   tokenbucket.New(params.Config.ProcessorRPS(), clock.NewRealTimeSource()),
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentParentClosePolicy),
+	}
+}
+
+// Start starts the processor worker
+func (p *Processor) Start() error {
+	zapLogger, err := zap.NewProduction()
+	if err != nil {
+		p.logger.Error("failed to initialize zap logger", tag.Error(err))
+		return err
+	}
+	workerOpts := worker.Options{
+		Logger:                             zapLogger,
+		MetricsScope:                       p.tallyScope,
+		MaxConcurrentActivityExecutionSize: p.cfg.MaxConcurrentActivityExecutionSize(),
+		BackgroundActivityContext:          context.WithValue(context.Background(), processorContextKey, p),
+	}
+	processorWorker := worker.New(p.svcClient, common.SystemDomainName, processorTaskListName, workerOpts)
+	return processorWorker.Start()
+}
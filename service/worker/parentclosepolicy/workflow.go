@@ -0,0 +1,160 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parentclosepolicy
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+)
+
+type contextKey int
+
+const (
+	processorContextKey contextKey = 0
+
+	workflowIDPrefix                = "cadence-sys-parent-close-policy"
+	processorTaskListName           = "cadence-sys-parent-close-policy-tl"
+	processorWFTypeName             = "cadence-sys-parent-close-policy-workflow"
+	processorActivityName           = "cadence-sys-parent-close-policy-activity"
+	processorChannelName            = "cadence-sys-parent-close-policy-signal"
+	workflowStartToCloseTimeout     = time.Hour * 24 * 30
+	workflowTaskStartToCloseTimeout = time.Minute
+	rateLimiterTimeout              = time.Minute
+
+	identity        = "cadence-sys-parent-close-policy-processor"
+	terminateReason = "by parent close policy"
+)
+
+var (
+	errRateLimited = errors.New("parent close policy processor is rate limited")
+
+	processorActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: 24 * time.Hour,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(ProcessorWorkflow, workflow.RegisterOptions{Name: processorWFTypeName})
+	activity.RegisterWithOptions(ProcessorActivity, activity.RegisterOptions{Name: processorActivityName})
+}
+
+// ProcessorWorkflow is the workflow that applies the parent close policy to the children
+// of closed parents. It drains all the buffered requests and completes, the next request
+// will start a new run through SignalWithStart.
+func ProcessorWorkflow(ctx workflow.Context) error {
+	requestCh := workflow.GetSignalChannel(ctx, processorChannelName)
+	for {
+		var request Request
+		if !requestCh.ReceiveAsync(&request) {
+			// no more request
+			break
+		}
+
+		opt := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			ScheduleToStartTimeout: time.Minute,
+			StartToCloseTimeout:    5 * time.Minute,
+			RetryPolicy:            &processorActivityRetryPolicy,
+		})
+		if err := workflow.ExecuteActivity(opt, processorActivityName, request).Get(ctx, nil); err != nil {
+			workflow.GetLogger(ctx).Error("failed to apply parent close policy")
+		}
+	}
+	return nil
+}
+
+// ProcessorActivity is the activity that applies the parent close policy to each child in the request
+func ProcessorActivity(ctx context.Context, request Request) error {
+	processor := ctx.Value(processorContextKey).(*Processor)
+	for _, execution := range request.Executions {
+		if !processor.rateLimiter.Consume(1, rateLimiterTimeout) {
+			return errRateLimited
+		}
+
+		var err error
+		switch execution.Policy {
+		case shared.ChildPolicyAbandon:
+			continue
+		case shared.ChildPolicyTerminate:
+			err = processor.historyClient.TerminateWorkflowExecution(nil, &h.TerminateWorkflowExecutionRequest{
+				DomainUUID: common.StringPtr(execution.DomainID),
+				TerminateRequest: &shared.TerminateWorkflowExecutionRequest{
+					Domain: common.StringPtr(execution.DomainName),
+					WorkflowExecution: &shared.WorkflowExecution{
+						WorkflowId: common.StringPtr(execution.WorkflowID),
+						RunId:      common.StringPtr(execution.RunID),
+					},
+					Reason:   common.StringPtr(terminateReason),
+					Identity: common.StringPtr(identity),
+				},
+			})
+		case shared.ChildPolicyRequestCancel:
+			err = processor.historyClient.RequestCancelWorkflowExecution(nil, &h.RequestCancelWorkflowExecutionRequest{
+				DomainUUID: common.StringPtr(execution.DomainID),
+				CancelRequest: &shared.RequestCancelWorkflowExecutionRequest{
+					Domain: common.StringPtr(execution.DomainName),
+					WorkflowExecution: &shared.WorkflowExecution{
+						WorkflowId: common.StringPtr(execution.WorkflowID),
+						RunId:      common.StringPtr(execution.RunID),
+					},
+					Identity: common.StringPtr(identity),
+				},
+			})
+		}
+
+		switch err.(type) {
+		case nil:
+			processor.metricsClient.IncCounter(metrics.ParentClosePolicyProcessorScope, metrics.ParentClosePolicyProcessorSuccess)
+		case *shared.EntityNotExistsError, *shared.CancellationAlreadyRequestedError:
+			// the child is already closed or being cancelled
+			processor.metricsClient.IncCounter(metrics.ParentClosePolicyProcessorScope, metrics.ParentClosePolicyProcessorSuccess)
+		case *shared.DomainNotActiveError:
+			// the child domain is active in another cluster, retrying will not help
+			processor.metricsClient.IncCounter(metrics.ParentClosePolicyProcessorScope, metrics.ParentClosePolicyProcessorSkipped)
+			processor.logger.Warn("skip parent close policy for child in domain not active in current cluster",
+				tag.WorkflowDomainID(execution.DomainID),
+				tag.WorkflowID(execution.WorkflowID),
+				tag.WorkflowRunID(execution.RunID),
+				tag.Error(err))
+		default:
+			processor.metricsClient.IncCounter(metrics.ParentClosePolicyProcessorScope, metrics.ParentClosePolicyProcessorFailures)
+			processor.logger.Error("failed to apply parent close policy",
+				tag.WorkflowDomainID(execution.DomainID),
+				tag.WorkflowID(execution.WorkflowID),
+				tag.WorkflowRunID(execution.RunID),
+				tag.Error(err))
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parentclosepolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/tokenbucket"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/zap"
+)
+
+type parentClosePolicyWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestParentClosePolicyWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(parentClosePolicyWorkflowTestSuite))
+}
+
+func (s *parentClosePolicyWorkflowTestSuite) TestWorkflow_NoRequest() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(processorWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *parentClosePolicyWorkflowTestSuite) TestProcessorActivity() {
+	historyClient := &mocks.HistoryClient{}
+	historyClient.On("TerminateWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
+	historyClient.On("RequestCancelWorkflowExecution", mock.Anything, mock.Anything).Return(&shared.EntityNotExistsError{}).Once()
+	processor := &Processor{
+		historyClient: historyClient,
+		rateLimiter:   tokenbucket.New(1000, clock.NewRealTimeSource()),
+		metricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		logger:        loggerimpl.NewLogger(zap.NewNop()),
+	}
+
+	env := s.NewTestActivityEnvironment()
+	env.SetTestTimeout(time.Second * 5)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), processorContextKey, processor),
+	})
+	_, err := env.ExecuteActivity(processorActivityName, Request{
+		ParentDomainID:   "some random parent domain ID",
+		ParentWorkflowID: "some random parent workflow ID",
+		ParentRunID:      "some random parent run ID",
+		Executions: []RequestDetail{
+			{DomainID: "some random domain ID", DomainName: "some random domain", WorkflowID: "wid-terminate", RunID: "rid", Policy: shared.ChildPolicyTerminate},
+			{DomainID: "some random domain ID", DomainName: "some random domain", WorkflowID: "wid-cancel", RunID: "rid", Policy: shared.ChildPolicyRequestCancel},
+			{DomainID: "some random domain ID", DomainName: "some random domain", WorkflowID: "wid-abandon", RunID: "rid", Policy: shared.ChildPolicyAbandon},
+		},
+	})
+	s.NoError(err)
+	historyClient.AssertExpectations(s.T())
+}
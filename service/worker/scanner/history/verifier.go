@@ -0,0 +1,176 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tokenbucket"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+)
+
+type (
+	// Verifier is the type that replays the histories of all open workflow executions
+	// through the history service and reports the ones which differ from their mutable states
+	Verifier struct {
+		domainDB      p.MetadataManager
+		sdkClient     workflowserviceclient.Interface
+		historyClient history.Client
+		rateLimiter   tokenbucket.TokenBucket
+		metrics       metrics.Client
+		logger        log.Logger
+	}
+)
+
+const (
+	domainsPageSize    = 100
+	executionsPageSize = 1000
+	rpcTimeout         = 10 * time.Second
+	rateLimiterTimeout = time.Minute
+)
+
+// NewVerifier returns a new instance of the history verifier, rps is the max rate of
+// verification calls issued to the history service
+func NewVerifier(
+	domainDB p.MetadataManager,
+	sdkClient workflowserviceclient.Interface,
+	historyClient history.Client,
+	rps int,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Verifier {
+	return &Verifier{
+		domainDB:      domainDB,
+		sdkClient:     sdkClient,
+		historyClient: historyClient,
+		rateLimiter:   tokenbucket.New(rps, clock.NewRealTimeSource()),
+		metrics:       metricsClient,
+		logger:        logger.WithTags(tag.ComponentHistoryVerifier),
+	}
+}
+
+// Run verifies the open workflow executions of every domain, the heartbeat function
+// is invoked after each page of executions. Run returns when all domains are verified
+// or when the context is done
+func (v *Verifier) Run(ctx context.Context, heartbeat func()) error {
+	var nextPageToken []byte
+	for {
+		resp, err := v.domainDB.ListDomains(&p.ListDomainsRequest{
+			PageSize:      domainsPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, domain := range resp.Domains {
+			if err := v.verifyDomain(ctx, domain.Info.ID, domain.Info.Name, heartbeat); err != nil {
+				return err
+			}
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (v *Verifier) verifyDomain(ctx context.Context, domainID string, domainName string, heartbeat func()) error {
+	var nextPageToken []byte
+	for {
+		listCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+		resp, err := v.sdkClient.ListOpenWorkflowExecutions(listCtx, &shared.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(domainName),
+			MaximumPageSize: common.Int32Ptr(executionsPageSize),
+			NextPageToken:   nextPageToken,
+			StartTimeFilter: &shared.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+			},
+		})
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// move on to the next domain, it will be verified again on the next run
+			v.metrics.IncCounter(metrics.HistoryVerifierScope, metrics.HistoryVerifierFailures)
+			v.logger.Error("Failed to list open workflow executions", tag.WorkflowDomainName(domainName), tag.Error(err))
+			return nil
+		}
+
+		for _, info := range resp.Executions {
+			for !v.rateLimiter.Consume(1, rateLimiterTimeout) {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+			}
+			v.verifyExecution(ctx, domainID, domainName, info.Execution.GetWorkflowId(), info.Execution.GetRunId())
+		}
+		heartbeat()
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (v *Verifier) verifyExecution(ctx context.Context, domainID string, domainName string, workflowID string, runID string) {
+	verifyCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	resp, err := v.historyClient.VerifyWorkflowExecutionHistory(verifyCtx, &h.VerifyWorkflowExecutionHistoryRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *gen.EntityNotExistsError, *gen.BadRequestError:
+			// the execution is gone or has buffered events, it will be verified again on the next run
+		default:
+			v.metrics.IncCounter(metrics.HistoryVerifierScope, metrics.HistoryVerifierFailures)
+			v.logger.Warn("Failed to verify workflow execution history",
+				tag.WorkflowDomainName(domainName), tag.WorkflowID(workflowID), tag.WorkflowRunID(runID), tag.Error(err))
+		}
+		return
+	}
+
+	v.metrics.IncCounter(metrics.HistoryVerifierScope, metrics.HistoryVerifierVerifiedCount)
+	if len(resp.Mismatches) > 0 {
+		v.metrics.IncCounter(metrics.HistoryVerifierScope, metrics.HistoryVerifierMismatchCount)
+		v.logger.Error("Workflow execution history does not match its mutable state",
+			tag.WorkflowDomainName(domainName), tag.WorkflowID(workflowID), tag.WorkflowRunID(runID), tag.Value(resp.Mismatches))
+	}
+}
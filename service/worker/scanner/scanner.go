@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cluster"
@@ -51,6 +52,10 @@ type (
 		Persistence *config.Persistence
 		// ClusterMetadata contains the metadata for this cluster
 		ClusterMetadata cluster.Metadata
+		// HistoryVerifierEnabled indicates if the history verifier workflow should be started
+		HistoryVerifierEnabled dynamicconfig.BoolPropertyFn
+		// HistoryVerifierRPS the max rate of history verification calls
+		HistoryVerifierRPS dynamicconfig.IntPropertyFn
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -60,6 +65,8 @@ type (
 		Config Config
 		// SDKClient is an instance of cadence sdk client
 		SDKClient workflowserviceclient.Interface
+		// HistoryClient is an instance of history service client
+		HistoryClient history.Client
 		// MetricsClient is an instance of metrics object for emitting stats
 		MetricsClient metrics.Client
 		Logger        log.Logger
@@ -74,6 +81,7 @@ type (
 		domainDB      p.MetadataManager
 		cfg           Config
 		sdkClient     workflowserviceclient.Interface
+		historyClient history.Client
 		metricsClient metrics.Client
 		tallyScope    tally.Scope
 		logger        log.Logger
@@ -104,6 +112,7 @@ func New(params *BootstrapParams) *Scanner {
 		context: scannerContext{
 			cfg:           cfg,
 			sdkClient:     params.SDKClient,
+			historyClient: params.HistoryClient,
 			metricsClient: params.MetricsClient,
 			tallyScope:    params.TallyScope,
 			zapLogger:     zapLogger,
@@ -124,35 +133,40 @@ func (s *Scanner) Start() error {
 		MaxConcurrentDecisionTaskExecutionSize: maxConcurrentDecisionTaskExecutionSize,
 		BackgroundActivityContext:              context.WithValue(context.Background(), scannerContextKey, s.context),
 	}
-	go s.startWorkflowWithRetry()
+	if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL {
+		go s.startWorkflowWithRetry(tlScannerWFStartOptions, tlScannerWFTypeName)
+	}
+	if s.context.cfg.HistoryVerifierEnabled() {
+		go s.startWorkflowWithRetry(historyVerifierWFStartOptions, historyVerifierWFTypeName)
+	}
 	worker := worker.New(s.context.sdkClient, common.SystemDomainName, tlScannerTaskListName, workerOpts)
 	return worker.Start()
 }
 
-func (s *Scanner) startWorkflowWithRetry() error {
+func (s *Scanner) startWorkflowWithRetry(options cclient.StartWorkflowOptions, workflowType string) error {
 	client := cclient.NewClient(s.context.sdkClient, common.SystemDomainName, &cclient.Options{})
 	policy := backoff.NewExponentialRetryPolicy(time.Second)
 	policy.SetMaximumInterval(time.Minute)
 	policy.SetExpirationInterval(backoff.NoInterval)
 	return backoff.Retry(func() error {
-		return s.startWorkflow(client)
+		return s.startWorkflow(client, options, workflowType)
 	}, policy, func(err error) bool {
 		return true
 	})
 }
 
-func (s *Scanner) startWorkflow(client cclient.Client) error {
+func (s *Scanner) startWorkflow(client cclient.Client, options cclient.StartWorkflowOptions, workflowType string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-	_, err := client.StartWorkflow(ctx, tlScannerWFStartOptions, tlScannerWFTypeName)
+	_, err := client.StartWorkflow(ctx, options, workflowType)
 	cancel()
 	if err != nil {
 		if _, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
 			return nil
 		}
-		s.context.logger.Error("error starting scanner workflow", tag.Error(err))
+		s.context.logger.Error("error starting scanner workflow", tag.WorkflowType(workflowType), tag.Error(err))
 		return err
 	}
-	s.context.logger.Info("Scanner workflow successfully started")
+	s.context.logger.Info("Scanner workflow successfully started", tag.WorkflowType(workflowType))
 	return nil
 }
 
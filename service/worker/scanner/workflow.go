@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/service/worker/scanner/history"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
@@ -45,6 +46,10 @@ const (
 	tlScannerWFTypeName           = "cadence-sys-tl-scanner-workflow"
 	tlScannerTaskListName         = "cadence-sys-tl-scanner-tasklist-0"
 	taskListScavengerActivityName = "cadence-sys-tl-scanner-scvg-activity"
+
+	historyVerifierWFID         = "cadence-sys-history-verifier"
+	historyVerifierWFTypeName   = "cadence-sys-history-verifier-workflow"
+	historyVerifierActivityName = "cadence-sys-history-verifier-activity"
 )
 
 var (
@@ -63,11 +68,20 @@ var (
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
 		CronSchedule:                 "0 */12 * * *",
 	}
+	historyVerifierWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           historyVerifierWFID,
+		TaskList:                     tlScannerTaskListName,
+		ExecutionStartToCloseTimeout: 5 * 24 * time.Hour,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 0 * * *",
+	}
 )
 
 func init() {
 	workflow.RegisterWithOptions(TaskListScannerWorkflow, workflow.RegisterOptions{Name: tlScannerWFTypeName})
 	activity.RegisterWithOptions(TaskListScavengerActivity, activity.RegisterOptions{Name: taskListScavengerActivityName})
+	workflow.RegisterWithOptions(HistoryVerifierWorkflow, workflow.RegisterOptions{Name: historyVerifierWFTypeName})
+	activity.RegisterWithOptions(HistoryVerifierActivity, activity.RegisterOptions{Name: historyVerifierActivityName})
 }
 
 // TaskListScannerWorkflow is the workflow that runs the task-list scanner background daemon
@@ -99,3 +113,30 @@ func TaskListScavengerActivity(aCtx context.Context) error {
 	}
 	return nil
 }
+
+// HistoryVerifierWorkflow is the workflow that runs the history verifier background daemon
+func HistoryVerifierWorkflow(ctx workflow.Context) error {
+	opts := workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy:            &tlScavengerActivityRetryPolicy,
+	}
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, opts), historyVerifierActivityName)
+	return future.Get(ctx, nil)
+}
+
+// HistoryVerifierActivity is the activity that replays the histories of all open workflow executions
+// and reports the ones which do not match their mutable states
+func HistoryVerifierActivity(aCtx context.Context) error {
+	ctx := aCtx.Value(scannerContextKey).(scannerContext)
+	if !ctx.cfg.HistoryVerifierEnabled() {
+		ctx.logger.Info("History verifier is disabled, skipping")
+		return nil
+	}
+	verifier := history.NewVerifier(ctx.domainDB, ctx.sdkClient, ctx.historyClient, ctx.cfg.HistoryVerifierRPS(), ctx.metricsClient, ctx.logger)
+	ctx.logger.Info("Starting history verifier")
+	return verifier.Run(aCtx, func() {
+		activity.RecordHeartbeat(aCtx)
+	})
+}
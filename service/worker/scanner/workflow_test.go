@@ -53,6 +53,13 @@ func (s *scannerWorkflowTestSuite) TestWorkflow() {
 	s.True(env.IsWorkflowCompleted())
 }
 
+func (s *scannerWorkflowTestSuite) TestHistoryVerifierWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(historyVerifierActivityName, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(historyVerifierWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+}
+
 func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	env := s.NewTestActivityEnvironment()
 	taskDB := &mocks.TaskManager{}
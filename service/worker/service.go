@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
@@ -39,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/indexer"
+	"github.com/uber/cadence/service/worker/parentclosepolicy"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
 )
@@ -48,6 +50,7 @@ type (
 	// 1. Replicator: Handles applying replication tasks generated by remote clusters.
 	// 2. Indexer: Handles uploading of visibility records to elastic search.
 	// 3. Archiver: Handles archival of workflow histories.
+	// 4. ParentClosePolicy: Handles applying the child policy to the children of closed parents.
 	Service struct {
 		stopC         chan struct{}
 		isStopped     int32
@@ -59,11 +62,13 @@ type (
 
 	// Config contains all the service config for worker
 	Config struct {
-		ReplicationCfg  *replicator.Config
-		ArchiverConfig  *archiver.Config
-		IndexerCfg      *indexer.Config
-		ScannerCfg      *scanner.Config
-		ThrottledLogRPS dynamicconfig.IntPropertyFn
+		ReplicationCfg                *replicator.Config
+		ArchiverConfig                *archiver.Config
+		IndexerCfg                    *indexer.Config
+		ScannerCfg                    *scanner.Config
+		ParentClosePolicyCfg          *parentclosepolicy.Config
+		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
+		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 	}
 )
 
@@ -111,11 +116,18 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 1*time.Second),
 		},
 		ScannerCfg: &scanner.Config{
-			PersistenceMaxQPS: dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
-			Persistence:       &params.PersistenceConfig,
-			ClusterMetadata:   params.ClusterMetadata,
+			PersistenceMaxQPS:      dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
+			Persistence:            &params.PersistenceConfig,
+			ClusterMetadata:        params.ClusterMetadata,
+			HistoryVerifierEnabled: dc.GetBoolProperty(dynamicconfig.ScannerHistoryVerifierEnabled, false),
+			HistoryVerifierRPS:     dc.GetIntProperty(dynamicconfig.ScannerHistoryVerifierRPS, 10),
 		},
-		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
+		ParentClosePolicyCfg: &parentclosepolicy.Config{
+			MaxConcurrentActivityExecutionSize: dc.GetIntProperty(dynamicconfig.WorkerParentClosePolicyConcurrency, 10),
+			ProcessorRPS:                       dc.GetIntProperty(dynamicconfig.WorkerParentClosePolicyRPS, 100),
+		},
+		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
+		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 	}
 }
 
@@ -133,14 +145,16 @@ func (s *Service) Start() {
 
 	replicatorEnabled := base.GetClusterMetadata().IsGlobalDomainEnabled()
 	archiverEnabled := base.GetClusterMetadata().ArchivalConfig().ConfiguredForArchival()
-	scannerEnabled := s.config.ScannerCfg.Persistence.DefaultStoreType() == config.StoreTypeSQL
+	scannerEnabled := s.config.ScannerCfg.Persistence.DefaultStoreType() == config.StoreTypeSQL ||
+		s.config.ScannerCfg.HistoryVerifierEnabled()
+	parentClosePolicyEnabled := s.config.EnableParentClosePolicyWorker()
 
-	if replicatorEnabled || archiverEnabled || scannerEnabled {
+	if replicatorEnabled || archiverEnabled || scannerEnabled || parentClosePolicyEnabled {
 		pConfig := s.params.PersistenceConfig
 		pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.ReplicationCfg.PersistenceMaxQPS())
 		pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.logger)
 
-		if archiverEnabled || scannerEnabled {
+		if archiverEnabled || scannerEnabled || parentClosePolicyEnabled {
 			s.ensureSystemDomainExists(pFactory, base.GetClusterMetadata().GetCurrentClusterName())
 		}
 		if replicatorEnabled {
@@ -152,6 +166,9 @@ func (s *Service) Start() {
 		if scannerEnabled {
 			s.startScanner(base)
 		}
+		if parentClosePolicyEnabled {
+			s.startParentClosePolicyProcessor(base)
+		}
 	}
 
 	s.logger.Info("service started", tag.ComponentWorker)
@@ -172,6 +189,7 @@ func (s *Service) startScanner(base service.Service) {
 	params := &scanner.BootstrapParams{
 		Config:        *s.config.ScannerCfg,
 		SDKClient:     s.params.PublicClient,
+		HistoryClient: base.GetClientBean().GetHistoryClient(),
 		MetricsClient: s.metricsClient,
 		Logger:        s.logger,
 		TallyScope:    s.params.MetricScope,
@@ -182,6 +200,21 @@ func (s *Service) startScanner(base service.Service) {
 	}
 }
 
+func (s *Service) startParentClosePolicyProcessor(base service.Service) {
+	params := &parentclosepolicy.BootstrapParams{
+		Config:        *s.config.ParentClosePolicyCfg,
+		ServiceClient: s.params.PublicClient,
+		HistoryClient: base.GetClientBean().GetHistoryClient(),
+		MetricsClient: s.metricsClient,
+		Logger:        s.logger,
+		TallyScope:    s.params.MetricScope,
+	}
+	processor := parentclosepolicy.New(params)
+	if err := processor.Start(); err != nil {
+		s.logger.Fatal("error starting parent close policy processor", tag.Error(err))
+	}
+}
+
 func (s *Service) startReplicator(base service.Service, pFactory persistencefactory.Factory) {
 	metadataV2Mgr, err := pFactory.NewMetadataManager(persistencefactory.MetadataV2)
 	if err != nil {
@@ -250,6 +283,7 @@ func (s *Service) startArchiver(base service.Service, pFactory persistencefactor
 		HistoryManager:   historyManager,
 		HistoryV2Manager: historyV2Manager,
 		Blobstore:        blobstoreClient,
+		PayloadStore:     payload.NewStore(blobstoreClient),
 		DomainCache:      domainCache,
 		Config:           s.config.ArchiverConfig,
 	}
@@ -64,6 +64,9 @@ const (
 	readSchemaVersionCQL        = `SELECT curr_version from schema_version where keyspace_name=?`
 	listTablesCQL               = `SELECT table_name from system_schema.tables where keyspace_name=?`
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
+	listColumnsCQL              = `SELECT table_name, column_name, type from system_schema.columns where keyspace_name=?`
+	listIndexesCQL              = `SELECT table_name, index_name, options from system_schema.indexes where keyspace_name=?`
+	listTypeFieldsCQL           = `SELECT type_name, field_names, field_types from system_schema.types where keyspace_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
 
@@ -215,6 +218,44 @@ func (client *cqlClient) ListTables() ([]string, error) {
 	return names, nil
 }
 
+// DescribeSchema returns the tables, columns, indexes and user defined types of the Keyspace
+func (client *cqlClient) DescribeSchema() (*schema.SchemaDescription, error) {
+	desc := schema.NewSchemaDescription()
+	iter := client.session.Query(listColumnsCQL, client.clusterConfig.Keyspace).Iter()
+	var table, column, columnType string
+	for iter.Scan(&table, &column, &columnType) {
+		desc.AddColumn(table, column, columnType)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	iter = client.session.Query(listIndexesCQL, client.clusterConfig.Keyspace).Iter()
+	var index string
+	var options map[string]string
+	for iter.Scan(&table, &index, &options) {
+		desc.AddIndex(table, index, options["target"])
+		options = nil
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	iter = client.session.Query(listTypeFieldsCQL, client.clusterConfig.Keyspace).Iter()
+	var typeName string
+	var fieldNames, fieldTypes []string
+	for iter.Scan(&typeName, &fieldNames, &fieldTypes) {
+		for i, field := range fieldNames {
+			desc.AddTypeField(typeName, field, fieldTypes[i])
+		}
+		fieldNames, fieldTypes = nil, nil
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
 // listTypes lists the User defined types in a Keyspace
 func (client *cqlClient) listTypes() ([]string, error) {
 	qry := client.session.Query(listTypesCQL, client.clusterConfig.Keyspace)
@@ -121,6 +121,56 @@ func updateSchema(cli *cli.Context) error {
 	return nil
 }
 
+// rollbackSchema executes the rollbackSchemaTask
+// using the given command line args as input
+func rollbackSchema(cli *cli.Context) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := newCQLClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := schema.Rollback(cli, client); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// validateSchema executes the validateSchemaTask
+// using the given command line args as input
+func validateSchema(cli *cli.Context) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := newCQLClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+
+	// the expected schema is built in a scratch keyspace from the versioned schema dirs
+	expectedCfg := *config
+	expectedCfg.Keyspace = schema.NewValidateDBName()
+	if err := doCreateKeyspace(expectedCfg, expectedCfg.Keyspace); err != nil {
+		return handleErr(fmt.Errorf("error creating validation Keyspace: %v", err))
+	}
+	defer doDropKeyspace(expectedCfg, expectedCfg.Keyspace)
+	expectedClient, err := newCQLClient(&expectedCfg)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer expectedClient.Close()
+
+	if err := schema.Validate(cli, client, expectedClient); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
 // createKeyspace creates a cassandra Keyspace
 func createKeyspace(cli *cli.Context) error {
 	config, err := newCQLClientConfig(cli)
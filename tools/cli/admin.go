@@ -106,6 +106,24 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDescribeWorkflow(c)
 			},
 		},
+		{
+			Name:    "verify",
+			Aliases: []string{"vf"},
+			Usage:   "Replay the history of workflow execution and verify it against the mutableState",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVerifyWorkflow(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -529,5 +547,64 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminDescribeTaskList(c)
 			},
 		},
+		{
+			Name:    "promote-build",
+			Aliases: []string{"pb"},
+			Usage:   "Promote a worker build as the default for new workflows on a decision tasklist",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagBinaryChecksumWithAlias,
+					Usage: "Binary checksum of the worker build, empty to clear the default build",
+				},
+				cli.StringFlag{ // use StringFlag instead of buggy StringSliceFlag
+					Name:  FlagCompatibleBuildsWithAlias,
+					Usage: "Binary checksums of the worker builds compatible with the default build, in format of bc1,bc2",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPromoteTaskListBuild(c)
+			},
+		},
+		{
+			Name:    "migrate",
+			Aliases: []string{"mg"},
+			Usage:   "Drain the backlog of a tasklist into another tasklist of the domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Source TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetTaskListWithAlias,
+					Usage: "Target TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Number of tasks migrated per request",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 100,
+					Usage: "Maximum number of tasks migrated per second",
+				},
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only count the tasks that would be migrated",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMigrateTaskList(c)
+			},
+		},
 	}
 }
@@ -182,6 +182,38 @@ func describeMutableState(c *cli.Context) *admin.DescribeWorkflowExecutionRespon
 	return resp
 }
 
+// AdminVerifyWorkflow replays the history of a workflow execution and prints the mismatches against its mutableState
+func AdminVerifyWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.VerifyWorkflowExecutionHistory(ctx, &admin.VerifyWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Verify workflow history failed", err)
+	}
+
+	if len(resp.Mismatches) == 0 {
+		fmt.Println("History is consistent with mutableState.")
+		return
+	}
+	for _, mismatch := range resp.Mismatches {
+		fmt.Println(mismatch)
+	}
+	ErrorAndExit(fmt.Sprintf("Found %v mismatches between history and mutableState.", len(resp.Mismatches)), nil)
+}
+
 // AdminDeleteWorkflow describe a new workflow execution for admin
 func AdminDeleteWorkflow(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
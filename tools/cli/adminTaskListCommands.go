@@ -25,8 +25,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
@@ -62,6 +64,8 @@ func AdminDescribeTaskList(c *cli.Context) {
 	}
 	printTaskListStatus(taskListStatus)
 	fmt.Printf("\n")
+	printTaskListDispatchStats(taskListStatus)
+	fmt.Printf("\n")
 
 	pollers := response.Pollers
 	if len(pollers) == 0 {
@@ -70,6 +74,97 @@ func AdminDescribeTaskList(c *cli.Context) {
 	printPollerInfo(pollers, taskListType)
 }
 
+// AdminPromoteTaskListBuild promotes a worker build as the default for new workflows on a decision task list,
+// along with the builds compatible with it.
+func AdminPromoteTaskListBuild(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	binaryChecksum := c.String(FlagBinaryChecksum)
+	var compatibleBinaryChecksums []string
+	if c.IsSet(FlagCompatibleBuilds) {
+		for _, checksum := range strings.Split(c.String(FlagCompatibleBuilds), ",") {
+			if checksum = strings.TrimSpace(checksum); checksum != "" {
+				compatibleBinaryChecksums = append(compatibleBinaryChecksums, checksum)
+			}
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.PromoteTaskListBuild(ctx, &admin.PromoteTaskListBuildRequest{
+		Domain:                    common.StringPtr(domain),
+		TaskList:                  &s.TaskList{Name: common.StringPtr(taskList)},
+		BinaryChecksum:            common.StringPtr(binaryChecksum),
+		CompatibleBinaryChecksums: compatibleBinaryChecksums,
+	})
+	if err != nil {
+		ErrorAndExit("Operation PromoteTaskListBuild failed.", err)
+	}
+	if binaryChecksum == "" {
+		fmt.Println("Default build of tasklist " + taskList + " cleared.")
+	} else {
+		fmt.Println("Build " + binaryChecksum + " promoted as default for tasklist " + taskList + ".")
+	}
+}
+
+// AdminMigrateTaskList drains the backlog of a task list into another task list of the domain.
+func AdminMigrateTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	sourceTaskList := getRequiredOption(c, FlagTaskList)
+	targetTaskList := getRequiredOption(c, FlagTargetTaskList)
+	taskListType := s.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = s.TaskListTypeActivity
+	}
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		ErrorAndExit("Page size must be positive.", nil)
+	}
+	rps := c.Int(FlagRPS)
+	if rps <= 0 {
+		ErrorAndExit("RPS must be positive.", nil)
+	}
+	dryRun := c.Bool(FlagDryRun)
+	// every page is followed by a pause long enough to keep the task rate under rps
+	pageInterval := time.Duration(pageSize) * time.Second / time.Duration(rps)
+
+	var readLevel int64
+	var migratedTasks, expiredTasks int64
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.MigrateTaskListBacklog(ctx, &admin.MigrateTaskListBacklogRequest{
+			Domain:         common.StringPtr(domain),
+			SourceTaskList: &s.TaskList{Name: common.StringPtr(sourceTaskList)},
+			TargetTaskList: &s.TaskList{Name: common.StringPtr(targetTaskList)},
+			TaskListType:   &taskListType,
+			ReadLevel:      common.Int64Ptr(readLevel),
+			PageSize:       common.Int32Ptr(int32(pageSize)),
+			DryRun:         common.BoolPtr(dryRun),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation MigrateTaskListBacklog failed.", err)
+		}
+		migratedTasks += int64(response.GetMigratedTasks())
+		expiredTasks += int64(response.GetExpiredTasks())
+		readLevel = response.GetNextReadLevel()
+		if !response.GetHasMore() {
+			break
+		}
+		time.Sleep(pageInterval)
+	}
+
+	if dryRun {
+		fmt.Printf("%v tasks would be migrated from tasklist %v to %v, %v tasks are expired.\n",
+			migratedTasks, sourceTaskList, targetTaskList, expiredTasks)
+	} else {
+		fmt.Printf("%v tasks migrated from tasklist %v to %v, %v tasks are expired.\n",
+			migratedTasks, sourceTaskList, targetTaskList, expiredTasks)
+	}
+}
+
 func printTaskListStatus(taskListStatus *s.TaskListStatus) {
 	taskIDBlock := taskListStatus.GetTaskIDBlock()
 
@@ -87,6 +182,30 @@ func printTaskListStatus(taskListStatus *s.TaskListStatus) {
 	table.Render()
 }
 
+func printTaskListDispatchStats(taskListStatus *s.TaskListStatus) {
+	latency := taskListStatus.GetScheduleToStartLatency()
+	formatMillis := func(millis int64) string {
+		return (time.Duration(millis) * time.Millisecond).String()
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Oldest Backlog Task Age", "Sync Matched", "Backlog Matched",
+		"ScheduleToStart P50", "ScheduleToStart P95", "ScheduleToStart P99", "ScheduleToStart Max"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue,
+		tableHeaderBlue, tableHeaderBlue)
+	table.Append([]string{formatMillis(taskListStatus.GetOldestUnackedTaskAgeInMillis()),
+		strconv.FormatInt(taskListStatus.GetSyncMatchCount(), 10),
+		strconv.FormatInt(taskListStatus.GetBacklogMatchCount(), 10),
+		formatMillis(latency.GetP50InMillis()),
+		formatMillis(latency.GetP95InMillis()),
+		formatMillis(latency.GetP99InMillis()),
+		formatMillis(latency.GetMaxInMillis())})
+	table.Render()
+}
+
 func printPollerInfo(pollers []*s.PollerInfo, taskListType s.TaskListType) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
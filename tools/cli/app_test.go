@@ -21,6 +21,8 @@
 package cli
 
 import (
+	"io/ioutil"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -268,6 +270,72 @@ func (s *cliAppSuite) TestShowHistory_PrintDateTime() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestShowHistory_EventFilter() {
+	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *serverShared.GetWorkflowExecutionHistoryRequest, _ ...interface{}) (*serverShared.GetWorkflowExecutionHistoryResponse, error) {
+			filter := request.EventFilter
+			s.Equal([]serverShared.EventType{serverShared.EventTypeActivityTaskFailed, serverShared.EventTypeTimerFired}, filter.EventTypes)
+			s.Equal(int64(5), filter.GetMinEventId())
+			s.Equal(int64(100), filter.GetMaxEventId())
+			s.Nil(filter.MinTimestamp)
+			s.Equal("aid", filter.GetActivityId())
+			return &serverShared.GetWorkflowExecutionHistoryResponse{
+				History: &serverShared.History{
+					Events: []*serverShared.HistoryEvent{
+						{
+							EventId:   common.Int64Ptr(10),
+							EventType: serverShared.EventTypeActivityTaskFailed.Ptr(),
+							Version:   common.Int64Ptr(0),
+							ActivityTaskFailedEventAttributes: &serverShared.ActivityTaskFailedEventAttributes{
+								Reason: common.StringPtr("faked reason"),
+							},
+						},
+					},
+				},
+				NextPageToken: []byte{1},
+			}, nil
+		})
+	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&serverShared.GetWorkflowExecutionHistoryResponse{
+		History: &serverShared.History{},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "show", "-w", "wid", "--event_types", "ActivityTaskFailed, TimerFired",
+		"--min_event_id", "5", "--max_event_id", "100", "--activity_id", "aid", "-eid", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestShowHistory_Tail() {
+	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *serverShared.GetWorkflowExecutionHistoryRequest, _ ...interface{}) (*serverShared.GetWorkflowExecutionHistoryResponse, error) {
+			s.True(request.GetReverse())
+			s.Equal(int32(2), request.GetMaximumPageSize())
+			return &serverShared.GetWorkflowExecutionHistoryResponse{
+				History: &serverShared.History{
+					Events: []*serverShared.HistoryEvent{
+						{
+							EventId:   common.Int64Ptr(10),
+							EventType: serverShared.EventTypeTimerFired.Ptr(),
+							Version:   common.Int64Ptr(0),
+							TimerFiredEventAttributes: &serverShared.TimerFiredEventAttributes{
+								TimerId: common.StringPtr("tid"),
+							},
+						},
+						{
+							EventId:   common.Int64Ptr(9),
+							EventType: serverShared.EventTypeTimerStarted.Ptr(),
+							Version:   common.Int64Ptr(0),
+							TimerStartedEventAttributes: &serverShared.TimerStartedEventAttributes{
+								TimerId: common.StringPtr("tid"),
+							},
+						},
+					},
+				},
+				NextPageToken: []byte{1},
+			}, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "show", "-w", "wid", "--tail", "2", "-eid", "9"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestStartWorkflow() {
 	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
@@ -287,6 +355,40 @@ func (s *cliAppSuite) TestStartWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestStartWorkflowBatch() {
+	file, err := ioutil.TempFile("", "start-batch")
+	s.NoError(err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(`{"workflow_id": "wid1", "input": {"key": "value"}}
+
+{"workflow_id": "wid2", "workflow_type": "otherWorkflowType", "workflow_id_reuse_policy": 2}
+{"workflow_id": "wid3"}
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	s.serverFrontendClient.EXPECT().StartWorkflowExecutionBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *serverShared.StartWorkflowExecutionBatchRequest, _ ...interface{}) (*serverShared.StartWorkflowExecutionBatchResponse, error) {
+			s.Len(request.Requests, 2)
+			s.Equal("wid1", request.Requests[0].GetWorkflowId())
+			s.Equal(`{"key": "value"}`, string(request.Requests[0].Input))
+			s.Equal("testWorkflowType", request.Requests[0].WorkflowType.GetName())
+			s.Equal("otherWorkflowType", request.Requests[1].WorkflowType.GetName())
+			s.Equal(serverShared.WorkflowIdReusePolicyRejectDuplicate, request.Requests[1].GetWorkflowIdReusePolicy())
+			return &serverShared.StartWorkflowExecutionBatchResponse{
+				Results: []*serverShared.StartWorkflowExecutionBatchResult{
+					{RunId: common.StringPtr(uuid.New())},
+					{ErrorType: common.StringPtr("WorkflowExecutionAlreadyStartedError"), ErrorMessage: common.StringPtr("faked error")},
+				},
+			}, nil
+		})
+	s.serverFrontendClient.EXPECT().StartWorkflowExecutionBatch(gomock.Any(), gomock.Any()).Return(&serverShared.StartWorkflowExecutionBatchResponse{
+		Results: []*serverShared.StartWorkflowExecutionBatchResult{{RunId: common.StringPtr(uuid.New())}},
+	}, nil)
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "start-batch", "-if", file.Name(), "-bs", "2", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestRunWorkflow() {
 	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	history := getWorkflowExecutionHistoryResponse
@@ -495,6 +597,48 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestAdminVerifyWorkflow() {
+	resp := &admin.VerifyWorkflowExecutionHistoryResponse{}
+	s.serverAdminClient.EXPECT().VerifyWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "verify", "-w", "test-wf-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminVerifyWorkflow_Mismatch() {
+	resp := &admin.VerifyWorkflowExecutionHistoryResponse{
+		Mismatches: []string{"next event ID is 5 in history but 6 in mutable state"},
+	}
+	s.serverAdminClient.EXPECT().VerifyWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(resp, nil)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "wf", "verify", "-w", "test-wf-id"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminPromoteTaskListBuild() {
+	s.serverAdminClient.EXPECT().PromoteTaskListBuild(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "promote-build", "-tl", "test-taskList", "-bc", "build-2"})
+	s.Nil(err)
+
+	s.serverAdminClient.EXPECT().PromoteTaskListBuild(gomock.Any(), &admin.PromoteTaskListBuildRequest{
+		Domain:                    common.StringPtr(domainName),
+		TaskList:                  &serverShared.TaskList{Name: common.StringPtr("test-taskList")},
+		BinaryChecksum:            common.StringPtr("build-2"),
+		CompatibleBinaryChecksums: []string{"build-1", "build-0"},
+	}).Return(nil)
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "promote-build", "-tl", "test-taskList", "-bc", "build-2", "-cb", "build-1, build-0"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminMigrateTaskList() {
+	resp := &admin.MigrateTaskListBacklogResponse{
+		MigratedTasks: common.Int32Ptr(3),
+		NextReadLevel: common.Int64Ptr(10),
+		HasMore:       common.BoolPtr(false),
+	}
+	s.serverAdminClient.EXPECT().MigrateTaskListBacklog(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "migrate", "-tl", "test-taskList", "-ttl", "test-taskList-2", "--dry_run"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -453,6 +453,28 @@ func DescribeDomain(c *cli.Context) {
 		}
 		table.Render()
 	}
+	if len(resp.WorkflowTypeConcurrency) > 0 {
+		fmt.Println("Running executions per workflow type:")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetBorder(true)
+		table.SetColumnSeparator("|")
+		header := []string{"Workflow Type", "Running", "Limit"}
+		headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+		table.SetHeader(header)
+		table.SetHeaderColor(headerColor...)
+		for _, concurrency := range resp.WorkflowTypeConcurrency {
+			limit := "unlimited"
+			if concurrency.GetLimit() > 0 {
+				limit = strconv.Itoa(int(concurrency.GetLimit()))
+			}
+			table.Append([]string{
+				concurrency.GetWorkflowType(),
+				strconv.FormatInt(concurrency.GetRunningExecutions(), 10),
+				limit,
+			})
+		}
+		table.Render()
+	}
 }
 
 // ShowHistory shows the history of given workflow execution based on workflowID and runID.
@@ -491,7 +513,19 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 
 	ctx, cancel := newContext(c)
 	defer cancel()
-	history, err := GetHistory(ctx, wfClient, wid, rid)
+	var history *s.History
+	var err error
+	eventFilter := getHistoryEventFilter(c)
+	if c.IsSet(FlagTail) {
+		if eventFilter != nil {
+			ErrorAndExit(fmt.Sprintf("Option %s cannot be used with event filter options.", FlagTail), nil)
+		}
+		history, err = getHistoryTail(ctx, c, wid, rid, c.Int(FlagTail))
+	} else if eventFilter != nil {
+		history, err = getFilteredHistory(ctx, c, wid, rid, eventFilter)
+	} else {
+		history, err = GetHistory(ctx, wfClient, wid, rid)
+	}
 	if err != nil {
 		ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
 	}
@@ -509,12 +543,18 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 			fmt.Println(anyToString(e, true, maxFieldLength))
 		}
 	} else if c.IsSet(FlagEventID) { // only dump that event
-		eventID := c.Int(FlagEventID)
-		if eventID <= 0 || eventID > len(history.Events) {
-			ErrorAndExit("EventId out of range.", fmt.Errorf("number should be 1 - %d inclusive", len(history.Events)))
+		eventID := int64(c.Int(FlagEventID))
+		var event *s.HistoryEvent
+		for _, e := range history.Events {
+			if e.GetEventId() == eventID {
+				event = e
+				break
+			}
 		}
-		e := history.Events[eventID-1]
-		fmt.Println(anyToString(e, true, 0))
+		if event == nil {
+			ErrorAndExit("EventId out of range.", fmt.Errorf("event %d is not found in the history", eventID))
+		}
+		fmt.Println(anyToString(event, true, 0))
 	} else { // use table to pretty output, will trim long text
 		table := tablewriter.NewWriter(os.Stdout)
 		table.SetBorder(false)
@@ -558,6 +598,125 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 	}
 }
 
+// getHistoryEventFilter builds the server side history event filter from the command flags,
+// nil is returned if no filter flag is set
+func getHistoryEventFilter(c *cli.Context) *shared.HistoryEventFilter {
+	if !c.IsSet(FlagEventTypes) && !c.IsSet(FlagMinEventID) && !c.IsSet(FlagMaxEventID) &&
+		!c.IsSet(FlagEarliestTime) && !c.IsSet(FlagLatestTime) && !c.IsSet(FlagActivityID) && !c.IsSet(FlagTimerID) {
+		return nil
+	}
+
+	filter := &shared.HistoryEventFilter{}
+	if c.IsSet(FlagEventTypes) {
+		for _, name := range strings.Split(c.String(FlagEventTypes), ",") {
+			var eventType shared.EventType
+			if err := eventType.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+				ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagEventTypes), err)
+			}
+			filter.EventTypes = append(filter.EventTypes, eventType)
+		}
+	}
+	if c.IsSet(FlagMinEventID) {
+		filter.MinEventId = common.Int64Ptr(c.Int64(FlagMinEventID))
+	}
+	if c.IsSet(FlagMaxEventID) {
+		filter.MaxEventId = common.Int64Ptr(c.Int64(FlagMaxEventID))
+	}
+	if c.IsSet(FlagEarliestTime) {
+		filter.MinTimestamp = common.Int64Ptr(parseTime(c.String(FlagEarliestTime), 0))
+	}
+	if c.IsSet(FlagLatestTime) {
+		filter.MaxTimestamp = common.Int64Ptr(parseTime(c.String(FlagLatestTime), 0))
+	}
+	if c.IsSet(FlagActivityID) {
+		filter.ActivityId = common.StringPtr(c.String(FlagActivityID))
+	}
+	if c.IsSet(FlagTimerID) {
+		filter.TimerId = common.StringPtr(c.String(FlagTimerID))
+	}
+	return filter
+}
+
+// getFilteredHistory iterates over all pages of history events matching the filter
+func getFilteredHistory(ctx context.Context, c *cli.Context, wid, rid string, filter *shared.HistoryEventFilter) (*s.History, error) {
+	request := newGetHistoryRequest(c, wid, rid)
+	request.EventFilter = filter
+	history, err := getServerHistory(ctx, c, request, 0)
+	if err != nil {
+		return nil, err
+	}
+	return toClientHistory(history)
+}
+
+// getHistoryTail reads the last eventCount events of history, which are returned in ascending order of event ID
+func getHistoryTail(ctx context.Context, c *cli.Context, wid, rid string, eventCount int) (*s.History, error) {
+	if eventCount <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagTail), nil)
+	}
+
+	request := newGetHistoryRequest(c, wid, rid)
+	request.Reverse = common.BoolPtr(true)
+	request.MaximumPageSize = common.Int32Ptr(int32(eventCount))
+	history, err := getServerHistory(ctx, c, request, eventCount)
+	if err != nil {
+		return nil, err
+	}
+
+	events := history.Events
+	if len(events) > eventCount {
+		events = events[:eventCount]
+	}
+	history.Events = make([]*shared.HistoryEvent, 0, len(events))
+	for i := len(events) - 1; i >= 0; i-- {
+		history.Events = append(history.Events, events[i])
+	}
+	return toClientHistory(history)
+}
+
+func newGetHistoryRequest(c *cli.Context, wid, rid string) *shared.GetWorkflowExecutionHistoryRequest {
+	return &shared.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr(getRequiredGlobalOption(c, FlagDomain)),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	}
+}
+
+// getServerHistory iterates over the pages of history until maxEventCount events are read,
+// all the pages are read if maxEventCount is not positive
+func getServerHistory(ctx context.Context, c *cli.Context, request *shared.GetWorkflowExecutionHistoryRequest, maxEventCount int) (*shared.History, error) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	history := &shared.History{}
+	for {
+		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		history.Events = append(history.Events, resp.GetHistory().GetEvents()...)
+		request.NextPageToken = resp.NextPageToken
+		if len(request.NextPageToken) == 0 || (maxEventCount > 0 && len(history.Events) >= maxEventCount) {
+			break
+		}
+	}
+	return history, nil
+}
+
+// toClientHistory converts the history to the client types so it could be rendered in the same way as
+// the history read by the workflow client
+func toClientHistory(history *shared.History) (*s.History, error) {
+	wireValue, err := history.ToWire()
+	if err != nil {
+		return nil, err
+	}
+	result := &s.History{}
+	if err := result.FromWire(wireValue); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // StartWorkflow starts a new workflow execution
 func StartWorkflow(c *cli.Context) {
 	startWorkflowHelper(c, false)
@@ -608,6 +767,15 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	if c.IsSet(FlagCronSchedule) {
 		startRequest.CronSchedule = common.StringPtr(c.String(FlagCronSchedule))
 	}
+	if c.IsSet(FlagRetentionDays) {
+		startRequest.RetentionPeriodInDays = common.Int32Ptr(int32(c.Int(FlagRetentionDays)))
+	}
+	if c.IsSet(FlagDelayStartSeconds) {
+		startRequest.DelayStartSeconds = common.Int32Ptr(int32(c.Int(FlagDelayStartSeconds)))
+	}
+	if c.IsSet(FlagWorkflowExecutionTimeout) {
+		startRequest.WorkflowExecutionTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagWorkflowExecutionTimeout)))
+	}
 
 	memoFields := processMemo(c)
 	if len(memoFields) != 0 {
@@ -661,6 +829,149 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	}
 }
 
+// startBatchLine is one line of the JSONL file of StartWorkflowBatch, unset fields fall back to the command options
+type startBatchLine struct {
+	WorkflowID            string          `json:"workflow_id"`
+	WorkflowType          string          `json:"workflow_type"`
+	TaskList              string          `json:"tasklist"`
+	Input                 json.RawMessage `json:"input"`
+	ExecutionTimeout      int32           `json:"execution_timeout"`
+	DecisionTimeout       int32           `json:"decision_timeout"`
+	WorkflowIDReusePolicy *int            `json:"workflow_id_reuse_policy"`
+	CronSchedule          string          `json:"cron"`
+}
+
+// StartWorkflowBatch starts the workflow executions described by the lines of a JSONL file
+func StartWorkflowBatch(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	inputFileName := getRequiredOption(c, FlagInputFile)
+	batchSize := c.Int(FlagBatchSize)
+	if batchSize <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagBatchSize), nil)
+	}
+
+	startRequests, err := parseStartBatchFile(c, domain, inputFileName)
+	if err != nil {
+		ErrorAndExit("Unable to parse start requests.", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Workflow Id", "Run Id", "Error"})
+	failed := 0
+	for start := 0; start < len(startRequests); start += batchSize {
+		end := start + batchSize
+		if end > len(startRequests) {
+			end = len(startRequests)
+		}
+		batch := startRequests[start:end]
+
+		ctx, cancel := newContext(c)
+		resp, err := frontendClient.StartWorkflowExecutionBatch(ctx, &shared.StartWorkflowExecutionBatchRequest{
+			Requests: batch,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to start workflows %d to %d.", start+1, end), err)
+		}
+		for i, result := range resp.Results {
+			errorMessage := ""
+			if result.ErrorType != nil {
+				errorMessage = result.GetErrorType() + ": " + result.GetErrorMessage()
+				failed++
+			}
+			table.Append([]string{batch[i].GetWorkflowId(), result.GetRunId(), errorMessage})
+		}
+	}
+	table.Render()
+	fmt.Printf("Started %d of %d workflows.\n", len(startRequests)-failed, len(startRequests))
+}
+
+func parseStartBatchFile(c *cli.Context, domain, fileName string) ([]*shared.StartWorkflowExecutionRequest, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var startRequests []*shared.StartWorkflowExecutionRequest
+	scanner := bufio.NewScanner(file)
+	idx := 0
+	for scanner.Scan() {
+		idx++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 {
+			continue
+		}
+
+		var startLine startBatchLine
+		if err := json.Unmarshal([]byte(line), &startLine); err != nil {
+			return nil, fmt.Errorf("line %v cannot be deserialized: %v", idx, err)
+		}
+		startRequest, err := newStartRequestOfBatchLine(c, domain, &startLine)
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %v", idx, err)
+		}
+		startRequests = append(startRequests, startRequest)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return startRequests, nil
+}
+
+func newStartRequestOfBatchLine(c *cli.Context, domain string, startLine *startBatchLine) (*shared.StartWorkflowExecutionRequest, error) {
+	if startLine.WorkflowID == "" {
+		startLine.WorkflowID = uuid.New()
+	}
+	if startLine.WorkflowType == "" {
+		startLine.WorkflowType = c.String(FlagWorkflowType)
+	}
+	if startLine.TaskList == "" {
+		startLine.TaskList = c.String(FlagTaskList)
+	}
+	if startLine.ExecutionTimeout == 0 {
+		startLine.ExecutionTimeout = int32(c.Int(FlagExecutionTimeout))
+	}
+	if startLine.DecisionTimeout == 0 {
+		startLine.DecisionTimeout = int32(c.Int(FlagDecisionTimeout))
+	}
+	if startLine.WorkflowType == "" || startLine.TaskList == "" || startLine.ExecutionTimeout == 0 {
+		return nil, errors.New("workflow_type, tasklist and execution_timeout must be set in the line or as options")
+	}
+
+	reusePolicy := defaultWorkflowIDReusePolicy
+	if startLine.WorkflowIDReusePolicy != nil {
+		reusePolicy = s.WorkflowIdReusePolicy(*startLine.WorkflowIDReusePolicy)
+	} else if c.IsSet(FlagWorkflowIDReusePolicy) {
+		reusePolicy = *getWorkflowIDReusePolicy(c.Int(FlagWorkflowIDReusePolicy))
+	}
+
+	startRequest := &shared.StartWorkflowExecutionRequest{
+		RequestId:  common.StringPtr(uuid.New()),
+		Domain:     common.StringPtr(domain),
+		WorkflowId: common.StringPtr(startLine.WorkflowID),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr(startLine.WorkflowType),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr(startLine.TaskList),
+		},
+		Input:                               []byte(startLine.Input),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(startLine.ExecutionTimeout),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(startLine.DecisionTimeout),
+		Identity:                            common.StringPtr(getCliIdentity()),
+		WorkflowIdReusePolicy:               shared.WorkflowIdReusePolicy(reusePolicy).Ptr(),
+	}
+	if startLine.CronSchedule != "" {
+		startRequest.CronSchedule = common.StringPtr(startLine.CronSchedule)
+	}
+	return startRequest, nil
+}
+
 // helper function to print workflow progress with time refresh every second
 func printWorkflowProgress(c *cli.Context, wid, rid string) {
 	fmt.Println(colorMagenta("Progress:"))
@@ -767,9 +1078,7 @@ func SignalWorkflow(c *cli.Context) {
 	name := getRequiredOption(c, FlagName)
 	input := processJSONInput(c)
 
-	tcCtx, cancel := newContext(c)
-	defer cancel()
-	err := serviceClient.SignalWorkflowExecution(tcCtx, &s.SignalWorkflowExecutionRequest{
+	signalRequest := &s.SignalWorkflowExecutionRequest{
 		Domain: common.StringPtr(domain),
 		WorkflowExecution: &s.WorkflowExecution{
 			WorkflowId: common.StringPtr(wid),
@@ -778,7 +1087,14 @@ func SignalWorkflow(c *cli.Context) {
 		SignalName: common.StringPtr(name),
 		Input:      []byte(input),
 		Identity:   common.StringPtr(getCliIdentity()),
-	})
+	}
+	if c.IsSet(FlagDeliverAt) {
+		signalRequest.DeliverAtTimestamp = common.Int64Ptr(parseTime(c.String(FlagDeliverAt), 0))
+	}
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := serviceClient.SignalWorkflowExecution(tcCtx, signalRequest)
 
 	if err != nil {
 		ErrorAndExit("Signal workflow failed.", err)
@@ -787,6 +1103,49 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// UpdateWorkflow sends an update to a workflow execution and waits for the result
+func UpdateWorkflow(c *cli.Context) {
+	serviceClient := cFactory.ClientFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	name := getRequiredOption(c, FlagName)
+	input := processJSONInput(c)
+
+	updateRequest := &s.UpdateWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		UpdateName: common.StringPtr(name),
+		Input:      []byte(input),
+		Identity:   common.StringPtr(getCliIdentity()),
+		RequestId:  common.StringPtr(uuid.New()),
+	}
+	if c.IsSet(FlagUpdateTimeout) {
+		updateRequest.TimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagUpdateTimeout)))
+	}
+
+	tcCtx, cancel := newContextForLongPoll(c)
+	defer cancel()
+	resp, err := serviceClient.UpdateWorkflowExecution(tcCtx, updateRequest)
+	if err != nil {
+		ErrorAndExit("Update workflow failed.", err)
+	}
+
+	result := resp.GetUpdateResult()
+	if result.GetResultType() == s.WorkflowUpdateResultTypeRejected {
+		fmt.Printf("Update rejected by run %s: %s\n", resp.GetRunId(), result.GetRejectReason())
+		return
+	}
+	fmt.Printf("Update accepted by run %s.\n", resp.GetRunId())
+	if len(result.Result) > 0 {
+		fmt.Printf("Result: %s\n", string(result.Result))
+	}
+}
+
 // QueryWorkflow query workflow execution
 func QueryWorkflow(c *cli.Context) {
 	getRequiredGlobalOption(c, FlagDomain) // for pre-check and alert if not provided
@@ -1025,6 +1384,7 @@ type workflowExecutionInfo struct {
 	ParentDomainID  *string
 	ParentExecution *shared.WorkflowExecution
 	AutoResetPoints *shared.ResetPoints
+	ExpirationTime  *string `json:",omitempty"` // change from *int64
 }
 
 // pendingActivityInfo has same fields as shared.PendingActivityInfo, but different field type for better display
@@ -1053,6 +1413,7 @@ func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecu
 		ParentDomainID:  info.ParentDomainId,
 		ParentExecution: info.ParentExecution,
 		AutoResetPoints: info.AutoResetPoints,
+		ExpirationTime:  timestampPtrToStringPtr(info.WorkflowExecutionExpirationTime, false),
 	}
 	var pendingActs []*pendingActivityInfo
 	var tmpAct *pendingActivityInfo
@@ -137,6 +137,9 @@ const (
 	FlagEventIDWithAlias            = FlagEventID + ", eid"
 	FlagActivityID                  = "activity_id"
 	FlagActivityIDWithAlias         = FlagActivityID + ", aid"
+	FlagTimerID                     = "timer_id"
+	FlagEventTypes                  = "event_types"
+	FlagTail                        = "tail"
 	FlagMaxFieldLength              = "max_field_length"
 	FlagMaxFieldLengthWithAlias     = FlagMaxFieldLength + ", maxl"
 	FlagSecurityToken               = "security_token"
@@ -161,6 +164,18 @@ const (
 	FlagResetType                   = "reset_type"
 	FlagResetPointsOnly             = "reset_points_only"
 	FlagResetBadBinaryChecksum      = "reset_bad_binary_checksum"
+	FlagDelayStartSeconds           = "delay_start_seconds"
+	FlagWorkflowExecutionTimeout    = "workflow_execution_timeout"
+	FlagDeliverAt                   = "deliver_at"
+	FlagUpdateTimeout               = "update_timeout"
+	FlagBinaryChecksum              = "binary_checksum"
+	FlagBinaryChecksumWithAlias     = FlagBinaryChecksum + ", bc"
+	FlagCompatibleBuilds            = "compatible_builds"
+	FlagCompatibleBuildsWithAlias   = FlagCompatibleBuilds + ", cb"
+	FlagTargetTaskList              = "target_tasklist"
+	FlagTargetTaskListWithAlias     = FlagTargetTaskList + ", ttl"
+	FlagDryRun                      = "dry_run"
+	FlagRPS                         = "rps"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -213,6 +228,38 @@ func getFlagsForShowID() []cli.Flag {
 			Name:  FlagResetPointsOnly,
 			Usage: "Only show events that are eligible for reset",
 		},
+		cli.StringFlag{
+			Name:  FlagEventTypes,
+			Usage: "Only show events of given types, separated by comma, e.g. ActivityTaskFailed,TimerFired",
+		},
+		cli.Int64Flag{
+			Name:  FlagMinEventID,
+			Usage: "Only show events with event id greater than or equal to this value",
+		},
+		cli.Int64Flag{
+			Name:  FlagMaxEventID,
+			Usage: "Only show events with event id less than or equal to this value",
+		},
+		cli.StringFlag{
+			Name:  FlagEarliestTimeWithAlias,
+			Usage: "Only show events happened at or after this time, in UTC format 2006-01-02T15:04:05Z or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagLatestTimeWithAlias,
+			Usage: "Only show events happened at or before this time, in UTC format 2006-01-02T15:04:05Z or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagActivityIDWithAlias,
+			Usage: "Only show events of the activity with given activity id",
+		},
+		cli.StringFlag{
+			Name:  FlagTimerID,
+			Usage: "Only show events of the timer with given timer id",
+		},
+		cli.IntFlag{
+			Name:  FlagTail,
+			Usage: "Only show the last N events, the latest events are read first",
+		},
 	}
 }
 
@@ -250,10 +297,22 @@ func getFlagsForStart() []cli.Flag {
 				"\t│ │ │ │ │ \n" +
 				"\t* * * * *",
 		},
+		cli.IntFlag{
+			Name:  FlagRetentionDaysWithAlias,
+			Usage: "Optional retention in days for the workflow, overrides the domain retention",
+		},
+		cli.IntFlag{
+			Name:  FlagDelayStartSeconds,
+			Usage: "Optional delay in seconds before the first decision task of the workflow is scheduled",
+		},
+		cli.IntFlag{
+			Name:  FlagWorkflowExecutionTimeout,
+			Usage: "Optional timeout in seconds covering all runs of the workflow, including retries, cron runs and continue-as-new",
+		},
 		cli.IntFlag{
 			Name: FlagWorkflowIDReusePolicyAlias,
 			Usage: "Optional input to configure if the same workflow ID is allow to use for new workflow execution. " +
-				"Available options: 0: AllowDuplicateFailedOnly, 1: AllowDuplicate, 2: RejectDuplicate",
+				"Available options: 0: AllowDuplicateFailedOnly, 1: AllowDuplicate, 2: RejectDuplicate, 3: TerminateIfRunning",
 		},
 		cli.StringFlag{
 			Name:  FlagInputWithAlias,
@@ -283,6 +283,9 @@ func ColorEvent(e *s.HistoryEvent) string {
 	case s.EventTypeWorkflowExecutionSignaled:
 		data = e.EventType.String()
 
+	case s.EventTypeWorkflowExecutionUpdateAccepted:
+		data = e.EventType.String()
+
 	case s.EventTypeWorkflowExecutionTerminated:
 		data = e.EventType.String()
 
@@ -412,6 +415,9 @@ func getEventAttributes(e *s.HistoryEvent) interface{} {
 	case s.EventTypeWorkflowExecutionSignaled:
 		data = e.WorkflowExecutionSignaledEventAttributes
 
+	case s.EventTypeWorkflowExecutionUpdateAccepted:
+		data = e.WorkflowExecutionUpdateAcceptedEventAttributes
+
 	case s.EventTypeWorkflowExecutionTerminated:
 		data = e.WorkflowExecutionTerminatedEventAttributes
 
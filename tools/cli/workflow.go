@@ -67,6 +67,46 @@ func newWorkflowCommands() []cli.Command {
 				RunWorkflow(c)
 			},
 		},
+		{
+			Name:  "start-batch",
+			Usage: "start the workflow executions of a JSONL file, one JSON object per line with optional fields workflow_id, workflow_type, tasklist, input, execution_timeout, decision_timeout, workflow_id_reuse_policy and cron",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "JSONL file of the workflows to start",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchSizeWithAlias,
+					Value: 100,
+					Usage: "Number of workflows started per request",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Default TaskList",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "Default WorkflowTypeName",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Default execution start to close timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagDecisionTimeoutWithAlias,
+					Value: defaultDecisionTimeoutInSeconds,
+					Usage: "Default decision task start to close timeout in seconds",
+				},
+				cli.IntFlag{
+					Name: FlagWorkflowIDReusePolicyAlias,
+					Usage: "Default WorkflowIDReusePolicy: 0 (AllowDuplicateFailedOnly), " +
+						"1 (AllowDuplicate), 2 (RejectDuplicate), 3 (TerminateIfRunning)",
+				},
+			},
+			Action: func(c *cli.Context) {
+				StartWorkflowBatch(c)
+			},
+		},
 		{
 			Name:    "cancel",
 			Aliases: []string{"c"},
@@ -101,11 +141,50 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagInputFileWithAlias,
 					Usage: "Input for the signal from JSON file.",
 				},
+				cli.StringFlag{
+					Name: FlagDeliverAt,
+					Usage: "Optional time to deliver the signal at, the signal is delivered immediately if not set. " +
+						"Supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano",
+				},
 			},
 			Action: func(c *cli.Context) {
 				SignalWorkflow(c)
 			},
 		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "send an update to a workflow execution and wait for the worker to accept or reject it",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "UpdateName",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Input for the update, in JSON format.",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Input for the update from JSON file.",
+				},
+				cli.IntFlag{
+					Name:  FlagUpdateTimeout,
+					Usage: "Optional seconds to wait for the worker, the server default is used if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateWorkflow(c)
+			},
+		},
 		{
 			Name:    "terminate",
 			Aliases: []string{"term"},
@@ -75,6 +75,45 @@ func Update(cli *cli.Context, db DB) error {
 	return newUpdateSchemaTask(db, cfg).Run()
 }
 
+// Rollback rolls back the schema for the specified database to a previous version
+func Rollback(cli *cli.Context, db DB) error {
+	cfg, err := newRollbackConfig(cli)
+	if err != nil {
+		return err
+	}
+	return newRollbackSchemaTask(db, cfg).Run()
+}
+
+// Validate compares the schema of the specified database with the versioned schema of its
+// current version, the expected schema is built in expectedDB which must be an empty database
+func Validate(cli *cli.Context, db DB, expectedDB DB) error {
+	cfg, err := newValidateConfig(cli)
+	if err != nil {
+		return err
+	}
+	return newValidateSchemaTask(db, expectedDB, cfg).Run()
+}
+
+func newRollbackConfig(cli *cli.Context) (*RollbackConfig, error) {
+	config := new(RollbackConfig)
+	config.SchemaDir = cli.String(CLIOptSchemaDir)
+	config.TargetVersion = cli.String(CLIOptRollbackTargetVersion)
+
+	if err := validateRollbackConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func newValidateConfig(cli *cli.Context) (*ValidateConfig, error) {
+	config := new(ValidateConfig)
+	config.SchemaDir = cli.String(CLIOptSchemaDir)
+	if len(config.SchemaDir) == 0 {
+		return nil, NewConfigError("missing " + flag(CLIOptSchemaDir) + " argument ")
+	}
+	return config, nil
+}
+
 func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
@@ -133,6 +172,18 @@ func validateUpdateConfig(config *UpdateConfig) error {
 	return nil
 }
 
+func validateRollbackConfig(config *RollbackConfig) error {
+	if len(config.SchemaDir) == 0 {
+		return NewConfigError("missing " + flag(CLIOptSchemaDir) + " argument ")
+	}
+	ver, err := parseValidateVersion(config.TargetVersion)
+	if err != nil {
+		return NewConfigError("invalid " + flag(CLIOptRollbackTargetVersion) + " argument:" + err.Error())
+	}
+	config.TargetVersion = ver
+	return nil
+}
+
 func flag(opt string) string {
 	return "(-" + opt + ")"
 }
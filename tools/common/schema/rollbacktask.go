@@ -0,0 +1,192 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+type (
+	// RollbackTask represents a task that rolls back
+	// a schema to a previous version using the
+	// rollback files of the versions after it
+	RollbackTask struct {
+		db     DB
+		config *RollbackConfig
+	}
+)
+
+var (
+	whitelistedRollbackCQLPrefixes = [5]string{"CREATE", "ALTER", "INSERT", "DROP", "DELETE"}
+)
+
+// newRollbackSchemaTask returns a new instance of RollbackTask
+func newRollbackSchemaTask(db DB, config *RollbackConfig) *RollbackTask {
+	return &RollbackTask{
+		db:     db,
+		config: config,
+	}
+}
+
+// Run executes the task
+func (task *RollbackTask) Run() error {
+	config := task.config
+
+	log.Printf("RollbackSchemaTask started, config=%+v\n", config)
+
+	currVer, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+	if cmpVersion(config.TargetVersion, currVer) >= 0 {
+		return fmt.Errorf("target version %v must be lower than current version %v", config.TargetVersion, currVer)
+	}
+
+	// every rollback file is read before anything is executed, so that a missing
+	// file fails the rollback instead of leaving the schema between versions
+	rollbacks, err := task.buildChangeSet(currVer)
+	if err != nil {
+		return err
+	}
+	minCompatibleVersion, err := task.readMinCompatibleVersion(config.TargetVersion)
+	if err != nil {
+		return err
+	}
+
+	for i := len(rollbacks) - 1; i >= 0; i-- {
+		cs := rollbacks[i]
+		prevVer := config.TargetVersion
+		prevMinCompatibleVersion := minCompatibleVersion
+		if i > 0 {
+			prevVer = rollbacks[i-1].version
+			prevMinCompatibleVersion = rollbacks[i-1].manifest.MinCompatibleVersion
+		}
+
+		if err := task.execCQLStmts(cs.version, cs.cqlStmts); err != nil {
+			return err
+		}
+		if err := task.db.UpdateSchemaVersion(prevVer, prevMinCompatibleVersion); err != nil {
+			return fmt.Errorf("failed to update schema_version table, err=%v", err.Error())
+		}
+		err := task.db.WriteSchemaUpdateLog(cs.version, prevVer, cs.manifest.md5, "rollback of "+cs.manifest.Description)
+		if err != nil {
+			return fmt.Errorf("failed to add entry to schema_update_history, err=%v", err.Error())
+		}
+
+		log.Printf("Schema rolled back from %v to %v\n", cs.version, prevVer)
+	}
+
+	log.Printf("RollbackSchemaTask done\n")
+
+	return nil
+}
+
+func (task *RollbackTask) execCQLStmts(ver string, stmts []string) error {
+	log.Printf("---- Executing rollback for version %v ----\n", ver)
+	for _, stmt := range stmts {
+		log.Println(rmspaceRegex.ReplaceAllString(stmt, " "))
+		e := task.db.Exec(stmt)
+		if e != nil {
+			return fmt.Errorf("error executing CQL statement:%v", e)
+		}
+	}
+	log.Printf("---- Done ----\n")
+	return nil
+}
+
+// buildChangeSet returns the rollback changes of the versions
+// after the target version up to the current version, in ascending order
+func (task *RollbackTask) buildChangeSet(currVer string) ([]changeSet, error) {
+
+	config := task.config
+
+	verDirs, err := readSchemaDir(config.SchemaDir, config.TargetVersion, currVer)
+	if err != nil {
+		return nil, fmt.Errorf("error listing schema dir:%v", err.Error())
+	}
+
+	var result []changeSet
+
+	for _, vd := range verDirs {
+
+		dirPath := config.SchemaDir + "/" + vd
+
+		m, e := readManifest(dirPath)
+		if e != nil {
+			return nil, fmt.Errorf("error processing manifest for version %v:%v", vd, e.Error())
+		}
+
+		if len(m.SchemaRollbackCqlFiles) == 0 {
+			return nil, fmt.Errorf("manifest of version %v has no SchemaRollbackCqlFiles, cannot rollback", vd)
+		}
+
+		stmts, e := parseSQLStmts(dirPath, m.SchemaRollbackCqlFiles)
+		if e != nil {
+			return nil, e
+		}
+
+		e = validateRollbackCQLStmts(stmts)
+		if e != nil {
+			return nil, fmt.Errorf("error processing version %v:%v", vd, e.Error())
+		}
+
+		cs := changeSet{}
+		cs.manifest = m
+		cs.cqlStmts = stmts
+		cs.version = m.CurrVersion
+		result = append(result, cs)
+	}
+
+	return result, nil
+}
+
+// readMinCompatibleVersion returns the min compatible version of the
+// given version, versions without a schema dir are compatible with themselves
+func (task *RollbackTask) readMinCompatibleVersion(version string) (string, error) {
+	dirPath := task.config.SchemaDir + "/v" + version
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+		return version, nil
+	}
+	m, err := readManifest(dirPath)
+	if err != nil {
+		return "", fmt.Errorf("error processing manifest for version %v:%v", version, err.Error())
+	}
+	return m.MinCompatibleVersion, nil
+}
+
+func validateRollbackCQLStmts(stmts []string) error {
+	for _, stmt := range stmts {
+		valid := false
+		for _, prefix := range whitelistedRollbackCQLPrefixes {
+			if strings.HasPrefix(stmt, prefix) {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("CQL prefix not in rollback whitelist, stmt=%v", stmt)
+		}
+	}
+	return nil
+}
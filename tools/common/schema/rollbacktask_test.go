@@ -0,0 +1,157 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	RollbackTaskTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+		tmpDir string
+	}
+
+	// fakeDB records the statements and version updates of a task
+	fakeDB struct {
+		version              string
+		minCompatibleVersion string
+		stmts                []string
+		updateLog            []string
+	}
+)
+
+func TestRollbackTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(RollbackTaskTestSuite))
+}
+
+func (s *RollbackTaskTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	var err error
+	s.tmpDir, err = ioutil.TempDir("", "rollback_schema_test")
+	s.Nil(err)
+}
+
+func (s *RollbackTaskTestSuite) TearDownTest() {
+	os.RemoveAll(s.tmpDir)
+}
+
+func (s *RollbackTaskTestSuite) TestRollback() {
+	s.makeVersionDir("1.0", "1.0", true)
+	s.makeVersionDir("2.0", "1.0", true)
+	s.makeVersionDir("3.0", "2.0", true)
+	db := &fakeDB{version: "3.0"}
+
+	err := newRollbackSchemaTask(db, &RollbackConfig{TargetVersion: "1.0", SchemaDir: s.tmpDir}).Run()
+	s.Nil(err)
+	s.Equal([]string{"DROP TABLE table_3_0;", "DROP TABLE table_2_0;"}, db.stmts)
+	s.Equal("1.0", db.version)
+	s.Equal("1.0", db.minCompatibleVersion)
+	s.Equal([]string{"3.0->2.0", "2.0->1.0"}, db.updateLog)
+}
+
+func (s *RollbackTaskTestSuite) TestRollback_MissingRollbackFile() {
+	s.makeVersionDir("1.0", "1.0", true)
+	s.makeVersionDir("2.0", "1.0", false)
+	s.makeVersionDir("3.0", "2.0", true)
+	db := &fakeDB{version: "3.0"}
+
+	err := newRollbackSchemaTask(db, &RollbackConfig{TargetVersion: "1.0", SchemaDir: s.tmpDir}).Run()
+	s.NotNil(err)
+	s.Empty(db.stmts)
+	s.Equal("3.0", db.version)
+}
+
+func (s *RollbackTaskTestSuite) TestRollback_InvalidTargetVersion() {
+	s.makeVersionDir("1.0", "1.0", true)
+	db := &fakeDB{version: "1.0"}
+
+	err := newRollbackSchemaTask(db, &RollbackConfig{TargetVersion: "1.0", SchemaDir: s.tmpDir}).Run()
+	s.NotNil(err)
+	err = newRollbackSchemaTask(db, &RollbackConfig{TargetVersion: "2.0", SchemaDir: s.tmpDir}).Run()
+	s.NotNil(err)
+	s.Empty(db.stmts)
+}
+
+func (s *RollbackTaskTestSuite) makeVersionDir(version string, minCompatibleVersion string, withRollback bool) {
+	dir := s.tmpDir + "/v" + version
+	s.Nil(os.Mkdir(dir, os.FileMode(0700)))
+
+	table := "table_" + version[:1] + "_" + version[2:]
+	rollbackFiles := ""
+	if withRollback {
+		rollbackFiles = `, "SchemaRollbackCqlFiles": ["rollback.cql"]`
+		err := ioutil.WriteFile(dir+"/rollback.cql", []byte("DROP TABLE "+table+";"), os.FileMode(0600))
+		s.Nil(err)
+	}
+	manifest := fmt.Sprintf(`{
+		"CurrVersion": "%v",
+		"MinCompatibleVersion": "%v",
+		"Description": "v%v of schema",
+		"SchemaUpdateCqlFiles": ["base.cql"]%v
+	}`, version, minCompatibleVersion, version, rollbackFiles)
+	err := ioutil.WriteFile(dir+"/manifest.json", []byte(manifest), os.FileMode(0600))
+	s.Nil(err)
+	err = ioutil.WriteFile(dir+"/base.cql", []byte("CREATE TABLE "+table+" (id int, PRIMARY KEY (id));"), os.FileMode(0600))
+	s.Nil(err)
+}
+
+func (db *fakeDB) Exec(stmt string) error {
+	db.stmts = append(db.stmts, stmt)
+	return nil
+}
+
+func (db *fakeDB) DropAllTables() error {
+	return nil
+}
+
+func (db *fakeDB) CreateSchemaVersionTables() error {
+	return nil
+}
+
+func (db *fakeDB) ReadSchemaVersion() (string, error) {
+	return db.version, nil
+}
+
+func (db *fakeDB) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	db.version = newVersion
+	db.minCompatibleVersion = minCompatibleVersion
+	return nil
+}
+
+func (db *fakeDB) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	db.updateLog = append(db.updateLog, oldVersion+"->"+newVersion)
+	return nil
+}
+
+func (db *fakeDB) DescribeSchema() (*SchemaDescription, error) {
+	return NewSchemaDescription(), nil
+}
+
+func (db *fakeDB) Close() {
+}
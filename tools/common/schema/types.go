@@ -23,6 +23,9 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strings"
+
+	"github.com/pborman/uuid"
 )
 
 type (
@@ -39,6 +42,34 @@ type (
 		SchemaDir     string
 		IsDryRun      bool
 	}
+	// RollbackConfig holds the config
+	// params for executing a RollbackTask
+	RollbackConfig struct {
+		TargetVersion string
+		SchemaDir     string
+	}
+	// ValidateConfig holds the config
+	// params for executing a ValidateTask
+	ValidateConfig struct {
+		SchemaDir string
+	}
+	// TableSchema describes the columns
+	// and indexes of a table
+	TableSchema struct {
+		// Columns maps column names to their types
+		Columns map[string]string
+		// Indexes maps index names to the indexed columns
+		Indexes map[string]string
+	}
+	// SchemaDescription describes the tables and
+	// user defined types of a keyspace/database
+	SchemaDescription struct {
+		// Tables are keyed by table name
+		Tables map[string]*TableSchema
+		// Types maps the names of user defined types
+		// to their field names and field types
+		Types map[string]map[string]string
+	}
 	// SetupConfig holds the config
 	// params need by the SetupTask
 	SetupConfig struct {
@@ -63,6 +94,8 @@ type (
 		UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error
 		// WriteSchemaUpdateLog adds an entry to the schema update history table
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		// DescribeSchema returns the tables, columns, indexes and user defined types of the keyspace
+		DescribeSchema() (*SchemaDescription, error)
 		// Close gracefully closes the client object
 		Close()
 	}
@@ -97,6 +130,8 @@ const (
 	CLIOptTargetVersion = "version"
 	// CLIOptDryrun is the cli option for enabling dryrun
 	CLIOptDryrun = "dryrun"
+	// CLIOptRollbackTargetVersion is the cli option for rollback target version
+	CLIOptRollbackTargetVersion = "target-version"
 	// CLIOptSchemaDir is the cli option for schema directory
 	CLIOptSchemaDir = "schema-dir"
 	// CLIOptReplicationFactor is the cli option for replication factor
@@ -132,6 +167,8 @@ const (
 	CLIFlagTargetVersion = CLIOptTargetVersion + ", v"
 	// CLIFlagDryrun is the cli flag for dryrun
 	CLIFlagDryrun = CLIOptDryrun + ", y"
+	// CLIFlagRollbackTargetVersion is the cli flag for rollback target version
+	CLIFlagRollbackTargetVersion = CLIOptRollbackTargetVersion + ", v"
 	// CLIFlagSchemaDir is the cli flag for schema directory
 	CLIFlagSchemaDir = CLIOptSchemaDir + ", d"
 	// CLIFlagReplicationFactor is the cli flag for replication factor
@@ -143,6 +180,15 @@ const (
 // DryrunDBName is the db name used for dryrun
 const DryrunDBName = "_cadence_dryrun_"
 
+// ValidateDBName is the prefix of the db names used to build the expected schema for validation
+const ValidateDBName = "_cadence_validate_"
+
+// NewValidateDBName returns the db name to build the expected schema of a validation run in, it is unique
+// to the run so concurrent runs, or the leftovers of an aborted run, do not interfere with each other
+func NewValidateDBName() string {
+	return ValidateDBName + strings.Replace(uuid.New(), "-", "", -1)[:16]
+}
+
 var rmspaceRegex = regexp.MustCompile("\\s+")
 
 // NewConfigError creates and returns an instance of ConfigError
@@ -47,7 +47,9 @@ type (
 		MinCompatibleVersion string
 		Description          string
 		SchemaUpdateCqlFiles []string
-		md5                  string
+		// SchemaRollbackCqlFiles undo the changes of this version, they are optional
+		SchemaRollbackCqlFiles []string
+		md5                    string
 	}
 
 	// changeSet represents all the changes
@@ -188,7 +190,7 @@ func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
 				vd, m.CurrVersion)
 		}
 
-		stmts, e := task.parseSQLStmts(dirPath, m)
+		stmts, e := parseSQLStmts(dirPath, m.SchemaUpdateCqlFiles)
 		if e != nil {
 			return nil, e
 		}
@@ -208,11 +210,11 @@ func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
 	return result, nil
 }
 
-func (task *UpdateTask) parseSQLStmts(dir string, manifest *manifest) ([]string, error) {
+func parseSQLStmts(dir string, files []string) ([]string, error) {
 
 	result := make([]string, 0, 4)
 
-	for _, file := range manifest.SchemaUpdateCqlFiles {
+	for _, file := range files {
 		path := dir + "/" + file
 		stmts, err := ParseFile(path)
 		if err != nil {
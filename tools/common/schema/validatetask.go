@@ -0,0 +1,233 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"log"
+	"sort"
+)
+
+type (
+	// ValidateTask represents a task that compares the
+	// live schema with the versioned schema of its version
+	ValidateTask struct {
+		db         DB
+		expectedDB DB
+		config     *ValidateConfig
+	}
+)
+
+// newValidateSchemaTask returns a new instance of ValidateTask
+func newValidateSchemaTask(db DB, expectedDB DB, config *ValidateConfig) *ValidateTask {
+	return &ValidateTask{
+		db:         db,
+		expectedDB: expectedDB,
+		config:     config,
+	}
+}
+
+// Run executes the task
+func (task *ValidateTask) Run() error {
+	config := task.config
+
+	log.Printf("ValidateSchemaTask started, config=%+v\n", config)
+
+	currVer, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+
+	if err := task.setupExpectedDatabase(currVer); err != nil {
+		return fmt.Errorf("error building schema of version %v:%v", currVer, err.Error())
+	}
+
+	expected, err := task.expectedDB.DescribeSchema()
+	if err != nil {
+		return fmt.Errorf("error describing versioned schema:%v", err.Error())
+	}
+	actual, err := task.db.DescribeSchema()
+	if err != nil {
+		return fmt.Errorf("error describing live schema:%v", err.Error())
+	}
+
+	diffs := diffSchema(expected, actual)
+	for _, diff := range diffs {
+		log.Println(diff)
+	}
+	if len(diffs) > 0 {
+		return fmt.Errorf("schema does not match version %v, found %v differences", currVer, len(diffs))
+	}
+
+	log.Printf("ValidateSchemaTask done, schema matches version %v\n", currVer)
+
+	return nil
+}
+
+// setupExpectedDatabase applies the versioned
+// schema up to the given version to the expected database
+func (task *ValidateTask) setupExpectedDatabase(version string) error {
+	setupConfig := &SetupConfig{
+		Overwrite:      true,
+		InitialVersion: "0.0",
+	}
+	if err := newSetupSchemaTask(task.expectedDB, setupConfig).Run(); err != nil {
+		return err
+	}
+	if cmpVersion(version, "0.0") <= 0 {
+		return nil
+	}
+	updateConfig := &UpdateConfig{
+		SchemaDir:     task.config.SchemaDir,
+		TargetVersion: version,
+	}
+	return newUpdateSchemaTask(task.expectedDB, updateConfig).Run()
+}
+
+// diffSchema returns the missing, extra and changed tables,
+// columns, indexes and user defined types of the actual schema
+func diffSchema(expected *SchemaDescription, actual *SchemaDescription) []string {
+	var diffs []string
+	for _, table := range sortedNames(tableNames(expected.Tables), tableNames(actual.Tables)) {
+		expectedTable, inExpected := expected.Tables[table]
+		actualTable, inActual := actual.Tables[table]
+		switch {
+		case !inActual:
+			diffs = append(diffs, fmt.Sprintf("missing table %v", table))
+		case !inExpected:
+			diffs = append(diffs, fmt.Sprintf("unexpected table %v", table))
+		default:
+			diffs = append(diffs, diffObjects(table, "column", expectedTable.Columns, actualTable.Columns)...)
+			diffs = append(diffs, diffObjects(table, "index", expectedTable.Indexes, actualTable.Indexes)...)
+		}
+	}
+	for _, name := range sortedNames(typeNames(expected.Types), typeNames(actual.Types)) {
+		expectedType, inExpected := expected.Types[name]
+		actualType, inActual := actual.Types[name]
+		switch {
+		case !inActual:
+			diffs = append(diffs, fmt.Sprintf("missing type %v", name))
+		case !inExpected:
+			diffs = append(diffs, fmt.Sprintf("unexpected type %v", name))
+		default:
+			diffs = append(diffs, diffObjects(name, "field", expectedType, actualType)...)
+		}
+	}
+	return diffs
+}
+
+func diffObjects(parent string, kind string, expected map[string]string, actual map[string]string) []string {
+	var diffs []string
+	for _, name := range sortedNames(objectNames(expected), objectNames(actual)) {
+		expectedDef, inExpected := expected[name]
+		actualDef, inActual := actual[name]
+		switch {
+		case !inActual:
+			diffs = append(diffs, fmt.Sprintf("missing %v %v.%v", kind, parent, name))
+		case !inExpected:
+			diffs = append(diffs, fmt.Sprintf("unexpected %v %v.%v", kind, parent, name))
+		case expectedDef != actualDef:
+			diffs = append(diffs, fmt.Sprintf("%v %v.%v is %v, expected %v", kind, parent, name, actualDef, expectedDef))
+		}
+	}
+	return diffs
+}
+
+// sortedNames returns the sorted union of the given names
+func sortedNames(expected []string, actual []string) []string {
+	set := make(map[string]struct{}, len(expected)+len(actual))
+	for _, name := range expected {
+		set[name] = struct{}{}
+	}
+	for _, name := range actual {
+		set[name] = struct{}{}
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func tableNames(tables map[string]*TableSchema) []string {
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	return names
+}
+
+func typeNames(types map[string]map[string]string) []string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	return names
+}
+
+func objectNames(objects map[string]string) []string {
+	names := make([]string, 0, len(objects))
+	for name := range objects {
+		names = append(names, name)
+	}
+	return names
+}
+
+// NewSchemaDescription returns an empty SchemaDescription
+func NewSchemaDescription() *SchemaDescription {
+	return &SchemaDescription{
+		Tables: make(map[string]*TableSchema),
+		Types:  make(map[string]map[string]string),
+	}
+}
+
+// AddColumn adds a column of the given type to the description of a table
+func (d *SchemaDescription) AddColumn(table string, column string, columnType string) {
+	d.table(table).Columns[column] = columnType
+}
+
+// AddIndex adds an index on the given columns to the description of a table
+func (d *SchemaDescription) AddIndex(table string, index string, columns string) {
+	d.table(table).Indexes[index] = columns
+}
+
+// AddTypeField adds a field of the given type to the description of a user defined type
+func (d *SchemaDescription) AddTypeField(typeName string, field string, fieldType string) {
+	fields, ok := d.Types[typeName]
+	if !ok {
+		fields = make(map[string]string)
+		d.Types[typeName] = fields
+	}
+	fields[field] = fieldType
+}
+
+func (d *SchemaDescription) table(name string) *TableSchema {
+	t, ok := d.Tables[name]
+	if !ok {
+		t = &TableSchema{
+			Columns: make(map[string]string),
+			Indexes: make(map[string]string),
+		}
+		d.Tables[name] = t
+	}
+	return t
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	ValidateTaskTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestValidateTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(ValidateTaskTestSuite))
+}
+
+func (s *ValidateTaskTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *ValidateTaskTestSuite) TestDiffSchema_Match() {
+	s.Empty(diffSchema(s.newDescription(), s.newDescription()))
+}
+
+func (s *ValidateTaskTestSuite) TestDiffSchema_Mismatch() {
+	expected := s.newDescription()
+	expected.AddColumn("tasks", "created_time", "timestamp")
+	expected.AddColumn("domains", "id", "uuid")
+	expected.AddTypeField("domain", "id", "uuid")
+	expected.AddTypeField("task_list", "name", "text")
+
+	actual := s.newDescription()
+	actual.AddColumn("executions", "hotfix", "text")
+	actual.AddColumn("tasks", "task_id", "int")
+	actual.AddIndex("executions", "executions_by_run", "run_id")
+	actual.AddColumn("shards", "shard_id", "int")
+	actual.AddTypeField("task_list", "kind", "text")
+	actual.AddTypeField("task_list", "last_updated", "timestamp")
+	actual.AddTypeField("shard", "range_id", "bigint")
+
+	s.Equal([]string{
+		"missing table domains",
+		"unexpected column executions.hotfix",
+		"unexpected index executions.executions_by_run",
+		"unexpected table shards",
+		"missing column tasks.created_time",
+		"column tasks.task_id is int, expected bigint",
+		"missing type domain",
+		"unexpected type shard",
+		"field task_list.kind is text, expected int",
+		"unexpected field task_list.last_updated",
+		"missing field task_list.name",
+	}, diffSchema(expected, actual))
+}
+
+func (s *ValidateTaskTestSuite) TestNewValidateDBName() {
+	name := NewValidateDBName()
+	s.True(strings.HasPrefix(name, ValidateDBName))
+	s.NotEqual(name, NewValidateDBName())
+}
+
+func (s *ValidateTaskTestSuite) newDescription() *SchemaDescription {
+	desc := NewSchemaDescription()
+	desc.AddColumn("executions", "shard_id", "int")
+	desc.AddColumn("executions", "run_id", "uuid")
+	desc.AddColumn("tasks", "task_id", "bigint")
+	desc.AddIndex("tasks", "tasks_by_id", "task_id")
+	desc.AddTypeField("task_list", "kind", "int")
+	return desc
+}
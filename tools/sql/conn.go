@@ -22,6 +22,7 @@ package sql
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -49,6 +50,11 @@ const (
 	readSchemaVersionSQL        = `SELECT curr_version from schema_version where db_name=?`
 	writeSchemaVersionSQL       = `REPLACE into schema_version(db_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistorySQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
+	listColumnsSQL              = `SELECT table_name AS table_name, column_name AS column_name, column_type AS column_type ` +
+		`FROM information_schema.columns WHERE table_schema=?`
+	listIndexesSQL = `SELECT table_name AS table_name, index_name AS index_name, column_name AS column_name ` +
+		`FROM information_schema.statistics WHERE table_schema=? ` +
+		`ORDER BY table_name, index_name, seq_in_index`
 
 	createSchemaVersionTableSQL = `CREATE TABLE schema_version(db_name VARCHAR(255) not null PRIMARY KEY, ` +
 		`creation_time DATETIME(6), ` +
@@ -107,6 +113,41 @@ func (c *sqlConn) WriteSchemaUpdateLog(oldVersion string, newVersion string, man
 	return err
 }
 
+// DescribeSchema returns the tables, columns and indexes of the database
+func (c *sqlConn) DescribeSchema() (*schema.SchemaDescription, error) {
+	var columns []struct {
+		Table  string `db:"table_name"`
+		Column string `db:"column_name"`
+		Type   string `db:"column_type"`
+	}
+	if err := c.db.Select(&columns, listColumnsSQL, c.database); err != nil {
+		return nil, err
+	}
+	var indexColumns []struct {
+		Table  string `db:"table_name"`
+		Index  string `db:"index_name"`
+		Column string `db:"column_name"`
+	}
+	if err := c.db.Select(&indexColumns, listIndexesSQL, c.database); err != nil {
+		return nil, err
+	}
+
+	desc := schema.NewSchemaDescription()
+	for _, col := range columns {
+		desc.AddColumn(col.Table, col.Column, col.Type)
+	}
+	// index columns are ordered by their position in the index
+	indexes := make(map[[2]string][]string)
+	for _, col := range indexColumns {
+		key := [2]string{col.Table, col.Index}
+		indexes[key] = append(indexes[key], col.Column)
+	}
+	for key, cols := range indexes {
+		desc.AddIndex(key[0], key[1], strings.Join(cols, ","))
+	}
+	return desc, nil
+}
+
 // Exec executes a sql statement
 func (c *sqlConn) Exec(stmt string) error {
 	_, err := c.db.Exec(stmt)
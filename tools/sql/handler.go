@@ -72,6 +72,56 @@ func updateSchema(cli *cli.Context) error {
 	return nil
 }
 
+// rollbackSchema executes the rollbackSchemaTask
+// using the given command line args as input
+func rollbackSchema(cli *cli.Context) error {
+	params, err := parseConnectParams(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	conn, err := newConn(params)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer conn.Close()
+	if err := schema.Rollback(cli, conn); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// validateSchema executes the validateSchemaTask
+// using the given command line args as input
+func validateSchema(cli *cli.Context) error {
+	params, err := parseConnectParams(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	conn, err := newConn(params)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer conn.Close()
+
+	// the expected schema is built in a scratch database from the versioned schema dirs
+	expectedParams := *params
+	expectedParams.database = schema.NewValidateDBName()
+	if err := doCreateDatabase(expectedParams, expectedParams.database); err != nil {
+		return handleErr(fmt.Errorf("error creating validation database: %v", err))
+	}
+	defer doDropDatabase(expectedParams, expectedParams.database)
+	expectedConn, err := newConn(&expectedParams)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer expectedConn.Close()
+
+	if err := schema.Validate(cli, conn, expectedConn); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
 // createDatabase creates a sql database
 func createDatabase(cli *cli.Context) error {
 	params, err := parseConnectParams(cli)
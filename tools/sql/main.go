@@ -142,6 +142,38 @@ func buildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema)
 			},
 		},
+		{
+			Name:    "rollback-schema",
+			Aliases: []string{"rollback"},
+			Usage:   "rollback sql schema to a previous version using the rollback files of the versioned schema",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagRollbackTargetVersion,
+					Usage: "target version for the schema rollback",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, rollbackSchema)
+			},
+		},
+		{
+			Name:    "validate-schema",
+			Aliases: []string{"validate"},
+			Usage:   "validate sql schema against the versioned schema of its current version",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, validateSchema)
+			},
+		},
 		{
 			Name:    "create-database",
 			Aliases: []string{"create"},